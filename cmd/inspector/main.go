@@ -20,6 +20,7 @@ import (
 	"storj.io/common/identity"
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/process"
 	"storj.io/storj/private/prompt"
 	_ "storj.io/storj/private/version" // This attaches version information during release builds.
@@ -81,6 +82,12 @@ var (
 		Args:  cobra.MinimumNArgs(4),
 		RunE:  SegmentHealth,
 	}
+	durabilityHealthCmd = &cobra.Command{
+		Use:   "durability <project-id> [<bucket>]",
+		Short: "Get the number of segments by healthy piece count of a project or a bucket",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE:  DurabilityHistogram,
+	}
 )
 
 // Inspector gives access to overlay.
@@ -255,6 +262,89 @@ func SegmentHealth(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// DurabilityHistogram gets the durability histograms of a project or a bucket
+// computed by the last checker loop.
+func DurabilityHistogram(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	i, err := NewInspector(ctx, *Addr, *IdentityPath)
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, i.Close()) }()
+
+	projectID, err := uuid.FromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	req := &internalpb.DurabilityHistogramRequest{
+		ProjectId: projectID[:],
+	}
+	if len(args) > 1 {
+		req.Bucket = []byte(args[1])
+	}
+
+	resp, err := i.healthclient.DurabilityHistogram(ctx, req)
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	if resp.ComputedAt.IsZero() {
+		fmt.Println("no durability histograms computed yet")
+		return nil
+	}
+
+	f, err := csvOutput()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			fmt.Printf("error closing file: %+v\n", err)
+		}
+	}()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	if err := w.Write([]string{"Computed At", resp.ComputedAt.String()}); err != nil {
+		return fmt.Errorf("error writing record to csv: %s", err)
+	}
+
+	for _, histogram := range resp.Histograms {
+		redundancy, err := eestream.NewRedundancyStrategyFromProto(histogram.GetRedundancy())
+		if err != nil {
+			return ErrRequest.Wrap(err)
+		}
+
+		table := [][]string{
+			{},
+			{"Total Pieces (n)", "Minimum Required (k)", "Optimal Threshold (o)", "Repair Threshold (m)"},
+			{strconv.Itoa(redundancy.TotalCount()), strconv.Itoa(redundancy.RequiredCount()), strconv.Itoa(redundancy.OptimalThreshold()), strconv.Itoa(redundancy.RepairThreshold())},
+			{},
+			{"Lost", "Needs Repair", "Below Optimal", "Healthy"},
+			{strconv.FormatInt(histogram.Lost, 10), strconv.FormatInt(histogram.NeedsRepair, 10), strconv.FormatInt(histogram.BelowOptimal, 10), strconv.FormatInt(histogram.Healthy, 10)},
+			{},
+			{"Healthy Pieces", "Segments"},
+		}
+		for numHealthy, count := range histogram.HealthyCounts {
+			if count == 0 {
+				continue
+			}
+			table = append(table, []string{strconv.Itoa(numHealthy), strconv.FormatInt(count, 10)})
+		}
+
+		for _, row := range table {
+			if err := w.Write(row); err != nil {
+				return fmt.Errorf("error writing record to csv: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func csvOutput() (*os.File, error) {
 	if CSVPath == "stdout" {
 		return os.Stdout, nil
@@ -434,8 +524,10 @@ func init() {
 
 	healthCmd.AddCommand(objectHealthCmd)
 	healthCmd.AddCommand(segmentHealthCmd)
+	healthCmd.AddCommand(durabilityHealthCmd)
 
 	objectHealthCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
+	durabilityHealthCmd.Flags().StringVar(&CSVPath, "csv-path", "stdout", "csv path where command output is written")
 
 	irreparableCmd.Flags().Int32Var(&irreparableLimit, "limit", 50, "max number of results per page")
 
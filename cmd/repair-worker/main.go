@@ -0,0 +1,126 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/identity"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/pkg/revocation"
+	"storj.io/storj/satellite/repair/repairer"
+)
+
+// Config defines the repair worker configuration.
+type Config struct {
+	Identity identity.Config
+	TLS      tlsopts.Config
+
+	repairer.WorkerConfig
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "repair-worker",
+		Short: "Repair worker for delegated satellite repair",
+	}
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the repair worker",
+		RunE:  cmdRun,
+	}
+	setupCmd = &cobra.Command{
+		Use:         "setup",
+		Short:       "Create config files",
+		RunE:        cmdSetup,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	runCfg      Config
+	setupCfg    Config
+	confDir     string
+	identityDir string
+)
+
+func main() {
+	process.ExecCustomDebug(rootCmd)
+}
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "repair-worker")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "repair-worker")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for repair worker configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for repair worker identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	rootCmd.AddCommand(setupCmd)
+	rootCmd.AddCommand(runCmd)
+
+	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
+}
+
+func cmdSetup(cmd *cobra.Command, args []string) (err error) {
+	setupDir, err := filepath.Abs(confDir)
+	if err != nil {
+		return err
+	}
+
+	valid, _ := fpath.IsValidSetupDir(setupDir)
+	if !valid {
+		return fmt.Errorf("repair worker configuration already exists (%v)", setupDir)
+	}
+
+	err = os.MkdirAll(setupDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return process.SaveConfig(cmd, filepath.Join(setupDir, "config.yaml"))
+}
+
+func cmdRun(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	identity, err := runCfg.Identity.Load()
+	if err != nil {
+		log.Error("failed to load identity", zap.Error(err))
+		return errs.New("failed to load identity: %+v", err)
+	}
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, runCfg.TLS)
+	if err != nil {
+		return errs.New("error creating revocation database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, revocationDB.Close())
+	}()
+
+	tlsOptions, err := tlsopts.NewOptions(identity, runCfg.TLS, revocationDB)
+	if err != nil {
+		return err
+	}
+
+	worker, err := repairer.NewWorker(log.Named("repair-worker"), rpc.NewDefaultDialer(tlsOptions), runCfg.WorkerConfig)
+	if err != nil {
+		return err
+	}
+
+	if err := process.InitMetricsWithHostname(ctx, log, nil); err != nil {
+		log.Warn("Failed to initialize telemetry batcher on repair worker", zap.Error(err))
+	}
+
+	return worker.Run(ctx)
+}
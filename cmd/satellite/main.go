@@ -150,6 +150,13 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdReportsGracefulExit,
 	}
+	reportsSettlementHistoryCmd = &cobra.Command{
+		Use:   "settlement-history [storage node ID] [start] [end]",
+		Short: "Generate an order settlement history report of a storage node",
+		Long:  "Generate an order settlement history report with the rejected orders breakdown of a storage node for a given period. Format dates using YYYY-MM-DD. The end date is exclusive.",
+		Args:  cobra.MinimumNArgs(3),
+		RunE:  cmdReportsSettlementHistory,
+	}
 	reportsVerifyGEReceiptCmd = &cobra.Command{
 		Use:   "verify-exit-receipt [storage node ID] [receipt]",
 		Short: "Verify a graceful exit receipt",
@@ -279,6 +286,10 @@ var (
 	}
 	reportsVerifyGracefulExitReceiptCfg struct {
 	}
+	reportsSettlementHistoryCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output   string `help:"destination of report output" default:""`
+	}
 	consistencyGECleanupCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
@@ -311,6 +322,7 @@ func init() {
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
 	reportsCmd.AddCommand(reportsVerifyGEReceiptCmd)
+	reportsCmd.AddCommand(reportsSettlementHistoryCmd)
 	compensationCmd.AddCommand(generateInvoicesCmd)
 	compensationCmd.AddCommand(recordPeriodCmd)
 	compensationCmd.AddCommand(recordOneOffPaymentsCmd)
@@ -336,6 +348,7 @@ func init() {
 	process.Bind(recordOneOffPaymentsCmd, &recordOneOffPaymentsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsGracefulExitCmd, &reportsGracefulExitCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsVerifyGEReceiptCmd, &reportsVerifyGracefulExitReceiptCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reportsSettlementHistoryCmd, &reportsSettlementHistoryCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(partnerAttributionCmd, &partnerAttribtionCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(prepareCustomerInvoiceRecordsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(createCustomerInvoiceItemsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -595,6 +608,24 @@ func cmdReportsGracefulExit(cmd *cobra.Command, args []string) (err error) {
 	return generateGracefulExitCSV(ctx, reportsGracefulExitCfg.Completed, start, end, file)
 }
 
+func cmdReportsSettlementHistory(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.Combine(err, errs.New("Invalid node ID."))
+	}
+
+	start, end, err := reports.ParseRange(args[1], args[2])
+	if err != nil {
+		return err
+	}
+
+	return runWithOutput(reportsSettlementHistoryCfg.Output, func(out io.Writer) error {
+		return generateSettlementHistoryCSV(ctx, nodeID, start, end, out)
+	})
+}
+
 func cmdNodeUsage(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
@@ -0,0 +1,93 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generateSettlementHistoryCSV creates a report with the order settlement outcomes of a storage node
+// for the windows in a given period, followed by the totals of the period.
+func generateSettlementHistoryCSV(ctx context.Context, nodeID storj.NodeID, start time.Time, end time.Time, output io.Writer) (err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), reportsSettlementHistoryCfg.Database, satellitedb.Options{ApplicationName: "satellite-settlements"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	report, err := orders.GetSettlementReport(ctx, db.Orders(), nodeID, start, end)
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(output)
+	headers := []string{
+		"intervalStart",
+		"settledAt",
+		"status",
+		"alreadyProcessed",
+		"ordersReceived",
+		"ordersAccepted",
+		"ordersUnknownBucket",
+		"amountSettled",
+	}
+	for _, reason := range orders.SettlementRejectReasons {
+		headers = append(headers, "rejected_"+string(reason))
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+
+	for _, outcome := range report.Outcomes {
+		record := []string{
+			outcome.IntervalStart.Format(time.RFC3339),
+			outcome.SettledAt.Format(time.RFC3339),
+			outcome.Status.String(),
+			strconv.FormatBool(outcome.AlreadyProcessed),
+			strconv.Itoa(outcome.OrdersReceived),
+			strconv.Itoa(outcome.OrdersAccepted),
+			strconv.Itoa(outcome.OrdersUnknownBucket),
+			strconv.FormatInt(outcome.AmountSettled, 10),
+		}
+		for _, reason := range orders.SettlementRejectReasons {
+			record = append(record, strconv.Itoa(outcome.Rejected[reason]))
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	total := []string{
+		"total",
+		"",
+		strconv.Itoa(report.AcceptedWindows) + " accepted, " + strconv.Itoa(report.RejectedWindows) + " rejected",
+		strconv.Itoa(report.RetriedWindows) + " retried",
+		strconv.Itoa(report.OrdersReceived),
+		strconv.Itoa(report.OrdersAccepted),
+		strconv.Itoa(report.OrdersUnknownBucket),
+		strconv.FormatInt(report.AmountSettled, 10),
+	}
+	for _, reason := range orders.SettlementRejectReasons {
+		total = append(total, strconv.Itoa(report.Rejected[reason]))
+	}
+	if err := w.Write(total); err != nil {
+		return err
+	}
+
+	w.Flush()
+	return w.Error()
+}
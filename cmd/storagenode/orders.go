@@ -0,0 +1,329 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/private/prompt"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/orders"
+)
+
+var (
+	ordersCmd = &cobra.Command{
+		Use:         "orders",
+		Short:       "Inspect and manage unsent and archived orders",
+		Annotations: map[string]string{"type": "helper"},
+	}
+	ordersListCmd = &cobra.Command{
+		Use:         "list",
+		Short:       "List order windows per satellite",
+		RunE:        cmdOrdersList,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	ordersSettleCmd = &cobra.Command{
+		Use:   "settle [satellite ID]",
+		Short: "Send the unsent orders of a satellite for settlement now",
+		Long: "Send the unsent orders of a satellite for settlement now.\n" +
+			"The storage node must be running, since the orders are sent by the node.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        cmdOrdersSettle,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	ordersValidateCmd = &cobra.Command{
+		Use:         "validate",
+		Short:       "Check order files for corrupt entries",
+		RunE:        cmdOrdersValidate,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	ordersRepairCmd = &cobra.Command{
+		Use:   "repair",
+		Short: "Drop corrupt entries from order files",
+		Long: "Drop corrupt entries from order files, keeping every entry which can be read.\n" +
+			"The storage node must be stopped while the files are repaired.",
+		RunE:        cmdOrdersRepair,
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	ordersFilterCfg struct {
+		Satellite string
+		Unsent    bool
+		Archived  bool
+	}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{ordersListCmd, ordersValidateCmd, ordersRepairCmd} {
+		cmd.Flags().StringVar(&ordersFilterCfg.Satellite, "satellite", "", "only include the windows of this satellite ID")
+	}
+	ordersListCmd.Flags().BoolVar(&ordersFilterCfg.Unsent, "unsent", false, "only list unsent windows")
+	ordersListCmd.Flags().BoolVar(&ordersFilterCfg.Archived, "archived", false, "only list archived windows")
+
+	ordersCmd.AddCommand(ordersListCmd)
+	ordersCmd.AddCommand(ordersSettleCmd)
+	ordersCmd.AddCommand(ordersValidateCmd)
+	ordersCmd.AddCommand(ordersRepairCmd)
+}
+
+func cmdOrdersList(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	windows, err := listOrderWindows(ctx)
+	if err != nil {
+		return err
+	}
+
+	filtered := windows[:0]
+	for _, window := range windows {
+		if ordersFilterCfg.Unsent != ordersFilterCfg.Archived && window.Archived != ordersFilterCfg.Archived {
+			continue
+		}
+		filtered = append(filtered, window)
+	}
+
+	if len(filtered) == 0 {
+		fmt.Println("No orders found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	displayOrderWindows(w, filtered)
+	return nil
+}
+
+func cmdOrdersSettle(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite ID: %w", err)
+	}
+
+	conn, err := rpc.NewDefaultDialer(nil).DialAddressUnencrypted(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			zap.L().Debug("Closing orders client failed.", zap.Error(err))
+		}
+	}()
+
+	resp, err := internalpb.NewDRPCNodeOrdersClient(conn).SettleSatellite(ctx, &internalpb.SettleSatelliteRequest{
+		SatelliteId: satelliteID,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if len(resp.GetWindows()) == 0 {
+		fmt.Println("No unsent orders ready for settlement.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer func() { err = errs.Combine(err, w.Flush()) }()
+
+	fmt.Fprintln(w, "Window\tOrders\tStatus\t")
+	var failed bool
+	for _, window := range resp.GetWindows() {
+		status := window.GetStatus()
+		if window.GetError() != "" {
+			failed = true
+			status = "failed: " + window.GetError()
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t\n", window.CreatedAtHour.Format(time.RFC3339), window.GetCount(), status)
+	}
+
+	if failed {
+		return errs.New("settlement failed, the remaining windows will be sent later by the node")
+	}
+	return nil
+}
+
+func cmdOrdersValidate(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	store, err := openOrdersStore()
+	if err != nil {
+		return err
+	}
+
+	windows, listErr := store.ListWindows(ctx)
+	windows, err = filterOrderWindows(windows, listErr)
+	if err != nil {
+		return err
+	}
+	if listErr != nil {
+		return errs.New("some order files could not be read: %w", listErr)
+	}
+
+	corrupt := corruptOrderWindows(windows)
+	if len(corrupt) == 0 {
+		fmt.Printf("Checked %d order files, no corrupt entries found.\n", len(windows))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	displayOrderWindows(w, corrupt)
+	if err := w.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+
+	return errs.New("%d of %d order files contain corrupt entries, use 'orders repair' to recover them", len(corrupt), len(windows))
+}
+
+func cmdOrdersRepair(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	store, err := openOrdersStore()
+	if err != nil {
+		return err
+	}
+
+	windows, err := filterOrderWindows(store.ListWindows(ctx))
+	if err != nil {
+		return err
+	}
+
+	corrupt := corruptOrderWindows(windows)
+	if len(corrupt) == 0 {
+		fmt.Println("No corrupt order files found.")
+		return nil
+	}
+
+	confirmed, err := prompt.Confirm(fmt.Sprintf("Found %d corrupt order files.\nThe storage node must be stopped before repairing them.\nAre you sure you want to continue? [y/n]\n", len(corrupt)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return nil
+	}
+
+	var errList errs.Group
+	for _, window := range corrupt {
+		recovered, err := store.Repair(ctx, window)
+		if err != nil {
+			errList.Add(err)
+			fmt.Printf("%s: repair failed: %v\n", window.Path, err)
+			continue
+		}
+		fmt.Printf("%s: recovered %d orders, dropped %d corrupt entries\n", window.Path, recovered, window.Corrupt)
+	}
+
+	return errList.Err()
+}
+
+// openOrdersStore opens the orders directory of the configured storage node.
+func openOrdersStore() (*orders.FileStore, error) {
+	return orders.NewFileStore(zap.L().Named("orders"), diagCfg.Storage2.Orders.Path, diagCfg.Storage2.OrderLimitGracePeriod)
+}
+
+// listOrderWindows returns the order windows matching the satellite filter,
+// sorted by satellite and window.
+func listOrderWindows(ctx context.Context) ([]orders.WindowInfo, error) {
+	store, err := openOrdersStore()
+	if err != nil {
+		return nil, err
+	}
+	return filterOrderWindows(store.ListWindows(ctx))
+}
+
+func filterOrderWindows(windows []orders.WindowInfo, listErr error) ([]orders.WindowInfo, error) {
+	if listErr != nil {
+		// files which can't be parsed are reported, but shouldn't hide the readable ones.
+		zap.L().Warn("Some order files could not be read.", zap.Error(listErr))
+	}
+
+	if ordersFilterCfg.Satellite != "" {
+		satelliteID, err := storj.NodeIDFromString(ordersFilterCfg.Satellite)
+		if err != nil {
+			return nil, errs.New("invalid satellite ID: %w", err)
+		}
+
+		filtered := windows[:0]
+		for _, window := range windows {
+			if window.SatelliteID == satelliteID {
+				filtered = append(filtered, window)
+			}
+		}
+		windows = filtered
+	}
+
+	sort.Slice(windows, func(i, k int) bool {
+		if windows[i].SatelliteID != windows[k].SatelliteID {
+			return windows[i].SatelliteID.Less(windows[k].SatelliteID)
+		}
+		if !windows[i].CreatedAtHour.Equal(windows[k].CreatedAtHour) {
+			return windows[i].CreatedAtHour.Before(windows[k].CreatedAtHour)
+		}
+		return windows[i].ArchivedAt.Before(windows[k].ArchivedAt)
+	})
+
+	return windows, nil
+}
+
+func corruptOrderWindows(windows []orders.WindowInfo) []orders.WindowInfo {
+	var corrupt []orders.WindowInfo
+	for _, window := range windows {
+		if window.Corrupt > 0 {
+			corrupt = append(corrupt, window)
+		}
+	}
+	return corrupt
+}
+
+func displayOrderWindows(w io.Writer, windows []orders.WindowInfo) {
+	fmt.Fprintln(w, "Satellite ID\tWindow\tStatus\tArchived At\tOrders\tAmount\tCorrupt\t")
+
+	for _, window := range windows {
+		status := "unsent"
+		switch window.Status {
+		case orders.StatusAccepted:
+			status = "accepted"
+		case orders.StatusRejected:
+			status = "rejected"
+		}
+
+		archivedAt := "-"
+		if window.Archived {
+			archivedAt = window.ArchivedAt.Format(time.RFC3339)
+		}
+
+		corrupt := "-"
+		if window.Corrupt > 0 {
+			corrupt = fmt.Sprintf("%d", window.Corrupt)
+			if window.Truncated {
+				corrupt += " (truncated)"
+			}
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t\n",
+			window.SatelliteID.String(),
+			window.CreatedAtHour.Format(time.RFC3339),
+			status,
+			archivedAt,
+			window.Count,
+			memory.Size(window.Amount).Base10String(),
+			corrupt,
+		)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// ErrNoAlert is a special error type that indicates about absence of alert in DB.
+var ErrNoAlert = errs.Class("no such alert")
+
+// DB stores the raised alerts.
+//
+// architecture: Database
+type DB interface {
+	// Create stores a newly raised alert.
+	Create(ctx context.Context, alert Alert) error
+	// ListActive returns all alerts that are not resolved.
+	ListActive(ctx context.Context) ([]Alert, error)
+	// List returns the most recently raised alerts, newest first.
+	List(ctx context.Context, limit int) ([]Alert, error)
+	// Resolve marks the alert as resolved.
+	Resolve(ctx context.Context, id uuid.UUID, resolvedAt time.Time) error
+	// Acknowledge marks the alert as acknowledged, keeping the time of the first acknowledgment.
+	Acknowledge(ctx context.Context, id uuid.UUID, acknowledgedAt time.Time) error
+}
+
+// Kind is the kind of condition an alert was raised for.
+type Kind string
+
+const (
+	// KindNodeOffline is raised when a node is unreachable or has not contacted its satellites for too long.
+	KindNodeOffline Kind = "node-offline"
+	// KindSuspended is raised when the suspension score of a node on a satellite is too low.
+	KindSuspended Kind = "suspended"
+	// KindAuditScore is raised when the audit score of a node on a satellite is too low.
+	KindAuditScore Kind = "audit-score"
+	// KindDiskUsage is raised when a node uses too much of its allocated disk space.
+	KindDiskUsage Kind = "disk-usage"
+	// KindVersion is raised when a node runs a version below the minimum allowed by version control.
+	KindVersion Kind = "version"
+)
+
+// Alert is a condition raised for a node, optionally on a specific satellite.
+type Alert struct {
+	ID             uuid.UUID     `json:"id"`
+	NodeID         storj.NodeID  `json:"nodeID"`
+	SatelliteID    *storj.NodeID `json:"satelliteID,omitempty"`
+	Kind           Kind          `json:"kind"`
+	Message        string        `json:"message"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	ResolvedAt     *time.Time    `json:"resolvedAt,omitempty"`
+	AcknowledgedAt *time.Time    `json:"acknowledgedAt,omitempty"`
+}
+
+// key identifies the condition an alert was raised for, so that the same
+// condition is not raised again while its alert is active.
+type key struct {
+	nodeID      storj.NodeID
+	satelliteID storj.NodeID
+	kind        Kind
+}
+
+func (alert *Alert) key() key {
+	k := key{nodeID: alert.NodeID, kind: alert.Kind}
+	if alert.SatelliteID != nil {
+		k.satelliteID = *alert.SatelliteID
+	}
+	return k
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+func TestAlertsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		alertsDB := db.Alerts()
+
+		nodeID := testrand.NodeID()
+		satelliteID := testrand.NodeID()
+		require.NoError(t, db.Nodes().Add(ctx, nodeID, testrand.Bytes(32), "127.0.0.1:55550"))
+
+		now := time.Date(2021, time.May, 10, 12, 0, 0, 0, time.UTC)
+
+		offline := alerts.Alert{
+			ID:        testrand.UUID(),
+			NodeID:    nodeID,
+			Kind:      alerts.KindNodeOffline,
+			Message:   "node has been unreachable for 1h0m0s",
+			CreatedAt: now,
+		}
+		suspended := alerts.Alert{
+			ID:          testrand.UUID(),
+			NodeID:      nodeID,
+			SatelliteID: &satelliteID,
+			Kind:        alerts.KindSuspended,
+			Message:     "suspension score 0.8000 is below 0.9500",
+			CreatedAt:   now.Add(time.Minute),
+		}
+
+		require.NoError(t, alertsDB.Create(ctx, offline))
+		require.NoError(t, alertsDB.Create(ctx, suspended))
+
+		active, err := alertsDB.ListActive(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []alerts.Alert{suspended, offline}, active)
+
+		require.NoError(t, alertsDB.Resolve(ctx, offline.ID, now.Add(time.Hour)))
+
+		active, err = alertsDB.ListActive(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []alerts.Alert{suspended}, active)
+
+		// the first acknowledgment is kept.
+		require.NoError(t, alertsDB.Acknowledge(ctx, suspended.ID, now.Add(2*time.Hour)))
+		require.NoError(t, alertsDB.Acknowledge(ctx, suspended.ID, now.Add(3*time.Hour)))
+
+		err = alertsDB.Acknowledge(ctx, testrand.UUID(), now)
+		require.True(t, alerts.ErrNoAlert.Has(err))
+
+		list, err := alertsDB.List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, suspended.ID, list[0].ID)
+		require.Equal(t, now.Add(2*time.Hour), *list[0].AcknowledgedAt)
+		require.Nil(t, list[0].ResolvedAt)
+		require.Equal(t, offline.ID, list[1].ID)
+		require.Equal(t, now.Add(time.Hour), *list[1].ResolvedAt)
+		require.Nil(t, list[1].SatelliteID)
+
+		list, err = alertsDB.List(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		// the active alerts of removed nodes are not returned.
+		require.NoError(t, db.Nodes().Remove(ctx, nodeID))
+
+		active, err = alertsDB.ListActive(ctx)
+		require.NoError(t, err)
+		require.Empty(t, active)
+	})
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var payload alerts.WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	notifier := alerts.NewWebhookNotifier(alerts.WebhookConfig{
+		URL:     server.URL,
+		Timeout: time.Minute,
+	})
+
+	node := nodes.Node{ID: testrand.NodeID(), Name: "node1"}
+	alert := alerts.Alert{
+		ID:        testrand.UUID(),
+		NodeID:    node.ID,
+		Kind:      alerts.KindDiskUsage,
+		Message:   "disk usage 95.0% is above 90.0%",
+		CreatedAt: time.Date(2021, time.May, 10, 12, 0, 0, 0, time.UTC),
+	}
+
+	require.NoError(t, notifier.Notify(ctx, node, alert))
+	require.Equal(t, "node1", payload.NodeName)
+	require.Equal(t, alert, payload.Alert)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	notifier = alerts.NewWebhookNotifier(alerts.WebhookConfig{
+		URL:     failing.URL,
+		Timeout: time.Minute,
+	})
+	require.Error(t, notifier.Notify(ctx, node, alert))
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/post"
+)
+
+// Notifier delivers raised alerts.
+type Notifier interface {
+	// Notify delivers the alert raised for the node.
+	Notify(ctx context.Context, node nodes.Node, alert Alert) error
+}
+
+// EmailConfig contains configurable values for alert emails.
+type EmailConfig struct {
+	SMTPServerAddress string   `help:"smtp server address used to send alert emails, emails are not sent when empty" default:""`
+	From              string   `help:"sender email address of alert emails" default:""`
+	To                []string `help:"recipient email addresses of alert emails"`
+	AuthType          string   `help:"smtp authentication type: plain, login or none" default:"plain"`
+	Login             string   `help:"smtp login" default:""`
+	Password          string   `help:"smtp password" default:""`
+}
+
+// EmailNotifier sends alerts via SMTP.
+type EmailNotifier struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+// NewEmailNotifier creates an EmailNotifier from the config.
+func NewEmailNotifier(config EmailConfig) (*EmailNotifier, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	host, _, err := net.SplitHostPort(config.SMTPServerAddress)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if len(config.To) == 0 {
+		return nil, Error.New("no alert email recipients")
+	}
+
+	var to []post.Address
+	for _, recipient := range config.To {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		to = append(to, *address)
+	}
+
+	sender := &post.SMTPSender{
+		From:          *from,
+		ServerAddress: config.SMTPServerAddress,
+	}
+
+	switch config.AuthType {
+	case "plain":
+		sender.Auth = smtp.PlainAuth("", config.Login, config.Password, host)
+	case "login":
+		sender.Auth = post.LoginAuth{
+			Username: config.Login,
+			Password: config.Password,
+		}
+	case "none", "":
+	default:
+		return nil, Error.New("unsupported smtp authentication type %q", config.AuthType)
+	}
+
+	return &EmailNotifier{
+		sender: sender,
+		to:     to,
+	}, nil
+}
+
+// Notify sends an email about the alert raised for the node.
+func (notifier *EmailNotifier) Notify(ctx context.Context, node nodes.Node, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	text := fmt.Sprintf("Node: %s (%s)\n", node.Name, node.ID)
+	if alert.SatelliteID != nil {
+		text += fmt.Sprintf("Satellite: %s\n", alert.SatelliteID)
+	}
+	text += fmt.Sprintf("Alert: %s\nRaised at: %s\n", alert.Message, alert.CreatedAt.Format(time.RFC3339))
+
+	msg := &post.Message{
+		From:      notifier.sender.FromAddress(),
+		To:        notifier.to,
+		Subject:   fmt.Sprintf("[%s] %s", alert.Kind, nodeName(node)),
+		Date:      alert.CreatedAt,
+		PlainText: text,
+	}
+
+	return Error.Wrap(notifier.sender.SendEmail(ctx, msg))
+}
+
+// WebhookConfig contains configurable values for alert webhooks.
+type WebhookConfig struct {
+	URL     string        `help:"url that alerts are posted to as json, nothing is posted when empty" default:""`
+	Timeout time.Duration `help:"timeout of alert webhook requests" default:"10s"`
+}
+
+// WebhookNotifier posts alerts as json to an url.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a WebhookNotifier from the config.
+func NewWebhookNotifier(config WebhookConfig) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    config.URL,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// WebhookPayload is the json body posted by WebhookNotifier.
+type WebhookPayload struct {
+	NodeName string `json:"nodeName"`
+	Alert
+}
+
+// Notify posts the alert raised for the node.
+func (notifier *WebhookNotifier) Notify(ctx context.Context, node nodes.Node, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(WebhookPayload{
+		NodeName: node.Name,
+		Alert:    alert,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := notifier.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+// nodeName returns the name of the node, or its id when it has no name.
+func nodeName(node nodes.Node) string {
+	if node.Name != "" {
+		return node.Name
+	}
+	return node.ID.String()
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"fmt"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/private/version"
+)
+
+// Rules contains the thresholds at which alerts are raised.
+type Rules struct {
+	OfflineThreshold         time.Duration `help:"how long a node can be unreachable or out of contact with its satellites before an alert is raised" default:"30m0s"`
+	SuspensionScoreThreshold float64       `help:"suspension score below which an alert is raised" default:"0.95"`
+	AuditScoreThreshold      float64       `help:"audit score below which an alert is raised" default:"0.95"`
+	DiskUsageThreshold       float64       `help:"fraction of the allocated disk space above which an alert is raised" default:"0.9"`
+}
+
+// NodeStatus is the state of a node as seen by the last check.
+type NodeStatus struct {
+	NodeID storj.NodeID
+	// Reachable is false when the node could not be queried, in which case
+	// only UnreachableSince is set.
+	Reachable        bool
+	UnreachableSince time.Time
+
+	Version     string
+	LastContact time.Time
+	Allocated   int64
+	Used        int64
+	Reputations []SatelliteReputation
+}
+
+// SatelliteReputation contains the scores of a node on a satellite.
+type SatelliteReputation struct {
+	SatelliteID     storj.NodeID
+	AuditScore      float64
+	SuspensionScore float64
+}
+
+// condition is a rule violated by a node.
+type condition struct {
+	key
+	message string
+}
+
+// evaluate returns the conditions the node status violates and the kinds
+// of conditions that could be evaluated. The minimum version is ignored
+// when it is zero.
+func (rules Rules) evaluate(status NodeStatus, minimum version.SemVer, now time.Time) (conditions []condition, evaluated map[Kind]bool) {
+	evaluated = map[Kind]bool{KindNodeOffline: true}
+
+	nodeKey := func(kind Kind) key {
+		return key{nodeID: status.NodeID, kind: kind}
+	}
+
+	if !status.Reachable {
+		if offline := now.Sub(status.UnreachableSince); offline > rules.OfflineThreshold {
+			conditions = append(conditions, condition{
+				key:     nodeKey(KindNodeOffline),
+				message: fmt.Sprintf("node has been unreachable for %s", offline.Truncate(time.Minute)),
+			})
+		}
+		return conditions, evaluated
+	}
+
+	evaluated[KindSuspended] = true
+	evaluated[KindAuditScore] = true
+	evaluated[KindDiskUsage] = true
+
+	if offline := now.Sub(status.LastContact); offline > rules.OfflineThreshold {
+		conditions = append(conditions, condition{
+			key:     nodeKey(KindNodeOffline),
+			message: fmt.Sprintf("node has not contacted its satellites for %s", offline.Truncate(time.Minute)),
+		})
+	}
+
+	for _, rep := range status.Reputations {
+		if rep.SuspensionScore < rules.SuspensionScoreThreshold {
+			conditions = append(conditions, condition{
+				key:     key{nodeID: status.NodeID, satelliteID: rep.SatelliteID, kind: KindSuspended},
+				message: fmt.Sprintf("suspension score %.4f is below %.4f", rep.SuspensionScore, rules.SuspensionScoreThreshold),
+			})
+		}
+		if rep.AuditScore < rules.AuditScoreThreshold {
+			conditions = append(conditions, condition{
+				key:     key{nodeID: status.NodeID, satelliteID: rep.SatelliteID, kind: KindAuditScore},
+				message: fmt.Sprintf("audit score %.4f is below %.4f", rep.AuditScore, rules.AuditScoreThreshold),
+			})
+		}
+	}
+
+	if status.Allocated > 0 {
+		if usage := float64(status.Used) / float64(status.Allocated); usage > rules.DiskUsageThreshold {
+			conditions = append(conditions, condition{
+				key:     nodeKey(KindDiskUsage),
+				message: fmt.Sprintf("disk usage %.1f%% is above %.1f%%", usage*100, rules.DiskUsageThreshold*100),
+			})
+		}
+	}
+
+	if !minimum.IsZero() {
+		current, err := version.NewSemVer(status.Version)
+		if err == nil {
+			evaluated[KindVersion] = true
+			if current.Compare(minimum) < 0 {
+				conditions = append(conditions, condition{
+					key:     nodeKey(KindVersion),
+					message: fmt.Sprintf("version %s is below the minimum %s", current.String(), minimum.String()),
+				})
+			}
+		}
+	}
+
+	return conditions, evaluated
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+)
+
+func TestRulesEvaluate(t *testing.T) {
+	rules := Rules{
+		OfflineThreshold:         30 * time.Minute,
+		SuspensionScoreThreshold: 0.95,
+		AuditScoreThreshold:      0.95,
+		DiskUsageThreshold:       0.9,
+	}
+
+	now := time.Date(2021, time.May, 10, 12, 0, 0, 0, time.UTC)
+	nodeID := testrand.NodeID()
+	satelliteID := testrand.NodeID()
+
+	minimum, err := version.NewSemVer("v1.28.0")
+	require.NoError(t, err)
+
+	kinds := func(conditions []condition) map[Kind]bool {
+		result := map[Kind]bool{}
+		for _, cond := range conditions {
+			result[cond.kind] = true
+		}
+		return result
+	}
+
+	healthy := NodeStatus{
+		NodeID:      nodeID,
+		Reachable:   true,
+		Version:     "v1.28.2",
+		LastContact: now.Add(-time.Minute),
+		Allocated:   1000,
+		Used:        500,
+		Reputations: []SatelliteReputation{
+			{SatelliteID: satelliteID, AuditScore: 1, SuspensionScore: 1},
+		},
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		conditions, evaluated := rules.evaluate(healthy, minimum, now)
+		require.Empty(t, conditions)
+		require.Equal(t, map[Kind]bool{
+			KindNodeOffline: true,
+			KindSuspended:   true,
+			KindAuditScore:  true,
+			KindDiskUsage:   true,
+			KindVersion:     true,
+		}, evaluated)
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		status := healthy
+		status.Version = "v1.27.6"
+		status.LastContact = now.Add(-time.Hour)
+		status.Used = 950
+		status.Reputations = []SatelliteReputation{
+			{SatelliteID: satelliteID, AuditScore: 0.9, SuspensionScore: 0.8},
+		}
+
+		conditions, _ := rules.evaluate(status, minimum, now)
+		require.Equal(t, map[Kind]bool{
+			KindNodeOffline: true,
+			KindSuspended:   true,
+			KindAuditScore:  true,
+			KindDiskUsage:   true,
+			KindVersion:     true,
+		}, kinds(conditions))
+
+		for _, cond := range conditions {
+			require.Equal(t, nodeID, cond.nodeID)
+			switch cond.kind {
+			case KindSuspended, KindAuditScore:
+				require.Equal(t, satelliteID, cond.satelliteID)
+			default:
+				require.True(t, cond.satelliteID.IsZero())
+			}
+		}
+	})
+
+	t.Run("no minimum version", func(t *testing.T) {
+		status := healthy
+		status.Version = "v1.0.0"
+
+		conditions, evaluated := rules.evaluate(status, version.SemVer{}, now)
+		require.Empty(t, conditions)
+		require.False(t, evaluated[KindVersion])
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		status := NodeStatus{
+			NodeID:           nodeID,
+			UnreachableSince: now.Add(-10 * time.Minute),
+		}
+
+		conditions, evaluated := rules.evaluate(status, minimum, now)
+		require.Empty(t, conditions)
+		require.Equal(t, map[Kind]bool{KindNodeOffline: true}, evaluated)
+
+		status.UnreachableSince = now.Add(-time.Hour)
+		conditions, _ = rules.evaluate(status, minimum, now)
+		require.Equal(t, map[Kind]bool{KindNodeOffline: true}, kinds(conditions))
+	})
+}
@@ -0,0 +1,372 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/private/version"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/version/checker"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is an error class for alerts service error.
+	Error = errs.Class("alerts service error")
+	// errUnreachable is used when the node cannot be dialed or the connection fails.
+	errUnreachable = errs.Class("node unreachable")
+)
+
+// Config contains configurable values for alerting.
+type Config struct {
+	Interval time.Duration `help:"how often to check the nodes for alerts" default:"5m0s"`
+	Rules
+
+	VersionControl checker.ClientConfig
+	Email          EmailConfig
+	Webhook        WebhookConfig
+}
+
+// Service periodically checks every node against the alert rules, stores
+// the raised alerts and delivers them to the notifiers. Alerts are resolved
+// once their condition no longer holds.
+//
+// architecture: Service
+type Service struct {
+	log       *zap.Logger
+	dialer    rpc.Dialer
+	nodes     nodes.DB
+	db        DB
+	versions  *checker.Client
+	notifiers []Notifier
+	config    Config
+	nowFn     func() time.Time
+
+	Loop *sync2.Cycle
+
+	mu               sync.Mutex
+	unreachableSince map[storj.NodeID]time.Time
+}
+
+// NewService creates new instance of Service. The version control client may be nil.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, db DB, versions *checker.Client, notifiers []Notifier, config Config) *Service {
+	return &Service{
+		log:       log,
+		dialer:    dialer,
+		nodes:     nodes,
+		db:        db,
+		versions:  versions,
+		notifiers: notifiers,
+		config:    config,
+		nowFn:     time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+
+		unreachableSince: make(map[storj.NodeID]time.Time),
+	}
+}
+
+// Run starts checking the nodes periodically.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Check(ctx); err != nil {
+			service.log.Error("failed to check nodes for alerts", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Check queries every node, raises alerts for the violated rules and
+// resolves the alerts whose condition no longer holds. A failure to check
+// a single node is logged and doesn't stop checking the remaining nodes.
+func (service *Service) Check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := service.nodes.List(ctx)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	var minimum version.SemVer
+	if service.versions != nil {
+		process, err := service.versions.Process(ctx, "storagenode")
+		if err != nil {
+			service.log.Warn("failed to get minimum storagenode version", zap.Error(err))
+		} else if minimum, err = process.Minimum.SemVer(); err != nil {
+			service.log.Warn("invalid minimum storagenode version", zap.Error(err))
+		}
+	}
+
+	active, err := service.db.ListActive(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	activeByKey := make(map[key]Alert, len(active))
+	for _, alert := range active {
+		activeByKey[alert.key()] = alert
+	}
+
+	for _, node := range list {
+		status, ok := service.status(ctx, node)
+		if !ok {
+			continue
+		}
+		now := service.nowFn()
+
+		conditions, evaluated := service.config.Rules.evaluate(status, minimum, now)
+
+		raised := make(map[key]bool, len(conditions))
+		for _, cond := range conditions {
+			raised[cond.key] = true
+			if _, ok := activeByKey[cond.key]; ok {
+				continue
+			}
+
+			if err := service.raise(ctx, node, cond, now); err != nil {
+				service.log.Error("failed to raise alert",
+					zap.Stringer("Node ID", node.ID),
+					zap.String("Kind", string(cond.kind)),
+					zap.Error(err))
+			}
+		}
+
+		for k, alert := range activeByKey {
+			if k.nodeID != node.ID || raised[k] || !evaluated[k.kind] {
+				continue
+			}
+
+			if err := service.db.Resolve(ctx, alert.ID, now); err != nil {
+				service.log.Error("failed to resolve alert",
+					zap.Stringer("Node ID", node.ID),
+					zap.String("Kind", string(alert.Kind)),
+					zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// raise stores a new alert for the condition and delivers it to the notifiers.
+func (service *Service) raise(ctx context.Context, node nodes.Node, cond condition, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	alert := Alert{
+		ID:        id,
+		NodeID:    cond.nodeID,
+		Kind:      cond.kind,
+		Message:   cond.message,
+		CreatedAt: now,
+	}
+	if !cond.satelliteID.IsZero() {
+		satelliteID := cond.satelliteID
+		alert.SatelliteID = &satelliteID
+	}
+
+	if err := service.db.Create(ctx, alert); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, notifier := range service.notifiers {
+		if err := notifier.Notify(ctx, node, alert); err != nil {
+			service.log.Error("failed to deliver alert",
+				zap.Stringer("Node ID", node.ID),
+				zap.String("Kind", string(alert.Kind)),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// status queries the state of the node, marking it unreachable when it cannot
+// be dialed or the connection fails. It returns false, when the node responded
+// with an error, in which case the rules cannot be evaluated.
+func (service *Service) status(ctx context.Context, node nodes.Node) (_ NodeStatus, ok bool) {
+	status, err := service.fetchStatus(ctx, node)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if err != nil && !errUnreachable.Has(err) {
+		service.log.Warn("failed to query node", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		delete(service.unreachableSince, node.ID)
+		return NodeStatus{}, false
+	}
+
+	if err != nil {
+		service.log.Warn("node is unreachable", zap.Stringer("Node ID", node.ID), zap.Error(err))
+
+		since, ok := service.unreachableSince[node.ID]
+		if !ok {
+			since = service.nowFn()
+			service.unreachableSince[node.ID] = since
+		}
+
+		return NodeStatus{
+			NodeID:           node.ID,
+			UnreachableSince: since,
+		}, true
+	}
+
+	delete(service.unreachableSince, node.ID)
+	return status, true
+}
+
+// fetchStatus queries the state of the node via rpc.
+func (service *Service) fetchStatus(ctx context.Context, node nodes.Node) (_ NodeStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return NodeStatus{}, errUnreachable.Wrap(err)
+	}
+
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret,
+	}
+
+	status := NodeStatus{
+		NodeID:    node.ID,
+		Reachable: true,
+	}
+
+	nodeVersion, err := nodeClient.Version(ctx, &multinodepb.VersionRequest{Header: header})
+	if err != nil {
+		return NodeStatus{}, rpcError(err)
+	}
+	status.Version = nodeVersion.Version
+
+	lastContact, err := nodeClient.LastContact(ctx, &multinodepb.LastContactRequest{Header: header})
+	if err != nil {
+		return NodeStatus{}, rpcError(err)
+	}
+	status.LastContact = lastContact.LastContact
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		return NodeStatus{}, rpcError(err)
+	}
+	status.Allocated = diskSpace.Allocated
+	status.Used = diskSpace.UsedPieces + diskSpace.UsedTrash
+
+	satellites, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		return NodeStatus{}, rpcError(err)
+	}
+
+	for _, satellite := range satellites.TrustedSatellites {
+		rep, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			return NodeStatus{}, rpcError(err)
+		}
+
+		status.Reputations = append(status.Reputations, SatelliteReputation{
+			SatelliteID:     satellite.NodeId,
+			AuditScore:      rep.GetAudit().GetScore(),
+			SuspensionScore: rep.GetAudit().GetSuspensionScore(),
+		})
+	}
+
+	return status, nil
+}
+
+// rpcError wraps the error of a request to the node, failures of the
+// connection are wrapped with errUnreachable.
+func rpcError(err error) error {
+	var netErr net.Error
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &netErr) ||
+		errs2.IsRPC(err, rpcstatus.Unavailable) {
+		return errUnreachable.Wrap(err)
+	}
+	return Error.Wrap(err)
+}
+
+// List returns the most recently raised alerts, newest first.
+func (service *Service) List(ctx context.Context, limit int) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := service.db.List(ctx, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if alerts == nil {
+		alerts = []Alert{}
+	}
+
+	return alerts, nil
+}
+
+// ListActive returns all alerts that are not resolved.
+func (service *Service) ListActive(ctx context.Context) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := service.db.ListActive(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if alerts == nil {
+		alerts = []Alert{}
+	}
+
+	return alerts, nil
+}
+
+// Acknowledge marks the alert as acknowledged.
+func (service *Service) Acknowledge(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(service.db.Acknowledge(ctx, id, service.nowFn()))
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(now func() time.Time) {
+	service.nowFn = now
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/alerts"
+)
+
+const defaultAlertsLimit = 100
+
+var (
+	// ErrAlerts is an internal error type for alerts web api controller.
+	ErrAlerts = errs.Class("alerts web api controller error")
+)
+
+// Alerts is a web api controller.
+type Alerts struct {
+	log     *zap.Logger
+	service *alerts.Service
+}
+
+// NewAlerts is a constructor for Alerts.
+func NewAlerts(log *zap.Logger, service *alerts.Service) *Alerts {
+	return &Alerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// List handles retrieval of the alert history.
+func (controller *Alerts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	limit := defaultAlertsLimit
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit <= 0 {
+			controller.serveError(w, http.StatusBadRequest, ErrAlerts.New("invalid limit %q", limitParam))
+			return
+		}
+	}
+
+	list, err := controller.service.List(ctx, limit)
+	if err != nil {
+		controller.log.Error("list alerts internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// ListActive handles retrieval of the alerts that are not resolved.
+func (controller *Alerts) ListActive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	list, err := controller.service.ListActive(ctx)
+	if err != nil {
+		controller.log.Error("list active alerts internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Acknowledge handles acknowledgment of an alert.
+func (controller *Alerts) Acknowledge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+
+	err = controller.service.Acknowledge(ctx, id)
+	if err != nil {
+		if alerts.ErrNoAlert.Has(err) {
+			controller.serveError(w, http.StatusNotFound, ErrAlerts.Wrap(err))
+			return
+		}
+		controller.log.Error("acknowledge alert internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Alerts) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -246,6 +246,139 @@ func (controller *Nodes) TrustedSatellites(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// InitiateGracefulExit handles starting graceful exit from a satellite on the selected nodes.
+func (controller *Nodes) InitiateGracefulExit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs     []storj.NodeID `json:"nodeIds"`
+		SatelliteID storj.NodeID   `json:"satelliteId"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	if payload.SatelliteID.IsZero() {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.New("satellite id is missing"))
+		return
+	}
+
+	results, err := controller.service.InitiateGracefulExit(ctx, payload.NodeIDs, payload.SatelliteID)
+	if err != nil {
+		controller.log.Error("initiate graceful exit internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// GracefulExitProgress handles retrieval of graceful exit progress of the selected nodes.
+func (controller *Nodes) GracefulExitProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var nodeIDs []storj.NodeID
+	for _, idString := range r.URL.Query()["id"] {
+		id, err := storj.NodeIDFromString(idString)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+			return
+		}
+		nodeIDs = append(nodeIDs, id)
+	}
+
+	progress, err := controller.service.GracefulExitProgress(ctx, nodeIDs)
+	if err != nil {
+		controller.log.Error("graceful exit progress internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(progress); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// SetAllocatedDiskSpace handles changing allocated disk space of the selected nodes.
+func (controller *Nodes) SetAllocatedDiskSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs   []storj.NodeID `json:"nodeIds"`
+		Allocated int64          `json:"allocated"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	if payload.Allocated <= 0 {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.New("allocated disk space must be positive"))
+		return
+	}
+
+	results, err := controller.service.SetAllocatedDiskSpace(ctx, payload.NodeIDs, payload.Allocated)
+	if err != nil {
+		controller.log.Error("set allocated disk space internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// RotateAPIKeys handles api secret rotation of the selected nodes.
+func (controller *Nodes) RotateAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	var payload struct {
+		NodeIDs []storj.NodeID `json:"nodeIds"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrNodes.Wrap(err))
+		return
+	}
+
+	results, err := controller.service.RotateAPIKeys(ctx, payload.NodeIDs)
+	if err != nil {
+		controller.log.Error("rotate api keys internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrNodes.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
 // serveError set http statuses and send json error.
 func (controller *Nodes) serveError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -0,0 +1,145 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/rollups"
+)
+
+const defaultBandwidthDays = 30
+
+var (
+	// ErrRollups is an internal error type for rollups web api controller.
+	ErrRollups = errs.Class("rollups web api controller error")
+)
+
+// Rollups is a web api controller.
+type Rollups struct {
+	log     *zap.Logger
+	service *rollups.Service
+}
+
+// NewRollups is a constructor for Rollups.
+func NewRollups(log *zap.Logger, service *rollups.Service) *Rollups {
+	return &Rollups{
+		log:     log,
+		service: service,
+	}
+}
+
+// DailyBandwidth handles retrieval of daily bandwidth usage per satellite summed across nodes.
+func (controller *Rollups) DailyBandwidth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	days := defaultBandwidthDays
+	if daysParam := r.URL.Query().Get("days"); daysParam != "" {
+		days, err = strconv.Atoi(daysParam)
+		if err != nil || days <= 0 {
+			controller.serveError(w, http.StatusBadRequest, ErrRollups.New("invalid days %q", daysParam))
+			return
+		}
+	}
+
+	bandwidth, err := controller.service.DailyBandwidth(ctx, days)
+	if err != nil {
+		controller.log.Error("daily bandwidth internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrRollups.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(bandwidth); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// DiskSpace handles retrieval of disk space usage of all nodes.
+func (controller *Rollups) DiskSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	diskSpace, err := controller.service.DiskSpace(ctx)
+	if err != nil {
+		controller.log.Error("disk space internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrRollups.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(diskSpace); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Reputations handles retrieval of scores of every node on every satellite.
+func (controller *Rollups) Reputations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	reputations, err := controller.service.Reputations(ctx)
+	if err != nil {
+		controller.log.Error("reputations internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrRollups.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(reputations); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// HeldAmounts handles retrieval of held amount history per satellite summed across nodes.
+func (controller *Rollups) HeldAmounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	held, err := controller.service.HeldAmounts(ctx)
+	if err != nil {
+		controller.log.Error("held amounts internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrRollups.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(held); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Rollups) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
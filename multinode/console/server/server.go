@@ -15,9 +15,11 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/console/controllers"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/payouts"
+	"storj.io/storj/multinode/rollups"
 )
 
 var (
@@ -40,6 +42,8 @@ type Server struct {
 	config  Config
 	nodes   *nodes.Service
 	payouts *payouts.Service
+	rollups *rollups.Service
+	alerts  *alerts.Service
 
 	listener net.Listener
 	http     http.Server
@@ -48,13 +52,15 @@ type Server struct {
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
-func NewServer(log *zap.Logger, config Config, nodes *nodes.Service, payouts *payouts.Service, listener net.Listener) (*Server, error) {
+func NewServer(log *zap.Logger, config Config, nodes *nodes.Service, payouts *payouts.Service, rollups *rollups.Service, alerts *alerts.Service, listener net.Listener) (*Server, error) {
 	server := Server{
 		log:      log,
 		config:   config,
 		nodes:    nodes,
 		listener: listener,
 		payouts:  payouts,
+		rollups:  rollups,
+		alerts:   alerts,
 	}
 
 	router := mux.NewRouter()
@@ -69,6 +75,10 @@ func NewServer(log *zap.Logger, config Config, nodes *nodes.Service, payouts *pa
 	nodesRouter.HandleFunc("/infos", nodesController.ListInfos).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/infos/{satelliteID}", nodesController.ListInfosSatellite).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/trusted-satellites", nodesController.TrustedSatellites).Methods(http.MethodGet)
+	nodesRouter.HandleFunc("/graceful-exit", nodesController.InitiateGracefulExit).Methods(http.MethodPost)
+	nodesRouter.HandleFunc("/graceful-exit", nodesController.GracefulExitProgress).Methods(http.MethodGet)
+	nodesRouter.HandleFunc("/allocated-disk-space", nodesController.SetAllocatedDiskSpace).Methods(http.MethodPost)
+	nodesRouter.HandleFunc("/rotate-api-keys", nodesController.RotateAPIKeys).Methods(http.MethodPost)
 	nodesRouter.HandleFunc("/{id}", nodesController.Get).Methods(http.MethodGet)
 	nodesRouter.HandleFunc("/{id}", nodesController.UpdateName).Methods(http.MethodPatch)
 	nodesRouter.HandleFunc("/{id}", nodesController.Delete).Methods(http.MethodDelete)
@@ -77,6 +87,19 @@ func NewServer(log *zap.Logger, config Config, nodes *nodes.Service, payouts *pa
 	payoutsRouter := apiRouter.PathPrefix("/payouts").Subrouter()
 	payoutsRouter.HandleFunc("/total-earned", payoutsController.GetAllNodesTotalEarned).Methods(http.MethodGet)
 
+	rollupsController := controllers.NewRollups(server.log, server.rollups)
+	rollupsRouter := apiRouter.PathPrefix("/rollups").Subrouter()
+	rollupsRouter.HandleFunc("/bandwidth", rollupsController.DailyBandwidth).Methods(http.MethodGet)
+	rollupsRouter.HandleFunc("/disk-space", rollupsController.DiskSpace).Methods(http.MethodGet)
+	rollupsRouter.HandleFunc("/reputations", rollupsController.Reputations).Methods(http.MethodGet)
+	rollupsRouter.HandleFunc("/held-amounts", rollupsController.HeldAmounts).Methods(http.MethodGet)
+
+	alertsController := controllers.NewAlerts(server.log, server.alerts)
+	alertsRouter := apiRouter.PathPrefix("/alerts").Subrouter()
+	alertsRouter.HandleFunc("", alertsController.List).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/active", alertsController.ListActive).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/{id}/acknowledge", alertsController.Acknowledge).Methods(http.MethodPost)
+
 	if server.config.StaticDir != "" {
 		router.PathPrefix("/static/").Handler(http.StripPrefix("/static", fs))
 		router.PathPrefix("/").HandlerFunc(server.appHandler)
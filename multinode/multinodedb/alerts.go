@@ -0,0 +1,172 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/multinode/alerts"
+	"storj.io/storj/multinode/multinodedb/dbx"
+	"storj.io/storj/private/tagsql"
+)
+
+// ErrAlertsDB indicates about internal AlertsDB error.
+var ErrAlertsDB = errs.Class("AlertsDB error")
+
+// ensures that alertsdb implements alerts.DB.
+var _ alerts.DB = (*alertsdb)(nil)
+
+// alertsdb stores the raised alerts.
+//
+// architecture: Database
+type alertsdb struct {
+	db *dbx.DB
+}
+
+// Create stores a newly raised alert.
+func (a *alertsdb) Create(ctx context.Context, alert alerts.Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var satelliteID []byte
+	if alert.SatelliteID != nil {
+		satelliteID = alert.SatelliteID.Bytes()
+	}
+
+	_, err = a.db.ExecContext(ctx, a.db.Rebind(`
+		INSERT INTO alerts (id, node_id, satellite_id, kind, message, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`), alert.ID[:], alert.NodeID.Bytes(), satelliteID, string(alert.Kind), alert.Message, alert.CreatedAt.UTC())
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// ListActive returns all alerts that are not resolved.
+func (a *alertsdb) ListActive(ctx context.Context) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, `
+		SELECT id, node_id, satellite_id, kind, message, created_at, resolved_at, acknowledged_at
+		FROM alerts
+		WHERE resolved_at IS NULL
+			AND node_id IN (SELECT id FROM nodes)
+		ORDER BY created_at DESC
+	`)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	return scanAlerts(rows)
+}
+
+// List returns the most recently raised alerts, newest first.
+func (a *alertsdb) List(ctx context.Context, limit int) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := a.db.QueryContext(ctx, a.db.Rebind(`
+		SELECT id, node_id, satellite_id, kind, message, created_at, resolved_at, acknowledged_at
+		FROM alerts
+		ORDER BY created_at DESC
+		LIMIT ?
+	`), limit)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	return scanAlerts(rows)
+}
+
+// Resolve marks the alert as resolved.
+func (a *alertsdb) Resolve(ctx context.Context, id uuid.UUID, resolvedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`
+		UPDATE alerts SET resolved_at = ? WHERE id = ?
+	`), resolvedAt.UTC(), id[:])
+	if err != nil {
+		return ErrAlertsDB.Wrap(err)
+	}
+
+	return checkAlertUpdated(result)
+}
+
+// Acknowledge marks the alert as acknowledged, keeping the time of the first acknowledgment.
+func (a *alertsdb) Acknowledge(ctx context.Context, id uuid.UUID, acknowledgedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := a.db.ExecContext(ctx, a.db.Rebind(`
+		UPDATE alerts SET acknowledged_at = COALESCE(acknowledged_at, ?) WHERE id = ?
+	`), acknowledgedAt.UTC(), id[:])
+	if err != nil {
+		return ErrAlertsDB.Wrap(err)
+	}
+
+	return checkAlertUpdated(result)
+}
+
+// checkAlertUpdated returns alerts.ErrNoAlert when no alert was updated.
+func checkAlertUpdated(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return ErrAlertsDB.Wrap(err)
+	}
+	if affected == 0 {
+		return alerts.ErrNoAlert.New("no alert was updated")
+	}
+	return nil
+}
+
+// scanAlerts converts the rows to alerts and closes them.
+func scanAlerts(rows tagsql.Rows) (_ []alerts.Alert, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []alerts.Alert
+	for rows.Next() {
+		var id, nodeID, satelliteID []byte
+		var kind string
+		var resolvedAt, acknowledgedAt *time.Time
+		var alert alerts.Alert
+
+		err := rows.Scan(&id, &nodeID, &satelliteID, &kind, &alert.Message, &alert.CreatedAt, &resolvedAt, &acknowledgedAt)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+
+		alert.ID, err = uuid.FromBytes(id)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+		alert.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+		if satelliteID != nil {
+			satellite, err := storj.NodeIDFromBytes(satelliteID)
+			if err != nil {
+				return nil, ErrAlertsDB.Wrap(err)
+			}
+			alert.SatelliteID = &satellite
+		}
+
+		alert.Kind = alerts.Kind(kind)
+		alert.CreatedAt = alert.CreatedAt.UTC()
+		if resolvedAt != nil {
+			t := resolvedAt.UTC()
+			alert.ResolvedAt = &t
+		}
+		if acknowledgedAt != nil {
+			t := acknowledgedAt.UTC()
+			alert.AcknowledgedAt = &t
+		}
+
+		list = append(list, alert)
+	}
+
+	return list, ErrAlertsDB.Wrap(rows.Err())
+}
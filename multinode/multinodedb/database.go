@@ -12,9 +12,11 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/console"
 	"storj.io/storj/multinode/multinodedb/dbx"
 	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/multinode/rollups"
 	"storj.io/storj/private/dbutil"
 	"storj.io/storj/private/dbutil/pgutil"
 )
@@ -98,6 +100,20 @@ func (db *multinodeDB) Members() console.Members {
 	}
 }
 
+// Rollups returns rollups cache database.
+func (db *multinodeDB) Rollups() rollups.DB {
+	return &rollupsdb{
+		db: db.DB,
+	}
+}
+
+// Alerts returns alerts database.
+func (db *multinodeDB) Alerts() alerts.DB {
+	return &alertsdb{
+		db: db.DB,
+	}
+}
+
 // CreateSchema creates schema.
 func (db *multinodeDB) CreateSchema(ctx context.Context) error {
 	_, err := db.ExecContext(ctx, db.DB.Schema())
@@ -275,7 +275,44 @@ func newpgx(db *DB) *pgxDB {
 }
 
 func (obj *pgxDB) Schema() string {
-	return `CREATE TABLE members (
+	return `CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	satellite_id bytea,
+	kind text NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	acknowledged_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bandwidth_rollups (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	egress bigint NOT NULL,
+	ingress bigint NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, interval_start )
+);
+CREATE TABLE disk_spaces (
+	node_id bytea NOT NULL,
+	allocated bigint NOT NULL,
+	used_pieces bigint NOT NULL,
+	used_trash bigint NOT NULL,
+	free bigint NOT NULL,
+	available bigint NOT NULL,
+	overused bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE held_amounts (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	period text NOT NULL,
+	amount bigint NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, period )
+);
+CREATE TABLE members (
 	id bytea NOT NULL,
 	email text NOT NULL,
 	name text NOT NULL,
@@ -289,6 +326,15 @@ CREATE TABLE nodes (
 	public_address text NOT NULL,
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
+);
+CREATE TABLE reputations (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	online_score double precision NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id )
 );`
 }
 
@@ -353,7 +399,44 @@ func newsqlite3(db *DB) *sqlite3DB {
 }
 
 func (obj *sqlite3DB) Schema() string {
-	return `CREATE TABLE members (
+	return `CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	satellite_id BLOB,
+	kind TEXT NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	acknowledged_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bandwidth_rollups (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	egress INTEGER NOT NULL,
+	ingress INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, interval_start )
+);
+CREATE TABLE disk_spaces (
+	node_id BLOB NOT NULL,
+	allocated INTEGER NOT NULL,
+	used_pieces INTEGER NOT NULL,
+	used_trash INTEGER NOT NULL,
+	free INTEGER NOT NULL,
+	available INTEGER NOT NULL,
+	overused INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE held_amounts (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	period TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, period )
+);
+CREATE TABLE members (
 	id BLOB NOT NULL,
 	email TEXT NOT NULL,
 	name TEXT NOT NULL,
@@ -367,6 +450,15 @@ CREATE TABLE nodes (
 	public_address TEXT NOT NULL,
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
+);
+CREATE TABLE reputations (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	online_score REAL NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id )
 );`
 }
 
@@ -551,7 +643,8 @@ type Node struct {
 func (Node) _Table() string { return "nodes" }
 
 type Node_Update_Fields struct {
-	Name Node_Name_Field
+	Name      Node_Name_Field
+	ApiSecret Node_ApiSecret_Field
 }
 
 type Node_Id_Field struct {
@@ -1249,6 +1342,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -1290,6 +1388,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -1428,6 +1531,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1443,6 +1556,46 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM held_amounts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM disk_spaces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bandwidth_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1658,6 +1811,11 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -1709,6 +1867,11 @@ func (obj *sqlite3Impl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -1900,6 +2063,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1915,6 +2088,46 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM held_amounts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM disk_spaces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bandwidth_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
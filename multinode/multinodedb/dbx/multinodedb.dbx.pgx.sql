@@ -1,5 +1,42 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	satellite_id bytea,
+	kind text NOT NULL,
+	message text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	resolved_at timestamp with time zone,
+	acknowledged_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bandwidth_rollups (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	egress bigint NOT NULL,
+	ingress bigint NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, interval_start )
+);
+CREATE TABLE disk_spaces (
+	node_id bytea NOT NULL,
+	allocated bigint NOT NULL,
+	used_pieces bigint NOT NULL,
+	used_trash bigint NOT NULL,
+	free bigint NOT NULL,
+	available bigint NOT NULL,
+	overused bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE held_amounts (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	period text NOT NULL,
+	amount bigint NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, period )
+);
 CREATE TABLE members (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -15,3 +52,12 @@ CREATE TABLE nodes (
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE reputations (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	online_score double precision NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id )
+);
@@ -1,5 +1,42 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	id BLOB NOT NULL,
+	node_id BLOB NOT NULL,
+	satellite_id BLOB,
+	kind TEXT NOT NULL,
+	message TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	resolved_at TIMESTAMP,
+	acknowledged_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bandwidth_rollups (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	egress INTEGER NOT NULL,
+	ingress INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, interval_start )
+);
+CREATE TABLE disk_spaces (
+	node_id BLOB NOT NULL,
+	allocated INTEGER NOT NULL,
+	used_pieces INTEGER NOT NULL,
+	used_trash INTEGER NOT NULL,
+	free INTEGER NOT NULL,
+	available INTEGER NOT NULL,
+	overused INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE held_amounts (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	period TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, period )
+);
 CREATE TABLE members (
 	id BLOB NOT NULL,
 	email TEXT NOT NULL,
@@ -15,3 +52,12 @@ CREATE TABLE nodes (
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE reputations (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	online_score REAL NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id )
+);
@@ -105,6 +105,17 @@ func (n *nodesdb) UpdateName(ctx context.Context, id storj.NodeID, name string)
 	return ErrNodesDB.Wrap(err)
 }
 
+// UpdateAPISecret will update api secret of the specified node in database.
+func (n *nodesdb) UpdateAPISecret(ctx context.Context, id storj.NodeID, apiSecret []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = n.methods.UpdateNoReturn_Node_By_Id(ctx, dbx.Node_Id(id.Bytes()), dbx.Node_Update_Fields{
+		ApiSecret: dbx.Node_ApiSecret(apiSecret),
+	})
+
+	return ErrNodesDB.Wrap(err)
+}
+
 // fromDBXNode converts dbx.Node to console.Node.
 func fromDBXNode(ctx context.Context, node *dbx.Node) (_ nodes.Node, err error) {
 	defer mon.Task()(&ctx)(&err)
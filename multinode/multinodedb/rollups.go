@@ -0,0 +1,247 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/multinodedb/dbx"
+	"storj.io/storj/multinode/rollups"
+)
+
+// ErrRollupsDB indicates about internal RollupsDB error.
+var ErrRollupsDB = errs.Class("RollupsDB error")
+
+// ensures that rollupsdb implements rollups.DB.
+var _ rollups.DB = (*rollupsdb)(nil)
+
+// rollupsdb caches the rollups fetched from the nodes.
+//
+// architecture: Database
+type rollupsdb struct {
+	db *dbx.DB
+}
+
+// Store replaces the cached rollups of the node.
+func (r *rollupsdb) Store(ctx context.Context, nodeID storj.NodeID, nodeRollups rollups.NodeRollups) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := r.db.Open(ctx)
+	if err != nil {
+		return ErrRollupsDB.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	exec := func(query string, args ...interface{}) error {
+		_, err := tx.Tx.ExecContext(ctx, tx.Rebind(query), args...)
+		return ErrRollupsDB.Wrap(err)
+	}
+
+	for _, table := range []string{"bandwidth_rollups", "disk_spaces", "reputations", "held_amounts"} {
+		if err := exec(`DELETE FROM `+table+` WHERE node_id = ?`, nodeID.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	for _, rollup := range nodeRollups.Bandwidth {
+		err := exec(`
+			INSERT INTO bandwidth_rollups (node_id, satellite_id, interval_start, egress, ingress)
+			VALUES (?, ?, ?, ?, ?)
+		`, nodeID.Bytes(), rollup.SatelliteID.Bytes(), rollup.IntervalStart.UTC(), rollup.Egress, rollup.Ingress)
+		if err != nil {
+			return err
+		}
+	}
+
+	diskSpace := nodeRollups.DiskSpace
+	err = exec(`
+		INSERT INTO disk_spaces (node_id, allocated, used_pieces, used_trash, free, available, overused, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, nodeID.Bytes(), diskSpace.Allocated, diskSpace.UsedPieces, diskSpace.UsedTrash,
+		diskSpace.Free, diskSpace.Available, diskSpace.Overused, diskSpace.UpdatedAt.UTC())
+	if err != nil {
+		return err
+	}
+
+	for _, rep := range nodeRollups.Reputations {
+		err := exec(`
+			INSERT INTO reputations (node_id, satellite_id, online_score, audit_score, suspension_score, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, nodeID.Bytes(), rep.SatelliteID.Bytes(), rep.OnlineScore, rep.AuditScore, rep.SuspensionScore, rep.UpdatedAt.UTC())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, held := range nodeRollups.HeldAmounts {
+		err := exec(`
+			INSERT INTO held_amounts (node_id, satellite_id, period, amount)
+			VALUES (?, ?, ?, ?)
+		`, nodeID.Bytes(), held.SatelliteID.Bytes(), held.Period, held.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DailyBandwidth returns the daily bandwidth usage per satellite, summed across nodes, since the given time.
+func (r *rollupsdb) DailyBandwidth(ctx context.Context, since time.Time) (_ []rollups.SatelliteBandwidth, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`
+		SELECT satellite_id, interval_start, SUM(egress), SUM(ingress)
+		FROM bandwidth_rollups
+		WHERE interval_start >= ?
+			AND node_id IN (SELECT id FROM nodes)
+		GROUP BY satellite_id, interval_start
+		ORDER BY interval_start, satellite_id
+	`), since.UTC())
+	if err != nil {
+		return nil, ErrRollupsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var bandwidth []rollups.SatelliteBandwidth
+	for rows.Next() {
+		var satelliteID []byte
+		var rollup rollups.SatelliteBandwidth
+		if err := rows.Scan(&satelliteID, &rollup.IntervalStart, &rollup.Egress, &rollup.Ingress); err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+
+		rollup.SatelliteID, err = storj.NodeIDFromBytes(satelliteID)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+		rollup.IntervalStart = rollup.IntervalStart.UTC()
+
+		bandwidth = append(bandwidth, rollup)
+	}
+
+	return bandwidth, ErrRollupsDB.Wrap(rows.Err())
+}
+
+// DiskSpaces returns the cached disk space of every node.
+func (r *rollupsdb) DiskSpaces(ctx context.Context) (_ []rollups.DiskSpace, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT node_id, allocated, used_pieces, used_trash, free, available, overused, updated_at
+		FROM disk_spaces
+		WHERE node_id IN (SELECT id FROM nodes)
+		ORDER BY node_id
+	`)
+	if err != nil {
+		return nil, ErrRollupsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var diskSpaces []rollups.DiskSpace
+	for rows.Next() {
+		var nodeID []byte
+		var diskSpace rollups.DiskSpace
+		err := rows.Scan(&nodeID, &diskSpace.Allocated, &diskSpace.UsedPieces, &diskSpace.UsedTrash,
+			&diskSpace.Free, &diskSpace.Available, &diskSpace.Overused, &diskSpace.UpdatedAt)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+
+		diskSpace.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+		diskSpace.UpdatedAt = diskSpace.UpdatedAt.UTC()
+
+		diskSpaces = append(diskSpaces, diskSpace)
+	}
+
+	return diskSpaces, ErrRollupsDB.Wrap(rows.Err())
+}
+
+// Reputations returns the cached reputation of every node on every satellite.
+func (r *rollupsdb) Reputations(ctx context.Context) (_ []rollups.Reputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT node_id, satellite_id, online_score, audit_score, suspension_score, updated_at
+		FROM reputations
+		WHERE node_id IN (SELECT id FROM nodes)
+		ORDER BY node_id, satellite_id
+	`)
+	if err != nil {
+		return nil, ErrRollupsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var reputations []rollups.Reputation
+	for rows.Next() {
+		var nodeID, satelliteID []byte
+		var rep rollups.Reputation
+		err := rows.Scan(&nodeID, &satelliteID, &rep.OnlineScore, &rep.AuditScore, &rep.SuspensionScore, &rep.UpdatedAt)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+
+		rep.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+		rep.SatelliteID, err = storj.NodeIDFromBytes(satelliteID)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+		rep.UpdatedAt = rep.UpdatedAt.UTC()
+
+		reputations = append(reputations, rep)
+	}
+
+	return reputations, ErrRollupsDB.Wrap(rows.Err())
+}
+
+// HeldAmounts returns the held amount history per satellite, summed across nodes.
+func (r *rollupsdb) HeldAmounts(ctx context.Context) (_ []rollups.HeldAmount, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT satellite_id, period, SUM(amount)
+		FROM held_amounts
+		WHERE node_id IN (SELECT id FROM nodes)
+		GROUP BY satellite_id, period
+		ORDER BY period, satellite_id
+	`)
+	if err != nil {
+		return nil, ErrRollupsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var heldAmounts []rollups.HeldAmount
+	for rows.Next() {
+		var satelliteID []byte
+		var held rollups.HeldAmount
+		if err := rows.Scan(&satelliteID, &held.Period, &held.Amount); err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+
+		held.SatelliteID, err = storj.NodeIDFromBytes(satelliteID)
+		if err != nil {
+			return nil, ErrRollupsDB.Wrap(err)
+		}
+
+		heldAmounts = append(heldAmounts, held)
+	}
+
+	return heldAmounts, ErrRollupsDB.Wrap(rows.Err())
+}
@@ -26,6 +26,8 @@ type DB interface {
 	Remove(ctx context.Context, id storj.NodeID) error
 	// UpdateName will update name of the specified node in database.
 	UpdateName(ctx context.Context, id storj.NodeID, name string) error
+	// UpdateAPISecret will update api secret of the specified node in database.
+	UpdateAPISecret(ctx context.Context, id storj.NodeID, apiSecret []byte) error
 }
 
 // ErrNoNode is a special error type that indicates about absence of node in NodesDB.
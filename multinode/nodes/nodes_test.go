@@ -47,6 +47,15 @@ func TestNodesDB(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, node.Name, newName)
 
+		newAPISecret := []byte("new secret")
+		err = nodesRepository.UpdateAPISecret(ctx, nodeID, newAPISecret)
+		assert.NoError(t, err)
+
+		node, err = nodesRepository.Get(ctx, nodeID)
+		assert.NoError(t, err)
+		assert.Equal(t, node.APISecret, newAPISecret)
+		assert.Equal(t, node.Name, newName)
+
 		err = nodesRepository.Remove(ctx, nodeID)
 		assert.NoError(t, err)
 
@@ -0,0 +1,192 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodes
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/private/multinodepb"
+)
+
+// OperationResult is the outcome of a bulk operation on a single node.
+type OperationResult struct {
+	NodeID storj.NodeID `json:"nodeId"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// GracefulExitProgress contains the progress of a graceful exit from a satellite.
+type GracefulExitProgress struct {
+	SatelliteID       storj.NodeID `json:"satelliteId"`
+	InitiatedAt       *time.Time   `json:"initiatedAt"`
+	FinishedAt        *time.Time   `json:"finishedAt"`
+	StartingDiskUsage int64        `json:"startingDiskUsage"`
+	BytesDeleted      int64        `json:"bytesDeleted"`
+	PercentComplete   float32      `json:"percentComplete"`
+	Successful        bool         `json:"successful"`
+}
+
+// NodeGracefulExitProgress contains the graceful exit progress of a single node.
+type NodeGracefulExitProgress struct {
+	NodeID   storj.NodeID           `json:"nodeId"`
+	Progress []GracefulExitProgress `json:"progress"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// InitiateGracefulExit starts graceful exit from the satellite on every listed node.
+func (service *Service) InitiateGracefulExit(ctx context.Context, nodeIDs []storj.NodeID, satelliteID storj.NodeID) (_ []OperationResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.forEach(ctx, nodeIDs, func(ctx context.Context, node Node, conn *rpc.Conn) error {
+		client := multinodepb.NewDRPCGracefulExitClient(conn)
+
+		_, err := client.InitiateExit(ctx, &multinodepb.InitiateGracefulExitRequest{
+			Header:      &multinodepb.RequestHeader{ApiKey: node.APISecret},
+			SatelliteId: satelliteID,
+		})
+		return err
+	}), nil
+}
+
+// GracefulExitProgress returns the progress of graceful exits of every listed node.
+func (service *Service) GracefulExitProgress(ctx context.Context, nodeIDs []storj.NodeID) (_ []NodeGracefulExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress := make(map[storj.NodeID][]GracefulExitProgress, len(nodeIDs))
+	results := service.forEach(ctx, nodeIDs, func(ctx context.Context, node Node, conn *rpc.Conn) error {
+		client := multinodepb.NewDRPCGracefulExitClient(conn)
+
+		resp, err := client.ExitProgress(ctx, &multinodepb.GracefulExitProgressRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: node.APISecret},
+		})
+		if err != nil {
+			return err
+		}
+
+		exits := []GracefulExitProgress{}
+		for _, exit := range resp.GetProgress() {
+			exits = append(exits, GracefulExitProgress{
+				SatelliteID:       exit.SatelliteId,
+				InitiatedAt:       exit.GetInitiatedAt(),
+				FinishedAt:        exit.GetFinishedAt(),
+				StartingDiskUsage: exit.GetStartingDiskUsage(),
+				BytesDeleted:      exit.GetBytesDeleted(),
+				PercentComplete:   exit.GetPercentComplete(),
+				Successful:        exit.GetSuccessful(),
+			})
+		}
+
+		progress[node.ID] = exits
+		return nil
+	})
+
+	list := make([]NodeGracefulExitProgress, 0, len(results))
+	for _, result := range results {
+		list = append(list, NodeGracefulExitProgress{
+			NodeID:   result.NodeID,
+			Progress: progress[result.NodeID],
+			Error:    result.Error,
+		})
+	}
+
+	return list, nil
+}
+
+// SetAllocatedDiskSpace changes the allocated disk space of every listed node.
+// The change lasts until the node is restarted.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, nodeIDs []storj.NodeID, allocated int64) (_ []OperationResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if allocated <= 0 {
+		return nil, Error.New("allocated disk space must be positive")
+	}
+
+	return service.forEach(ctx, nodeIDs, func(ctx context.Context, node Node, conn *rpc.Conn) error {
+		client := multinodepb.NewDRPCStorageClient(conn)
+
+		_, err := client.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+			Header:    &multinodepb.RequestHeader{ApiKey: node.APISecret},
+			Allocated: allocated,
+		})
+		return err
+	}), nil
+}
+
+// RotateAPIKeys issues a new api secret on every listed node, stores it and
+// then revokes the previous secret on the node.
+//
+// The previous secret is only revoked after the new one has been stored, so
+// that a failure in between never leaves the node without a usable secret.
+func (service *Service) RotateAPIKeys(ctx context.Context, nodeIDs []storj.NodeID) (_ []OperationResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.forEach(ctx, nodeIDs, func(ctx context.Context, node Node, conn *rpc.Conn) error {
+		client := multinodepb.NewDRPCNodeClient(conn)
+
+		resp, err := client.IssueAPIKey(ctx, &multinodepb.IssueAPIKeyRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: node.APISecret},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := service.nodes.UpdateAPISecret(ctx, node.ID, resp.ApiKey); err != nil {
+			return err
+		}
+
+		_, err = client.RevokeAPIKey(ctx, &multinodepb.RevokeAPIKeyRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: resp.ApiKey},
+			ApiKey: node.APISecret,
+		})
+		if err != nil {
+			service.log.Error("previous api secret was not revoked",
+				zap.Stringer("Node ID", node.ID),
+				zap.Error(err))
+			return err
+		}
+
+		return nil
+	}), nil
+}
+
+// forEach dials every listed node and runs the operation on it, collecting
+// the per node outcome. A failing node does not stop the remaining ones.
+func (service *Service) forEach(ctx context.Context, nodeIDs []storj.NodeID, operation func(context.Context, Node, *rpc.Conn) error) []OperationResult {
+	results := make([]OperationResult, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		err := func() (err error) {
+			node, err := service.nodes.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+				ID:      node.ID,
+				Address: node.PublicAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			defer func() {
+				err = errs.Combine(err, conn.Close())
+			}()
+
+			return operation(ctx, node, conn)
+		}()
+
+		result := OperationResult{NodeID: id}
+		if err != nil {
+			result.Error = Error.Wrap(err).Error()
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -8,6 +8,7 @@ import (
 	"net"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -15,11 +16,14 @@ import (
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
 	"storj.io/private/debug"
+	"storj.io/storj/multinode/alerts"
 	"storj.io/storj/multinode/console"
 	"storj.io/storj/multinode/console/server"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/payouts"
+	"storj.io/storj/multinode/rollups"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/version/checker"
 )
 
 var (
@@ -34,6 +38,10 @@ type DB interface {
 	Nodes() nodes.DB
 	// Members returns members database.
 	Members() console.Members
+	// Rollups returns rollups cache database.
+	Rollups() rollups.DB
+	// Alerts returns alerts database.
+	Alerts() alerts.DB
 
 	// Close closes the database.
 	Close() error
@@ -47,6 +55,8 @@ type Config struct {
 	Debug    debug.Config
 
 	Console server.Config
+	Rollups rollups.Config
+	Alerts  alerts.Config
 }
 
 // Peer is the a Multinode Dashboard application itself.
@@ -70,13 +80,25 @@ type Peer struct {
 		Service *payouts.Service
 	}
 
+	// contains logic of the cached nodes rollups.
+	Rollups struct {
+		Chore   *rollups.Chore
+		Service *rollups.Service
+	}
+
+	// contains logic of the node alerts.
+	Alerts struct {
+		Service *alerts.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
 		Endpoint *server.Server
 	}
 
-	Servers *lifecycle.Group
+	Servers  *lifecycle.Group
+	Services *lifecycle.Group
 }
 
 // New creates a new instance of Multinode Dashboard application.
@@ -86,6 +108,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		Identity: full,
 		DB:       db,
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
+		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
 	tlsConfig := tlsopts.Config{
@@ -116,6 +139,60 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // rollups setup
+		peer.Rollups.Chore = rollups.NewChore(
+			peer.Log.Named("rollups:chore"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.Rollups(),
+			config.Rollups,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "rollups:chore",
+			Run:   peer.Rollups.Chore.Run,
+			Close: peer.Rollups.Chore.Close,
+		})
+
+		peer.Rollups.Service = rollups.NewService(
+			peer.Log.Named("rollups:service"),
+			peer.DB.Rollups(),
+		)
+	}
+
+	{ // alerts setup
+		var notifiers []alerts.Notifier
+		if config.Alerts.Email.SMTPServerAddress != "" {
+			email, err := alerts.NewEmailNotifier(config.Alerts.Email)
+			if err != nil {
+				return nil, err
+			}
+			notifiers = append(notifiers, email)
+		}
+		if config.Alerts.Webhook.URL != "" {
+			notifiers = append(notifiers, alerts.NewWebhookNotifier(config.Alerts.Webhook))
+		}
+
+		var versions *checker.Client
+		if config.Alerts.VersionControl.ServerAddress != "" {
+			versions = checker.New(config.Alerts.VersionControl)
+		}
+
+		peer.Alerts.Service = alerts.NewService(
+			peer.Log.Named("alerts:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.Alerts(),
+			versions,
+			notifiers,
+			config.Alerts,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "alerts:service",
+			Run:   peer.Alerts.Service.Run,
+			Close: peer.Alerts.Service.Close,
+		})
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -127,6 +204,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 			config.Console,
 			peer.Nodes.Service,
 			peer.Payouts.Service,
+			peer.Rollups.Service,
+			peer.Alerts.Service,
 			peer.Console.Listener,
 		)
 		if err != nil {
@@ -150,11 +229,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group, ctx := errgroup.WithContext(ctx)
 
 	peer.Servers.Run(ctx, group)
+	peer.Services.Run(ctx, group)
 
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() error {
-	return peer.Servers.Close()
+	return errs.Combine(
+		peer.Servers.Close(),
+		peer.Services.Close(),
+	)
 }
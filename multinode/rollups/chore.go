@@ -0,0 +1,206 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollups
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+// Config contains configurable values for the rollups chore.
+type Config struct {
+	Interval      time.Duration `help:"how often to refresh the cached rollups of the nodes" default:"1h0m0s"`
+	BandwidthDays int           `help:"number of days of bandwidth usage to cache" default:"30"`
+}
+
+// Chore periodically fetches the rollups of every node and stores them in
+// the cache. A node that cannot be reached keeps its previously cached
+// rollups.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  nodes.DB
+	db     DB
+	config Config
+	nowFn  func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates new instance of Chore.
+func NewChore(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, db DB, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+		db:     db,
+		config: config,
+		nowFn:  time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.Refresh(ctx); err != nil {
+			chore.log.Error("failed to refresh rollups", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Refresh fetches the rollups of every node and stores them in the cache.
+func (chore *Chore) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := chore.nodes.List(ctx)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	for _, node := range list {
+		rollups, err := chore.fetch(ctx, node)
+		if err != nil {
+			chore.log.Warn("failed to fetch rollups of node", zap.Stringer("Node ID", node.ID), zap.Error(err))
+			continue
+		}
+
+		if err := chore.db.Store(ctx, node.ID, rollups); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// fetch queries the rollups of a single node via rpc.
+func (chore *Chore) fetch(ctx context.Context, node nodes.Node) (_ NodeRollups, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := chore.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return NodeRollups{}, Error.Wrap(err)
+	}
+
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+	bandwidthClient := multinodepb.NewDRPCBandwidthClient(conn)
+	payoutClient := multinodepb.NewDRPCPayoutClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret,
+	}
+
+	now := chore.nowFn().UTC()
+
+	var rollups NodeRollups
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		return NodeRollups{}, Error.Wrap(err)
+	}
+
+	rollups.DiskSpace = DiskSpace{
+		NodeID:     node.ID,
+		Allocated:  diskSpace.Allocated,
+		UsedPieces: diskSpace.UsedPieces,
+		UsedTrash:  diskSpace.UsedTrash,
+		Free:       diskSpace.Free,
+		Available:  diskSpace.Available,
+		Overused:   diskSpace.Overused,
+		UpdatedAt:  now,
+	}
+
+	to := now
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -chore.config.BandwidthDays+1)
+
+	bandwidth, err := bandwidthClient.DailySatellite(ctx, &multinodepb.BandwidthDailySatelliteRequest{
+		Header: header,
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		return NodeRollups{}, Error.Wrap(err)
+	}
+
+	for _, rollup := range bandwidth.Rollups {
+		rollups.Bandwidth = append(rollups.Bandwidth, BandwidthRollup{
+			SatelliteID:   rollup.SatelliteId,
+			IntervalStart: rollup.IntervalStart,
+			Egress:        rollup.Egress,
+			Ingress:       rollup.Ingress,
+		})
+	}
+
+	satellites, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		return NodeRollups{}, Error.Wrap(err)
+	}
+
+	for _, satellite := range satellites.TrustedSatellites {
+		rep, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			return NodeRollups{}, Error.Wrap(err)
+		}
+
+		rollups.Reputations = append(rollups.Reputations, Reputation{
+			NodeID:          node.ID,
+			SatelliteID:     satellite.NodeId,
+			OnlineScore:     rep.GetOnline().GetScore(),
+			AuditScore:      rep.GetAudit().GetScore(),
+			SuspensionScore: rep.GetAudit().GetSuspensionScore(),
+			UpdatedAt:       now,
+		})
+	}
+
+	held, err := payoutClient.HeldAmountHistory(ctx, &multinodepb.HeldAmountHistoryRequest{Header: header})
+	if err != nil {
+		return NodeRollups{}, Error.Wrap(err)
+	}
+
+	for _, history := range held.History {
+		for _, period := range history.HeldAmounts {
+			rollups.HeldAmounts = append(rollups.HeldAmounts, HeldAmount{
+				SatelliteID: history.SatelliteId,
+				Period:      period.Period,
+				Amount:      period.Amount,
+			})
+		}
+	}
+
+	return rollups, nil
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
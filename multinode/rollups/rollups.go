@@ -0,0 +1,92 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollups
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// DB caches the rollups fetched from the nodes, so that the dashboard can be
+// served without contacting every node.
+//
+// architecture: Database
+type DB interface {
+	// Store replaces the cached rollups of the node.
+	Store(ctx context.Context, nodeID storj.NodeID, rollups NodeRollups) error
+	// DailyBandwidth returns the daily bandwidth usage per satellite, summed across nodes, since the given time.
+	DailyBandwidth(ctx context.Context, since time.Time) ([]SatelliteBandwidth, error)
+	// DiskSpaces returns the cached disk space of every node.
+	DiskSpaces(ctx context.Context) ([]DiskSpace, error)
+	// Reputations returns the cached reputation of every node on every satellite.
+	Reputations(ctx context.Context) ([]Reputation, error)
+	// HeldAmounts returns the held amount history per satellite, summed across nodes.
+	HeldAmounts(ctx context.Context) ([]HeldAmount, error)
+}
+
+// NodeRollups contains everything fetched from a single node.
+type NodeRollups struct {
+	Bandwidth   []BandwidthRollup
+	DiskSpace   DiskSpace
+	Reputations []Reputation
+	HeldAmounts []HeldAmount
+}
+
+// BandwidthRollup contains the bandwidth used by a node on a satellite during a day.
+type BandwidthRollup struct {
+	SatelliteID   storj.NodeID
+	IntervalStart time.Time
+	Egress        int64
+	Ingress       int64
+}
+
+// SatelliteBandwidth contains the bandwidth used by all nodes on a satellite during a day.
+type SatelliteBandwidth struct {
+	SatelliteID   storj.NodeID `json:"satelliteID"`
+	IntervalStart time.Time    `json:"intervalStart"`
+	Egress        int64        `json:"egress"`
+	Ingress       int64        `json:"ingress"`
+}
+
+// DiskSpace contains the disk space usage of a node.
+type DiskSpace struct {
+	NodeID     storj.NodeID `json:"nodeID"`
+	Allocated  int64        `json:"allocated"`
+	UsedPieces int64        `json:"usedPieces"`
+	UsedTrash  int64        `json:"usedTrash"`
+	Free       int64        `json:"free"`
+	Available  int64        `json:"available"`
+	Overused   int64        `json:"overused"`
+	UpdatedAt  time.Time    `json:"updatedAt"`
+}
+
+// DiskSpaceSummary contains the disk space usage of all nodes.
+type DiskSpaceSummary struct {
+	Allocated  int64       `json:"allocated"`
+	UsedPieces int64       `json:"usedPieces"`
+	UsedTrash  int64       `json:"usedTrash"`
+	Free       int64       `json:"free"`
+	Available  int64       `json:"available"`
+	Overused   int64       `json:"overused"`
+	Nodes      []DiskSpace `json:"nodes"`
+}
+
+// Reputation contains the scores of a node on a satellite.
+type Reputation struct {
+	NodeID          storj.NodeID `json:"nodeID"`
+	SatelliteID     storj.NodeID `json:"satelliteID"`
+	OnlineScore     float64      `json:"onlineScore"`
+	AuditScore      float64      `json:"auditScore"`
+	SuspensionScore float64      `json:"suspensionScore"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+}
+
+// HeldAmount contains the amount held by a satellite for a period.
+type HeldAmount struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	Period      string       `json:"period"`
+	Amount      int64        `json:"amount"`
+}
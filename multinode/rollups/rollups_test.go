@@ -0,0 +1,122 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollups_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/rollups"
+)
+
+func TestRollupsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		cache := db.Rollups()
+
+		nodeID1, nodeID2 := testrand.NodeID(), testrand.NodeID()
+		satelliteID := testrand.NodeID()
+
+		require.NoError(t, db.Nodes().Add(ctx, nodeID1, testrand.Bytes(32), "127.0.0.1:55550"))
+		require.NoError(t, db.Nodes().Add(ctx, nodeID2, testrand.Bytes(32), "127.0.0.1:55551"))
+
+		day := time.Date(2021, time.May, 10, 0, 0, 0, 0, time.UTC)
+		now := day.Add(12 * time.Hour)
+
+		nodeRollups := func(nodeID storj.NodeID, usedPieces int64) rollups.NodeRollups {
+			return rollups.NodeRollups{
+				Bandwidth: []rollups.BandwidthRollup{
+					{SatelliteID: satelliteID, IntervalStart: day.AddDate(0, 0, -1), Egress: 10, Ingress: 20},
+					{SatelliteID: satelliteID, IntervalStart: day, Egress: 1, Ingress: 2},
+				},
+				DiskSpace: rollups.DiskSpace{
+					NodeID:     nodeID,
+					Allocated:  1000,
+					UsedPieces: usedPieces,
+					Available:  1000 - usedPieces,
+					UpdatedAt:  now,
+				},
+				Reputations: []rollups.Reputation{
+					{NodeID: nodeID, SatelliteID: satelliteID, OnlineScore: 1, AuditScore: 0.9, SuspensionScore: 0.8, UpdatedAt: now},
+				},
+				HeldAmounts: []rollups.HeldAmount{
+					{SatelliteID: satelliteID, Period: "2021-04", Amount: 5},
+				},
+			}
+		}
+
+		require.NoError(t, cache.Store(ctx, nodeID1, nodeRollups(nodeID1, 100)))
+		require.NoError(t, cache.Store(ctx, nodeID2, nodeRollups(nodeID2, 200)))
+
+		bandwidth, err := cache.DailyBandwidth(ctx, day)
+		require.NoError(t, err)
+		require.Equal(t, []rollups.SatelliteBandwidth{
+			{SatelliteID: satelliteID, IntervalStart: day, Egress: 2, Ingress: 4},
+		}, bandwidth)
+
+		bandwidth, err = cache.DailyBandwidth(ctx, day.AddDate(0, 0, -1))
+		require.NoError(t, err)
+		require.Len(t, bandwidth, 2)
+		require.EqualValues(t, 20, bandwidth[0].Egress)
+		require.EqualValues(t, 40, bandwidth[0].Ingress)
+
+		diskSpaces, err := cache.DiskSpaces(ctx)
+		require.NoError(t, err)
+		require.Len(t, diskSpaces, 2)
+		for _, diskSpace := range diskSpaces {
+			require.EqualValues(t, 1000, diskSpace.Allocated)
+			require.Equal(t, now, diskSpace.UpdatedAt)
+		}
+
+		reputations, err := cache.Reputations(ctx)
+		require.NoError(t, err)
+		require.Len(t, reputations, 2)
+		for _, rep := range reputations {
+			require.Equal(t, satelliteID, rep.SatelliteID)
+			require.Equal(t, 0.9, rep.AuditScore)
+		}
+
+		held, err := cache.HeldAmounts(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []rollups.HeldAmount{
+			{SatelliteID: satelliteID, Period: "2021-04", Amount: 10},
+		}, held)
+
+		// storing again replaces the previous rollups of the node.
+		replaced := nodeRollups(nodeID1, 300)
+		replaced.Bandwidth = nil
+		replaced.HeldAmounts = nil
+		require.NoError(t, cache.Store(ctx, nodeID1, replaced))
+
+		bandwidth, err = cache.DailyBandwidth(ctx, day)
+		require.NoError(t, err)
+		require.Len(t, bandwidth, 1)
+		require.EqualValues(t, 1, bandwidth[0].Egress)
+
+		held, err = cache.HeldAmounts(ctx)
+		require.NoError(t, err)
+		require.Len(t, held, 1)
+		require.EqualValues(t, 5, held[0].Amount)
+
+		// the rollups of removed nodes are not returned.
+		require.NoError(t, db.Nodes().Remove(ctx, nodeID2))
+
+		diskSpaces, err = cache.DiskSpaces(ctx)
+		require.NoError(t, err)
+		require.Len(t, diskSpaces, 1)
+		require.Equal(t, nodeID1, diskSpaces[0].NodeID)
+		require.EqualValues(t, 300, diskSpaces[0].UsedPieces)
+
+		reputations, err = cache.Reputations(ctx)
+		require.NoError(t, err)
+		require.Len(t, reputations, 1)
+		require.Equal(t, nodeID1, reputations[0].NodeID)
+	})
+}
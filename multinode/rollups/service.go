@@ -0,0 +1,110 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollups
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is an error class for rollups service error.
+	Error = errs.Class("rollups service error")
+)
+
+// Service exposes the cached rollups of all nodes.
+//
+// architecture: Service
+type Service struct {
+	log   *zap.Logger
+	db    DB
+	nowFn func() time.Time
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, db DB) *Service {
+	return &Service{
+		log:   log,
+		db:    db,
+		nowFn: time.Now,
+	}
+}
+
+// DailyBandwidth returns the daily bandwidth usage per satellite of all nodes for the last given number of days.
+func (service *Service) DailyBandwidth(ctx context.Context, days int) (_ []SatelliteBandwidth, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn().UTC()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -days+1)
+
+	bandwidth, err := service.db.DailyBandwidth(ctx, since)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if bandwidth == nil {
+		bandwidth = []SatelliteBandwidth{}
+	}
+
+	return bandwidth, nil
+}
+
+// DiskSpace returns the disk space usage of every node and the totals across nodes.
+func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpaceSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	diskSpaces, err := service.db.DiskSpaces(ctx)
+	if err != nil {
+		return DiskSpaceSummary{}, Error.Wrap(err)
+	}
+
+	summary := DiskSpaceSummary{
+		Nodes: []DiskSpace{},
+	}
+	for _, diskSpace := range diskSpaces {
+		summary.Allocated += diskSpace.Allocated
+		summary.UsedPieces += diskSpace.UsedPieces
+		summary.UsedTrash += diskSpace.UsedTrash
+		summary.Free += diskSpace.Free
+		summary.Available += diskSpace.Available
+		summary.Overused += diskSpace.Overused
+		summary.Nodes = append(summary.Nodes, diskSpace)
+	}
+
+	return summary, nil
+}
+
+// Reputations returns the scores of every node on every satellite.
+func (service *Service) Reputations(ctx context.Context) (_ []Reputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reputations, err := service.db.Reputations(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if reputations == nil {
+		reputations = []Reputation{}
+	}
+
+	return reputations, nil
+}
+
+// HeldAmounts returns the held amount history per satellite of all nodes.
+func (service *Service) HeldAmounts(ctx context.Context) (_ []HeldAmount, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	held, err := service.db.HeldAmounts(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if held == nil {
+		held = []HeldAmount{}
+	}
+
+	return held, nil
+}
@@ -177,6 +177,82 @@ func (m *DiskSpaceResponse) GetOverused() int64 {
 	return 0
 }
 
+type SetAllocatedDiskSpaceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Allocated            int64          `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceRequest) Reset()         { *m = SetAllocatedDiskSpaceRequest{} }
+func (m *SetAllocatedDiskSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceRequest) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{3}
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Size(m)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceRequest proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetAllocatedDiskSpaceRequest) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type SetAllocatedDiskSpaceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceResponse) Reset()         { *m = SetAllocatedDiskSpaceResponse{} }
+func (m *SetAllocatedDiskSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceResponse) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{4}
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Size(m)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceResponse proto.InternalMessageInfo
+
 type BandwidthMonthSummaryRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -188,7 +264,7 @@ func (m *BandwidthMonthSummaryRequest) Reset()         { *m = BandwidthMonthSumm
 func (m *BandwidthMonthSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*BandwidthMonthSummaryRequest) ProtoMessage()    {}
 func (*BandwidthMonthSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{3}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{5}
 }
 func (m *BandwidthMonthSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthMonthSummaryRequest.Unmarshal(m, b)
@@ -226,7 +302,7 @@ func (m *BandwidthMonthSummaryResponse) Reset()         { *m = BandwidthMonthSum
 func (m *BandwidthMonthSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*BandwidthMonthSummaryResponse) ProtoMessage()    {}
 func (*BandwidthMonthSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{4}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{6}
 }
 func (m *BandwidthMonthSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BandwidthMonthSummaryResponse.Unmarshal(m, b)
@@ -253,6 +329,155 @@ func (m *BandwidthMonthSummaryResponse) GetUsed() int64 {
 	return 0
 }
 
+type BandwidthDailySatelliteRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	From                 time.Time      `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from"`
+	To                   time.Time      `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BandwidthDailySatelliteRequest) Reset()         { *m = BandwidthDailySatelliteRequest{} }
+func (m *BandwidthDailySatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*BandwidthDailySatelliteRequest) ProtoMessage()    {}
+func (*BandwidthDailySatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{7}
+}
+func (m *BandwidthDailySatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BandwidthDailySatelliteRequest.Unmarshal(m, b)
+}
+func (m *BandwidthDailySatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BandwidthDailySatelliteRequest.Marshal(b, m, deterministic)
+}
+func (m *BandwidthDailySatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthDailySatelliteRequest.Merge(m, src)
+}
+func (m *BandwidthDailySatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_BandwidthDailySatelliteRequest.Size(m)
+}
+func (m *BandwidthDailySatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthDailySatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthDailySatelliteRequest proto.InternalMessageInfo
+
+func (m *BandwidthDailySatelliteRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BandwidthDailySatelliteRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
+	}
+	return time.Time{}
+}
+
+func (m *BandwidthDailySatelliteRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
+	}
+	return time.Time{}
+}
+
+type BandwidthDailySatelliteResponse struct {
+	Rollups              []*BandwidthDailySatelliteResponse_Rollup `protobuf:"bytes,1,rep,name=rollups,proto3" json:"rollups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *BandwidthDailySatelliteResponse) Reset()         { *m = BandwidthDailySatelliteResponse{} }
+func (m *BandwidthDailySatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*BandwidthDailySatelliteResponse) ProtoMessage()    {}
+func (*BandwidthDailySatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{8}
+}
+func (m *BandwidthDailySatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BandwidthDailySatelliteResponse.Unmarshal(m, b)
+}
+func (m *BandwidthDailySatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BandwidthDailySatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *BandwidthDailySatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthDailySatelliteResponse.Merge(m, src)
+}
+func (m *BandwidthDailySatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_BandwidthDailySatelliteResponse.Size(m)
+}
+func (m *BandwidthDailySatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthDailySatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthDailySatelliteResponse proto.InternalMessageInfo
+
+func (m *BandwidthDailySatelliteResponse) GetRollups() []*BandwidthDailySatelliteResponse_Rollup {
+	if m != nil {
+		return m.Rollups
+	}
+	return nil
+}
+
+type BandwidthDailySatelliteResponse_Rollup struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	IntervalStart        time.Time `protobuf:"bytes,2,opt,name=interval_start,json=intervalStart,proto3,stdtime" json:"interval_start"`
+	Egress               int64     `protobuf:"varint,3,opt,name=egress,proto3" json:"egress,omitempty"`
+	Ingress              int64     `protobuf:"varint,4,opt,name=ingress,proto3" json:"ingress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BandwidthDailySatelliteResponse_Rollup) Reset() {
+	*m = BandwidthDailySatelliteResponse_Rollup{}
+}
+func (m *BandwidthDailySatelliteResponse_Rollup) String() string { return proto.CompactTextString(m) }
+func (*BandwidthDailySatelliteResponse_Rollup) ProtoMessage()    {}
+func (*BandwidthDailySatelliteResponse_Rollup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{8, 0}
+}
+func (m *BandwidthDailySatelliteResponse_Rollup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BandwidthDailySatelliteResponse_Rollup.Unmarshal(m, b)
+}
+func (m *BandwidthDailySatelliteResponse_Rollup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BandwidthDailySatelliteResponse_Rollup.Marshal(b, m, deterministic)
+}
+func (m *BandwidthDailySatelliteResponse_Rollup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthDailySatelliteResponse_Rollup.Merge(m, src)
+}
+func (m *BandwidthDailySatelliteResponse_Rollup) XXX_Size() int {
+	return xxx_messageInfo_BandwidthDailySatelliteResponse_Rollup.Size(m)
+}
+func (m *BandwidthDailySatelliteResponse_Rollup) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthDailySatelliteResponse_Rollup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandwidthDailySatelliteResponse_Rollup proto.InternalMessageInfo
+
+func (m *BandwidthDailySatelliteResponse_Rollup) GetIntervalStart() time.Time {
+	if m != nil {
+		return m.IntervalStart
+	}
+	return time.Time{}
+}
+
+func (m *BandwidthDailySatelliteResponse_Rollup) GetEgress() int64 {
+	if m != nil {
+		return m.Egress
+	}
+	return 0
+}
+
+func (m *BandwidthDailySatelliteResponse_Rollup) GetIngress() int64 {
+	if m != nil {
+		return m.Ingress
+	}
+	return 0
+}
+
 type VersionRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -264,7 +489,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{5}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{9}
 }
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
@@ -302,7 +527,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{6}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{10}
 }
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
@@ -340,7 +565,7 @@ func (m *LastContactRequest) Reset()         { *m = LastContactRequest{} }
 func (m *LastContactRequest) String() string { return proto.CompactTextString(m) }
 func (*LastContactRequest) ProtoMessage()    {}
 func (*LastContactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{7}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{11}
 }
 func (m *LastContactRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LastContactRequest.Unmarshal(m, b)
@@ -378,7 +603,7 @@ func (m *LastContactResponse) Reset()         { *m = LastContactResponse{} }
 func (m *LastContactResponse) String() string { return proto.CompactTextString(m) }
 func (*LastContactResponse) ProtoMessage()    {}
 func (*LastContactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{8}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{12}
 }
 func (m *LastContactResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LastContactResponse.Unmarshal(m, b)
@@ -417,7 +642,7 @@ func (m *ReputationRequest) Reset()         { *m = ReputationRequest{} }
 func (m *ReputationRequest) String() string { return proto.CompactTextString(m) }
 func (*ReputationRequest) ProtoMessage()    {}
 func (*ReputationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{9}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{13}
 }
 func (m *ReputationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationRequest.Unmarshal(m, b)
@@ -456,7 +681,7 @@ func (m *ReputationResponse) Reset()         { *m = ReputationResponse{} }
 func (m *ReputationResponse) String() string { return proto.CompactTextString(m) }
 func (*ReputationResponse) ProtoMessage()    {}
 func (*ReputationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{10}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{14}
 }
 func (m *ReputationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationResponse.Unmarshal(m, b)
@@ -501,7 +726,7 @@ func (m *ReputationResponse_Online) Reset()         { *m = ReputationResponse_On
 func (m *ReputationResponse_Online) String() string { return proto.CompactTextString(m) }
 func (*ReputationResponse_Online) ProtoMessage()    {}
 func (*ReputationResponse_Online) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{10, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{14, 0}
 }
 func (m *ReputationResponse_Online) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationResponse_Online.Unmarshal(m, b)
@@ -540,7 +765,7 @@ func (m *ReputationResponse_Audit) Reset()         { *m = ReputationResponse_Aud
 func (m *ReputationResponse_Audit) String() string { return proto.CompactTextString(m) }
 func (*ReputationResponse_Audit) ProtoMessage()    {}
 func (*ReputationResponse_Audit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{10, 1}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{14, 1}
 }
 func (m *ReputationResponse_Audit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReputationResponse_Audit.Unmarshal(m, b)
@@ -585,7 +810,7 @@ func (m *TrustedSatellitesRequest) Reset()         { *m = TrustedSatellitesReque
 func (m *TrustedSatellitesRequest) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesRequest) ProtoMessage()    {}
 func (*TrustedSatellitesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{11}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{15}
 }
 func (m *TrustedSatellitesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesRequest.Unmarshal(m, b)
@@ -623,7 +848,7 @@ func (m *TrustedSatellitesResponse) Reset()         { *m = TrustedSatellitesResp
 func (m *TrustedSatellitesResponse) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesResponse) ProtoMessage()    {}
 func (*TrustedSatellitesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{12}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{16}
 }
 func (m *TrustedSatellitesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesResponse.Unmarshal(m, b)
@@ -662,7 +887,7 @@ func (m *TrustedSatellitesResponse_NodeURL) Reset()         { *m = TrustedSatell
 func (m *TrustedSatellitesResponse_NodeURL) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesResponse_NodeURL) ProtoMessage()    {}
 func (*TrustedSatellitesResponse_NodeURL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{12, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{16, 0}
 }
 func (m *TrustedSatellitesResponse_NodeURL) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesResponse_NodeURL.Unmarshal(m, b)
@@ -689,6 +914,158 @@ func (m *TrustedSatellitesResponse_NodeURL) GetAddress() string {
 	return ""
 }
 
+type IssueAPIKeyRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *IssueAPIKeyRequest) Reset()         { *m = IssueAPIKeyRequest{} }
+func (m *IssueAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*IssueAPIKeyRequest) ProtoMessage()    {}
+func (*IssueAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{17}
+}
+func (m *IssueAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *IssueAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *IssueAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueAPIKeyRequest.Merge(m, src)
+}
+func (m *IssueAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_IssueAPIKeyRequest.Size(m)
+}
+func (m *IssueAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueAPIKeyRequest proto.InternalMessageInfo
+
+func (m *IssueAPIKeyRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type IssueAPIKeyResponse struct {
+	ApiKey               []byte   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssueAPIKeyResponse) Reset()         { *m = IssueAPIKeyResponse{} }
+func (m *IssueAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*IssueAPIKeyResponse) ProtoMessage()    {}
+func (*IssueAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{18}
+}
+func (m *IssueAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *IssueAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *IssueAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueAPIKeyResponse.Merge(m, src)
+}
+func (m *IssueAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_IssueAPIKeyResponse.Size(m)
+}
+func (m *IssueAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueAPIKeyResponse proto.InternalMessageInfo
+
+func (m *IssueAPIKeyResponse) GetApiKey() []byte {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ApiKey               []byte         `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{19}
+}
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(m, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RevokeAPIKeyRequest) GetApiKey() []byte {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type RevokeAPIKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()    {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{20}
+}
+func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyResponse.Merge(m, src)
+}
+func (m *RevokeAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Size(m)
+}
+func (m *RevokeAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyResponse proto.InternalMessageInfo
+
 type EarnedRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -700,7 +1077,7 @@ func (m *EarnedRequest) Reset()         { *m = EarnedRequest{} }
 func (m *EarnedRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedRequest) ProtoMessage()    {}
 func (*EarnedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{13}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{21}
 }
 func (m *EarnedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedRequest.Unmarshal(m, b)
@@ -738,7 +1115,7 @@ func (m *EarnedResponse) Reset()         { *m = EarnedResponse{} }
 func (m *EarnedResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedResponse) ProtoMessage()    {}
 func (*EarnedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{14}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{22}
 }
 func (m *EarnedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedResponse.Unmarshal(m, b)
@@ -776,7 +1153,7 @@ func (m *EarnedPerSatelliteRequest) Reset()         { *m = EarnedPerSatelliteReq
 func (m *EarnedPerSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteRequest) ProtoMessage()    {}
 func (*EarnedPerSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{15}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{23}
 }
 func (m *EarnedPerSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteRequest.Unmarshal(m, b)
@@ -814,7 +1191,7 @@ func (m *EarnedPerSatelliteResponse) Reset()         { *m = EarnedPerSatelliteRe
 func (m *EarnedPerSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteResponse) ProtoMessage()    {}
 func (*EarnedPerSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{16}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{24}
 }
 func (m *EarnedPerSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteResponse.Unmarshal(m, b)
@@ -853,7 +1230,7 @@ func (m *EarnedSatellite) Reset()         { *m = EarnedSatellite{} }
 func (m *EarnedSatellite) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatellite) ProtoMessage()    {}
 func (*EarnedSatellite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{17}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{25}
 }
 func (m *EarnedSatellite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatellite.Unmarshal(m, b)
@@ -880,12 +1257,408 @@ func (m *EarnedSatellite) GetTotal() int64 {
 	return 0
 }
 
+type HeldAmountHistoryRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *HeldAmountHistoryRequest) Reset()         { *m = HeldAmountHistoryRequest{} }
+func (m *HeldAmountHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HeldAmountHistoryRequest) ProtoMessage()    {}
+func (*HeldAmountHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{26}
+}
+func (m *HeldAmountHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeldAmountHistoryRequest.Unmarshal(m, b)
+}
+func (m *HeldAmountHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeldAmountHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *HeldAmountHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldAmountHistoryRequest.Merge(m, src)
+}
+func (m *HeldAmountHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_HeldAmountHistoryRequest.Size(m)
+}
+func (m *HeldAmountHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldAmountHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldAmountHistoryRequest proto.InternalMessageInfo
+
+func (m *HeldAmountHistoryRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type HeldAmountHistoryResponse struct {
+	History              []*HeldAmountHistoryResponse_HeldAmountHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *HeldAmountHistoryResponse) Reset()         { *m = HeldAmountHistoryResponse{} }
+func (m *HeldAmountHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*HeldAmountHistoryResponse) ProtoMessage()    {}
+func (*HeldAmountHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{27}
+}
+func (m *HeldAmountHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeldAmountHistoryResponse.Unmarshal(m, b)
+}
+func (m *HeldAmountHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeldAmountHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *HeldAmountHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldAmountHistoryResponse.Merge(m, src)
+}
+func (m *HeldAmountHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_HeldAmountHistoryResponse.Size(m)
+}
+func (m *HeldAmountHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldAmountHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldAmountHistoryResponse proto.InternalMessageInfo
+
+func (m *HeldAmountHistoryResponse) GetHistory() []*HeldAmountHistoryResponse_HeldAmountHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type HeldAmountHistoryResponse_HeldForPeriod struct {
+	Period               string   `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeldAmountHistoryResponse_HeldForPeriod) Reset() {
+	*m = HeldAmountHistoryResponse_HeldForPeriod{}
+}
+func (m *HeldAmountHistoryResponse_HeldForPeriod) String() string { return proto.CompactTextString(m) }
+func (*HeldAmountHistoryResponse_HeldForPeriod) ProtoMessage()    {}
+func (*HeldAmountHistoryResponse_HeldForPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{27, 0}
+}
+func (m *HeldAmountHistoryResponse_HeldForPeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeldAmountHistoryResponse_HeldForPeriod.Unmarshal(m, b)
+}
+func (m *HeldAmountHistoryResponse_HeldForPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeldAmountHistoryResponse_HeldForPeriod.Marshal(b, m, deterministic)
+}
+func (m *HeldAmountHistoryResponse_HeldForPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldAmountHistoryResponse_HeldForPeriod.Merge(m, src)
+}
+func (m *HeldAmountHistoryResponse_HeldForPeriod) XXX_Size() int {
+	return xxx_messageInfo_HeldAmountHistoryResponse_HeldForPeriod.Size(m)
+}
+func (m *HeldAmountHistoryResponse_HeldForPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldAmountHistoryResponse_HeldForPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldAmountHistoryResponse_HeldForPeriod proto.InternalMessageInfo
+
+func (m *HeldAmountHistoryResponse_HeldForPeriod) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *HeldAmountHistoryResponse_HeldForPeriod) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type HeldAmountHistoryResponse_HeldAmountHistory struct {
+	SatelliteId          NodeID                                     `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	HeldAmounts          []*HeldAmountHistoryResponse_HeldForPeriod `protobuf:"bytes,2,rep,name=held_amounts,json=heldAmounts,proto3" json:"held_amounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) Reset() {
+	*m = HeldAmountHistoryResponse_HeldAmountHistory{}
+}
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) String() string {
+	return proto.CompactTextString(m)
+}
+func (*HeldAmountHistoryResponse_HeldAmountHistory) ProtoMessage() {}
+func (*HeldAmountHistoryResponse_HeldAmountHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{27, 1}
+}
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Unmarshal(m, b)
+}
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Marshal(b, m, deterministic)
+}
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Merge(m, src)
+}
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Size() int {
+	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Size(m)
+}
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory proto.InternalMessageInfo
+
+func (m *HeldAmountHistoryResponse_HeldAmountHistory) GetHeldAmounts() []*HeldAmountHistoryResponse_HeldForPeriod {
+	if m != nil {
+		return m.HeldAmounts
+	}
+	return nil
+}
+
+type InitiateGracefulExitRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{28}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+func (m *InitiateGracefulExitRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type InitiateGracefulExitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateGracefulExitResponse) Reset()         { *m = InitiateGracefulExitResponse{} }
+func (m *InitiateGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitResponse) ProtoMessage()    {}
+func (*InitiateGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{29}
+}
+func (m *InitiateGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitResponse.Merge(m, src)
+}
+func (m *InitiateGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Size(m)
+}
+func (m *InitiateGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitResponse proto.InternalMessageInfo
+
+type GracefulExitProgressRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GracefulExitProgressRequest) Reset()         { *m = GracefulExitProgressRequest{} }
+func (m *GracefulExitProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitProgressRequest) ProtoMessage()    {}
+func (*GracefulExitProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{30}
+}
+func (m *GracefulExitProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitProgressRequest.Unmarshal(m, b)
+}
+func (m *GracefulExitProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitProgressRequest.Merge(m, src)
+}
+func (m *GracefulExitProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitProgressRequest.Size(m)
+}
+func (m *GracefulExitProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitProgressRequest proto.InternalMessageInfo
+
+func (m *GracefulExitProgressRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type GracefulExitProgressResponse struct {
+	Progress             []*GracefulExitProgressResponse_Progress `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *GracefulExitProgressResponse) Reset()         { *m = GracefulExitProgressResponse{} }
+func (m *GracefulExitProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitProgressResponse) ProtoMessage()    {}
+func (*GracefulExitProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{31}
+}
+func (m *GracefulExitProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitProgressResponse.Unmarshal(m, b)
+}
+func (m *GracefulExitProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitProgressResponse.Merge(m, src)
+}
+func (m *GracefulExitProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitProgressResponse.Size(m)
+}
+func (m *GracefulExitProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitProgressResponse proto.InternalMessageInfo
+
+func (m *GracefulExitProgressResponse) GetProgress() []*GracefulExitProgressResponse_Progress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type GracefulExitProgressResponse_Progress struct {
+	SatelliteId          NodeID     `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	InitiatedAt          *time.Time `protobuf:"bytes,2,opt,name=initiated_at,json=initiatedAt,proto3,stdtime" json:"initiated_at,omitempty"`
+	FinishedAt           *time.Time `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3,stdtime" json:"finished_at,omitempty"`
+	StartingDiskUsage    int64      `protobuf:"varint,4,opt,name=starting_disk_usage,json=startingDiskUsage,proto3" json:"starting_disk_usage,omitempty"`
+	BytesDeleted         int64      `protobuf:"varint,5,opt,name=bytes_deleted,json=bytesDeleted,proto3" json:"bytes_deleted,omitempty"`
+	PercentComplete      float32    `protobuf:"fixed32,6,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	Successful           bool       `protobuf:"varint,7,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GracefulExitProgressResponse_Progress) Reset()         { *m = GracefulExitProgressResponse_Progress{} }
+func (m *GracefulExitProgressResponse_Progress) String() string { return proto.CompactTextString(m) }
+func (*GracefulExitProgressResponse_Progress) ProtoMessage()    {}
+func (*GracefulExitProgressResponse_Progress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{31, 0}
+}
+func (m *GracefulExitProgressResponse_Progress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GracefulExitProgressResponse_Progress.Unmarshal(m, b)
+}
+func (m *GracefulExitProgressResponse_Progress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GracefulExitProgressResponse_Progress.Marshal(b, m, deterministic)
+}
+func (m *GracefulExitProgressResponse_Progress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GracefulExitProgressResponse_Progress.Merge(m, src)
+}
+func (m *GracefulExitProgressResponse_Progress) XXX_Size() int {
+	return xxx_messageInfo_GracefulExitProgressResponse_Progress.Size(m)
+}
+func (m *GracefulExitProgressResponse_Progress) XXX_DiscardUnknown() {
+	xxx_messageInfo_GracefulExitProgressResponse_Progress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GracefulExitProgressResponse_Progress proto.InternalMessageInfo
+
+func (m *GracefulExitProgressResponse_Progress) GetInitiatedAt() *time.Time {
+	if m != nil {
+		return m.InitiatedAt
+	}
+	return nil
+}
+
+func (m *GracefulExitProgressResponse_Progress) GetFinishedAt() *time.Time {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+func (m *GracefulExitProgressResponse_Progress) GetStartingDiskUsage() int64 {
+	if m != nil {
+		return m.StartingDiskUsage
+	}
+	return 0
+}
+
+func (m *GracefulExitProgressResponse_Progress) GetBytesDeleted() int64 {
+	if m != nil {
+		return m.BytesDeleted
+	}
+	return 0
+}
+
+func (m *GracefulExitProgressResponse_Progress) GetPercentComplete() float32 {
+	if m != nil {
+		return m.PercentComplete
+	}
+	return 0
+}
+
+func (m *GracefulExitProgressResponse_Progress) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "multinode.RequestHeader")
 	proto.RegisterType((*DiskSpaceRequest)(nil), "multinode.DiskSpaceRequest")
 	proto.RegisterType((*DiskSpaceResponse)(nil), "multinode.DiskSpaceResponse")
+	proto.RegisterType((*SetAllocatedDiskSpaceRequest)(nil), "multinode.SetAllocatedDiskSpaceRequest")
+	proto.RegisterType((*SetAllocatedDiskSpaceResponse)(nil), "multinode.SetAllocatedDiskSpaceResponse")
 	proto.RegisterType((*BandwidthMonthSummaryRequest)(nil), "multinode.BandwidthMonthSummaryRequest")
 	proto.RegisterType((*BandwidthMonthSummaryResponse)(nil), "multinode.BandwidthMonthSummaryResponse")
+	proto.RegisterType((*BandwidthDailySatelliteRequest)(nil), "multinode.BandwidthDailySatelliteRequest")
+	proto.RegisterType((*BandwidthDailySatelliteResponse)(nil), "multinode.BandwidthDailySatelliteResponse")
+	proto.RegisterType((*BandwidthDailySatelliteResponse_Rollup)(nil), "multinode.BandwidthDailySatelliteResponse.Rollup")
 	proto.RegisterType((*VersionRequest)(nil), "multinode.VersionRequest")
 	proto.RegisterType((*VersionResponse)(nil), "multinode.VersionResponse")
 	proto.RegisterType((*LastContactRequest)(nil), "multinode.LastContactRequest")
@@ -897,71 +1670,123 @@ func init() {
 	proto.RegisterType((*TrustedSatellitesRequest)(nil), "multinode.TrustedSatellitesRequest")
 	proto.RegisterType((*TrustedSatellitesResponse)(nil), "multinode.TrustedSatellitesResponse")
 	proto.RegisterType((*TrustedSatellitesResponse_NodeURL)(nil), "multinode.TrustedSatellitesResponse.NodeURL")
+	proto.RegisterType((*IssueAPIKeyRequest)(nil), "multinode.IssueAPIKeyRequest")
+	proto.RegisterType((*IssueAPIKeyResponse)(nil), "multinode.IssueAPIKeyResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "multinode.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "multinode.RevokeAPIKeyResponse")
 	proto.RegisterType((*EarnedRequest)(nil), "multinode.EarnedRequest")
 	proto.RegisterType((*EarnedResponse)(nil), "multinode.EarnedResponse")
 	proto.RegisterType((*EarnedPerSatelliteRequest)(nil), "multinode.EarnedPerSatelliteRequest")
 	proto.RegisterType((*EarnedPerSatelliteResponse)(nil), "multinode.EarnedPerSatelliteResponse")
 	proto.RegisterType((*EarnedSatellite)(nil), "multinode.EarnedSatellite")
+	proto.RegisterType((*HeldAmountHistoryRequest)(nil), "multinode.HeldAmountHistoryRequest")
+	proto.RegisterType((*HeldAmountHistoryResponse)(nil), "multinode.HeldAmountHistoryResponse")
+	proto.RegisterType((*HeldAmountHistoryResponse_HeldForPeriod)(nil), "multinode.HeldAmountHistoryResponse.HeldForPeriod")
+	proto.RegisterType((*HeldAmountHistoryResponse_HeldAmountHistory)(nil), "multinode.HeldAmountHistoryResponse.HeldAmountHistory")
+	proto.RegisterType((*InitiateGracefulExitRequest)(nil), "multinode.InitiateGracefulExitRequest")
+	proto.RegisterType((*InitiateGracefulExitResponse)(nil), "multinode.InitiateGracefulExitResponse")
+	proto.RegisterType((*GracefulExitProgressRequest)(nil), "multinode.GracefulExitProgressRequest")
+	proto.RegisterType((*GracefulExitProgressResponse)(nil), "multinode.GracefulExitProgressResponse")
+	proto.RegisterType((*GracefulExitProgressResponse_Progress)(nil), "multinode.GracefulExitProgressResponse.Progress")
 }
 
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x73, 0xdb, 0x44,
-	0x10, 0x47, 0x49, 0x2c, 0xd7, 0x6b, 0x37, 0x4e, 0x8e, 0xce, 0xa0, 0x88, 0xa4, 0xee, 0xa8, 0x81,
-	0x86, 0x81, 0x91, 0xc1, 0x7d, 0x62, 0x06, 0x66, 0xa8, 0x49, 0x4b, 0x33, 0xb8, 0x10, 0xe4, 0xc0,
-	0x43, 0x99, 0xa9, 0xe7, 0x62, 0x6d, 0x1d, 0x51, 0x59, 0x27, 0x74, 0xa7, 0x40, 0xbe, 0x05, 0x9f,
-	0x83, 0x2f, 0xc1, 0xf0, 0xc2, 0xf0, 0x19, 0x78, 0x28, 0x1f, 0x83, 0x57, 0x46, 0x77, 0x27, 0x59,
-	0x8e, 0x65, 0x87, 0x31, 0x6f, 0xda, 0xdd, 0xdf, 0xfe, 0x76, 0x6f, 0xff, 0x09, 0xda, 0xd3, 0x34,
-	0x14, 0x41, 0xc4, 0x7c, 0x74, 0xe3, 0x84, 0x09, 0x46, 0x1a, 0x85, 0xc2, 0x86, 0x09, 0x9b, 0x30,
-	0xa5, 0xb6, 0x3b, 0x13, 0xc6, 0x26, 0x21, 0x76, 0xa5, 0x74, 0x9e, 0xbe, 0xec, 0x8a, 0x60, 0x8a,
-	0x5c, 0xd0, 0x69, 0xac, 0x00, 0xce, 0x11, 0xdc, 0xf6, 0xf0, 0xc7, 0x14, 0xb9, 0x78, 0x8a, 0xd4,
-	0xc7, 0x84, 0xbc, 0x05, 0x75, 0x1a, 0x07, 0xa3, 0x57, 0x78, 0x65, 0x19, 0xf7, 0x8c, 0xa3, 0x96,
-	0x67, 0xd2, 0x38, 0xf8, 0x12, 0xaf, 0x9c, 0x63, 0xd8, 0x39, 0x0e, 0xf8, 0xab, 0x61, 0x4c, 0xc7,
-	0xa8, 0x5d, 0xc8, 0x87, 0x60, 0x5e, 0x48, 0x37, 0x89, 0x6d, 0xf6, 0x2c, 0x77, 0x96, 0xd7, 0x1c,
-	0xad, 0xa7, 0x71, 0xce, 0x6f, 0x06, 0xec, 0x96, 0x68, 0x78, 0xcc, 0x22, 0x8e, 0x64, 0x1f, 0x1a,
-	0x34, 0x0c, 0xd9, 0x98, 0x0a, 0xf4, 0x25, 0xd5, 0xa6, 0x37, 0x53, 0x90, 0x0e, 0x34, 0x53, 0x8e,
-	0xfe, 0x28, 0x0e, 0x70, 0x8c, 0xdc, 0xda, 0x90, 0x76, 0xc8, 0x54, 0xa7, 0x52, 0x43, 0x0e, 0x40,
-	0x4a, 0x23, 0x91, 0x50, 0x7e, 0x61, 0x6d, 0x2a, 0xff, 0x4c, 0x73, 0x96, 0x29, 0x08, 0x81, 0xad,
-	0x97, 0x09, 0xa2, 0xb5, 0x25, 0x0d, 0xf2, 0x5b, 0x46, 0xbc, 0xa4, 0x41, 0x48, 0xcf, 0x43, 0xb4,
-	0x6a, 0x3a, 0x62, 0xae, 0x20, 0x36, 0xdc, 0x62, 0x97, 0x98, 0x64, 0x14, 0x96, 0x29, 0x8d, 0x85,
-	0xec, 0x9c, 0xc2, 0x7e, 0x9f, 0x46, 0xfe, 0x4f, 0x81, 0x2f, 0x2e, 0x9e, 0xb1, 0x48, 0x5c, 0x0c,
-	0xd3, 0xe9, 0x94, 0x26, 0x57, 0xeb, 0xd7, 0xe4, 0x21, 0x1c, 0x2c, 0x61, 0xd4, 0xe5, 0x21, 0xb0,
-	0x25, 0x53, 0x51, 0x95, 0x91, 0xdf, 0x4e, 0x1f, 0xb6, 0xbf, 0xc3, 0x84, 0x07, 0x2c, 0x5a, 0x3f,
-	0xf0, 0xfb, 0xd0, 0x2e, 0x38, 0x74, 0x28, 0x0b, 0xea, 0x97, 0x4a, 0x25, 0x59, 0x1a, 0x5e, 0x2e,
-	0x3a, 0x4f, 0x80, 0x0c, 0x28, 0x17, 0x9f, 0xb3, 0x48, 0xd0, 0xb1, 0x58, 0x3f, 0xe8, 0x0b, 0x78,
-	0x73, 0x8e, 0x47, 0x07, 0xfe, 0x02, 0x5a, 0x21, 0xe5, 0x62, 0x34, 0x56, 0x7a, 0x4d, 0x67, 0xbb,
-	0x6a, 0x80, 0xdd, 0x7c, 0x80, 0xdd, 0xb3, 0x7c, 0x80, 0xfb, 0xb7, 0xfe, 0x7c, 0xdd, 0x79, 0xe3,
-	0x97, 0xbf, 0x3b, 0x86, 0xd7, 0x0c, 0x67, 0x84, 0xce, 0xcf, 0xb0, 0xeb, 0x61, 0x9c, 0x0a, 0x2a,
-	0xfe, 0x4f, 0x6d, 0xc8, 0x47, 0xd0, 0xe2, 0x54, 0x60, 0x18, 0x06, 0x02, 0x47, 0x81, 0x2f, 0xa7,
-	0xae, 0xd5, 0xdf, 0xce, 0x62, 0xfe, 0xf5, 0xba, 0x63, 0x7e, 0xc5, 0x7c, 0x3c, 0x39, 0xf6, 0x9a,
-	0x05, 0xe6, 0xc4, 0x77, 0xfe, 0x31, 0x80, 0x94, 0x43, 0xeb, 0x97, 0x7d, 0x02, 0x26, 0x8b, 0xc2,
-	0x20, 0x42, 0x1d, 0xfb, 0x70, 0x2e, 0xf6, 0x75, 0xb8, 0xfb, 0xb5, 0xc4, 0x7a, 0xda, 0x87, 0x7c,
-	0x0c, 0x35, 0x9a, 0xfa, 0x81, 0x90, 0x09, 0x34, 0x7b, 0xf7, 0x57, 0x3b, 0x3f, 0xca, 0xa0, 0x9e,
-	0xf2, 0xb0, 0xef, 0x82, 0xa9, 0xc8, 0xc8, 0x1d, 0xa8, 0xf1, 0x31, 0x4b, 0x54, 0x06, 0x86, 0xa7,
-	0x04, 0xfb, 0x29, 0xd4, 0x24, 0xbe, 0xda, 0x4c, 0xde, 0x83, 0x1d, 0x9e, 0xf2, 0x18, 0xa3, 0xac,
-	0xfd, 0x23, 0x05, 0xd8, 0x90, 0x80, 0xf6, 0x4c, 0x3f, 0xcc, 0xd4, 0xce, 0x00, 0xac, 0xb3, 0x24,
-	0xe5, 0x02, 0xfd, 0x61, 0x5e, 0x0f, 0xbe, 0xfe, 0x84, 0xfc, 0x61, 0xc0, 0x5e, 0x05, 0x9d, 0x2e,
-	0xe7, 0xf7, 0x40, 0x84, 0x32, 0x8e, 0x8a, 0xe2, 0x73, 0xcb, 0xb8, 0xb7, 0x79, 0xd4, 0xec, 0x7d,
-	0x50, 0xe2, 0x5e, 0xca, 0xe0, 0x66, 0xbd, 0xfb, 0xd6, 0x1b, 0x78, 0xbb, 0xe2, 0x3a, 0xc4, 0x1e,
-	0x40, 0x5d, 0x5b, 0xc9, 0x03, 0xa8, 0x67, 0x3c, 0x59, 0xef, 0x8d, 0xca, 0xde, 0x9b, 0x99, 0xf9,
-	0xc4, 0xcf, 0x56, 0x86, 0xfa, 0x7e, 0x82, 0x5c, 0x9d, 0xa6, 0x86, 0x97, 0x8b, 0xce, 0x23, 0xb8,
-	0xfd, 0x98, 0x26, 0x11, 0xfa, 0xeb, 0xd7, 0xe2, 0x5d, 0xd8, 0xce, 0x29, 0xf4, 0xfb, 0xef, 0x40,
-	0x4d, 0x30, 0x41, 0x43, 0x7d, 0x0d, 0x94, 0xe0, 0x3c, 0x83, 0x3d, 0x85, 0x3b, 0xc5, 0xa4, 0x78,
-	0xcf, 0xfa, 0x61, 0xc7, 0x60, 0x57, 0xd1, 0xe9, 0x14, 0x1e, 0xc3, 0x0e, 0x4a, 0xeb, 0xac, 0x03,
-	0xba, 0x01, 0x76, 0x89, 0x59, 0x11, 0xcc, 0xbc, 0xdb, 0x38, 0xaf, 0x70, 0x9e, 0x43, 0xfb, 0x1a,
-	0xa6, 0xfa, 0x71, 0x6b, 0xec, 0x62, 0xef, 0x1b, 0xa8, 0x0f, 0x05, 0x4b, 0xe8, 0x04, 0xc9, 0x13,
-	0x68, 0x14, 0x7f, 0x1c, 0xf2, 0x76, 0x29, 0xc1, 0xeb, 0xbf, 0x33, 0x7b, 0xbf, 0xda, 0xa8, 0x5e,
-	0xdd, 0x8b, 0xa0, 0x51, 0x9c, 0x69, 0x42, 0xa1, 0x55, 0x3e, 0xd5, 0xe4, 0x41, 0xc9, 0x75, 0xd5,
-	0xef, 0xc1, 0x3e, 0xba, 0x19, 0xa8, 0xe3, 0xfd, 0xbe, 0x01, 0x5b, 0xd9, 0xd3, 0xc8, 0x67, 0x50,
-	0xd7, 0x67, 0x9a, 0xec, 0x95, 0xbc, 0xe7, 0xcf, 0xbf, 0x6d, 0x57, 0x99, 0x74, 0xc3, 0x06, 0xd0,
-	0x2c, 0xdd, 0x5c, 0x72, 0x50, 0x82, 0x2e, 0xde, 0x74, 0xfb, 0xee, 0x32, 0xb3, 0x66, 0x3b, 0x01,
-	0x98, 0x9d, 0x1e, 0xb2, 0xbf, 0xe4, 0x22, 0x29, 0xae, 0x83, 0x95, 0xf7, 0x8a, 0xbc, 0x80, 0xdd,
-	0x85, 0x3d, 0x25, 0xf7, 0x57, 0x6f, 0xb1, 0x22, 0x3e, 0xfc, 0x2f, 0xab, 0xde, 0xfb, 0xd5, 0x00,
-	0xf3, 0x94, 0x5e, 0xb1, 0x54, 0x90, 0x4f, 0xc1, 0x54, 0xd3, 0x46, 0xac, 0x85, 0x21, 0xcd, 0x49,
-	0xf7, 0x2a, 0x2c, 0x3a, 0x53, 0x0a, 0x64, 0x71, 0x23, 0xc8, 0xe1, 0x82, 0x43, 0xc5, 0xfe, 0xd9,
-	0xef, 0xdc, 0x80, 0x52, 0x21, 0xfa, 0x87, 0xcf, 0x1d, 0x2e, 0x58, 0xf2, 0x83, 0x1b, 0xb0, 0xae,
-	0xfc, 0xe8, 0xc6, 0x49, 0x70, 0x49, 0x05, 0x76, 0x0b, 0xf7, 0xf8, 0xfc, 0xdc, 0x94, 0xbf, 0xc2,
-	0x87, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x3f, 0xac, 0xd3, 0x03, 0x0a, 0x00, 0x00,
+	// 1517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x7f, 0x94, 0x6d, 0xc9, 0x1e, 0xc9, 0x76, 0xbc, 0xce, 0xcb, 0xa3, 0x19, 0xdb, 0x0a, 0x68,
+	0xbf, 0xd8, 0x79, 0xaf, 0x90, 0x13, 0xa5, 0x28, 0x5a, 0xa0, 0x45, 0x2b, 0xc7, 0x49, 0x6c, 0xd8,
+	0x69, 0x04, 0x3a, 0xe9, 0x21, 0x05, 0xc2, 0xae, 0xc5, 0xb5, 0xc4, 0x98, 0xe2, 0xb2, 0xdc, 0xa5,
+	0x1b, 0x5f, 0x7b, 0xec, 0xa9, 0x5f, 0xa0, 0x9f, 0xa0, 0xdf, 0xa0, 0x40, 0x51, 0xa0, 0x87, 0xa2,
+	0xa7, 0xa2, 0x87, 0x02, 0x05, 0x7a, 0x48, 0x7b, 0xe9, 0x67, 0xe8, 0xb5, 0xe0, 0xee, 0x92, 0xa2,
+	0xac, 0x3f, 0x76, 0x24, 0xa0, 0x37, 0xed, 0x6f, 0x66, 0x7e, 0xc3, 0x99, 0x9d, 0xd5, 0xcc, 0xc0,
+	0x7c, 0x3b, 0xf2, 0xb8, 0xeb, 0x53, 0x87, 0x54, 0x82, 0x90, 0x72, 0x8a, 0x66, 0x52, 0xc0, 0x80,
+	0x26, 0x6d, 0x52, 0x09, 0x1b, 0xe5, 0x26, 0xa5, 0x4d, 0x8f, 0x6c, 0x89, 0xd3, 0x51, 0x74, 0xbc,
+	0xc5, 0xdd, 0x36, 0x61, 0x1c, 0xb7, 0x03, 0xa9, 0x60, 0x6e, 0xc2, 0xac, 0x45, 0x3e, 0x8d, 0x08,
+	0xe3, 0xbb, 0x04, 0x3b, 0x24, 0x44, 0xff, 0x81, 0x02, 0x0e, 0x5c, 0xfb, 0x84, 0x9c, 0xe9, 0xda,
+	0x0d, 0x6d, 0xb3, 0x64, 0xe5, 0x71, 0xe0, 0xee, 0x93, 0x33, 0x73, 0x07, 0xae, 0xec, 0xb8, 0xec,
+	0xe4, 0x30, 0xc0, 0x0d, 0xa2, 0x4c, 0xd0, 0x6d, 0xc8, 0xb7, 0x84, 0x99, 0xd0, 0x2d, 0x56, 0xf5,
+	0x4a, 0xe7, 0xbb, 0xba, 0x68, 0x2d, 0xa5, 0x67, 0x7e, 0xa7, 0xc1, 0x42, 0x86, 0x86, 0x05, 0xd4,
+	0x67, 0x04, 0x2d, 0xc3, 0x0c, 0xf6, 0x3c, 0xda, 0xc0, 0x9c, 0x38, 0x82, 0x6a, 0xc2, 0xea, 0x00,
+	0xa8, 0x0c, 0xc5, 0x88, 0x11, 0xc7, 0x0e, 0x5c, 0xd2, 0x20, 0x4c, 0xcf, 0x09, 0x39, 0xc4, 0x50,
+	0x5d, 0x20, 0x68, 0x05, 0xc4, 0xc9, 0xe6, 0x21, 0x66, 0x2d, 0x7d, 0x42, 0xda, 0xc7, 0xc8, 0x93,
+	0x18, 0x40, 0x08, 0x26, 0x8f, 0x43, 0x42, 0xf4, 0x49, 0x21, 0x10, 0xbf, 0x85, 0xc7, 0x53, 0xec,
+	0x7a, 0xf8, 0xc8, 0x23, 0xfa, 0x94, 0xf2, 0x98, 0x00, 0xc8, 0x80, 0x69, 0x7a, 0x4a, 0xc2, 0x98,
+	0x42, 0xcf, 0x0b, 0x61, 0x7a, 0x36, 0x7d, 0x58, 0x3e, 0x24, 0xbc, 0x96, 0x7c, 0xdd, 0xf8, 0x39,
+	0xe9, 0x8e, 0x3e, 0x77, 0x2e, 0x7a, 0xb3, 0x0c, 0x2b, 0x03, 0xfc, 0xc9, 0xe4, 0x99, 0x75, 0x58,
+	0xde, 0xc6, 0xbe, 0xf3, 0x99, 0xeb, 0xf0, 0xd6, 0x23, 0xea, 0xf3, 0xd6, 0x61, 0xd4, 0x6e, 0xe3,
+	0xf0, 0x6c, 0xf4, 0x4b, 0xba, 0x0b, 0x2b, 0x03, 0x18, 0xd5, 0x7d, 0x21, 0x98, 0x14, 0xb9, 0x91,
+	0x57, 0x25, 0x7e, 0x9b, 0xdf, 0x6b, 0xb0, 0x9a, 0x5a, 0xed, 0x60, 0xd7, 0x3b, 0x3b, 0xc4, 0x9c,
+	0x78, 0x9e, 0xcb, 0xc7, 0x48, 0xcd, 0xdb, 0xf1, 0xd5, 0xd1, 0xb6, 0xc8, 0x4a, 0xb1, 0x6a, 0x54,
+	0x64, 0x39, 0x57, 0x92, 0x72, 0xae, 0x3c, 0x49, 0xca, 0x79, 0x7b, 0xfa, 0xc7, 0x57, 0xe5, 0x7f,
+	0x7d, 0xf9, 0x7b, 0x59, 0xb3, 0x84, 0x05, 0x7a, 0x13, 0x72, 0x9c, 0xea, 0x13, 0xaf, 0x61, 0x97,
+	0xe3, 0xd4, 0xfc, 0x3a, 0x07, 0xe5, 0x81, 0x41, 0xa8, 0xe0, 0xf7, 0xa1, 0x10, 0x52, 0xcf, 0x8b,
+	0x02, 0xa6, 0x6b, 0x37, 0x26, 0x36, 0x8b, 0xd5, 0x3b, 0x99, 0x30, 0x2e, 0x30, 0xae, 0x58, 0xc2,
+	0xd2, 0x4a, 0x18, 0x8c, 0x6f, 0x34, 0xc8, 0x4b, 0x0c, 0xdd, 0x81, 0x12, 0x4b, 0xf4, 0x6d, 0x57,
+	0x26, 0xb7, 0xb4, 0x3d, 0x17, 0x7f, 0xdf, 0x6f, 0xaf, 0xca, 0xf9, 0x0f, 0xa9, 0x43, 0xf6, 0x76,
+	0xac, 0x62, 0xaa, 0xb3, 0xe7, 0xa0, 0x7d, 0x98, 0x73, 0x7d, 0x4e, 0xc2, 0x53, 0xec, 0xd9, 0x8c,
+	0xe3, 0x90, 0xbf, 0x56, 0xa2, 0x66, 0x13, 0xdb, 0xc3, 0xd8, 0x14, 0x5d, 0x83, 0x3c, 0x69, 0x86,
+	0x84, 0x31, 0xf5, 0x82, 0xd4, 0x09, 0xe9, 0x50, 0x70, 0x7d, 0x29, 0x90, 0x2f, 0x28, 0x39, 0x9a,
+	0xdb, 0x30, 0xf7, 0x11, 0x09, 0x99, 0x4b, 0xfd, 0xd1, 0x6b, 0xed, 0xff, 0x30, 0x9f, 0x72, 0xa8,
+	0x04, 0xeb, 0x50, 0x38, 0x95, 0x90, 0x60, 0x99, 0xb1, 0x92, 0xa3, 0xf9, 0x00, 0xd0, 0x01, 0x66,
+	0xfc, 0x1e, 0xf5, 0x39, 0x6e, 0xf0, 0xd1, 0x9d, 0x3e, 0x87, 0xc5, 0x2e, 0x1e, 0xe5, 0xf8, 0x21,
+	0x94, 0x3c, 0xcc, 0xb8, 0xdd, 0x90, 0xb8, 0xae, 0xbd, 0x46, 0x32, 0x8b, 0x5e, 0x87, 0xd0, 0x7c,
+	0x09, 0x0b, 0x16, 0x09, 0x22, 0x8e, 0xf9, 0x38, 0xb9, 0xe9, 0xa9, 0x88, 0xdc, 0x85, 0x15, 0x61,
+	0xfe, 0xa5, 0x01, 0xca, 0xba, 0x56, 0x91, 0xbd, 0x0b, 0x79, 0xea, 0x7b, 0xae, 0x4f, 0x94, 0xef,
+	0xf5, 0x2e, 0xdf, 0xe7, 0xd5, 0x2b, 0x8f, 0x85, 0xae, 0xa5, 0x6c, 0xd0, 0x3b, 0x30, 0x85, 0x23,
+	0xc7, 0x4d, 0xaa, 0x6b, 0x6d, 0xb8, 0x71, 0x2d, 0x56, 0xb5, 0xa4, 0x85, 0xb1, 0x0a, 0x79, 0x49,
+	0x86, 0xae, 0xc2, 0x14, 0x6b, 0xd0, 0x50, 0x7e, 0x81, 0x66, 0xc9, 0x83, 0xb1, 0x0b, 0x53, 0x42,
+	0xbf, 0xbf, 0x18, 0xdd, 0x82, 0x2b, 0x2c, 0x62, 0x01, 0xf1, 0xe3, 0xeb, 0xb7, 0xa5, 0x42, 0x4e,
+	0x28, 0xcc, 0x77, 0xf0, 0xc3, 0x18, 0x36, 0x0f, 0x40, 0x7f, 0x12, 0x46, 0x8c, 0x13, 0x27, 0x7d,
+	0x75, 0x6c, 0xf4, 0x0a, 0xf9, 0x41, 0x83, 0xa5, 0x3e, 0x74, 0x2a, 0x9d, 0x1f, 0x03, 0xe2, 0x52,
+	0x68, 0xa7, 0xc9, 0x4f, 0xfe, 0x0d, 0xde, 0xc8, 0x70, 0x0f, 0x64, 0xa8, 0xc4, 0x77, 0xf7, 0xd4,
+	0x3a, 0xb0, 0x16, 0xf8, 0x79, 0x15, 0xe3, 0x00, 0x0a, 0x4a, 0x8a, 0x36, 0xa0, 0x10, 0xf3, 0x0c,
+	0xfe, 0x37, 0xc8, 0xc7, 0xe2, 0x3d, 0x27, 0x7e, 0x32, 0xd8, 0x71, 0xc4, 0x1b, 0xcd, 0xc9, 0x27,
+	0xa3, 0x8e, 0xf1, 0x93, 0xd9, 0x63, 0x2c, 0x22, 0xb5, 0xfa, 0xde, 0x3e, 0x19, 0xa3, 0x27, 0x54,
+	0x60, 0xb1, 0x8b, 0x47, 0x65, 0x62, 0xe0, 0xb8, 0xf0, 0x09, 0x2c, 0x5a, 0xe4, 0x94, 0x9e, 0x8c,
+	0xeb, 0x38, 0xeb, 0x21, 0xd7, 0xe5, 0xe1, 0x1a, 0x5c, 0xed, 0xf6, 0xa0, 0xfa, 0x61, 0x0d, 0x66,
+	0xef, 0xe3, 0xd0, 0x27, 0xce, 0xe8, 0xc1, 0xde, 0x84, 0xb9, 0x84, 0x42, 0xc5, 0x79, 0x15, 0xa6,
+	0x38, 0xe5, 0xd8, 0x53, 0x2d, 0x4f, 0x1e, 0xcc, 0x47, 0xb0, 0x24, 0xf5, 0xea, 0x24, 0x1c, 0xbf,
+	0xdb, 0x99, 0x0d, 0x30, 0xfa, 0xd1, 0xa9, 0x4f, 0xb8, 0x0f, 0x57, 0x88, 0x90, 0x76, 0x6a, 0x4e,
+	0x95, 0x9c, 0x91, 0x61, 0x96, 0x04, 0x1d, 0xeb, 0x79, 0xd2, 0x0d, 0x98, 0xcf, 0x60, 0xfe, 0x9c,
+	0x4e, 0xff, 0xe0, 0x46, 0xf9, 0xf7, 0x39, 0x00, 0x7d, 0x97, 0x78, 0x4e, 0xad, 0x4d, 0x23, 0x9f,
+	0xef, 0xba, 0x8c, 0xd3, 0x71, 0xc6, 0x90, 0x9f, 0x72, 0xb0, 0xd4, 0x87, 0x4e, 0xa5, 0xa3, 0x0e,
+	0x85, 0x96, 0x84, 0x54, 0x16, 0xde, 0xca, 0x10, 0x0e, 0x34, 0xeb, 0x23, 0x49, 0x68, 0x8c, 0xf7,
+	0x61, 0x36, 0x96, 0x3e, 0xa0, 0x61, 0x9d, 0x84, 0x2e, 0x75, 0xe2, 0x8e, 0x18, 0x88, 0x5f, 0xaa,
+	0x0f, 0xe5, 0x83, 0x14, 0xc7, 0x82, 0x42, 0x4d, 0x6b, 0xea, 0x64, 0x7c, 0xa5, 0xc1, 0x42, 0x0f,
+	0xff, 0x28, 0x7d, 0xfd, 0x29, 0x94, 0x5a, 0xc4, 0x73, 0x6c, 0xc9, 0x1b, 0xbf, 0xe9, 0x38, 0xc0,
+	0xea, 0xa5, 0x03, 0x4c, 0x43, 0xb0, 0x8a, 0xad, 0x54, 0x91, 0x99, 0x9f, 0x6b, 0x70, 0x7d, 0xcf,
+	0x77, 0xb9, 0x8b, 0x39, 0x79, 0x18, 0xe2, 0x06, 0x39, 0x8e, 0xbc, 0xfb, 0x2f, 0x5d, 0xfe, 0x8f,
+	0x76, 0xa8, 0x55, 0x58, 0xee, 0xff, 0x0d, 0xea, 0xf9, 0x3e, 0x86, 0xeb, 0x59, 0xbc, 0x1e, 0x52,
+	0x31, 0x6c, 0x8c, 0x5e, 0x46, 0xbf, 0x4c, 0xc0, 0x72, 0x7f, 0x46, 0x55, 0x49, 0x07, 0x30, 0x1d,
+	0x28, 0x4c, 0x95, 0xd2, 0xed, 0x0c, 0xe9, 0x30, 0xd3, 0x4a, 0x0a, 0xa4, 0x0c, 0xc6, 0x9f, 0x39,
+	0x98, 0x4e, 0xe0, 0x51, 0xee, 0xfe, 0x1e, 0x94, 0x5c, 0x95, 0x1f, 0xc7, 0xc6, 0x97, 0x99, 0xe8,
+	0x26, 0xe5, 0x00, 0x92, 0x5a, 0xd5, 0x38, 0xaa, 0x41, 0xf1, 0xd8, 0xf5, 0x5d, 0xd6, 0x92, 0x1c,
+	0x13, 0x97, 0xe4, 0x80, 0xc4, 0xa8, 0xc6, 0x51, 0x05, 0x16, 0xc5, 0x48, 0xe9, 0xfa, 0x4d, 0xdb,
+	0x71, 0xd9, 0x89, 0x1d, 0x31, 0xdc, 0x4c, 0x96, 0xa8, 0x85, 0x44, 0x14, 0xaf, 0x23, 0x4f, 0x63,
+	0x01, 0x5a, 0x83, 0xd9, 0xa3, 0x33, 0x4e, 0x98, 0xed, 0x10, 0x8f, 0xc4, 0x9b, 0x8c, 0xdc, 0xaa,
+	0x4a, 0x02, 0xdc, 0x91, 0x58, 0xdc, 0xcf, 0x03, 0x12, 0x36, 0x88, 0x1f, 0x0f, 0x59, 0xed, 0x20,
+	0x06, 0xc5, 0x82, 0x95, 0xb3, 0xe6, 0x15, 0x7e, 0x4f, 0xc1, 0x68, 0x15, 0x80, 0x45, 0x8d, 0x06,
+	0x61, 0xec, 0x38, 0xf2, 0xf4, 0xc2, 0x0d, 0x6d, 0x73, 0xda, 0xca, 0x20, 0xd5, 0x6f, 0x35, 0x28,
+	0x1c, 0x72, 0x1a, 0xc6, 0xbe, 0x1f, 0xc0, 0x4c, 0xba, 0x17, 0xa1, 0xeb, 0x99, 0xcb, 0x3b, 0xbf,
+	0x9d, 0x19, 0xcb, 0xfd, 0x85, 0xaa, 0x12, 0x5e, 0xc0, 0xbf, 0xfb, 0xee, 0x5a, 0x68, 0x23, 0x63,
+	0x36, 0x6c, 0xfb, 0x33, 0x36, 0x2f, 0x56, 0x94, 0xbe, 0xaa, 0xbf, 0x6a, 0x30, 0x93, 0x6e, 0x0b,
+	0x08, 0x43, 0x29, 0xbb, 0x69, 0x75, 0x39, 0x1c, 0xb6, 0xdd, 0x19, 0x9b, 0x17, 0x2b, 0xaa, 0xe0,
+	0x9a, 0x30, 0xd7, 0xbd, 0x94, 0xa0, 0x5b, 0x97, 0x59, 0x5c, 0xa4, 0x9b, 0xff, 0x5d, 0x7e, 0xc7,
+	0xa9, 0xfe, 0x31, 0x01, 0x93, 0x71, 0x65, 0xa3, 0x0f, 0xa0, 0xa0, 0x66, 0x7b, 0xb4, 0x94, 0xb1,
+	0xef, 0xde, 0x19, 0x0c, 0xa3, 0x9f, 0x28, 0x7d, 0x9a, 0xc5, 0xcc, 0xa0, 0x8e, 0x56, 0x32, 0xaa,
+	0xbd, 0x8b, 0x80, 0xb1, 0x3a, 0x48, 0xac, 0xd8, 0xf6, 0x00, 0x3a, 0xf3, 0x2a, 0x5a, 0x1e, 0x30,
+	0xc6, 0x4a, 0xae, 0x95, 0xa1, 0x43, 0x2e, 0x7a, 0x0e, 0x0b, 0x3d, 0xc3, 0x1d, 0x5a, 0x1b, 0x3e,
+	0xfa, 0x49, 0xe2, 0xf5, 0xcb, 0xcc, 0x87, 0x71, 0xe0, 0x99, 0x71, 0xab, 0x2b, 0xf0, 0xde, 0x71,
+	0xce, 0x58, 0x1d, 0x24, 0x56, 0x6c, 0x8f, 0xa1, 0x94, 0x1d, 0x95, 0xd0, 0x6a, 0x57, 0x70, 0x3d,
+	0x53, 0x9a, 0x51, 0x1e, 0x28, 0x57, 0x57, 0xfc, 0x45, 0x0e, 0xf2, 0x75, 0x7c, 0x46, 0x23, 0x8e,
+	0xde, 0x83, 0xbc, 0x9c, 0x27, 0x90, 0xde, 0x33, 0x86, 0x24, 0x7c, 0x4b, 0x7d, 0x24, 0xea, 0xd3,
+	0x30, 0xa0, 0xde, 0x99, 0x07, 0xad, 0xf7, 0x18, 0xf4, 0x99, 0xb0, 0x8c, 0xff, 0x5e, 0xa0, 0xd5,
+	0xb9, 0xab, 0xde, 0xae, 0xbc, 0x36, 0xbc, 0x99, 0xf6, 0xde, 0xd5, 0xc0, 0x8e, 0x5b, 0xfd, 0x59,
+	0x83, 0x52, 0xb6, 0x4b, 0x20, 0x1b, 0x4a, 0x49, 0x8b, 0x13, 0xe7, 0x9b, 0xd9, 0xeb, 0x19, 0xdc,
+	0x7f, 0x8d, 0x8d, 0x0b, 0xf5, 0x54, 0x44, 0x36, 0x94, 0xb2, 0xed, 0xa8, 0xcb, 0xc1, 0x90, 0xe6,
+	0x69, 0x6c, 0x5c, 0xa8, 0x27, 0x1d, 0x6c, 0xaf, 0x3f, 0x33, 0xe3, 0x18, 0x5f, 0x54, 0x5c, 0xba,
+	0x25, 0x7e, 0x6c, 0x05, 0xa1, 0x7b, 0x8a, 0x39, 0xd9, 0x4a, 0x09, 0x82, 0xa3, 0xa3, 0xbc, 0x68,
+	0x24, 0x77, 0xff, 0x1e, 0x00, 0x98, 0xda, 0xb4, 0xbf, 0x8e, 0x14, 0x00, 0x00,
 }
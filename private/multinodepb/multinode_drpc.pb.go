@@ -43,6 +43,7 @@ type DRPCStorageClient interface {
 	DRPCConn() drpc.Conn
 
 	DiskSpace(ctx context.Context, in *DiskSpaceRequest) (*DiskSpaceResponse, error)
+	SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
 }
 
 type drpcStorageClient struct {
@@ -64,8 +65,18 @@ func (c *drpcStorageClient) DiskSpace(ctx context.Context, in *DiskSpaceRequest)
 	return out, nil
 }
 
+func (c *drpcStorageClient) SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	out := new(SetAllocatedDiskSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Storage/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCStorageServer interface {
 	DiskSpace(context.Context, *DiskSpaceRequest) (*DiskSpaceResponse, error)
+	SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
 }
 
 type DRPCStorageUnimplementedServer struct{}
@@ -74,9 +85,13 @@ func (s *DRPCStorageUnimplementedServer) DiskSpace(context.Context, *DiskSpaceRe
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
 }
 
+func (s *DRPCStorageUnimplementedServer) SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
 type DRPCStorageDescription struct{}
 
-func (DRPCStorageDescription) NumMethods() int { return 1 }
+func (DRPCStorageDescription) NumMethods() int { return 2 }
 
 func (DRPCStorageDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -89,6 +104,15 @@ func (DRPCStorageDescription) Method(n int) (string, drpc.Encoding, drpc.Receive
 						in1.(*DiskSpaceRequest),
 					)
 			}, DRPCStorageServer.DiskSpace, true
+	case 1:
+		return "/multinode.Storage/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCStorageServer).
+					SetAllocatedDiskSpace(
+						ctx,
+						in1.(*SetAllocatedDiskSpaceRequest),
+					)
+			}, DRPCStorageServer.SetAllocatedDiskSpace, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -114,10 +138,27 @@ func (x *drpcStorage_DiskSpaceStream) SendAndClose(m *DiskSpaceResponse) error {
 	return x.CloseSend()
 }
 
+type DRPCStorage_SetAllocatedDiskSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*SetAllocatedDiskSpaceResponse) error
+}
+
+type drpcStorage_SetAllocatedDiskSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcStorage_SetAllocatedDiskSpaceStream) SendAndClose(m *SetAllocatedDiskSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCBandwidthClient interface {
 	DRPCConn() drpc.Conn
 
 	MonthSummary(ctx context.Context, in *BandwidthMonthSummaryRequest) (*BandwidthMonthSummaryResponse, error)
+	DailySatellite(ctx context.Context, in *BandwidthDailySatelliteRequest) (*BandwidthDailySatelliteResponse, error)
 }
 
 type drpcBandwidthClient struct {
@@ -139,8 +180,18 @@ func (c *drpcBandwidthClient) MonthSummary(ctx context.Context, in *BandwidthMon
 	return out, nil
 }
 
+func (c *drpcBandwidthClient) DailySatellite(ctx context.Context, in *BandwidthDailySatelliteRequest) (*BandwidthDailySatelliteResponse, error) {
+	out := new(BandwidthDailySatelliteResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Bandwidth/DailySatellite", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCBandwidthServer interface {
 	MonthSummary(context.Context, *BandwidthMonthSummaryRequest) (*BandwidthMonthSummaryResponse, error)
+	DailySatellite(context.Context, *BandwidthDailySatelliteRequest) (*BandwidthDailySatelliteResponse, error)
 }
 
 type DRPCBandwidthUnimplementedServer struct{}
@@ -149,9 +200,13 @@ func (s *DRPCBandwidthUnimplementedServer) MonthSummary(context.Context, *Bandwi
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
 }
 
+func (s *DRPCBandwidthUnimplementedServer) DailySatellite(context.Context, *BandwidthDailySatelliteRequest) (*BandwidthDailySatelliteResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
 type DRPCBandwidthDescription struct{}
 
-func (DRPCBandwidthDescription) NumMethods() int { return 1 }
+func (DRPCBandwidthDescription) NumMethods() int { return 2 }
 
 func (DRPCBandwidthDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -164,6 +219,15 @@ func (DRPCBandwidthDescription) Method(n int) (string, drpc.Encoding, drpc.Recei
 						in1.(*BandwidthMonthSummaryRequest),
 					)
 			}, DRPCBandwidthServer.MonthSummary, true
+	case 1:
+		return "/multinode.Bandwidth/DailySatellite", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCBandwidthServer).
+					DailySatellite(
+						ctx,
+						in1.(*BandwidthDailySatelliteRequest),
+					)
+			}, DRPCBandwidthServer.DailySatellite, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -189,6 +253,22 @@ func (x *drpcBandwidth_MonthSummaryStream) SendAndClose(m *BandwidthMonthSummary
 	return x.CloseSend()
 }
 
+type DRPCBandwidth_DailySatelliteStream interface {
+	drpc.Stream
+	SendAndClose(*BandwidthDailySatelliteResponse) error
+}
+
+type drpcBandwidth_DailySatelliteStream struct {
+	drpc.Stream
+}
+
+func (x *drpcBandwidth_DailySatelliteStream) SendAndClose(m *BandwidthDailySatelliteResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCNodeClient interface {
 	DRPCConn() drpc.Conn
 
@@ -196,6 +276,8 @@ type DRPCNodeClient interface {
 	LastContact(ctx context.Context, in *LastContactRequest) (*LastContactResponse, error)
 	Reputation(ctx context.Context, in *ReputationRequest) (*ReputationResponse, error)
 	TrustedSatellites(ctx context.Context, in *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error)
+	IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 }
 
 type drpcNodeClient struct {
@@ -244,11 +326,31 @@ func (c *drpcNodeClient) TrustedSatellites(ctx context.Context, in *TrustedSatel
 	return out, nil
 }
 
+func (c *drpcNodeClient) IssueAPIKey(ctx context.Context, in *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error) {
+	out := new(IssueAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Node/IssueAPIKey", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Node/RevokeAPIKey", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCNodeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error)
 	Reputation(context.Context, *ReputationRequest) (*ReputationResponse, error)
 	TrustedSatellites(context.Context, *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error)
+	IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 }
 
 type DRPCNodeUnimplementedServer struct{}
@@ -269,9 +371,17 @@ func (s *DRPCNodeUnimplementedServer) TrustedSatellites(context.Context, *Truste
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
 }
 
+func (s *DRPCNodeUnimplementedServer) IssueAPIKey(context.Context, *IssueAPIKeyRequest) (*IssueAPIKeyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCNodeUnimplementedServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
 type DRPCNodeDescription struct{}
 
-func (DRPCNodeDescription) NumMethods() int { return 4 }
+func (DRPCNodeDescription) NumMethods() int { return 6 }
 
 func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -311,6 +421,24 @@ func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver,
 						in1.(*TrustedSatellitesRequest),
 					)
 			}, DRPCNodeServer.TrustedSatellites, true
+	case 4:
+		return "/multinode.Node/IssueAPIKey", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeServer).
+					IssueAPIKey(
+						ctx,
+						in1.(*IssueAPIKeyRequest),
+					)
+			}, DRPCNodeServer.IssueAPIKey, true
+	case 5:
+		return "/multinode.Node/RevokeAPIKey", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeServer).
+					RevokeAPIKey(
+						ctx,
+						in1.(*RevokeAPIKeyRequest),
+					)
+			}, DRPCNodeServer.RevokeAPIKey, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -384,11 +512,44 @@ func (x *drpcNode_TrustedSatellitesStream) SendAndClose(m *TrustedSatellitesResp
 	return x.CloseSend()
 }
 
+type DRPCNode_IssueAPIKeyStream interface {
+	drpc.Stream
+	SendAndClose(*IssueAPIKeyResponse) error
+}
+
+type drpcNode_IssueAPIKeyStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNode_IssueAPIKeyStream) SendAndClose(m *IssueAPIKeyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNode_RevokeAPIKeyStream interface {
+	drpc.Stream
+	SendAndClose(*RevokeAPIKeyResponse) error
+}
+
+type drpcNode_RevokeAPIKeyStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNode_RevokeAPIKeyStream) SendAndClose(m *RevokeAPIKeyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCPayoutClient interface {
 	DRPCConn() drpc.Conn
 
 	Earned(ctx context.Context, in *EarnedRequest) (*EarnedResponse, error)
 	EarnedPerSatellite(ctx context.Context, in *EarnedPerSatelliteRequest) (*EarnedPerSatelliteResponse, error)
+	HeldAmountHistory(ctx context.Context, in *HeldAmountHistoryRequest) (*HeldAmountHistoryResponse, error)
 }
 
 type drpcPayoutClient struct {
@@ -419,9 +580,19 @@ func (c *drpcPayoutClient) EarnedPerSatellite(ctx context.Context, in *EarnedPer
 	return out, nil
 }
 
+func (c *drpcPayoutClient) HeldAmountHistory(ctx context.Context, in *HeldAmountHistoryRequest) (*HeldAmountHistoryResponse, error) {
+	out := new(HeldAmountHistoryResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Payout/HeldAmountHistory", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCPayoutServer interface {
 	Earned(context.Context, *EarnedRequest) (*EarnedResponse, error)
 	EarnedPerSatellite(context.Context, *EarnedPerSatelliteRequest) (*EarnedPerSatelliteResponse, error)
+	HeldAmountHistory(context.Context, *HeldAmountHistoryRequest) (*HeldAmountHistoryResponse, error)
 }
 
 type DRPCPayoutUnimplementedServer struct{}
@@ -434,9 +605,13 @@ func (s *DRPCPayoutUnimplementedServer) EarnedPerSatellite(context.Context, *Ear
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
 }
 
+func (s *DRPCPayoutUnimplementedServer) HeldAmountHistory(context.Context, *HeldAmountHistoryRequest) (*HeldAmountHistoryResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
 type DRPCPayoutDescription struct{}
 
-func (DRPCPayoutDescription) NumMethods() int { return 2 }
+func (DRPCPayoutDescription) NumMethods() int { return 3 }
 
 func (DRPCPayoutDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -458,6 +633,15 @@ func (DRPCPayoutDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver
 						in1.(*EarnedPerSatelliteRequest),
 					)
 			}, DRPCPayoutServer.EarnedPerSatellite, true
+	case 2:
+		return "/multinode.Payout/HeldAmountHistory", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPayoutServer).
+					HeldAmountHistory(
+						ctx,
+						in1.(*HeldAmountHistoryRequest),
+					)
+			}, DRPCPayoutServer.HeldAmountHistory, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -498,3 +682,134 @@ func (x *drpcPayout_EarnedPerSatelliteStream) SendAndClose(m *EarnedPerSatellite
 	}
 	return x.CloseSend()
 }
+
+type DRPCPayout_HeldAmountHistoryStream interface {
+	drpc.Stream
+	SendAndClose(*HeldAmountHistoryResponse) error
+}
+
+type drpcPayout_HeldAmountHistoryStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPayout_HeldAmountHistoryStream) SendAndClose(m *HeldAmountHistoryResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCGracefulExitClient interface {
+	DRPCConn() drpc.Conn
+
+	InitiateExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	ExitProgress(ctx context.Context, in *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error)
+}
+
+type drpcGracefulExitClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCGracefulExitClient(cc drpc.Conn) DRPCGracefulExitClient {
+	return &drpcGracefulExitClient{cc}
+}
+
+func (c *drpcGracefulExitClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcGracefulExitClient) InitiateExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	out := new(InitiateGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/multinode.GracefulExit/InitiateExit", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcGracefulExitClient) ExitProgress(ctx context.Context, in *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error) {
+	out := new(GracefulExitProgressResponse)
+	err := c.cc.Invoke(ctx, "/multinode.GracefulExit/ExitProgress", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCGracefulExitServer interface {
+	InitiateExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	ExitProgress(context.Context, *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error)
+}
+
+type DRPCGracefulExitUnimplementedServer struct{}
+
+func (s *DRPCGracefulExitUnimplementedServer) InitiateExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCGracefulExitUnimplementedServer) ExitProgress(context.Context, *GracefulExitProgressRequest) (*GracefulExitProgressResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+type DRPCGracefulExitDescription struct{}
+
+func (DRPCGracefulExitDescription) NumMethods() int { return 2 }
+
+func (DRPCGracefulExitDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.GracefulExit/InitiateExit", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitServer).
+					InitiateExit(
+						ctx,
+						in1.(*InitiateGracefulExitRequest),
+					)
+			}, DRPCGracefulExitServer.InitiateExit, true
+	case 1:
+		return "/multinode.GracefulExit/ExitProgress", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitServer).
+					ExitProgress(
+						ctx,
+						in1.(*GracefulExitProgressRequest),
+					)
+			}, DRPCGracefulExitServer.ExitProgress, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterGracefulExit(mux drpc.Mux, impl DRPCGracefulExitServer) error {
+	return mux.Register(impl, DRPCGracefulExitDescription{})
+}
+
+type DRPCGracefulExit_InitiateExitStream interface {
+	drpc.Stream
+	SendAndClose(*InitiateGracefulExitResponse) error
+}
+
+type drpcGracefulExit_InitiateExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExit_InitiateExitStream) SendAndClose(m *InitiateGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCGracefulExit_ExitProgressStream interface {
+	drpc.Stream
+	SendAndClose(*GracefulExitProgressResponse) error
+}
+
+type drpcGracefulExit_ExitProgressStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExit_ExitProgressStream) SendAndClose(m *GracefulExitProgressResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -124,6 +124,14 @@ func (bad *BadBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
 	return bad.blobs.Trash(ctx, ref)
 }
 
+// Quarantine moves the blob with the namespace and key to the quarantine.
+func (bad *BadBlobs) Quarantine(ctx context.Context, ref storage.BlobRef) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.Quarantine(ctx, ref)
+}
+
 // RestoreTrash restores all files in the trash.
 func (bad *BadBlobs) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
 	if err := bad.err.Err(); err != nil {
@@ -33,6 +33,7 @@ import (
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/apikeyusage"
 	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
 	"storj.io/storj/satellite/accounting/rollup"
@@ -43,12 +44,14 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metainfo/metaloop"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -138,10 +141,15 @@ type Satellite struct {
 		Chore *expireddeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		Rollup           *rollup.Service
 		ProjectUsage     *accounting.Service
+		APIKeyUsage      *apikeyusage.Service
 		ProjectBWCleanup *projectbwcleanup.Chore
 		RollupArchive    *rolluparchive.Chore
 	}
@@ -164,6 +172,10 @@ type Satellite struct {
 		Endpoint *consoleweb.Server
 	}
 
+	UsageAlerts struct {
+		Chore *usagealerts.Chore
+	}
+
 	NodeStats struct {
 		Endpoint *nodestats.Endpoint
 	}
@@ -477,10 +489,11 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 				ListLimit:        10000,
 			},
 			RateLimiter: metainfo.RateLimiterConfig{
-				Enabled:         true,
-				Rate:            1000,
-				CacheCapacity:   100,
-				CacheExpiration: 10 * time.Second,
+				Enabled:          true,
+				Rate:             1000,
+				CacheCapacity:    100,
+				KeyCacheCapacity: 100,
+				CacheExpiration:  10 * time.Second,
 			},
 			ProjectLimits: metainfo.ProjectLimitConfig{
 				MaxBuckets: 10,
@@ -558,6 +571,11 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 			Interval: defaultInterval,
 			Enabled:  true,
 		},
+		BucketLifecycle: bucketlifecycle.Config{
+			Interval:  defaultInterval,
+			Enabled:   true,
+			ListLimit: 100,
+		},
 		Tally: tally.Config{
 			Interval: defaultInterval,
 		},
@@ -572,8 +590,9 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 			Enabled:    true,
 		},
 		ProjectBWCleanup: projectbwcleanup.Config{
-			Interval:     defaultInterval,
-			RetainMonths: 1,
+			Interval:                    defaultInterval,
+			RetainMonths:                1,
+			SettlementOutcomesRetention: 24 * time.Hour,
 		},
 		LiveAccounting: live.Config{
 			StorageBackend:    "redis://" + redis.Addr() + "?db=0",
@@ -604,6 +623,12 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 				NumLimits: 10,
 			},
 		},
+		UsageAlerts: usagealerts.Config{
+			Enabled:           true,
+			Interval:          defaultInterval,
+			DefaultThresholds: "80,100",
+			ListLimit:         100,
+		},
 		Version: planet.NewVersionConfig(),
 		GracefulExit: gracefulexit.Config{
 			Enabled: true,
@@ -622,6 +647,10 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		Metrics: metrics.Config{
 			ChoreInterval: defaultInterval,
 		},
+		APIKeyUsage: apikeyusage.Config{
+			FlushInterval:   defaultInterval,
+			CacheExpiration: 10 * time.Second,
+		},
 	}
 
 	if planet.config.Reconfigure.Satellite != nil {
@@ -666,7 +695,7 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		return nil, err
 	}
 
-	adminPeer, err := planet.newAdmin(ctx, index, identity, db, config, versionInfo)
+	adminPeer, err := planet.newAdmin(ctx, index, identity, db, metabaseDB, config, versionInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -738,9 +767,12 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 
+	system.BucketLifecycle.Chore = peer.BucketLifecycle.Chore
+
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.Rollup = peer.Accounting.Rollup
 	system.Accounting.ProjectUsage = api.Accounting.ProjectUsage
+	system.Accounting.APIKeyUsage = api.Accounting.APIKeyUsage
 	system.Accounting.ProjectBWCleanup = peer.Accounting.ProjectBWCleanupChore
 	system.Accounting.RollupArchive = peer.Accounting.RollupArchiveChore
 
@@ -748,6 +780,8 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ProjectLimits.Cache = api.ProjectLimits.Cache
 
+	system.UsageAlerts.Chore = peer.UsageAlerts.Chore
+
 	system.GracefulExit.Chore = peer.GracefulExit.Chore
 	system.GracefulExit.Endpoint = api.GracefulExit.Endpoint
 
@@ -779,11 +813,11 @@ func (planet *Planet) newAPI(ctx context.Context, index int, identity *identity.
 	return satellite.NewAPI(log, identity, db, pointerDB, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, &config, versionInfo, nil)
 }
 
-func (planet *Planet) newAdmin(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, config satellite.Config, versionInfo version.Info) (*satellite.Admin, error) {
+func (planet *Planet) newAdmin(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB metainfo.MetabaseDB, config satellite.Config, versionInfo version.Info) (*satellite.Admin, error) {
 	prefix := "satellite-admin" + strconv.Itoa(index)
 	log := planet.log.Named(prefix)
 
-	return satellite.NewAdmin(log, identity, db, versionInfo, &config, nil)
+	return satellite.NewAdmin(log, identity, db, metabaseDB, versionInfo, &config, nil)
 }
 
 func (planet *Planet) newRepairer(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, pointerDB metainfo.PointerDB, metabaseDB metainfo.MetabaseDB, config satellite.Config, versionInfo version.Info) (*satellite.Repairer, error) {
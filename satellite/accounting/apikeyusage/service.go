@@ -0,0 +1,276 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apikeyusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// Error is the default error class for the api key usage service.
+	Error = errs.Class("api key usage")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the api key usage service.
+type Config struct {
+	FlushInterval   time.Duration `help:"how often to flush the api key usage to the database" releaseDefault:"1m" devDefault:"10s"`
+	CacheExpiration time.Duration `help:"how long to cache the monthly egress of api keys with a bandwidth limit" releaseDefault:"1m" devDefault:"10s"`
+}
+
+// DB stores the usage of api keys.
+//
+// architecture: Database
+type DB interface {
+	// AddUsage adds the given usage to the hourly usage rollups of the api keys.
+	AddUsage(ctx context.Context, rollups []console.APIKeyUsageRollup) error
+	// GetEgress returns the egress allocated with the api key with the given head since the given time.
+	GetEgress(ctx context.Context, head []byte, since time.Time) (int64, error)
+}
+
+// rollupKey identifies the hourly rollup of an api key.
+type rollupKey struct {
+	head          string
+	intervalStart time.Time
+}
+
+// monthlyEgress is the cached egress of an api key for a month, as stored in
+// the database.
+type monthlyEgress struct {
+	month    time.Time
+	loadedAt time.Time
+	egress   int64
+}
+
+// Service records the request count and egress of api keys, so that the
+// usage of a project can be attributed to its keys, and enforces the
+// bandwidth limits of api keys.
+//
+// The usage is kept in memory and flushed to the database periodically.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	nowFn  func() time.Time
+
+	Loop *sync2.Cycle
+
+	mu             sync.Mutex
+	pending        map[rollupKey]*console.APIKeyUsageRollup
+	pendingEgress  map[string]int64
+	flushingEgress map[string]int64
+	flushes        int64
+	egress         map[string]*monthlyEgress
+}
+
+// NewService creates a new api key usage service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:            log,
+		db:             db,
+		config:         config,
+		nowFn:          time.Now,
+		Loop:           sync2.NewCycle(config.FlushInterval),
+		pending:        make(map[rollupKey]*console.APIKeyUsageRollup),
+		pendingEgress:  make(map[string]int64),
+		flushingEgress: make(map[string]int64),
+		egress:         make(map[string]*monthlyEgress),
+	}
+}
+
+// Run starts flushing the api key usage periodically.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Flush(ctx); err != nil {
+			service.log.Error("unable to flush api key usage", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the service and flushes the remaining usage.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return service.Flush(context.Background())
+}
+
+// AddRequest records a request made with the api key.
+func (service *Service) AddRequest(ctx context.Context, key *console.APIKeyInfo) {
+	defer mon.Task()(&ctx)(nil)
+	service.add(key, 1, 0)
+}
+
+// AddEgress records egress allocated with the api key.
+func (service *Service) AddEgress(ctx context.Context, key *console.APIKeyInfo, amount int64) {
+	defer mon.Task()(&ctx)(nil)
+	service.add(key, 0, amount)
+}
+
+func (service *Service) add(key *console.APIKeyInfo, requests, egress int64) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	intervalStart := service.nowFn().UTC().Truncate(time.Hour)
+
+	rkey := rollupKey{head: string(key.Head), intervalStart: intervalStart}
+	rollup, ok := service.pending[rkey]
+	if !ok {
+		rollup = &console.APIKeyUsageRollup{
+			Head:          key.Head,
+			ProjectID:     key.ProjectID,
+			IntervalStart: intervalStart,
+		}
+		service.pending[rkey] = rollup
+	}
+	rollup.Requests += requests
+	rollup.Egress += egress
+
+	if egress != 0 {
+		service.pendingEgress[rkey.head] += egress
+	}
+}
+
+// ExceedsBandwidthLimit returns whether the monthly egress of the api key
+// has reached its bandwidth limit.
+//
+// The egress recorded by other satellite instances is only taken into
+// account once it has been flushed, so the limit is enforced approximately.
+func (service *Service) ExceedsBandwidthLimit(ctx context.Context, key *console.APIKeyInfo) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if key.BandwidthLimit == nil {
+		return false, nil
+	}
+
+	head := string(key.Head)
+
+	service.mu.Lock()
+	now := service.nowFn().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	cached, ok := service.egress[head]
+	stale := !ok || !cached.month.Equal(month) || now.Sub(cached.loadedAt) >= service.config.CacheExpiration
+	flushes := service.flushes
+	service.mu.Unlock()
+
+	if stale {
+		egress, err := service.db.GetEgress(ctx, key.Head, month)
+		if err != nil {
+			return false, Error.Wrap(err)
+		}
+
+		cached = &monthlyEgress{month: month, loadedAt: now, egress: egress}
+
+		// the egress may have been loaded before a concurrent flush committed,
+		// in that case it must not be cached.
+		service.mu.Lock()
+		if service.flushes == flushes {
+			service.egress[head] = cached
+		}
+		service.mu.Unlock()
+	}
+
+	service.mu.Lock()
+	total := cached.egress + service.pendingEgress[head] + service.flushingEgress[head]
+	service.mu.Unlock()
+
+	return total >= *key.BandwidthLimit, nil
+}
+
+// Flush writes the recorded usage to the database.
+func (service *Service) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the egress being flushed keeps counting towards the bandwidth limits
+	// until it is committed and the cached monthly egress is invalidated.
+	service.mu.Lock()
+	pending := service.pending
+	flushing := service.pendingEgress
+	service.pending = make(map[rollupKey]*console.APIKeyUsageRollup)
+	service.pendingEgress = make(map[string]int64)
+	for head, egress := range flushing {
+		service.flushingEgress[head] += egress
+	}
+	service.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	rollups := make([]console.APIKeyUsageRollup, 0, len(pending))
+	for _, rollup := range pending {
+		rollups = append(rollups, *rollup)
+	}
+
+	if err := service.db.AddUsage(ctx, rollups); err != nil {
+		// put the usage back, so that it is retried on the next flush and
+		// still counts towards the bandwidth limits.
+		service.mu.Lock()
+		service.releaseFlushing(flushing)
+		for key, rollup := range pending {
+			service.merge(key, rollup)
+		}
+		service.mu.Unlock()
+
+		return Error.Wrap(err)
+	}
+
+	// the flushed egress is no longer pending, so the cached monthly egress
+	// has to be reloaded from the database.
+	service.mu.Lock()
+	service.releaseFlushing(flushing)
+	for key := range pending {
+		delete(service.egress, key.head)
+	}
+	service.flushes++
+	service.mu.Unlock()
+
+	return nil
+}
+
+// releaseFlushing removes the egress of a finished flush from the egress
+// being flushed. The caller must hold the lock.
+func (service *Service) releaseFlushing(flushing map[string]int64) {
+	for head, egress := range flushing {
+		service.flushingEgress[head] -= egress
+		if service.flushingEgress[head] == 0 {
+			delete(service.flushingEgress, head)
+		}
+	}
+}
+
+// merge adds the rollup to the pending usage. The caller must hold the lock.
+func (service *Service) merge(key rollupKey, rollup *console.APIKeyUsageRollup) {
+	existing, ok := service.pending[key]
+	if !ok {
+		service.pending[key] = rollup
+	} else {
+		existing.Requests += rollup.Requests
+		existing.Egress += rollup.Egress
+	}
+
+	if rollup.Egress != 0 {
+		service.pendingEgress[key.head] += rollup.Egress
+	}
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(now func() time.Time) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.nowFn = now
+}
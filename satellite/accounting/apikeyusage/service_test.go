@@ -0,0 +1,220 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apikeyusage_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/accounting/apikeyusage"
+	"storj.io/storj/satellite/console"
+)
+
+type usageDB struct {
+	mu      sync.Mutex
+	rollups []console.APIKeyUsageRollup
+	fail    bool
+}
+
+func (db *usageDB) AddUsage(ctx context.Context, rollups []console.APIKeyUsageRollup) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.fail {
+		return errs.New("database unavailable")
+	}
+	db.rollups = append(db.rollups, rollups...)
+	return nil
+}
+
+func (db *usageDB) GetEgress(ctx context.Context, head []byte, since time.Time) (egress int64, err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, rollup := range db.rollups {
+		if string(rollup.Head) == string(head) && !rollup.IntervalStart.Before(since) {
+			egress += rollup.Egress
+		}
+	}
+	return egress, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := &usageDB{}
+	service := apikeyusage.NewService(zaptest.NewLogger(t), db, apikeyusage.Config{
+		FlushInterval:   time.Hour,
+		CacheExpiration: time.Hour,
+	})
+
+	now := time.Date(2021, time.May, 12, 10, 30, 0, 0, time.UTC)
+	service.SetNow(func() time.Time { return now })
+
+	bandwidthLimit := int64(1000)
+	key := &console.APIKeyInfo{
+		ID:             testrand.UUID(),
+		ProjectID:      testrand.UUID(),
+		Head:           testrand.Bytes(32),
+		BandwidthLimit: &bandwidthLimit,
+	}
+	unlimited := &console.APIKeyInfo{
+		ID:        testrand.UUID(),
+		ProjectID: key.ProjectID,
+		Head:      testrand.Bytes(32),
+	}
+
+	service.AddRequest(ctx, key)
+	service.AddRequest(ctx, key)
+	service.AddEgress(ctx, key, 600)
+	service.AddRequest(ctx, unlimited)
+	service.AddEgress(ctx, unlimited, 5000)
+
+	exceeded, err := service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exceeded)
+
+	exceeded, err = service.ExceedsBandwidthLimit(ctx, unlimited)
+	require.NoError(t, err)
+	require.False(t, exceeded)
+
+	require.NoError(t, service.Flush(ctx))
+	require.Len(t, db.rollups, 2)
+	for _, rollup := range db.rollups {
+		require.Equal(t, key.ProjectID, rollup.ProjectID)
+		require.Equal(t, time.Date(2021, time.May, 12, 10, 0, 0, 0, time.UTC), rollup.IntervalStart)
+		if string(rollup.Head) == string(key.Head) {
+			require.EqualValues(t, 2, rollup.Requests)
+			require.EqualValues(t, 600, rollup.Egress)
+		} else {
+			require.EqualValues(t, 1, rollup.Requests)
+			require.EqualValues(t, 5000, rollup.Egress)
+		}
+	}
+
+	// the flushed egress and the pending egress are both counted
+	service.AddEgress(ctx, key, 400)
+	exceeded, err = service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.True(t, exceeded)
+
+	// the limit is reset at the start of the next month
+	now = time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, service.Flush(ctx))
+	exceeded, err = service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exceeded)
+}
+
+func TestServiceFlushFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := &usageDB{}
+	service := apikeyusage.NewService(zaptest.NewLogger(t), db, apikeyusage.Config{
+		FlushInterval:   time.Hour,
+		CacheExpiration: time.Hour,
+	})
+
+	now := time.Date(2021, time.May, 12, 10, 30, 0, 0, time.UTC)
+	service.SetNow(func() time.Time { return now })
+
+	bandwidthLimit := int64(1000)
+	key := &console.APIKeyInfo{
+		ID:             testrand.UUID(),
+		ProjectID:      testrand.UUID(),
+		Head:           testrand.Bytes(32),
+		BandwidthLimit: &bandwidthLimit,
+	}
+
+	service.AddRequest(ctx, key)
+	service.AddEgress(ctx, key, 600)
+
+	db.fail = true
+	require.Error(t, service.Flush(ctx))
+
+	// the usage that failed to flush still counts towards the limit
+	service.AddRequest(ctx, key)
+	service.AddEgress(ctx, key, 400)
+	exceeded, err := service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.True(t, exceeded)
+
+	// and is written together with the new usage on the next flush
+	db.fail = false
+	require.NoError(t, service.Flush(ctx))
+	require.Len(t, db.rollups, 1)
+	require.EqualValues(t, 2, db.rollups[0].Requests)
+	require.EqualValues(t, 1000, db.rollups[0].Egress)
+}
+
+// blockingDB blocks adding the usage until it is released.
+type blockingDB struct {
+	*usageDB
+	started chan struct{}
+	release chan struct{}
+}
+
+func (db *blockingDB) AddUsage(ctx context.Context, rollups []console.APIKeyUsageRollup) error {
+	close(db.started)
+	<-db.release
+	return db.usageDB.AddUsage(ctx, rollups)
+}
+
+func TestServiceFlushInProgress(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := &blockingDB{
+		usageDB: &usageDB{},
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	service := apikeyusage.NewService(zaptest.NewLogger(t), db, apikeyusage.Config{
+		FlushInterval:   time.Hour,
+		CacheExpiration: time.Hour,
+	})
+
+	now := time.Date(2021, time.May, 12, 10, 30, 0, 0, time.UTC)
+	service.SetNow(func() time.Time { return now })
+
+	bandwidthLimit := int64(1000)
+	key := &console.APIKeyInfo{
+		ID:             testrand.UUID(),
+		ProjectID:      testrand.UUID(),
+		Head:           testrand.Bytes(32),
+		BandwidthLimit: &bandwidthLimit,
+	}
+
+	// load the monthly egress into the cache
+	exceeded, err := service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exceeded)
+
+	service.AddEgress(ctx, key, 1000)
+
+	flushed := make(chan error, 1)
+	go func() { flushed <- service.Flush(ctx) }()
+	<-db.started
+
+	// the egress being flushed still counts towards the limit
+	exceeded, err = service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.True(t, exceeded)
+
+	close(db.release)
+	require.NoError(t, <-flushed)
+
+	// and is loaded from the database afterwards
+	exceeded, err = service.ExceedsBandwidthLimit(ctx, key)
+	require.NoError(t, err)
+	require.True(t, exceeded)
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	lrucache "storj.io/storj/pkg/cache"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+var (
+	// ErrGetBucketLimit error for getting bucket limits from database.
+	ErrGetBucketLimit = errs.Class("get bucket limits error")
+)
+
+// BucketLimitDB stores information about the limits of buckets.
+//
+// architecture: Database
+type BucketLimitDB interface {
+	// GetBucketLimits returns the limits of a bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (BucketLimits, error)
+}
+
+// BucketLimitCache stores the limits of buckets, so that the limits don't
+// have to be read from the database for every upload and download.
+type BucketLimitCache struct {
+	bucketLimitDB BucketLimitDB
+
+	state *lrucache.ExpiringLRU
+}
+
+// NewBucketLimitCache creates a new bucket limit cache, which uses the same
+// capacity and expiration as the project limit cache.
+func NewBucketLimitCache(db BucketLimitDB, config ProjectLimitConfig) *BucketLimitCache {
+	return &BucketLimitCache{
+		bucketLimitDB: db,
+		state: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+	}
+}
+
+// Get returns the limits of a bucket.
+func (c *BucketLimitCache) Get(ctx context.Context, bucket metabase.BucketLocation) (_ BucketLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fn := func() (interface{}, error) {
+		limits, err := c.bucketLimitDB.GetBucketLimits(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+		if err != nil {
+			return nil, ErrGetBucketLimit.Wrap(err)
+		}
+		return limits, nil
+	}
+	value, err := c.state.Get(bucket.ProjectID.String()+"/"+bucket.BucketName, fn)
+	if err != nil {
+		return BucketLimits{}, err
+	}
+	limits, ok := value.(BucketLimits)
+	if !ok {
+		return BucketLimits{}, ErrProjectLimitType.New("cache Get error")
+	}
+	return limits, nil
+}
@@ -83,6 +83,53 @@ type ProjectLimits struct {
 	Bandwidth *int64
 }
 
+// BucketLimits contains the storage, bandwidth, object count and segment
+// count limits of a bucket. A nil limit means that the bucket is only limited
+// by the limits of its project.
+type BucketLimits struct {
+	Storage   *int64 `json:"storage"`
+	Bandwidth *int64 `json:"bandwidth"`
+	Objects   *int64 `json:"objects"`
+	Segments  *int64 `json:"segments"`
+}
+
+// IsZero returns true when none of the limits is set.
+func (limits BucketLimits) IsZero() bool {
+	return limits.Storage == nil && limits.Bandwidth == nil && limits.Objects == nil && limits.Segments == nil
+}
+
+// HasStorageLimits returns true when the storage, object count or segment
+// count limit is set.
+func (limits BucketLimits) HasStorageLimits() bool {
+	return limits.Storage != nil || limits.Objects != nil || limits.Segments != nil
+}
+
+// BucketStorageUsage contains the storage, object count and segment count of
+// a bucket which are counted against its limits.
+type BucketStorageUsage struct {
+	Storage  int64
+	Objects  int64
+	Segments int64
+}
+
+// Add returns the sum of both usages.
+func (usage BucketStorageUsage) Add(other BucketStorageUsage) BucketStorageUsage {
+	return BucketStorageUsage{
+		Storage:  usage.Storage + other.Storage,
+		Objects:  usage.Objects + other.Objects,
+		Segments: usage.Segments + other.Segments,
+	}
+}
+
+// Sub returns the difference of both usages.
+func (usage BucketStorageUsage) Sub(other BucketStorageUsage) BucketStorageUsage {
+	return BucketStorageUsage{
+		Storage:  usage.Storage - other.Storage,
+		Objects:  usage.Objects - other.Objects,
+		Segments: usage.Segments - other.Segments,
+	}
+}
+
 // BucketUsage consist of total bucket usage for period.
 type BucketUsage struct {
 	ProjectID  uuid.UUID
@@ -185,6 +232,10 @@ type ProjectAccounting interface {
 	GetAllocatedBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error)
 	// GetProjectAllocatedBandwidth returns project allocated bandwidth for the specified year and month.
 	GetProjectAllocatedBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month) (int64, error)
+	// GetBucketStorageUsage returns the bucket storage usage of the most recent tally.
+	GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (BucketStorageUsage, error)
+	// GetBucketAllocatedBandwidth returns bucket allocated bandwidth for the specified year and month.
+	GetBucketAllocatedBandwidth(ctx context.Context, bucket metabase.BucketLocation, year int, month time.Month) (int64, error)
 	// DeleteProjectAllocatedBandwidthBefore deletes project bandwidth rollups before the given time
 	DeleteProjectAllocatedBandwidthBefore(ctx context.Context, before time.Time) error
 
@@ -249,6 +300,20 @@ type Cache interface {
 	AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) error
 	// GetAllProjectTotals return the total projects' storage used space.
 	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]int64, error)
+	// GetBucketStorageUsage returns the bucket's storage usage.
+	GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (BucketStorageUsage, error)
+	// AddBucketStorageUsage adds the increment to the bucket's storage usage.
+	// The bucket is inserted to the increment when it doesn't exist, hence
+	// this method will never return ErrKeyNotFound.
+	AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, increment BucketStorageUsage) error
+	// GetAllBucketTotals returns the storage usage of all the buckets.
+	GetAllBucketTotals(ctx context.Context) (map[metabase.BucketLocation]BucketStorageUsage, error)
+	// GetBucketBandwidthUsage returns the bucket's bandwidth usage.
+	GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error)
+	// UpdateBucketBandwidthUsage updates the bucket's bandwidth usage
+	// increasing it. The bucket is inserted to the increment when it doesn't
+	// exist, hence this method will never return ErrKeyNotFound.
+	UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) error
 	// Close the client, releasing any open resources. Once it's called any other
 	// method must be called.
 	Close() error
@@ -23,8 +23,9 @@ var (
 
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend    string        `help:"what to use for storing real-time accounting data"`
+	StorageBackend    string        `help:"what to use for storing real-time accounting data: redis://..., memory, file:<path>, postgres://... or cockroach://..."`
 	BandwidthCacheTTL time.Duration `default:"5m" help:"bandwidth cache key time to live"`
+	FileFlushInterval time.Duration `default:"1m" help:"how often the file backend writes the real-time accounting data to disk"`
 }
 
 // OpenCache creates a new accounting.Cache instance using the type specified backend in
@@ -49,7 +50,17 @@ func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.
 	switch backendType {
 	case "redis":
 		return openRedisLiveAccounting(ctx, config.StorageBackend)
+	case "memory":
+		return newMemoryLiveAccounting(), nil
+	case "file":
+		var path string
+		if len(parts) > 1 {
+			path = strings.TrimPrefix(parts[1], "//")
+		}
+		return openFileLiveAccounting(log.Named("live-accounting"), path, config.FileFlushInterval)
+	case "postgres", "postgresql", "cockroach":
+		return openDatabaseLiveAccounting(ctx, log.Named("live-accounting"), config.StorageBackend)
 	default:
-		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis is supported", backendType)
+		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently redis, memory, file, postgres and cockroach are supported", backendType)
 	}
 }
@@ -0,0 +1,344 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	_ "github.com/jackc/pgx/v4/stdlib" // registers pgx as a tagsql driver.
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/dbutil"
+	_ "storj.io/storj/private/dbutil/cockroachutil" // registers cockroach as a tagsql driver.
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/private/tagsql"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+// databaseLiveAccounting keeps the live accounting data in a Postgres or
+// CockroachDB database, so that it can be shared by several API processes
+// without running Redis. It can use the satellite database, since its
+// tables don't collide with the satellite tables.
+type databaseLiveAccounting struct {
+	log *zap.Logger
+	db  tagsql.DB
+
+	nowFn func() time.Time
+}
+
+// openDatabaseLiveAccounting returns a databaseLiveAccounting cache instance
+// and creates the tables when they don't exist yet.
+func openDatabaseLiveAccounting(ctx context.Context, log *zap.Logger, address string) (_ *databaseLiveAccounting, err error) {
+	driver, source, implementation, err := dbutil.SplitConnStr(address)
+	if err != nil {
+		return nil, accounting.ErrInvalidArgument.New("address: %w", err)
+	}
+	if implementation != dbutil.Postgres && implementation != dbutil.Cockroach {
+		return nil, accounting.ErrInvalidArgument.New("address: unsupported database %q", driver)
+	}
+
+	db, err := tagsql.Open(ctx, driver, source)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("unable to open database: %w", err)
+	}
+	dbutil.Configure(ctx, db, "live-accounting", mon)
+
+	cache := &databaseLiveAccounting{
+		log:   log,
+		db:    db,
+		nowFn: time.Now,
+	}
+
+	if err := cache.migration().Run(ctx, log.Named("migrate")); err != nil {
+		return nil, errs.Combine(accounting.ErrSystemOrNetError.New("unable to migrate database: %w", err), db.Close())
+	}
+
+	return cache, nil
+}
+
+// migration returns the steps for creating the live accounting tables.
+func (cache *databaseLiveAccounting) migration() *migrate.Migration {
+	return &migrate.Migration{
+		Table: "live_accounting_versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          &cache.db,
+				Description: "initial setup",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE live_accounting_storage (
+						project_id BYTEA NOT NULL,
+						total      INT8  NOT NULL,
+						PRIMARY KEY (project_id)
+					)`,
+					`CREATE TABLE live_accounting_bandwidth (
+						project_id BYTEA       NOT NULL,
+						month      INT4        NOT NULL,
+						used       INT8        NOT NULL,
+						expires_at TIMESTAMPTZ NOT NULL,
+						PRIMARY KEY (project_id, month)
+					)`,
+				},
+			},
+			{
+				DB:          &cache.db,
+				Description: "add bucket usage",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE live_accounting_bucket_storage (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						storage     INT8  NOT NULL,
+						objects     INT8  NOT NULL,
+						segments    INT8  NOT NULL,
+						PRIMARY KEY (project_id, bucket_name)
+					)`,
+					`CREATE TABLE live_accounting_bucket_bandwidth (
+						project_id  BYTEA       NOT NULL,
+						bucket_name BYTEA       NOT NULL,
+						month       INT4        NOT NULL,
+						used        INT8        NOT NULL,
+						expires_at  TIMESTAMPTZ NOT NULL,
+						PRIMARY KEY (project_id, bucket_name, month)
+					)`,
+				},
+			},
+		},
+	}
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *databaseLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT total FROM live_accounting_storage WHERE project_id = $1
+	`, projectID).Scan(&totalUsed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q", projectID)
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	return totalUsed, nil
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *databaseLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT used FROM live_accounting_bandwidth
+		WHERE project_id = $1 AND month = $2 AND expires_at > $3
+	`, projectID, int(now.Month()), cache.nowFn()).Scan(&currentUsed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q", projectID)
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	return currentUsed, nil
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth usage. The expiration
+// is only set when the usage is created, like the redis backend does.
+func (cache *databaseLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	current := cache.nowFn()
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_bandwidth (project_id, month, used, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id, month) DO UPDATE SET
+			used = CASE WHEN live_accounting_bandwidth.expires_at > $5
+				THEN live_accounting_bandwidth.used + EXCLUDED.used
+				ELSE EXCLUDED.used
+			END,
+			expires_at = CASE WHEN live_accounting_bandwidth.expires_at > $5
+				THEN live_accounting_bandwidth.expires_at
+				ELSE EXCLUDED.expires_at
+			END
+	`, projectID, int(now.Month()), increment, current.Add(ttl), current)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+	return nil
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added spaceUsed bytes of storage (from the user's
+// perspective; i.e. segment size).
+func (cache *databaseLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, projectID, spaceUsed)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_storage (project_id, total) VALUES ($1, $2)
+		ON CONFLICT (project_id) DO UPDATE SET total = live_accounting_storage.total + EXCLUDED.total
+	`, projectID, spaceUsed)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+	return nil
+}
+
+// GetAllProjectTotals returns a map of project IDs and totals.
+func (cache *databaseLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT project_id, total FROM live_accounting_storage
+	`)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	projects := make(map[uuid.UUID]int64)
+	for rows.Next() {
+		var projectID uuid.UUID
+		var total int64
+		if err := rows.Scan(&projectID, &total); err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the row: %w", err)
+		}
+		projects[projectID] = total
+	}
+	if err := rows.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	// the expired project and bucket bandwidth usage isn't needed anymore.
+	current := cache.nowFn()
+	_, err = cache.db.ExecContext(ctx, `
+		DELETE FROM live_accounting_bandwidth WHERE expires_at <= $1
+	`, current)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database delete failed: %w", err)
+	}
+	_, err = cache.db.ExecContext(ctx, `
+		DELETE FROM live_accounting_bucket_bandwidth WHERE expires_at <= $1
+	`, current)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database delete failed: %w", err)
+	}
+
+	return projects, nil
+}
+
+// GetBucketStorageUsage returns the bucket's storage usage.
+func (cache *databaseLiveAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (usage accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT storage, objects, segments FROM live_accounting_bucket_storage
+		WHERE project_id = $1 AND bucket_name = $2
+	`, bucket.ProjectID, []byte(bucket.BucketName)).Scan(&usage.Storage, &usage.Objects, &usage.Segments)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accounting.BucketStorageUsage{}, accounting.ErrKeyNotFound.New("%q", bucket.BucketName)
+		}
+		return accounting.BucketStorageUsage{}, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	return usage, nil
+}
+
+// AddBucketStorageUsage adds the increment to the bucket's storage usage.
+func (cache *databaseLiveAccounting) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, increment accounting.BucketStorageUsage) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_bucket_storage AS b (project_id, bucket_name, storage, objects, segments)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			storage  = b.storage + EXCLUDED.storage,
+			objects  = b.objects + EXCLUDED.objects,
+			segments = b.segments + EXCLUDED.segments
+	`, bucket.ProjectID, []byte(bucket.BucketName), increment.Storage, increment.Objects, increment.Segments)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+	return nil
+}
+
+// GetAllBucketTotals returns the storage usage of all the buckets.
+func (cache *databaseLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, storage, objects, segments FROM live_accounting_bucket_storage
+	`)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	buckets := make(map[metabase.BucketLocation]accounting.BucketStorageUsage)
+	for rows.Next() {
+		var bucket metabase.BucketLocation
+		var bucketName []byte
+		var usage accounting.BucketStorageUsage
+		if err := rows.Scan(&bucket.ProjectID, &bucketName, &usage.Storage, &usage.Objects, &usage.Segments); err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the row: %w", err)
+		}
+		bucket.BucketName = string(bucketName)
+		buckets[bucket] = usage
+	}
+	if err := rows.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *databaseLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT used FROM live_accounting_bucket_bandwidth
+		WHERE project_id = $1 AND bucket_name = $2 AND month = $3 AND expires_at > $4
+	`, bucket.ProjectID, []byte(bucket.BucketName), int(now.Month()), cache.nowFn()).Scan(&currentUsed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q", bucket.BucketName)
+		}
+		return 0, accounting.ErrSystemOrNetError.New("database query failed: %w", err)
+	}
+	return currentUsed, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket.
+// The expiration is only set when the usage is created.
+func (cache *databaseLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	current := cache.nowFn()
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting_bucket_bandwidth AS b (project_id, bucket_name, month, used, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (project_id, bucket_name, month) DO UPDATE SET
+			used       = CASE WHEN b.expires_at > $6 THEN b.used + EXCLUDED.used ELSE EXCLUDED.used END,
+			expires_at = CASE WHEN b.expires_at > $6 THEN b.expires_at ELSE EXCLUDED.expires_at END
+	`, bucket.ProjectID, []byte(bucket.BucketName), int(now.Month()), increment, current.Add(ttl), current)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database update failed: %w", err)
+	}
+	return nil
+}
+
+// Close the DB connection.
+func (cache *databaseLiveAccounting) Close() error {
+	err := cache.db.Close()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("database close failed: %w", err)
+	}
+	return nil
+}
@@ -5,5 +5,10 @@
 Package live provides live accounting functionality. That is, it keeps track
 of deltas in the amount of storage used by each project relative to the last
 tally operation (see satellite/accounting/tally).
+
+The data is kept in Redis, in the memory of the process, optionally persisted
+to a local file, or in a Postgres or CockroachDB database. The in-process
+backends are meant for single-binary deployments, where running Redis is
+an unnecessary burden.
 */
 package live
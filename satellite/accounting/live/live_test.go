@@ -20,6 +20,7 @@ import (
 	"storj.io/storj/private/testredis"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/metainfo/metabase"
 )
 
 func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
@@ -29,6 +30,12 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "file",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -43,10 +50,20 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "memory":
+				config = live.Config{
+					StorageBackend: "memory",
+				}
+			case "file":
+				config = live.Config{
+					StorageBackend:    "file:" + ctx.File("live-accounting.json"),
+					FileFlushInterval: time.Minute,
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -103,6 +120,12 @@ func TestGetAllProjectTotals(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "file",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -117,10 +140,20 @@ func TestGetAllProjectTotals(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "memory":
+				config = live.Config{
+					StorageBackend: "memory",
+				}
+			case "file":
+				config = live.Config{
+					StorageBackend:    "file:" + ctx.File("live-accounting.json"),
+					FileFlushInterval: time.Minute,
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -155,6 +188,12 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "file",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -170,10 +209,20 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "memory":
+				config = live.Config{
+					StorageBackend: "memory",
+				}
+			case "file":
+				config = live.Config{
+					StorageBackend:    "file:" + ctx.File("live-accounting.json"),
+					FileFlushInterval: time.Minute,
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -198,6 +247,159 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 	}
 }
 
+func TestBucketUsage(t *testing.T) {
+	tests := []struct {
+		backend string
+	}{
+		{
+			backend: "redis",
+		},
+		{
+			backend: "memory",
+		},
+		{
+			backend: "file",
+		},
+	}
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.backend, func(t *testing.T) {
+			ctx := testcontext.New(t)
+
+			var config live.Config
+			switch tt.backend {
+			case "redis":
+				config = live.Config{
+					StorageBackend: "redis://" + redis.Addr() + "?db=0",
+				}
+			case "memory":
+				config = live.Config{
+					StorageBackend: "memory",
+				}
+			case "file":
+				config = live.Config{
+					StorageBackend:    "file:" + ctx.File("live-accounting.json"),
+					FileFlushInterval: time.Minute,
+				}
+			}
+
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+			require.NoError(t, err)
+			defer ctx.Check(cache.Close)
+
+			var (
+				bucket = metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "testbucket"}
+				other  = metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: "otherbucket"}
+				now    = time.Now()
+			)
+
+			_, err = cache.GetBucketStorageUsage(ctx, bucket)
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+			_, err = cache.GetBucketBandwidthUsage(ctx, bucket, now)
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			require.NoError(t, cache.AddBucketStorageUsage(ctx, bucket, accounting.BucketStorageUsage{
+				Storage: 100, Objects: 3, Segments: 4,
+			}))
+			require.NoError(t, cache.AddBucketStorageUsage(ctx, bucket, accounting.BucketStorageUsage{
+				Storage: 10, Segments: 1,
+			}))
+			require.NoError(t, cache.AddBucketStorageUsage(ctx, other, accounting.BucketStorageUsage{
+				Objects: 1,
+			}))
+			require.NoError(t, cache.UpdateBucketBandwidthUsage(ctx, bucket, 200, time.Hour, now))
+			require.NoError(t, cache.UpdateBucketBandwidthUsage(ctx, bucket, 20, time.Hour, now))
+
+			usage, err := cache.GetBucketStorageUsage(ctx, bucket)
+			require.NoError(t, err)
+			require.Equal(t, accounting.BucketStorageUsage{Storage: 110, Objects: 3, Segments: 5}, usage)
+
+			usage, err = cache.GetBucketStorageUsage(ctx, other)
+			require.NoError(t, err)
+			require.Equal(t, accounting.BucketStorageUsage{Objects: 1}, usage)
+
+			bandwidth, err := cache.GetBucketBandwidthUsage(ctx, bucket, now)
+			require.NoError(t, err)
+			require.EqualValues(t, 220, bandwidth)
+
+			// the bandwidth of the next month is counted separately.
+			_, err = cache.GetBucketBandwidthUsage(ctx, bucket, now.AddDate(0, 1, 0))
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			// a negative increment resets the usage, like the tally does.
+			require.NoError(t, cache.AddBucketStorageUsage(ctx, other, accounting.BucketStorageUsage{
+				Objects: -1,
+			}))
+
+			buckets, err := cache.GetAllBucketTotals(ctx)
+			require.NoError(t, err)
+			require.Equal(t, map[metabase.BucketLocation]accounting.BucketStorageUsage{
+				bucket: {Storage: 110, Objects: 3, Segments: 5},
+				other:  {},
+			}, buckets)
+
+			// bucket usage isn't returned as project totals.
+			projectTotals, err := cache.GetAllProjectTotals(ctx)
+			require.NoError(t, err)
+			require.NotContains(t, projectTotals, bucket.ProjectID)
+		})
+	}
+}
+
+func TestFileLiveAccounting_Persistence(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	config := live.Config{
+		StorageBackend:    "file://" + ctx.File("live-accounting.json"),
+		FileFlushInterval: time.Hour,
+	}
+
+	cache, err := live.OpenCache(ctx, zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+
+	var (
+		projectID = testrand.UUID()
+		now       = time.Now()
+	)
+	require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 100))
+	require.NoError(t, cache.UpdateProjectBandwidthUsage(ctx, projectID, 200, time.Hour, now))
+	bucket := metabase.BucketLocation{ProjectID: projectID, BucketName: "testbucket"}
+	require.NoError(t, cache.AddBucketStorageUsage(ctx, bucket, accounting.BucketStorageUsage{Storage: 300, Objects: 1}))
+	require.NoError(t, cache.UpdateBucketBandwidthUsage(ctx, bucket, 400, time.Hour, now))
+	require.NoError(t, cache.Close())
+
+	cache, err = live.OpenCache(ctx, zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	storageUsed, err := cache.GetProjectStorageUsage(ctx, projectID)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, storageUsed)
+
+	bandwidthUsed, err := cache.GetProjectBandwidthUsage(ctx, projectID, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, bandwidthUsed)
+
+	bucketUsage, err := cache.GetBucketStorageUsage(ctx, bucket)
+	require.NoError(t, err)
+	require.Equal(t, accounting.BucketStorageUsage{Storage: 300, Objects: 1}, bucketUsage)
+
+	bucketBandwidth, err := cache.GetBucketBandwidthUsage(ctx, bucket, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 400, bucketBandwidth)
+
+	_, err = cache.GetProjectStorageUsage(ctx, testrand.UUID())
+	require.True(t, accounting.ErrKeyNotFound.Has(err))
+}
+
 type populateCacheData struct {
 	projectID    uuid.UUID
 	storageSum   int64
@@ -0,0 +1,428 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+// bandwidthKey identifies the bandwidth usage of a project in a month.
+type bandwidthKey struct {
+	projectID uuid.UUID
+	month     time.Month
+}
+
+// bandwidthUsage is the bandwidth used by a project until the usage expires.
+type bandwidthUsage struct {
+	used      int64
+	expiresAt time.Time
+}
+
+// bucketBandwidthKey identifies the bandwidth usage of a bucket in a month.
+type bucketBandwidthKey struct {
+	bucket metabase.BucketLocation
+	month  time.Month
+}
+
+// memoryLiveAccounting keeps the live accounting data in memory. When a path
+// is set, the data is loaded from the file at start and periodically written
+// back to it, so that it survives restarts of the satellite.
+type memoryLiveAccounting struct {
+	log   *zap.Logger
+	path  string
+	loop  *sync2.Cycle
+	group errgroup.Group
+
+	mu              sync.Mutex
+	storage         map[uuid.UUID]int64
+	bandwidth       map[bandwidthKey]bandwidthUsage
+	buckets         map[metabase.BucketLocation]accounting.BucketStorageUsage
+	bucketBandwidth map[bucketBandwidthKey]bandwidthUsage
+	changed         bool
+
+	nowFn func() time.Time
+}
+
+// newMemoryLiveAccounting returns a memoryLiveAccounting cache instance which
+// doesn't persist the data.
+func newMemoryLiveAccounting() *memoryLiveAccounting {
+	return &memoryLiveAccounting{
+		storage:         make(map[uuid.UUID]int64),
+		bandwidth:       make(map[bandwidthKey]bandwidthUsage),
+		buckets:         make(map[metabase.BucketLocation]accounting.BucketStorageUsage),
+		bucketBandwidth: make(map[bucketBandwidthKey]bandwidthUsage),
+		nowFn:           time.Now,
+	}
+}
+
+// openFileLiveAccounting returns a memoryLiveAccounting cache instance which
+// is persisted to the file at path every flushInterval and when it's closed.
+func openFileLiveAccounting(log *zap.Logger, path string, flushInterval time.Duration) (*memoryLiveAccounting, error) {
+	if path == "" {
+		return nil, accounting.ErrInvalidArgument.New("address: a file path has to be specified")
+	}
+
+	cache := newMemoryLiveAccounting()
+	cache.log = log
+	cache.path = path
+
+	if err := cache.load(); err != nil {
+		return nil, err
+	}
+
+	cache.loop = sync2.NewCycle(flushInterval)
+	cache.loop.Start(context.Background(), &cache.group, func(ctx context.Context) error {
+		if err := cache.flush(); err != nil {
+			cache.log.Error("unable to write live accounting to file", zap.String("path", cache.path), zap.Error(err))
+		}
+		return nil
+	})
+
+	return cache, nil
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *memoryLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	totalUsed, ok := cache.storage[projectID]
+	if !ok {
+		return 0, accounting.ErrKeyNotFound.New("%q", projectID)
+	}
+	return totalUsed, nil
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *memoryLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := bandwidthKey{projectID: projectID, month: now.Month()}
+	usage, ok := cache.bandwidth[key]
+	if !ok || !cache.nowFn().Before(usage.expiresAt) {
+		return 0, accounting.ErrKeyNotFound.New("%q", projectID)
+	}
+	return usage.used, nil
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth usage. The expiration
+// is only set when the usage is created, like the redis backend does.
+func (cache *memoryLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	current := cache.nowFn()
+	key := bandwidthKey{projectID: projectID, month: now.Month()}
+	usage, ok := cache.bandwidth[key]
+	if !ok || !current.Before(usage.expiresAt) {
+		usage = bandwidthUsage{expiresAt: current.Add(ttl)}
+	}
+	usage.used += increment
+	cache.bandwidth[key] = usage
+	cache.changed = true
+
+	return nil
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added spaceUsed bytes of storage (from the user's
+// perspective; i.e. segment size).
+func (cache *memoryLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, projectID, spaceUsed)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.storage[projectID] += spaceUsed
+	cache.changed = true
+
+	return nil
+}
+
+// GetAllProjectTotals returns a map of project IDs and totals.
+func (cache *memoryLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	projects := make(map[uuid.UUID]int64, len(cache.storage))
+	for projectID, total := range cache.storage {
+		projects[projectID] = total
+	}
+
+	// drop the expired bandwidth usage while holding the lock anyways.
+	current := cache.nowFn()
+	for key, usage := range cache.bandwidth {
+		if !current.Before(usage.expiresAt) {
+			delete(cache.bandwidth, key)
+		}
+	}
+	for key, usage := range cache.bucketBandwidth {
+		if !current.Before(usage.expiresAt) {
+			delete(cache.bucketBandwidth, key)
+		}
+	}
+
+	return projects, nil
+}
+
+// GetBucketStorageUsage returns the bucket's storage usage.
+func (cache *memoryLiveAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (_ accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	usage, ok := cache.buckets[bucket]
+	if !ok {
+		return accounting.BucketStorageUsage{}, accounting.ErrKeyNotFound.New("%q", bucket.BucketName)
+	}
+	return usage, nil
+}
+
+// AddBucketStorageUsage adds the increment to the bucket's storage usage.
+func (cache *memoryLiveAccounting) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, increment accounting.BucketStorageUsage) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.buckets[bucket] = cache.buckets[bucket].Add(increment)
+	cache.changed = true
+
+	return nil
+}
+
+// GetAllBucketTotals returns the storage usage of all the buckets.
+func (cache *memoryLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	buckets := make(map[metabase.BucketLocation]accounting.BucketStorageUsage, len(cache.buckets))
+	for bucket, usage := range cache.buckets {
+		buckets[bucket] = usage
+	}
+	return buckets, nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *memoryLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := bucketBandwidthKey{bucket: bucket, month: now.Month()}
+	usage, ok := cache.bucketBandwidth[key]
+	if !ok || !cache.nowFn().Before(usage.expiresAt) {
+		return 0, accounting.ErrKeyNotFound.New("%q", bucket.BucketName)
+	}
+	return usage.used, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket.
+// The expiration is only set when the usage is created.
+func (cache *memoryLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	current := cache.nowFn()
+	key := bucketBandwidthKey{bucket: bucket, month: now.Month()}
+	usage, ok := cache.bucketBandwidth[key]
+	if !ok || !current.Before(usage.expiresAt) {
+		usage = bandwidthUsage{expiresAt: current.Add(ttl)}
+	}
+	usage.used += increment
+	cache.bucketBandwidth[key] = usage
+	cache.changed = true
+
+	return nil
+}
+
+// Close writes the data to the file, when the cache is persisted.
+func (cache *memoryLiveAccounting) Close() error {
+	if cache.loop == nil {
+		return nil
+	}
+	cache.loop.Close()
+	return errs.Combine(cache.group.Wait(), cache.flush())
+}
+
+// fileContents is the format of the file of the persisted cache.
+type fileContents struct {
+	Storage         map[uuid.UUID]int64   `json:"storage"`
+	Bandwidth       []fileBandwidth       `json:"bandwidth"`
+	Buckets         []fileBucket          `json:"buckets"`
+	BucketBandwidth []fileBucketBandwidth `json:"bucketBandwidth"`
+}
+
+type fileBandwidth struct {
+	ProjectID uuid.UUID  `json:"projectId"`
+	Month     time.Month `json:"month"`
+	Used      int64      `json:"used"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+}
+
+type fileBucket struct {
+	ProjectID  uuid.UUID `json:"projectId"`
+	BucketName string    `json:"bucketName"`
+	Storage    int64     `json:"storage"`
+	Objects    int64     `json:"objects"`
+	Segments   int64     `json:"segments"`
+}
+
+type fileBucketBandwidth struct {
+	ProjectID  uuid.UUID  `json:"projectId"`
+	BucketName string     `json:"bucketName"`
+	Month      time.Month `json:"month"`
+	Used       int64      `json:"used"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+}
+
+// load reads the data from the file. A missing file is an empty cache.
+func (cache *memoryLiveAccounting) load() error {
+	data, err := ioutil.ReadFile(cache.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return accounting.ErrSystemOrNetError.New("unable to read file: %w", err)
+	}
+
+	var contents fileContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return accounting.ErrUnexpectedValue.New("unable to parse file %q: %w", cache.path, err)
+	}
+
+	for projectID, total := range contents.Storage {
+		cache.storage[projectID] = total
+	}
+	for _, usage := range contents.Bandwidth {
+		cache.bandwidth[bandwidthKey{projectID: usage.ProjectID, month: usage.Month}] = bandwidthUsage{
+			used:      usage.Used,
+			expiresAt: usage.ExpiresAt,
+		}
+	}
+	for _, usage := range contents.Buckets {
+		cache.buckets[metabase.BucketLocation{ProjectID: usage.ProjectID, BucketName: usage.BucketName}] = accounting.BucketStorageUsage{
+			Storage:  usage.Storage,
+			Objects:  usage.Objects,
+			Segments: usage.Segments,
+		}
+	}
+	for _, usage := range contents.BucketBandwidth {
+		bucket := metabase.BucketLocation{ProjectID: usage.ProjectID, BucketName: usage.BucketName}
+		cache.bucketBandwidth[bucketBandwidthKey{bucket: bucket, month: usage.Month}] = bandwidthUsage{
+			used:      usage.Used,
+			expiresAt: usage.ExpiresAt,
+		}
+	}
+	return nil
+}
+
+// flush writes the data to the file when it changed since the last write.
+func (cache *memoryLiveAccounting) flush() (err error) {
+	cache.mu.Lock()
+	if !cache.changed {
+		cache.mu.Unlock()
+		return nil
+	}
+	contents := fileContents{
+		Storage:         make(map[uuid.UUID]int64, len(cache.storage)),
+		Bandwidth:       make([]fileBandwidth, 0, len(cache.bandwidth)),
+		Buckets:         make([]fileBucket, 0, len(cache.buckets)),
+		BucketBandwidth: make([]fileBucketBandwidth, 0, len(cache.bucketBandwidth)),
+	}
+	for projectID, total := range cache.storage {
+		contents.Storage[projectID] = total
+	}
+	for key, usage := range cache.bandwidth {
+		contents.Bandwidth = append(contents.Bandwidth, fileBandwidth{
+			ProjectID: key.projectID,
+			Month:     key.month,
+			Used:      usage.used,
+			ExpiresAt: usage.expiresAt,
+		})
+	}
+	for bucket, usage := range cache.buckets {
+		contents.Buckets = append(contents.Buckets, fileBucket{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Storage:    usage.Storage,
+			Objects:    usage.Objects,
+			Segments:   usage.Segments,
+		})
+	}
+	for key, usage := range cache.bucketBandwidth {
+		contents.BucketBandwidth = append(contents.BucketBandwidth, fileBucketBandwidth{
+			ProjectID:  key.bucket.ProjectID,
+			BucketName: key.bucket.BucketName,
+			Month:      key.month,
+			Used:       usage.used,
+			ExpiresAt:  usage.expiresAt,
+		})
+	}
+	cache.changed = false
+	cache.mu.Unlock()
+
+	defer func() {
+		if err != nil {
+			// make sure the data is written on the next flush.
+			cache.mu.Lock()
+			cache.changed = true
+			cache.mu.Unlock()
+		}
+	}()
+
+	data, err := json.Marshal(contents)
+	if err != nil {
+		return accounting.ErrUnexpectedValue.Wrap(err)
+	}
+
+	// write to a temporary file first, so that a crash doesn't leave a
+	// partially written file behind.
+	tmp, err := ioutil.TempFile(filepath.Dir(cache.path), filepath.Base(cache.path)+".*.tmp")
+	if err != nil {
+		return accounting.ErrSystemOrNetError.Wrap(err)
+	}
+	_, err = tmp.Write(data)
+	err = errs.Combine(err, tmp.Sync(), tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), cache.path)
+	}
+	if err != nil {
+		return accounting.ErrSystemOrNetError.Wrap(errs.Combine(err, os.Remove(tmp.Name())))
+	}
+	return nil
+}
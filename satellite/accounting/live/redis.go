@@ -16,6 +16,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metainfo/metabase"
 )
 
 type redisLiveAccounting struct {
@@ -140,8 +141,8 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 	for it.Next(ctx) {
 		key := it.Val()
 
-		// skip bandwidth keys
-		if strings.HasSuffix(key, "bandwidth") {
+		// skip bandwidth and bucket keys
+		if strings.HasSuffix(key, "bandwidth") || strings.HasSuffix(key, bucketKeySuffix) {
 			continue
 		}
 
@@ -169,6 +170,98 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 	return projects, nil
 }
 
+// GetBucketStorageUsage returns the bucket's storage usage.
+func (cache *redisLiveAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (_ accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.getBucketStorageUsage(ctx, createBucketKey(bucket))
+}
+
+// AddBucketStorageUsage adds the increment to the bucket's storage usage.
+func (cache *redisLiveAccounting) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, increment accounting.BucketStorageUsage) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := createBucketKey(bucket)
+	_, err = cache.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, "storage", increment.Storage)
+		pipe.HIncrBy(ctx, key, "objects", increment.Objects)
+		pipe.HIncrBy(ctx, key, "segments", increment.Segments)
+		return nil
+	})
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis hincrby failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllBucketTotals iterates through the live accounting DB and returns the
+// storage usage of all the buckets.
+func (cache *redisLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets := make(map[metabase.BucketLocation]accounting.BucketStorageUsage)
+	it := cache.client.Scan(ctx, 0, "*"+bucketKeySuffix, 0).Iterator()
+	for it.Next(ctx) {
+		key := it.Val()
+
+		bucket, err := parseBucketKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, seen := buckets[bucket]; seen {
+			continue
+		}
+
+		usage, err := cache.getBucketStorageUsage(ctx, key)
+		if err != nil {
+			if accounting.ErrKeyNotFound.Has(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		buckets[bucket] = usage
+	}
+	if err := it.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Redis scan failed: %w", err)
+	}
+
+	return buckets, nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *redisLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createBucketBandwidthKey(bucket, now))
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the bucket.
+// The key expiration is only set when the key is created.
+func (cache *redisLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	// see UpdateProjectBandwidthUsage for how the expiration is set.
+	script := fmt.Sprintf(`local current
+	current = redis.call("incrby", KEYS[1], "%d")
+	if tonumber(current) == %d then
+		redis.call("expire",KEYS[1], %d)
+	end
+	return current
+	`, increment, increment, int(ttl.Seconds()))
+
+	key := createBucketBandwidthKey(bucket, now)
+	err = cache.client.Eval(ctx, script, []string{key}).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
 // Close the DB connection.
 func (cache *redisLiveAccounting) Close() error {
 	err := cache.client.Close()
@@ -199,6 +292,34 @@ func (cache *redisLiveAccounting) getInt64(ctx context.Context, key string) (_ i
 	return intval, nil
 }
 
+func (cache *redisLiveAccounting) getBucketStorageUsage(ctx context.Context, key string) (_ accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	values, err := cache.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return accounting.BucketStorageUsage{}, accounting.ErrSystemOrNetError.New("Redis hgetall failed: %w", err)
+	}
+	if len(values) == 0 {
+		return accounting.BucketStorageUsage{}, accounting.ErrKeyNotFound.New("%q", key)
+	}
+
+	var usage accounting.BucketStorageUsage
+	for field, value := range map[string]*int64{
+		"storage":  &usage.Storage,
+		"objects":  &usage.Objects,
+		"segments": &usage.Segments,
+	} {
+		if values[field] == "" {
+			continue
+		}
+		*value, err = strconv.ParseInt(values[field], 10, 64)
+		if err != nil {
+			return accounting.BucketStorageUsage{}, accounting.ErrUnexpectedValue.New("cannot parse the value as int64; key=%q field=%q val=%q", key, field, values[field])
+		}
+	}
+	return usage, nil
+}
+
 // createBandwidthProjectIDKey creates the bandwidth project key.
 // The current month is combined with projectID to create a prefix.
 func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
@@ -208,3 +329,37 @@ func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
 
 	return string(key) + ":bandwidth"
 }
+
+// bucketKeySuffix is the suffix of the bucket storage usage keys.
+const bucketKeySuffix = ":bucket"
+
+// createBucketKey creates the bucket storage usage key.
+func createBucketKey(bucket metabase.BucketLocation) string {
+	return string(bucket.ProjectID[:]) + bucket.BucketName + bucketKeySuffix
+}
+
+// parseBucketKey returns the bucket of a bucket storage usage key.
+func parseBucketKey(key string) (metabase.BucketLocation, error) {
+	if len(key) < len(uuid.UUID{})+len(bucketKeySuffix) || !strings.HasSuffix(key, bucketKeySuffix) {
+		return metabase.BucketLocation{}, accounting.ErrUnexpectedValue.New("cannot parse the key as bucket; key=%q", key)
+	}
+
+	projectID, err := uuid.FromBytes([]byte(key[:len(uuid.UUID{})]))
+	if err != nil {
+		return metabase.BucketLocation{}, accounting.ErrUnexpectedValue.New("cannot parse the key as bucket; key=%q", key)
+	}
+
+	return metabase.BucketLocation{
+		ProjectID:  projectID,
+		BucketName: strings.TrimSuffix(key[len(uuid.UUID{}):], bucketKeySuffix),
+	}, nil
+}
+
+// createBucketBandwidthKey creates the bucket bandwidth key. The current
+// month is combined with the projectID to create a prefix.
+func createBucketBandwidthKey(bucket metabase.BucketLocation, now time.Time) string {
+	_, month, _ := now.Date()
+	key := append(bucket.ProjectID[:], byte(int(month)))
+
+	return string(key) + bucket.BucketName + ":bucket:bandwidth"
+}
@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/orders"
 )
 
 var mon = monkit.Package()
@@ -21,25 +22,30 @@ var mon = monkit.Package()
 type Config struct {
 	Interval     time.Duration `help:"how often to remove unused project bandwidth rollups" default:"168h"`
 	RetainMonths int           `help:"number of months of project bandwidth rollups to retain, not including the current month" default:"2"`
+
+	SettlementOutcomesRetention time.Duration `help:"how long to retain the order settlement outcomes of storage nodes, zero retains them forever" default:"2160h"`
 }
 
-// Chore to remove unused project bandwidth rollups.
+// Chore to remove unused project bandwidth rollups and old order settlement
+// outcomes of storage nodes.
 //
 // architecture: Chore
 type Chore struct {
 	log    *zap.Logger
 	db     accounting.ProjectAccounting
+	orders orders.DB
 	config Config
 
 	Loop *sync2.Cycle
 }
 
 // NewChore creates new chore for removing unused project bandwidth rollups.
-func NewChore(log *zap.Logger, db accounting.ProjectAccounting, config Config) *Chore {
+func NewChore(log *zap.Logger, db accounting.ProjectAccounting, orders orders.DB, config Config) *Chore {
 
 	return &Chore{
 		log:    log,
 		db:     db,
+		orders: orders,
 		config: config,
 
 		Loop: sync2.NewCycle(config.Interval),
@@ -58,7 +64,7 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 	})
 }
 
-// RunOnce removes unused project bandwidth rollups.
+// RunOnce removes unused project bandwidth rollups and old settlement outcomes.
 func (chore *Chore) RunOnce(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -69,7 +75,17 @@ func (chore *Chore) RunOnce(ctx context.Context) (err error) {
 	now := time.Now().UTC()
 	beforeMonth := time.Date(now.Year(), now.Month()-time.Month(chore.config.RetainMonths), 1, 0, 0, 0, 0, time.UTC)
 
-	return chore.db.DeleteProjectAllocatedBandwidthBefore(ctx, beforeMonth)
+	err = chore.db.DeleteProjectAllocatedBandwidthBefore(ctx, beforeMonth)
+
+	if chore.config.SettlementOutcomesRetention > 0 {
+		deleted, deleteErr := chore.orders.DeleteSettlementOutcomesBefore(ctx, now.Add(-chore.config.SettlementOutcomesRetention))
+		if deleteErr != nil {
+			return errs.Combine(err, deleteErr)
+		}
+		mon.IntVal("settlement_outcomes_deleted").Observe(deleted)
+	}
+
+	return err
 }
 
 // Close stops the chore.
@@ -15,6 +15,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/orders"
 )
 
 var testBytes int64 = 5000
@@ -85,3 +86,41 @@ func testProjectAllocatedBandwidthRetain(t *testing.T, retain int) {
 		}
 	})
 }
+
+func TestSettlementOutcomesRetain(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.ProjectBWCleanup.SettlementOutcomesRetention = 24 * time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Accounting.ProjectBWCleanup.Loop.Pause()
+		ordersDB := satellite.DB.Orders()
+
+		nodeID := testrand.NodeID()
+		now := time.Now().UTC().Truncate(time.Hour)
+
+		for _, settledAt := range []time.Time{now.Add(-48 * time.Hour), now} {
+			err := ordersDB.InsertSettlementOutcome(ctx, orders.SettlementOutcome{
+				StorageNodeID:  nodeID,
+				IntervalStart:  settledAt.Add(-time.Hour),
+				SettledAt:      settledAt,
+				Status:         pb.SettlementWithWindowResponse_ACCEPTED,
+				OrdersReceived: 1,
+				OrdersAccepted: 1,
+				AmountSettled:  testBytes,
+			})
+			require.NoError(t, err)
+		}
+
+		satellite.Accounting.ProjectBWCleanup.Loop.TriggerWait()
+
+		outcomes, err := ordersDB.GetSettlementOutcomes(ctx, nodeID, now.Add(-72*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, outcomes, 1)
+		require.Equal(t, now, outcomes[0].SettledAt)
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metainfo/metabase"
 )
 
 var mon = monkit.Package()
@@ -29,16 +30,18 @@ type Service struct {
 	projectAccountingDB ProjectAccounting
 	liveAccounting      Cache
 	projectLimitCache   *ProjectLimitCache
+	bucketLimitCache    *BucketLimitCache
 	bandwidthCacheTTL   time.Duration
 	nowFn               func() time.Time
 }
 
 // NewService created new instance of project usage service.
-func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, bandwidthCacheTTL time.Duration) *Service {
+func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, bucketLimitCache *BucketLimitCache, bandwidthCacheTTL time.Duration) *Service {
 	return &Service{
 		projectAccountingDB: projectAccountingDB,
 		liveAccounting:      liveAccounting,
 		projectLimitCache:   limitCache,
+		bucketLimitCache:    bucketLimitCache,
 		bandwidthCacheTTL:   bandwidthCacheTTL,
 		nowFn:               time.Now,
 	}
@@ -219,6 +222,145 @@ func (usage *Service) AddProjectStorageUsage(ctx context.Context, projectID uuid
 	return usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, spaceUsed)
 }
 
+// ExceedsBucketUploadLimits returns true if the storage or the segment count
+// of a bucket is currently over the bucket's limits. The object count is only
+// checked when a new object is going to be created. The name of the exceeded
+// limit is returned for logging.
+func (usage *Service) ExceedsBucketUploadLimits(ctx context.Context, bucket metabase.BucketLocation, newObject bool) (_ bool, limitName string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return false, "", ErrProjectUsage.Wrap(err)
+	}
+	if !limits.HasStorageLimits() {
+		return false, "", nil
+	}
+
+	current, err := usage.getBucketStorageUsage(ctx, bucket)
+	if err != nil {
+		return false, "", ErrProjectUsage.Wrap(err)
+	}
+
+	switch {
+	case limits.Storage != nil && current.Storage >= *limits.Storage:
+		return true, "storage", nil
+	case limits.Segments != nil && current.Segments >= *limits.Segments:
+		return true, "segments", nil
+	case newObject && limits.Objects != nil && current.Objects >= *limits.Objects:
+		return true, "objects", nil
+	}
+	return false, "", nil
+}
+
+// ExceedsBucketBandwidthUsage returns true if the bandwidth usage of a bucket
+// in the current month is over the bucket's bandwidth limit.
+func (usage *Service) ExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return false, 0, nil
+	}
+
+	// Get the current bandwidth usage from cache.
+	now := usage.nowFn()
+	bandwidthUsage, err := usage.liveAccounting.GetBucketBandwidthUsage(ctx, bucket, now)
+	if err != nil {
+		if !ErrKeyNotFound.Has(err) {
+			return false, 0, ErrProjectUsage.Wrap(err)
+		}
+
+		// Get current bandwidth value from database.
+		bandwidthUsage, err = usage.projectAccountingDB.GetBucketAllocatedBandwidth(ctx, bucket, now.Year(), now.Month())
+		if err != nil {
+			return false, 0, ErrProjectUsage.Wrap(err)
+		}
+
+		// Create cache key with database value.
+		err = usage.liveAccounting.UpdateBucketBandwidthUsage(ctx, bucket, bandwidthUsage, usage.bandwidthCacheTTL, now)
+		if err != nil {
+			return false, 0, ErrProjectUsage.Wrap(err)
+		}
+	}
+
+	limit = memory.Size(*limits.Bandwidth)
+	return bandwidthUsage >= limit.Int64(), limit, nil
+}
+
+// AddBucketStorageUsage lets the live accounting know that the given bucket
+// has just used more storage, objects or segments. The usage is only tracked
+// for buckets which have storage, object or segment limits.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.AddBucketStorageUsage, wrapped by
+// ErrProjectUsage.
+func (usage *Service) AddBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, increment BucketStorageUsage) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return ErrProjectUsage.Wrap(err)
+	}
+	if !limits.HasStorageLimits() {
+		return nil
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.AddBucketStorageUsage(ctx, bucket, increment))
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth usage of the given
+// bucket. The usage is only tracked for buckets which have a bandwidth limit.
+//
+// It can return one of the following errors returned by
+// storj.io/storj/satellite/accounting.Cache.UpdateBucketBandwidthUsage,
+// wrapped by ErrProjectUsage.
+func (usage *Service) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.Get(ctx, bucket)
+	if err != nil {
+		return ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return nil
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.UpdateBucketBandwidthUsage(ctx, bucket, increment, usage.bandwidthCacheTTL, usage.nowFn()))
+}
+
+// getBucketStorageUsage returns the storage usage of a bucket from the live
+// accounting cache. The cache contains the usage of the most recent tally and
+// the usage added since then, and it's reset by every tally. When the bucket
+// isn't cached, it's added to the cache with the usage of the most recent
+// tally, so that the usage added by the following uploads is counted on top
+// of it.
+func (usage *Service) getBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (_ BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current, err := usage.liveAccounting.GetBucketStorageUsage(ctx, bucket)
+	if err == nil {
+		return current, nil
+	}
+	if !ErrKeyNotFound.Has(err) {
+		return BucketStorageUsage{}, err
+	}
+
+	current, err = usage.projectAccountingDB.GetBucketStorageUsage(ctx, bucket)
+	if err != nil {
+		return BucketStorageUsage{}, err
+	}
+
+	err = usage.liveAccounting.AddBucketStorageUsage(ctx, bucket, current)
+	if err != nil {
+		return BucketStorageUsage{}, err
+	}
+	return current, nil
+}
+
 // SetNow allows tests to have the Service act as if the current time is whatever they want.
 func (usage *Service) SetNow(now func() time.Time) {
 	usage.nowFn = now
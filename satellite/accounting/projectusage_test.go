@@ -730,3 +730,45 @@ func TestProjectUsage_BandwidthDownloadLimit(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestProjectUsage_BucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		project := planet.Uplinks[0].Projects[0]
+
+		err := planet.Uplinks[0].CreateBucket(ctx, sat, "testbucket")
+		require.NoError(t, err)
+
+		objects, bandwidth := int64(1), int64(200*memory.KiB)
+		err = sat.DB.Buckets().UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, accounting.BucketLimits{
+			Objects:   &objects,
+			Bandwidth: &bandwidth,
+		})
+		require.NoError(t, err)
+
+		data := testrand.Bytes(100 * memory.KiB)
+
+		err = planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path1", data)
+		require.NoError(t, err)
+
+		// the object limit is reached, so a second object is rejected
+		err = planet.Uplinks[0].Upload(ctx, sat, "testbucket", "test/path2", data)
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		// a download exceeds the bandwidth limit after the first one
+		_, err = planet.Uplinks[0].Download(ctx, sat, "testbucket", "test/path1")
+		require.NoError(t, err)
+		_, err = planet.Uplinks[0].Download(ctx, sat, "testbucket", "test/path1")
+		require.NoError(t, err)
+		_, err = planet.Uplinks[0].Download(ctx, sat, "testbucket", "test/path1")
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		// other buckets of the project are not affected
+		err = planet.Uplinks[0].Upload(ctx, sat, "otherbucket", "test/path2", data)
+		require.NoError(t, err)
+	})
+}
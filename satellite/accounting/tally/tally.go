@@ -104,6 +104,9 @@ func (service *Service) SetNow(now func() time.Time) {
 // totals. For the reason we make an assumption that 50% of the data is
 // accounted for. So to calculate the new live accounting totals, we sum the
 // metainfo totals and 50% of the deltas.
+//
+// The storage usage of the buckets with limits is calculated in the same way,
+// only for the buckets which are already in the live accounting cache.
 func (service *Service) Tally(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -165,6 +168,68 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		}
 	}
 
+	// No-op unless that there isn't an error getting the
+	// liveAccounting.GetAllBucketTotals
+	updateLiveBucketTotals := func(_ map[metabase.BucketLocation]*accounting.BucketTally) {}
+
+	initialBucketTotals, err := service.liveAccounting.GetAllBucketTotals(ctx)
+	if err != nil {
+		service.log.Error(
+			"tally won't update the live accounting storage usages of the buckets in this cycle",
+			zap.Error(err),
+		)
+	} else {
+		updateLiveBucketTotals = func(tallies map[metabase.BucketLocation]*accounting.BucketTally) {
+			latestBucketTotals, err := service.liveAccounting.GetAllBucketTotals(ctx)
+			if err != nil {
+				service.log.Error(
+					"tally isn't updating the live accounting storage usages of the buckets in this cycle",
+					zap.Error(err),
+				)
+				return
+			}
+
+			for bucket, latestTotal := range latestBucketTotals {
+				initialTotal, ok := initialBucketTotals[bucket]
+				if !ok {
+					// the bucket was added to the cache with the usage of the
+					// previous tally during the metainfo loop, so it's updated
+					// by the next tally.
+					continue
+				}
+
+				var tallyTotal accounting.BucketStorageUsage
+				if tally, ok := tallies[bucket]; ok {
+					tallyTotal = accounting.BucketStorageUsage{
+						Storage:  tally.Bytes(),
+						Objects:  tally.ObjectCount,
+						Segments: tally.Segments(),
+					}
+				}
+
+				// read the method documentation why the increase passed to this method
+				// is calculated in this way
+				increment := tallyTotal.Sub(latestTotal).Add(halfDelta(latestTotal, initialTotal))
+				err = service.liveAccounting.AddBucketStorageUsage(ctx, bucket, increment)
+				if err != nil {
+					if accounting.ErrSystemOrNetError.Has(err) {
+						service.log.Error(
+							"tally isn't updating the live accounting storage usages of the buckets in this cycle",
+							zap.Error(err),
+						)
+						return
+					}
+
+					service.log.Error(
+						"tally isn't updating the live accounting storage usage of the bucket in this cycle",
+						zap.Error(err),
+						zap.String("projectID", bucket.ProjectID.String()),
+					)
+				}
+			}
+		}
+	}
+
 	// Fetch when the last tally happened so we can roughly calculate the byte-hours.
 	lastTime, err := service.storagenodeAccountingDB.LastTimestamp(ctx, accounting.LastAtRestTally)
 	if err != nil {
@@ -208,6 +273,7 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		}
 
 		updateLiveAccountingTotals(projectTotalsFromBuckets(observer.Bucket))
+		updateLiveBucketTotals(observer.Bucket)
 	}
 
 	// report bucket metrics
@@ -339,5 +405,23 @@ func projectTotalsFromBuckets(buckets map[metabase.BucketLocation]*accounting.Bu
 	return projectTallyTotals
 }
 
+// halfDelta returns half of the bucket storage usage which was added between
+// initial and latest. Usage which was removed isn't counted.
+func halfDelta(latest, initial accounting.BucketStorageUsage) accounting.BucketStorageUsage {
+	half := func(delta int64) int64 {
+		if delta < 0 {
+			return 0
+		}
+		return delta / 2
+	}
+
+	delta := latest.Sub(initial)
+	return accounting.BucketStorageUsage{
+		Storage:  half(delta.Storage),
+		Objects:  half(delta.Objects),
+		Segments: half(delta.Segments),
+	}
+}
+
 // using custom name to avoid breaking monitoring.
 var monAccounting = monkit.ScopeNamed("storj.io/storj/satellite/accounting")
@@ -286,6 +286,67 @@ func TestTallyLiveAccounting(t *testing.T) {
 	})
 }
 
+func TestTallyBucketLiveAccounting(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		tally := sat.Accounting.Tally
+		tally.Loop.Pause()
+
+		projectID := planet.Uplinks[0].Projects[0].ID
+		bucket := metabase.BucketLocation{ProjectID: projectID, BucketName: "testbucket"}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, sat, bucket.BucketName)
+		require.NoError(t, err)
+
+		objects := int64(100)
+		err = sat.DB.Buckets().UpdateBucketLimits(ctx, []byte(bucket.BucketName), projectID, accounting.BucketLimits{
+			Objects: &objects,
+		})
+		require.NoError(t, err)
+
+		data := testrand.Bytes(5 * memory.KiB)
+		for i := 0; i < 2; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, bucket.BucketName, fmt.Sprintf("test/path/%d", i), data)
+			require.NoError(t, err)
+		}
+
+		// the usage is counted since the bucket was added to the cache.
+		usage, err := sat.LiveAccounting.Cache.GetBucketStorageUsage(ctx, bucket)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, usage.Objects)
+		require.EqualValues(t, 2, usage.Segments)
+
+		// the tally replaces the usage, so that it's not counted twice.
+		tally.Loop.TriggerWait()
+
+		usage, err = sat.LiveAccounting.Cache.GetBucketStorageUsage(ctx, bucket)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, usage.Objects)
+		require.EqualValues(t, 2, usage.Segments)
+
+		err = planet.Uplinks[0].Upload(ctx, sat, bucket.BucketName, "test/path/2", data)
+		require.NoError(t, err)
+
+		usage, err = sat.LiveAccounting.Cache.GetBucketStorageUsage(ctx, bucket)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, usage.Objects)
+		require.EqualValues(t, 3, usage.Segments)
+
+		// deleted objects are removed by the tally.
+		err = planet.Uplinks[0].DeleteObject(ctx, sat, bucket.BucketName, "test/path/0")
+		require.NoError(t, err)
+
+		tally.Loop.TriggerWait()
+
+		usage, err = sat.LiveAccounting.Cache.GetBucketStorageUsage(ctx, bucket)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, usage.Objects)
+		require.EqualValues(t, 2, usage.Segments)
+	})
+}
+
 func TestTallyEmptyProjectUpdatesLiveAccounting(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 2,
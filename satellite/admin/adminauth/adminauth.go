@@ -0,0 +1,185 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package adminauth implements the named, scoped tokens of the admin api and
+// the audit log of the requests made with them.
+//
+// Tokens are granted scopes, which limit the mutating requests allowed with
+// the token. Every token is allowed to read. Only the hash of the secret of a
+// token is stored, so the secret is only shown when the token is created.
+package adminauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// Error is the default error class for admin authentication.
+var Error = errs.Class("admin auth")
+
+// secretPrefix is the prefix of the token secrets, which makes them recognizable.
+const secretPrefix = "adm_"
+
+// Scope is a group of mutating admin api requests, which a token can be granted.
+type Scope string
+
+const (
+	// ScopeReadOnly grants no mutating requests. It's the scope of tokens, which are only allowed to read.
+	ScopeReadOnly Scope = "read-only"
+	// ScopeUsers grants managing users, their projects and api keys.
+	ScopeUsers Scope = "users"
+	// ScopeBilling grants managing coupons.
+	ScopeBilling Scope = "billing"
+	// ScopeLimits grants changing project and bucket limits.
+	ScopeLimits Scope = "limits"
+)
+
+// Scopes lists all scopes.
+var Scopes = []Scope{ScopeReadOnly, ScopeUsers, ScopeBilling, ScopeLimits}
+
+// ParseScopes parses a comma separated list of scopes.
+func ParseScopes(value string) ([]Scope, error) {
+	var scopes []Scope
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		scope, err := ParseScope(name)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	if len(scopes) == 0 {
+		return nil, Error.New("no scopes")
+	}
+	return scopes, nil
+}
+
+// ParseScope parses the name of a scope.
+func ParseScope(name string) (Scope, error) {
+	for _, scope := range Scopes {
+		if strings.EqualFold(string(scope), name) {
+			return scope, nil
+		}
+	}
+	return "", Error.New("invalid scope %q", name)
+}
+
+// FormatScopes formats scopes as a comma separated list.
+func FormatScopes(scopes []Scope) string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return strings.Join(names, ",")
+}
+
+// Token is a named admin api token.
+type Token struct {
+	ID        uuid.UUID
+	Name      string
+	Scopes    []Scope
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+// Allows returns whether requests of the scope are allowed with the token.
+func (token *Token) Allows(scope Scope) bool {
+	if scope == ScopeReadOnly {
+		return true
+	}
+	for _, granted := range token.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid returns whether the token can be used at the time.
+func (token *Token) Valid(now time.Time) bool {
+	if token.RevokedAt != nil {
+		return false
+	}
+	return token.ExpiresAt == nil || now.Before(*token.ExpiresAt)
+}
+
+// NewSecret generates a new token secret and returns it together with its hash.
+func NewSecret() (secret string, hash []byte, err error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", nil, Error.Wrap(err)
+	}
+
+	secret = secretPrefix + base64.RawURLEncoding.EncodeToString(b[:])
+	return secret, HashSecret(secret), nil
+}
+
+// HashSecret returns the hash of a token secret, which is stored instead of the secret.
+func HashSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
+
+// AuditEntry is a mutating admin api request in the audit log.
+type AuditEntry struct {
+	ID        int64
+	CreatedAt time.Time
+	// Actor is the name of the token used for the request.
+	Actor string
+	// TokenID is nil for the static authorization token.
+	TokenID   *uuid.UUID
+	Method    string
+	Path      string
+	UserEmail string
+	ProjectID *uuid.UUID
+	Details   string
+	// StatusCode is 0 until the request is served.
+	StatusCode int
+}
+
+// AuditFilter selects entries of the audit log. Empty fields match all entries.
+type AuditFilter struct {
+	Actor     string
+	UserEmail string
+	ProjectID *uuid.UUID
+	Since     time.Time
+	Before    time.Time
+	Limit     int
+}
+
+// DB stores the admin tokens and the audit log.
+//
+// architecture: Database
+type DB interface {
+	// CreateToken stores a new token with the hash of its secret.
+	CreateToken(ctx context.Context, token Token, secretHash []byte) error
+	// GetTokenBySecretHash returns the token with the hash of the secret.
+	GetTokenBySecretHash(ctx context.Context, secretHash []byte) (Token, error)
+	// ListTokens returns all tokens ordered by name.
+	ListTokens(ctx context.Context) ([]Token, error)
+	// RevokeToken revokes the token, so that it can't be used anymore.
+	// It returns sql.ErrNoRows, when there is no unrevoked token with the id.
+	RevokeToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+
+	// AppendAuditEntry appends an entry to the audit log and returns its id.
+	// Entries are never deleted and only their status code is ever set.
+	AppendAuditEntry(ctx context.Context, entry AuditEntry) (id int64, err error)
+	// SetAuditStatus sets the status code of an entry appended before its
+	// request was served. The status code of an entry is only set once.
+	SetAuditStatus(ctx context.Context, id int64, statusCode int) error
+	// ListAuditEntries returns the entries of the audit log matching the filter, newest first.
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package adminauth_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin/adminauth"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestTokens(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		tokens := db.AdminAuth()
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		expiresAt := now.Add(time.Hour)
+
+		secret, hash, err := adminauth.NewSecret()
+		require.NoError(t, err)
+		require.Equal(t, hash, adminauth.HashSecret(secret))
+
+		token := adminauth.Token{
+			ID:        testrand.UUID(),
+			Name:      "support",
+			Scopes:    []adminauth.Scope{adminauth.ScopeUsers, adminauth.ScopeLimits},
+			CreatedAt: now,
+			ExpiresAt: &expiresAt,
+		}
+		require.NoError(t, tokens.CreateToken(ctx, token, hash))
+
+		// names are unique
+		_, otherHash, err := adminauth.NewSecret()
+		require.NoError(t, err)
+		require.Error(t, tokens.CreateToken(ctx, adminauth.Token{ID: testrand.UUID(), Name: "support", Scopes: token.Scopes}, otherHash))
+
+		got, err := tokens.GetTokenBySecretHash(ctx, hash)
+		require.NoError(t, err)
+		require.Equal(t, token.ID, got.ID)
+		require.Equal(t, token.Scopes, got.Scopes)
+		require.WithinDuration(t, expiresAt, *got.ExpiresAt, time.Microsecond)
+		require.True(t, got.Valid(now))
+		require.False(t, got.Valid(expiresAt))
+		require.True(t, got.Allows(adminauth.ScopeReadOnly))
+		require.True(t, got.Allows(adminauth.ScopeLimits))
+		require.False(t, got.Allows(adminauth.ScopeBilling))
+
+		_, err = tokens.GetTokenBySecretHash(ctx, otherHash)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		require.NoError(t, tokens.RevokeToken(ctx, token.ID, now))
+		require.ErrorIs(t, tokens.RevokeToken(ctx, token.ID, now), sql.ErrNoRows)
+
+		list, err := tokens.ListTokens(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.NotNil(t, list[0].RevokedAt)
+		require.False(t, list[0].Valid(now))
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		log := db.AdminAuth()
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		tokenID := testrand.UUID()
+		projectID := testrand.UUID()
+
+		entries := []adminauth.AuditEntry{
+			{
+				CreatedAt:  now.Add(-2 * time.Hour),
+				Actor:      "root",
+				Method:     "POST",
+				Path:       "/api/user",
+				UserEmail:  "alice@mail.test",
+				Details:    `{"body":{"email":"alice@mail.test","password":"[redacted]"}}`,
+				StatusCode: 200,
+			},
+			{
+				CreatedAt:  now.Add(-time.Hour),
+				Actor:      "support",
+				TokenID:    &tokenID,
+				Method:     "PUT",
+				Path:       "/api/project/{project}/limit",
+				ProjectID:  &projectID,
+				Details:    `{"query":"usage=1GB"}`,
+				StatusCode: 200,
+			},
+			{
+				CreatedAt:  now,
+				Actor:      "support",
+				TokenID:    &tokenID,
+				Method:     "DELETE",
+				Path:       "/api/user/{useremail}",
+				UserEmail:  "alice@mail.test",
+				StatusCode: 403,
+			},
+		}
+		for _, entry := range entries {
+			_, err := log.AppendAuditEntry(ctx, entry)
+			require.NoError(t, err)
+		}
+
+		all, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		require.Equal(t, "DELETE", all[0].Method)
+		require.Equal(t, "POST", all[2].Method)
+		require.Nil(t, all[2].TokenID)
+		require.Equal(t, entries[2].Details, all[0].Details)
+
+		byActor, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{Actor: "support"})
+		require.NoError(t, err)
+		require.Len(t, byActor, 2)
+		require.Equal(t, tokenID, *byActor[0].TokenID)
+
+		byUser, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{UserEmail: "alice@mail.test", Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, byUser, 1)
+		require.Equal(t, "DELETE", byUser[0].Method)
+
+		byProject, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{ProjectID: &projectID})
+		require.NoError(t, err)
+		require.Len(t, byProject, 1)
+		require.Equal(t, projectID, *byProject[0].ProjectID)
+		require.Equal(t, `{"query":"usage=1GB"}`, byProject[0].Details)
+
+		between, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{
+			Since:  now.Add(-90 * time.Minute),
+			Before: now,
+		})
+		require.NoError(t, err)
+		require.Len(t, between, 1)
+		require.Equal(t, "PUT", between[0].Method)
+
+		// the entry is appended before the request is served
+		id, err := log.AppendAuditEntry(ctx, adminauth.AuditEntry{
+			CreatedAt: now.Add(time.Hour),
+			Actor:     "root",
+			Method:    "DELETE",
+			Path:      "/api/project/{project}",
+			ProjectID: &projectID,
+		})
+		require.NoError(t, err)
+
+		pending, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, id, pending[0].ID)
+		require.Zero(t, pending[0].StatusCode)
+
+		// the status code is only set once
+		require.NoError(t, log.SetAuditStatus(ctx, id, 500))
+		require.NoError(t, log.SetAuditStatus(ctx, id, 200))
+
+		served, err := log.ListAuditEntries(ctx, adminauth.AuditFilter{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, served, 1)
+		require.Equal(t, 500, served[0].StatusCode)
+	})
+}
@@ -0,0 +1,322 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/adminauth"
+)
+
+type adminToken struct {
+	ID        uuid.UUID         `json:"id"`
+	Name      string            `json:"name"`
+	Scopes    []adminauth.Scope `json:"scopes"`
+	CreatedAt time.Time         `json:"createdAt"`
+	ExpiresAt *time.Time        `json:"expiresAt,omitempty"`
+	RevokedAt *time.Time        `json:"revokedAt,omitempty"`
+	// Secret is only returned when the token is created.
+	Secret string `json:"secret,omitempty"`
+}
+
+func newAdminToken(token adminauth.Token) adminToken {
+	return adminToken{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+		RevokedAt: token.RevokedAt,
+	}
+}
+
+// addAdminToken creates a named admin token with scopes.
+func (server *Server) addAdminToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		httpJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Name      string   `json:"name"`
+		Scopes    []string `json:"scopes"`
+		ExpiresIn string   `json:"expiresIn"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		httpJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.Name == "" {
+		httpJSONError(w, "Name is not set",
+			"", http.StatusBadRequest)
+		return
+	}
+	if input.Name == rootActor {
+		httpJSONError(w, "Name is reserved",
+			"", http.StatusBadRequest)
+		return
+	}
+	if len(input.Scopes) == 0 {
+		httpJSONError(w, "Scopes are not set",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	scopes := make([]adminauth.Scope, 0, len(input.Scopes))
+	for _, name := range input.Scopes {
+		scope, err := adminauth.ParseScope(name)
+		if err != nil {
+			httpJSONError(w, "invalid scope",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		scopes = append(scopes, scope)
+	}
+
+	now := server.nowFn()
+	token := adminauth.Token{
+		Name:      input.Name,
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+
+	if input.ExpiresIn != "" {
+		expiresIn, err := time.ParseDuration(input.ExpiresIn)
+		if err != nil || expiresIn <= 0 {
+			httpJSONError(w, "invalid expiresIn",
+				"expected a positive duration, e.g. 720h", http.StatusBadRequest)
+			return
+		}
+		expiresAt := now.Add(expiresIn)
+		token.ExpiresAt = &expiresAt
+	}
+
+	existing, err := server.db.AdminAuth().ListTokens(ctx)
+	if err != nil {
+		httpJSONError(w, "failed to list tokens",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, other := range existing {
+		if other.Name == token.Name {
+			httpJSONError(w, "token with the name already exists",
+				"", http.StatusConflict)
+			return
+		}
+	}
+
+	token.ID, err = uuid.New()
+	if err != nil {
+		httpJSONError(w, "failed to generate token id",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	secret, hash, err := adminauth.NewSecret()
+	if err != nil {
+		httpJSONError(w, "failed to generate token secret",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = server.db.AdminAuth().CreateToken(ctx, token, hash)
+	if err != nil {
+		httpJSONError(w, "failed to create token",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := newAdminToken(token)
+	output.Secret = secret
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// listAdminTokens lists the admin tokens, including the expired and revoked ones.
+func (server *Server) listAdminTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tokens, err := server.db.AdminAuth().ListTokens(ctx)
+	if err != nil {
+		httpJSONError(w, "failed to list tokens",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]adminToken, 0, len(tokens))
+	for _, token := range tokens {
+		output = append(output, newAdminToken(token))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// revokeAdminToken revokes an admin token, so that it can't be used anymore.
+func (server *Server) revokeAdminToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tokenIDString, ok := mux.Vars(r)["tokenid"]
+	if !ok {
+		httpJSONError(w, "token-id missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	tokenID, err := uuid.FromString(tokenIDString)
+	if err != nil {
+		httpJSONError(w, "invalid token-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.AdminAuth().RevokeToken(ctx, tokenID, server.nowFn())
+	if errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, "token does not exist or is already revoked",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "failed to revoke token",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+type auditEntry struct {
+	ID         int64           `json:"id"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	Actor      string          `json:"actor"`
+	TokenID    *uuid.UUID      `json:"tokenId,omitempty"`
+	Method     string          `json:"method"`
+	Path       string          `json:"path"`
+	UserEmail  string          `json:"userEmail,omitempty"`
+	ProjectID  *uuid.UUID      `json:"projectId,omitempty"`
+	Details    json.RawMessage `json:"details,omitempty"`
+	StatusCode int             `json:"statusCode"`
+}
+
+// listAuditEntries returns the audit log of the mutating admin api requests, newest first.
+func (server *Server) listAuditEntries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	filter := adminauth.AuditFilter{
+		Actor:     query.Get("actor"),
+		UserEmail: query.Get("user"),
+	}
+
+	if projectString := query.Get("project"); projectString != "" {
+		projectID, err := uuid.FromString(projectString)
+		if err != nil {
+			httpJSONError(w, "invalid project-uuid",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		filter.ProjectID = &projectID
+	}
+
+	var err error
+	if sinceString := query.Get("since"); sinceString != "" {
+		filter.Since, err = parseAuditTime(sinceString)
+		if err != nil {
+			httpJSONError(w, "invalid since",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if beforeString := query.Get("before"); beforeString != "" {
+		filter.Before, err = parseAuditTime(beforeString)
+		if err != nil {
+			httpJSONError(w, "invalid before",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if limitString := query.Get("limit"); limitString != "" {
+		filter.Limit, err = strconv.Atoi(limitString)
+		if err != nil || filter.Limit <= 0 {
+			httpJSONError(w, "invalid limit",
+				"expected a positive number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	entries, err := server.db.AdminAuth().ListAuditEntries(ctx, filter)
+	if err != nil {
+		httpJSONError(w, "failed to list audit log",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]auditEntry, 0, len(entries))
+	for _, entry := range entries {
+		var details json.RawMessage
+		if json.Valid([]byte(entry.Details)) {
+			details = json.RawMessage(entry.Details)
+		}
+		output = append(output, auditEntry{
+			ID:         entry.ID,
+			CreatedAt:  entry.CreatedAt,
+			Actor:      entry.Actor,
+			TokenID:    entry.TokenID,
+			Method:     entry.Method,
+			Path:       entry.Path,
+			UserEmail:  entry.UserEmail,
+			ProjectID:  entry.ProjectID,
+			Details:    details,
+			StatusCode: entry.StatusCode,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// parseAuditTime parses a RFC3339 time or a YYYY-MM-DD date.
+func parseAuditTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
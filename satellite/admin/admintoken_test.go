@@ -0,0 +1,139 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestAdminTokens(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		root := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		// do sends the request and returns the status code and the body of the response.
+		do := func(method, path, authorization, body string) (int, []byte) {
+			req, err := http.NewRequest(method, "http://"+address.String()+path, strings.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", authorization)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			responseBody, err := ioutil.ReadAll(response.Body)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			return response.StatusCode, responseBody
+		}
+
+		var token struct {
+			ID     string   `json:"id"`
+			Name   string   `json:"name"`
+			Scopes []string `json:"scopes"`
+			Secret string   `json:"secret"`
+		}
+		status, body := do(http.MethodPost, "/api/admintoken", root, `{"name":"support","scopes":["limits"],"expiresIn":"24h"}`)
+		require.Equal(t, http.StatusOK, status, string(body))
+		require.NoError(t, json.Unmarshal(body, &token))
+		require.Equal(t, "support", token.Name)
+		require.Equal(t, []string{"limits"}, token.Scopes)
+		require.NotEmpty(t, token.Secret)
+
+		// names are unique
+		status, body = do(http.MethodPost, "/api/admintoken", root, `{"name":"support","scopes":["users"]}`)
+		require.Equal(t, http.StatusConflict, status, string(body))
+
+		// only the static authorization token manages tokens
+		status, body = do(http.MethodGet, "/api/admintoken", token.Secret, "")
+		require.Equal(t, http.StatusForbidden, status, string(body))
+
+		limitPath := "/api/project/" + projectID.String() + "/limit"
+
+		// tokens are allowed to read
+		status, body = do(http.MethodGet, limitPath, token.Secret, "")
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		// and to make the requests of their scopes
+		status, body = do(http.MethodPut, limitPath+"?usage=1GB", token.Secret, "")
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		// but not the requests of other scopes
+		status, body = do(http.MethodPut, "/api/project/"+projectID.String()+"?access_token=t0k3n", token.Secret,
+			`{"projectName":"renamed","keys":[{"name":"key","api_key_secret":"s3cr3t"}],"owner":{"Password":"p4ssw0rd"}}`)
+		require.Equal(t, http.StatusForbidden, status, string(body))
+
+		var entries []struct {
+			Actor      string `json:"actor"`
+			TokenID    string `json:"tokenId"`
+			Method     string `json:"method"`
+			Path       string `json:"path"`
+			ProjectID  string `json:"projectId"`
+			StatusCode int    `json:"statusCode"`
+			Details    struct {
+				Query string          `json:"query"`
+				Body  json.RawMessage `json:"body"`
+			} `json:"details"`
+		}
+		status, body = do(http.MethodGet, "/api/audit?actor=support", token.Secret, "")
+		require.Equal(t, http.StatusOK, status, string(body))
+		require.NoError(t, json.Unmarshal(body, &entries))
+		require.Len(t, entries, 2)
+
+		require.Equal(t, http.MethodPut, entries[0].Method)
+		require.Equal(t, "/api/project/{project}", entries[0].Path)
+		require.Equal(t, http.StatusForbidden, entries[0].StatusCode)
+
+		// secrets are redacted, also in nested fields
+		require.Equal(t, "access_token=%5Bredacted%5D", entries[0].Details.Query)
+		require.JSONEq(t,
+			`{"projectName":"renamed","keys":[{"name":"key","api_key_secret":"[redacted]"}],"owner":{"Password":"[redacted]"}}`,
+			string(entries[0].Details.Body))
+
+		require.Equal(t, "support", entries[1].Actor)
+		require.Equal(t, token.ID, entries[1].TokenID)
+		require.Equal(t, "/api/project/{project}/limit", entries[1].Path)
+		require.Equal(t, projectID.String(), entries[1].ProjectID)
+		require.Equal(t, "usage=1GB", entries[1].Details.Query)
+		require.Equal(t, http.StatusOK, entries[1].StatusCode)
+
+		// the token creation is recorded for the static authorization token
+		status, body = do(http.MethodGet, "/api/audit?actor=root&limit=1", root, "")
+		require.Equal(t, http.StatusOK, status, string(body))
+		require.NoError(t, json.Unmarshal(body, &entries))
+		require.Len(t, entries, 1)
+		require.Equal(t, "/api/admintoken", entries[0].Path)
+		require.Equal(t, http.StatusConflict, entries[0].StatusCode)
+
+		// revoked tokens aren't allowed anything
+		status, body = do(http.MethodDelete, "/api/admintoken/"+token.ID, root, "")
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		status, body = do(http.MethodGet, limitPath, token.Secret, "")
+		require.Equal(t, http.StatusForbidden, status, string(body))
+
+		status, body = do(http.MethodDelete, "/api/admintoken/"+token.ID, root, "")
+		require.Equal(t, http.StatusNotFound, status, string(body))
+	})
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/adminauth"
+)
+
+const (
+	// rootActor is the name of the actor using the static authorization token.
+	rootActor = "root"
+
+	// scopeTokens is the scope of managing the admin tokens. It can't be
+	// granted to a token, so only the static authorization token has it.
+	scopeTokens adminauth.Scope = "tokens"
+
+	// maxAuditBody is the maximum size of a request body recorded in the audit log.
+	maxAuditBody = 64 << 10
+
+	// redacted replaces secrets recorded in the audit log.
+	redacted = "[redacted]"
+)
+
+// actor is the caller of an admin api request.
+type actor struct {
+	name string
+	// token is nil for the static authorization token.
+	token *adminauth.Token
+}
+
+// allows returns whether requests of the scope are allowed for the actor.
+func (actor *actor) allows(scope adminauth.Scope) bool {
+	if actor.token == nil {
+		return true
+	}
+	return actor.token.Allows(scope)
+}
+
+type actorKey struct{}
+
+// withActor returns a context with the actor of the request.
+func withActor(ctx context.Context, actor *actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// getActor returns the actor of the request.
+func getActor(ctx context.Context) *actor {
+	actor, _ := ctx.Value(actorKey{}).(*actor)
+	return actor
+}
+
+// withScope returns a handler, which only serves the request when the actor
+// is allowed requests of the scope. Requests other than GET are recorded in
+// the audit log, including the denied ones. The entry is appended before the
+// request is served and the request fails, when it can't be appended.
+func (server *Server) withScope(scope adminauth.Scope, handler http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		actor := getActor(ctx)
+		if actor == nil {
+			httpJSONError(w, "Forbidden",
+				"", http.StatusForbidden)
+			return
+		}
+
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			if !actor.allows(scope) {
+				httpJSONError(w, "Forbidden",
+					"token isn't granted the scope "+string(scope), http.StatusForbidden)
+				return
+			}
+			handler(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			httpJSONError(w, "failed to read body",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		entry := server.auditEntry(r, actor, body)
+		allowed := actor.allows(scope)
+		if !allowed {
+			entry.StatusCode = http.StatusForbidden
+		}
+
+		entryID, err := server.db.AdminAuth().AppendAuditEntry(ctx, entry)
+		if err != nil {
+			server.log.Error("failed to append to the audit log",
+				zap.String("actor", entry.Actor),
+				zap.String("method", entry.Method),
+				zap.String("path", r.URL.Path),
+				zap.Error(err))
+			httpJSONError(w, "failed to record the request in the audit log",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if !allowed {
+			httpJSONError(w, "Forbidden",
+				"token isn't granted the scope "+string(scope), http.StatusForbidden)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler(recorder, r)
+
+		// the request was served, so the entry only misses the outcome, when this fails.
+		err = server.db.AdminAuth().SetAuditStatus(ctx, entryID, recorder.status)
+		if err != nil {
+			server.log.Error("failed to set the status code in the audit log",
+				zap.Int64("entry", entryID),
+				zap.Int("status", recorder.status),
+				zap.Error(err))
+		}
+	})
+}
+
+// auditEntry returns the audit log entry of the request.
+func (server *Server) auditEntry(r *http.Request, actor *actor, body []byte) adminauth.AuditEntry {
+	vars := mux.Vars(r)
+
+	entry := adminauth.AuditEntry{
+		CreatedAt: server.nowFn(),
+		Actor:     actor.name,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		UserEmail: vars["useremail"],
+	}
+	if actor.token != nil {
+		entry.TokenID = &actor.token.ID
+	}
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			entry.Path = template
+		}
+	}
+	if projectID, err := uuid.FromString(vars["project"]); err == nil {
+		entry.ProjectID = &projectID
+	}
+
+	details := struct {
+		Vars  map[string]string `json:"vars,omitempty"`
+		Query string            `json:"query,omitempty"`
+		Body  interface{}       `json:"body,omitempty"`
+	}{
+		Vars:  map[string]string{},
+		Query: r.URL.RawQuery,
+	}
+	for name, value := range vars {
+		if isSecretField(name) {
+			value = redacted
+		}
+		details.Vars[name] = value
+	}
+	if query, err := url.ParseQuery(r.URL.RawQuery); err == nil {
+		secrets := false
+		for name := range query {
+			if isSecretField(name) {
+				query.Set(name, redacted)
+				secrets = true
+			}
+		}
+		if secrets {
+			details.Query = query.Encode()
+		}
+	}
+
+	if len(body) > maxAuditBody {
+		details.Body = "request body of " + strconv.Itoa(len(body)) + " bytes isn't recorded"
+	} else if len(body) > 0 {
+		var decoded interface{}
+		if err := json.Unmarshal(body, &decoded); err == nil {
+			// the user created by the request isn't in the path.
+			if fields, ok := decoded.(map[string]interface{}); ok {
+				if email, ok := fields["email"].(string); ok && entry.UserEmail == "" {
+					entry.UserEmail = email
+				}
+			}
+			details.Body = redactSecrets(decoded)
+		} else {
+			details.Body = string(body)
+		}
+	}
+
+	data, err := json.Marshal(details)
+	if err != nil {
+		server.log.Error("failed to encode audit details", zap.Error(err))
+	}
+	entry.Details = string(data)
+
+	return entry
+}
+
+// secretFields lists the parts of field names, whose values are secrets and
+// therefore aren't recorded in the audit log. Names are compared in lower case
+// without dashes and underscores, e.g. "api_key_secret" contains "secret".
+var secretFields = []string{"password", "passphrase", "secret", "token", "apikey", "accessgrant"}
+
+// isSecretField returns whether the value of the field is a secret.
+func isSecretField(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+	for _, secret := range secretFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactSecrets replaces the values of secret fields in the decoded json
+// value, including the fields of nested objects and arrays.
+func redactSecrets(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			if isSecretField(name) {
+				value[name] = redacted
+			} else {
+				value[name] = redactSecrets(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactSecrets(item)
+		}
+	}
+	return value
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code and writes it to the response.
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+)
+
+type bucketLimitsInfo struct {
+	Storage struct {
+		Amount *memory.Size `json:"amount"`
+		Bytes  *int64       `json:"bytes"`
+	} `json:"storage"`
+	Bandwidth struct {
+		Amount *memory.Size `json:"amount"`
+		Bytes  *int64       `json:"bytes"`
+	} `json:"bandwidth"`
+	Objects  *int64 `json:"objects"`
+	Segments *int64 `json:"segments"`
+}
+
+func (server *Server) getBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	limits, ok := server.fetchBucketLimits(ctx, w, projectUUID, bucket)
+	if !ok {
+		return
+	}
+
+	var output bucketLimitsInfo
+	if limits.Storage != nil {
+		amount := memory.Size(*limits.Storage)
+		output.Storage.Amount = &amount
+		output.Storage.Bytes = limits.Storage
+	}
+	if limits.Bandwidth != nil {
+		amount := memory.Size(*limits.Bandwidth)
+		output.Bandwidth.Amount = &amount
+		output.Bandwidth.Bytes = limits.Bandwidth
+	}
+	output.Objects = limits.Objects
+	output.Segments = limits.Segments
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// putBucketLimits updates the limits of a bucket. Only the given limits are
+// changed and a negative value removes the limit.
+func (server *Server) putBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, bucket, ok := bucketFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	var arguments struct {
+		Storage   *memory.Size `schema:"storage"`
+		Bandwidth *memory.Size `schema:"bandwidth"`
+		Objects   *int64       `schema:"objects"`
+		Segments  *int64       `schema:"segments"`
+	}
+
+	if err := r.ParseForm(); err != nil {
+		httpJSONError(w, "invalid form",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	decoder := schema.NewDecoder()
+	err := decoder.Decode(&arguments, r.Form)
+	if err != nil {
+		httpJSONError(w, "invalid arguments",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	limits, ok := server.fetchBucketLimits(ctx, w, projectUUID, bucket)
+	if !ok {
+		return
+	}
+
+	if arguments.Storage != nil {
+		limits.Storage = bucketLimit(arguments.Storage.Int64())
+	}
+	if arguments.Bandwidth != nil {
+		limits.Bandwidth = bucketLimit(arguments.Bandwidth.Int64())
+	}
+	if arguments.Objects != nil {
+		limits.Objects = bucketLimit(*arguments.Objects)
+	}
+	if arguments.Segments != nil {
+		limits.Segments = bucketLimit(*arguments.Segments)
+	}
+
+	err = server.db.Buckets().UpdateBucketLimits(ctx, []byte(bucket), projectUUID, limits)
+	if err != nil {
+		httpJSONError(w, "failed to update bucket limits",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// fetchBucketLimits returns the limits of the bucket or writes the error
+// response.
+func (server *Server) fetchBucketLimits(ctx context.Context, w http.ResponseWriter, projectUUID uuid.UUID, bucket string) (_ accounting.BucketLimits, ok bool) {
+	limits, err := server.db.Buckets().GetBucketLimits(ctx, []byte(bucket), projectUUID)
+	if storj.ErrBucketNotFound.Has(err) {
+		httpJSONError(w, "bucket does not exist",
+			"", http.StatusNotFound)
+		return accounting.BucketLimits{}, false
+	}
+	if err != nil {
+		httpJSONError(w, "unable to fetch bucket limits",
+			err.Error(), http.StatusInternalServerError)
+		return accounting.BucketLimits{}, false
+	}
+	return limits, true
+}
+
+// bucketLimit returns the limit to store, negative values remove the limit.
+func bucketLimit(value int64) *int64 {
+	if value < 0 {
+		return nil
+	}
+	return &value
+}
+
+func bucketFromVars(w http.ResponseWriter, r *http.Request) (projectUUID uuid.UUID, bucket string, ok bool) {
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		httpJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, "", false
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, "", false
+	}
+
+	bucket, ok = vars["bucket"]
+	if !ok {
+		httpJSONError(w, "bucket name missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, "", false
+	}
+
+	return projectUUID, bucket, true
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/repair/durability"
+)
+
+type durabilityReport struct {
+	ComputedAt *time.Time            `json:"computedAt"`
+	Histograms []durabilityHistogram `json:"histograms"`
+}
+
+type durabilityHistogram struct {
+	Redundancy struct {
+		Required int16 `json:"required"`
+		Repair   int16 `json:"repair"`
+		Optimal  int16 `json:"optimal"`
+		Total    int16 `json:"total"`
+	} `json:"redundancy"`
+	HealthyCounts []int64 `json:"healthyCounts"`
+	Lost          int64   `json:"lost"`
+	NeedsRepair   int64   `json:"needsRepair"`
+	BelowOptimal  int64   `json:"belowOptimal"`
+	Healthy       int64   `json:"healthy"`
+}
+
+// getDurability returns the durability histograms of a project or a bucket
+// computed by the last checker loop.
+func (server *Server) getDurability(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		httpJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "unable to fetch project details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	bucket := vars["bucket"]
+	if bucket != "" {
+		_, err = server.db.Buckets().GetBucket(ctx, []byte(bucket), projectUUID)
+		if storj.ErrBucketNotFound.Has(err) {
+			httpJSONError(w, "bucket does not exist",
+				"", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			httpJSONError(w, "unable to fetch bucket",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	report, err := durability.GetReport(ctx, server.db.DurabilityHistograms(), projectUUID, bucket)
+	if err != nil {
+		httpJSONError(w, "unable to fetch durability histograms",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := durabilityReport{
+		Histograms: []durabilityHistogram{},
+	}
+	if !report.ComputedAt.IsZero() {
+		output.ComputedAt = &report.ComputedAt
+	}
+	for _, histogram := range report.Histograms {
+		summary := histogram.Summary()
+
+		var item durabilityHistogram
+		item.Redundancy.Required = histogram.Redundancy.RequiredShares
+		item.Redundancy.Repair = histogram.Redundancy.RepairShares
+		item.Redundancy.Optimal = histogram.Redundancy.OptimalShares
+		item.Redundancy.Total = histogram.Redundancy.TotalShares
+		item.HealthyCounts = histogram.HealthyCounts
+		item.Lost = summary.Lost
+		item.NeedsRepair = summary.NeedsRepair
+		item.BelowOptimal = summary.BelowOptimal
+		item.Healthy = summary.Healthy
+		output.Histograms = append(output.Histograms, item)
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/repair/durability"
+)
+
+func TestDurability(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+		address := sat.Admin.Admin.Listener.Addr().String()
+
+		sat.Repair.Checker.Loop.Pause()
+
+		err := planet.Uplinks[0].CreateBucket(ctx, sat, "bucket")
+		require.NoError(t, err)
+
+		link := fmt.Sprintf("http://%s/api/project/%s/durability", address, projectID)
+		bucketLink := fmt.Sprintf("http://%s/api/project/%s/bucket/bucket/durability", address, projectID)
+
+		t.Run("Not computed", func(t *testing.T) {
+			assertGet(t, link, `{"computedAt":null,"histograms":[]}`, authToken)
+		})
+
+		computedAt := time.Date(2021, 5, 4, 8, 28, 24, 0, time.UTC)
+		err = sat.DB.DurabilityHistograms().Replace(ctx, []durability.Histogram{
+			{
+				ProjectID:     projectID,
+				BucketName:    "bucket",
+				Redundancy:    durability.Redundancy{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 5},
+				HealthyCounts: []int64{0, 1, 2, 0, 15, 82},
+			},
+		}, computedAt)
+		require.NoError(t, err)
+
+		expected := `{"computedAt":"2021-05-04T08:28:24Z","histograms":[{` +
+			`"redundancy":{"required":2,"repair":3,"optimal":4,"total":5},` +
+			`"healthyCounts":[0,1,2,0,15,82],"lost":1,"needsRepair":2,"belowOptimal":0,"healthy":97}]}`
+
+		t.Run("Project", func(t *testing.T) {
+			assertGet(t, link, expected, authToken)
+		})
+
+		t.Run("Bucket", func(t *testing.T) {
+			assertGet(t, bucketLink, expected, authToken)
+		})
+
+		t.Run("Missing", func(t *testing.T) {
+			for _, url := range []string{
+				fmt.Sprintf("http://%s/api/project/%s/durability", address, testrand.UUID()),
+				fmt.Sprintf("http://%s/api/project/%s/bucket/missing/durability", address, projectID),
+			} {
+				req, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(t, err)
+				req.Header.Set("Authorization", authToken)
+
+				response, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusNotFound, response.StatusCode)
+				require.NoError(t, response.Body.Close())
+			}
+		})
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/schema"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+type objectLockInfo struct {
+	Version   int64 `json:"version"`
+	LegalHold bool  `json:"legalHold"`
+	Retention struct {
+		Mode        string     `json:"mode"`
+		RetainUntil *time.Time `json:"retainUntil"`
+	} `json:"retention"`
+}
+
+func (server *Server) getObjectLock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	location, ok := objectLocationFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	var arguments struct {
+		Version int64 `schema:"version"`
+	}
+	if !decodeObjectLockArguments(w, r, &arguments) {
+		return
+	}
+
+	object, ok := server.getLockedObject(ctx, w, location, metabase.Version(arguments.Version))
+	if !ok {
+		return
+	}
+
+	var output objectLockInfo
+	output.Version = int64(object.Version)
+	output.LegalHold = object.LegalHold
+	output.Retention.Mode = object.Retention.Mode.String()
+	output.Retention.RetainUntil = object.Retention.RetainUntil
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
+
+// liftObjectLock lifts the legal hold and the retention in governance mode of
+// an object. It requires an explicit governance override, retention in
+// compliance mode cannot be lifted.
+func (server *Server) liftObjectLock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	location, ok := objectLocationFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	var arguments struct {
+		Version            int64 `schema:"version"`
+		GovernanceOverride bool  `schema:"governanceOverride"`
+	}
+	if !decodeObjectLockArguments(w, r, &arguments) {
+		return
+	}
+
+	if !arguments.GovernanceOverride {
+		httpJSONError(w, "governance override is required to lift object lock",
+			"", http.StatusForbidden)
+		return
+	}
+
+	object, ok := server.getLockedObject(ctx, w, location, metabase.Version(arguments.Version))
+	if !ok {
+		return
+	}
+
+	if object.Retention.Mode == metabase.ComplianceMode && object.Retention.Active(time.Now()) {
+		httpJSONError(w, "retention in compliance mode cannot be lifted",
+			object.Retention.RetainUntil.String(), http.StatusConflict)
+		return
+	}
+
+	if object.Retention.Mode != metabase.NoRetention {
+		err := server.metabaseDB.SetObjectRetention(ctx, metabase.SetObjectRetention{
+			ObjectLocation:   location,
+			Version:          object.Version,
+			BypassGovernance: true,
+		})
+		if err != nil {
+			httpJSONError(w, "unable to lift retention",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if object.LegalHold {
+		err := server.metabaseDB.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+			ObjectLocation: location,
+			Version:        object.Version,
+			Enabled:        false,
+		})
+		if err != nil {
+			httpJSONError(w, "unable to lift legal hold",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	server.log.Info("object lock lifted with governance override",
+		zap.Stringer("Project ID", location.ProjectID),
+		zap.String("Bucket", location.BucketName),
+		zap.Int64("Version", int64(object.Version)),
+		zap.Stringer("Retention Mode", object.Retention.Mode),
+		zap.Bool("Legal Hold", object.LegalHold),
+	)
+}
+
+// getLockedObject returns the specified object version, or the latest one
+// when version is zero.
+func (server *Server) getLockedObject(ctx context.Context, w http.ResponseWriter, location metabase.ObjectLocation, version metabase.Version) (_ metabase.Object, ok bool) {
+	var object metabase.Object
+	var err error
+	if version == 0 {
+		object, err = server.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: location,
+		})
+	} else {
+		object, err = server.metabaseDB.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+			ObjectLocation: location,
+			Version:        version,
+		})
+	}
+	if err != nil {
+		switch {
+		case storj.ErrObjectNotFound.Has(err):
+			httpJSONError(w, "object not found",
+				"", http.StatusNotFound)
+		case metabase.ErrInvalidRequest.Has(err):
+			httpJSONError(w, "invalid request",
+				err.Error(), http.StatusBadRequest)
+		default:
+			httpJSONError(w, "unable to get object",
+				err.Error(), http.StatusInternalServerError)
+		}
+		return metabase.Object{}, false
+	}
+	return object, true
+}
+
+// objectLocationFromVars parses the object location from the request path.
+// The encrypted object key is expected to be encoded with URL safe base64.
+func objectLocationFromVars(w http.ResponseWriter, r *http.Request) (_ metabase.ObjectLocation, ok bool) {
+	vars := mux.Vars(r)
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		httpJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return metabase.ObjectLocation{}, false
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		httpJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return metabase.ObjectLocation{}, false
+	}
+
+	bucket, ok := vars["bucket"]
+	if !ok {
+		httpJSONError(w, "bucket name missing",
+			"", http.StatusBadRequest)
+		return metabase.ObjectLocation{}, false
+	}
+
+	encodedKey, ok := vars["key"]
+	if !ok {
+		httpJSONError(w, "object key missing",
+			"", http.StatusBadRequest)
+		return metabase.ObjectLocation{}, false
+	}
+
+	key, err := base64.URLEncoding.DecodeString(encodedKey)
+	if err != nil {
+		httpJSONError(w, "invalid object key",
+			err.Error(), http.StatusBadRequest)
+		return metabase.ObjectLocation{}, false
+	}
+
+	return metabase.ObjectLocation{
+		ProjectID:  projectUUID,
+		BucketName: bucket,
+		ObjectKey:  metabase.ObjectKey(key),
+	}, true
+}
+
+func decodeObjectLockArguments(w http.ResponseWriter, r *http.Request, arguments interface{}) bool {
+	if err := r.ParseForm(); err != nil {
+		httpJSONError(w, "invalid form",
+			err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	decoder := schema.NewDecoder()
+	if err := decoder.Decode(arguments, r.Form); err != nil {
+		httpJSONError(w, "invalid arguments",
+			err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestObjectLock(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 4,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "object", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := sat.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "bucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		location := metabase.ObjectLocation{
+			ProjectID:  projectID,
+			BucketName: "bucket",
+			ObjectKey:  objects[0].ObjectKey,
+		}
+
+		retainUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		err = sat.Metainfo.Metabase.SetObjectRetention(ctx, metabase.SetObjectRetention{
+			ObjectLocation: location,
+			Version:        objects[0].Version,
+			Retention:      metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &retainUntil},
+		})
+		require.NoError(t, err)
+
+		err = sat.Metainfo.Metabase.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+			ObjectLocation: location,
+			Version:        objects[0].Version,
+			Enabled:        true,
+		})
+		require.NoError(t, err)
+
+		link := fmt.Sprintf("http://%s/api/project/%s/bucket/bucket/object/%s/lock",
+			sat.Admin.Admin.Listener.Addr().String(), projectID,
+			base64.URLEncoding.EncodeToString([]byte(objects[0].ObjectKey)))
+
+		t.Run("Get", func(t *testing.T) {
+			object, err := sat.Metainfo.Metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+				ObjectLocation: location,
+				Version:        objects[0].Version,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, object.Retention.RetainUntil)
+
+			expected := fmt.Sprintf(`{"version":%d,"legalHold":true,"retention":{"mode":"governance","retainUntil":"%s"}}`,
+				objects[0].Version, object.Retention.RetainUntil.Format(time.RFC3339Nano))
+			assertGet(t, link, expected, authToken)
+		})
+
+		t.Run("Lift without override", func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, link, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", authToken)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusForbidden, response.StatusCode)
+			require.NoError(t, response.Body.Close())
+		})
+
+		t.Run("Lift with override", func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, link+"?governanceOverride=true", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", authToken)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, response.StatusCode)
+			require.NoError(t, response.Body.Close())
+
+			expected := fmt.Sprintf(`{"version":%d,"legalHold":false,"retention":{"mode":"none","retainUntil":null}}`, objects[0].Version)
+			assertGet(t, link, expected, authToken)
+		})
+
+		t.Run("Compliance mode", func(t *testing.T) {
+			err = sat.Metainfo.Metabase.SetObjectRetention(ctx, metabase.SetObjectRetention{
+				ObjectLocation: location,
+				Version:        objects[0].Version,
+				Retention:      metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: &retainUntil},
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodDelete, link+"?governanceOverride=true", nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", authToken)
+
+			response, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusConflict, response.StatusCode)
+			require.NoError(t, response.Body.Close())
+		})
+	})
+}
@@ -7,6 +7,7 @@ package admin
 import (
 	"context"
 	"crypto/subtle"
+	"database/sql"
 	"errors"
 	"net"
 	"net/http"
@@ -18,10 +19,13 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/adminauth"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/durability"
 )
 
 // Config defines configuration for debug server.
@@ -41,6 +45,12 @@ type DB interface {
 	StripeCoinPayments() stripecoinpayments.DB
 	// Buckets returns database for satellite buckets
 	Buckets() metainfo.BucketsDB
+	// DurabilityHistograms returns database for durability histograms computed by the checker
+	DurabilityHistograms() durability.DB
+	// Orders returns database for orders and their settlement outcomes
+	Orders() orders.DB
+	// AdminAuth returns database for the admin api tokens and audit log
+	AdminAuth() adminauth.DB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -51,71 +61,96 @@ type Server struct {
 	server   http.Server
 	mux      *mux.Router
 
-	db       DB
-	payments payments.Accounts
+	db         DB
+	metabaseDB metainfo.MetabaseDB
+	payments   payments.Accounts
 
 	nowFn func() time.Time
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, accounts payments.Accounts, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, metabaseDB metainfo.MetabaseDB, accounts payments.Accounts, config Config) *Server {
 	server := &Server{
 		log: log,
 
 		listener: listener,
 		mux:      mux.NewRouter(),
 
-		db:       db,
-		payments: accounts,
+		db:         db,
+		metabaseDB: metabaseDB,
+		payments:   accounts,
 
 		nowFn: time.Now,
 	}
 
 	server.server.Handler = &protectedServer{
+		log:                  log,
 		allowedAuthorization: config.AuthorizationToken,
+		tokens:               db.AdminAuth(),
+		now:                  func() time.Time { return server.nowFn() },
 		next:                 server.mux,
 	}
 
 	// When adding new options, also update README.md
-	server.mux.HandleFunc("/api/user", server.addUser).Methods("POST")
-	server.mux.HandleFunc("/api/user/{useremail}", server.updateUser).Methods("PUT")
-	server.mux.HandleFunc("/api/user/{useremail}", server.userInfo).Methods("GET")
-	server.mux.HandleFunc("/api/user/{useremail}", server.deleteUser).Methods("DELETE")
-	server.mux.HandleFunc("/api/coupon", server.addCoupon).Methods("POST")
-	server.mux.HandleFunc("/api/coupon/{couponid}", server.couponInfo).Methods("GET")
-	server.mux.HandleFunc("/api/coupon/{couponid}", server.deleteCoupon).Methods("DELETE")
-	server.mux.HandleFunc("/api/project", server.addProject).Methods("POST")
-	server.mux.HandleFunc("/api/project/{project}/usage", server.checkProjectUsage).Methods("GET")
-	server.mux.HandleFunc("/api/project/{project}/limit", server.getProjectLimit).Methods("GET")
-	server.mux.HandleFunc("/api/project/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
-	server.mux.HandleFunc("/api/project/{project}", server.getProject).Methods("GET")
-	server.mux.HandleFunc("/api/project/{project}", server.renameProject).Methods("PUT")
-	server.mux.HandleFunc("/api/project/{project}", server.deleteProject).Methods("DELETE")
-	server.mux.HandleFunc("/api/project/{project}/apikey", server.addAPIKey).Methods("POST")
-	server.mux.HandleFunc("/api/project/{project}/apikey/{name}", server.deleteAPIKeyByName).Methods("DELETE")
-	server.mux.HandleFunc("/api/apikey/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	server.mux.Handle("/api/user", server.withScope(adminauth.ScopeUsers, server.addUser)).Methods("POST")
+	server.mux.Handle("/api/user/{useremail}", server.withScope(adminauth.ScopeUsers, server.updateUser)).Methods("PUT")
+	server.mux.Handle("/api/user/{useremail}", server.withScope(adminauth.ScopeReadOnly, server.userInfo)).Methods("GET")
+	server.mux.Handle("/api/user/{useremail}", server.withScope(adminauth.ScopeUsers, server.deleteUser)).Methods("DELETE")
+	server.mux.Handle("/api/user/{useremail}/mfa", server.withScope(adminauth.ScopeUsers, server.disableUserMFA)).Methods("DELETE")
+	server.mux.Handle("/api/coupon", server.withScope(adminauth.ScopeBilling, server.addCoupon)).Methods("POST")
+	server.mux.Handle("/api/coupon/{couponid}", server.withScope(adminauth.ScopeReadOnly, server.couponInfo)).Methods("GET")
+	server.mux.Handle("/api/coupon/{couponid}", server.withScope(adminauth.ScopeBilling, server.deleteCoupon)).Methods("DELETE")
+	server.mux.Handle("/api/project", server.withScope(adminauth.ScopeUsers, server.addProject)).Methods("POST")
+	server.mux.Handle("/api/project/{project}/usage", server.withScope(adminauth.ScopeReadOnly, server.checkProjectUsage)).Methods("GET")
+	server.mux.Handle("/api/project/{project}/limit", server.withScope(adminauth.ScopeReadOnly, server.getProjectLimit)).Methods("GET")
+	server.mux.Handle("/api/project/{project}/limit", server.withScope(adminauth.ScopeLimits, server.putProjectLimit)).Methods("PUT", "POST")
+	server.mux.Handle("/api/project/{project}", server.withScope(adminauth.ScopeReadOnly, server.getProject)).Methods("GET")
+	server.mux.Handle("/api/project/{project}", server.withScope(adminauth.ScopeUsers, server.renameProject)).Methods("PUT")
+	server.mux.Handle("/api/project/{project}", server.withScope(adminauth.ScopeUsers, server.deleteProject)).Methods("DELETE")
+	server.mux.Handle("/api/project/{project}/durability", server.withScope(adminauth.ScopeReadOnly, server.getDurability)).Methods("GET")
+	server.mux.Handle("/api/project/{project}/bucket/{bucket}/durability", server.withScope(adminauth.ScopeReadOnly, server.getDurability)).Methods("GET")
+	server.mux.Handle("/api/project/{project}/bucket/{bucket}/limit", server.withScope(adminauth.ScopeReadOnly, server.getBucketLimits)).Methods("GET")
+	server.mux.Handle("/api/project/{project}/bucket/{bucket}/limit", server.withScope(adminauth.ScopeLimits, server.putBucketLimits)).Methods("PUT", "POST")
+	server.mux.Handle("/api/project/{project}/apikey", server.withScope(adminauth.ScopeUsers, server.addAPIKey)).Methods("POST")
+	server.mux.Handle("/api/project/{project}/apikey/{name}", server.withScope(adminauth.ScopeUsers, server.deleteAPIKeyByName)).Methods("DELETE")
+	server.mux.Handle("/api/apikey/{apikey}", server.withScope(adminauth.ScopeUsers, server.deleteAPIKey)).Methods("DELETE")
+	server.mux.Handle("/api/project/{project}/bucket/{bucket}/object/{key}/lock", server.withScope(adminauth.ScopeReadOnly, server.getObjectLock)).Methods("GET")
+	server.mux.Handle("/api/project/{project}/bucket/{bucket}/object/{key}/lock", server.withScope(adminauth.ScopeUsers, server.liftObjectLock)).Methods("DELETE")
+	server.mux.Handle("/api/node/{node}/settlements", server.withScope(adminauth.ScopeReadOnly, server.getSettlements)).Methods("GET")
+	server.mux.Handle("/api/admintoken", server.withScope(scopeTokens, server.addAdminToken)).Methods("POST")
+	server.mux.Handle("/api/admintoken", server.withScope(scopeTokens, server.listAdminTokens)).Methods("GET")
+	server.mux.Handle("/api/admintoken/{tokenid}", server.withScope(scopeTokens, server.revokeAdminToken)).Methods("DELETE")
+	server.mux.Handle("/api/audit", server.withScope(adminauth.ScopeReadOnly, server.listAuditEntries)).Methods("GET")
 
 	return server
 }
 
 type protectedServer struct {
+	log *zap.Logger
+
 	allowedAuthorization string
+	tokens               adminauth.DB
+	now                  func() time.Time
 
 	next http.Handler
 }
 
 func (server *protectedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if server.allowedAuthorization == "" {
-		httpJSONError(w, "Authorization not enabled.",
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		httpJSONError(w, "Forbidden",
 			"", http.StatusForbidden)
 		return
 	}
 
-	equality := subtle.ConstantTimeCompare(
-		[]byte(r.Header.Get("Authorization")),
-		[]byte(server.allowedAuthorization),
-	)
-	if equality != 1 {
+	actor, err := server.authenticate(r.Context(), authorization)
+	if err != nil {
+		server.log.Error("failed to authenticate admin token", zap.Error(err))
+		httpJSONError(w, "failed to authenticate",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if actor == nil {
 		httpJSONError(w, "Forbidden",
 			"", http.StatusForbidden)
 		return
@@ -123,7 +158,33 @@ func (server *protectedServer) ServeHTTP(w http.ResponseWriter, r *http.Request)
 
 	r.Header.Set("Cache-Control", "must-revalidate")
 
-	server.next.ServeHTTP(w, r)
+	server.next.ServeHTTP(w, r.WithContext(withActor(r.Context(), actor)))
+}
+
+// authenticate returns the actor of the authorization, or nil when the
+// authorization isn't valid.
+func (server *protectedServer) authenticate(ctx context.Context, authorization string) (*actor, error) {
+	if server.allowedAuthorization != "" {
+		equality := subtle.ConstantTimeCompare(
+			[]byte(authorization),
+			[]byte(server.allowedAuthorization),
+		)
+		if equality == 1 {
+			return &actor{name: rootActor}, nil
+		}
+	}
+
+	token, err := server.tokens.GetTokenBySecretHash(ctx, adminauth.HashSecret(authorization))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !token.Valid(server.now()) {
+		return nil, nil
+	}
+	return &actor{name: token.Name, token: &token}, nil
 }
 
 // Run starts the admin endpoint.
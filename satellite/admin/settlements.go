@@ -0,0 +1,135 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/orders"
+)
+
+// defaultSettlementPeriod is the period reported when no start date is given.
+const defaultSettlementPeriod = 30 * 24 * time.Hour
+
+type settlementReport struct {
+	NodeID              storj.NodeID                          `json:"nodeId"`
+	From                time.Time                             `json:"from"`
+	To                  time.Time                             `json:"to"`
+	AcceptedWindows     int                                   `json:"acceptedWindows"`
+	RejectedWindows     int                                   `json:"rejectedWindows"`
+	RetriedWindows      int                                   `json:"retriedWindows"`
+	OrdersReceived      int                                   `json:"ordersReceived"`
+	OrdersAccepted      int                                   `json:"ordersAccepted"`
+	OrdersUnknownBucket int                                   `json:"ordersUnknownBucket"`
+	AmountSettled       int64                                 `json:"amountSettled"`
+	Rejected            map[orders.SettlementRejectReason]int `json:"rejected"`
+	Windows             []settlementWindow                    `json:"windows"`
+}
+
+type settlementWindow struct {
+	IntervalStart       time.Time                             `json:"intervalStart"`
+	SettledAt           time.Time                             `json:"settledAt"`
+	Status              string                                `json:"status"`
+	AlreadyProcessed    bool                                  `json:"alreadyProcessed"`
+	OrdersReceived      int                                   `json:"ordersReceived"`
+	OrdersAccepted      int                                   `json:"ordersAccepted"`
+	OrdersUnknownBucket int                                   `json:"ordersUnknownBucket"`
+	AmountSettled       int64                                 `json:"amountSettled"`
+	Rejected            map[orders.SettlementRejectReason]int `json:"rejected"`
+}
+
+// getSettlements returns the order settlement history of a storage node.
+func (server *Server) getSettlements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeIDString, ok := mux.Vars(r)["node"]
+	if !ok {
+		httpJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		httpJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	to := server.nowFn().UTC()
+	if toString := r.URL.Query().Get("to"); toString != "" {
+		to, err = time.Parse("2006-01-02", toString)
+		if err != nil {
+			httpJSONError(w, "invalid to date, use YYYY-MM-DD",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	from := to.Add(-defaultSettlementPeriod)
+	if fromString := r.URL.Query().Get("from"); fromString != "" {
+		from, err = time.Parse("2006-01-02", fromString)
+		if err != nil {
+			httpJSONError(w, "invalid from date, use YYYY-MM-DD",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if !from.Before(to) {
+		httpJSONError(w, "from date must be before to date",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	report, err := orders.GetSettlementReport(ctx, server.db.Orders(), nodeID, from, to)
+	if err != nil {
+		httpJSONError(w, "unable to fetch settlement history",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := settlementReport{
+		NodeID:              report.StorageNodeID,
+		From:                report.From,
+		To:                  report.To,
+		AcceptedWindows:     report.AcceptedWindows,
+		RejectedWindows:     report.RejectedWindows,
+		RetriedWindows:      report.RetriedWindows,
+		OrdersReceived:      report.OrdersReceived,
+		OrdersAccepted:      report.OrdersAccepted,
+		OrdersUnknownBucket: report.OrdersUnknownBucket,
+		AmountSettled:       report.AmountSettled,
+		Rejected:            report.Rejected,
+		Windows:             []settlementWindow{},
+	}
+	for _, outcome := range report.Outcomes {
+		output.Windows = append(output.Windows, settlementWindow{
+			IntervalStart:       outcome.IntervalStart,
+			SettledAt:           outcome.SettledAt,
+			Status:              outcome.Status.String(),
+			AlreadyProcessed:    outcome.AlreadyProcessed,
+			OrdersReceived:      outcome.OrdersReceived,
+			OrdersAccepted:      outcome.OrdersAccepted,
+			OrdersUnknownBucket: outcome.OrdersUnknownBucket,
+			AmountSettled:       outcome.AmountSettled,
+			Rejected:            outcome.Rejected,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		httpJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data) // nothing to do with the error response, probably the client requesting disappeared
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/orders"
+)
+
+func TestSettlements(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		authToken := sat.Config.Console.AuthToken
+		address := sat.Admin.Admin.Listener.Addr().String()
+		nodeID := testrand.NodeID()
+
+		window := time.Date(2021, 5, 4, 8, 0, 0, 0, time.UTC)
+		for _, outcome := range []orders.SettlementOutcome{
+			{
+				StorageNodeID:       nodeID,
+				IntervalStart:       window,
+				SettledAt:           window.Add(2 * time.Hour),
+				Status:              pb.SettlementWithWindowResponse_ACCEPTED,
+				OrdersReceived:      12,
+				OrdersAccepted:      9,
+				OrdersUnknownBucket: 2,
+				AmountSettled:       4096,
+				Rejected: map[orders.SettlementRejectReason]int{
+					orders.RejectExpired:        1,
+					orders.RejectBadSignature:   1,
+					orders.RejectAlreadySettled: 1,
+				},
+			},
+			{
+				// a retry of the first window isn't part of the totals.
+				StorageNodeID:    nodeID,
+				IntervalStart:    window,
+				SettledAt:        window.Add(150 * time.Minute),
+				Status:           pb.SettlementWithWindowResponse_ACCEPTED,
+				AlreadyProcessed: true,
+				OrdersReceived:   9,
+			},
+			{
+				StorageNodeID:  nodeID,
+				IntervalStart:  window.Add(time.Hour),
+				SettledAt:      window.Add(3 * time.Hour),
+				Status:         pb.SettlementWithWindowResponse_REJECTED,
+				OrdersReceived: 2,
+				Rejected: map[orders.SettlementRejectReason]int{
+					orders.RejectInvalid: 2,
+				},
+			},
+			{
+				// outside of the reported period.
+				StorageNodeID:  nodeID,
+				IntervalStart:  window.Add(-72 * time.Hour),
+				SettledAt:      window.Add(-70 * time.Hour),
+				Status:         pb.SettlementWithWindowResponse_ACCEPTED,
+				OrdersReceived: 1,
+				OrdersAccepted: 1,
+				AmountSettled:  10,
+			},
+		} {
+			require.NoError(t, sat.DB.Orders().InsertSettlementOutcome(ctx, outcome))
+		}
+
+		link := fmt.Sprintf("http://%s/api/node/%s/settlements?from=2021-05-04&to=2021-05-05", address, nodeID)
+
+		expected := `{"nodeId":"` + nodeID.String() + `","from":"2021-05-04T00:00:00Z","to":"2021-05-05T00:00:00Z",` +
+			`"acceptedWindows":1,"rejectedWindows":1,"retriedWindows":1,"ordersReceived":14,"ordersAccepted":9,"ordersUnknownBucket":2,"amountSettled":4096,` +
+			`"rejected":{"already_settled":1,"bad_signature":1,"expired":1,"invalid":2},` +
+			`"windows":[` +
+			`{"intervalStart":"2021-05-04T08:00:00Z","settledAt":"2021-05-04T10:00:00Z","status":"ACCEPTED","alreadyProcessed":false,` +
+			`"ordersReceived":12,"ordersAccepted":9,"ordersUnknownBucket":2,"amountSettled":4096,` +
+			`"rejected":{"already_settled":1,"bad_signature":1,"expired":1,"invalid":0}},` +
+			`{"intervalStart":"2021-05-04T08:00:00Z","settledAt":"2021-05-04T10:30:00Z","status":"ACCEPTED","alreadyProcessed":true,` +
+			`"ordersReceived":9,"ordersAccepted":0,"ordersUnknownBucket":0,"amountSettled":0,` +
+			`"rejected":{"already_settled":0,"bad_signature":0,"expired":0,"invalid":0}},` +
+			`{"intervalStart":"2021-05-04T09:00:00Z","settledAt":"2021-05-04T11:00:00Z","status":"REJECTED","alreadyProcessed":false,` +
+			`"ordersReceived":2,"ordersAccepted":0,"ordersUnknownBucket":0,"amountSettled":0,` +
+			`"rejected":{"already_settled":0,"bad_signature":0,"expired":0,"invalid":2}}]}`
+
+		assertGet(t, link, expected, authToken)
+
+		t.Run("Invalid", func(t *testing.T) {
+			for _, url := range []string{
+				fmt.Sprintf("http://%s/api/node/invalid/settlements", address),
+				fmt.Sprintf("http://%s/api/node/%s/settlements?from=2021-05-05&to=2021-05-04", address, nodeID),
+				fmt.Sprintf("http://%s/api/node/%s/settlements?from=yesterday", address, nodeID),
+			} {
+				req, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(t, err)
+				req.Header.Set("Authorization", authToken)
+
+				response, err := http.DefaultClient.Do(req)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusBadRequest, response.StatusCode)
+				require.NoError(t, response.Body.Close())
+			}
+		})
+	})
+}
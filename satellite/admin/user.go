@@ -382,3 +382,37 @@ func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 			err.Error(), http.StatusInternalServerError)
 	}
 }
+
+func (server *Server) disableUserMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		httpJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		httpJSONError(w, fmt.Sprintf("user with email %q not found", userEmail),
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httpJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	user.MFAEnabled = false
+	user.MFASecretKey = ""
+	user.MFARecoveryCodes = nil
+
+	err = server.db.Console().Users().UpdateMFA(ctx, user)
+	if err != nil {
+		httpJSONError(w, "failed to disable mfa",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
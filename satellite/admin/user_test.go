@@ -278,3 +278,42 @@ func TestDeleteUser(t *testing.T) {
 		require.Equal(t, len(responseBody), 0)
 	})
 }
+
+func TestDisableUserMFA(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		address := planet.Satellites[0].Admin.Admin.Listener.Addr()
+		users := planet.Satellites[0].DB.Console().Users()
+
+		user, err := users.GetByEmail(ctx, planet.Uplinks[0].Projects[0].Owner.Email)
+		require.NoError(t, err)
+
+		user.MFAEnabled = true
+		user.MFASecretKey = "JBSWY3DPEHPK3PXP"
+		user.MFARecoveryCodes = []string{"hash1", "hash2"}
+		require.NoError(t, users.UpdateMFA(ctx, user))
+
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("http://"+address.String()+"/api/user/%s/mfa", user.Email), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", planet.Satellites[0].Config.Console.AuthToken)
+
+		response, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		user, err = users.GetByEmail(ctx, user.Email)
+		require.NoError(t, err)
+		require.False(t, user.MFAEnabled)
+		require.Empty(t, user.MFASecretKey)
+		require.Empty(t, user.MFARecoveryCodes)
+	})
+}
@@ -8,8 +8,6 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"net/mail"
-	"net/smtp"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
@@ -27,10 +25,9 @@ import (
 	"storj.io/private/version"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/private/lifecycle"
-	"storj.io/storj/private/post"
-	"storj.io/storj/private/post/oauth2"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/apikeyusage"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
@@ -40,7 +37,6 @@ import (
 	"storj.io/storj/satellite/inspector"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/mailservice"
-	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/nodestats"
@@ -50,6 +46,8 @@ import (
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/irreparable"
+	"storj.io/storj/satellite/repair/lostpieces"
+	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/rewards"
 	"storj.io/storj/satellite/snopayouts"
 )
@@ -109,11 +107,17 @@ type API struct {
 	}
 
 	Repair struct {
-		Inspector *irreparable.Inspector
+		Inspector   *irreparable.Inspector
+		Coordinator *repairer.Coordinator
+	}
+
+	LostPieces struct {
+		Endpoint *lostpieces.Endpoint
 	}
 
 	Accounting struct {
 		ProjectUsage *accounting.Service
+		APIKeyUsage  *apikeyusage.Service
 	}
 
 	LiveAccounting struct {
@@ -124,6 +128,10 @@ type API struct {
 		Cache *accounting.ProjectLimitCache
 	}
 
+	BucketLimits struct {
+		Cache *accounting.BucketLimitCache
+	}
+
 	Mail struct {
 		Service *mailservice.Service
 	}
@@ -304,15 +312,35 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		)
 	}
 
+	{ // setup bucket limits
+		peer.BucketLimits.Cache = accounting.NewBucketLimitCache(peer.DB.Buckets(), config.ProjectLimit)
+	}
+
 	{ // setup accounting project usage
 		peer.Accounting.ProjectUsage = accounting.NewService(
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Cache,
 			peer.ProjectLimits.Cache,
+			peer.BucketLimits.Cache,
 			config.LiveAccounting.BandwidthCacheTTL,
 		)
 	}
 
+	{ // setup api key usage
+		peer.Accounting.APIKeyUsage = apikeyusage.NewService(
+			peer.Log.Named("accounting:apikeyusage"),
+			peer.DB.Console().APIKeys(),
+			config.APIKeyUsage,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "accounting:apikeyusage",
+			Run:   peer.Accounting.APIKeyUsage.Run,
+			Close: peer.Accounting.APIKeyUsage.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Accounting API Key Usage", peer.Accounting.APIKeyUsage.Loop))
+	}
+
 	{ // setup orders
 		peer.Orders.DB = rollupsWriteCache
 		peer.Orders.Chore = orders.NewChore(log.Named("orders:chore"), rollupsWriteCache, config.Orders)
@@ -402,6 +430,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.PeerIdentities(),
 			peer.DB.Console().APIKeys(),
 			peer.Accounting.ProjectUsage,
+			peer.Accounting.APIKeyUsage,
 			peer.DB.Console().Projects(),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.DB.Revocation(),
@@ -414,6 +443,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint2); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := internalpb.DRPCRegisterExtendedMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint2); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
@@ -426,6 +458,27 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := internalpb.DRPCRegisterIrreparableInspector(peer.Server.PrivateDRPC(), peer.Repair.Inspector); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Repair.Coordinator, err = repairer.NewCoordinator(
+			peer.Log.Named("repair:coordinator"),
+			config.RepairCoordinator,
+			peer.DB.RepairQueue(),
+			peer.DB.Irreparable(),
+			peer.Metainfo.Metabase,
+			peer.DB.Buckets(),
+			peer.Orders.Service,
+			peer.Overlay.Service,
+			peer.DB.PeerIdentities(),
+			config.Repairer.MaxExcessRateOptimalThreshold,
+			config.Checker.RepairOverrides,
+			signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity()),
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		if err := internalpb.DRPCRegisterRepairCoordinator(peer.Server.DRPC(), peer.Repair.Coordinator); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup inspector
@@ -433,6 +486,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("inspector"),
 			peer.Overlay.Service,
 			peer.Metainfo.Metabase,
+			peer.DB.DurabilityHistograms(),
 		)
 		if err := internalpb.DRPCRegisterHealthInspector(peer.Server.PrivateDRPC(), peer.Inspector.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -440,66 +494,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup mailservice
-		// TODO(yar): test multiple satellites using same OAUTH credentials
-		mailConfig := config.Mail
-
-		// validate from mail address
-		from, err := mail.ParseAddress(mailConfig.From)
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
-
-		// validate smtp server address
-		host, _, err := net.SplitHostPort(mailConfig.SMTPServerAddress)
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
-
-		var sender mailservice.Sender
-		switch mailConfig.AuthType {
-		case "oauth2":
-			creds := oauth2.Credentials{
-				ClientID:     mailConfig.ClientID,
-				ClientSecret: mailConfig.ClientSecret,
-				TokenURI:     mailConfig.TokenURI,
-			}
-			token, err := oauth2.RefreshToken(context.TODO(), creds, mailConfig.RefreshToken)
-			if err != nil {
-				return nil, errs.Combine(err, peer.Close())
-			}
-
-			sender = &post.SMTPSender{
-				From: *from,
-				Auth: &oauth2.Auth{
-					UserEmail: from.Address,
-					Storage:   oauth2.NewTokenStore(creds, *token),
-				},
-				ServerAddress: mailConfig.SMTPServerAddress,
-			}
-		case "plain":
-			sender = &post.SMTPSender{
-				From:          *from,
-				Auth:          smtp.PlainAuth("", mailConfig.Login, mailConfig.Password, host),
-				ServerAddress: mailConfig.SMTPServerAddress,
-			}
-		case "login":
-			sender = &post.SMTPSender{
-				From: *from,
-				Auth: post.LoginAuth{
-					Username: mailConfig.Login,
-					Password: mailConfig.Password,
-				},
-				ServerAddress: mailConfig.SMTPServerAddress,
-			}
-		default:
-			sender = &simulate.LinkClicker{}
-		}
-
-		peer.Mail.Service, err = mailservice.New(
-			peer.Log.Named("mail:service"),
-			sender,
-			mailConfig.TemplatePath,
-		)
+		peer.Mail.Service, err = setupMailService(peer.Log, *config)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -625,6 +620,18 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup lost pieces endpoint
+		peer.LostPieces.Endpoint = lostpieces.NewEndpoint(
+			peer.Log.Named("lostpieces:endpoint"),
+			peer.DB.LostPieces(),
+			peer.Overlay.Service,
+			config.LostPieces,
+		)
+		if err := internalpb.DRPCRegisterLostPieces(peer.Server.DRPC(), peer.LostPieces.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup SnoPayout endpoint
 		peer.SNOPayouts.DB = peer.DB.SNOPayouts()
 		peer.SNOPayouts.Service = snopayouts.NewService(
@@ -649,6 +656,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.Overlay.DB,
 				peer.Overlay.Service,
 				peer.Metainfo.Metabase,
+				peer.DB.Buckets(),
 				peer.Orders.Service,
 				peer.DB.PeerIdentities(),
 				config.GracefulExit)
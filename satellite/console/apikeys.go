@@ -26,8 +26,17 @@ type APIKeys interface {
 	Create(ctx context.Context, head []byte, info APIKeyInfo) (*APIKeyInfo, error)
 	// Update updates APIKeyInfo in store
 	Update(ctx context.Context, key APIKeyInfo) error
+	// UpdateLimits updates the request rate and bandwidth limits of the api key
+	UpdateLimits(ctx context.Context, id uuid.UUID, rateLimit *int, bandwidthLimit *int64) error
 	// Delete deletes APIKeyInfo from store
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AddUsage adds the given usage to the hourly usage rollups of the api keys
+	AddUsage(ctx context.Context, rollups []APIKeyUsageRollup) error
+	// GetEgress returns the egress allocated with the api key with the given head since the given time
+	GetEgress(ctx context.Context, head []byte, since time.Time) (int64, error)
+	// GetUsageByProjectID returns the usage of every api key of the project for the given period
+	GetUsageByProjectID(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]APIKeyUsage, error)
 }
 
 // APIKeyInfo describing api key model in the database.
@@ -36,8 +45,37 @@ type APIKeyInfo struct {
 	ProjectID uuid.UUID `json:"projectId"`
 	PartnerID uuid.UUID `json:"partnerId"`
 	Name      string    `json:"name"`
+	Head      []byte    `json:"-"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+
+	// RateLimit is the number of requests per second allowed for the key,
+	// in addition to the rate limit of the project.
+	RateLimit *int `json:"rateLimit"`
+	// BandwidthLimit is the monthly egress allowed for the key, in addition
+	// to the bandwidth limit of the project.
+	BandwidthLimit *int64 `json:"bandwidthLimit"`
+}
+
+// APIKeyUsageRollup contains the usage of an api key during an hour.
+type APIKeyUsageRollup struct {
+	Head          []byte
+	ProjectID     uuid.UUID
+	IntervalStart time.Time
+
+	Requests int64
+	Egress   int64
+}
+
+// APIKeyUsage contains the usage of an api key during a period.
+//
+// The ID and Name are empty when the key has been deleted since.
+type APIKeyUsage struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+
+	Requests int64 `json:"requests"`
+	Egress   int64 `json:"egress"`
 }
 
 // APIKeyCursor holds info for api keys cursor pagination.
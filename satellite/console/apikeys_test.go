@@ -6,11 +6,14 @@ package console_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
@@ -158,3 +161,73 @@ func TestApiKeysRepository(t *testing.T) {
 
 	})
 }
+
+func TestApiKeysUsage(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projects := db.Console().Projects()
+		apikeys := db.Console().APIKeys()
+
+		project, err := projects.Insert(ctx, &console.Project{
+			Name:        "ProjectName",
+			Description: "projects description",
+		})
+		require.NoError(t, err)
+
+		key, err := macaroon.NewAPIKey([]byte("testSecret"))
+		require.NoError(t, err)
+
+		keyInfo, err := apikeys.Create(ctx, key.Head(), console.APIKeyInfo{
+			Name:      "limited key",
+			ProjectID: project.ID,
+			Secret:    []byte("testSecret"),
+		})
+		require.NoError(t, err)
+		require.Nil(t, keyInfo.RateLimit)
+		require.Nil(t, keyInfo.BandwidthLimit)
+
+		rateLimit, bandwidthLimit := 10, int64(1000)
+		err = apikeys.UpdateLimits(ctx, keyInfo.ID, &rateLimit, &bandwidthLimit)
+		require.NoError(t, err)
+
+		keyInfo, err = apikeys.Get(ctx, keyInfo.ID)
+		require.NoError(t, err)
+		require.Equal(t, key.Head(), keyInfo.Head)
+		require.Equal(t, &rateLimit, keyInfo.RateLimit)
+		require.Equal(t, &bandwidthLimit, keyInfo.BandwidthLimit)
+
+		err = apikeys.UpdateLimits(ctx, keyInfo.ID, nil, nil)
+		require.NoError(t, err)
+
+		keyInfo, err = apikeys.Get(ctx, keyInfo.ID)
+		require.NoError(t, err)
+		require.Nil(t, keyInfo.RateLimit)
+		require.Nil(t, keyInfo.BandwidthLimit)
+
+		now := time.Now().UTC().Truncate(time.Hour)
+		deletedHead := testrand.Bytes(32)
+
+		err = apikeys.AddUsage(ctx, []console.APIKeyUsageRollup{
+			{Head: key.Head(), ProjectID: project.ID, IntervalStart: now.Add(-time.Hour), Requests: 2, Egress: 100},
+			{Head: key.Head(), ProjectID: project.ID, IntervalStart: now, Requests: 1, Egress: 50},
+			{Head: deletedHead, ProjectID: project.ID, IntervalStart: now, Requests: 3},
+		})
+		require.NoError(t, err)
+
+		// adding to an existing rollup sums the usage
+		err = apikeys.AddUsage(ctx, []console.APIKeyUsageRollup{
+			{Head: key.Head(), ProjectID: project.ID, IntervalStart: now, Requests: 1, Egress: 50},
+		})
+		require.NoError(t, err)
+
+		egress, err := apikeys.GetEgress(ctx, key.Head(), now)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, egress)
+
+		usage, err := apikeys.GetUsageByProjectID(ctx, project.ID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, []console.APIKeyUsage{
+			{ID: keyInfo.ID, Name: "limited key", Requests: 4, Egress: 200},
+			{Requests: 3},
+		}, usage)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metainfo/metabase"
 )
 
@@ -30,4 +31,12 @@ type Buckets interface {
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has.
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// GetBucketLifecycle returns the lifecycle rules of a bucket.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (rules []metabase.LifecycleRule, err error)
+	// UpdateBucketLifecycle replaces the lifecycle rules of a bucket.
+	UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []metabase.LifecycleRule) (err error)
+	// GetBucketLimits returns the limits of a bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits accounting.BucketLimits, err error)
+	// UpdateBucketLimits replaces the limits of a bucket.
+	UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits accounting.BucketLimits) (err error)
 }
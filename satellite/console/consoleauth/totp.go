@@ -0,0 +1,128 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint: gosec // sha1 is mandated by RFC 6238 for authenticator apps.
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// TOTPPeriod is how long a time-based one-time passcode is valid.
+	TOTPPeriod = 30 * time.Second
+	// TOTPDigits is the number of digits of a time-based one-time passcode.
+	TOTPDigits = 6
+	// totpSkew is how many periods before and after the current one are accepted,
+	// to allow for clock differences between the satellite and the authenticator.
+	totpSkew = 1
+	// totpSecretSize is the size of a TOTP secret key in bytes.
+	totpSecretSize = 20
+)
+
+// ErrTOTP is used when a TOTP secret key or passcode is malformed.
+var ErrTOTP = errs.Class("totp")
+
+// totpEncoding is the encoding of secret keys used by authenticator apps.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecretKey generates a new random secret key for time-based one-time passcodes.
+func NewTOTPSecretKey() (string, error) {
+	var secret [totpSecretSize]byte
+	if _, err := rand.Read(secret[:]); err != nil {
+		return "", ErrTOTP.Wrap(err)
+	}
+	return totpEncoding.EncodeToString(secret[:]), nil
+}
+
+// TOTPKeyURL returns the otpauth URL of a secret key, which is used to enroll
+// the key in authenticator apps.
+func TOTPKeyURL(issuer, account, secretKey string) string {
+	label := url.PathEscape(issuer + ":" + account)
+	values := url.Values{}
+	values.Set("secret", secretKey)
+	values.Set("issuer", issuer)
+	values.Set("digits", fmt.Sprint(TOTPDigits))
+	values.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+// TOTPPasscode returns the time-based one-time passcode (RFC 6238) of the secret key at a given time.
+func TOTPPasscode(secretKey string, t time.Time) (string, error) {
+	secret, err := decodeTOTPSecretKey(secretKey)
+	if err != nil {
+		return "", err
+	}
+	return totpPasscode(secret, totpCounter(t)), nil
+}
+
+// ValidateTOTPPasscode checks whether the passcode is valid for the secret key at a given time.
+func ValidateTOTPPasscode(passcode, secretKey string, t time.Time) (bool, error) {
+	_, valid, err := ValidateTOTPPasscodeCounter(passcode, secretKey, t)
+	return valid, err
+}
+
+// ValidateTOTPPasscodeCounter checks whether the passcode is valid for the secret key at a given time
+// and returns the counter of the passcode. A passcode is valid for several counters around the given
+// time, so the counter has to be stored to reject passcodes which were already used.
+func ValidateTOTPPasscodeCounter(passcode, secretKey string, t time.Time) (counter int64, valid bool, err error) {
+	secret, err := decodeTOTPSecretKey(secretKey)
+	if err != nil {
+		return 0, false, err
+	}
+
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != TOTPDigits {
+		return 0, false, nil
+	}
+
+	current := int64(totpCounter(t))
+	for skew := int64(-totpSkew); skew <= totpSkew; skew++ {
+		expected := totpPasscode(secret, uint64(current+skew))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(passcode)) == 1 {
+			return current + skew, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func decodeTOTPSecretKey(secretKey string) ([]byte, error) {
+	secret, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secretKey, "=")))
+	if err != nil {
+		return nil, ErrTOTP.New("invalid secret key: %v", err)
+	}
+	return secret, nil
+}
+
+func totpCounter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(TOTPPeriod/time.Second))
+}
+
+// totpPasscode computes the HOTP value (RFC 4226) of a counter.
+func totpPasscode(secret []byte, counter uint64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, secret)
+	_, _ = mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, code%modulo)
+}
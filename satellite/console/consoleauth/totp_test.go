@@ -0,0 +1,89 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPPasscode(t *testing.T) {
+	// test vectors from RFC 6238 appendix B, truncated to 6 digits.
+	secretKey := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for _, tt := range []struct {
+		unix     int64
+		passcode string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	} {
+		passcode, err := TOTPPasscode(secretKey, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.passcode, passcode, tt.unix)
+	}
+}
+
+func TestValidateTOTPPasscode(t *testing.T) {
+	secretKey, err := NewTOTPSecretKey()
+	require.NoError(t, err)
+
+	now := time.Now()
+	passcode, err := TOTPPasscode(secretKey, now)
+	require.NoError(t, err)
+
+	valid, err := ValidateTOTPPasscode(passcode, secretKey, now)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// the previous and the next periods are accepted
+	valid, err = ValidateTOTPPasscode(passcode, secretKey, now.Add(TOTPPeriod))
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = ValidateTOTPPasscode(passcode, secretKey, now.Add(-3*TOTPPeriod))
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = ValidateTOTPPasscode("12345", secretKey, now)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = ValidateTOTPPasscode(passcode, "not base32!", now)
+	require.True(t, ErrTOTP.Has(err))
+}
+
+func TestValidateTOTPPasscodeCounter(t *testing.T) {
+	secretKey, err := NewTOTPSecretKey()
+	require.NoError(t, err)
+
+	now := time.Unix(59, 0)
+	passcode, err := TOTPPasscode(secretKey, now)
+	require.NoError(t, err)
+
+	// the counter is the one of the passcode, not the one of the validation time
+	for _, at := range []time.Time{now, now.Add(TOTPPeriod), now.Add(-TOTPPeriod)} {
+		counter, valid, err := ValidateTOTPPasscodeCounter(passcode, secretKey, at)
+		require.NoError(t, err)
+		assert.True(t, valid)
+		assert.EqualValues(t, 1, counter)
+	}
+
+	_, valid, err := ValidateTOTPPasscodeCounter(passcode, secretKey, now.Add(3*TOTPPeriod))
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestTOTPKeyURL(t *testing.T) {
+	url := TOTPKeyURL("Storj", "user@mail.test", "JBSWY3DPEHPK3PXP")
+	assert.Equal(t, "otpauth://totp/Storj:user@mail.test?digits=6&issuer=Storj&period=30&secret=JBSWY3DPEHPK3PXP", url)
+}
@@ -6,6 +6,8 @@ package consoleapi
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -70,6 +72,93 @@ func (keys *APIKeys) DeleteByNameAndProjectID(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// UpdateLimits updates the request rate and bandwidth limits of an api key.
+func (keys *APIKeys) UpdateLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.FromString(r.URL.Query().Get("id"))
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var limits struct {
+		RateLimit      *int   `json:"rateLimit"`
+		BandwidthLimit *int64 `json:"bandwidthLimit"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&limits)
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = keys.service.UpdateAPIKeyLimits(ctx, id, limits.RateLimit, limits.BandwidthLimit)
+	if err != nil {
+		keys.serveJSONError(w, keys.getStatusCode(err), err)
+		return
+	}
+}
+
+// Usage returns the request count and egress of every api key of a project.
+func (keys *APIKeys) Usage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("since"), 10, 64)
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("before"), 10, 64)
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	since := time.Unix(sinceStamp, 0).UTC()
+	before := time.Unix(beforeStamp, 0).UTC()
+
+	usage, err := keys.service.GetAPIKeysUsage(ctx, projectID, since, before)
+	if err != nil {
+		keys.serveJSONError(w, keys.getStatusCode(err), err)
+		return
+	}
+
+	if usage == nil {
+		usage = []console.APIKeyUsage{}
+	}
+
+	err = json.NewEncoder(w).Encode(usage)
+	if err != nil {
+		keys.log.Error("failed to write json api keys usage response", zap.Error(ErrAPIKeysAPI.Wrap(err)))
+	}
+}
+
+// getStatusCode returns http.StatusCode depends on console error class.
+func (keys *APIKeys) getStatusCode(err error) int {
+	switch {
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrNoAPIKey.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (keys *APIKeys) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
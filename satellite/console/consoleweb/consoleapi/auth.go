@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
@@ -16,6 +18,7 @@ import (
 	"storj.io/storj/private/post"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
 	"storj.io/storj/satellite/mailservice"
@@ -68,8 +71,10 @@ func (a *Auth) Token(w http.ResponseWriter, r *http.Request) {
 	defer mon.Task()(&ctx)(&err)
 
 	var tokenRequest struct {
-		Email    string `json:"email"`
-		Password string `json:"password"`
+		Email           string `json:"email"`
+		Password        string `json:"password"`
+		MFAPasscode     string `json:"mfaPasscode"`
+		MFARecoveryCode string `json:"mfaRecoveryCode"`
 	}
 
 	err = json.NewDecoder(r.Body).Decode(&tokenRequest)
@@ -78,7 +83,18 @@ func (a *Auth) Token(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := a.service.Token(ctx, tokenRequest.Email, tokenRequest.Password)
+	token, err := a.service.TokenWithMFA(ctx, tokenRequest.Email, tokenRequest.Password, tokenRequest.MFAPasscode, tokenRequest.MFARecoveryCode)
+	if console.ErrMFAMissing.Has(err) {
+		// the credentials are valid, but the client needs to ask for the second factor.
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(struct {
+			MFARequired bool `json:"mfaRequired"`
+		}{MFARequired: true})
+		if err != nil {
+			a.log.Error("token handler could not encode MFA response", zap.Error(ErrAuthAPI.Wrap(err)))
+		}
+		return
+	}
 	if err != nil {
 		a.log.Info("Error authenticating token request", zap.String("email", tokenRequest.Email), zap.Error(ErrAuthAPI.Wrap(err)))
 		a.serveJSONError(w, err)
@@ -260,6 +276,7 @@ func (a *Auth) GetAccount(w http.ResponseWriter, r *http.Request) {
 		Position       string    `json:"position"`
 		CompanyName    string    `json:"companyName"`
 		EmployeeCount  string    `json:"employeeCount"`
+		IsMFAEnabled   bool      `json:"isMFAEnabled"`
 	}
 
 	auth, err := console.GetAuth(ctx)
@@ -278,6 +295,7 @@ func (a *Auth) GetAccount(w http.ResponseWriter, r *http.Request) {
 	user.CompanyName = auth.User.CompanyName
 	user.Position = auth.User.Position
 	user.EmployeeCount = auth.User.EmployeeCount
+	user.IsMFAEnabled = auth.User.MFAEnabled
 
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(&user)
@@ -343,6 +361,133 @@ func (a *Auth) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GenerateMFASecretKey creates a new MFA secret key for the user and returns it
+// together with the otpauth URL used to enroll it in an authenticator app.
+func (a *Auth) GenerateMFASecretKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := a.service.ResetMFASecretKey(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	auth, err := console.GetAuth(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	var response struct {
+		SecretKey string `json:"secretKey"`
+		KeyURL    string `json:"keyURL"`
+	}
+	response.SecretKey = key
+	response.KeyURL = consoleauth.TOTPKeyURL(a.mfaIssuer(), auth.User.Email, key)
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		a.log.Error("could not encode MFA secret key", zap.Error(ErrAuthAPI.Wrap(err)))
+		return
+	}
+}
+
+// EnableUserMFA enables MFA for the user after the passcode of the generated
+// secret key is confirmed, and returns the recovery codes.
+func (a *Auth) EnableUserMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		Passcode string `json:"passcode"`
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	codes, err := a.service.EnableUserMFA(ctx, data.Passcode, time.Now())
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	a.serveRecoveryCodes(w, codes)
+}
+
+// DisableUserMFA disables MFA for the user, using either a passcode or a recovery code.
+func (a *Auth) DisableUserMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		Passcode     string `json:"passcode"`
+		RecoveryCode string `json:"recoveryCode"`
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	err = a.service.DisableUserMFA(ctx, data.Passcode, time.Now(), data.RecoveryCode)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+}
+
+// RegenerateMFARecoveryCodes replaces the MFA recovery codes of the user and returns the new ones.
+func (a *Auth) RegenerateMFARecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		Passcode string `json:"passcode"`
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	codes, err := a.service.ResetMFARecoveryCodes(ctx, data.Passcode, time.Now())
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	a.serveRecoveryCodes(w, codes)
+}
+
+// serveRecoveryCodes writes MFA recovery codes to response output stream.
+func (a *Auth) serveRecoveryCodes(w http.ResponseWriter, codes []string) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(codes)
+	if err != nil {
+		a.log.Error("could not encode MFA recovery codes", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// mfaIssuer returns the issuer shown for the satellite in authenticator apps.
+func (a *Auth) mfaIssuer() string {
+	issuer := "Storj"
+	if u, err := url.Parse(a.ExternalAddress); err == nil && u.Host != "" {
+		issuer += " " + u.Host
+	}
+	return issuer
+}
+
 // ForgotPassword creates password-reset token and sends email to user.
 func (a *Auth) ForgotPassword(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -469,10 +614,14 @@ func (a *Auth) getStatusCode(err error) int {
 	switch {
 	case console.ErrValidation.Has(err):
 		return http.StatusBadRequest
-	case console.ErrUnauthorized.Has(err):
+	case console.ErrUnauthorized.Has(err), console.ErrSSO.Has(err):
 		return http.StatusUnauthorized
-	case console.ErrEmailUsed.Has(err):
+	case console.ErrEmailUsed.Has(err), console.ErrMFAConflict.Has(err):
 		return http.StatusConflict
+	case console.ErrMFAPasscode.Has(err), console.ErrMFARecoveryCode.Has(err), console.ErrMFAMissing.Has(err):
+		return http.StatusBadRequest
+	case console.ErrMFALocked.Has(err):
+		return http.StatusTooManyRequests
 	case errors.Is(err, errNotImplemented):
 		return http.StatusNotImplemented
 	default:
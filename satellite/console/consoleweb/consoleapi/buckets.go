@@ -10,8 +10,11 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo/metabase"
 )
 
 var (
@@ -66,6 +69,132 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Lifecycle returns the lifecycle rules of a bucket.
+func (b *Buckets) Lifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	bucketName := r.URL.Query().Get("bucketName")
+
+	rules, err := b.service.GetBucketLifecycle(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveJSONError(w, b.getStatusCode(err), err)
+		return
+	}
+
+	if rules == nil {
+		rules = []metabase.LifecycleRule{}
+	}
+
+	err = json.NewEncoder(w).Encode(rules)
+	if err != nil {
+		b.log.Error("failed to write json bucket lifecycle response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateLifecycle replaces the lifecycle rules of a bucket.
+func (b *Buckets) UpdateLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	bucketName := r.URL.Query().Get("bucketName")
+
+	var rules []metabase.LifecycleRule
+	err = json.NewDecoder(r.Body).Decode(&rules)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLifecycle(ctx, projectID, bucketName, rules)
+	if err != nil {
+		b.serveJSONError(w, b.getStatusCode(err), err)
+		return
+	}
+}
+
+// Limits returns the limits of a bucket.
+func (b *Buckets) Limits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	bucketName := r.URL.Query().Get("bucketName")
+
+	limits, err := b.service.GetBucketLimits(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveJSONError(w, b.getStatusCode(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(limits)
+	if err != nil {
+		b.log.Error("failed to write json bucket limits response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateLimits replaces the limits of a bucket.
+func (b *Buckets) UpdateLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	bucketName := r.URL.Query().Get("bucketName")
+
+	var limits accounting.BucketLimits
+	err = json.NewDecoder(r.Body).Decode(&limits)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLimits(ctx, projectID, bucketName, limits)
+	if err != nil {
+		b.serveJSONError(w, b.getStatusCode(err), err)
+		return
+	}
+}
+
+// getStatusCode returns http.StatusCode depends on console error class.
+func (b *Buckets) getStatusCode(err error) int {
+	switch {
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	case storj.ErrBucketNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	if status == http.StatusInternalServerError {
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo/metabase"
 )
 
 func Test_AllBucketNames(t *testing.T) {
@@ -103,3 +105,93 @@ func Test_AllBucketNames(t *testing.T) {
 		}()
 	})
 }
+
+func Test_BucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Jack-lifecycle",
+			Email:    "lifecycletest@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "lifecycletest")
+		require.NoError(t, err)
+
+		_, err = sat.DB.Buckets().CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "testbucket",
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		// we are using full name as a password
+		token, err := sat.API.Console.Service.Token(ctx, user.Email, user.FullName)
+		require.NoError(t, err)
+
+		link := "http://" + sat.API.Console.Listener.Addr().String() + "/api/v0/buckets/lifecycle?projectID=" + project.ID.String() + "&bucketName=testbucket"
+
+		doRequest := func(method, body string) (int, []byte) {
+			req, err := http.NewRequest(method, link, strings.NewReader(body))
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   token,
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, result.Body.Close()) }()
+
+			data, err := ioutil.ReadAll(result.Body)
+			require.NoError(t, err)
+			return result.StatusCode, data
+		}
+
+		status, body := doRequest(http.MethodGet, "")
+		require.Equal(t, http.StatusOK, status)
+		require.JSONEq(t, `[]`, string(body))
+
+		// object keys are encrypted, so the console cannot filter by prefix.
+		status, _ = doRequest(http.MethodPut, `[{"id":"logs","prefix":"bG9ncy8=","expireAfterDays":30}]`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		// rules with a prefix set with an access grant are kept and not shown.
+		prefixed := metabase.LifecycleRule{ID: "logs", Prefix: []byte("encrypted"), ExpireAfterDays: 7}
+		err = sat.DB.Buckets().UpdateBucketLifecycle(ctx, []byte("testbucket"), project.ID, []metabase.LifecycleRule{prefixed})
+		require.NoError(t, err)
+
+		rules := `[{"id":"all","expireAfterDays":30}]`
+		status, _ = doRequest(http.MethodPut, rules)
+		require.Equal(t, http.StatusOK, status)
+
+		status, body = doRequest(http.MethodGet, "")
+		require.Equal(t, http.StatusOK, status)
+		require.JSONEq(t, rules, string(body))
+
+		stored, err := sat.DB.Buckets().GetBucketLifecycle(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, []metabase.LifecycleRule{
+			{ID: "all", ExpireAfterDays: 30},
+			prefixed,
+		}, stored)
+
+		status, _ = doRequest(http.MethodPut, `[{"id":"invalid"}]`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		link = strings.Replace(link, "bucketName=testbucket", "bucketName=missing", 1)
+		status, _ = doRequest(http.MethodPut, rules)
+		require.Equal(t, http.StatusNotFound, status)
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrProjectMembersAPI - console project members api error type.
+	ErrProjectMembersAPI = errs.Class("console project members api error")
+)
+
+// ProjectMembers is an api controller that exposes project members and their roles.
+type ProjectMembers struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewProjectMembers is a constructor for api project members controller.
+func NewProjectMembers(log *zap.Logger, service *console.Service) *ProjectMembers {
+	return &ProjectMembers{
+		log:     log,
+		service: service,
+	}
+}
+
+// projectMember is the json representation of a project member.
+type projectMember struct {
+	ID       uuid.UUID `json:"id"`
+	FullName string    `json:"fullName"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+// List returns a page of the members of a project together with their roles.
+func (members *ProjectMembers) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		members.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	cursor := console.ProjectMembersCursor{
+		Search: query.Get("search"),
+		Limit:  50,
+		Page:   1,
+		Order:  console.Name,
+	}
+	if limit := query.Get("limit"); limit != "" {
+		value, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			members.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+		cursor.Limit = uint(value)
+	}
+	if page := query.Get("page"); page != "" {
+		value, err := strconv.ParseUint(page, 10, 32)
+		if err != nil {
+			members.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+		cursor.Page = uint(value)
+	}
+
+	page, err := members.service.GetProjectMembers(ctx, projectID, cursor)
+	if err != nil {
+		members.serveJSONError(w, members.getStatusCode(err), err)
+		return
+	}
+
+	var response struct {
+		Members     []projectMember `json:"members"`
+		PageCount   uint            `json:"pageCount"`
+		CurrentPage uint            `json:"currentPage"`
+		TotalCount  uint64          `json:"totalCount"`
+	}
+	response.Members = []projectMember{}
+	response.PageCount = page.PageCount
+	response.CurrentPage = page.CurrentPage
+	response.TotalCount = page.TotalCount
+
+	for _, member := range page.ProjectMembers {
+		user, err := members.service.GetUser(ctx, member.MemberID)
+		if err != nil {
+			members.serveJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		response.Members = append(response.Members, projectMember{
+			ID:       user.ID,
+			FullName: user.FullName,
+			Email:    user.Email,
+			Role:     member.Role.String(),
+			JoinedAt: member.CreatedAt,
+		})
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		members.log.Error("failed to write json project members response", zap.Error(ErrProjectMembersAPI.Wrap(err)))
+	}
+}
+
+// UpdateRole changes the role of a project member.
+func (members *ProjectMembers) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		members.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request struct {
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		members.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	role, err := console.ParseProjectMemberRole(request.Role)
+	if err != nil {
+		members.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = members.service.UpdateProjectMemberRole(ctx, projectID, request.Email, role)
+	if err != nil {
+		members.serveJSONError(w, members.getStatusCode(err), err)
+		return
+	}
+}
+
+// getStatusCode returns http.StatusCode depends on console error class.
+func (members *ProjectMembers) getStatusCode(err error) int {
+	switch {
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (members *ProjectMembers) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	if status == http.StatusInternalServerError {
+		members.log.Error("returning internal server error to client", zap.Int("code", status), zap.Error(err))
+	} else {
+		members.log.Debug("returning error to client", zap.Int("code", status), zap.Error(err))
+	}
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		members.log.Error("failed to write json error response", zap.Error(ErrProjectMembersAPI.Wrap(err)))
+	}
+}
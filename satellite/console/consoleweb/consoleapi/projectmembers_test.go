@@ -0,0 +1,118 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func Test_ProjectMemberRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OpenRegistrationEnabled = true
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Project Owner",
+			Email:    "owner@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		contractor, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Project Contractor",
+			Email:    "contractor@test.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "roles")
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.AuthenticatedContext(ctx, owner.ID)
+		require.NoError(t, err)
+		_, err = service.AddProjectMembers(ownerCtx, project.ID, []string{contractor.Email}, console.RoleDeveloper)
+		require.NoError(t, err)
+
+		// we are using full name as a password
+		ownerToken, err := service.Token(ctx, owner.Email, owner.FullName)
+		require.NoError(t, err)
+		contractorToken, err := service.Token(ctx, contractor.Email, contractor.FullName)
+		require.NoError(t, err)
+
+		baseURL := "http://" + sat.API.Console.Listener.Addr().String() + "/api/v0/project-members"
+
+		doRequest := func(token, method, url, body string) (int, []byte) {
+			req, err := http.NewRequest(method, url, strings.NewReader(body))
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   token,
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(result.Body)
+			require.NoError(t, err)
+			require.NoError(t, result.Body.Close())
+			return result.StatusCode, data
+		}
+
+		// the owner makes the contractor read-only
+		status, body := doRequest(ownerToken, http.MethodPut, baseURL+"/role?projectID="+project.ID.String(),
+			`{"email":"contractor@test.test","role":"read-only"}`)
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		status, body = doRequest(ownerToken, http.MethodPut, baseURL+"/role?projectID="+project.ID.String(),
+			`{"email":"contractor@test.test","role":"superuser"}`)
+		require.Equal(t, http.StatusBadRequest, status, string(body))
+
+		// the read-only contractor can see the members, but not change the roles
+		status, body = doRequest(contractorToken, http.MethodGet, baseURL+"?projectID="+project.ID.String(), "")
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		var page struct {
+			Members []struct {
+				Email string `json:"email"`
+				Role  string `json:"role"`
+			} `json:"members"`
+			TotalCount uint64 `json:"totalCount"`
+		}
+		require.NoError(t, json.Unmarshal(body, &page))
+		require.EqualValues(t, 2, page.TotalCount)
+
+		roles := map[string]string{}
+		for _, member := range page.Members {
+			roles[member.Email] = member.Role
+		}
+		require.Equal(t, map[string]string{
+			owner.Email:      "owner",
+			contractor.Email: "read-only",
+		}, roles)
+
+		status, body = doRequest(contractorToken, http.MethodPut, baseURL+"/role?projectID="+project.ID.String(),
+			`{"email":"contractor@test.test","role":"admin"}`)
+		require.Equal(t, http.StatusUnauthorized, status, string(body))
+	})
+}
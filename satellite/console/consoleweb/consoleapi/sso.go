@@ -0,0 +1,259 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc"
+	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+)
+
+const (
+	// ssoStateCookie is the name of the cookie, which binds the sign in at the identity provider to the browser.
+	ssoStateCookie = "_ssoState"
+	// ssoStateLifetime is the time the user has to sign in at the identity provider.
+	ssoStateLifetime = 10 * time.Minute
+	// ssoVerificationCookie is the name of the cookie, which keeps a sign in waiting for the proof of the user.
+	ssoVerificationCookie = "_ssoVerification"
+)
+
+var (
+	// ErrSSOAPI - console single sign-on api error type.
+	ErrSSOAPI = errs.Class("console sso api error")
+)
+
+// SSO is an api controller that exposes the OpenID Connect single sign-on flow.
+type SSO struct {
+	log             *zap.Logger
+	service         *console.Service
+	provider        *consoleoidc.Provider
+	cookieAuth      *consolewebauth.CookieAuth
+	externalAddress string
+}
+
+// NewSSO is a constructor for api single sign-on controller.
+func NewSSO(log *zap.Logger, service *console.Service, provider *consoleoidc.Provider, cookieAuth *consolewebauth.CookieAuth, externalAddress string) *SSO {
+	return &SSO{
+		log:             log,
+		service:         service,
+		provider:        provider,
+		cookieAuth:      cookieAuth,
+		externalAddress: externalAddress,
+	}
+}
+
+// Login redirects the user to the identity provider for signing in.
+func (sso *SSO) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := randomSSOValue()
+	if err != nil {
+		sso.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+	nonce, err := randomSSOValue()
+	if err != nil {
+		sso.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	authURL, err := sso.provider.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		sso.serveJSONError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    state + "." + nonce,
+		Path:     "/api/v0/auth/sso",
+		Expires:  time.Now().Add(ssoStateLifetime),
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(sso.externalAddress, "https://"),
+		// the identity provider redirects back with a cross-site navigation.
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback finishes the sign in at the identity provider, authenticates the user
+// and redirects to the console.
+func (sso *SSO) Callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	query := r.URL.Query()
+
+	cookie, err := r.Cookie(ssoStateCookie)
+	if err != nil {
+		sso.serveJSONError(w, http.StatusBadRequest, ErrSSOAPI.New("missing sign in state"))
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    "",
+		Path:     "/api/v0/auth/sso",
+		Expires:  time.Unix(0, 0),
+		HttpOnly: true,
+	})
+
+	parts := strings.SplitN(cookie.Value, ".", 2)
+	if len(parts) != 2 || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(query.Get("state"))) != 1 {
+		sso.serveJSONError(w, http.StatusBadRequest, ErrSSOAPI.New("sign in state mismatch"))
+		return
+	}
+
+	if idpError := query.Get("error"); idpError != "" {
+		sso.serveJSONError(w, http.StatusUnauthorized, ErrSSOAPI.New("identity provider error: %s %s", idpError, query.Get("error_description")))
+		return
+	}
+
+	claims, err := sso.provider.Exchange(ctx, query.Get("code"), parts[1])
+	if err != nil {
+		sso.serveJSONError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	result, err := sso.service.SSOLogin(ctx, console.SSOClaims{
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		FullName:      claims.Name,
+	})
+	if err != nil {
+		sso.serveJSONError(w, sso.getStatusCode(err), err)
+		return
+	}
+
+	if result.VerificationToken != "" {
+		// the console asks for the proof named in the query and finishes the sign in with Verify.
+		http.SetCookie(w, &http.Cookie{
+			Name:     ssoVerificationCookie,
+			Value:    result.VerificationToken,
+			Path:     "/api/v0/auth/sso",
+			Expires:  time.Now().Add(console.SSOVerificationLifetime),
+			HttpOnly: true,
+			Secure:   strings.HasPrefix(sso.externalAddress, "https://"),
+			SameSite: http.SameSiteStrictMode,
+		})
+		http.Redirect(w, r, sso.externalAddress+"login?sso-verify="+string(result.Verification), http.StatusFound)
+		return
+	}
+
+	sso.cookieAuth.SetTokenCookie(w, result.Token)
+
+	http.Redirect(w, r, sso.externalAddress, http.StatusFound)
+}
+
+// Verify finishes a sign in, which needs the password or the second factor of the user,
+// and returns auth token.
+func (sso *SSO) Verify(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request struct {
+		Password        string `json:"password"`
+		MFAPasscode     string `json:"mfaPasscode"`
+		MFARecoveryCode string `json:"mfaRecoveryCode"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		sso.serveJSONError(w, http.StatusBadRequest, ErrSSOAPI.Wrap(err))
+		return
+	}
+
+	cookie, err := r.Cookie(ssoVerificationCookie)
+	if err != nil {
+		sso.serveJSONError(w, http.StatusUnauthorized, ErrSSOAPI.New("missing sign in verification"))
+		return
+	}
+
+	token, err := sso.service.VerifySSOLogin(ctx, cookie.Value, console.SSOProof{
+		Password:        request.Password,
+		MFAPasscode:     request.MFAPasscode,
+		MFARecoveryCode: request.MFARecoveryCode,
+	})
+	if err != nil {
+		sso.serveJSONError(w, sso.getStatusCode(err), err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoVerificationCookie,
+		Value:    "",
+		Path:     "/api/v0/auth/sso",
+		Expires:  time.Unix(0, 0),
+		HttpOnly: true,
+	})
+	sso.cookieAuth.SetTokenCookie(w, token)
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(token)
+	if err != nil {
+		sso.log.Error("verify handler could not encode token response", zap.Error(ErrSSOAPI.Wrap(err)))
+	}
+}
+
+// randomSSOValue returns a random value for the state and nonce of a sign in.
+func randomSSOValue() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", ErrSSOAPI.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// getStatusCode returns http.StatusCode depends on console error class.
+func (sso *SSO) getStatusCode(err error) int {
+	switch {
+	case console.ErrSSO.Has(err), console.ErrUnauthorized.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrMFAPasscode.Has(err), console.ErrMFARecoveryCode.Has(err), console.ErrMFAMissing.Has(err):
+		return http.StatusBadRequest
+	case console.ErrMFALocked.Has(err):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (sso *SSO) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if status == http.StatusInternalServerError {
+		sso.log.Error("returning internal server error to client", zap.Int("code", status), zap.Error(err))
+	} else {
+		sso.log.Debug("returning error to client", zap.Int("code", status), zap.Error(err))
+	}
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sso.log.Error("failed to write json error response", zap.Error(ErrSSOAPI.Wrap(err)))
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc/oidctest"
+)
+
+func Test_SSO(t *testing.T) {
+	idp, err := oidctest.NewServer("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OIDC.Enabled = true
+				config.Console.OIDC.IssuerURL = idp.URL()
+				config.Console.OIDC.ClientID = "satellite"
+				config.Console.OIDC.ClientSecret = "secret"
+				config.Console.OIDC.Scopes = "openid email profile"
+				config.Console.RateLimit.Burst = 10
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		baseURL := "http://" + sat.API.Console.Listener.Addr().String()
+
+		idp.SetIdentity(oidctest.Identity{
+			Subject:       "1234567890",
+			Email:         "sso@mail.test",
+			EmailVerified: true,
+			Name:          "SSO User",
+		})
+
+		jar, err := cookiejar.New(nil)
+		require.NoError(t, err)
+		client := &http.Client{
+			Jar: jar,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		// get requests the url and returns the redirect location.
+		get := func(url string) (int, string) {
+			response, err := client.Get(url)
+			require.NoError(t, err)
+			body, err := ioutil.ReadAll(response.Body)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			if response.StatusCode != http.StatusFound {
+				return response.StatusCode, string(body)
+			}
+			return response.StatusCode, response.Header.Get("Location")
+		}
+
+		// the callback requires the state of a sign in started by the browser
+		status, _ := get(baseURL + "/api/v0/auth/sso/callback?state=state&code=code")
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, authURL := get(baseURL + "/api/v0/auth/sso/login")
+		require.Equal(t, http.StatusFound, status, authURL)
+
+		status, callbackURL := get(authURL)
+		require.Equal(t, http.StatusFound, status, callbackURL)
+
+		status, consoleURL := get(callbackURL)
+		require.Equal(t, http.StatusFound, status, consoleURL)
+		require.Equal(t, baseURL+"/", consoleURL)
+
+		// the browser is signed in as the provisioned user
+		status, body := get(baseURL + "/api/v0/auth/account")
+		require.Equal(t, http.StatusOK, status, body)
+
+		var account struct {
+			FullName string `json:"fullName"`
+			Email    string `json:"email"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(body), &account))
+		require.Equal(t, "SSO User", account.FullName)
+		require.Equal(t, "sso@mail.test", account.Email)
+
+		// the state can't be used twice
+		status, _ = get(callbackURL)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		// an existing account is linked only with the password of the user
+		existing, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Existing User",
+			Email:    "existing@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		idp.SetIdentity(oidctest.Identity{
+			Subject:       "existing",
+			Email:         existing.Email,
+			EmailVerified: true,
+			Name:          existing.FullName,
+		})
+
+		status, authURL = get(baseURL + "/api/v0/auth/sso/login")
+		require.Equal(t, http.StatusFound, status, authURL)
+		status, callbackURL = get(authURL)
+		require.Equal(t, http.StatusFound, status, callbackURL)
+		status, consoleURL = get(callbackURL)
+		require.Equal(t, http.StatusFound, status, consoleURL)
+		require.Equal(t, baseURL+"/login?sso-verify=password", consoleURL)
+
+		// verify posts the proof and returns the status.
+		verify := func(password string) int {
+			response, err := client.Post(baseURL+"/api/v0/auth/sso/verify", "application/json",
+				strings.NewReader(`{"password":"`+password+`"}`))
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			return response.StatusCode
+		}
+
+		require.Equal(t, http.StatusUnauthorized, verify("wrong"))
+		require.Equal(t, http.StatusOK, verify(existing.FullName))
+
+		status, body = get(baseURL + "/api/v0/auth/account")
+		require.Equal(t, http.StatusOK, status, body)
+		require.NoError(t, json.Unmarshal([]byte(body), &account))
+		require.Equal(t, existing.Email, account.Email)
+
+		// the verification can't be used twice
+		require.Equal(t, http.StatusUnauthorized, verify(existing.FullName))
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrUsageAlertsAPI - console usage alerts api error type.
+	ErrUsageAlertsAPI = errs.Class("console usage alerts api error")
+)
+
+// UsageAlerts is an api controller that exposes the usage alerts of projects and their notifications.
+type UsageAlerts struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewUsageAlerts is a constructor for api usage alerts controller.
+func NewUsageAlerts(log *zap.Logger, service *console.Service) *UsageAlerts {
+	return &UsageAlerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// usageAlert is the json representation of a usage alert in requests.
+type usageAlert struct {
+	Kind      console.UsageAlertKind `json:"kind"`
+	Threshold int64                  `json:"threshold"`
+}
+
+// Get returns the usage alerts of a project.
+func (alerts *UsageAlerts) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := alerts.projectID(r)
+	if err != nil {
+		alerts.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	projectAlerts, err := alerts.service.GetProjectUsageAlerts(ctx, projectID)
+	if err != nil {
+		alerts.serveJSONError(w, alerts.getStatusCode(err), err)
+		return
+	}
+	if projectAlerts == nil {
+		projectAlerts = []console.UsageAlert{}
+	}
+
+	err = json.NewEncoder(w).Encode(projectAlerts)
+	if err != nil {
+		alerts.log.Error("failed to write json usage alerts response", zap.Error(ErrUsageAlertsAPI.Wrap(err)))
+	}
+}
+
+// Update replaces the usage alerts of a project.
+func (alerts *UsageAlerts) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := alerts.projectID(r)
+	if err != nil {
+		alerts.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request []usageAlert
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		alerts.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	projectAlerts := make([]console.UsageAlert, 0, len(request))
+	for _, alert := range request {
+		projectAlerts = append(projectAlerts, console.UsageAlert{
+			ProjectID: projectID,
+			Kind:      alert.Kind,
+			Threshold: alert.Threshold,
+		})
+	}
+
+	err = alerts.service.UpdateProjectUsageAlerts(ctx, projectID, projectAlerts)
+	if err != nil {
+		alerts.serveJSONError(w, alerts.getStatusCode(err), err)
+		return
+	}
+}
+
+// Notifications returns the latest usage notifications of a project.
+func (alerts *UsageAlerts) Notifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, err := alerts.projectID(r)
+	if err != nil {
+		alerts.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	notifications, err := alerts.service.GetProjectUsageNotifications(ctx, projectID)
+	if err != nil {
+		alerts.serveJSONError(w, alerts.getStatusCode(err), err)
+		return
+	}
+	if notifications == nil {
+		notifications = []console.UsageNotification{}
+	}
+
+	err = json.NewEncoder(w).Encode(notifications)
+	if err != nil {
+		alerts.log.Error("failed to write json usage notifications response", zap.Error(ErrUsageAlertsAPI.Wrap(err)))
+	}
+}
+
+// projectID returns the project id of the route.
+func (alerts *UsageAlerts) projectID(r *http.Request) (uuid.UUID, error) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		return uuid.UUID{}, ErrUsageAlertsAPI.New("missing project id route param")
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		return uuid.UUID{}, ErrUsageAlertsAPI.New("invalid project id: %v", err)
+	}
+	return projectID, nil
+}
+
+// getStatusCode returns http.StatusCode depends on console error class.
+func (alerts *UsageAlerts) getStatusCode(err error) int {
+	switch {
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (alerts *UsageAlerts) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	if status == http.StatusInternalServerError {
+		alerts.log.Error("returning internal server error to client", zap.Int("code", status), zap.Error(err))
+	} else {
+		alerts.log.Debug("returning error to client", zap.Int("code", status), zap.Error(err))
+	}
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		alerts.log.Error("failed to write json error response", zap.Error(ErrUsageAlertsAPI.Wrap(err)))
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package consoleoidc implements the relying party side of the OpenID Connect
+// authorization code flow, which is used for single sign-on to the satellite console.
+package consoleoidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for OpenID Connect errors.
+	Error = errs.Class("oidc")
+
+	// ErrInvalidToken is error type that occurs when the identity provider returned an invalid id token.
+	ErrInvalidToken = errs.Class("oidc invalid id token")
+)
+
+// clockSkew is the tolerated difference between the clocks of the satellite and the identity provider.
+const clockSkew = time.Minute
+
+// maxResponseSize limits the size of the responses read from the identity provider.
+const maxResponseSize = 1 << 20
+
+// Config contains configuration for OpenID Connect single sign-on.
+type Config struct {
+	Enabled      bool   `help:"enable single sign-on through an OpenID Connect identity provider" default:"false"`
+	IssuerURL    string `help:"issuer url of the OpenID Connect identity provider" default:""`
+	ClientID     string `help:"client id registered at the identity provider" default:""`
+	ClientSecret string `help:"client secret registered at the identity provider" default:""`
+	RedirectURL  string `help:"callback url registered at the identity provider (default: <external-address>/api/v0/auth/sso/callback)" default:""`
+	Scopes       string `help:"space separated scopes requested from the identity provider" default:"openid email profile"`
+}
+
+// Claims are the verified claims of an id token.
+type Claims struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider is an OpenID Connect identity provider. Its metadata and signing keys are
+// discovered on first use and cached.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	mu       sync.Mutex
+	metadata *metadata
+	keys     map[string]*rsa.PublicKey
+}
+
+// metadata is the part of the identity provider metadata used by Provider.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// NewProvider creates a new identity provider, which uses client for requests.
+func NewProvider(config Config, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Provider{
+		config: config,
+		client: client,
+	}
+}
+
+// AuthCodeURL returns the url of the identity provider, where the user signs in.
+// The identity provider redirects back to the redirect url with the state and a code,
+// which is exchanged for the claims by Exchange.
+func (provider *Provider) AuthCodeURL(ctx context.Context, state, nonce string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadata, err := provider.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.config.ClientID)
+	query.Set("redirect_uri", provider.config.RedirectURL)
+	query.Set("scope", provider.scopes())
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// scopes returns the requested scopes, which always include openid.
+func (provider *Provider) scopes() string {
+	scopes := strings.Fields(provider.config.Scopes)
+	for _, scope := range scopes {
+		if scope == "openid" {
+			return strings.Join(scopes, " ")
+		}
+	}
+	return strings.Join(append([]string{"openid"}, scopes...), " ")
+}
+
+// Exchange exchanges the code for an id token and returns its verified claims.
+// The nonce must match the one passed to AuthCodeURL.
+func (provider *Provider) Exchange(ctx context.Context, code, nonce string) (_ *Claims, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadata, err := provider.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", provider.config.RedirectURL)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	request.SetBasicAuth(url.QueryEscape(provider.config.ClientID), url.QueryEscape(provider.config.ClientSecret))
+
+	var response struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := provider.do(request, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || response.Error != "" {
+		return nil, Error.New("token request failed with status %d: %s %s", status, response.Error, response.ErrorDescription)
+	}
+	if response.IDToken == "" {
+		return nil, Error.New("token response doesn't contain an id token")
+	}
+
+	return provider.verify(ctx, metadata, response.IDToken, nonce, time.Now())
+}
+
+// Verify verifies the signature and the claims of an id token issued by the
+// identity provider and returns its claims.
+func (provider *Provider) Verify(ctx context.Context, idToken, nonce string) (_ *Claims, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metadata, err := provider.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return provider.verify(ctx, metadata, idToken, nonce, time.Now())
+}
+
+// verify verifies the signature and the claims of the id token.
+func (provider *Provider) verify(ctx context.Context, metadata *metadata, idToken, nonce string, now time.Time) (_ *Claims, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken.New("malformed token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidToken.Wrap(err)
+	}
+	if header.Algorithm != "RS256" {
+		return nil, ErrInvalidToken.New("unsupported signing algorithm %q", header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken.Wrap(err)
+	}
+
+	key, err := provider.key(ctx, metadata, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, ErrInvalidToken.New("invalid signature")
+	}
+
+	var payload struct {
+		Issuer        string      `json:"iss"`
+		Subject       string      `json:"sub"`
+		Audience      audience    `json:"aud"`
+		ExpiresAt     int64       `json:"exp"`
+		IssuedAt      int64       `json:"iat"`
+		Nonce         string      `json:"nonce"`
+		Email         string      `json:"email"`
+		EmailVerified interface{} `json:"email_verified"`
+		Name          string      `json:"name"`
+	}
+	if err := decodeSegment(parts[1], &payload); err != nil {
+		return nil, ErrInvalidToken.Wrap(err)
+	}
+
+	switch {
+	case payload.Issuer != metadata.Issuer:
+		return nil, ErrInvalidToken.New("unexpected issuer %q", payload.Issuer)
+	case !payload.Audience.contains(provider.config.ClientID):
+		return nil, ErrInvalidToken.New("token isn't issued for client %q", provider.config.ClientID)
+	case now.After(time.Unix(payload.ExpiresAt, 0).Add(clockSkew)):
+		return nil, ErrInvalidToken.New("token expired")
+	case payload.IssuedAt != 0 && now.Add(clockSkew).Before(time.Unix(payload.IssuedAt, 0)):
+		return nil, ErrInvalidToken.New("token issued in the future")
+	case nonce == "" || payload.Nonce != nonce:
+		return nil, ErrInvalidToken.New("nonce mismatch")
+	case payload.Subject == "":
+		return nil, ErrInvalidToken.New("missing subject")
+	}
+
+	claims := &Claims{
+		Issuer:  payload.Issuer,
+		Subject: payload.Subject,
+		Email:   payload.Email,
+		Name:    payload.Name,
+	}
+	// some identity providers encode email_verified as a string.
+	switch verified := payload.EmailVerified.(type) {
+	case bool:
+		claims.EmailVerified = verified
+	case string:
+		claims.EmailVerified = verified == "true"
+	}
+
+	return claims, nil
+}
+
+// discover returns the metadata of the identity provider.
+func (provider *Provider) discover(ctx context.Context) (_ *metadata, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.metadata != nil {
+		return provider.metadata, nil
+	}
+
+	issuer := strings.TrimSuffix(provider.config.IssuerURL, "/")
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var discovered metadata
+	status, err := provider.do(request, &discovered)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, Error.New("discovery failed with status %d", status)
+	}
+	if strings.TrimSuffix(discovered.Issuer, "/") != issuer {
+		return nil, Error.New("issuer %q doesn't match the configured issuer %q", discovered.Issuer, provider.config.IssuerURL)
+	}
+	if discovered.AuthorizationEndpoint == "" || discovered.TokenEndpoint == "" || discovered.JWKSURI == "" {
+		return nil, Error.New("incomplete identity provider metadata")
+	}
+
+	provider.metadata = &discovered
+	return provider.metadata, nil
+}
+
+// key returns the signing key with the id. The keys are refetched, when the
+// key isn't known, because the identity provider may have rotated its keys.
+func (provider *Provider) key(ctx context.Context, metadata *metadata, keyID string) (_ *rsa.PublicKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if key, ok := provider.findKey(keyID); ok {
+		return key, nil
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, metadata.JWKSURI, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var keySet struct {
+		Keys []struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			Use     string `json:"use"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		} `json:"keys"`
+	}
+	status, err := provider.do(request, &keySet)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, Error.New("fetching signing keys failed with status %d", status)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range keySet.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, Error.New("invalid modulus of key %q: %v", jwk.KeyID, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, Error.New("invalid exponent of key %q: %v", jwk.KeyID, err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, Error.New("invalid exponent of key %q", jwk.KeyID)
+		}
+
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(exponent.Int64()),
+		}
+	}
+	provider.keys = keys
+
+	if key, ok := provider.findKey(keyID); ok {
+		return key, nil
+	}
+	return nil, ErrInvalidToken.New("unknown signing key %q", keyID)
+}
+
+// findKey returns the cached signing key with the id. Tokens without a key id
+// are accepted, when the identity provider has a single key.
+func (provider *Provider) findKey(keyID string) (*rsa.PublicKey, bool) {
+	if keyID == "" && len(provider.keys) == 1 {
+		for _, key := range provider.keys {
+			return key, true
+		}
+	}
+	key, ok := provider.keys[keyID]
+	return key, ok
+}
+
+// do sends the request and decodes the json response into v.
+func (provider *Provider) do(request *http.Request, v interface{}) (status int, err error) {
+	response, err := provider.client.Do(request)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(response.Body.Close())) }()
+
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+	if err != nil {
+		return response.StatusCode, Error.Wrap(err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil && response.StatusCode == http.StatusOK {
+		return response.StatusCode, Error.Wrap(err)
+	}
+	return response.StatusCode, nil
+}
+
+// decodeSegment decodes a base64url encoded json segment of a token.
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// audience is the aud claim, which is either a string or an array of strings.
+type audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (aud *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*aud = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*aud = multiple
+	return nil
+}
+
+// contains returns whether the audience contains the client id.
+func (aud audience) contains(clientID string) bool {
+	for _, value := range aud {
+		if value == clientID {
+			return true
+		}
+	}
+	return false
+}
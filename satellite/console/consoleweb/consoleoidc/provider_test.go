@@ -0,0 +1,155 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleoidc_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc/oidctest"
+)
+
+func TestProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	idp, err := oidctest.NewServer("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	idp.SetIdentity(oidctest.Identity{
+		Subject:       "1234567890",
+		Email:         "user@mail.test",
+		EmailVerified: true,
+		Name:          "Test User",
+	})
+
+	const redirectURL = "http://satellite.test/api/v0/auth/sso/callback"
+	provider := consoleoidc.NewProvider(consoleoidc.Config{
+		Enabled:      true,
+		IssuerURL:    idp.URL(),
+		ClientID:     "satellite",
+		ClientSecret: "secret",
+		RedirectURL:  redirectURL,
+		Scopes:       "email profile",
+	}, nil)
+
+	// signIn follows the authorization request to the identity provider and returns
+	// the query of the redirect back to the satellite.
+	signIn := func(t *testing.T, nonce string) url.Values {
+		authURL, err := provider.AuthCodeURL(ctx, "state", nonce)
+		require.NoError(t, err)
+
+		parsed, err := url.Parse(authURL)
+		require.NoError(t, err)
+		require.Equal(t, "openid email profile", parsed.Query().Get("scope"))
+
+		client := &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		response, err := client.Get(authURL)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		require.Equal(t, http.StatusFound, response.StatusCode)
+
+		location, err := url.Parse(response.Header.Get("Location"))
+		require.NoError(t, err)
+		require.Equal(t, "satellite.test", location.Host)
+		require.Equal(t, "state", location.Query().Get("state"))
+		return location.Query()
+	}
+
+	t.Run("sign in", func(t *testing.T) {
+		query := signIn(t, "nonce")
+
+		claims, err := provider.Exchange(ctx, query.Get("code"), "nonce")
+		require.NoError(t, err)
+		require.Equal(t, &consoleoidc.Claims{
+			Issuer:        idp.URL(),
+			Subject:       "1234567890",
+			Email:         "user@mail.test",
+			EmailVerified: true,
+			Name:          "Test User",
+		}, claims)
+
+		// codes can only be used once
+		_, err = provider.Exchange(ctx, query.Get("code"), "nonce")
+		require.Error(t, err)
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		query := signIn(t, "nonce")
+
+		_, err := provider.Exchange(ctx, query.Get("code"), "other nonce")
+		require.True(t, consoleoidc.ErrInvalidToken.Has(err), err)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		_, err := provider.Exchange(ctx, "unknown", "nonce")
+		require.Error(t, err)
+	})
+
+	t.Run("wrong client secret", func(t *testing.T) {
+		other := consoleoidc.NewProvider(consoleoidc.Config{
+			IssuerURL:    idp.URL(),
+			ClientID:     "satellite",
+			ClientSecret: "wrong",
+			RedirectURL:  redirectURL,
+		}, nil)
+
+		query := signIn(t, "nonce")
+		_, err := other.Exchange(ctx, query.Get("code"), "nonce")
+		require.Error(t, err)
+	})
+}
+
+func TestProviderVerify(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	idp, err := oidctest.NewServer("satellite", "secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	other, err := oidctest.NewServer("satellite", "secret")
+	require.NoError(t, err)
+	defer other.Close()
+
+	provider := consoleoidc.NewProvider(consoleoidc.Config{
+		IssuerURL:    idp.URL(),
+		ClientID:     "satellite",
+		ClientSecret: "secret",
+	}, nil)
+
+	identity := oidctest.Identity{Subject: "1234567890", Email: "user@mail.test"}
+
+	token, err := idp.IDToken(identity, "nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	claims, err := provider.Verify(ctx, token, "nonce")
+	require.NoError(t, err)
+	require.Equal(t, "1234567890", claims.Subject)
+	require.False(t, claims.EmailVerified)
+
+	expired, err := idp.IDToken(identity, "nonce", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = provider.Verify(ctx, expired, "nonce")
+	require.True(t, consoleoidc.ErrInvalidToken.Has(err), err)
+
+	// tokens signed by another identity provider have the wrong issuer and signature
+	forged, err := other.IDToken(identity, "nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = provider.Verify(ctx, forged, "nonce")
+	require.True(t, consoleoidc.ErrInvalidToken.Has(err), err)
+
+	_, err = provider.Verify(ctx, "not.a.token", "nonce")
+	require.True(t, consoleoidc.ErrInvalidToken.Has(err), err)
+}
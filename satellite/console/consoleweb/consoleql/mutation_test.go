@@ -66,7 +66,8 @@ func TestGraphqlMutation(t *testing.T) {
 
 		projectLimitCache := accounting.NewProjectLimitCache(db.ProjectAccounting(), 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
 
-		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, 5*time.Minute)
+		bucketLimitCache := accounting.NewBucketLimitCache(db.Buckets(), accounting.ProjectLimitConfig{CacheCapacity: 100})
+		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, bucketLimitCache, 5*time.Minute)
 
 		// TODO maybe switch this test to testplanet to avoid defining config and Stripe service
 		pc := paymentsconfig.Config{
@@ -50,7 +50,8 @@ func TestGraphqlQuery(t *testing.T) {
 
 		projectLimitCache := accounting.NewProjectLimitCache(db.ProjectAccounting(), 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
 
-		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, 5*time.Minute)
+		bucketLimitCache := accounting.NewBucketLimitCache(db.Buckets(), accounting.ProjectLimitConfig{CacheCapacity: 100})
+		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, bucketLimitCache, 5*time.Minute)
 
 		// TODO maybe switch this test to testplanet to avoid defining config and Stripe service
 		pc := paymentsconfig.Config{
@@ -246,13 +247,13 @@ func TestGraphqlQuery(t *testing.T) {
 		users, err := service.AddProjectMembers(authCtx, createdProject.ID, []string{
 			user1.Email,
 			user2.Email,
-		})
+		}, console.RoleDeveloper)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(users))
 
 		t.Run("Project query team members", func(t *testing.T) {
 			query := fmt.Sprintf(
-				"query {project(id: \"%s\") {members( cursor: { limit: %d, search: \"%s\", page: %d, order: %d, orderDirection: %d } ) { projectMembers{ user { id, fullName, shortName, email, createdAt }, joinedAt }, search, limit, order, offset, pageCount, currentPage, totalCount } } }",
+				"query {project(id: \"%s\") {members( cursor: { limit: %d, search: \"%s\", page: %d, order: %d, orderDirection: %d } ) { projectMembers{ user { id, fullName, shortName, email, createdAt }, joinedAt, role }, search, limit, order, offset, pageCount, currentPage, totalCount } } }",
 				createdProject.ID.String(),
 				5,
 				"",
@@ -292,12 +293,15 @@ func TestGraphqlQuery(t *testing.T) {
 				case rootUser.ID.String():
 					foundRoot = true
 					testUser(t, user, rootUser)
+					assert.Equal(t, console.RoleOwner.String(), member[consoleql.FieldRole])
 				case user1.ID.String():
 					foundU1 = true
 					testUser(t, user, user1)
+					assert.Equal(t, console.RoleDeveloper.String(), member[consoleql.FieldRole])
 				case user2.ID.String():
 					foundU2 = true
 					testUser(t, user, user2)
+					assert.Equal(t, console.RoleDeveloper.String(), member[consoleql.FieldRole])
 				}
 			}
 
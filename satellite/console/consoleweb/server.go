@@ -38,6 +38,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
+	"storj.io/storj/satellite/console/consoleweb/consoleoidc"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
 	"storj.io/storj/satellite/mailservice"
@@ -93,6 +94,7 @@ type Config struct {
 	PathwayOverviewEnabled          bool   `help:"indicates if the overview onboarding step should render with pathways" default:"true"`
 
 	RateLimit web.IPRateLimiterConfig
+	OIDC      consoleoidc.Config
 
 	console.Config
 }
@@ -180,6 +182,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 		server.withAuth(http.HandlerFunc(server.projectUsageLimitsHandler)),
 	).Methods(http.MethodGet)
 
+	usageAlertsController := consoleapi.NewUsageAlerts(logger, service)
+	router.Handle("/api/v0/projects/{id}/usage-alerts", server.withAuth(http.HandlerFunc(usageAlertsController.Get))).Methods(http.MethodGet)
+	router.Handle("/api/v0/projects/{id}/usage-alerts", server.withAuth(http.HandlerFunc(usageAlertsController.Update))).Methods(http.MethodPut)
+	router.Handle("/api/v0/projects/{id}/usage-notifications", server.withAuth(http.HandlerFunc(usageAlertsController.Notifications))).Methods(http.MethodGet)
+
 	authController := consoleapi.NewAuth(logger, service, mailService, server.cookieAuth, partners, server.analytics, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
 	authRouter.Handle("/account", server.withAuth(http.HandlerFunc(authController.GetAccount))).Methods(http.MethodGet)
@@ -187,12 +194,27 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	authRouter.Handle("/account/change-email", server.withAuth(http.HandlerFunc(authController.ChangeEmail))).Methods(http.MethodPost)
 	authRouter.Handle("/account/change-password", server.withAuth(http.HandlerFunc(authController.ChangePassword))).Methods(http.MethodPost)
 	authRouter.Handle("/account/delete", server.withAuth(http.HandlerFunc(authController.DeleteAccount))).Methods(http.MethodPost)
+	authRouter.Handle("/mfa/generate-secret-key", server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey))).Methods(http.MethodPost)
+	authRouter.Handle("/mfa/enable", server.rateLimiter.Limit(server.withAuth(http.HandlerFunc(authController.EnableUserMFA)))).Methods(http.MethodPost)
+	authRouter.Handle("/mfa/disable", server.rateLimiter.Limit(server.withAuth(http.HandlerFunc(authController.DisableUserMFA)))).Methods(http.MethodPost)
+	authRouter.Handle("/mfa/regenerate-recovery-codes", server.rateLimiter.Limit(server.withAuth(http.HandlerFunc(authController.RegenerateMFARecoveryCodes)))).Methods(http.MethodPost)
 	authRouter.HandleFunc("/logout", authController.Logout).Methods(http.MethodPost)
 	authRouter.Handle("/token", server.rateLimiter.Limit(http.HandlerFunc(authController.Token))).Methods(http.MethodPost)
 	authRouter.Handle("/register", server.rateLimiter.Limit(http.HandlerFunc(authController.Register))).Methods(http.MethodPost)
 	authRouter.Handle("/forgot-password/{email}", server.rateLimiter.Limit(http.HandlerFunc(authController.ForgotPassword))).Methods(http.MethodPost)
 	authRouter.Handle("/resend-email/{id}", server.rateLimiter.Limit(http.HandlerFunc(authController.ResendEmail))).Methods(http.MethodPost)
 
+	if server.config.OIDC.Enabled {
+		oidcConfig := server.config.OIDC
+		if oidcConfig.RedirectURL == "" {
+			oidcConfig.RedirectURL = server.config.ExternalAddress + "api/v0/auth/sso/callback"
+		}
+		ssoController := consoleapi.NewSSO(logger, service, consoleoidc.NewProvider(oidcConfig, nil), server.cookieAuth, server.config.ExternalAddress)
+		authRouter.Handle("/sso/login", server.rateLimiter.Limit(http.HandlerFunc(ssoController.Login))).Methods(http.MethodGet)
+		authRouter.Handle("/sso/callback", server.rateLimiter.Limit(http.HandlerFunc(ssoController.Callback))).Methods(http.MethodGet)
+		authRouter.Handle("/sso/verify", server.rateLimiter.Limit(http.HandlerFunc(ssoController.Verify))).Methods(http.MethodPost)
+	}
+
 	paymentController := consoleapi.NewPayments(logger, service)
 	paymentsRouter := router.PathPrefix("/api/v0/payments").Subrouter()
 	paymentsRouter.Use(server.withAuth)
@@ -211,11 +233,23 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/lifecycle", bucketsController.Lifecycle).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/lifecycle", bucketsController.UpdateLifecycle).Methods(http.MethodPut)
+	bucketsRouter.HandleFunc("/limits", bucketsController.Limits).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/limits", bucketsController.UpdateLimits).Methods(http.MethodPut)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
 	apiKeysRouter.Use(server.withAuth)
 	apiKeysRouter.HandleFunc("/delete-by-name", apiKeysController.DeleteByNameAndProjectID).Methods(http.MethodDelete)
+	apiKeysRouter.HandleFunc("/limits", apiKeysController.UpdateLimits).Methods(http.MethodPut)
+	apiKeysRouter.HandleFunc("/usage", apiKeysController.Usage).Methods(http.MethodGet)
+
+	projectMembersController := consoleapi.NewProjectMembers(logger, service)
+	projectMembersRouter := router.PathPrefix("/api/v0/project-members").Subrouter()
+	projectMembersRouter.Use(server.withAuth)
+	projectMembersRouter.HandleFunc("", projectMembersController.List).Methods(http.MethodGet)
+	projectMembersRouter.HandleFunc("/role", projectMembersController.UpdateRole).Methods(http.MethodPut)
 
 	analyticsController := consoleapi.NewAnalytics(logger, service, server.analytics)
 	analyticsRouter := router.PathPrefix("/api/v0/analytics").Subrouter()
@@ -321,6 +355,7 @@ func (server *Server) appHandler(w http.ResponseWriter, r *http.Request) {
 		FileBrowserFlowDisabled         bool
 		LinksharingURL                  string
 		PathwayOverviewEnabled          bool
+		SSOEnabled                      bool
 		StorageTBPrice                  string
 		EgressTBPrice                   string
 		ObjectPrice                     string
@@ -344,6 +379,7 @@ func (server *Server) appHandler(w http.ResponseWriter, r *http.Request) {
 	data.FileBrowserFlowDisabled = server.config.FileBrowserFlowDisabled
 	data.LinksharingURL = server.config.LinksharingURL
 	data.PathwayOverviewEnabled = server.config.PathwayOverviewEnabled
+	data.SSOEnabled = server.config.OIDC.Enabled
 	data.StorageTBPrice = server.pricing.StorageTBPrice
 	data.EgressTBPrice = server.pricing.EgressTBPrice
 	data.ObjectPrice = server.pricing.ObjectPrice
@@ -23,6 +23,10 @@ type DB interface {
 	RegistrationTokens() RegistrationTokens
 	// ResetPasswordTokens is a getter for ResetPasswordTokens repository.
 	ResetPasswordTokens() ResetPasswordTokens
+	// SSOIdentities is a getter for SSOIdentities repository.
+	SSOIdentities() SSOIdentities
+	// UsageAlerts is a getter for UsageAlerts repository.
+	UsageAlerts() UsageAlerts
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
@@ -0,0 +1,313 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+const (
+	// MFARecoveryCodeCount is the number of recovery codes issued to a user.
+	MFARecoveryCodeCount = 10
+	// mfaRecoveryCodeSize is the number of random bytes in a recovery code.
+	mfaRecoveryCodeSize = 5
+
+	mfaMissingErrMsg      = "A multi-factor authentication passcode or recovery code is required"
+	mfaPasscodeErrMsg     = "The multi-factor authentication passcode is invalid"
+	mfaRecoveryCodeErrMsg = "The multi-factor authentication recovery code is invalid"
+	mfaEnabledErrMsg      = "Multi-factor authentication is already enabled"
+	mfaNotEnabledErrMsg   = "Multi-factor authentication is not enabled"
+	mfaNoSecretKeyErrMsg  = "A multi-factor authentication secret key must be generated first"
+	mfaLockedErrMsg       = "Too many failed multi-factor authentication attempts, please try again later"
+)
+
+var (
+	// ErrMFAMissing is error type that occurs when a login requires a second factor, which wasn't provided.
+	ErrMFAMissing = errs.Class("MFA credentials missing")
+
+	// ErrMFAPasscode is error type that occurs when a MFA passcode is invalid.
+	ErrMFAPasscode = errs.Class("MFA passcode error")
+
+	// ErrMFARecoveryCode is error type that occurs when a MFA recovery code is invalid.
+	ErrMFARecoveryCode = errs.Class("MFA recovery code error")
+
+	// ErrMFAConflict is error type that occurs when the MFA state of the user doesn't allow the operation.
+	ErrMFAConflict = errs.Class("MFA conflict")
+
+	// ErrMFALocked is error type that occurs when the second factor of the user is locked after too many failed attempts.
+	ErrMFALocked = errs.Class("MFA locked")
+)
+
+// MFAConfig keeps track of the multi-factor authentication configuration of the console service.
+type MFAConfig struct {
+	MaxFailedAttempts int           `help:"number of failed multi-factor authentication attempts before the second factor of a user is locked" default:"5"`
+	LockoutDuration   time.Duration `help:"how long the second factor of a user is locked after too many failed multi-factor authentication attempts" default:"15m"`
+}
+
+// ResetMFASecretKey generates a new MFA secret key for the user, which needs to be
+// confirmed with a passcode by EnableUserMFA. It's not allowed while MFA is enabled.
+func (s *Service) ResetMFASecretKey(ctx context.Context) (key string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := s.getAuthAndAuditLog(ctx, "reset MFA secret key")
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	if auth.User.MFAEnabled {
+		return "", ErrMFAConflict.New(mfaEnabledErrMsg)
+	}
+
+	key, err = consoleauth.NewTOTPSecretKey()
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	auth.User.MFASecretKey = key
+	err = s.store.Users().UpdateMFA(ctx, &auth.User)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return key, nil
+}
+
+// EnableUserMFA enables MFA for the user, once the passcode proves that the secret key
+// was enrolled. It returns the recovery codes of the user, which are only shown once.
+func (s *Service) EnableUserMFA(ctx context.Context, passcode string, t time.Time) (recoveryCodes []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := s.getAuthAndAuditLog(ctx, "enable MFA")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if auth.User.MFAEnabled {
+		return nil, ErrMFAConflict.New(mfaEnabledErrMsg)
+	}
+	if auth.User.MFASecretKey == "" {
+		return nil, ErrMFAConflict.New(mfaNoSecretKeyErrMsg)
+	}
+
+	if err := s.verifyMFAPasscode(ctx, &auth.User, passcode, t); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, hashes, err := newMFARecoveryCodes()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	auth.User.MFAEnabled = true
+	auth.User.MFARecoveryCodes = hashes
+	err = s.store.Users().UpdateMFA(ctx, &auth.User)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableUserMFA disables MFA for the user. Either a valid passcode or one of the
+// recovery codes of the user is required.
+func (s *Service) DisableUserMFA(ctx context.Context, passcode string, t time.Time, recoveryCode string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := s.getAuthAndAuditLog(ctx, "disable MFA")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if !auth.User.MFAEnabled {
+		return ErrMFAConflict.New(mfaNotEnabledErrMsg)
+	}
+
+	if err := s.verifyMFA(ctx, &auth.User, passcode, t, recoveryCode); err != nil {
+		return err
+	}
+
+	auth.User.MFAEnabled = false
+	auth.User.MFASecretKey = ""
+	auth.User.MFARecoveryCodes = nil
+	err = s.store.Users().UpdateMFA(ctx, &auth.User)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// ResetMFARecoveryCodes replaces the recovery codes of the user with new ones.
+func (s *Service) ResetMFARecoveryCodes(ctx context.Context, passcode string, t time.Time) (recoveryCodes []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := s.getAuthAndAuditLog(ctx, "reset MFA recovery codes")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !auth.User.MFAEnabled {
+		return nil, ErrMFAConflict.New(mfaNotEnabledErrMsg)
+	}
+
+	if err := s.verifyMFAPasscode(ctx, &auth.User, passcode, t); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, hashes, err := newMFARecoveryCodes()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	auth.User.MFARecoveryCodes = hashes
+	err = s.store.Users().UpdateMFA(ctx, &auth.User)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// verifyMFA checks the second factor of a user with MFA enabled, which is either
+// a passcode or one of the recovery codes of the user.
+func (s *Service) verifyMFA(ctx context.Context, user *User, passcode string, t time.Time, recoveryCode string) error {
+	switch {
+	case recoveryCode != "":
+		return s.verifyMFARecoveryCode(ctx, user, recoveryCode, t)
+	case passcode != "":
+		return s.verifyMFAPasscode(ctx, user, passcode, t)
+	default:
+		return ErrMFAMissing.New(mfaMissingErrMsg)
+	}
+}
+
+// verifyMFAPasscode checks a passcode of the user. Every passcode is accepted only
+// once, even though it's valid for several periods.
+func (s *Service) verifyMFAPasscode(ctx context.Context, user *User, passcode string, t time.Time) error {
+	if t.Before(user.MFALockedUntil) {
+		return ErrMFALocked.New(mfaLockedErrMsg)
+	}
+
+	counter, valid, err := consoleauth.ValidateTOTPPasscodeCounter(passcode, user.MFASecretKey, t)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if valid {
+		valid, err = s.store.Users().UseMFACounter(ctx, user.ID, counter)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	if !valid {
+		return s.failMFA(ctx, user, t, ErrMFAPasscode.New(mfaPasscodeErrMsg))
+	}
+
+	user.MFALastCounter = counter
+	return s.resetMFAFailedAttempts(ctx, user)
+}
+
+// verifyMFARecoveryCode checks a recovery code of the user. A recovery code can only
+// be used once, so it's removed from the user when it matches.
+func (s *Service) verifyMFARecoveryCode(ctx context.Context, user *User, recoveryCode string, t time.Time) error {
+	if t.Before(user.MFALockedUntil) {
+		return ErrMFALocked.New(mfaLockedErrMsg)
+	}
+
+	hash := hashMFARecoveryCode(recoveryCode)
+	for i, code := range user.MFARecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(code), []byte(hash)) != 1 {
+			continue
+		}
+
+		remaining := append(user.MFARecoveryCodes[:i:i], user.MFARecoveryCodes[i+1:]...)
+		used, err := s.store.Users().UpdateMFARecoveryCodes(ctx, user.ID, user.MFARecoveryCodes, remaining)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !used {
+			// the recovery codes were changed by a concurrent request, which might have used the same code.
+			break
+		}
+
+		user.MFARecoveryCodes = remaining
+		return s.resetMFAFailedAttempts(ctx, user)
+	}
+	return s.failMFA(ctx, user, t, ErrMFARecoveryCode.New(mfaRecoveryCodeErrMsg))
+}
+
+// failMFA records a failed attempt of the user and returns the error of the attempt.
+// The second factor of the user is locked once too many attempts failed.
+func (s *Service) failMFA(ctx context.Context, user *User, t time.Time, attemptErr error) error {
+	for {
+		attempts, lockedUntil := user.MFAFailedAttempts+1, time.Time{}
+		if s.config.MFA.MaxFailedAttempts > 0 && attempts >= s.config.MFA.MaxFailedAttempts {
+			attempts, lockedUntil = 0, t.Add(s.config.MFA.LockoutDuration)
+		}
+
+		updated, err := s.store.Users().UpdateMFAFailedAttempts(ctx, user.ID, user.MFAFailedAttempts, attempts, lockedUntil)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if updated {
+			user.MFAFailedAttempts, user.MFALockedUntil = attempts, lockedUntil
+			return attemptErr
+		}
+
+		// another attempt was recorded in the meantime, so retry with the current count.
+		current, err := s.store.Users().Get(ctx, user.ID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		user.MFAFailedAttempts, user.MFALockedUntil = current.MFAFailedAttempts, current.MFALockedUntil
+		if t.Before(user.MFALockedUntil) {
+			return attemptErr
+		}
+	}
+}
+
+// resetMFAFailedAttempts clears the failed attempts of the user after a successful attempt.
+func (s *Service) resetMFAFailedAttempts(ctx context.Context, user *User) error {
+	if user.MFAFailedAttempts == 0 {
+		return nil
+	}
+
+	// a concurrent failed attempt may have been recorded in the meantime, which is kept then.
+	_, err := s.store.Users().UpdateMFAFailedAttempts(ctx, user.ID, user.MFAFailedAttempts, 0, time.Time{})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	user.MFAFailedAttempts = 0
+	return nil
+}
+
+// newMFARecoveryCodes generates new recovery codes together with the hashes,
+// which are stored instead of the codes.
+func newMFARecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < MFARecoveryCodeCount; i++ {
+		var random [mfaRecoveryCodeSize]byte
+		if _, err := rand.Read(random[:]); err != nil {
+			return nil, nil, err
+		}
+
+		code := base32.StdEncoding.EncodeToString(random[:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashMFARecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashMFARecoveryCode hashes a recovery code, ignoring case and separators.
+func hashMFARecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+func TestMFA(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			service := sat.API.Console.Service
+
+			user, err := sat.API.DB.Console().Users().Get(ctx, planet.Uplinks[0].Projects[0].Owner.ID)
+			require.NoError(t, err)
+			// testplanet uses the full name as password.
+			password := user.FullName
+
+			// authorize creates a context with the current state of the user.
+			authorize := func(passcode, recoveryCode string) context.Context {
+				token, err := service.TokenWithMFA(ctx, user.Email, password, passcode, recoveryCode)
+				require.NoError(t, err)
+				auth, err := service.Authorize(consoleauth.WithAPIKey(ctx, []byte(token)))
+				require.NoError(t, err)
+				return console.WithAuth(ctx, auth)
+			}
+
+			now := time.Now()
+
+			// enabling requires a secret key
+			_, err = service.EnableUserMFA(authorize("", ""), "123456", now)
+			require.True(t, console.ErrMFAConflict.Has(err))
+
+			key, err := service.ResetMFASecretKey(authorize("", ""))
+			require.NoError(t, err)
+
+			// enabling requires a valid passcode
+			_, err = service.EnableUserMFA(authorize("", ""), "000000", now.Add(time.Hour))
+			require.True(t, console.ErrMFAPasscode.Has(err))
+
+			passcode, err := consoleauth.TOTPPasscode(key, now)
+			require.NoError(t, err)
+			codes, err := service.EnableUserMFA(authorize("", ""), passcode, now)
+			require.NoError(t, err)
+			require.Len(t, codes, console.MFARecoveryCodeCount)
+
+			// the login requires the second factor
+			_, err = service.Token(ctx, user.Email, password)
+			require.True(t, console.ErrMFAMissing.Has(err))
+
+			_, err = service.TokenWithMFA(ctx, user.Email, "wrong", passcode, "")
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			wrongPasscode, err := consoleauth.TOTPPasscode(key, now.Add(time.Hour))
+			require.NoError(t, err)
+			_, err = service.TokenWithMFA(ctx, user.Email, password, wrongPasscode, "")
+			require.True(t, console.ErrMFAPasscode.Has(err))
+
+			// a passcode can only be used once
+			_, err = service.TokenWithMFA(ctx, user.Email, password, passcode, "")
+			require.True(t, console.ErrMFAPasscode.Has(err))
+
+			nextPasscode, err := consoleauth.TOTPPasscode(key, now.Add(consoleauth.TOTPPeriod))
+			require.NoError(t, err)
+			authCtx := authorize(nextPasscode, "")
+
+			// a new secret key can't be generated while enabled
+			_, err = service.ResetMFASecretKey(authCtx)
+			require.True(t, console.ErrMFAConflict.Has(err))
+
+			// recovery codes can only be used once
+			_ = authorize("", codes[0])
+			_, err = service.TokenWithMFA(ctx, user.Email, password, "", codes[0])
+			require.True(t, console.ErrMFARecoveryCode.Has(err))
+
+			// the recovery codes are stored hashed
+			stored, err := sat.API.DB.Console().Users().Get(ctx, user.ID)
+			require.NoError(t, err)
+			require.True(t, stored.MFAEnabled)
+			require.Len(t, stored.MFARecoveryCodes, console.MFARecoveryCodeCount-1)
+			for _, code := range codes {
+				require.NotContains(t, stored.MFARecoveryCodes, code)
+			}
+
+			// regenerated recovery codes replace the old ones
+			later := now.Add(2 * consoleauth.TOTPPeriod)
+			laterPasscode, err := consoleauth.TOTPPasscode(key, later)
+			require.NoError(t, err)
+			newCodes, err := service.ResetMFARecoveryCodes(authorize("", codes[1]), laterPasscode, later)
+			require.NoError(t, err)
+			require.Len(t, newCodes, console.MFARecoveryCodeCount)
+
+			_, err = service.TokenWithMFA(ctx, user.Email, password, "", codes[2])
+			require.True(t, console.ErrMFARecoveryCode.Has(err))
+
+			// disabling with a recovery code removes the second factor from the login
+			err = service.DisableUserMFA(authorize("", newCodes[0]), "", now, newCodes[1])
+			require.NoError(t, err)
+
+			_, err = service.Token(ctx, user.Email, password)
+			require.NoError(t, err)
+
+			stored, err = sat.API.DB.Console().Users().Get(ctx, user.ID)
+			require.NoError(t, err)
+			require.False(t, stored.MFAEnabled)
+			require.Empty(t, stored.MFASecretKey)
+			require.Empty(t, stored.MFARecoveryCodes)
+
+			err = service.DisableUserMFA(authorize("", ""), passcode, now, "")
+			require.True(t, console.ErrMFAConflict.Has(err))
+		})
+}
+
+func TestMFALockout(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			service := sat.API.Console.Service
+			config := sat.Config.Console.MFA
+
+			user, err := sat.API.DB.Console().Users().Get(ctx, planet.Uplinks[0].Projects[0].Owner.ID)
+			require.NoError(t, err)
+			// testplanet uses the full name as password.
+			password := user.FullName
+
+			// the token is created before enabling MFA, so it stays usable while locked.
+			token, err := service.Token(ctx, user.Email, password)
+			require.NoError(t, err)
+
+			// authorize creates a context with the current state of the user.
+			authorize := func() context.Context {
+				auth, err := service.Authorize(consoleauth.WithAPIKey(ctx, []byte(token)))
+				require.NoError(t, err)
+				return console.WithAuth(ctx, auth)
+			}
+
+			now := time.Now()
+			key, err := service.ResetMFASecretKey(authorize())
+			require.NoError(t, err)
+			passcode, err := consoleauth.TOTPPasscode(key, now)
+			require.NoError(t, err)
+			codes, err := service.EnableUserMFA(authorize(), passcode, now)
+			require.NoError(t, err)
+
+			wrongPasscode, err := consoleauth.TOTPPasscode(key, now.Add(time.Hour))
+			require.NoError(t, err)
+			for i := 0; i < config.MaxFailedAttempts; i++ {
+				_, err = service.TokenWithMFA(ctx, user.Email, password, wrongPasscode, "")
+				require.True(t, console.ErrMFAPasscode.Has(err))
+			}
+
+			// neither passcodes nor recovery codes are accepted while locked
+			nextPasscode, err := consoleauth.TOTPPasscode(key, now.Add(consoleauth.TOTPPeriod))
+			require.NoError(t, err)
+			_, err = service.TokenWithMFA(ctx, user.Email, password, nextPasscode, "")
+			require.True(t, console.ErrMFALocked.Has(err))
+			_, err = service.TokenWithMFA(ctx, user.Email, password, "", codes[0])
+			require.True(t, console.ErrMFALocked.Has(err))
+
+			stored, err := sat.API.DB.Console().Users().Get(ctx, user.ID)
+			require.NoError(t, err)
+			require.Zero(t, stored.MFAFailedAttempts)
+			require.WithinDuration(t, now.Add(config.LockoutDuration), stored.MFALockedUntil, time.Minute)
+			require.Len(t, stored.MFARecoveryCodes, console.MFARecoveryCodeCount)
+
+			// the lockout ends after the lockout duration
+			unlocked := stored.MFALockedUntil.Add(time.Second)
+			unlockedPasscode, err := consoleauth.TOTPPasscode(key, unlocked)
+			require.NoError(t, err)
+			err = service.DisableUserMFA(authorize(), unlockedPasscode, unlocked, "")
+			require.NoError(t, err)
+		})
+}
@@ -18,8 +18,10 @@ type ProjectMembers interface {
 	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]ProjectMember, error)
 	// GetPagedByProjectID is a method for querying project members from the database by projectID and cursor
 	GetPagedByProjectID(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (*ProjectMembersPage, error)
-	// Insert is a method for inserting project member into the database.
-	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
+	// Insert is a method for inserting project member with a role into the database.
+	Insert(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
+	// UpdateRole is a method for changing the role of a project member.
+	UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) error
 	// Delete is a method for deleting project member by memberID and projectID from the database.
 	Delete(ctx context.Context, memberID, projectID uuid.UUID) error
 }
@@ -30,6 +32,8 @@ type ProjectMember struct {
 	MemberID uuid.UUID
 	// FK on Projects table.
 	ProjectID uuid.UUID
+	// Role decides which operations the member is allowed to do in the project.
+	Role ProjectMemberRole
 
 	CreatedAt time.Time
 }
@@ -5,6 +5,8 @@ package console_test
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,7 +30,7 @@ func TestProjectMembersRepository(t *testing.T) {
 		t.Run("Can't insert projectMember without memberID", func(t *testing.T) {
 			missingUserID := testrand.UUID()
 
-			projMember, err := projectMembers.Insert(ctx, missingUserID, createdProjects[0].ID)
+			projMember, err := projectMembers.Insert(ctx, missingUserID, createdProjects[0].ID, console.RoleDeveloper)
 			assert.Nil(t, projMember)
 			assert.Error(t, err)
 		})
@@ -36,37 +38,38 @@ func TestProjectMembersRepository(t *testing.T) {
 		t.Run("Can't insert projectMember without projectID", func(t *testing.T) {
 			missingProjectID := testrand.UUID()
 
-			projMember, err := projectMembers.Insert(ctx, createdUsers[0].ID, missingProjectID)
+			projMember, err := projectMembers.Insert(ctx, createdUsers[0].ID, missingProjectID, console.RoleDeveloper)
 			assert.Nil(t, projMember)
 			assert.Error(t, err)
 		})
 
 		t.Run("Insert  success", func(t *testing.T) {
-			projMember1, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[0].ID)
+			projMember1, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[0].ID, console.RoleAdmin)
 			assert.NotNil(t, projMember1)
 			assert.NoError(t, err)
+			assert.Equal(t, console.RoleAdmin, projMember1.Role)
 
-			projMember2, err := projectMembers.Insert(ctx, createdUsers[1].ID, createdProjects[0].ID)
+			projMember2, err := projectMembers.Insert(ctx, createdUsers[1].ID, createdProjects[0].ID, console.RoleDeveloper)
 			assert.NotNil(t, projMember2)
 			assert.NoError(t, err)
 
-			projMember3, err := projectMembers.Insert(ctx, createdUsers[3].ID, createdProjects[0].ID)
+			projMember3, err := projectMembers.Insert(ctx, createdUsers[3].ID, createdProjects[0].ID, console.RoleDeveloper)
 			assert.NotNil(t, projMember3)
 			assert.NoError(t, err)
 
-			projMember4, err := projectMembers.Insert(ctx, createdUsers[4].ID, createdProjects[0].ID)
+			projMember4, err := projectMembers.Insert(ctx, createdUsers[4].ID, createdProjects[0].ID, console.RoleDeveloper)
 			assert.NotNil(t, projMember4)
 			assert.NoError(t, err)
 
-			projMember5, err := projectMembers.Insert(ctx, createdUsers[5].ID, createdProjects[0].ID)
+			projMember5, err := projectMembers.Insert(ctx, createdUsers[5].ID, createdProjects[0].ID, console.RoleDeveloper)
 			assert.NotNil(t, projMember5)
 			assert.NoError(t, err)
 
-			projMember6, err := projectMembers.Insert(ctx, createdUsers[2].ID, createdProjects[1].ID)
+			projMember6, err := projectMembers.Insert(ctx, createdUsers[2].ID, createdProjects[1].ID, console.RoleDeveloper)
 			assert.NotNil(t, projMember6)
 			assert.NoError(t, err)
 
-			projMember7, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[1].ID)
+			projMember7, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[1].ID, console.RoleDeveloper)
 			assert.NotNil(t, projMember7)
 			assert.NoError(t, err)
 		})
@@ -137,6 +140,19 @@ func TestProjectMembersRepository(t *testing.T) {
 			assert.Equal(t, originalMember2.ID, selectedMembers2[0].MemberID)
 		})
 
+		t.Run("Update role", func(t *testing.T) {
+			err := projectMembers.UpdateRole(ctx, createdUsers[1].ID, createdProjects[0].ID, console.RoleReadOnly)
+			assert.NoError(t, err)
+
+			members, err := projectMembers.GetByMemberID(ctx, createdUsers[1].ID)
+			assert.NoError(t, err)
+			require.Len(t, members, 1)
+			assert.Equal(t, console.RoleReadOnly, members[0].Role)
+
+			err = projectMembers.UpdateRole(ctx, createdUsers[1].ID, createdProjects[1].ID, console.RoleAdmin)
+			assert.True(t, errors.Is(err, sql.ErrNoRows))
+		})
+
 		t.Run("Delete member by memberID and projectID success", func(t *testing.T) {
 			err := projectMembers.Delete(ctx, createdUsers[0].ID, createdProjects[0].ID)
 			assert.NoError(t, err)
@@ -0,0 +1,106 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"strings"
+)
+
+// ProjectMemberRole is the role of a member in a project.
+type ProjectMemberRole int
+
+const (
+	// RoleOwner is the role of the project owner, who is allowed to do everything.
+	RoleOwner ProjectMemberRole = 1
+	// RoleAdmin is allowed to do everything except deleting the project.
+	RoleAdmin ProjectMemberRole = 2
+	// RoleDeveloper is allowed to manage api keys and buckets, but not the project or its members.
+	RoleDeveloper ProjectMemberRole = 3
+	// RoleReadOnly is only allowed to view the project, its members and its usage.
+	RoleReadOnly ProjectMemberRole = 4
+)
+
+// ProjectMemberRoles lists all roles, which can be assigned to project members.
+var ProjectMemberRoles = []ProjectMemberRole{RoleOwner, RoleAdmin, RoleDeveloper, RoleReadOnly}
+
+// String returns the name of the role.
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleOwner:
+		return "owner"
+	case RoleAdmin:
+		return "admin"
+	case RoleDeveloper:
+		return "developer"
+	case RoleReadOnly:
+		return "read-only"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseProjectMemberRole parses the name of a role.
+func ParseProjectMemberRole(name string) (ProjectMemberRole, error) {
+	for _, role := range ProjectMemberRoles {
+		if strings.EqualFold(role.String(), name) {
+			return role, nil
+		}
+	}
+	return 0, ErrValidation.New("invalid project member role %q", name)
+}
+
+// Permission is an operation on a project, which is granted to some roles.
+type Permission int
+
+const (
+	// PermissionViewProject allows to view the project, its members and its usage.
+	PermissionViewProject Permission = iota
+	// PermissionUpdateProject allows to change the name and description of the project.
+	PermissionUpdateProject
+	// PermissionDeleteProject allows to delete the project.
+	PermissionDeleteProject
+	// PermissionManageMembers allows to add and remove project members and change their roles.
+	PermissionManageMembers
+	// PermissionViewAPIKeys allows to list api keys and their usage.
+	PermissionViewAPIKeys
+	// PermissionManageAPIKeys allows to create and delete api keys.
+	PermissionManageAPIKeys
+	// PermissionViewBuckets allows to list buckets and view their settings.
+	PermissionViewBuckets
+	// PermissionManageBuckets allows to change bucket settings, such as lifecycle rules.
+	PermissionManageBuckets
+	// PermissionManageLimits allows to change api key and bucket limits.
+	PermissionManageLimits
+)
+
+// rolePermissions is the permission matrix of the project member roles.
+var rolePermissions = map[ProjectMemberRole][]Permission{
+	RoleOwner: {
+		PermissionViewProject, PermissionUpdateProject, PermissionDeleteProject, PermissionManageMembers,
+		PermissionViewAPIKeys, PermissionManageAPIKeys, PermissionViewBuckets, PermissionManageBuckets,
+		PermissionManageLimits,
+	},
+	RoleAdmin: {
+		PermissionViewProject, PermissionUpdateProject, PermissionManageMembers,
+		PermissionViewAPIKeys, PermissionManageAPIKeys, PermissionViewBuckets, PermissionManageBuckets,
+		PermissionManageLimits,
+	},
+	RoleDeveloper: {
+		PermissionViewProject,
+		PermissionViewAPIKeys, PermissionManageAPIKeys, PermissionViewBuckets, PermissionManageBuckets,
+	},
+	RoleReadOnly: {
+		PermissionViewProject,
+	},
+}
+
+// Can returns whether the role is granted the permission.
+func (role ProjectMemberRole) Can(permission Permission) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -26,6 +26,7 @@ import (
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/metainfo/metabase"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/rewards"
 )
@@ -43,15 +44,17 @@ const (
 
 // Error messages.
 const (
-	unauthorizedErrMsg                   = "You are not authorized to perform this action"
-	emailUsedErrMsg                      = "This email is already in use, try another"
-	passwordRecoveryTokenIsExpiredErrMsg = "Your password recovery link has expired, please request another one"
-	credentialsErrMsg                    = "Your email or password was incorrect, please try again"
-	passwordIncorrectErrMsg              = "Your password needs at least %d characters long"
-	projectOwnerDeletionForbiddenErrMsg  = "%s is a project owner and can not be deleted"
-	apiKeyWithNameExistsErrMsg           = "An API Key with this name already exists in this project, please use a different name"
-	apiKeyWithNameDoesntExistErrMsg      = "An API Key with this name doesn't exist in this project."
-	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
+	unauthorizedErrMsg                    = "You are not authorized to perform this action"
+	emailUsedErrMsg                       = "This email is already in use, try another"
+	passwordRecoveryTokenIsExpiredErrMsg  = "Your password recovery link has expired, please request another one"
+	credentialsErrMsg                     = "Your email or password was incorrect, please try again"
+	passwordIncorrectErrMsg               = "Your password needs at least %d characters long"
+	projectOwnerDeletionForbiddenErrMsg   = "%s is a project owner and can not be deleted"
+	projectOwnerRoleChangeForbiddenErrMsg = "The role of the project owner can not be changed"
+	projectMemberRoleErrMsg               = "The %s role can not be assigned to project members"
+	apiKeyWithNameExistsErrMsg            = "An API Key with this name already exists in this project, please use a different name"
+	apiKeyWithNameDoesntExistErrMsg       = "An API Key with this name doesn't exist in this project."
+	teamMemberDoesNotExistErrMsg          = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
 
 	usedRegTokenErrMsg = "This registration token has already been used"
@@ -96,7 +99,9 @@ type Service struct {
 	accounts          payments.Accounts
 	analytics         *analytics.Service
 
-	config Config
+	config         Config
+	ssoAutoJoin    ssoAutoJoin
+	ssoLinkDomains map[string]bool
 
 	minCoinPayment int64
 }
@@ -107,6 +112,8 @@ type Config struct {
 	OpenRegistrationEnabled bool `help:"enable open registration" default:"false"`
 	DefaultProjectLimit     int  `help:"default project limits for users" default:"3"`
 	UsageLimits             UsageLimitsConfig
+	SSO                     SSOConfig
+	MFA                     MFAConfig
 }
 
 // PaymentsService separates all payment related functionality.
@@ -129,6 +136,11 @@ func NewService(log *zap.Logger, signer Signer, store DB, projectAccounting acco
 		config.PasswordCost = bcrypt.DefaultCost
 	}
 
+	ssoAutoJoin, err := parseSSOAutoJoin(config.SSO)
+	if err != nil {
+		return nil, errs.New("invalid single sign-on config: %v", err)
+	}
+
 	return &Service{
 		log:               log,
 		auditLogger:       log.Named("auditlog"),
@@ -141,6 +153,8 @@ func NewService(log *zap.Logger, signer Signer, store DB, projectAccounting acco
 		accounts:          accounts,
 		analytics:         analytics,
 		config:            config,
+		ssoAutoJoin:       ssoAutoJoin,
+		ssoLinkDomains:    parseSSOLinkDomains(config.SSO),
 		minCoinPayment:    minCoinPayment,
 	}, nil
 }
@@ -764,6 +778,15 @@ func (s *Service) RevokeResetPasswordToken(ctx context.Context, resetPasswordTok
 // Token authenticates User by credentials and returns auth token.
 func (s *Service) Token(ctx context.Context, email, password string) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.TokenWithMFA(ctx, email, password, "", "")
+}
+
+// TokenWithMFA authenticates User by credentials and returns auth token.
+// When the user has MFA enabled, either a passcode or a recovery code is required,
+// otherwise ErrMFAMissing is returned. Users linked to an identity provider account
+// get ErrSSO, when password login is disabled for them.
+func (s *Service) TokenWithMFA(ctx context.Context, email, password, passcode, recoveryCode string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	user, err := s.store.Users().GetByEmail(ctx, email)
 	if err != nil {
@@ -775,6 +798,18 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 		return "", ErrUnauthorized.New(credentialsErrMsg)
 	}
 
+	err = s.checkPasswordLoginAllowed(ctx, user)
+	if err != nil {
+		return "", err
+	}
+
+	if user.MFAEnabled {
+		err = s.verifyMFA(ctx, user, passcode, time.Now(), recoveryCode)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	claims := consoleauth.Claims{
 		ID:         user.ID,
 		Expiration: time.Now().Add(tokenExpirationTime),
@@ -946,7 +981,7 @@ func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Proje
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1045,7 +1080,7 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 			return Error.Wrap(err)
 		}
 
-		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID)
+		_, err = tx.ProjectMembers().Insert(ctx, auth.User.ID, p.ID, RoleOwner)
 		if err != nil {
 			return Error.Wrap(err)
 		}
@@ -1078,7 +1113,7 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return Error.Wrap(err)
 	}
 
-	_, err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionDeleteProject)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1110,7 +1145,7 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, name s
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionUpdateProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1126,15 +1161,19 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, name s
 	return project, nil
 }
 
-// AddProjectMembers adds users by email to given project.
-func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
+// AddProjectMembers adds users by email to given project with the role.
+func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string, role ProjectMemberRole) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
-	auth, err := s.getAuthAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	auth, err := s.getAuthAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails), zap.Stringer("role", role))
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if err = validateAssignableRole(role); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers); err != nil {
 		return nil, Error.Wrap(err)
 	}
 
@@ -1158,7 +1197,7 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	// add project members in transaction scope
 	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
 		for _, user := range users {
-			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID); err != nil {
+			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID, role); err != nil {
 				return err
 			}
 		}
@@ -1179,7 +1218,7 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return Error.Wrap(err)
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers); err != nil {
 		return Error.Wrap(err)
 	}
 
@@ -1223,6 +1262,50 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 	return Error.Wrap(err)
 }
 
+// UpdateProjectMemberRole changes the role of a project member by email.
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID uuid.UUID, email string, role ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := s.getAuthAndAuditLog(ctx, "update project member role", zap.String("projectID", projectID.String()), zap.String("email", email), zap.Stringer("role", role))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageMembers)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err = validateAssignableRole(role); err != nil {
+		return err
+	}
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	if err != nil {
+		return ErrValidation.New(teamMemberDoesNotExistErrMsg)
+	}
+
+	if isMember.project.OwnerID == user.ID {
+		return ErrValidation.New(projectOwnerRoleChangeForbiddenErrMsg)
+	}
+
+	err = s.store.ProjectMembers().UpdateRole(ctx, user.ID, projectID, role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrValidation.New(teamMemberDoesNotExistErrMsg)
+	}
+	return Error.Wrap(err)
+}
+
+// validateAssignableRole checks that the role can be given to a project member.
+// The owner role belongs to the owner of the project and can't be assigned.
+func validateAssignableRole(role ProjectMemberRole) error {
+	switch role {
+	case RoleAdmin, RoleDeveloper, RoleReadOnly:
+		return nil
+	default:
+		return ErrValidation.New(projectMemberRoleErrMsg, role)
+	}
+}
+
 // GetProjectMembers returns ProjectMembers for given Project.
 func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cursor ProjectMembersCursor) (pmp *ProjectMembersPage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1232,7 +1315,7 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, cu
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1258,7 +1341,7 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return nil, nil, Error.Wrap(err)
 	}
@@ -1309,7 +1392,7 @@ func (s *Service) GetAPIKeyInfo(ctx context.Context, id uuid.UUID) (_ *APIKeyInf
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1340,7 +1423,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+		_, err = s.checkProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionManageAPIKeys)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
@@ -1373,7 +1456,7 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageAPIKeys)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1391,6 +1474,63 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 	return nil
 }
 
+// UpdateAPIKeyLimits updates the request rate and bandwidth limits of an api key.
+// A nil limit removes the limit.
+func (s *Service) UpdateAPIKeyLimits(ctx context.Context, id uuid.UUID, rateLimit *int, bandwidthLimit *int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "update api key limits", zap.String("apiKeyID", id.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	key, err := s.store.APIKeys().Get(ctx, id)
+	if err != nil {
+		return ErrNoAPIKey.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, key.ProjectID, PermissionManageLimits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if rateLimit != nil && *rateLimit < 0 {
+		return ErrValidation.New("api key rate limit cannot be negative")
+	}
+	if bandwidthLimit != nil && *bandwidthLimit < 0 {
+		return ErrValidation.New("api key bandwidth limit cannot be negative")
+	}
+
+	err = s.store.APIKeys().UpdateLimits(ctx, id, rateLimit, bandwidthLimit)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetAPIKeysUsage returns the request count and egress of every api key of the project for a given period.
+func (s *Service) GetAPIKeysUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []APIKeyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get api keys usage", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewAPIKeys)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage, err := s.store.APIKeys().GetUsageByProjectID(ctx, projectID, since, before)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return usage, nil
+}
+
 // GetAPIKeys returns paged api key list for given Project.
 func (s *Service) GetAPIKeys(ctx context.Context, projectID uuid.UUID, cursor APIKeyCursor) (page *APIKeyPage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1400,7 +1540,7 @@ func (s *Service) GetAPIKeys(ctx context.Context, projectID uuid.UUID, cursor AP
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewAPIKeys)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1426,7 +1566,7 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1448,7 +1588,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, Error.Wrap(err)
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1470,7 +1610,7 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewBuckets)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1496,6 +1636,133 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// GetBucketLifecycle returns the lifecycle rules of a bucket. Rules filtered by
+// an encrypted key prefix are managed with an access grant and aren't returned.
+func (s *Service) GetBucketLifecycle(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []metabase.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get bucket lifecycle", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewBuckets)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rules, err := s.buckets.GetBucketLifecycle(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var unfiltered []metabase.LifecycleRule
+	for _, rule := range rules {
+		if len(rule.Prefix) == 0 {
+			unfiltered = append(unfiltered, rule)
+		}
+	}
+
+	return unfiltered, nil
+}
+
+// UpdateBucketLifecycle replaces the lifecycle rules of a bucket. Object keys are
+// encrypted, so the rules cannot be filtered by a prefix. Existing rules with a
+// prefix were set with an access grant and are kept.
+func (s *Service) UpdateBucketLifecycle(ctx context.Context, projectID uuid.UUID, bucketName string, rules []metabase.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "update bucket lifecycle", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageBuckets)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	existing, err := s.buckets.GetBucketLifecycle(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, rule := range rules {
+		if len(rule.Prefix) != 0 {
+			return ErrValidation.New("lifecycle rule %q: prefix can only be set with an access grant", rule.ID)
+		}
+	}
+
+	for _, rule := range existing {
+		if len(rule.Prefix) != 0 {
+			rules = append(rules, rule)
+		}
+	}
+
+	if err := metabase.VerifyLifecycleRules(rules); err != nil {
+		return ErrValidation.Wrap(err)
+	}
+
+	err = s.buckets.UpdateBucketLifecycle(ctx, []byte(bucketName), projectID, rules)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketLimits returns the limits of a bucket.
+func (s *Service) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ accounting.BucketLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return accounting.BucketLimits{}, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewBuckets)
+	if err != nil {
+		return accounting.BucketLimits{}, Error.Wrap(err)
+	}
+
+	limits, err := s.buckets.GetBucketLimits(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return accounting.BucketLimits{}, Error.Wrap(err)
+	}
+
+	return limits, nil
+}
+
+// UpdateBucketLimits replaces the limits of a bucket. Only the project owner
+// is allowed to change them, since the limits are used for splitting the
+// project between the members.
+func (s *Service) UpdateBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string, limits accounting.BucketLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "update bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageLimits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, limit := range []*int64{limits.Storage, limits.Bandwidth, limits.Objects, limits.Segments} {
+		if limit != nil && *limit < 0 {
+			return ErrValidation.New("bucket limits cannot be negative")
+		}
+	}
+
+	err = s.buckets.UpdateBucketLimits(ctx, []byte(bucketName), projectID, limits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1505,7 +1772,7 @@ func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1525,7 +1792,12 @@ func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID
 func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectUsageLimits, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = s.getAuthAndAuditLog(ctx, "get project usage limits", zap.String("projectID", projectID.String()))
+	auth, err := s.getAuthAndAuditLog(ctx, "get project usage limits", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -1739,6 +2011,10 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 	membership, ok := findMembershipByProjectID(memberships, projectID)
 	if ok {
+		// the owner of the project is always allowed to do everything.
+		if project.OwnerID == userID {
+			membership.Role = RoleOwner
+		}
 		return isProjectMember{
 			project:    project,
 			membership: &membership,
@@ -1748,6 +2024,22 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
+// checkProjectPermission checks if the user is a member of given project,
+// whose role is granted the permission.
+func (s *Service) checkProjectPermission(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, permission Permission) (_ isProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	isMember, err := s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return isProjectMember{}, err
+	}
+
+	if !isMember.membership.Role.Can(permission) {
+		return isProjectMember{}, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	return isMember, nil
+}
+
 func findMembershipByProjectID(memberships []ProjectMember, projectID uuid.UUID) (ProjectMember, bool) {
 	for _, membership := range memberships {
 		if membership.ProjectID == projectID {
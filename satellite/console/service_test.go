@@ -5,6 +5,7 @@ package console_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -14,6 +15,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
 )
 
@@ -67,13 +69,13 @@ func TestService(t *testing.T) {
 
 			t.Run("TestAddProjectMembers", func(t *testing.T) {
 				// Adding members to own project should work
-				addedUsers, err := service.AddProjectMembers(authCtx1, up1Pro1.ID, []string{up2User.Email})
+				addedUsers, err := service.AddProjectMembers(authCtx1, up1Pro1.ID, []string{up2User.Email}, console.RoleDeveloper)
 				require.NoError(t, err)
 				require.Len(t, addedUsers, 1)
 				require.Contains(t, addedUsers, up2User)
 
 				// Adding members to someone else project should not work
-				addedUsers, err = service.AddProjectMembers(authCtx1, up2Pro1.ID, []string{up2User.Email})
+				addedUsers, err = service.AddProjectMembers(authCtx1, up2Pro1.ID, []string{up2User.Email}, console.RoleDeveloper)
 				require.Error(t, err)
 				require.Nil(t, addedUsers)
 			})
@@ -211,3 +213,92 @@ func TestService(t *testing.T) {
 			})
 		})
 }
+
+func TestProjectMemberRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 3},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			service := sat.API.Console.Service
+
+			project := planet.Uplinks[0].Projects[0]
+			owner := project.Owner
+			member := planet.Uplinks[1].Projects[0].Owner
+			other := planet.Uplinks[2].Projects[0].Owner
+
+			ownerCtx, err := sat.AuthenticatedContext(ctx, owner.ID)
+			require.NoError(t, err)
+			memberCtx, err := sat.AuthenticatedContext(ctx, member.ID)
+			require.NoError(t, err)
+
+			_, err = service.AddProjectMembers(ownerCtx, project.ID, []string{member.Email}, console.RoleReadOnly)
+			require.NoError(t, err)
+
+			// only the owner of the project has the owner role
+			_, err = service.AddProjectMembers(ownerCtx, project.ID, []string{other.Email}, console.RoleOwner)
+			require.True(t, console.ErrValidation.Has(err))
+			err = service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.RoleOwner)
+			require.True(t, console.ErrValidation.Has(err))
+			err = service.UpdateProjectMemberRole(ownerCtx, project.ID, owner.Email, console.RoleReadOnly)
+			require.True(t, console.ErrValidation.Has(err))
+
+			t.Run("read-only", func(t *testing.T) {
+				_, err := service.GetProject(memberCtx, project.ID)
+				require.NoError(t, err)
+				_, err = service.GetProjectUsageLimits(memberCtx, project.ID)
+				require.NoError(t, err)
+				_, err = service.GetBucketUsageRollups(memberCtx, project.ID, time.Now().Add(-time.Hour), time.Now())
+				require.NoError(t, err)
+
+				_, err = service.GetAPIKeys(memberCtx, project.ID, console.APIKeyCursor{Page: 1, Limit: 10})
+				require.True(t, console.ErrUnauthorized.Has(err))
+				_, _, err = service.CreateAPIKey(memberCtx, project.ID, "read-only")
+				require.True(t, console.ErrUnauthorized.Has(err))
+				_, err = service.UpdateProject(memberCtx, project.ID, "name", "description")
+				require.True(t, console.ErrUnauthorized.Has(err))
+				err = service.UpdateProjectMemberRole(memberCtx, project.ID, member.Email, console.RoleAdmin)
+				require.True(t, console.ErrUnauthorized.Has(err))
+			})
+
+			t.Run("developer", func(t *testing.T) {
+				err := service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.RoleDeveloper)
+				require.NoError(t, err)
+
+				_, _, err = service.CreateAPIKey(memberCtx, project.ID, "developer")
+				require.NoError(t, err)
+				err = service.DeleteAPIKeyByNameAndProjectID(memberCtx, "developer", project.ID)
+				require.NoError(t, err)
+
+				_, err = service.AddProjectMembers(memberCtx, project.ID, []string{other.Email}, console.RoleReadOnly)
+				require.True(t, console.ErrUnauthorized.Has(err))
+				err = service.UpdateBucketLimits(memberCtx, project.ID, "bucket", accounting.BucketLimits{})
+				require.True(t, console.ErrUnauthorized.Has(err))
+			})
+
+			t.Run("admin", func(t *testing.T) {
+				err := service.UpdateProjectMemberRole(ownerCtx, project.ID, member.Email, console.RoleAdmin)
+				require.NoError(t, err)
+
+				_, err = service.AddProjectMembers(memberCtx, project.ID, []string{other.Email}, console.RoleReadOnly)
+				require.NoError(t, err)
+				err = service.UpdateProjectMemberRole(memberCtx, project.ID, other.Email, console.RoleDeveloper)
+				require.NoError(t, err)
+				err = service.DeleteProjectMembers(memberCtx, project.ID, []string{other.Email})
+				require.NoError(t, err)
+
+				err = service.DeleteProject(memberCtx, project.ID)
+				require.True(t, console.ErrUnauthorized.Has(err))
+			})
+
+			page, err := service.GetProjectMembers(ownerCtx, project.ID, console.ProjectMembersCursor{Page: 1, Limit: 10})
+			require.NoError(t, err)
+			roles := map[uuid.UUID]console.ProjectMemberRole{}
+			for _, member := range page.ProjectMembers {
+				roles[member.MemberID] = member.Role
+			}
+			require.Equal(t, map[uuid.UUID]console.ProjectMemberRole{
+				owner.ID:  console.RoleOwner,
+				member.ID: console.RoleAdmin,
+			}, roles)
+		})
+}
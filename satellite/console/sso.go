@@ -0,0 +1,511 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+const (
+	ssoEmailNotVerifiedErrMsg      = "The identity provider didn't verify the email address of the account"
+	ssoPasswordLoginDisabledErrMsg = "Password login is disabled for this account, please sign in through single sign-on"
+	ssoVerificationErrMsg          = "The single sign-on verification is invalid or expired, please sign in again"
+)
+
+// SSOVerificationLifetime is the time the user has to verify a single sign-on login.
+const SSOVerificationLifetime = 5 * time.Minute
+
+// ssoVerificationPrefix separates the payload of verification tokens from the one of
+// auth tokens, so that a verification token is never accepted as auth token.
+var ssoVerificationPrefix = []byte("sso-verification:")
+
+// ErrSSO is error type that occurs when a single sign-on login is rejected.
+var ErrSSO = errs.Class("SSO error")
+
+// SSOIdentities exposes methods to manage the links between users and their accounts at identity providers.
+//
+// architecture: Database
+type SSOIdentities interface {
+	// GetUserID returns the id of the user linked to the subject of the issuer.
+	GetUserID(ctx context.Context, issuer, subject string) (uuid.UUID, error)
+	// Insert links the subject of the issuer to the user.
+	Insert(ctx context.Context, identity SSOIdentity) error
+	// ExistsForUser returns whether the user is linked to any identity provider account.
+	ExistsForUser(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+// SSOIdentity links a user to the account of the user at an identity provider.
+type SSOIdentity struct {
+	Issuer  string
+	Subject string
+	UserID  uuid.UUID
+}
+
+// SSOClaims are the verified claims about a user, which an identity provider asserted.
+type SSOClaims struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	FullName      string
+}
+
+// SSOVerification is the proof a user has to give to finish a single sign-on login.
+type SSOVerification string
+
+const (
+	// SSOVerificationMFA requires a passcode or a recovery code of a user with MFA enabled.
+	SSOVerificationMFA SSOVerification = "mfa"
+	// SSOVerificationPassword requires the password of an existing user, before the
+	// identity provider account is linked to it.
+	SSOVerificationPassword SSOVerification = "password"
+)
+
+// SSOLoginResult is the result of a single sign-on login. Either the login is finished
+// and Token is set, or the user has to give the proof named by Verification and finish
+// the login with VerifySSOLogin.
+type SSOLoginResult struct {
+	Token             string
+	Verification      SSOVerification
+	VerificationToken string
+}
+
+// SSOProof is the proof of a user, which finishes a single sign-on login.
+type SSOProof struct {
+	Password        string
+	MFAPasscode     string
+	MFARecoveryCode string
+}
+
+// ssoPendingLogin is a single sign-on login, which waits for the proof of the user.
+type ssoPendingLogin struct {
+	UserID  uuid.UUID `json:"userId"`
+	Issuer  string    `json:"issuer"`
+	Subject string    `json:"subject"`
+	// Link is set, when the identity provider account still has to be linked to the user.
+	Link       bool      `json:"link,omitempty"`
+	Expiration time.Time `json:"expires"`
+}
+
+// SSOConfig keeps track of the single sign-on configuration of the console service.
+type SSOConfig struct {
+	LinkDomains          string `help:"comma separated list of email domains owned by the identity provider, existing users of these domains are linked to their identity provider account without signing in with their password" default:""`
+	DomainProjects       string `help:"comma separated list of email-domain=project-id pairs, users signing in through single sign-on join the project of their email domain" default:""`
+	AutoJoinRole         string `help:"role of the users joining the project of their email domain" default:"developer"`
+	DisablePasswordLogin bool   `help:"disallow password login for users linked to an identity provider account" default:"false"`
+}
+
+// ssoAutoJoin contains the parsed domain auto-join configuration.
+type ssoAutoJoin struct {
+	projects map[string]uuid.UUID
+	role     ProjectMemberRole
+}
+
+// parseSSOLinkDomains parses the email domains, whose users are linked without a password.
+func parseSSOLinkDomains(config SSOConfig) map[string]bool {
+	domains := map[string]bool{}
+	for _, domain := range strings.Split(config.LinkDomains, ",") {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain != "" {
+			domains[domain] = true
+		}
+	}
+	return domains
+}
+
+// parseSSOAutoJoin parses the domain auto-join part of the config.
+func parseSSOAutoJoin(config SSOConfig) (autoJoin ssoAutoJoin, err error) {
+	autoJoin.role = RoleDeveloper
+	if config.AutoJoinRole != "" {
+		autoJoin.role, err = ParseProjectMemberRole(config.AutoJoinRole)
+		if err != nil {
+			return ssoAutoJoin{}, err
+		}
+		if err = validateAssignableRole(autoJoin.role); err != nil {
+			return ssoAutoJoin{}, err
+		}
+	}
+
+	autoJoin.projects = map[string]uuid.UUID{}
+	for _, pair := range strings.Split(config.DomainProjects, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return ssoAutoJoin{}, errs.New("invalid domain project pair %q", pair)
+		}
+
+		projectID, err := uuid.FromString(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return ssoAutoJoin{}, errs.New("invalid project id in domain project pair %q: %v", pair, err)
+		}
+		autoJoin.projects[strings.ToLower(strings.TrimSpace(parts[0]))] = projectID
+	}
+
+	return autoJoin, nil
+}
+
+// SSOLogin authenticates a user, who signed in at an identity provider. Users are found by
+// their linked identity provider account or, for the first login, by their verified email
+// address. Unknown users are provisioned just in time and join the project configured for
+// their email domain.
+//
+// The identity provider account is only linked to an existing user without further proof,
+// when the email domain of the user is one of the link domains. Otherwise, and for users
+// with MFA enabled, the login has to be finished by VerifySSOLogin.
+func (s *Service) SSOLogin(ctx context.Context, claims SSOClaims) (result SSOLoginResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if claims.Issuer == "" || claims.Subject == "" {
+		return SSOLoginResult{}, ErrSSO.New("missing issuer or subject")
+	}
+
+	var user *User
+	linked := true
+	userID, err := s.store.SSOIdentities().GetUserID(ctx, claims.Issuer, claims.Subject)
+	switch {
+	case err == nil:
+		user, err = s.store.Users().Get(ctx, userID)
+		if err != nil {
+			return SSOLoginResult{}, Error.Wrap(err)
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		user, linked, err = s.findOrProvisionSSOUser(ctx, claims)
+		if err != nil {
+			return SSOLoginResult{}, err
+		}
+	default:
+		return SSOLoginResult{}, Error.Wrap(err)
+	}
+
+	if user.Status != Active {
+		return SSOLoginResult{}, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	if !linked || user.MFAEnabled {
+		result.Verification = SSOVerificationPassword
+		if user.MFAEnabled {
+			result.Verification = SSOVerificationMFA
+		}
+		result.VerificationToken, err = s.createSSOVerificationToken(ctx, ssoPendingLogin{
+			UserID:     user.ID,
+			Issuer:     claims.Issuer,
+			Subject:    claims.Subject,
+			Link:       !linked,
+			Expiration: time.Now().Add(SSOVerificationLifetime),
+		})
+		if err != nil {
+			return SSOLoginResult{}, err
+		}
+		return result, nil
+	}
+
+	result.Token, err = s.finishSSOLogin(ctx, user, claims.Issuer)
+	if err != nil {
+		return SSOLoginResult{}, err
+	}
+	return result, nil
+}
+
+// VerifySSOLogin finishes a single sign-on login with the proof of the user and returns
+// auth token. Users with MFA enabled need a passcode or a recovery code, other users need
+// their password, before the identity provider account is linked to them.
+func (s *Service) VerifySSOLogin(ctx context.Context, verificationToken string, proof SSOProof) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := s.parseSSOVerificationToken(ctx, verificationToken)
+	if err != nil {
+		return "", ErrUnauthorized.New(ssoVerificationErrMsg)
+	}
+
+	user, err := s.store.Users().Get(ctx, pending.UserID)
+	if err != nil {
+		return "", ErrUnauthorized.New(ssoVerificationErrMsg)
+	}
+	if user.Status != Active {
+		return "", ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	switch {
+	case user.MFAEnabled:
+		// a passcode proves the ownership of the account as well as the password does.
+		err = s.verifyMFA(ctx, user, proof.MFAPasscode, time.Now(), proof.MFARecoveryCode)
+		if err != nil {
+			return "", err
+		}
+	case pending.Link:
+		err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(proof.Password))
+		if err != nil {
+			return "", ErrUnauthorized.New(credentialsErrMsg)
+		}
+	}
+
+	if pending.Link {
+		err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+			return s.linkSSOIdentity(ctx, tx, user, pending.Issuer, pending.Subject)
+		})
+		if err != nil {
+			return "", Error.Wrap(err)
+		}
+	}
+
+	return s.finishSSOLogin(ctx, user, pending.Issuer)
+}
+
+// finishSSOLogin returns auth token of a user, who signed in through single sign-on.
+func (s *Service) finishSSOLogin(ctx context.Context, user *User, issuer string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err = s.createToken(ctx, &consoleauth.Claims{
+		ID:         user.ID,
+		Expiration: time.Now().Add(tokenExpirationTime),
+	})
+	if err != nil {
+		return "", err
+	}
+	s.auditLog(ctx, "sso login", &user.ID, user.Email, zap.String("issuer", issuer))
+
+	s.analytics.TrackSignedIn(user.ID, user.Email)
+
+	return token, nil
+}
+
+// findOrProvisionSSOUser returns the user with the email of the identity provider account,
+// creating the user if there is none. The account is linked to new users and to users
+// of the link domains, otherwise linked is false and the link needs the proof of the user.
+func (s *Service) findOrProvisionSSOUser(ctx context.Context, claims SSOClaims) (user *User, linked bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !claims.EmailVerified {
+		return nil, false, ErrSSO.New(ssoEmailNotVerifiedErrMsg)
+	}
+	if _, err := mail.ParseAddress(claims.Email); err != nil {
+		return nil, false, ErrSSO.Wrap(err)
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		user, err = tx.Users().GetByEmail(ctx, claims.Email)
+		switch {
+		case err == nil:
+			if !s.ssoLinkDomains[emailDomain(user.Email)] {
+				linked = false
+				return nil
+			}
+		case errors.Is(err, sql.ErrNoRows):
+			user, err = s.provisionSSOUser(ctx, tx, claims)
+			if err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+
+		linked = true
+		return s.linkSSOIdentity(ctx, tx, user, claims.Issuer, claims.Subject)
+	})
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	return user, linked, nil
+}
+
+// linkSSOIdentity links the identity provider account to the user and adds the user
+// to the project of the email domain.
+func (s *Service) linkSSOIdentity(ctx context.Context, tx DBTx, user *User, issuer, subject string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = tx.SSOIdentities().Insert(ctx, SSOIdentity{
+		Issuer:  issuer,
+		Subject: subject,
+		UserID:  user.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditLog(ctx, "link sso identity", &user.ID, user.Email, zap.String("issuer", issuer))
+
+	return s.autoJoinDomainProject(ctx, tx, user)
+}
+
+// createSSOVerificationToken signs the pending login, which is finished by VerifySSOLogin.
+func (s *Service) createSSOVerificationToken(ctx context.Context, pending ssoPendingLogin) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	payload, err := json.Marshal(pending)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	token := consoleauth.Token{Payload: append(append([]byte{}, ssoVerificationPrefix...), payload...)}
+	if err := signToken(&token, s.Signer); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return token.String(), nil
+}
+
+// parseSSOVerificationToken returns the pending login of a verification token, which is
+// signed by the service and not expired.
+func (s *Service) parseSSOVerificationToken(ctx context.Context, verificationToken string) (_ *ssoPendingLogin, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := consoleauth.FromBase64URLString(verificationToken)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	signature := token.Signature
+	if err := signToken(&token, s.Signer); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if subtle.ConstantTimeCompare(signature, token.Signature) != 1 {
+		return nil, Error.New("incorrect signature")
+	}
+
+	if !bytes.HasPrefix(token.Payload, ssoVerificationPrefix) {
+		return nil, Error.New("not a verification token")
+	}
+
+	var pending ssoPendingLogin
+	if err := json.Unmarshal(token.Payload[len(ssoVerificationPrefix):], &pending); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if pending.Expiration.Before(time.Now()) {
+		return nil, ErrTokenExpiration.New("")
+	}
+
+	return &pending, nil
+}
+
+// provisionSSOUser creates an active user for the identity provider account. The user
+// gets a random password, so it's only able to sign in through single sign-on until
+// the password is reset.
+func (s *Service) provisionSSOUser(ctx context.Context, tx DBTx, claims SSOClaims) (user *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var password [32]byte
+	if _, err = rand.Read(password[:]); err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword(password[:], s.config.PasswordCost)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	fullName := claims.FullName
+	if fullName == "" {
+		fullName = claims.Email
+	}
+
+	user, err = tx.Users().Insert(ctx, &User{
+		ID:           userID,
+		Email:        claims.Email,
+		FullName:     fullName,
+		PasswordHash: hash,
+		ProjectLimit: s.config.DefaultProjectLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user.Status = Active
+	if err = tx.Users().Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	s.auditLog(ctx, "create sso user", &user.ID, user.Email, zap.String("issuer", claims.Issuer))
+
+	return user, nil
+}
+
+// autoJoinDomainProject adds the user to the project configured for the email domain
+// of the user, unless the user is already a member of it.
+func (s *Service) autoJoinDomainProject(ctx context.Context, tx DBTx, user *User) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, ok := s.ssoAutoJoin.projects[emailDomain(user.Email)]
+	if !ok {
+		return nil
+	}
+
+	memberships, err := tx.ProjectMembers().GetByMemberID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if _, ok := findMembershipByProjectID(memberships, projectID); ok {
+		return nil
+	}
+
+	project, err := tx.Projects().Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if project.OwnerID == user.ID {
+		return nil
+	}
+
+	_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID, s.ssoAutoJoin.role)
+	if err != nil {
+		return err
+	}
+
+	s.auditLog(ctx, "sso domain project join", &user.ID, user.Email,
+		zap.String("projectID", projectID.String()), zap.Stringer("role", s.ssoAutoJoin.role))
+
+	return nil
+}
+
+// checkPasswordLoginAllowed returns an error, when password login is disabled for
+// users linked to an identity provider account and the user is linked to one.
+func (s *Service) checkPasswordLoginAllowed(ctx context.Context, user *User) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !s.config.SSO.DisablePasswordLogin {
+		return nil
+	}
+
+	linked, err := s.store.SSOIdentities().ExistsForUser(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if linked {
+		return ErrSSO.New(ssoPasswordLoginDisabledErrMsg)
+	}
+	return nil
+}
+
+// emailDomain returns the lower case domain of an email address.
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
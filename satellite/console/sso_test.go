@@ -0,0 +1,288 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+func TestSSOLogin(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+
+			owner, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Corp Owner",
+				Email:    "owner@corp.test",
+			}, 1)
+			require.NoError(t, err)
+
+			existing, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Existing User",
+				Email:    "existing@corp.test",
+			}, 1)
+			require.NoError(t, err)
+
+			external, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "External User",
+				Email:    "external@mail.test",
+			}, 1)
+			require.NoError(t, err)
+
+			project, err := sat.AddProject(ctx, owner.ID, "corp")
+			require.NoError(t, err)
+
+			newService := func(sso console.SSOConfig) (*console.Service, error) {
+				return console.NewService(
+					zaptest.NewLogger(t),
+					&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+					sat.DB.Console(),
+					sat.DB.ProjectAccounting(),
+					sat.API.Accounting.ProjectUsage,
+					sat.DB.Buckets(),
+					sat.API.Marketing.PartnersService,
+					sat.API.Payments.Accounts,
+					sat.API.Analytics.Service,
+					console.Config{PasswordCost: console.TestPasswordCost, DefaultProjectLimit: 2, SSO: sso},
+					0,
+				)
+			}
+
+			_, err = newService(console.SSOConfig{DomainProjects: "corp.test"})
+			require.Error(t, err)
+			_, err = newService(console.SSOConfig{AutoJoinRole: "owner"})
+			require.Error(t, err)
+
+			service, err := newService(console.SSOConfig{
+				LinkDomains:          "Corp.Test",
+				DomainProjects:       "Corp.Test=" + project.ID.String(),
+				AutoJoinRole:         "read-only",
+				DisablePasswordLogin: true,
+			})
+			require.NoError(t, err)
+
+			// authorize returns the user authorized by the token.
+			authorize := func(token string) *console.User {
+				auth, err := service.Authorize(consoleauth.WithAPIKey(ctx, []byte(token)))
+				require.NoError(t, err)
+				return &auth.User
+			}
+
+			// login returns the user of a login, which doesn't need a verification.
+			login := func(claims console.SSOClaims) (*console.User, error) {
+				result, err := service.SSOLogin(ctx, claims)
+				if err != nil {
+					return nil, err
+				}
+				require.Empty(t, result.VerificationToken)
+				return authorize(result.Token), nil
+			}
+
+			const issuer = "https://idp.corp.test"
+
+			t.Run("provision new user", func(t *testing.T) {
+				user, err := login(console.SSOClaims{
+					Issuer:        issuer,
+					Subject:       "new",
+					Email:         "new@corp.test",
+					EmailVerified: true,
+					FullName:      "New User",
+				})
+				require.NoError(t, err)
+				require.Equal(t, "new@corp.test", user.Email)
+				require.Equal(t, "New User", user.FullName)
+				require.Equal(t, console.Active, user.Status)
+				require.Equal(t, 2, user.ProjectLimit)
+
+				memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, user.ID)
+				require.NoError(t, err)
+				require.Len(t, memberships, 1)
+				require.Equal(t, project.ID, memberships[0].ProjectID)
+				require.Equal(t, console.RoleReadOnly, memberships[0].Role)
+
+				// the next login finds the user by the identity, even when the email changed
+				again, err := login(console.SSOClaims{
+					Issuer:  issuer,
+					Subject: "new",
+					Email:   "renamed@corp.test",
+				})
+				require.NoError(t, err)
+				require.Equal(t, user.ID, again.ID)
+
+				memberships, err = sat.DB.Console().ProjectMembers().GetByMemberID(ctx, user.ID)
+				require.NoError(t, err)
+				require.Len(t, memberships, 1)
+			})
+
+			t.Run("link existing user of link domain", func(t *testing.T) {
+				// the password login works before the user is linked
+				_, err := service.Token(ctx, existing.Email, existing.FullName)
+				require.NoError(t, err)
+
+				user, err := login(console.SSOClaims{
+					Issuer:        issuer,
+					Subject:       "existing",
+					Email:         "EXISTING@corp.test",
+					EmailVerified: true,
+				})
+				require.NoError(t, err)
+				require.Equal(t, existing.ID, user.ID)
+				require.Equal(t, existing.FullName, user.FullName)
+
+				memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, user.ID)
+				require.NoError(t, err)
+				require.Len(t, memberships, 1)
+				require.Equal(t, project.ID, memberships[0].ProjectID)
+
+				// password login is disabled for linked users
+				_, err = service.Token(ctx, existing.Email, existing.FullName)
+				require.True(t, console.ErrSSO.Has(err), err)
+
+				// unless password login for linked users is allowed
+				_, err = sat.API.Console.Service.Token(ctx, existing.Email, existing.FullName)
+				require.NoError(t, err)
+			})
+
+			t.Run("owner keeps the role", func(t *testing.T) {
+				user, err := login(console.SSOClaims{
+					Issuer:        issuer,
+					Subject:       "owner",
+					Email:         owner.Email,
+					EmailVerified: true,
+				})
+				require.NoError(t, err)
+				require.Equal(t, owner.ID, user.ID)
+
+				members, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, owner.ID)
+				require.NoError(t, err)
+				require.Len(t, members, 1)
+				require.Equal(t, console.RoleOwner, members[0].Role)
+			})
+
+			t.Run("other domain", func(t *testing.T) {
+				user, err := login(console.SSOClaims{
+					Issuer:        issuer,
+					Subject:       "other",
+					Email:         "other@mail.test",
+					EmailVerified: true,
+				})
+				require.NoError(t, err)
+				// the email is used as name, when the identity provider doesn't share it
+				require.Equal(t, "other@mail.test", user.FullName)
+
+				memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, user.ID)
+				require.NoError(t, err)
+				require.Empty(t, memberships)
+			})
+
+			t.Run("link existing user with password", func(t *testing.T) {
+				claims := console.SSOClaims{
+					Issuer:        issuer,
+					Subject:       "external",
+					Email:         external.Email,
+					EmailVerified: true,
+				}
+
+				result, err := service.SSOLogin(ctx, claims)
+				require.NoError(t, err)
+				require.Empty(t, result.Token)
+				require.Equal(t, console.SSOVerificationPassword, result.Verification)
+
+				// the verification token isn't an auth token
+				_, err = service.Authorize(consoleauth.WithAPIKey(ctx, []byte(result.VerificationToken)))
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+
+				_, err = service.VerifySSOLogin(ctx, result.VerificationToken, console.SSOProof{Password: "wrong"})
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+
+				// the account isn't linked without the password
+				_, err = sat.DB.Console().SSOIdentities().GetUserID(ctx, issuer, "external")
+				require.True(t, errors.Is(err, sql.ErrNoRows), err)
+
+				token, err := service.VerifySSOLogin(ctx, result.VerificationToken, console.SSOProof{Password: external.FullName})
+				require.NoError(t, err)
+				require.Equal(t, external.ID, authorize(token).ID)
+
+				// the next login doesn't need the password
+				user, err := login(claims)
+				require.NoError(t, err)
+				require.Equal(t, external.ID, user.ID)
+			})
+
+			t.Run("mfa", func(t *testing.T) {
+				claims := console.SSOClaims{
+					Issuer:        issuer,
+					Subject:       "mfa",
+					Email:         "mfa@corp.test",
+					EmailVerified: true,
+				}
+				user, err := login(claims)
+				require.NoError(t, err)
+
+				// authorized creates a context with the current state of the user.
+				result, err := service.SSOLogin(ctx, claims)
+				require.NoError(t, err)
+				authorized := func() context.Context {
+					auth, err := service.Authorize(consoleauth.WithAPIKey(ctx, []byte(result.Token)))
+					require.NoError(t, err)
+					return console.WithAuth(ctx, auth)
+				}
+
+				now := time.Now()
+				key, err := service.ResetMFASecretKey(authorized())
+				require.NoError(t, err)
+				passcode, err := consoleauth.TOTPPasscode(key, now)
+				require.NoError(t, err)
+				codes, err := service.EnableUserMFA(authorized(), passcode, now)
+				require.NoError(t, err)
+
+				// the identity provider login doesn't replace the second factor
+				result, err = service.SSOLogin(ctx, claims)
+				require.NoError(t, err)
+				require.Empty(t, result.Token)
+				require.Equal(t, console.SSOVerificationMFA, result.Verification)
+
+				_, err = service.VerifySSOLogin(ctx, result.VerificationToken, console.SSOProof{Password: user.FullName})
+				require.True(t, console.ErrMFAMissing.Has(err), err)
+
+				token, err := service.VerifySSOLogin(ctx, result.VerificationToken, console.SSOProof{MFARecoveryCode: codes[0]})
+				require.NoError(t, err)
+				require.Equal(t, user.ID, authorize(token).ID)
+
+				// an auth token isn't a verification token
+				_, err = service.VerifySSOLogin(ctx, token, console.SSOProof{MFARecoveryCode: codes[1]})
+				require.True(t, console.ErrUnauthorized.Has(err), err)
+			})
+
+			t.Run("unverified email", func(t *testing.T) {
+				_, err := login(console.SSOClaims{
+					Issuer:  issuer,
+					Subject: "unverified",
+					Email:   "unverified@corp.test",
+				})
+				require.True(t, console.ErrSSO.Has(err), err)
+
+				// the identity of another issuer isn't linked to the user
+				_, err = login(console.SSOClaims{
+					Issuer:  "https://idp.mail.test",
+					Subject: "new",
+					Email:   "new@corp.test",
+				})
+				require.True(t, console.ErrSSO.Has(err), err)
+			})
+		})
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+const (
+	// maxUsageAlerts is the maximum number of usage alerts of a project.
+	maxUsageAlerts = 10
+	// usageNotificationsLimit is the number of the latest usage notifications shown in the console.
+	usageNotificationsLimit = 50
+)
+
+// UsageAlerts exposes methods to manage the usage alerts of projects and
+// the notifications sent when they are triggered.
+//
+// architecture: Database
+type UsageAlerts interface {
+	// GetByProjectID returns the usage alerts of the project.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]UsageAlert, error)
+	// Update replaces the usage alerts of the project.
+	Update(ctx context.Context, projectID uuid.UUID, alerts []UsageAlert) error
+
+	// InsertNotification records a triggered usage alert, which isn't sent yet.
+	// It returns false, when the alert was already triggered in the billing period.
+	InsertNotification(ctx context.Context, notification UsageNotification) (bool, error)
+	// ListUnsentNotifications returns the notifications of the project in the
+	// billing period starting at periodStart, which weren't sent yet.
+	ListUnsentNotifications(ctx context.Context, projectID uuid.UUID, periodStart time.Time) ([]UsageNotification, error)
+	// MarkNotificationSent records that the notification was sent to the project owner.
+	MarkNotificationSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+	// ListNotifications returns the latest usage notifications of the project, newest first.
+	ListNotifications(ctx context.Context, projectID uuid.UUID, limit int) ([]UsageNotification, error)
+}
+
+// UsageAlertKind is the usage of a project, which an alert is evaluated against.
+type UsageAlertKind string
+
+const (
+	// UsageAlertStorage is evaluated against the storage limit of the project.
+	UsageAlertStorage UsageAlertKind = "storage"
+	// UsageAlertBandwidth is evaluated against the egress limit of the project.
+	UsageAlertBandwidth UsageAlertKind = "bandwidth"
+	// UsageAlertSpend is evaluated against the estimated charges of the project in the current month.
+	UsageAlertSpend UsageAlertKind = "spend"
+)
+
+// UsageAlert is a threshold of the project usage, at which the project owner is notified.
+// Alerts don't limit the usage, they only warn about reaching the limits.
+type UsageAlert struct {
+	ProjectID uuid.UUID      `json:"projectId"`
+	Kind      UsageAlertKind `json:"kind"`
+	// Threshold is a percentage of the limit for storage and bandwidth, and
+	// the estimated charges in cents for spend.
+	Threshold int64     `json:"threshold"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// UsageNotification records that a usage alert of a project was triggered in
+// the billing period starting at PeriodStart. It's recorded before it's sent,
+// so that it's sent again, when sending fails.
+type UsageNotification struct {
+	ID          uuid.UUID      `json:"id"`
+	ProjectID   uuid.UUID      `json:"projectId"`
+	Kind        UsageAlertKind `json:"kind"`
+	Threshold   int64          `json:"threshold"`
+	PeriodStart time.Time      `json:"periodStart"`
+	// Used is the usage in bytes for storage and bandwidth, and the estimated charges in cents for spend.
+	Used int64 `json:"used"`
+	// Limit is the limit in bytes for storage and bandwidth, and zero for spend.
+	Limit     int64     `json:"limit"`
+	CreatedAt time.Time `json:"createdAt"`
+	// SentAt is nil until the notification is sent.
+	SentAt *time.Time `json:"sentAt"`
+}
+
+// ValidateUsageAlerts validates the usage alerts of a project.
+func ValidateUsageAlerts(alerts []UsageAlert) error {
+	if len(alerts) > maxUsageAlerts {
+		return ErrValidation.New("a project can have at most %d usage alerts", maxUsageAlerts)
+	}
+
+	type key struct {
+		kind      UsageAlertKind
+		threshold int64
+	}
+	seen := map[key]bool{}
+
+	for _, alert := range alerts {
+		switch alert.Kind {
+		case UsageAlertStorage, UsageAlertBandwidth:
+			if alert.Threshold < 1 || alert.Threshold > 100 {
+				return ErrValidation.New("%s alert threshold must be a percentage between 1 and 100", alert.Kind)
+			}
+		case UsageAlertSpend:
+			if alert.Threshold < 1 {
+				return ErrValidation.New("spend alert threshold must be a positive amount of cents")
+			}
+		default:
+			return ErrValidation.New("invalid usage alert kind %q", alert.Kind)
+		}
+
+		k := key{alert.Kind, alert.Threshold}
+		if seen[k] {
+			return ErrValidation.New("duplicate %s alert at %d", alert.Kind, alert.Threshold)
+		}
+		seen[k] = true
+	}
+	return nil
+}
+
+// GetProjectUsageAlerts returns the usage alerts of the project.
+func (s *Service) GetProjectUsageAlerts(ctx context.Context, projectID uuid.UUID) (_ []UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get project usage alerts", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	alerts, err := s.store.UsageAlerts().GetByProjectID(ctx, projectID)
+	return alerts, Error.Wrap(err)
+}
+
+// UpdateProjectUsageAlerts replaces the usage alerts of the project.
+func (s *Service) UpdateProjectUsageAlerts(ctx context.Context, projectID uuid.UUID, alerts []UsageAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "update project usage alerts", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionManageLimits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := ValidateUsageAlerts(alerts); err != nil {
+		return err
+	}
+
+	return Error.Wrap(s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		return tx.UsageAlerts().Update(ctx, projectID, alerts)
+	}))
+}
+
+// GetProjectUsageNotifications returns the latest usage notifications of the project.
+func (s *Service) GetProjectUsageNotifications(ctx context.Context, projectID uuid.UUID) (_ []UsageNotification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := s.getAuthAndAuditLog(ctx, "get project usage notifications", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.checkProjectPermission(ctx, auth.User.ID, projectID, PermissionViewProject)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	notifications, err := s.store.UsageAlerts().ListNotifications(ctx, projectID, usageNotificationsLimit)
+	return notifications, Error.Wrap(err)
+}
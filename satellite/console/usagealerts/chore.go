@@ -0,0 +1,367 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package usagealerts implements the chore, which notifies project owners when
+// their projects reach usage alert thresholds.
+package usagealerts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// Error is the default error class for usage alerts.
+	Error = errs.Class("usage alerts")
+
+	mon = monkit.Package()
+)
+
+// Config is a configuration struct for the Chore.
+type Config struct {
+	Enabled           bool          `help:"whether to notify project owners when their projects reach usage alert thresholds" default:"false"`
+	Interval          time.Duration `help:"how often to evaluate the usage alerts of the projects" default:"1h"`
+	DefaultThresholds string        `help:"comma separated percentages of the storage and egress limits, at which owners of projects without usage alerts are notified" default:"80,100"`
+	ListLimit         int           `help:"how many projects to evaluate in a batch" default:"100"`
+}
+
+// Links are the console links included in the alert emails.
+type Links struct {
+	ExternalAddress       string
+	ContactInfoURL        string
+	TermsAndConditionsURL string
+}
+
+// Chore evaluates the usage alerts of the projects against their current
+// usage and notifies the project owners, when a threshold is reached. Every
+// alert is triggered at most once per billing period.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	db       console.DB
+	usage    *accounting.Service
+	accounts payments.Accounts
+	mail     *mailservice.Service
+	links    Links
+	config   Config
+
+	defaultThresholds []int64
+	nowFn             func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates new chore for notifying project owners about their usage.
+func NewChore(log *zap.Logger, db console.DB, usage *accounting.Service, accounts payments.Accounts, mail *mailservice.Service, links Links, config Config) (*Chore, error) {
+	defaultThresholds, err := ParseThresholds(config.DefaultThresholds)
+	if err != nil {
+		return nil, err
+	}
+	if config.ListLimit <= 0 {
+		return nil, Error.New("list limit must be positive")
+	}
+
+	return &Chore{
+		log:      log,
+		db:       db,
+		usage:    usage,
+		accounts: accounts,
+		mail:     mail,
+		links:    links,
+		config:   config,
+
+		defaultThresholds: defaultThresholds,
+		nowFn:             time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// ParseThresholds parses a comma separated list of percentages.
+func ParseThresholds(value string) ([]int64, error) {
+	var thresholds []int64
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		threshold, err := strconv.ParseInt(strings.TrimSuffix(field, "%"), 10, 64)
+		if err != nil || threshold < 1 || threshold > 100 {
+			return nil, Error.New("invalid threshold %q, expected a percentage between 1 and 100", field)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return thresholds, nil
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("error evaluating usage alerts", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce evaluates the usage alerts of all projects.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn().UTC()
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	// the charges of all projects of an owner are estimated at once.
+	charges := map[uuid.UUID]map[uuid.UUID]int64{}
+
+	var offset int64
+	for {
+		page, err := chore.db.Projects().List(ctx, offset, chore.config.ListLimit, now)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, project := range page.Projects {
+			err := chore.evaluateProject(ctx, project, periodStart, now, charges)
+			if err != nil {
+				chore.log.Error("failed to evaluate usage alerts",
+					zap.Stringer("Project ID", project.ID),
+					zap.Error(err))
+			}
+		}
+
+		if !page.Next {
+			return nil
+		}
+		offset = page.NextOffset
+	}
+}
+
+// evaluateProject records the notifications of the usage alerts, which the
+// project reached in the billing period, and sends the ones not sent yet.
+// A notification is only marked as sent once its email is sent, so that
+// sending is retried on the next run, when it fails.
+func (chore *Chore) evaluateProject(ctx context.Context, project console.Project, periodStart, now time.Time, charges map[uuid.UUID]map[uuid.UUID]int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := chore.db.UsageAlerts().GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(alerts) == 0 {
+		for _, threshold := range chore.defaultThresholds {
+			alerts = append(alerts,
+				console.UsageAlert{ProjectID: project.ID, Kind: console.UsageAlertStorage, Threshold: threshold},
+				console.UsageAlert{ProjectID: project.ID, Kind: console.UsageAlertBandwidth, Threshold: threshold},
+			)
+		}
+	}
+
+	usages := map[console.UsageAlertKind]*usage{}
+	for _, alert := range alerts {
+		current, ok := usages[alert.Kind]
+		if !ok {
+			current, err = chore.currentUsage(ctx, project, alert.Kind, periodStart, now, charges)
+			if err != nil {
+				return err
+			}
+			usages[alert.Kind] = current
+		}
+
+		if !current.reached(alert) {
+			continue
+		}
+
+		_, err := chore.db.UsageAlerts().InsertNotification(ctx, console.UsageNotification{
+			ProjectID:   project.ID,
+			Kind:        alert.Kind,
+			Threshold:   alert.Threshold,
+			PeriodStart: periodStart,
+			Used:        current.used,
+			Limit:       current.limit,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	unsent, err := chore.db.UsageAlerts().ListUnsentNotifications(ctx, project.ID, periodStart)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(unsent) == 0 {
+		return nil
+	}
+
+	// only the highest unsent threshold of a kind is sent, the lower ones are
+	// sent along with it.
+	highest := map[console.UsageAlertKind]console.UsageNotification{}
+	for _, notification := range unsent {
+		if sent, ok := highest[notification.Kind]; !ok || notification.Threshold > sent.Threshold {
+			highest[notification.Kind] = notification
+		}
+	}
+
+	owner, err := chore.db.Users().Get(ctx, project.OwnerID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	userName := owner.ShortName
+	if userName == "" {
+		userName = owner.FullName
+	}
+
+	var group errs.Group
+	for kind, notification := range highest {
+		err := chore.mail.SendRendered(ctx,
+			[]post.Address{{Address: owner.Email, Name: userName}},
+			&UsageAlertEmail{
+				Origin:                chore.links.ExternalAddress,
+				UserName:              userName,
+				ProjectName:           project.Name,
+				Usage:                 describe(notification),
+				SignInLink:            chore.links.ExternalAddress + "login",
+				ContactInfoURL:        chore.links.ContactInfoURL,
+				TermsAndConditionsURL: chore.links.TermsAndConditionsURL,
+			},
+		)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+
+		sentAt := chore.nowFn()
+		for _, sent := range unsent {
+			if sent.Kind == kind {
+				group.Add(chore.db.UsageAlerts().MarkNotificationSent(ctx, sent.ID, sentAt))
+			}
+		}
+	}
+	return Error.Wrap(group.Err())
+}
+
+// usage is the current usage and limit of a project.
+type usage struct {
+	kind  console.UsageAlertKind
+	used  int64
+	limit int64
+}
+
+// reached returns whether the usage reached the threshold of the alert.
+func (usage *usage) reached(alert console.UsageAlert) bool {
+	if usage.kind == console.UsageAlertSpend {
+		return usage.used >= alert.Threshold
+	}
+	if usage.limit <= 0 {
+		return false
+	}
+	return usage.used*100 >= usage.limit*alert.Threshold
+}
+
+// currentUsage returns the usage of the project in the billing period. Storage
+// and bandwidth are taken from the live accounting cache.
+func (chore *Chore) currentUsage(ctx context.Context, project console.Project, kind console.UsageAlertKind, periodStart, now time.Time, charges map[uuid.UUID]map[uuid.UUID]int64) (_ *usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current := &usage{kind: kind}
+
+	switch kind {
+	case console.UsageAlertStorage:
+		current.used, err = chore.usage.GetProjectStorageTotals(ctx, project.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		limit, err := chore.usage.GetProjectStorageLimit(ctx, project.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		current.limit = limit.Int64()
+
+	case console.UsageAlertBandwidth:
+		current.used, err = chore.usage.GetProjectBandwidthUsage(ctx, project.ID)
+		if accounting.ErrKeyNotFound.Has(err) {
+			current.used, err = chore.usage.GetProjectAllocatedBandwidth(ctx, project.ID, now.Year(), now.Month())
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		limit, err := chore.usage.GetProjectBandwidthLimit(ctx, project.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		current.limit = limit.Int64()
+
+	case console.UsageAlertSpend:
+		ownerCharges, ok := charges[project.OwnerID]
+		if !ok {
+			projectCharges, err := chore.accounts.ProjectCharges(ctx, project.OwnerID, periodStart, now)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+
+			ownerCharges = map[uuid.UUID]int64{}
+			for _, charge := range projectCharges {
+				ownerCharges[charge.ProjectID] = charge.StorageGbHrs + charge.Egress + charge.ObjectCount
+			}
+			charges[project.OwnerID] = ownerCharges
+		}
+		current.used = ownerCharges[project.ID]
+
+	default:
+		return nil, Error.New("invalid usage alert kind %q", kind)
+	}
+
+	return current, nil
+}
+
+// describe returns the description of the reached usage used in the email.
+func describe(notification console.UsageNotification) string {
+	switch notification.Kind {
+	case console.UsageAlertStorage:
+		return fmt.Sprintf("%d%% of its storage limit (%s of %s)", notification.Threshold,
+			memory.Size(notification.Used).Base10String(), memory.Size(notification.Limit).Base10String())
+	case console.UsageAlertBandwidth:
+		return fmt.Sprintf("%d%% of its egress limit for this month (%s of %s)", notification.Threshold,
+			memory.Size(notification.Used).Base10String(), memory.Size(notification.Limit).Base10String())
+	default:
+		return fmt.Sprintf("estimated charges of %s this month, above the alert at %s",
+			formatCents(notification.Used), formatCents(notification.Threshold))
+	}
+}
+
+// formatCents formats an amount of cents as dollars.
+func formatCents(cents int64) string {
+	return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
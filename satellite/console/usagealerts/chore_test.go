@@ -0,0 +1,146 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealerts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/usagealerts"
+)
+
+func TestParseThresholds(t *testing.T) {
+	thresholds, err := usagealerts.ParseThresholds("80, 100%")
+	require.NoError(t, err)
+	require.Equal(t, []int64{80, 100}, thresholds)
+
+	thresholds, err = usagealerts.ParseThresholds("")
+	require.NoError(t, err)
+	require.Empty(t, thresholds)
+
+	for _, invalid := range []string{"0", "101", "eighty"} {
+		_, err = usagealerts.ParseThresholds(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 2},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+			service := sat.API.Console.Service
+			chore := sat.Core.UsageAlerts.Chore
+			chore.Loop.Pause()
+
+			project := planet.Uplinks[0].Projects[0]
+			ownerCtx, err := sat.AuthenticatedContext(ctx, project.Owner.ID)
+			require.NoError(t, err)
+
+			// other has no alerts, so the default thresholds apply
+			other := planet.Uplinks[1].Projects[0]
+			otherCtx, err := sat.AuthenticatedContext(ctx, other.Owner.ID)
+			require.NoError(t, err)
+
+			for _, invalid := range [][]console.UsageAlert{
+				{{Kind: console.UsageAlertStorage, Threshold: 101}},
+				{{Kind: console.UsageAlertSpend, Threshold: 0}},
+				{{Kind: "objects", Threshold: 50}},
+				{{Kind: console.UsageAlertStorage, Threshold: 50}, {Kind: console.UsageAlertStorage, Threshold: 50}},
+			} {
+				err = service.UpdateProjectUsageAlerts(ownerCtx, project.ID, invalid)
+				require.True(t, console.ErrValidation.Has(err), err)
+			}
+
+			// only members allowed to manage limits can change alerts
+			err = service.UpdateProjectUsageAlerts(otherCtx, project.ID, nil)
+			require.Error(t, err)
+
+			err = service.UpdateProjectUsageAlerts(ownerCtx, project.ID, []console.UsageAlert{
+				{Kind: console.UsageAlertStorage, Threshold: 90},
+				{Kind: console.UsageAlertStorage, Threshold: 50},
+				{Kind: console.UsageAlertBandwidth, Threshold: 100},
+				{Kind: console.UsageAlertSpend, Threshold: 1000},
+			})
+			require.NoError(t, err)
+
+			alerts, err := service.GetProjectUsageAlerts(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, alerts, 4)
+
+			for _, id := range []*testplanet.Project{project, other} {
+				err = sat.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, id.ID, memory.KB)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, sat.API.Accounting.ProjectUsage.AddProjectStorageUsage(ctx, project.ID, 600))
+			require.NoError(t, sat.API.Accounting.ProjectUsage.AddProjectStorageUsage(ctx, other.ID, 850))
+
+			require.NoError(t, chore.RunOnce(ctx))
+
+			notifications, err := service.GetProjectUsageNotifications(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, notifications, 1)
+			require.Equal(t, console.UsageAlertStorage, notifications[0].Kind)
+			require.EqualValues(t, 50, notifications[0].Threshold)
+			require.EqualValues(t, 600, notifications[0].Used)
+			require.Equal(t, memory.KB.Int64(), notifications[0].Limit)
+			require.NotNil(t, notifications[0].SentAt)
+
+			otherNotifications, err := service.GetProjectUsageNotifications(otherCtx, other.ID)
+			require.NoError(t, err)
+			require.Len(t, otherNotifications, 1)
+			require.EqualValues(t, 80, otherNotifications[0].Threshold)
+
+			// alerts are triggered once per billing period
+			require.NoError(t, chore.RunOnce(ctx))
+			notifications, err = service.GetProjectUsageNotifications(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, notifications, 1)
+
+			require.NoError(t, sat.API.Accounting.ProjectUsage.AddProjectStorageUsage(ctx, project.ID, 400))
+			require.NoError(t, chore.RunOnce(ctx))
+
+			notifications, err = service.GetProjectUsageNotifications(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, notifications, 2)
+			require.EqualValues(t, 90, notifications[0].Threshold)
+			require.EqualValues(t, 1000, notifications[0].Used)
+			require.NotNil(t, notifications[0].SentAt)
+
+			// a notification, which failed to be sent, is sent on the next run
+			now := time.Now().UTC()
+			inserted, err := sat.DB.Console().UsageAlerts().InsertNotification(ctx, console.UsageNotification{
+				ProjectID:   project.ID,
+				Kind:        console.UsageAlertBandwidth,
+				Threshold:   100,
+				PeriodStart: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+				Used:        memory.KB.Int64(),
+				Limit:       memory.KB.Int64(),
+			})
+			require.NoError(t, err)
+			require.True(t, inserted)
+
+			notifications, err = service.GetProjectUsageNotifications(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, notifications, 3)
+			require.Equal(t, console.UsageAlertBandwidth, notifications[0].Kind)
+			require.Nil(t, notifications[0].SentAt)
+
+			require.NoError(t, chore.RunOnce(ctx))
+
+			notifications, err = service.GetProjectUsageNotifications(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, notifications, 3)
+			for _, notification := range notifications {
+				require.NotNil(t, notification.SentAt, notification.Kind)
+			}
+		})
+}
@@ -0,0 +1,23 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealerts
+
+// UsageAlertEmail is mailservice template for the email sent, when a project reaches a usage alert.
+type UsageAlertEmail struct {
+	Origin                string
+	UserName              string
+	ProjectName           string
+	Usage                 string
+	SignInLink            string
+	ContactInfoURL        string
+	TermsAndConditionsURL string
+}
+
+// Template returns email template name.
+func (*UsageAlertEmail) Template() string { return "UsageAlert" }
+
+// Subject gets email subject.
+func (email *UsageAlertEmail) Subject() string {
+	return "Your project " + email.ProjectName + " reached a usage alert"
+}
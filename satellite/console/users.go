@@ -27,6 +27,17 @@ type Users interface {
 	Update(ctx context.Context, user *User) error
 	// GetProjectLimit is a method to get the users project limit
 	GetProjectLimit(ctx context.Context, id uuid.UUID) (limit int, err error)
+	// UpdateMFA is a method for updating the multi-factor authentication settings of a user.
+	UpdateMFA(ctx context.Context, user *User) error
+	// UseMFACounter stores the TOTP counter of an accepted passcode of a user. It returns false,
+	// when a passcode with the same or a later counter was already accepted.
+	UseMFACounter(ctx context.Context, id uuid.UUID, counter int64) (bool, error)
+	// UpdateMFARecoveryCodes replaces the recovery codes of a user, when they still equal old.
+	// It returns false, when the recovery codes were changed in the meantime.
+	UpdateMFARecoveryCodes(ctx context.Context, id uuid.UUID, old, codes []string) (bool, error)
+	// UpdateMFAFailedAttempts updates the failed MFA attempts and the lockout of a user, when the
+	// failed attempts still equal old. It returns false, when they were changed in the meantime.
+	UpdateMFAFailedAttempts(ctx context.Context, id uuid.UUID, old, attempts int, lockedUntil time.Time) (bool, error)
 }
 
 // UserInfo holds User updatable data.
@@ -117,4 +128,13 @@ type User struct {
 	CompanySize    int    `json:"companySize"`
 	WorkingOn      string `json:"workingOn"`
 	EmployeeCount  string `json:"employeeCount"`
+
+	MFAEnabled   bool   `json:"mfaEnabled"`
+	MFASecretKey string `json:"-"`
+	// MFARecoveryCodes contains the hashes of the unused recovery codes.
+	MFARecoveryCodes []string `json:"-"`
+	// MFALastCounter is the TOTP counter of the last accepted passcode.
+	MFALastCounter    int64     `json:"-"`
+	MFAFailedAttempts int       `json:"-"`
+	MFALockedUntil    time.Time `json:"-"`
 }
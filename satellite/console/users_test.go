@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
@@ -116,6 +117,68 @@ func TestUserEmailCase(t *testing.T) {
 	})
 }
 
+func TestUserMFARepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repository := db.Console().Users()
+
+		user, err := repository.Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     name,
+			Email:        email,
+			PasswordHash: []byte(passValid),
+		})
+		require.NoError(t, err)
+
+		user.MFAEnabled = true
+		user.MFASecretKey = "JBSWY3DPEHPK3PXP"
+		user.MFARecoveryCodes = []string{"first", "second"}
+		require.NoError(t, repository.UpdateMFA(ctx, user))
+
+		// a counter is only accepted once and never after a later one
+		used, err := repository.UseMFACounter(ctx, user.ID, 10)
+		require.NoError(t, err)
+		require.True(t, used)
+		for _, counter := range []int64{10, 9} {
+			used, err = repository.UseMFACounter(ctx, user.ID, counter)
+			require.NoError(t, err)
+			require.False(t, used)
+		}
+
+		// recovery codes are only replaced when they weren't changed in the meantime
+		updated, err := repository.UpdateMFARecoveryCodes(ctx, user.ID, user.MFARecoveryCodes, []string{"second"})
+		require.NoError(t, err)
+		require.True(t, updated)
+		updated, err = repository.UpdateMFARecoveryCodes(ctx, user.ID, user.MFARecoveryCodes, []string{"first"})
+		require.NoError(t, err)
+		require.False(t, updated)
+
+		lockedUntil := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		updated, err = repository.UpdateMFAFailedAttempts(ctx, user.ID, 0, 1, lockedUntil)
+		require.NoError(t, err)
+		require.True(t, updated)
+		updated, err = repository.UpdateMFAFailedAttempts(ctx, user.ID, 0, 1, time.Time{})
+		require.NoError(t, err)
+		require.False(t, updated)
+
+		stored, err := repository.Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.True(t, stored.MFAEnabled)
+		require.Equal(t, user.MFASecretKey, stored.MFASecretKey)
+		require.Equal(t, []string{"second"}, stored.MFARecoveryCodes)
+		require.EqualValues(t, 10, stored.MFALastCounter)
+		require.Equal(t, 1, stored.MFAFailedAttempts)
+		require.True(t, lockedUntil.Equal(stored.MFALockedUntil))
+
+		// the last recovery code leaves the user without any
+		updated, err = repository.UpdateMFARecoveryCodes(ctx, user.ID, stored.MFARecoveryCodes, nil)
+		require.NoError(t, err)
+		require.True(t, updated)
+		updated, err = repository.UpdateMFARecoveryCodes(ctx, user.ID, nil, nil)
+		require.NoError(t, err)
+		require.False(t, updated)
+	})
+}
+
 func testUsers(ctx context.Context, t *testing.T, repository console.Users, user *console.User) {
 
 	t.Run("User insertion success", func(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"net"
+	"strings"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
@@ -30,12 +31,16 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metainfo/metaloop"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -43,6 +48,7 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/lostpieces"
 )
 
 // Core is the satellite core process that runs chores.
@@ -81,10 +87,11 @@ type Core struct {
 	}
 
 	Metainfo struct {
-		Database metainfo.PointerDB // TODO: move into pointerDB
-		Metabase metainfo.MetabaseDB
-		Service  *metainfo.Service
-		Loop     *metaloop.Service
+		Database      metainfo.PointerDB // TODO: move into pointerDB
+		Metabase      metainfo.MetabaseDB
+		Service       *metainfo.Service
+		Loop          *metaloop.Service
+		PieceDeletion *piecedeletion.Service
 	}
 
 	Orders struct {
@@ -94,7 +101,8 @@ type Core struct {
 	}
 
 	Repair struct {
-		Checker *checker.Checker
+		Checker    *checker.Checker
+		LostPieces *lostpieces.Chore
 	}
 
 	Audit struct {
@@ -113,6 +121,10 @@ type Core struct {
 		Chore *expireddeletion.Chore
 	}
 
+	BucketLifecycle struct {
+		Chore *bucketlifecycle.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		Rollup                *rollup.Service
@@ -129,6 +141,14 @@ type Core struct {
 		Chore    *stripecoinpayments.Chore
 	}
 
+	Mail struct {
+		Service *mailservice.Service
+	}
+
+	UsageAlerts struct {
+		Chore *usagealerts.Chore
+	}
+
 	GracefulExit struct {
 		Chore *gracefulexit.Chore
 	}
@@ -290,6 +310,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Run:   peer.Metainfo.Loop.Run,
 			Close: peer.Metainfo.Loop.Close,
 		})
+
+		peer.Metainfo.PieceDeletion, err = piecedeletion.NewService(
+			peer.Log.Named("metainfo:piecedeletion"),
+			peer.Dialer,
+			peer.Overlay.Service,
+			config.Metainfo.PieceDeletion,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "metainfo:piecedeletion",
+			Run:   peer.Metainfo.PieceDeletion.Run,
+			Close: peer.Metainfo.PieceDeletion.Close,
+		})
 	}
 
 	{ // setup datarepair
@@ -298,6 +333,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("repair:checker"),
 			peer.DB.RepairQueue(),
 			peer.DB.Irreparable(),
+			peer.DB.DurabilityHistograms(),
 			peer.Metainfo.Metabase,
 			peer.Metainfo.Loop,
 			peer.Overlay.Service,
@@ -311,6 +347,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Repair Checker", peer.Repair.Checker.Loop))
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Repair Checker Irreparable", peer.Repair.Checker.IrreparableLoop))
+
+		if config.LostPieces.Enabled {
+			peer.Repair.LostPieces = lostpieces.NewChore(
+				peer.Log.Named("repair:lostpieces"),
+				config.LostPieces,
+				peer.DB.LostPieces(),
+				peer.Metainfo.Metabase,
+				peer.DB.RepairQueue(),
+				peer.Overlay.Service,
+				peer.Metainfo.Loop,
+				config.Checker.NodeFailureRate)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "repair:lostpieces",
+				Run:   peer.Repair.LostPieces.Run,
+				Close: peer.Repair.LostPieces.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Repair Lost Pieces", peer.Repair.LostPieces.Loop))
+		}
 	}
 
 	{ // setup audit
@@ -401,6 +456,23 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Expired Segments Chore", peer.ExpiredDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket lifecycle
+		peer.BucketLifecycle.Chore = bucketlifecycle.NewChore(
+			peer.Log.Named("core-bucket-lifecycle"),
+			config.BucketLifecycle,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+			peer.Metainfo.PieceDeletion,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketlifecycle:chore",
+			Run:   peer.BucketLifecycle.Chore.Run,
+			Close: peer.BucketLifecycle.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.BucketLifecycle.Chore.Loop))
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Loop, config.Tally.Interval)
 		peer.Services.Add(lifecycle.Item{
@@ -422,7 +494,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Accounting Rollup", peer.Accounting.Rollup.Loop))
 
-		peer.Accounting.ProjectBWCleanupChore = projectbwcleanup.NewChore(peer.Log.Named("accounting:chore"), peer.DB.ProjectAccounting(), config.ProjectBWCleanup)
+		peer.Accounting.ProjectBWCleanupChore = projectbwcleanup.NewChore(peer.Log.Named("accounting:chore"), peer.DB.ProjectAccounting(), peer.DB.Orders(), config.ProjectBWCleanup)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "accounting:project-bw-rollup",
 			Run:   peer.Accounting.ProjectBWCleanupChore.Run,
@@ -499,6 +571,66 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		)
 	}
 
+	{ // setup usage alerts
+		if config.UsageAlerts.Enabled {
+			peer.Mail.Service, err = setupMailService(peer.Log, *config)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Services.Add(lifecycle.Item{
+				Name:  "mail:service",
+				Close: peer.Mail.Service.Close,
+			})
+
+			projectUsage := accounting.NewService(
+				peer.DB.ProjectAccounting(),
+				peer.LiveAccounting.Cache,
+				accounting.NewProjectLimitCache(peer.DB.ProjectAccounting(),
+					config.Console.Config.UsageLimits.DefaultStorageLimit,
+					config.Console.Config.UsageLimits.DefaultBandwidthLimit,
+					config.ProjectLimit,
+				),
+				accounting.NewBucketLimitCache(peer.DB.Buckets(), config.ProjectLimit),
+				config.LiveAccounting.BandwidthCacheTTL,
+			)
+
+			externalAddress := config.Console.ExternalAddress
+			if externalAddress == "" {
+				externalAddress = "http://" + config.Console.Address
+			}
+			if !strings.HasSuffix(externalAddress, "/") {
+				externalAddress += "/"
+			}
+
+			peer.UsageAlerts.Chore, err = usagealerts.NewChore(
+				peer.Log.Named("console:usagealerts"),
+				peer.DB.Console(),
+				projectUsage,
+				peer.Payments.Accounts,
+				peer.Mail.Service,
+				usagealerts.Links{
+					ExternalAddress:       externalAddress,
+					ContactInfoURL:        config.Console.ContactInfoURL,
+					TermsAndConditionsURL: config.Console.TermsAndConditionsURL,
+				},
+				config.UsageAlerts,
+			)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "console:usagealerts",
+				Run:   peer.UsageAlerts.Chore.Run,
+				Close: peer.UsageAlerts.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Console Usage Alerts", peer.UsageAlerts.Chore.Loop))
+		} else {
+			peer.Log.Named("console:usagealerts").Info("disabled")
+		}
+	}
+
 	{ // setup graceful exit
 		if config.GracefulExit.Enabled {
 			peer.GracefulExit.Chore = gracefulexit.NewChore(peer.Log.Named("gracefulexit"), peer.DB.GracefulExit(), peer.Overlay.DB, peer.Metainfo.Loop, config.GracefulExit)
@@ -0,0 +1,124 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package geoip resolves the country of an IP address using a local database.
+package geoip
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for geoip.
+var Error = errs.Class("geoip")
+
+// IPToCountry resolves the country of an IP address.
+type IPToCountry interface {
+	io.Closer
+	// LookupIPCountry returns the ISO 3166-1 alpha-2 country code of the ip,
+	// or an empty string when the country is unknown.
+	LookupIPCountry(ip net.IP) (string, error)
+}
+
+// Database is an in-memory IP range to country database.
+//
+// The database is loaded from a CSV file where each record is
+// `first_ip,last_ip,country_code`, e.g. `1.0.0.0,1.0.0.255,AU`. Lines starting
+// with `#` are ignored. IPv4 and IPv6 ranges can be mixed in the same file.
+type Database struct {
+	ranges []ipRange
+}
+
+var _ IPToCountry = (*Database)(nil)
+
+// ipRange is an inclusive range of IPv6 (or IPv4-mapped) addresses.
+type ipRange struct {
+	first   net.IP
+	last    net.IP
+	country string
+}
+
+// Open loads the database from the file at path.
+func Open(path string) (_ *Database, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	return Parse(file)
+}
+
+// Parse loads the database from r.
+func Parse(r io.Reader) (*Database, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	db := &Database{}
+	for {
+		record, err := reader.Read()
+		if errs.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		first, last := net.ParseIP(record[0]), net.ParseIP(record[1])
+		if first == nil || last == nil {
+			return nil, Error.New("invalid range %q-%q", record[0], record[1])
+		}
+		first, last = first.To16(), last.To16()
+		if bytes.Compare(first, last) > 0 {
+			return nil, Error.New("invalid range %q-%q", record[0], record[1])
+		}
+
+		db.ranges = append(db.ranges, ipRange{
+			first:   first,
+			last:    last,
+			country: strings.ToUpper(strings.TrimSpace(record[2])),
+		})
+	}
+
+	sort.Slice(db.ranges, func(i, k int) bool {
+		return bytes.Compare(db.ranges[i].first, db.ranges[k].first) < 0
+	})
+	for i := 1; i < len(db.ranges); i++ {
+		if bytes.Compare(db.ranges[i-1].last, db.ranges[i].first) >= 0 {
+			return nil, Error.New("overlapping ranges starting at %s and %s", db.ranges[i-1].first, db.ranges[i].first)
+		}
+	}
+
+	return db, nil
+}
+
+// LookupIPCountry returns the country code of the ip, or an empty string when
+// the ip isn't part of any range.
+func (db *Database) LookupIPCountry(ip net.IP) (string, error) {
+	ip = ip.To16()
+	if ip == nil {
+		return "", Error.New("invalid ip")
+	}
+
+	// find the first range which ends at or after ip.
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].last, ip) >= 0
+	})
+	if i < len(db.ranges) && bytes.Compare(db.ranges[i].first, ip) <= 0 {
+		return db.ranges[i].country, nil
+	}
+	return "", nil
+}
+
+// Close closes the database.
+func (db *Database) Close() error { return nil }
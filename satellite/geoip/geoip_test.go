@@ -0,0 +1,56 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package geoip_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/geoip"
+)
+
+func TestDatabase(t *testing.T) {
+	db, err := geoip.Parse(strings.NewReader(`
+# first_ip,last_ip,country_code
+10.0.0.0,10.0.0.255,de
+1.0.0.0,1.0.0.255,AU
+2001:db8::,2001:db8::ffff,FR
+`))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	for ip, expected := range map[string]string{
+		"1.0.0.0":       "AU",
+		"1.0.0.128":     "AU",
+		"1.0.0.255":     "AU",
+		"1.0.1.0":       "",
+		"9.255.255.255": "",
+		"10.0.0.1":      "DE",
+		"127.0.0.1":     "",
+		"2001:db8::1":   "FR",
+		"2001:db8::1:0": "",
+	} {
+		country, err := db.LookupIPCountry(net.ParseIP(ip))
+		require.NoError(t, err)
+		require.Equal(t, expected, country, ip)
+	}
+
+	_, err = db.LookupIPCountry(nil)
+	require.Error(t, err)
+}
+
+func TestDatabase_Invalid(t *testing.T) {
+	for _, invalid := range []string{
+		"1.0.0.0,1.0.0.255",
+		"1.0.0.x,1.0.0.255,AU",
+		"1.0.0.255,1.0.0.0,AU",
+		"1.0.0.0,1.0.0.255,AU\n1.0.0.255,1.0.1.255,DE",
+	} {
+		_, err := geoip.Parse(strings.NewReader(invalid))
+		require.Error(t, err, invalid)
+	}
+}
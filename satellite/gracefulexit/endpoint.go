@@ -49,6 +49,7 @@ type Endpoint struct {
 	overlaydb      overlay.DB
 	overlay        *overlay.Service
 	metabase       metainfo.MetabaseDB
+	buckets        metainfo.BucketsDB
 	orders         *orders.Service
 	connections    *connectionsTracker
 	peerIdentities overlay.PeerIdentities
@@ -91,7 +92,7 @@ func (pm *connectionsTracker) delete(nodeID storj.NodeID) {
 }
 
 // NewEndpoint creates a new graceful exit endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overlay.DB, overlay *overlay.Service, metabase metainfo.MetabaseDB, orders *orders.Service,
+func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overlay.DB, overlay *overlay.Service, metabase metainfo.MetabaseDB, buckets metainfo.BucketsDB, orders *orders.Service,
 	peerIdentities overlay.PeerIdentities, config Config) *Endpoint {
 	return &Endpoint{
 		log:            log,
@@ -101,6 +102,7 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, db DB, overlaydb overla
 		overlaydb:      overlaydb,
 		overlay:        overlay,
 		metabase:       metabase,
+		buckets:        buckets,
 		orders:         orders,
 		connections:    newConnectionsTracker(),
 		peerIdentities: peerIdentities,
@@ -377,10 +379,21 @@ func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCS
 		excludedIDs[i] = piece.StorageNode
 	}
 
+	// the replacement node must honor the bucket placement
+	location, err := metabase.ParseSegmentKey(incomplete.Key)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	placement, err := endpoint.buckets.GetBucketPlacement(ctx, []byte(location.BucketName), location.ProjectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	// get replacement node
 	request := &overlay.FindStorageNodesRequest{
 		RequestedCount: 1,
 		ExcludedIDs:    excludedIDs,
+		Placement:      placement,
 	}
 
 	newNodes, err := endpoint.overlay.FindStorageNodesForGracefulExit(ctx, *request)
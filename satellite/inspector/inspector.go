@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/metabase"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/durability"
 )
 
 var (
@@ -34,14 +35,16 @@ type Endpoint struct {
 	log        *zap.Logger
 	overlay    *overlay.Service
 	metabaseDB metainfo.MetabaseDB
+	durability durability.DB
 }
 
 // NewEndpoint will initialize an Endpoint struct.
-func NewEndpoint(log *zap.Logger, cache *overlay.Service, metabaseDB metainfo.MetabaseDB) *Endpoint {
+func NewEndpoint(log *zap.Logger, cache *overlay.Service, metabaseDB metainfo.MetabaseDB, durabilityDB durability.DB) *Endpoint {
 	return &Endpoint{
 		log:        log,
 		overlay:    cache,
 		metabaseDB: metabaseDB,
+		durability: durabilityDB,
 	}
 }
 
@@ -203,3 +206,40 @@ func (endpoint *Endpoint) segmentHealth(ctx context.Context, segment metabase.Se
 		Redundancy: redundancy,
 	}, nil
 }
+
+// DurabilityHistogram will return the number of segments by healthy piece
+// count of a project or a bucket, as computed by the last checker loop.
+func (endpoint *Endpoint) DurabilityHistogram(ctx context.Context, in *internalpb.DurabilityHistogramRequest) (_ *internalpb.DurabilityHistogramResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, err := uuid.FromBytes(in.GetProjectId())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	report, err := durability.GetReport(ctx, endpoint.durability, projectID, string(in.GetBucket()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &internalpb.DurabilityHistogramResponse{
+		ComputedAt: report.ComputedAt,
+	}
+	for _, histogram := range report.Histograms {
+		summary := histogram.Summary()
+		response.Histograms = append(response.Histograms, &internalpb.DurabilityHistogram{
+			Redundancy: &pb.RedundancyScheme{
+				MinReq:           int32(histogram.Redundancy.RequiredShares),
+				RepairThreshold:  int32(histogram.Redundancy.RepairShares),
+				SuccessThreshold: int32(histogram.Redundancy.OptimalShares),
+				Total:            int32(histogram.Redundancy.TotalShares),
+			},
+			HealthyCounts: histogram.HealthyCounts,
+			Lost:          summary.Lost,
+			NeedsRepair:   summary.NeedsRepair,
+			BelowOptimal:  summary.BelowOptimal,
+			Healthy:       summary.Healthy,
+		})
+	}
+	return response, nil
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/repair/durability"
 	"storj.io/uplink/private/eestream"
 )
 
@@ -109,6 +111,50 @@ func TestInspectorStats(t *testing.T) {
 	})
 }
 
+func TestInspectorDurabilityHistogram(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		healthEndpoint := satellite.Inspector.Endpoint
+
+		satellite.Repair.Checker.Loop.Pause()
+
+		projectID := testrand.UUID()
+		rs := durability.Redundancy{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 5}
+
+		computedAt := time.Now().UTC().Truncate(time.Microsecond)
+		err := satellite.DB.DurabilityHistograms().Replace(ctx, []durability.Histogram{
+			{ProjectID: projectID, BucketName: "alpha", Redundancy: rs, HealthyCounts: []int64{1, 0, 2, 3, 4}},
+			{ProjectID: projectID, BucketName: "beta", Redundancy: rs, HealthyCounts: []int64{0, 0, 0, 0, 5, 6}},
+		}, computedAt)
+		require.NoError(t, err)
+
+		resp, err := healthEndpoint.DurabilityHistogram(ctx, &internalpb.DurabilityHistogramRequest{
+			ProjectId: projectID[:],
+		})
+		require.NoError(t, err)
+		require.True(t, computedAt.Equal(resp.ComputedAt))
+		require.Len(t, resp.Histograms, 1)
+
+		histogram := resp.Histograms[0]
+		require.EqualValues(t, 3, histogram.Redundancy.RepairThreshold)
+		require.Equal(t, []int64{1, 0, 2, 3, 9, 6}, histogram.HealthyCounts)
+		require.EqualValues(t, 1, histogram.Lost)
+		require.EqualValues(t, 5, histogram.NeedsRepair)
+		require.EqualValues(t, 0, histogram.BelowOptimal)
+		require.EqualValues(t, 15, histogram.Healthy)
+
+		resp, err = healthEndpoint.DurabilityHistogram(ctx, &internalpb.DurabilityHistogramRequest{
+			ProjectId: projectID[:],
+			Bucket:    []byte("beta"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Histograms, 1)
+		require.Equal(t, []int64{0, 0, 0, 0, 5, 6}, resp.Histograms[0].HealthyCounts)
+	})
+}
+
 func encryptionAccess(access string) (*encryption.Store, error) {
 	data, version, err := base58.CheckDecode(access)
 	if err != nil || version != 0 {
@@ -6,6 +6,7 @@ package internalpb
 import (
 	fmt "fmt"
 	math "math"
+	time "time"
 
 	proto "github.com/gogo/protobuf/proto"
 
@@ -16,6 +17,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -450,6 +452,176 @@ func (m *SegmentHealth) GetSegment() []byte {
 	return nil
 }
 
+type DurabilityHistogramRequest struct {
+	ProjectId            []byte   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               []byte   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DurabilityHistogramRequest) Reset()         { *m = DurabilityHistogramRequest{} }
+func (m *DurabilityHistogramRequest) String() string { return proto.CompactTextString(m) }
+func (*DurabilityHistogramRequest) ProtoMessage()    {}
+func (*DurabilityHistogramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{8}
+}
+func (m *DurabilityHistogramRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurabilityHistogramRequest.Unmarshal(m, b)
+}
+func (m *DurabilityHistogramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurabilityHistogramRequest.Marshal(b, m, deterministic)
+}
+func (m *DurabilityHistogramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurabilityHistogramRequest.Merge(m, src)
+}
+func (m *DurabilityHistogramRequest) XXX_Size() int {
+	return xxx_messageInfo_DurabilityHistogramRequest.Size(m)
+}
+func (m *DurabilityHistogramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurabilityHistogramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurabilityHistogramRequest proto.InternalMessageInfo
+
+func (m *DurabilityHistogramRequest) GetProjectId() []byte {
+	if m != nil {
+		return m.ProjectId
+	}
+	return nil
+}
+
+func (m *DurabilityHistogramRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type DurabilityHistogramResponse struct {
+	ComputedAt           time.Time              `protobuf:"bytes,1,opt,name=computed_at,json=computedAt,proto3,stdtime" json:"computed_at"`
+	Histograms           []*DurabilityHistogram `protobuf:"bytes,2,rep,name=histograms,proto3" json:"histograms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DurabilityHistogramResponse) Reset()         { *m = DurabilityHistogramResponse{} }
+func (m *DurabilityHistogramResponse) String() string { return proto.CompactTextString(m) }
+func (*DurabilityHistogramResponse) ProtoMessage()    {}
+func (*DurabilityHistogramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{9}
+}
+func (m *DurabilityHistogramResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurabilityHistogramResponse.Unmarshal(m, b)
+}
+func (m *DurabilityHistogramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurabilityHistogramResponse.Marshal(b, m, deterministic)
+}
+func (m *DurabilityHistogramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurabilityHistogramResponse.Merge(m, src)
+}
+func (m *DurabilityHistogramResponse) XXX_Size() int {
+	return xxx_messageInfo_DurabilityHistogramResponse.Size(m)
+}
+func (m *DurabilityHistogramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurabilityHistogramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurabilityHistogramResponse proto.InternalMessageInfo
+
+func (m *DurabilityHistogramResponse) GetComputedAt() time.Time {
+	if m != nil {
+		return m.ComputedAt
+	}
+	return time.Time{}
+}
+
+func (m *DurabilityHistogramResponse) GetHistograms() []*DurabilityHistogram {
+	if m != nil {
+		return m.Histograms
+	}
+	return nil
+}
+
+type DurabilityHistogram struct {
+	Redundancy           *pb.RedundancyScheme `protobuf:"bytes,1,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	HealthyCounts        []int64              `protobuf:"varint,2,rep,packed,name=healthy_counts,json=healthyCounts,proto3" json:"healthy_counts,omitempty"`
+	Lost                 int64                `protobuf:"varint,3,opt,name=lost,proto3" json:"lost,omitempty"`
+	NeedsRepair          int64                `protobuf:"varint,4,opt,name=needs_repair,json=needsRepair,proto3" json:"needs_repair,omitempty"`
+	BelowOptimal         int64                `protobuf:"varint,5,opt,name=below_optimal,json=belowOptimal,proto3" json:"below_optimal,omitempty"`
+	Healthy              int64                `protobuf:"varint,6,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DurabilityHistogram) Reset()         { *m = DurabilityHistogram{} }
+func (m *DurabilityHistogram) String() string { return proto.CompactTextString(m) }
+func (*DurabilityHistogram) ProtoMessage()    {}
+func (*DurabilityHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{10}
+}
+func (m *DurabilityHistogram) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurabilityHistogram.Unmarshal(m, b)
+}
+func (m *DurabilityHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurabilityHistogram.Marshal(b, m, deterministic)
+}
+func (m *DurabilityHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurabilityHistogram.Merge(m, src)
+}
+func (m *DurabilityHistogram) XXX_Size() int {
+	return xxx_messageInfo_DurabilityHistogram.Size(m)
+}
+func (m *DurabilityHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurabilityHistogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurabilityHistogram proto.InternalMessageInfo
+
+func (m *DurabilityHistogram) GetRedundancy() *pb.RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
+func (m *DurabilityHistogram) GetHealthyCounts() []int64 {
+	if m != nil {
+		return m.HealthyCounts
+	}
+	return nil
+}
+
+func (m *DurabilityHistogram) GetLost() int64 {
+	if m != nil {
+		return m.Lost
+	}
+	return 0
+}
+
+func (m *DurabilityHistogram) GetNeedsRepair() int64 {
+	if m != nil {
+		return m.NeedsRepair
+	}
+	return 0
+}
+
+func (m *DurabilityHistogram) GetBelowOptimal() int64 {
+	if m != nil {
+		return m.BelowOptimal
+	}
+	return 0
+}
+
+func (m *DurabilityHistogram) GetHealthy() int64 {
+	if m != nil {
+		return m.Healthy
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "satellite.inspector.ListIrreparableSegmentsRequest")
 	proto.RegisterType((*ListIrreparableSegmentsResponse)(nil), "satellite.inspector.ListIrreparableSegmentsResponse")
@@ -459,56 +631,72 @@ func init() {
 	proto.RegisterType((*SegmentHealthRequest)(nil), "satellite.inspector.SegmentHealthRequest")
 	proto.RegisterType((*SegmentHealthResponse)(nil), "satellite.inspector.SegmentHealthResponse")
 	proto.RegisterType((*SegmentHealth)(nil), "satellite.inspector.SegmentHealth")
+	proto.RegisterType((*DurabilityHistogramRequest)(nil), "satellite.inspector.DurabilityHistogramRequest")
+	proto.RegisterType((*DurabilityHistogramResponse)(nil), "satellite.inspector.DurabilityHistogramResponse")
+	proto.RegisterType((*DurabilityHistogram)(nil), "satellite.inspector.DurabilityHistogram")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x66, 0x9b, 0x36, 0xc0, 0x24, 0x69, 0x61, 0x1b, 0x4a, 0x14, 0x04, 0x8d, 0x5c, 0x55, 0xa4,
-	0x14, 0x25, 0x28, 0xe5, 0x02, 0x48, 0x48, 0xfd, 0x39, 0x10, 0x09, 0x41, 0xe5, 0xde, 0xb8, 0x58,
-	0xfe, 0x99, 0x34, 0x6e, 0x9d, 0x5d, 0xb3, 0xbb, 0x91, 0xe8, 0x9d, 0x07, 0x40, 0xe2, 0xc4, 0x81,
-	0xb7, 0xe0, 0x69, 0x38, 0x70, 0xe0, 0x00, 0xaf, 0x81, 0xbc, 0x5e, 0xbb, 0xce, 0x4f, 0x51, 0x10,
-	0xb7, 0xf5, 0x7c, 0xdf, 0xcc, 0xce, 0x7c, 0xdf, 0x64, 0x03, 0x6b, 0x21, 0x93, 0x31, 0xfa, 0x8a,
-	0x8b, 0x4e, 0x2c, 0xb8, 0xe2, 0x74, 0x5d, 0xba, 0x0a, 0xa3, 0x28, 0x54, 0xd8, 0xc9, 0xa1, 0x26,
-	0x9c, 0xf2, 0x53, 0x9e, 0x12, 0x9a, 0xc0, 0x78, 0x80, 0xe6, 0xbc, 0x16, 0xf3, 0x90, 0x29, 0x14,
-	0x81, 0x97, 0x06, 0xac, 0x73, 0x78, 0xf0, 0x3a, 0x94, 0xaa, 0x2f, 0x04, 0xc6, 0xae, 0x70, 0xbd,
-	0x08, 0x4f, 0xf0, 0x74, 0x84, 0x4c, 0x49, 0x1b, 0xdf, 0x8f, 0x51, 0x2a, 0x5a, 0x87, 0x95, 0x28,
-	0x1c, 0x85, 0xaa, 0x41, 0x5a, 0xa4, 0xbd, 0x62, 0xa7, 0x1f, 0x74, 0x0f, 0x36, 0x22, 0x57, 0x2a,
-	0x47, 0x22, 0x32, 0x47, 0xa6, 0x29, 0x4e, 0xec, 0xaa, 0x61, 0x63, 0xa9, 0x45, 0xda, 0x55, 0x7b,
-	0x3d, 0x41, 0x4f, 0x10, 0x99, 0x29, 0x77, 0xec, 0xaa, 0xa1, 0x35, 0x80, 0xcd, 0x2b, 0x2f, 0x93,
-	0x31, 0x67, 0x12, 0xe9, 0x21, 0xdc, 0x30, 0xd5, 0x64, 0x83, 0xb4, 0x4a, 0xed, 0x4a, 0xef, 0x61,
-	0x67, 0xce, 0x80, 0x9d, 0xd9, 0x1a, 0x76, 0x9e, 0x68, 0xfd, 0x22, 0x40, 0x67, 0x09, 0x94, 0xc2,
-	0xb2, 0xee, 0x90, 0xe8, 0x0e, 0xf5, 0x99, 0x3e, 0x83, 0xd5, 0xac, 0xfb, 0x00, 0x95, 0x1b, 0x46,
-	0xba, 0xff, 0x4a, 0x8f, 0x76, 0x2e, 0x95, 0x3a, 0x4e, 0x4f, 0x76, 0xcd, 0x30, 0x8f, 0x34, 0x91,
-	0x6e, 0x42, 0x25, 0xe2, 0x52, 0x39, 0x71, 0x88, 0x3e, 0xca, 0x46, 0x49, 0xcb, 0x03, 0x49, 0xe8,
-	0x58, 0x47, 0x68, 0x07, 0xb4, 0x0a, 0x4e, 0xd2, 0x48, 0x28, 0x1c, 0x57, 0x29, 0x1c, 0xc5, 0xaa,
-	0xb1, 0xdc, 0x22, 0xed, 0x92, 0x7d, 0x3b, 0x81, 0x6c, 0x8d, 0xec, 0xa7, 0x00, 0x7d, 0x02, 0xf5,
-	0x49, 0xaa, 0xe3, 0xf3, 0x31, 0x53, 0x8d, 0x15, 0x9d, 0x40, 0x45, 0x91, 0x7c, 0x98, 0x20, 0xd6,
-	0x6f, 0x02, 0xeb, 0x6f, 0xbd, 0x33, 0xf4, 0xd5, 0x2b, 0x74, 0x23, 0x35, 0xcc, 0x3c, 0xdb, 0x86,
-	0x55, 0x64, 0xbe, 0xb8, 0x88, 0x15, 0x06, 0x4e, 0x61, 0xe6, 0x5a, 0x1e, 0x4d, 0xfc, 0xa0, 0x1b,
-	0x50, 0xf6, 0xc6, 0xfe, 0x39, 0x2a, 0x63, 0x9a, 0xf9, 0xa2, 0xf7, 0x01, 0x62, 0xc1, 0x93, 0xb2,
-	0x4e, 0x18, 0xe8, 0xc1, 0xaa, 0xf6, 0x4d, 0x13, 0xe9, 0x07, 0xc9, 0x5c, 0x52, 0xb9, 0x42, 0x39,
-	0xee, 0x40, 0xa1, 0xc8, 0xdc, 0xcf, 0xe6, 0xd2, 0xd0, 0x7e, 0x82, 0x64, 0xba, 0x3f, 0x06, 0x8a,
-	0x2c, 0x70, 0x3c, 0x1c, 0x70, 0x81, 0x39, 0x3d, 0x9d, 0xea, 0x16, 0xb2, 0xe0, 0x40, 0x03, 0x19,
-	0x3b, 0xdf, 0xb7, 0x72, 0x61, 0xdf, 0xac, 0xcf, 0x04, 0xea, 0x93, 0x93, 0x9a, 0x85, 0x79, 0x39,
-	0xb3, 0x30, 0xd6, 0xdc, 0x85, 0x31, 0xe5, 0x4d, 0x76, 0x9e, 0x43, 0x5f, 0x00, 0x08, 0x0c, 0xc6,
-	0x2c, 0x70, 0x99, 0x7f, 0x61, 0xcc, 0xbf, 0x57, 0x30, 0xdf, 0xce, 0xc1, 0x13, 0x7f, 0x88, 0x23,
-	0xb4, 0x0b, 0x74, 0xeb, 0x0b, 0x81, 0xfa, 0x64, 0x61, 0x63, 0xc0, 0xa5, 0xb2, 0x64, 0x42, 0xd9,
-	0x59, 0x63, 0x96, 0xe6, 0x19, 0xb3, 0x05, 0xd9, 0xae, 0x39, 0x21, 0x0b, 0xf0, 0x83, 0xf6, 0xa0,
-	0x64, 0x57, 0x4d, 0xb0, 0x9f, 0xc4, 0xa6, 0x5c, 0x5a, 0x9e, 0x72, 0xc9, 0xfa, 0x44, 0xe0, 0xce,
-	0x54, 0x6f, 0x46, 0xb2, 0xe7, 0x50, 0x1e, 0xea, 0x88, 0x6e, 0x6e, 0x31, 0xc1, 0x4c, 0xc6, 0xff,
-	0xc9, 0xf5, 0x8d, 0x40, 0x6d, 0xa2, 0x2c, 0xdd, 0x85, 0x4a, 0x5a, 0xf8, 0xc2, 0x09, 0x83, 0xd4,
-	0xc0, 0xea, 0x01, 0x7c, 0xff, 0xb1, 0x59, 0x7e, 0xc3, 0x03, 0xec, 0x1f, 0xd9, 0x60, 0xe0, 0x7e,
-	0x20, 0x69, 0x17, 0x6a, 0x63, 0x56, 0xa4, 0x2f, 0xcd, 0xd0, 0xab, 0x39, 0x21, 0x49, 0xd8, 0x85,
-	0x0a, 0x1f, 0x0c, 0xa2, 0x90, 0xa1, 0xa6, 0x97, 0x66, 0xab, 0x1b, 0x38, 0x21, 0x37, 0xe0, 0x7a,
-	0x71, 0x93, 0xab, 0x76, 0xf6, 0xd9, 0xfb, 0x4a, 0xa0, 0x5e, 0x78, 0x4e, 0xfa, 0x99, 0x44, 0xf4,
-	0x23, 0x81, 0xbb, 0x57, 0x3c, 0x68, 0x74, 0x6f, 0xae, 0xa8, 0x7f, 0x7f, 0x6b, 0x9b, 0x4f, 0xff,
-	0x2d, 0x29, 0xf5, 0xb3, 0xf7, 0x93, 0xc0, 0x5a, 0xaa, 0xe7, 0x65, 0x6b, 0x08, 0xd5, 0xe2, 0xcf,
-	0x85, 0xb6, 0xe7, 0x56, 0x9e, 0xf3, 0x76, 0x34, 0x77, 0x16, 0x60, 0xa6, 0x17, 0x5b, 0xd7, 0xe8,
-	0x70, 0xda, 0xd0, 0x9d, 0x05, 0x76, 0xc9, 0x5c, 0xf4, 0x68, 0x11, 0x6a, 0x76, 0xd3, 0xc1, 0xf6,
-	0xbb, 0x2d, 0xa9, 0xb8, 0x38, 0xeb, 0x84, 0xbc, 0xab, 0x0f, 0xdd, 0x3c, 0xbb, 0xab, 0x97, 0x8f,
-	0xb9, 0x51, 0xec, 0x79, 0x65, 0xfd, 0xb7, 0xb6, 0xf7, 0x27, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x69,
-	0x35, 0x2a, 0x27, 0x07, 0x00, 0x00,
+	// 940 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xde, 0x8e, 0x13, 0xb3, 0x94, 0xed, 0x0d, 0x74, 0xcc, 0x62, 0x79, 0x05, 0x0e, 0xb3, 0x5a,
+	0xe1, 0x65, 0xd1, 0x78, 0xe5, 0x70, 0x01, 0x24, 0xa4, 0x64, 0x83, 0x14, 0x4b, 0x88, 0x8d, 0x26,
+	0x9c, 0xb8, 0x8c, 0x7a, 0x3c, 0x65, 0xbb, 0x77, 0xc7, 0xdd, 0xc3, 0x74, 0x8f, 0x20, 0x9c, 0x79,
+	0x80, 0x95, 0x38, 0x71, 0xe0, 0x15, 0x38, 0xf1, 0x10, 0x3c, 0x03, 0x87, 0x70, 0x83, 0x23, 0xaf,
+	0x80, 0xa6, 0xa7, 0x67, 0x32, 0xb6, 0x27, 0xc8, 0xd1, 0xde, 0xba, 0xab, 0xbe, 0xfa, 0xe9, 0xfa,
+	0xaa, 0xaa, 0x61, 0x9f, 0x0b, 0x15, 0xe3, 0x54, 0xcb, 0xc4, 0x8d, 0x13, 0xa9, 0x25, 0x3d, 0x50,
+	0x4c, 0x63, 0x14, 0x71, 0x8d, 0x6e, 0xa9, 0xea, 0xc3, 0x5c, 0xce, 0x65, 0x0e, 0xe8, 0x0f, 0xe6,
+	0x52, 0xce, 0x23, 0x1c, 0x99, 0x5b, 0x90, 0xce, 0x46, 0x9a, 0x2f, 0x51, 0x69, 0xb6, 0x8c, 0x2d,
+	0x00, 0x84, 0x0c, 0xd1, 0x9e, 0xf7, 0x63, 0xc9, 0x85, 0xc6, 0x24, 0x0c, 0x72, 0x81, 0xf3, 0x12,
+	0xde, 0xff, 0x8a, 0x2b, 0x3d, 0x49, 0x12, 0x8c, 0x59, 0xc2, 0x82, 0x08, 0x2f, 0x70, 0xbe, 0x44,
+	0xa1, 0x95, 0x87, 0xdf, 0xa5, 0xa8, 0x34, 0xed, 0xc2, 0x5e, 0xc4, 0x97, 0x5c, 0xf7, 0xc8, 0x21,
+	0x19, 0xee, 0x79, 0xf9, 0x85, 0x1e, 0xc1, 0xfd, 0x88, 0x29, 0xed, 0x2b, 0x44, 0xe1, 0xab, 0xdc,
+	0xc4, 0x8f, 0x99, 0x5e, 0xf4, 0x76, 0x0e, 0xc9, 0xb0, 0xed, 0x1d, 0x64, 0xda, 0x0b, 0x44, 0x61,
+	0xdd, 0x9d, 0x33, 0xbd, 0x70, 0x66, 0x30, 0xb8, 0x31, 0x98, 0x8a, 0xa5, 0x50, 0x48, 0x9f, 0xc1,
+	0x5d, 0xeb, 0x4d, 0xf5, 0xc8, 0x61, 0x63, 0xd8, 0x1a, 0x7f, 0xe8, 0xd6, 0x54, 0xc0, 0xdd, 0xf4,
+	0xe1, 0x95, 0x86, 0xce, 0xdf, 0x04, 0xe8, 0x26, 0x80, 0x52, 0xd8, 0x35, 0x19, 0x12, 0x93, 0xa1,
+	0x39, 0xd3, 0x4f, 0xe1, 0x5e, 0x91, 0x7d, 0x88, 0x9a, 0xf1, 0xc8, 0xe4, 0xdf, 0x1a, 0x53, 0xf7,
+	0xba, 0x52, 0xe7, 0xf9, 0xc9, 0xeb, 0x58, 0xe4, 0xa9, 0x01, 0xd2, 0x01, 0xb4, 0x22, 0xa9, 0xb4,
+	0x1f, 0x73, 0x9c, 0xa2, 0xea, 0x35, 0x4c, 0x79, 0x20, 0x13, 0x9d, 0x1b, 0x09, 0x75, 0xc1, 0x54,
+	0xc1, 0xcf, 0x12, 0xe1, 0x89, 0xcf, 0xb4, 0xc6, 0x65, 0xac, 0x7b, 0xbb, 0x87, 0x64, 0xd8, 0xf0,
+	0xde, 0xce, 0x54, 0x9e, 0xd1, 0x1c, 0xe7, 0x0a, 0xfa, 0x14, 0xba, 0xab, 0x50, 0x7f, 0x2a, 0x53,
+	0xa1, 0x7b, 0x7b, 0xc6, 0x80, 0x26, 0x55, 0xf0, 0xb3, 0x4c, 0xe3, 0xfc, 0x43, 0xe0, 0xe0, 0x79,
+	0xf0, 0x02, 0xa7, 0xfa, 0x0c, 0x59, 0xa4, 0x17, 0x05, 0x67, 0x8f, 0xe0, 0x1e, 0x8a, 0x69, 0x72,
+	0x19, 0x6b, 0x0c, 0xfd, 0xca, 0x9b, 0x3b, 0xa5, 0x34, 0xe3, 0x83, 0xde, 0x87, 0x66, 0x90, 0x4e,
+	0x5f, 0xa2, 0xb6, 0xa4, 0xd9, 0x1b, 0x7d, 0x0f, 0x20, 0x4e, 0x64, 0xe6, 0xd6, 0xe7, 0xa1, 0x79,
+	0x58, 0xdb, 0x7b, 0xd3, 0x4a, 0x26, 0x61, 0xf6, 0x2e, 0xa5, 0x59, 0xa2, 0x7d, 0x36, 0xd3, 0x98,
+	0x14, 0xec, 0x17, 0xef, 0x32, 0xaa, 0xe3, 0x4c, 0x53, 0xd4, 0xfd, 0x63, 0xa0, 0x28, 0x42, 0x3f,
+	0xc0, 0x99, 0x4c, 0xb0, 0x84, 0xe7, 0xaf, 0x7a, 0x0b, 0x45, 0x78, 0x62, 0x14, 0x05, 0xba, 0xec,
+	0xb7, 0x66, 0xa5, 0xdf, 0x9c, 0x9f, 0x09, 0x74, 0x57, 0x5f, 0x6a, 0x1b, 0xe6, 0x8b, 0x8d, 0x86,
+	0x71, 0x6a, 0x1b, 0xc6, 0xba, 0xb7, 0xd6, 0xa5, 0x0d, 0xfd, 0x1c, 0x20, 0xc1, 0x30, 0x15, 0x21,
+	0x13, 0xd3, 0x4b, 0x4b, 0xfe, 0x83, 0x0a, 0xf9, 0x5e, 0xa9, 0xbc, 0x98, 0x2e, 0x70, 0x89, 0x5e,
+	0x05, 0xee, 0xfc, 0x42, 0xa0, 0xbb, 0xea, 0xd8, 0x12, 0x70, 0x5d, 0x59, 0xb2, 0x52, 0xd9, 0x4d,
+	0x62, 0x76, 0xea, 0x88, 0x79, 0x08, 0x45, 0xaf, 0xf9, 0x5c, 0x84, 0xf8, 0x83, 0xe1, 0xa0, 0xe1,
+	0xb5, 0xad, 0x70, 0x92, 0xc9, 0xd6, 0x58, 0xda, 0x5d, 0x63, 0xc9, 0x79, 0x45, 0xe0, 0x9d, 0xb5,
+	0xdc, 0x6c, 0xc9, 0x3e, 0x83, 0xe6, 0xc2, 0x48, 0x4c, 0x72, 0xdb, 0x15, 0xcc, 0x5a, 0xbc, 0x5e,
+	0xb9, 0x7e, 0x27, 0xd0, 0x59, 0x71, 0x4b, 0x9f, 0x40, 0x2b, 0x77, 0x7c, 0xe9, 0xf3, 0x30, 0x27,
+	0xb0, 0x7d, 0x02, 0x7f, 0x5e, 0x0d, 0x9a, 0x5f, 0xcb, 0x10, 0x27, 0xa7, 0x1e, 0x58, 0xf5, 0x24,
+	0x54, 0x74, 0x04, 0x9d, 0x54, 0x54, 0xe1, 0x3b, 0x1b, 0xf0, 0x76, 0x2a, 0x2a, 0x06, 0x4f, 0xa0,
+	0x25, 0x67, 0xb3, 0x88, 0x0b, 0x34, 0xf0, 0xc6, 0xa6, 0x77, 0xab, 0xce, 0xc0, 0x3d, 0x78, 0xa3,
+	0xda, 0xc9, 0x6d, 0xaf, 0xb8, 0x3a, 0x17, 0xd0, 0x3f, 0x4d, 0x13, 0x16, 0xf0, 0x88, 0xeb, 0xcb,
+	0x33, 0xae, 0xb4, 0x9c, 0x27, 0x6c, 0x59, 0x50, 0xbd, 0x4a, 0x03, 0x59, 0x1f, 0x96, 0x1b, 0x66,
+	0xcc, 0xf9, 0x8d, 0xc0, 0x83, 0x5a, 0xaf, 0x96, 0xa4, 0x2f, 0xa1, 0x35, 0x95, 0xcb, 0x38, 0xcd,
+	0x1a, 0x85, 0x69, 0xcb, 0x54, 0xdf, 0xcd, 0x97, 0xbd, 0x5b, 0x2c, 0x7b, 0xf7, 0x9b, 0x62, 0xd9,
+	0x9f, 0xdc, 0xfd, 0xe3, 0x6a, 0x70, 0xe7, 0xd5, 0x5f, 0x03, 0xe2, 0x41, 0x61, 0x78, 0xac, 0xe9,
+	0x19, 0xc0, 0xa2, 0xf0, 0x9d, 0x17, 0xac, 0x35, 0x1e, 0xd6, 0xf2, 0x5d, 0x97, 0x4c, 0xc5, 0xd6,
+	0xf9, 0x97, 0xc0, 0x41, 0x0d, 0x66, 0xad, 0x23, 0xc8, 0xad, 0x3a, 0x22, 0x9b, 0x87, 0x82, 0x50,
+	0xb3, 0xeb, 0xf2, 0x14, 0x1b, 0x5e, 0xc7, 0x4a, 0xcd, 0x9a, 0x53, 0xd9, 0xe6, 0xce, 0xf6, 0xaa,
+	0x1d, 0x03, 0x73, 0xa6, 0x1f, 0x40, 0x5b, 0x20, 0x86, 0xca, 0xae, 0x57, 0xbb, 0x7e, 0x5a, 0x46,
+	0x96, 0xef, 0xd5, 0x6c, 0x8c, 0x02, 0x8c, 0xe4, 0xf7, 0xbe, 0x8c, 0x35, 0x5f, 0xb2, 0xc8, 0xee,
+	0x9c, 0xb6, 0x11, 0x3e, 0xcf, 0x65, 0x19, 0xef, 0x36, 0x98, 0xd9, 0x38, 0x0d, 0xaf, 0xb8, 0x8e,
+	0x7f, 0x25, 0xd0, 0xad, 0x7c, 0x23, 0x93, 0xa2, 0x54, 0xf4, 0x27, 0x02, 0xef, 0xde, 0xf0, 0x91,
+	0xd1, 0xa3, 0xda, 0xe2, 0xfe, 0xff, 0x1f, 0xdb, 0xff, 0xe4, 0x76, 0x46, 0x79, 0x8b, 0x8c, 0xaf,
+	0x76, 0x60, 0x3f, 0x9f, 0xa3, 0xeb, 0xd4, 0x10, 0xda, 0xd5, 0x35, 0x49, 0xeb, 0xb9, 0xae, 0xf9,
+	0x33, 0xfa, 0x8f, 0xb7, 0x40, 0xe6, 0x81, 0x9d, 0x3b, 0x74, 0xb1, 0x3e, 0xc8, 0x8f, 0xb7, 0xd8,
+	0x21, 0x36, 0xd0, 0x47, 0xdb, 0x40, 0xcb, 0x48, 0x3f, 0xd6, 0x77, 0xdd, 0x68, 0xeb, 0x1e, 0xb6,
+	0x51, 0x9f, 0x6e, 0x6f, 0x50, 0xc4, 0x3e, 0x79, 0xf4, 0xed, 0x43, 0xa5, 0x65, 0xf2, 0xc2, 0xe5,
+	0x72, 0x64, 0x0e, 0xa3, 0xd2, 0xc7, 0xc8, 0xb4, 0xb7, 0x60, 0x51, 0x1c, 0x04, 0x4d, 0x33, 0x8d,
+	0x47, 0xff, 0x0d, 0x00, 0xcb, 0x85, 0xe5, 0xa5, 0xbc, 0x09, 0x00, 0x00,
 }
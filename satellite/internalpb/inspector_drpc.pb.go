@@ -119,6 +119,7 @@ type DRPCHealthInspectorClient interface {
 
 	ObjectHealth(ctx context.Context, in *ObjectHealthRequest) (*ObjectHealthResponse, error)
 	SegmentHealth(ctx context.Context, in *SegmentHealthRequest) (*SegmentHealthResponse, error)
+	DurabilityHistogram(ctx context.Context, in *DurabilityHistogramRequest) (*DurabilityHistogramResponse, error)
 }
 
 type drpcHealthInspectorClient struct {
@@ -149,9 +150,19 @@ func (c *drpcHealthInspectorClient) SegmentHealth(ctx context.Context, in *Segme
 	return out, nil
 }
 
+func (c *drpcHealthInspectorClient) DurabilityHistogram(ctx context.Context, in *DurabilityHistogramRequest) (*DurabilityHistogramResponse, error) {
+	out := new(DurabilityHistogramResponse)
+	err := c.cc.Invoke(ctx, "/satellite.inspector.HealthInspector/DurabilityHistogram", drpcEncoding_File_inspector_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCHealthInspectorServer interface {
 	ObjectHealth(context.Context, *ObjectHealthRequest) (*ObjectHealthResponse, error)
 	SegmentHealth(context.Context, *SegmentHealthRequest) (*SegmentHealthResponse, error)
+	DurabilityHistogram(context.Context, *DurabilityHistogramRequest) (*DurabilityHistogramResponse, error)
 }
 
 type DRPCHealthInspectorUnimplementedServer struct{}
@@ -164,9 +175,13 @@ func (s *DRPCHealthInspectorUnimplementedServer) SegmentHealth(context.Context,
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
 }
 
+func (s *DRPCHealthInspectorUnimplementedServer) DurabilityHistogram(context.Context, *DurabilityHistogramRequest) (*DurabilityHistogramResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
 type DRPCHealthInspectorDescription struct{}
 
-func (DRPCHealthInspectorDescription) NumMethods() int { return 2 }
+func (DRPCHealthInspectorDescription) NumMethods() int { return 3 }
 
 func (DRPCHealthInspectorDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -188,6 +203,15 @@ func (DRPCHealthInspectorDescription) Method(n int) (string, drpc.Encoding, drpc
 						in1.(*SegmentHealthRequest),
 					)
 			}, DRPCHealthInspectorServer.SegmentHealth, true
+	case 2:
+		return "/satellite.inspector.HealthInspector/DurabilityHistogram", drpcEncoding_File_inspector_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCHealthInspectorServer).
+					DurabilityHistogram(
+						ctx,
+						in1.(*DurabilityHistogramRequest),
+					)
+			}, DRPCHealthInspectorServer.DurabilityHistogram, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -228,3 +252,19 @@ func (x *drpcHealthInspector_SegmentHealthStream) SendAndClose(m *SegmentHealthR
 	}
 	return x.CloseSend()
 }
+
+type DRPCHealthInspector_DurabilityHistogramStream interface {
+	drpc.Stream
+	SendAndClose(*DurabilityHistogramResponse) error
+}
+
+type drpcHealthInspector_DurabilityHistogramStream struct {
+	drpc.Stream
+}
+
+func (x *drpcHealthInspector_DurabilityHistogramStream) SendAndClose(m *DurabilityHistogramResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_inspector_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lostpieces.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ReportLostPiecesRequest struct {
+	// Pieces which are missing or failed verification on the node.
+	PieceIds             []PieceID `protobuf:"bytes,1,rep,name=piece_ids,json=pieceIds,proto3,customtype=PieceID" json:"piece_ids"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReportLostPiecesRequest) Reset()         { *m = ReportLostPiecesRequest{} }
+func (m *ReportLostPiecesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportLostPiecesRequest) ProtoMessage()    {}
+func (*ReportLostPiecesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b78e2664db6853, []int{0}
+}
+func (m *ReportLostPiecesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportLostPiecesRequest.Unmarshal(m, b)
+}
+func (m *ReportLostPiecesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportLostPiecesRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportLostPiecesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportLostPiecesRequest.Merge(m, src)
+}
+func (m *ReportLostPiecesRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportLostPiecesRequest.Size(m)
+}
+func (m *ReportLostPiecesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportLostPiecesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportLostPiecesRequest proto.InternalMessageInfo
+
+type ReportLostPiecesResponse struct {
+	// Number of pieces accepted from the report.
+	AcceptedCount        int32    `protobuf:"varint,1,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportLostPiecesResponse) Reset()         { *m = ReportLostPiecesResponse{} }
+func (m *ReportLostPiecesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportLostPiecesResponse) ProtoMessage()    {}
+func (*ReportLostPiecesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b78e2664db6853, []int{1}
+}
+func (m *ReportLostPiecesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportLostPiecesResponse.Unmarshal(m, b)
+}
+func (m *ReportLostPiecesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportLostPiecesResponse.Marshal(b, m, deterministic)
+}
+func (m *ReportLostPiecesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportLostPiecesResponse.Merge(m, src)
+}
+func (m *ReportLostPiecesResponse) XXX_Size() int {
+	return xxx_messageInfo_ReportLostPiecesResponse.Size(m)
+}
+func (m *ReportLostPiecesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportLostPiecesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportLostPiecesResponse proto.InternalMessageInfo
+
+func (m *ReportLostPiecesResponse) GetAcceptedCount() int32 {
+	if m != nil {
+		return m.AcceptedCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReportLostPiecesRequest)(nil), "satellite.lostpieces.ReportLostPiecesRequest")
+	proto.RegisterType((*ReportLostPiecesResponse)(nil), "satellite.lostpieces.ReportLostPiecesResponse")
+}
+
+func init() { proto.RegisterFile("lostpieces.proto", fileDescriptor_79b78e2664db6853) }
+
+var fileDescriptor_79b78e2664db6853 = []byte{
+	// 230 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc8, 0xc9, 0x2f, 0x2e,
+	0x29, 0xc8, 0x4c, 0x4d, 0x4e, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x29, 0x4e,
+	0x2c, 0x49, 0xcd, 0xc9, 0xc9, 0x2c, 0x49, 0xd5, 0x43, 0xc8, 0x49, 0x71, 0xa5, 0xe7, 0xa7, 0xe7,
+	0x43, 0x54, 0x28, 0xb9, 0x73, 0x89, 0x07, 0xa5, 0x16, 0xe4, 0x17, 0x95, 0xf8, 0xe4, 0x17, 0x97,
+	0x04, 0x80, 0xe5, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x74, 0xb8, 0x38, 0xc1, 0x1a,
+	0xe2, 0x33, 0x53, 0x8a, 0x25, 0x18, 0x15, 0x98, 0x35, 0x78, 0x9c, 0xf8, 0x4f, 0xdc, 0x93, 0x67,
+	0xb8, 0x75, 0x4f, 0x9e, 0x1d, 0xac, 0xd2, 0xd3, 0x25, 0x88, 0x03, 0xac, 0xc2, 0x33, 0xa5, 0x58,
+	0xc9, 0x91, 0x4b, 0x02, 0xd3, 0xa0, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x55, 0x2e, 0xbe,
+	0xc4, 0xe4, 0xe4, 0xd4, 0x82, 0x92, 0xd4, 0x94, 0xf8, 0xe4, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x46,
+	0x05, 0x46, 0x0d, 0xd6, 0x20, 0x5e, 0x98, 0xa8, 0x33, 0x48, 0xd0, 0xa8, 0x91, 0x91, 0x8b, 0x0b,
+	0xa1, 0x5b, 0xa8, 0x98, 0x4b, 0x00, 0xdd, 0x44, 0x21, 0x5d, 0x3d, 0x6c, 0x3e, 0xd2, 0xc3, 0xe1,
+	0x05, 0x29, 0x3d, 0x62, 0x95, 0x43, 0x1c, 0xaa, 0xc4, 0xe0, 0xa4, 0x1a, 0xa5, 0x5c, 0x5c, 0x92,
+	0x5f, 0x94, 0xa5, 0x97, 0x99, 0xaf, 0x0f, 0x66, 0xe8, 0xc3, 0x4d, 0xd0, 0xcf, 0xcc, 0x2b, 0x49,
+	0x2d, 0xca, 0x4b, 0xcc, 0x29, 0x48, 0x4a, 0x62, 0x03, 0x87, 0x9e, 0x31, 0x60, 0x00, 0x8f, 0xb9,
+	0xc5, 0xdb, 0x73, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.20
+// source: lostpieces.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_lostpieces_proto struct{}
+
+func (drpcEncoding_File_lostpieces_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_lostpieces_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_lostpieces_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_lostpieces_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCLostPiecesClient interface {
+	DRPCConn() drpc.Conn
+
+	ReportLostPieces(ctx context.Context, in *ReportLostPiecesRequest) (*ReportLostPiecesResponse, error)
+}
+
+type drpcLostPiecesClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCLostPiecesClient(cc drpc.Conn) DRPCLostPiecesClient {
+	return &drpcLostPiecesClient{cc}
+}
+
+func (c *drpcLostPiecesClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcLostPiecesClient) ReportLostPieces(ctx context.Context, in *ReportLostPiecesRequest) (*ReportLostPiecesResponse, error) {
+	out := new(ReportLostPiecesResponse)
+	err := c.cc.Invoke(ctx, "/satellite.lostpieces.LostPieces/ReportLostPieces", drpcEncoding_File_lostpieces_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCLostPiecesServer interface {
+	ReportLostPieces(context.Context, *ReportLostPiecesRequest) (*ReportLostPiecesResponse, error)
+}
+
+type DRPCLostPiecesUnimplementedServer struct{}
+
+func (s *DRPCLostPiecesUnimplementedServer) ReportLostPieces(context.Context, *ReportLostPiecesRequest) (*ReportLostPiecesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+type DRPCLostPiecesDescription struct{}
+
+func (DRPCLostPiecesDescription) NumMethods() int { return 1 }
+
+func (DRPCLostPiecesDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.lostpieces.LostPieces/ReportLostPieces", drpcEncoding_File_lostpieces_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCLostPiecesServer).
+					ReportLostPieces(
+						ctx,
+						in1.(*ReportLostPiecesRequest),
+					)
+			}, DRPCLostPiecesServer.ReportLostPieces, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterLostPieces(mux drpc.Mux, impl DRPCLostPiecesServer) error {
+	return mux.Register(impl, DRPCLostPiecesDescription{})
+}
+
+type DRPCLostPieces_ReportLostPiecesStream interface {
+	drpc.Stream
+	SendAndClose(*ReportLostPiecesResponse) error
+}
+
+type drpcLostPieces_ReportLostPiecesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcLostPieces_ReportLostPiecesStream) SendAndClose(m *ReportLostPiecesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_lostpieces_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
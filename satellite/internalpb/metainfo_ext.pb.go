@@ -0,0 +1,2219 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: metainfo_ext.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Versioning int32
+
+const (
+	Versioning_UNVERSIONED Versioning = 0
+	Versioning_ENABLED     Versioning = 1
+	Versioning_SUSPENDED   Versioning = 2
+)
+
+var Versioning_name = map[int32]string{
+	0: "UNVERSIONED",
+	1: "ENABLED",
+	2: "SUSPENDED",
+}
+
+var Versioning_value = map[string]int32{
+	"UNVERSIONED": 0,
+	"ENABLED":     1,
+	"SUSPENDED":   2,
+}
+
+func (x Versioning) String() string {
+	return proto.EnumName(Versioning_name, int32(x))
+}
+
+func (Versioning) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{0}
+}
+
+type RetentionMode int32
+
+const (
+	RetentionMode_NO_RETENTION RetentionMode = 0
+	RetentionMode_GOVERNANCE   RetentionMode = 1
+	RetentionMode_COMPLIANCE   RetentionMode = 2
+)
+
+var RetentionMode_name = map[int32]string{
+	0: "NO_RETENTION",
+	1: "GOVERNANCE",
+	2: "COMPLIANCE",
+}
+
+var RetentionMode_value = map[string]int32{
+	"NO_RETENTION": 0,
+	"GOVERNANCE":   1,
+	"COMPLIANCE":   2,
+}
+
+func (x RetentionMode) String() string {
+	return proto.EnumName(RetentionMode_name, int32(x))
+}
+
+func (RetentionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{1}
+}
+
+type EncryptedKeyAndNonce struct {
+	Position             *pb.SegmentPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	EncryptedKeyNonce    []byte              `protobuf:"bytes,2,opt,name=encrypted_key_nonce,json=encryptedKeyNonce,proto3" json:"encrypted_key_nonce,omitempty"`
+	EncryptedKey         []byte              `protobuf:"bytes,3,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EncryptedKeyAndNonce) Reset()         { *m = EncryptedKeyAndNonce{} }
+func (m *EncryptedKeyAndNonce) String() string { return proto.CompactTextString(m) }
+func (*EncryptedKeyAndNonce) ProtoMessage()    {}
+func (*EncryptedKeyAndNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{0}
+}
+func (m *EncryptedKeyAndNonce) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptedKeyAndNonce.Unmarshal(m, b)
+}
+func (m *EncryptedKeyAndNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptedKeyAndNonce.Marshal(b, m, deterministic)
+}
+func (m *EncryptedKeyAndNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedKeyAndNonce.Merge(m, src)
+}
+func (m *EncryptedKeyAndNonce) XXX_Size() int {
+	return xxx_messageInfo_EncryptedKeyAndNonce.Size(m)
+}
+func (m *EncryptedKeyAndNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedKeyAndNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedKeyAndNonce proto.InternalMessageInfo
+
+func (m *EncryptedKeyAndNonce) GetPosition() *pb.SegmentPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+func (m *EncryptedKeyAndNonce) GetEncryptedKeyNonce() []byte {
+	if m != nil {
+		return m.EncryptedKeyNonce
+	}
+	return nil
+}
+
+func (m *EncryptedKeyAndNonce) GetEncryptedKey() []byte {
+	if m != nil {
+		return m.EncryptedKey
+	}
+	return nil
+}
+
+type ObjectBeginMoveRequest struct {
+	Header                *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket                []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey    []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	NewBucket             []byte            `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey []byte            `protobuf:"bytes,4,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *ObjectBeginMoveRequest) Reset()         { *m = ObjectBeginMoveRequest{} }
+func (m *ObjectBeginMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginMoveRequest) ProtoMessage()    {}
+func (*ObjectBeginMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{1}
+}
+func (m *ObjectBeginMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginMoveRequest.Merge(m, src)
+}
+func (m *ObjectBeginMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginMoveRequest.Size(m)
+}
+func (m *ObjectBeginMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginMoveRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+type ObjectBeginMoveResponse struct {
+	// stream_id is the signed satellite stream id of the source object.
+	StreamId                  []byte                   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	EncryptedMetadataKeyNonce []byte                   `protobuf:"bytes,2,opt,name=encrypted_metadata_key_nonce,json=encryptedMetadataKeyNonce,proto3" json:"encrypted_metadata_key_nonce,omitempty"`
+	EncryptedMetadataKey      []byte                   `protobuf:"bytes,3,opt,name=encrypted_metadata_key,json=encryptedMetadataKey,proto3" json:"encrypted_metadata_key,omitempty"`
+	SegmentKeys               []*EncryptedKeyAndNonce  `protobuf:"bytes,4,rep,name=segment_keys,json=segmentKeys,proto3" json:"segment_keys,omitempty"`
+	EncryptionParameters      *pb.EncryptionParameters `protobuf:"bytes,5,opt,name=encryption_parameters,json=encryptionParameters,proto3" json:"encryption_parameters,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                 `json:"-"`
+	XXX_unrecognized          []byte                   `json:"-"`
+	XXX_sizecache             int32                    `json:"-"`
+}
+
+func (m *ObjectBeginMoveResponse) Reset()         { *m = ObjectBeginMoveResponse{} }
+func (m *ObjectBeginMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginMoveResponse) ProtoMessage()    {}
+func (*ObjectBeginMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{2}
+}
+func (m *ObjectBeginMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginMoveResponse.Merge(m, src)
+}
+func (m *ObjectBeginMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginMoveResponse.Size(m)
+}
+func (m *ObjectBeginMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginMoveResponse proto.InternalMessageInfo
+
+func (m *ObjectBeginMoveResponse) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.SegmentKeys
+	}
+	return nil
+}
+
+func (m *ObjectBeginMoveResponse) GetEncryptionParameters() *pb.EncryptionParameters {
+	if m != nil {
+		return m.EncryptionParameters
+	}
+	return nil
+}
+
+type ObjectFinishMoveRequest struct {
+	Header                       *pb.RequestHeader       `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	StreamId                     []byte                  `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	NewBucket                    []byte                  `protobuf:"bytes,2,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey        []byte                  `protobuf:"bytes,3,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	NewEncryptedMetadataKeyNonce []byte                  `protobuf:"bytes,4,opt,name=new_encrypted_metadata_key_nonce,json=newEncryptedMetadataKeyNonce,proto3" json:"new_encrypted_metadata_key_nonce,omitempty"`
+	NewEncryptedMetadataKey      []byte                  `protobuf:"bytes,5,opt,name=new_encrypted_metadata_key,json=newEncryptedMetadataKey,proto3" json:"new_encrypted_metadata_key,omitempty"`
+	NewSegmentKeys               []*EncryptedKeyAndNonce `protobuf:"bytes,6,rep,name=new_segment_keys,json=newSegmentKeys,proto3" json:"new_segment_keys,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                `json:"-"`
+	XXX_unrecognized             []byte                  `json:"-"`
+	XXX_sizecache                int32                   `json:"-"`
+}
+
+func (m *ObjectFinishMoveRequest) Reset()         { *m = ObjectFinishMoveRequest{} }
+func (m *ObjectFinishMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishMoveRequest) ProtoMessage()    {}
+func (*ObjectFinishMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{3}
+}
+func (m *ObjectFinishMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishMoveRequest.Merge(m, src)
+}
+func (m *ObjectFinishMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishMoveRequest.Size(m)
+}
+func (m *ObjectFinishMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishMoveRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishMoveRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishMoveRequest) GetNewSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.NewSegmentKeys
+	}
+	return nil
+}
+
+type ObjectFinishMoveResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectFinishMoveResponse) Reset()         { *m = ObjectFinishMoveResponse{} }
+func (m *ObjectFinishMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishMoveResponse) ProtoMessage()    {}
+func (*ObjectFinishMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{4}
+}
+func (m *ObjectFinishMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishMoveResponse.Merge(m, src)
+}
+func (m *ObjectFinishMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishMoveResponse.Size(m)
+}
+func (m *ObjectFinishMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishMoveResponse proto.InternalMessageInfo
+
+type ObjectBeginCopyRequest struct {
+	Header                *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket                []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey    []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	NewBucket             []byte            `protobuf:"bytes,3,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey []byte            `protobuf:"bytes,4,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
+	XXX_unrecognized      []byte            `json:"-"`
+	XXX_sizecache         int32             `json:"-"`
+}
+
+func (m *ObjectBeginCopyRequest) Reset()         { *m = ObjectBeginCopyRequest{} }
+func (m *ObjectBeginCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginCopyRequest) ProtoMessage()    {}
+func (*ObjectBeginCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{5}
+}
+func (m *ObjectBeginCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectBeginCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginCopyRequest.Merge(m, src)
+}
+func (m *ObjectBeginCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginCopyRequest.Size(m)
+}
+func (m *ObjectBeginCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectBeginCopyRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+type ObjectBeginCopyResponse struct {
+	// stream_id is the signed satellite stream id of the source object.
+	StreamId                  []byte                   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	EncryptedMetadataKeyNonce []byte                   `protobuf:"bytes,2,opt,name=encrypted_metadata_key_nonce,json=encryptedMetadataKeyNonce,proto3" json:"encrypted_metadata_key_nonce,omitempty"`
+	EncryptedMetadataKey      []byte                   `protobuf:"bytes,3,opt,name=encrypted_metadata_key,json=encryptedMetadataKey,proto3" json:"encrypted_metadata_key,omitempty"`
+	SegmentKeys               []*EncryptedKeyAndNonce  `protobuf:"bytes,4,rep,name=segment_keys,json=segmentKeys,proto3" json:"segment_keys,omitempty"`
+	EncryptionParameters      *pb.EncryptionParameters `protobuf:"bytes,5,opt,name=encryption_parameters,json=encryptionParameters,proto3" json:"encryption_parameters,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                 `json:"-"`
+	XXX_unrecognized          []byte                   `json:"-"`
+	XXX_sizecache             int32                    `json:"-"`
+}
+
+func (m *ObjectBeginCopyResponse) Reset()         { *m = ObjectBeginCopyResponse{} }
+func (m *ObjectBeginCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectBeginCopyResponse) ProtoMessage()    {}
+func (*ObjectBeginCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{6}
+}
+func (m *ObjectBeginCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectBeginCopyResponse.Unmarshal(m, b)
+}
+func (m *ObjectBeginCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectBeginCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectBeginCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectBeginCopyResponse.Merge(m, src)
+}
+func (m *ObjectBeginCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectBeginCopyResponse.Size(m)
+}
+func (m *ObjectBeginCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectBeginCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectBeginCopyResponse proto.InternalMessageInfo
+
+func (m *ObjectBeginCopyResponse) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.EncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.SegmentKeys
+	}
+	return nil
+}
+
+func (m *ObjectBeginCopyResponse) GetEncryptionParameters() *pb.EncryptionParameters {
+	if m != nil {
+		return m.EncryptionParameters
+	}
+	return nil
+}
+
+type ObjectFinishCopyRequest struct {
+	Header                       *pb.RequestHeader       `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	StreamId                     []byte                  `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	NewBucket                    []byte                  `protobuf:"bytes,2,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewEncryptedObjectKey        []byte                  `protobuf:"bytes,3,opt,name=new_encrypted_object_key,json=newEncryptedObjectKey,proto3" json:"new_encrypted_object_key,omitempty"`
+	NewEncryptedMetadataKeyNonce []byte                  `protobuf:"bytes,4,opt,name=new_encrypted_metadata_key_nonce,json=newEncryptedMetadataKeyNonce,proto3" json:"new_encrypted_metadata_key_nonce,omitempty"`
+	NewEncryptedMetadataKey      []byte                  `protobuf:"bytes,5,opt,name=new_encrypted_metadata_key,json=newEncryptedMetadataKey,proto3" json:"new_encrypted_metadata_key,omitempty"`
+	NewSegmentKeys               []*EncryptedKeyAndNonce `protobuf:"bytes,6,rep,name=new_segment_keys,json=newSegmentKeys,proto3" json:"new_segment_keys,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{}                `json:"-"`
+	XXX_unrecognized             []byte                  `json:"-"`
+	XXX_sizecache                int32                   `json:"-"`
+}
+
+func (m *ObjectFinishCopyRequest) Reset()         { *m = ObjectFinishCopyRequest{} }
+func (m *ObjectFinishCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishCopyRequest) ProtoMessage()    {}
+func (*ObjectFinishCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{7}
+}
+func (m *ObjectFinishCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Unmarshal(m, b)
+}
+func (m *ObjectFinishCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishCopyRequest.Merge(m, src)
+}
+func (m *ObjectFinishCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishCopyRequest.Size(m)
+}
+func (m *ObjectFinishCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishCopyRequest proto.InternalMessageInfo
+
+func (m *ObjectFinishCopyRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetStreamId() []byte {
+	if m != nil {
+		return m.StreamId
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.NewEncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewEncryptedMetadataKeyNonce() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKeyNonce
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewEncryptedMetadataKey() []byte {
+	if m != nil {
+		return m.NewEncryptedMetadataKey
+	}
+	return nil
+}
+
+func (m *ObjectFinishCopyRequest) GetNewSegmentKeys() []*EncryptedKeyAndNonce {
+	if m != nil {
+		return m.NewSegmentKeys
+	}
+	return nil
+}
+
+type ObjectFinishCopyResponse struct {
+	Object               *pb.Object `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ObjectFinishCopyResponse) Reset()         { *m = ObjectFinishCopyResponse{} }
+func (m *ObjectFinishCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectFinishCopyResponse) ProtoMessage()    {}
+func (*ObjectFinishCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{8}
+}
+func (m *ObjectFinishCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectFinishCopyResponse.Unmarshal(m, b)
+}
+func (m *ObjectFinishCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectFinishCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectFinishCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectFinishCopyResponse.Merge(m, src)
+}
+func (m *ObjectFinishCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectFinishCopyResponse.Size(m)
+}
+func (m *ObjectFinishCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectFinishCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectFinishCopyResponse proto.InternalMessageInfo
+
+func (m *ObjectFinishCopyResponse) GetObject() *pb.Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type GetBucketVersioningRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketVersioningRequest) Reset()         { *m = GetBucketVersioningRequest{} }
+func (m *GetBucketVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketVersioningRequest) ProtoMessage()    {}
+func (*GetBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{9}
+}
+func (m *GetBucketVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketVersioningRequest.Unmarshal(m, b)
+}
+func (m *GetBucketVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketVersioningRequest.Merge(m, src)
+}
+func (m *GetBucketVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketVersioningRequest.Size(m)
+}
+func (m *GetBucketVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketVersioningRequest proto.InternalMessageInfo
+
+func (m *GetBucketVersioningRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketVersioningRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketVersioningResponse struct {
+	Versioning           Versioning `protobuf:"varint,1,opt,name=versioning,proto3,enum=satellite.metainfo.Versioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetBucketVersioningResponse) Reset()         { *m = GetBucketVersioningResponse{} }
+func (m *GetBucketVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketVersioningResponse) ProtoMessage()    {}
+func (*GetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{10}
+}
+func (m *GetBucketVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketVersioningResponse.Unmarshal(m, b)
+}
+func (m *GetBucketVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketVersioningResponse.Merge(m, src)
+}
+func (m *GetBucketVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketVersioningResponse.Size(m)
+}
+func (m *GetBucketVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketVersioningResponse proto.InternalMessageInfo
+
+func (m *GetBucketVersioningResponse) GetVersioning() Versioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return Versioning_UNVERSIONED
+}
+
+type SetBucketVersioningRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Versioning           Versioning        `protobuf:"varint,2,opt,name=versioning,proto3,enum=satellite.metainfo.Versioning" json:"versioning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetBucketVersioningRequest) Reset()         { *m = SetBucketVersioningRequest{} }
+func (m *SetBucketVersioningRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketVersioningRequest) ProtoMessage()    {}
+func (*SetBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{11}
+}
+func (m *SetBucketVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketVersioningRequest.Unmarshal(m, b)
+}
+func (m *SetBucketVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketVersioningRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketVersioningRequest.Merge(m, src)
+}
+func (m *SetBucketVersioningRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketVersioningRequest.Size(m)
+}
+func (m *SetBucketVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketVersioningRequest proto.InternalMessageInfo
+
+func (m *SetBucketVersioningRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketVersioningRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketVersioningRequest) GetVersioning() Versioning {
+	if m != nil {
+		return m.Versioning
+	}
+	return Versioning_UNVERSIONED
+}
+
+type SetBucketVersioningResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketVersioningResponse) Reset()         { *m = SetBucketVersioningResponse{} }
+func (m *SetBucketVersioningResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketVersioningResponse) ProtoMessage()    {}
+func (*SetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{12}
+}
+func (m *SetBucketVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketVersioningResponse.Unmarshal(m, b)
+}
+func (m *SetBucketVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketVersioningResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketVersioningResponse.Merge(m, src)
+}
+func (m *SetBucketVersioningResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketVersioningResponse.Size(m)
+}
+func (m *SetBucketVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketVersioningResponse proto.InternalMessageInfo
+
+type ObjectListVersionsRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPrefix      []byte            `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	EncryptedCursor      []byte            `protobuf:"bytes,3,opt,name=encrypted_cursor,json=encryptedCursor,proto3" json:"encrypted_cursor,omitempty"`
+	VersionCursor        int64             `protobuf:"varint,4,opt,name=version_cursor,json=versionCursor,proto3" json:"version_cursor,omitempty"`
+	Limit                int32             `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectListVersionsRequest) Reset()         { *m = ObjectListVersionsRequest{} }
+func (m *ObjectListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsRequest) ProtoMessage()    {}
+func (*ObjectListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{13}
+}
+func (m *ObjectListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsRequest.Merge(m, src)
+}
+func (m *ObjectListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsRequest.Size(m)
+}
+func (m *ObjectListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsRequest proto.InternalMessageInfo
+
+func (m *ObjectListVersionsRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetEncryptedCursor() []byte {
+	if m != nil {
+		return m.EncryptedCursor
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsRequest) GetVersionCursor() int64 {
+	if m != nil {
+		return m.VersionCursor
+	}
+	return 0
+}
+
+func (m *ObjectListVersionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ObjectListVersionsResponse struct {
+	Items                []*pb.ObjectListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool                 `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ObjectListVersionsResponse) Reset()         { *m = ObjectListVersionsResponse{} }
+func (m *ObjectListVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectListVersionsResponse) ProtoMessage()    {}
+func (*ObjectListVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{14}
+}
+func (m *ObjectListVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectListVersionsResponse.Unmarshal(m, b)
+}
+func (m *ObjectListVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectListVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectListVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectListVersionsResponse.Merge(m, src)
+}
+func (m *ObjectListVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectListVersionsResponse.Size(m)
+}
+func (m *ObjectListVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectListVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectListVersionsResponse proto.InternalMessageInfo
+
+func (m *ObjectListVersionsResponse) GetItems() []*pb.ObjectListItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ObjectListVersionsResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type ObjectGetVersionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectGetVersionRequest) Reset()         { *m = ObjectGetVersionRequest{} }
+func (m *ObjectGetVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetVersionRequest) ProtoMessage()    {}
+func (*ObjectGetVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{15}
+}
+func (m *ObjectGetVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetVersionRequest.Unmarshal(m, b)
+}
+func (m *ObjectGetVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetVersionRequest.Merge(m, src)
+}
+func (m *ObjectGetVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetVersionRequest.Size(m)
+}
+func (m *ObjectGetVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetVersionRequest proto.InternalMessageInfo
+
+func (m *ObjectGetVersionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectGetVersionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectGetVersionRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectGetVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ObjectGetVersionResponse struct {
+	Object               *pb.Object `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ObjectGetVersionResponse) Reset()         { *m = ObjectGetVersionResponse{} }
+func (m *ObjectGetVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetVersionResponse) ProtoMessage()    {}
+func (*ObjectGetVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{16}
+}
+func (m *ObjectGetVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetVersionResponse.Unmarshal(m, b)
+}
+func (m *ObjectGetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetVersionResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetVersionResponse.Merge(m, src)
+}
+func (m *ObjectGetVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetVersionResponse.Size(m)
+}
+func (m *ObjectGetVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetVersionResponse proto.InternalMessageInfo
+
+func (m *ObjectGetVersionResponse) GetObject() *pb.Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type Retention struct {
+	Mode                 RetentionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=satellite.metainfo.RetentionMode" json:"mode,omitempty"`
+	RetainUntil          *time.Time    `protobuf:"bytes,2,opt,name=retain_until,json=retainUntil,proto3,stdtime" json:"retain_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Retention) Reset()         { *m = Retention{} }
+func (m *Retention) String() string { return proto.CompactTextString(m) }
+func (*Retention) ProtoMessage()    {}
+func (*Retention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{17}
+}
+func (m *Retention) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Retention.Unmarshal(m, b)
+}
+func (m *Retention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Retention.Marshal(b, m, deterministic)
+}
+func (m *Retention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retention.Merge(m, src)
+}
+func (m *Retention) XXX_Size() int {
+	return xxx_messageInfo_Retention.Size(m)
+}
+func (m *Retention) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retention proto.InternalMessageInfo
+
+func (m *Retention) GetMode() RetentionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return RetentionMode_NO_RETENTION
+}
+
+func (m *Retention) GetRetainUntil() *time.Time {
+	if m != nil {
+		return m.RetainUntil
+	}
+	return nil
+}
+
+type DefaultRetention struct {
+	Mode                 RetentionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=satellite.metainfo.RetentionMode" json:"mode,omitempty"`
+	Days                 int32         `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DefaultRetention) Reset()         { *m = DefaultRetention{} }
+func (m *DefaultRetention) String() string { return proto.CompactTextString(m) }
+func (*DefaultRetention) ProtoMessage()    {}
+func (*DefaultRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{18}
+}
+func (m *DefaultRetention) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DefaultRetention.Unmarshal(m, b)
+}
+func (m *DefaultRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DefaultRetention.Marshal(b, m, deterministic)
+}
+func (m *DefaultRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DefaultRetention.Merge(m, src)
+}
+func (m *DefaultRetention) XXX_Size() int {
+	return xxx_messageInfo_DefaultRetention.Size(m)
+}
+func (m *DefaultRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_DefaultRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DefaultRetention proto.InternalMessageInfo
+
+func (m *DefaultRetention) GetMode() RetentionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return RetentionMode_NO_RETENTION
+}
+
+func (m *DefaultRetention) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+type GetBucketDefaultRetentionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketDefaultRetentionRequest) Reset()         { *m = GetBucketDefaultRetentionRequest{} }
+func (m *GetBucketDefaultRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketDefaultRetentionRequest) ProtoMessage()    {}
+func (*GetBucketDefaultRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{19}
+}
+func (m *GetBucketDefaultRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketDefaultRetentionRequest.Unmarshal(m, b)
+}
+func (m *GetBucketDefaultRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketDefaultRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketDefaultRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketDefaultRetentionRequest.Merge(m, src)
+}
+func (m *GetBucketDefaultRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketDefaultRetentionRequest.Size(m)
+}
+func (m *GetBucketDefaultRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketDefaultRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketDefaultRetentionRequest proto.InternalMessageInfo
+
+func (m *GetBucketDefaultRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketDefaultRetentionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketDefaultRetentionResponse struct {
+	Retention            *DefaultRetention `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketDefaultRetentionResponse) Reset()         { *m = GetBucketDefaultRetentionResponse{} }
+func (m *GetBucketDefaultRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketDefaultRetentionResponse) ProtoMessage()    {}
+func (*GetBucketDefaultRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{20}
+}
+func (m *GetBucketDefaultRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketDefaultRetentionResponse.Unmarshal(m, b)
+}
+func (m *GetBucketDefaultRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketDefaultRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketDefaultRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketDefaultRetentionResponse.Merge(m, src)
+}
+func (m *GetBucketDefaultRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketDefaultRetentionResponse.Size(m)
+}
+func (m *GetBucketDefaultRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketDefaultRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketDefaultRetentionResponse proto.InternalMessageInfo
+
+func (m *GetBucketDefaultRetentionResponse) GetRetention() *DefaultRetention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+type SetBucketDefaultRetentionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Retention            *DefaultRetention `protobuf:"bytes,2,opt,name=retention,proto3" json:"retention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetBucketDefaultRetentionRequest) Reset()         { *m = SetBucketDefaultRetentionRequest{} }
+func (m *SetBucketDefaultRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketDefaultRetentionRequest) ProtoMessage()    {}
+func (*SetBucketDefaultRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{21}
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketDefaultRetentionRequest.Unmarshal(m, b)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketDefaultRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketDefaultRetentionRequest.Merge(m, src)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketDefaultRetentionRequest.Size(m)
+}
+func (m *SetBucketDefaultRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketDefaultRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketDefaultRetentionRequest proto.InternalMessageInfo
+
+func (m *SetBucketDefaultRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketDefaultRetentionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketDefaultRetentionRequest) GetRetention() *DefaultRetention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+type SetBucketDefaultRetentionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketDefaultRetentionResponse) Reset()         { *m = SetBucketDefaultRetentionResponse{} }
+func (m *SetBucketDefaultRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketDefaultRetentionResponse) ProtoMessage()    {}
+func (*SetBucketDefaultRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{22}
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketDefaultRetentionResponse.Unmarshal(m, b)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketDefaultRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketDefaultRetentionResponse.Merge(m, src)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketDefaultRetentionResponse.Size(m)
+}
+func (m *SetBucketDefaultRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketDefaultRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketDefaultRetentionResponse proto.InternalMessageInfo
+
+type ObjectGetLockRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectGetLockRequest) Reset()         { *m = ObjectGetLockRequest{} }
+func (m *ObjectGetLockRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetLockRequest) ProtoMessage()    {}
+func (*ObjectGetLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{23}
+}
+func (m *ObjectGetLockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetLockRequest.Unmarshal(m, b)
+}
+func (m *ObjectGetLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetLockRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetLockRequest.Merge(m, src)
+}
+func (m *ObjectGetLockRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetLockRequest.Size(m)
+}
+func (m *ObjectGetLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetLockRequest proto.InternalMessageInfo
+
+func (m *ObjectGetLockRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectGetLockRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectGetLockRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectGetLockRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ObjectGetLockResponse struct {
+	Retention            *Retention `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	LegalHold            bool       `protobuf:"varint,2,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ObjectGetLockResponse) Reset()         { *m = ObjectGetLockResponse{} }
+func (m *ObjectGetLockResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectGetLockResponse) ProtoMessage()    {}
+func (*ObjectGetLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{24}
+}
+func (m *ObjectGetLockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectGetLockResponse.Unmarshal(m, b)
+}
+func (m *ObjectGetLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectGetLockResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectGetLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectGetLockResponse.Merge(m, src)
+}
+func (m *ObjectGetLockResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectGetLockResponse.Size(m)
+}
+func (m *ObjectGetLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectGetLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectGetLockResponse proto.InternalMessageInfo
+
+func (m *ObjectGetLockResponse) GetRetention() *Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *ObjectGetLockResponse) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+type ObjectSetRetentionRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Retention            *Retention        `protobuf:"bytes,4,opt,name=retention,proto3" json:"retention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectSetRetentionRequest) Reset()         { *m = ObjectSetRetentionRequest{} }
+func (m *ObjectSetRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetRetentionRequest) ProtoMessage()    {}
+func (*ObjectSetRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{25}
+}
+func (m *ObjectSetRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetRetentionRequest.Unmarshal(m, b)
+}
+func (m *ObjectSetRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetRetentionRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetRetentionRequest.Merge(m, src)
+}
+func (m *ObjectSetRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetRetentionRequest.Size(m)
+}
+func (m *ObjectSetRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetRetentionRequest proto.InternalMessageInfo
+
+func (m *ObjectSetRetentionRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectSetRetentionRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectSetRetentionRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectSetRetentionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ObjectSetRetentionRequest) GetRetention() *Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+type ObjectSetRetentionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectSetRetentionResponse) Reset()         { *m = ObjectSetRetentionResponse{} }
+func (m *ObjectSetRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetRetentionResponse) ProtoMessage()    {}
+func (*ObjectSetRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{26}
+}
+func (m *ObjectSetRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetRetentionResponse.Unmarshal(m, b)
+}
+func (m *ObjectSetRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetRetentionResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetRetentionResponse.Merge(m, src)
+}
+func (m *ObjectSetRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetRetentionResponse.Size(m)
+}
+func (m *ObjectSetRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetRetentionResponse proto.InternalMessageInfo
+
+type ObjectSetLegalHoldRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedObjectKey   []byte            `protobuf:"bytes,2,opt,name=encrypted_object_key,json=encryptedObjectKey,proto3" json:"encrypted_object_key,omitempty"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ObjectSetLegalHoldRequest) Reset()         { *m = ObjectSetLegalHoldRequest{} }
+func (m *ObjectSetLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetLegalHoldRequest) ProtoMessage()    {}
+func (*ObjectSetLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{27}
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetLegalHoldRequest.Merge(m, src)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetLegalHoldRequest.Size(m)
+}
+func (m *ObjectSetLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetLegalHoldRequest proto.InternalMessageInfo
+
+func (m *ObjectSetLegalHoldRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ObjectSetLegalHoldRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *ObjectSetLegalHoldRequest) GetEncryptedObjectKey() []byte {
+	if m != nil {
+		return m.EncryptedObjectKey
+	}
+	return nil
+}
+
+func (m *ObjectSetLegalHoldRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ObjectSetLegalHoldResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectSetLegalHoldResponse) Reset()         { *m = ObjectSetLegalHoldResponse{} }
+func (m *ObjectSetLegalHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*ObjectSetLegalHoldResponse) ProtoMessage()    {}
+func (*ObjectSetLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{28}
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObjectSetLegalHoldResponse.Unmarshal(m, b)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObjectSetLegalHoldResponse.Marshal(b, m, deterministic)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectSetLegalHoldResponse.Merge(m, src)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_Size() int {
+	return xxx_messageInfo_ObjectSetLegalHoldResponse.Size(m)
+}
+func (m *ObjectSetLegalHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectSetLegalHoldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectSetLegalHoldResponse proto.InternalMessageInfo
+
+type LifecycleRule struct {
+	Id                        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EncryptedPrefix           []byte   `protobuf:"bytes,2,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	ExpireAfterDays           int32    `protobuf:"varint,3,opt,name=expire_after_days,json=expireAfterDays,proto3" json:"expire_after_days,omitempty"`
+	AbortPendingAfterHours    int32    `protobuf:"varint,4,opt,name=abort_pending_after_hours,json=abortPendingAfterHours,proto3" json:"abort_pending_after_hours,omitempty"`
+	NoncurrentExpireAfterDays int32    `protobuf:"varint,5,opt,name=noncurrent_expire_after_days,json=noncurrentExpireAfterDays,proto3" json:"noncurrent_expire_after_days,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{29}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetEncryptedPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpireAfterDays() int32 {
+	if m != nil {
+		return m.ExpireAfterDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortPendingAfterHours() int32 {
+	if m != nil {
+		return m.AbortPendingAfterHours
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetNoncurrentExpireAfterDays() int32 {
+	if m != nil {
+		return m.NoncurrentExpireAfterDays
+	}
+	return 0
+}
+
+type GetBucketLifecycleRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketLifecycleRequest) Reset()         { *m = GetBucketLifecycleRequest{} }
+func (m *GetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleRequest) ProtoMessage()    {}
+func (*GetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{30}
+}
+func (m *GetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *GetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Size(m)
+}
+func (m *GetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketLifecycleRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketLifecycleResponse struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketLifecycleResponse) Reset()         { *m = GetBucketLifecycleResponse{} }
+func (m *GetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleResponse) ProtoMessage()    {}
+func (*GetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{31}
+}
+func (m *GetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *GetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Size(m)
+}
+func (m *GetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleResponse proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleResponse) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Rules                []*LifecycleRule  `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetBucketLifecycleRequest) Reset()         { *m = SetBucketLifecycleRequest{} }
+func (m *SetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleRequest) ProtoMessage()    {}
+func (*SetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{32}
+}
+func (m *SetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *SetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Size(m)
+}
+func (m *SetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *SetBucketLifecycleRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLifecycleResponse) Reset()         { *m = SetBucketLifecycleResponse{} }
+func (m *SetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleResponse) ProtoMessage()    {}
+func (*SetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{33}
+}
+func (m *SetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *SetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Size(m)
+}
+func (m *SetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleResponse proto.InternalMessageInfo
+
+type Placement struct {
+	// allowed_countries restricts the node selection to nodes in the
+	// countries, empty allows all countries.
+	AllowedCountries     []string `protobuf:"bytes,1,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	ExcludedCountries    []string `protobuf:"bytes,2,rep,name=excluded_countries,json=excludedCountries,proto3" json:"excluded_countries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Placement) Reset()         { *m = Placement{} }
+func (m *Placement) String() string { return proto.CompactTextString(m) }
+func (*Placement) ProtoMessage()    {}
+func (*Placement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{34}
+}
+func (m *Placement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Placement.Unmarshal(m, b)
+}
+func (m *Placement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Placement.Marshal(b, m, deterministic)
+}
+func (m *Placement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Placement.Merge(m, src)
+}
+func (m *Placement) XXX_Size() int {
+	return xxx_messageInfo_Placement.Size(m)
+}
+func (m *Placement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Placement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Placement proto.InternalMessageInfo
+
+func (m *Placement) GetAllowedCountries() []string {
+	if m != nil {
+		return m.AllowedCountries
+	}
+	return nil
+}
+
+func (m *Placement) GetExcludedCountries() []string {
+	if m != nil {
+		return m.ExcludedCountries
+	}
+	return nil
+}
+
+type GetBucketPlacementRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketPlacementRequest) Reset()         { *m = GetBucketPlacementRequest{} }
+func (m *GetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketPlacementRequest) ProtoMessage()    {}
+func (*GetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{35}
+}
+func (m *GetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *GetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketPlacementRequest.Merge(m, src)
+}
+func (m *GetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketPlacementRequest.Size(m)
+}
+func (m *GetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *GetBucketPlacementRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketPlacementResponse struct {
+	Placement            *Placement `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetBucketPlacementResponse) Reset()         { *m = GetBucketPlacementResponse{} }
+func (m *GetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketPlacementResponse) ProtoMessage()    {}
+func (*GetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{36}
+}
+func (m *GetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *GetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketPlacementResponse.Merge(m, src)
+}
+func (m *GetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketPlacementResponse.Size(m)
+}
+func (m *GetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketPlacementResponse proto.InternalMessageInfo
+
+func (m *GetBucketPlacementResponse) GetPlacement() *Placement {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+type SetBucketPlacementRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Bucket               []byte            `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Placement            *Placement        `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetBucketPlacementRequest) Reset()         { *m = SetBucketPlacementRequest{} }
+func (m *SetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementRequest) ProtoMessage()    {}
+func (*SetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{37}
+}
+func (m *SetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementRequest.Merge(m, src)
+}
+func (m *SetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementRequest.Size(m)
+}
+func (m *SetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *SetBucketPlacementRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketPlacementRequest) GetPlacement() *Placement {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+type SetBucketPlacementResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketPlacementResponse) Reset()         { *m = SetBucketPlacementResponse{} }
+func (m *SetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementResponse) ProtoMessage()    {}
+func (*SetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8cdca9bebb3074f, []int{38}
+}
+func (m *SetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementResponse.Merge(m, src)
+}
+func (m *SetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementResponse.Size(m)
+}
+func (m *SetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("satellite.metainfo.Versioning", Versioning_name, Versioning_value)
+	proto.RegisterEnum("satellite.metainfo.RetentionMode", RetentionMode_name, RetentionMode_value)
+	proto.RegisterType((*EncryptedKeyAndNonce)(nil), "satellite.metainfo.EncryptedKeyAndNonce")
+	proto.RegisterType((*ObjectBeginMoveRequest)(nil), "satellite.metainfo.ObjectBeginMoveRequest")
+	proto.RegisterType((*ObjectBeginMoveResponse)(nil), "satellite.metainfo.ObjectBeginMoveResponse")
+	proto.RegisterType((*ObjectFinishMoveRequest)(nil), "satellite.metainfo.ObjectFinishMoveRequest")
+	proto.RegisterType((*ObjectFinishMoveResponse)(nil), "satellite.metainfo.ObjectFinishMoveResponse")
+	proto.RegisterType((*ObjectBeginCopyRequest)(nil), "satellite.metainfo.ObjectBeginCopyRequest")
+	proto.RegisterType((*ObjectBeginCopyResponse)(nil), "satellite.metainfo.ObjectBeginCopyResponse")
+	proto.RegisterType((*ObjectFinishCopyRequest)(nil), "satellite.metainfo.ObjectFinishCopyRequest")
+	proto.RegisterType((*ObjectFinishCopyResponse)(nil), "satellite.metainfo.ObjectFinishCopyResponse")
+	proto.RegisterType((*GetBucketVersioningRequest)(nil), "satellite.metainfo.GetBucketVersioningRequest")
+	proto.RegisterType((*GetBucketVersioningResponse)(nil), "satellite.metainfo.GetBucketVersioningResponse")
+	proto.RegisterType((*SetBucketVersioningRequest)(nil), "satellite.metainfo.SetBucketVersioningRequest")
+	proto.RegisterType((*SetBucketVersioningResponse)(nil), "satellite.metainfo.SetBucketVersioningResponse")
+	proto.RegisterType((*ObjectListVersionsRequest)(nil), "satellite.metainfo.ObjectListVersionsRequest")
+	proto.RegisterType((*ObjectListVersionsResponse)(nil), "satellite.metainfo.ObjectListVersionsResponse")
+	proto.RegisterType((*ObjectGetVersionRequest)(nil), "satellite.metainfo.ObjectGetVersionRequest")
+	proto.RegisterType((*ObjectGetVersionResponse)(nil), "satellite.metainfo.ObjectGetVersionResponse")
+	proto.RegisterType((*Retention)(nil), "satellite.metainfo.Retention")
+	proto.RegisterType((*DefaultRetention)(nil), "satellite.metainfo.DefaultRetention")
+	proto.RegisterType((*GetBucketDefaultRetentionRequest)(nil), "satellite.metainfo.GetBucketDefaultRetentionRequest")
+	proto.RegisterType((*GetBucketDefaultRetentionResponse)(nil), "satellite.metainfo.GetBucketDefaultRetentionResponse")
+	proto.RegisterType((*SetBucketDefaultRetentionRequest)(nil), "satellite.metainfo.SetBucketDefaultRetentionRequest")
+	proto.RegisterType((*SetBucketDefaultRetentionResponse)(nil), "satellite.metainfo.SetBucketDefaultRetentionResponse")
+	proto.RegisterType((*ObjectGetLockRequest)(nil), "satellite.metainfo.ObjectGetLockRequest")
+	proto.RegisterType((*ObjectGetLockResponse)(nil), "satellite.metainfo.ObjectGetLockResponse")
+	proto.RegisterType((*ObjectSetRetentionRequest)(nil), "satellite.metainfo.ObjectSetRetentionRequest")
+	proto.RegisterType((*ObjectSetRetentionResponse)(nil), "satellite.metainfo.ObjectSetRetentionResponse")
+	proto.RegisterType((*ObjectSetLegalHoldRequest)(nil), "satellite.metainfo.ObjectSetLegalHoldRequest")
+	proto.RegisterType((*ObjectSetLegalHoldResponse)(nil), "satellite.metainfo.ObjectSetLegalHoldResponse")
+	proto.RegisterType((*LifecycleRule)(nil), "satellite.metainfo.LifecycleRule")
+	proto.RegisterType((*GetBucketLifecycleRequest)(nil), "satellite.metainfo.GetBucketLifecycleRequest")
+	proto.RegisterType((*GetBucketLifecycleResponse)(nil), "satellite.metainfo.GetBucketLifecycleResponse")
+	proto.RegisterType((*SetBucketLifecycleRequest)(nil), "satellite.metainfo.SetBucketLifecycleRequest")
+	proto.RegisterType((*SetBucketLifecycleResponse)(nil), "satellite.metainfo.SetBucketLifecycleResponse")
+	proto.RegisterType((*Placement)(nil), "satellite.metainfo.Placement")
+	proto.RegisterType((*GetBucketPlacementRequest)(nil), "satellite.metainfo.GetBucketPlacementRequest")
+	proto.RegisterType((*GetBucketPlacementResponse)(nil), "satellite.metainfo.GetBucketPlacementResponse")
+	proto.RegisterType((*SetBucketPlacementRequest)(nil), "satellite.metainfo.SetBucketPlacementRequest")
+	proto.RegisterType((*SetBucketPlacementResponse)(nil), "satellite.metainfo.SetBucketPlacementResponse")
+}
+
+func init() { proto.RegisterFile("metainfo_ext.proto", fileDescriptor_d8cdca9bebb3074f) }
+
+var fileDescriptor_d8cdca9bebb3074f = []byte{
+	// 1666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x0e, 0x25, 0xdb, 0x89, 0xc6, 0x2f, 0x79, 0xe3, 0x38, 0x32, 0xe3, 0x34, 0x0e, 0xd3, 0x00,
+	0x8e, 0x93, 0xd0, 0x85, 0x9b, 0x20, 0x08, 0x02, 0xb4, 0xf0, 0x43, 0x71, 0x0c, 0xdb, 0xb2, 0x41,
+	0xc6, 0x01, 0x5a, 0x20, 0x50, 0x69, 0x71, 0x2c, 0x33, 0xa6, 0x48, 0x95, 0xa4, 0x62, 0xeb, 0x17,
+	0xb4, 0xc7, 0x1e, 0x7b, 0xe9, 0xa1, 0x05, 0x7a, 0x2a, 0xd0, 0xf6, 0xaf, 0xf4, 0xd2, 0x6b, 0x7f,
+	0x40, 0xd1, 0x4b, 0x2f, 0xbd, 0x16, 0x5c, 0x2e, 0x5f, 0x22, 0x25, 0x53, 0x89, 0x5c, 0x04, 0x68,
+	0x6e, 0xe4, 0xee, 0x37, 0xf3, 0xcd, 0x6b, 0x97, 0x33, 0x04, 0xd2, 0x40, 0x47, 0xd1, 0x8c, 0x43,
+	0xb3, 0x8a, 0xa7, 0x8e, 0xd8, 0xb4, 0x4c, 0xc7, 0x24, 0xc4, 0x56, 0x1c, 0xd4, 0x75, 0xcd, 0x41,
+	0xd1, 0xdf, 0xe5, 0x8b, 0x68, 0xd4, 0xac, 0x76, 0xd3, 0xd1, 0x4c, 0xc3, 0x43, 0xf1, 0x50, 0x37,
+	0xeb, 0x26, 0x7b, 0xbe, 0x51, 0x37, 0xcd, 0xba, 0x8e, 0x4b, 0xf4, 0xed, 0xa0, 0x75, 0xb8, 0xe4,
+	0x68, 0x0d, 0xb4, 0x1d, 0xa5, 0xd1, 0x64, 0x80, 0x09, 0x5f, 0x91, 0xf7, 0x2e, 0xfc, 0xc0, 0xc1,
+	0x74, 0xd9, 0xd3, 0x88, 0xea, 0x16, 0xb6, 0x57, 0x0c, 0xb5, 0x62, 0x1a, 0x35, 0x24, 0x0f, 0xe1,
+	0x52, 0xd3, 0xb4, 0x35, 0x97, 0xa7, 0xc4, 0xcd, 0x73, 0x0b, 0xa3, 0xcb, 0xb3, 0x81, 0x11, 0xa2,
+	0x8c, 0xf5, 0x06, 0x1a, 0xce, 0x1e, 0x03, 0x48, 0x01, 0x94, 0x88, 0x70, 0x19, 0x7d, 0x75, 0xd5,
+	0x63, 0x6c, 0x57, 0x0d, 0x57, 0x5b, 0x29, 0x37, 0xcf, 0x2d, 0x8c, 0x49, 0x53, 0x18, 0x61, 0xf2,
+	0x68, 0x6e, 0xc1, 0x78, 0x0c, 0x5f, 0xca, 0x53, 0xe4, 0x58, 0x14, 0x29, 0xfc, 0xc9, 0xc1, 0xcc,
+	0xee, 0xc1, 0x2b, 0xac, 0x39, 0xab, 0x58, 0xd7, 0x8c, 0x1d, 0xf3, 0x35, 0x4a, 0xf8, 0x65, 0x0b,
+	0x6d, 0x87, 0x2c, 0xc1, 0xc8, 0x11, 0x2a, 0x2a, 0x5a, 0xa5, 0x49, 0x6a, 0xe4, 0xd5, 0xd0, 0x48,
+	0x06, 0x79, 0x46, 0xb7, 0x25, 0x06, 0x23, 0x33, 0x30, 0x72, 0xd0, 0xaa, 0x1d, 0xa3, 0x43, 0xbd,
+	0x1a, 0x93, 0xd8, 0x1b, 0xf9, 0x08, 0xa6, 0x43, 0x43, 0x4c, 0x4a, 0x46, 0xed, 0xf1, 0x2c, 0x27,
+	0xc1, 0x9e, 0x67, 0xc7, 0x16, 0xb6, 0xc9, 0x75, 0x00, 0x03, 0x4f, 0xaa, 0x4c, 0x9b, 0x67, 0x77,
+	0xc1, 0xc0, 0x93, 0x55, 0x4f, 0xe1, 0x23, 0x28, 0xb9, 0xdb, 0xa9, 0x4a, 0x87, 0x28, 0xf8, 0x8a,
+	0x81, 0x27, 0xe5, 0x84, 0x5e, 0xe1, 0xb7, 0x1c, 0x5c, 0x4d, 0x78, 0x6b, 0x37, 0x4d, 0xc3, 0x46,
+	0x72, 0x0d, 0x0a, 0xb6, 0x63, 0xa1, 0xd2, 0xa8, 0x6a, 0x2a, 0x73, 0xe0, 0x92, 0xb7, 0xb0, 0xa9,
+	0x92, 0x4f, 0x61, 0x2e, 0x64, 0x73, 0xc3, 0xa0, 0x2a, 0x8e, 0x92, 0x48, 0xc2, 0x6c, 0x80, 0xd9,
+	0x61, 0x90, 0x20, 0x19, 0x0f, 0x60, 0x26, 0x5d, 0x01, 0xf3, 0x6e, 0x3a, 0x4d, 0x94, 0x6c, 0xc1,
+	0x98, 0xed, 0xd5, 0x83, 0x0b, 0xb5, 0x4b, 0x43, 0xf3, 0xf9, 0x85, 0xd1, 0xe5, 0x05, 0x31, 0x59,
+	0xbc, 0x62, 0x5a, 0xa5, 0x49, 0xa3, 0x4c, 0x7a, 0x0b, 0xdb, 0x36, 0xd9, 0x87, 0x2b, 0x61, 0x81,
+	0x57, 0x9b, 0x8a, 0xa5, 0x34, 0xd0, 0x41, 0xcb, 0x2e, 0x0d, 0xd3, 0xf4, 0xce, 0x8b, 0xe1, 0xae,
+	0xaf, 0x4d, 0x33, 0x8d, 0xbd, 0x00, 0x17, 0xd8, 0x18, 0x5b, 0x15, 0xbe, 0xcd, 0xfb, 0x31, 0x7d,
+	0xaa, 0x19, 0x9a, 0x7d, 0xf4, 0x56, 0x25, 0xd4, 0x33, 0x09, 0xf1, 0xaa, 0xc8, 0xf5, 0x53, 0x15,
+	0xf9, 0x1e, 0x55, 0x41, 0x9e, 0xc2, 0x7c, 0x5c, 0x30, 0x25, 0xc1, 0x5e, 0x59, 0xcd, 0x45, 0x15,
+	0x24, 0x72, 0xfc, 0x04, 0xf8, 0xee, 0x7a, 0x68, 0x94, 0xc7, 0xa4, 0xab, 0x5d, 0x34, 0x10, 0x09,
+	0x8a, 0xae, 0x70, 0x2c, 0xdd, 0x23, 0x7d, 0xa6, 0x7b, 0xc2, 0xc0, 0x13, 0x39, 0xcc, 0xb8, 0xc0,
+	0x43, 0x29, 0x99, 0x19, 0xaf, 0xdc, 0x3b, 0x0f, 0xfe, 0x9a, 0xd9, 0x6c, 0xff, 0x7f, 0x0e, 0xbe,
+	0xe7, 0xed, 0xfb, 0x83, 0x3f, 0xd0, 0x83, 0xff, 0x56, 0x25, 0xf4, 0xfe, 0xe0, 0x0f, 0xf2, 0xe0,
+	0xaf, 0x43, 0x29, 0x99, 0x19, 0x56, 0xee, 0x0b, 0x30, 0xe2, 0xc5, 0x87, 0xf5, 0x1e, 0xc5, 0x50,
+	0xb7, 0x27, 0x23, 0xb1, 0x7d, 0x01, 0x81, 0xdf, 0x40, 0xc7, 0x0b, 0xf2, 0x0b, 0xb4, 0x6c, 0xcd,
+	0x34, 0x34, 0xa3, 0x3e, 0xe8, 0x5b, 0x42, 0x78, 0x09, 0xd7, 0x52, 0x69, 0x98, 0xbd, 0x9f, 0x00,
+	0xbc, 0x0e, 0x56, 0xa9, 0xe8, 0xc4, 0xf2, 0x07, 0x69, 0x91, 0x89, 0xc8, 0x46, 0x24, 0x84, 0x1f,
+	0x39, 0xe0, 0xe5, 0xf3, 0x77, 0xa3, 0xc3, 0xce, 0x5c, 0xdf, 0x76, 0x5e, 0x87, 0x6b, 0x72, 0xf7,
+	0x30, 0x08, 0xff, 0x70, 0x30, 0xeb, 0xe5, 0x67, 0x5b, 0xb3, 0x7d, 0x80, 0x3d, 0x70, 0x2f, 0xee,
+	0x40, 0x31, 0x2c, 0xe3, 0xa6, 0x85, 0x87, 0xda, 0x29, 0x3b, 0x70, 0x93, 0xc1, 0xfa, 0x1e, 0x5d,
+	0x8e, 0x43, 0x6b, 0x2d, 0xcb, 0x36, 0xad, 0x52, 0xbe, 0x03, 0xba, 0x46, 0x97, 0xc9, 0x6d, 0x98,
+	0x60, 0x9e, 0xfa, 0x40, 0xf7, 0x58, 0xe5, 0xa5, 0x71, 0xb6, 0xca, 0x60, 0xd3, 0x30, 0xac, 0x6b,
+	0x0d, 0xcd, 0xa1, 0x47, 0x66, 0x58, 0xf2, 0x5e, 0x84, 0x2f, 0x80, 0x4f, 0x73, 0x9c, 0x95, 0x87,
+	0x08, 0xc3, 0x9a, 0x83, 0x0d, 0xbb, 0xc4, 0xd1, 0x33, 0x53, 0xea, 0xac, 0x66, 0x57, 0x68, 0xd3,
+	0xc1, 0x86, 0xe4, 0xc1, 0x08, 0x81, 0xa1, 0x86, 0x69, 0x79, 0x17, 0xf7, 0x25, 0x89, 0x3e, 0x0b,
+	0x3f, 0x73, 0xfe, 0x4d, 0xb6, 0x11, 0xc4, 0xfe, 0x1d, 0xf8, 0x18, 0x96, 0xe0, 0x22, 0x8b, 0x0f,
+	0x8d, 0x6b, 0x5e, 0xf2, 0x5f, 0xc3, 0xf3, 0x1d, 0xb5, 0xb7, 0xef, 0xf3, 0xfd, 0x15, 0x07, 0x05,
+	0x09, 0x1d, 0x34, 0xe8, 0x78, 0xf1, 0xd0, 0x0d, 0x8c, 0x8a, 0xec, 0x84, 0xdd, 0x4c, 0xab, 0xdc,
+	0x00, 0xbc, 0x63, 0xaa, 0x28, 0x51, 0x38, 0x59, 0x83, 0x31, 0x8b, 0xee, 0x57, 0x5b, 0x86, 0xa3,
+	0xe9, 0xd4, 0x9d, 0xd1, 0x65, 0x5e, 0xf4, 0xa6, 0x25, 0xd1, 0x9f, 0x96, 0xc4, 0xe7, 0xfe, 0xb4,
+	0xb4, 0x3a, 0xf4, 0xcd, 0x1f, 0x37, 0x38, 0x69, 0xd4, 0x93, 0xda, 0x77, 0x85, 0x84, 0x97, 0x50,
+	0x5c, 0xc7, 0x43, 0xa5, 0xa5, 0x3b, 0x6f, 0x6d, 0x0f, 0x81, 0x21, 0x55, 0x69, 0xdb, 0xd4, 0x8e,
+	0x61, 0x89, 0x3e, 0x0b, 0xc7, 0x30, 0x1f, 0xdc, 0x30, 0x9d, 0x3c, 0x03, 0xbf, 0xce, 0xea, 0x70,
+	0xb3, 0x07, 0x19, 0x4b, 0xd2, 0x2a, 0x14, 0x2c, 0x7f, 0x91, 0xe5, 0xe9, 0xc3, 0x34, 0x0f, 0x13,
+	0x0a, 0x42, 0x31, 0xe1, 0x17, 0x0e, 0xe6, 0xe5, 0xff, 0xca, 0xad, 0xb8, 0xc5, 0xb9, 0x37, 0xb3,
+	0xf8, 0x16, 0xdc, 0x94, 0xcf, 0x0a, 0x8d, 0xf0, 0x13, 0x07, 0xd3, 0x41, 0x71, 0x6f, 0x9b, 0xb5,
+	0xe3, 0x77, 0xfa, 0x24, 0xda, 0x70, 0xa5, 0xc3, 0x58, 0x96, 0xe1, 0x27, 0xc9, 0x0c, 0x5f, 0xef,
+	0x59, 0xc3, 0x91, 0x40, 0xb9, 0x0d, 0x8f, 0x8e, 0x75, 0x45, 0xaf, 0x1e, 0x99, 0xba, 0xca, 0xae,
+	0xaa, 0x02, 0x5d, 0x79, 0x66, 0xea, 0xaa, 0xf0, 0x57, 0xf0, 0x2d, 0x90, 0xf1, 0x1c, 0x53, 0x3e,
+	0xc0, 0x38, 0xc5, 0xc3, 0x31, 0xd4, 0x5f, 0x38, 0x84, 0x39, 0xe0, 0xd3, 0xdc, 0x65, 0x05, 0xf3,
+	0x6b, 0x34, 0x1a, 0xdb, 0x7e, 0x90, 0xde, 0xe9, 0xaa, 0x89, 0x3a, 0x14, 0xb1, 0x98, 0x39, 0xf4,
+	0x37, 0x07, 0xe3, 0xdb, 0xda, 0x21, 0xd6, 0xda, 0x35, 0x1d, 0xa5, 0x96, 0x8e, 0x64, 0x02, 0x72,
+	0xac, 0x2d, 0x2e, 0x48, 0x39, 0x4d, 0xed, 0xe7, 0x2b, 0xbd, 0x08, 0x53, 0x78, 0xda, 0xd4, 0x2c,
+	0xac, 0x2a, 0x87, 0x0e, 0x5a, 0x55, 0x7a, 0x39, 0xe6, 0xe9, 0xe5, 0x38, 0xe9, 0x6d, 0xac, 0xb8,
+	0xeb, 0xeb, 0x4a, 0xdb, 0x26, 0x8f, 0x61, 0x56, 0x39, 0x30, 0x2d, 0xa7, 0xda, 0x44, 0x43, 0xd5,
+	0x8c, 0x3a, 0x13, 0x39, 0x32, 0x5b, 0x96, 0x4d, 0x93, 0x36, 0x2c, 0xcd, 0x50, 0xc0, 0x9e, 0xb7,
+	0x4f, 0x25, 0x9f, 0xb9, 0xbb, 0xee, 0x9c, 0xe4, 0xf6, 0xcb, 0x2d, 0xcb, 0x42, 0xc3, 0xa9, 0x26,
+	0x19, 0xbd, 0x2f, 0xfa, 0x6c, 0x88, 0x29, 0xc7, 0xb9, 0x05, 0x15, 0x66, 0x83, 0x6b, 0x33, 0x74,
+	0x7e, 0xd0, 0x97, 0xf3, 0x3e, 0xf0, 0x69, 0x2c, 0xec, 0xcc, 0x3e, 0x82, 0x61, 0xab, 0xa5, 0xa3,
+	0xdf, 0x4b, 0xa4, 0x7e, 0x73, 0x62, 0x89, 0x91, 0x3c, 0xbc, 0xf0, 0x1d, 0x07, 0xb3, 0xf2, 0xb9,
+	0x5b, 0x1f, 0xda, 0x97, 0xeb, 0xd3, 0xbe, 0xb9, 0x48, 0x0b, 0x9c, 0x70, 0x5b, 0xa8, 0x43, 0x61,
+	0x4f, 0x57, 0x6a, 0xd8, 0x40, 0xc3, 0x21, 0x77, 0x61, 0x4a, 0xd1, 0x75, 0xf3, 0xc4, 0xed, 0xe9,
+	0xcc, 0x96, 0xe1, 0x58, 0x1a, 0x8b, 0x47, 0x41, 0x2a, 0xb2, 0x8d, 0x35, 0x7f, 0x9d, 0xdc, 0x07,
+	0x82, 0xa7, 0x35, 0xbd, 0xa5, 0xc6, 0xd0, 0x39, 0x8a, 0x9e, 0xf2, 0x77, 0x02, 0x78, 0x2c, 0xc7,
+	0x01, 0xe3, 0xc0, 0x73, 0xfc, 0x19, 0xf0, 0x69, 0x2c, 0xe1, 0xbd, 0xdc, 0xf4, 0x17, 0x7b, 0xdd,
+	0xcb, 0xa1, 0x64, 0x88, 0x17, 0xbe, 0x8f, 0xe6, 0xf9, 0xdc, 0x3c, 0x88, 0xdb, 0x98, 0xeb, 0xd3,
+	0xc6, 0x68, 0xae, 0x13, 0xee, 0x2f, 0x3e, 0x06, 0x08, 0x87, 0x0b, 0x32, 0x09, 0xa3, 0xfb, 0x95,
+	0x17, 0x65, 0x49, 0xde, 0xdc, 0xad, 0x94, 0xd7, 0x8b, 0x17, 0xc8, 0x28, 0x5c, 0x2c, 0x57, 0x56,
+	0x56, 0xb7, 0xcb, 0xeb, 0x45, 0x8e, 0x8c, 0x43, 0x41, 0xde, 0x97, 0xf7, 0xca, 0x95, 0xf5, 0xf2,
+	0x7a, 0x31, 0xb7, 0xb8, 0x02, 0xe3, 0xb1, 0x86, 0x8b, 0x14, 0x61, 0xac, 0xb2, 0x5b, 0x95, 0xca,
+	0xcf, 0xcb, 0x95, 0xe7, 0x9b, 0xbb, 0x95, 0xe2, 0x05, 0x32, 0x01, 0xb0, 0xb1, 0xfb, 0xa2, 0x2c,
+	0x55, 0x56, 0x2a, 0x6b, 0xe5, 0x22, 0xe7, 0xbe, 0xaf, 0xed, 0xee, 0xec, 0x6d, 0x6f, 0xd2, 0xf7,
+	0xdc, 0xf2, 0xef, 0x93, 0x50, 0x2c, 0x9f, 0x3a, 0x68, 0xa8, 0xde, 0x0c, 0xec, 0x7a, 0x41, 0x5e,
+	0xc1, 0x64, 0xf0, 0x37, 0xd6, 0xbb, 0x15, 0xc9, 0x62, 0x9a, 0xb7, 0xe9, 0xbf, 0xa9, 0xf9, 0xbb,
+	0x99, 0xb0, 0x2c, 0xfb, 0x0d, 0x28, 0x86, 0xff, 0xc2, 0x18, 0x59, 0x0f, 0x05, 0x89, 0x3f, 0x9a,
+	0xfc, 0xbd, 0x6c, 0x60, 0x46, 0xe7, 0xbb, 0xe6, 0x0e, 0xe0, 0x19, 0x5d, 0x8b, 0xfc, 0x45, 0xe1,
+	0xef, 0x66, 0xc2, 0x76, 0xba, 0x16, 0x21, 0x3b, 0xd3, 0xb5, 0x28, 0xdb, 0xbd, 0x6c, 0x60, 0x46,
+	0xf7, 0x1a, 0x2e, 0xa7, 0x4c, 0xed, 0x44, 0x4c, 0x53, 0xd2, 0xfd, 0x2f, 0x02, 0xbf, 0x94, 0x19,
+	0x1f, 0xf2, 0xca, 0x59, 0x79, 0xe5, 0x3e, 0x79, 0x7b, 0xcc, 0xdf, 0xc4, 0x06, 0xe2, 0x8e, 0x92,
+	0x5e, 0x3c, 0xd8, 0xbe, 0x4d, 0xee, 0x77, 0x8f, 0x59, 0xca, 0x98, 0xce, 0x8b, 0x59, 0xe1, 0x61,
+	0x4e, 0x37, 0x30, 0xce, 0xd9, 0x2b, 0xa7, 0x89, 0xe9, 0x95, 0xbf, 0x97, 0x0d, 0xcc, 0xe8, 0xbe,
+	0xe6, 0x22, 0x17, 0x74, 0x62, 0x20, 0x7b, 0xd0, 0x33, 0x55, 0x5d, 0x06, 0x10, 0xfe, 0x61, 0x9f,
+	0x52, 0x11, 0x53, 0xe4, 0xfe, 0x4c, 0x91, 0xdf, 0xc8, 0x94, 0x33, 0x07, 0x12, 0xa2, 0xc2, 0x78,
+	0x90, 0x04, 0xb7, 0xc5, 0x27, 0x0b, 0x3d, 0x83, 0x1a, 0x19, 0x59, 0xf8, 0x3b, 0x19, 0x90, 0x61,
+	0x7d, 0xc9, 0x3e, 0x4b, 0xe8, 0x68, 0x8f, 0xfa, 0x4a, 0x69, 0xfd, 0x79, 0x31, 0x2b, 0x3c, 0x85,
+	0x34, 0xe8, 0x43, 0xcf, 0x20, 0xed, 0xec, 0xb0, 0x79, 0x31, 0x2b, 0x3c, 0x24, 0x4d, 0xf6, 0x60,
+	0xe9, 0xa4, 0x5d, 0x3b, 0x42, 0x5e, 0xcc, 0x0a, 0x8f, 0x79, 0x9a, 0x89, 0x54, 0xee, 0x8f, 0x54,
+	0xee, 0x49, 0x9a, 0xec, 0x44, 0xce, 0xf0, 0xb4, 0xb3, 0xab, 0xe0, 0xc5, 0xac, 0xf0, 0x14, 0x4f,
+	0xcf, 0x20, 0x95, 0xfb, 0x23, 0xed, 0xde, 0x56, 0xac, 0xde, 0xfe, 0xfc, 0x96, 0xed, 0x98, 0xd6,
+	0x2b, 0x51, 0x33, 0x97, 0xe8, 0xc3, 0x52, 0x20, 0xbf, 0xa4, 0x19, 0x0e, 0x5a, 0x86, 0xa2, 0x37,
+	0x0f, 0x0e, 0x46, 0xe8, 0xef, 0xa0, 0x8f, 0xff, 0x1d, 0x00, 0x23, 0xc2, 0x23, 0x51, 0x92, 0x1f,
+	0x00, 0x00,
+}
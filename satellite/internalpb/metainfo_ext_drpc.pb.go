@@ -0,0 +1,755 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.20
+// source: metainfo_ext.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_metainfo_ext_proto struct{}
+
+func (drpcEncoding_File_metainfo_ext_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_metainfo_ext_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCExtendedMetainfoClient interface {
+	DRPCConn() drpc.Conn
+
+	BeginMoveObject(ctx context.Context, in *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error)
+	FinishMoveObject(ctx context.Context, in *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error)
+	BeginCopyObject(ctx context.Context, in *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error)
+	FinishCopyObject(ctx context.Context, in *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error)
+	GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error)
+	SetBucketVersioning(ctx context.Context, in *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error)
+	ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error)
+	GetObjectVersion(ctx context.Context, in *ObjectGetVersionRequest) (*ObjectGetVersionResponse, error)
+	GetBucketDefaultRetention(ctx context.Context, in *GetBucketDefaultRetentionRequest) (*GetBucketDefaultRetentionResponse, error)
+	SetBucketDefaultRetention(ctx context.Context, in *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error)
+	GetObjectLock(ctx context.Context, in *ObjectGetLockRequest) (*ObjectGetLockResponse, error)
+	SetObjectRetention(ctx context.Context, in *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error)
+	SetObjectLegalHold(ctx context.Context, in *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	GetBucketPlacement(ctx context.Context, in *GetBucketPlacementRequest) (*GetBucketPlacementResponse, error)
+	SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error)
+}
+
+type drpcExtendedMetainfoClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCExtendedMetainfoClient(cc drpc.Conn) DRPCExtendedMetainfoClient {
+	return &drpcExtendedMetainfoClient{cc}
+}
+
+func (c *drpcExtendedMetainfoClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcExtendedMetainfoClient) BeginMoveObject(ctx context.Context, in *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error) {
+	out := new(ObjectBeginMoveResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/BeginMoveObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) FinishMoveObject(ctx context.Context, in *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error) {
+	out := new(ObjectFinishMoveResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/FinishMoveObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) BeginCopyObject(ctx context.Context, in *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error) {
+	out := new(ObjectBeginCopyResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/BeginCopyObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) FinishCopyObject(ctx context.Context, in *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error) {
+	out := new(ObjectFinishCopyResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/FinishCopyObject", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error) {
+	out := new(GetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/GetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) SetBucketVersioning(ctx context.Context, in *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error) {
+	out := new(SetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/SetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) ListObjectVersions(ctx context.Context, in *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error) {
+	out := new(ObjectListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/ListObjectVersions", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) GetObjectVersion(ctx context.Context, in *ObjectGetVersionRequest) (*ObjectGetVersionResponse, error) {
+	out := new(ObjectGetVersionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/GetObjectVersion", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) GetBucketDefaultRetention(ctx context.Context, in *GetBucketDefaultRetentionRequest) (*GetBucketDefaultRetentionResponse, error) {
+	out := new(GetBucketDefaultRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/GetBucketDefaultRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) SetBucketDefaultRetention(ctx context.Context, in *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error) {
+	out := new(SetBucketDefaultRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/SetBucketDefaultRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) GetObjectLock(ctx context.Context, in *ObjectGetLockRequest) (*ObjectGetLockResponse, error) {
+	out := new(ObjectGetLockResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/GetObjectLock", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) SetObjectRetention(ctx context.Context, in *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error) {
+	out := new(ObjectSetRetentionResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/SetObjectRetention", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) SetObjectLegalHold(ctx context.Context, in *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error) {
+	out := new(ObjectSetLegalHoldResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/SetObjectLegalHold", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error) {
+	out := new(GetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/GetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error) {
+	out := new(SetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/SetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) GetBucketPlacement(ctx context.Context, in *GetBucketPlacementRequest) (*GetBucketPlacementResponse, error) {
+	out := new(GetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/GetBucketPlacement", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcExtendedMetainfoClient) SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error) {
+	out := new(SetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/satellite.metainfo.ExtendedMetainfo/SetBucketPlacement", drpcEncoding_File_metainfo_ext_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCExtendedMetainfoServer interface {
+	BeginMoveObject(context.Context, *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error)
+	FinishMoveObject(context.Context, *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error)
+	BeginCopyObject(context.Context, *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error)
+	FinishCopyObject(context.Context, *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error)
+	GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error)
+	SetBucketVersioning(context.Context, *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error)
+	ListObjectVersions(context.Context, *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error)
+	GetObjectVersion(context.Context, *ObjectGetVersionRequest) (*ObjectGetVersionResponse, error)
+	GetBucketDefaultRetention(context.Context, *GetBucketDefaultRetentionRequest) (*GetBucketDefaultRetentionResponse, error)
+	SetBucketDefaultRetention(context.Context, *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error)
+	GetObjectLock(context.Context, *ObjectGetLockRequest) (*ObjectGetLockResponse, error)
+	SetObjectRetention(context.Context, *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error)
+	SetObjectLegalHold(context.Context, *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error)
+	GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	GetBucketPlacement(context.Context, *GetBucketPlacementRequest) (*GetBucketPlacementResponse, error)
+	SetBucketPlacement(context.Context, *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error)
+}
+
+type DRPCExtendedMetainfoUnimplementedServer struct{}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) BeginMoveObject(context.Context, *ObjectBeginMoveRequest) (*ObjectBeginMoveResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) FinishMoveObject(context.Context, *ObjectFinishMoveRequest) (*ObjectFinishMoveResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) BeginCopyObject(context.Context, *ObjectBeginCopyRequest) (*ObjectBeginCopyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) FinishCopyObject(context.Context, *ObjectFinishCopyRequest) (*ObjectFinishCopyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) SetBucketVersioning(context.Context, *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) ListObjectVersions(context.Context, *ObjectListVersionsRequest) (*ObjectListVersionsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) GetObjectVersion(context.Context, *ObjectGetVersionRequest) (*ObjectGetVersionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) GetBucketDefaultRetention(context.Context, *GetBucketDefaultRetentionRequest) (*GetBucketDefaultRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) SetBucketDefaultRetention(context.Context, *SetBucketDefaultRetentionRequest) (*SetBucketDefaultRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) GetObjectLock(context.Context, *ObjectGetLockRequest) (*ObjectGetLockResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) SetObjectRetention(context.Context, *ObjectSetRetentionRequest) (*ObjectSetRetentionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) SetObjectLegalHold(context.Context, *ObjectSetLegalHoldRequest) (*ObjectSetLegalHoldResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) GetBucketPlacement(context.Context, *GetBucketPlacementRequest) (*GetBucketPlacementResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+func (s *DRPCExtendedMetainfoUnimplementedServer) SetBucketPlacement(context.Context, *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+type DRPCExtendedMetainfoDescription struct{}
+
+func (DRPCExtendedMetainfoDescription) NumMethods() int { return 17 }
+
+func (DRPCExtendedMetainfoDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.metainfo.ExtendedMetainfo/BeginMoveObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					BeginMoveObject(
+						ctx,
+						in1.(*ObjectBeginMoveRequest),
+					)
+			}, DRPCExtendedMetainfoServer.BeginMoveObject, true
+	case 1:
+		return "/satellite.metainfo.ExtendedMetainfo/FinishMoveObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					FinishMoveObject(
+						ctx,
+						in1.(*ObjectFinishMoveRequest),
+					)
+			}, DRPCExtendedMetainfoServer.FinishMoveObject, true
+	case 2:
+		return "/satellite.metainfo.ExtendedMetainfo/BeginCopyObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					BeginCopyObject(
+						ctx,
+						in1.(*ObjectBeginCopyRequest),
+					)
+			}, DRPCExtendedMetainfoServer.BeginCopyObject, true
+	case 3:
+		return "/satellite.metainfo.ExtendedMetainfo/FinishCopyObject", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					FinishCopyObject(
+						ctx,
+						in1.(*ObjectFinishCopyRequest),
+					)
+			}, DRPCExtendedMetainfoServer.FinishCopyObject, true
+	case 4:
+		return "/satellite.metainfo.ExtendedMetainfo/GetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					GetBucketVersioning(
+						ctx,
+						in1.(*GetBucketVersioningRequest),
+					)
+			}, DRPCExtendedMetainfoServer.GetBucketVersioning, true
+	case 5:
+		return "/satellite.metainfo.ExtendedMetainfo/SetBucketVersioning", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					SetBucketVersioning(
+						ctx,
+						in1.(*SetBucketVersioningRequest),
+					)
+			}, DRPCExtendedMetainfoServer.SetBucketVersioning, true
+	case 6:
+		return "/satellite.metainfo.ExtendedMetainfo/ListObjectVersions", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					ListObjectVersions(
+						ctx,
+						in1.(*ObjectListVersionsRequest),
+					)
+			}, DRPCExtendedMetainfoServer.ListObjectVersions, true
+	case 7:
+		return "/satellite.metainfo.ExtendedMetainfo/GetObjectVersion", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					GetObjectVersion(
+						ctx,
+						in1.(*ObjectGetVersionRequest),
+					)
+			}, DRPCExtendedMetainfoServer.GetObjectVersion, true
+	case 8:
+		return "/satellite.metainfo.ExtendedMetainfo/GetBucketDefaultRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					GetBucketDefaultRetention(
+						ctx,
+						in1.(*GetBucketDefaultRetentionRequest),
+					)
+			}, DRPCExtendedMetainfoServer.GetBucketDefaultRetention, true
+	case 9:
+		return "/satellite.metainfo.ExtendedMetainfo/SetBucketDefaultRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					SetBucketDefaultRetention(
+						ctx,
+						in1.(*SetBucketDefaultRetentionRequest),
+					)
+			}, DRPCExtendedMetainfoServer.SetBucketDefaultRetention, true
+	case 10:
+		return "/satellite.metainfo.ExtendedMetainfo/GetObjectLock", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					GetObjectLock(
+						ctx,
+						in1.(*ObjectGetLockRequest),
+					)
+			}, DRPCExtendedMetainfoServer.GetObjectLock, true
+	case 11:
+		return "/satellite.metainfo.ExtendedMetainfo/SetObjectRetention", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					SetObjectRetention(
+						ctx,
+						in1.(*ObjectSetRetentionRequest),
+					)
+			}, DRPCExtendedMetainfoServer.SetObjectRetention, true
+	case 12:
+		return "/satellite.metainfo.ExtendedMetainfo/SetObjectLegalHold", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					SetObjectLegalHold(
+						ctx,
+						in1.(*ObjectSetLegalHoldRequest),
+					)
+			}, DRPCExtendedMetainfoServer.SetObjectLegalHold, true
+	case 13:
+		return "/satellite.metainfo.ExtendedMetainfo/GetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					GetBucketLifecycle(
+						ctx,
+						in1.(*GetBucketLifecycleRequest),
+					)
+			}, DRPCExtendedMetainfoServer.GetBucketLifecycle, true
+	case 14:
+		return "/satellite.metainfo.ExtendedMetainfo/SetBucketLifecycle", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					SetBucketLifecycle(
+						ctx,
+						in1.(*SetBucketLifecycleRequest),
+					)
+			}, DRPCExtendedMetainfoServer.SetBucketLifecycle, true
+	case 15:
+		return "/satellite.metainfo.ExtendedMetainfo/GetBucketPlacement", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					GetBucketPlacement(
+						ctx,
+						in1.(*GetBucketPlacementRequest),
+					)
+			}, DRPCExtendedMetainfoServer.GetBucketPlacement, true
+	case 16:
+		return "/satellite.metainfo.ExtendedMetainfo/SetBucketPlacement", drpcEncoding_File_metainfo_ext_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCExtendedMetainfoServer).
+					SetBucketPlacement(
+						ctx,
+						in1.(*SetBucketPlacementRequest),
+					)
+			}, DRPCExtendedMetainfoServer.SetBucketPlacement, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterExtendedMetainfo(mux drpc.Mux, impl DRPCExtendedMetainfoServer) error {
+	return mux.Register(impl, DRPCExtendedMetainfoDescription{})
+}
+
+type DRPCExtendedMetainfo_BeginMoveObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectBeginMoveResponse) error
+}
+
+type drpcExtendedMetainfo_BeginMoveObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_BeginMoveObjectStream) SendAndClose(m *ObjectBeginMoveResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_FinishMoveObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectFinishMoveResponse) error
+}
+
+type drpcExtendedMetainfo_FinishMoveObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_FinishMoveObjectStream) SendAndClose(m *ObjectFinishMoveResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_BeginCopyObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectBeginCopyResponse) error
+}
+
+type drpcExtendedMetainfo_BeginCopyObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_BeginCopyObjectStream) SendAndClose(m *ObjectBeginCopyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_FinishCopyObjectStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectFinishCopyResponse) error
+}
+
+type drpcExtendedMetainfo_FinishCopyObjectStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_FinishCopyObjectStream) SendAndClose(m *ObjectFinishCopyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_GetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketVersioningResponse) error
+}
+
+type drpcExtendedMetainfo_GetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_GetBucketVersioningStream) SendAndClose(m *GetBucketVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_SetBucketVersioningStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketVersioningResponse) error
+}
+
+type drpcExtendedMetainfo_SetBucketVersioningStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_SetBucketVersioningStream) SendAndClose(m *SetBucketVersioningResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_ListObjectVersionsStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectListVersionsResponse) error
+}
+
+type drpcExtendedMetainfo_ListObjectVersionsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_ListObjectVersionsStream) SendAndClose(m *ObjectListVersionsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_GetObjectVersionStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectGetVersionResponse) error
+}
+
+type drpcExtendedMetainfo_GetObjectVersionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_GetObjectVersionStream) SendAndClose(m *ObjectGetVersionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_GetBucketDefaultRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketDefaultRetentionResponse) error
+}
+
+type drpcExtendedMetainfo_GetBucketDefaultRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_GetBucketDefaultRetentionStream) SendAndClose(m *GetBucketDefaultRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_SetBucketDefaultRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketDefaultRetentionResponse) error
+}
+
+type drpcExtendedMetainfo_SetBucketDefaultRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_SetBucketDefaultRetentionStream) SendAndClose(m *SetBucketDefaultRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_GetObjectLockStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectGetLockResponse) error
+}
+
+type drpcExtendedMetainfo_GetObjectLockStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_GetObjectLockStream) SendAndClose(m *ObjectGetLockResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_SetObjectRetentionStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectSetRetentionResponse) error
+}
+
+type drpcExtendedMetainfo_SetObjectRetentionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_SetObjectRetentionStream) SendAndClose(m *ObjectSetRetentionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_SetObjectLegalHoldStream interface {
+	drpc.Stream
+	SendAndClose(*ObjectSetLegalHoldResponse) error
+}
+
+type drpcExtendedMetainfo_SetObjectLegalHoldStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_SetObjectLegalHoldStream) SendAndClose(m *ObjectSetLegalHoldResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_GetBucketLifecycleStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketLifecycleResponse) error
+}
+
+type drpcExtendedMetainfo_GetBucketLifecycleStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_GetBucketLifecycleStream) SendAndClose(m *GetBucketLifecycleResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_SetBucketLifecycleStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketLifecycleResponse) error
+}
+
+type drpcExtendedMetainfo_SetBucketLifecycleStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_SetBucketLifecycleStream) SendAndClose(m *SetBucketLifecycleResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_GetBucketPlacementStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketPlacementResponse) error
+}
+
+type drpcExtendedMetainfo_GetBucketPlacementStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_GetBucketPlacementStream) SendAndClose(m *GetBucketPlacementResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCExtendedMetainfo_SetBucketPlacementStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketPlacementResponse) error
+}
+
+type drpcExtendedMetainfo_SetBucketPlacementStream struct {
+	drpc.Stream
+}
+
+func (x *drpcExtendedMetainfo_SetBucketPlacementStream) SendAndClose(m *SetBucketPlacementResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_metainfo_ext_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
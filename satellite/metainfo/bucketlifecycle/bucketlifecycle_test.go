@@ -0,0 +1,197 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		chore := satellite.Core.BucketLifecycle.Chore
+
+		chore.Loop.Pause()
+
+		err := upl.Upload(ctx, satellite, "testbucket", "logs/remote", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+		err = upl.Upload(ctx, satellite, "testbucket", "logs/inline", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+		err = upl.Upload(ctx, satellite, "testbucket", "data/remote", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		// object keys are encrypted per path component, hence the rule
+		// prefix is the encrypted "logs/" component.
+		logsPrefix := commonPrefix(objects)
+		require.NotEmpty(t, logsPrefix)
+
+		var dataKey metabase.ObjectKey
+		for _, object := range objects {
+			if !strings.HasPrefix(string(object.ObjectKey), string(logsPrefix)) {
+				dataKey = object.ObjectKey
+			}
+		}
+		require.NotEmpty(t, dataKey)
+
+		pendingStream := metabase.ObjectStream{
+			ProjectID:  projectID,
+			BucketName: "testbucket",
+			ObjectKey:  logsPrefix + "pending",
+			Version:    metabase.NextVersion,
+			StreamID:   testrand.UUID(),
+		}
+		_, err = satellite.Metainfo.Metabase.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+			ObjectStream: pendingStream,
+			Encryption: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   256,
+			},
+		})
+		require.NoError(t, err)
+
+		err = satellite.DB.Buckets().UpdateBucketLifecycle(ctx, []byte("testbucket"), projectID, []metabase.LifecycleRule{
+			{ID: "logs", Prefix: []byte(logsPrefix), ExpireAfterDays: 7, AbortPendingAfterHours: 24},
+		})
+		require.NoError(t, err)
+
+		// nothing is old enough yet.
+		chore.Loop.TriggerWait()
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		pending, err := satellite.Metainfo.Metabase.TestingAllPendingObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		// pending objects are aborted after a day, committed objects are kept.
+		chore.SetNow(func() time.Time {
+			return time.Now().Add(25 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		pending, err = satellite.Metainfo.Metabase.TestingAllPendingObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, pending, 0)
+
+		// objects with the prefix expire after a week.
+		chore.SetNow(func() time.Time {
+			return time.Now().AddDate(0, 0, 8)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, dataKey, objects[0].ObjectKey)
+	})
+}
+
+func TestBucketLifecycleVersioned(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+		chore := satellite.Core.BucketLifecycle.Chore
+
+		chore.Loop.Pause()
+
+		err := upl.CreateBucket(ctx, satellite, "testbucket")
+		require.NoError(t, err)
+		err = satellite.DB.Buckets().UpdateBucketVersioning(ctx, []byte("testbucket"), projectID, metainfo.VersioningEnabled)
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			err = upl.Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+		latest := objects[2]
+
+		err = satellite.DB.Buckets().UpdateBucketLifecycle(ctx, []byte("testbucket"), projectID, []metabase.LifecycleRule{
+			{ID: "versions", NoncurrentExpireAfterDays: 1, ExpireAfterDays: 30},
+		})
+		require.NoError(t, err)
+
+		// noncurrent versions are removed, the latest version is kept.
+		chore.SetNow(func() time.Time {
+			return time.Now().AddDate(0, 0, 2)
+		})
+		chore.Loop.TriggerWait()
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, latest.Version, objects[0].Version)
+
+		// the expired latest version is hidden by a delete marker.
+		chore.SetNow(func() time.Time {
+			return time.Now().AddDate(0, 0, 31)
+		})
+		chore.Loop.TriggerWait()
+
+		_, err = satellite.Metainfo.Metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: metabase.ObjectLocation{
+				ProjectID:  projectID,
+				BucketName: "testbucket",
+				ObjectKey:  latest.ObjectKey,
+			},
+		})
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+	})
+}
+
+// commonPrefix returns the common path prefix, including the delimiter, of
+// the object keys.
+func commonPrefix(objects []metabase.ObjectEntry) metabase.ObjectKey {
+	counts := map[metabase.ObjectKey]int{}
+	for _, object := range objects {
+		key := string(object.ObjectKey)
+		if i := strings.IndexByte(key, metabase.Delimiter); i >= 0 {
+			counts[metabase.ObjectKey(key[:i+1])]++
+		}
+	}
+
+	var prefix metabase.ObjectKey
+	for candidate, count := range counts {
+		if count > 1 {
+			prefix = candidate
+		}
+	}
+	return prefix
+}
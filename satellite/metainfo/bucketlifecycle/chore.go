@@ -0,0 +1,407 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
+)
+
+var (
+	// Error defines the bucketlifecycle chore errors class.
+	Error = errs.Class("bucketlifecycle chore error")
+	mon   = monkit.Package()
+)
+
+// piecesDeletionSuccessThreshold is the same threshold the metainfo endpoint
+// uses when deleting pieces of deleted objects.
+const piecesDeletionSuccessThreshold = 0.75
+
+// defaultListLimit is used when the configured list limit isn't positive.
+const defaultListLimit = 100
+
+// Config contains configurable values for the bucket lifecycle chore.
+type Config struct {
+	Interval  time.Duration `help:"the time between each attempt to apply bucket lifecycle rules" releaseDefault:"24h" devDefault:"10s"`
+	Enabled   bool          `help:"set if bucket lifecycle rules are applied or not" releaseDefault:"true" devDefault:"true"`
+	ListLimit int           `help:"how many buckets and objects to query in a batch" default:"100"`
+}
+
+// Chore implements the bucket lifecycle chore.
+//
+// architecture: Chore
+type Chore struct {
+	log           *zap.Logger
+	config        Config
+	buckets       metainfo.BucketsDB
+	metabase      metainfo.MetabaseDB
+	pieceDeletion *piecedeletion.Service
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketlifecycle chore.
+func NewChore(log *zap.Logger, config Config, buckets metainfo.BucketsDB, metabase metainfo.MetabaseDB, pieceDeletion *piecedeletion.Service) *Chore {
+	if config.ListLimit <= 0 {
+		config.ListLimit = defaultListLimit
+	}
+
+	return &Chore{
+		log:           log,
+		config:        config,
+		buckets:       buckets,
+		metabase:      metabase,
+		pieceDeletion: pieceDeletion,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketlifecycle loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyLifecycles)
+}
+
+// Close stops the bucketlifecycle chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyLifecycles(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("applying bucket lifecycle rules")
+
+	now := chore.nowFn()
+
+	var cursor metabase.BucketLocation
+	for {
+		lifecycles, err := chore.buckets.ListBucketLifecycles(ctx, cursor, chore.config.ListLimit)
+		if err != nil {
+			chore.log.Error("listing bucket lifecycles failed", zap.Error(err))
+			return nil
+		}
+
+		for _, lifecycle := range lifecycles {
+			// a failing bucket shouldn't prevent applying rules of other buckets.
+			if err := chore.applyBucketLifecycle(ctx, lifecycle, now); err != nil {
+				if errors.Is(err, context.Canceled) {
+					return err
+				}
+				chore.log.Error("applying bucket lifecycle failed",
+					zap.Stringer("Project ID", lifecycle.ProjectID),
+					zap.String("Bucket", lifecycle.BucketName),
+					zap.Error(err))
+			}
+		}
+
+		if len(lifecycles) < chore.config.ListLimit {
+			return nil
+		}
+		cursor = lifecycles[len(lifecycles)-1].BucketLocation
+	}
+}
+
+func (chore *Chore) applyBucketLifecycle(ctx context.Context, lifecycle metainfo.BucketLifecycle, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versioning, err := chore.buckets.GetBucketVersioning(ctx, []byte(lifecycle.BucketName), lifecycle.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			// the bucket was deleted in the meantime.
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	for _, rule := range lifecycle.Rules {
+		if rule.AbortPendingAfterHours > 0 {
+			if err := chore.abortPendingObjects(ctx, lifecycle.BucketLocation, rule, now); err != nil {
+				return err
+			}
+		}
+		if rule.ExpireAfterDays > 0 || rule.NoncurrentExpireAfterDays > 0 {
+			if err := chore.expireObjectVersions(ctx, lifecycle.BucketLocation, versioning, rule, now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// abortPendingObjects deletes pending objects with the rule prefix, which were
+// started before the rule deadline.
+func (chore *Chore) abortPendingObjects(ctx context.Context, bucket metabase.BucketLocation, rule metabase.LifecycleRule, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := metabase.ObjectKey(rule.Prefix)
+
+	// objects are collected in batches and deleted outside of the iteration,
+	// to avoid modifying the objects while the query is still being read.
+	var cursor metabase.IterateCursor
+	for {
+		var expired []metabase.ObjectEntry
+		more := false
+
+		err := chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Recursive:  true,
+			BatchSize:  chore.config.ListLimit,
+			Prefix:     prefix,
+			Cursor:     cursor,
+			Status:     metabase.Pending,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			for it.Next(ctx, &entry) {
+				entry.ObjectKey = prefix + entry.ObjectKey
+				cursor = metabase.IterateCursor{Key: entry.ObjectKey, Version: entry.Version}
+
+				if rule.PendingExpired(entry.CreatedAt, now) {
+					expired = append(expired, entry)
+				}
+				if len(expired) >= chore.config.ListLimit {
+					more = true
+					return nil
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, entry := range expired {
+			result, err := chore.metabase.DeletePendingObject(ctx, metabase.DeletePendingObject{
+				ObjectLocation: objectLocation(bucket, entry.ObjectKey),
+				Version:        entry.Version,
+				StreamID:       entry.StreamID,
+			})
+			if err != nil {
+				if storj.ErrObjectNotFound.Has(err) {
+					// the upload was committed or deleted in the meantime.
+					continue
+				}
+				return Error.Wrap(err)
+			}
+
+			mon.Meter("lifecycle_aborted_pending_objects").Mark(1)
+			chore.deletePieces(ctx, result.Segments)
+		}
+
+		if !more {
+			return nil
+		}
+	}
+}
+
+// expireObjectVersions applies the expiration of current versions and the
+// expiration of noncurrent versions to objects with the rule prefix.
+//
+// Delete markers aren't supported by IterateObjectsAllVersionsWithStatus,
+// hence all versions are iterated and pending objects are skipped.
+func (chore *Chore) expireObjectVersions(ctx context.Context, bucket metabase.BucketLocation, versioning metainfo.Versioning, rule metabase.LifecycleRule, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := metabase.ObjectKey(rule.Prefix)
+
+	var cursor metabase.IterateCursor
+	for {
+		var expired []objectVersion
+		more := false
+
+		err := chore.metabase.IterateObjectsAllVersions(ctx, metabase.IterateObjects{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			BatchSize:  chore.config.ListLimit,
+			Prefix:     prefix,
+			Cursor:     cursor,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			// versions of a single object are ordered by version, so the
+			// object versions are evaluated once the next object is reached.
+			var versions []metabase.ObjectEntry
+			var entry metabase.ObjectEntry
+			for it.Next(ctx, &entry) {
+				entry.ObjectKey = prefix + entry.ObjectKey
+
+				if len(versions) > 0 && versions[0].ObjectKey != entry.ObjectKey {
+					expired = append(expired, evaluateVersions(rule, versions, now)...)
+					last := versions[len(versions)-1]
+					cursor = metabase.IterateCursor{Key: last.ObjectKey, Version: last.Version}
+					versions = versions[:0]
+
+					if len(expired) >= chore.config.ListLimit {
+						more = true
+						return nil
+					}
+				}
+
+				if entry.Status == metabase.Committed || entry.Status == metabase.DeleteMarker {
+					versions = append(versions, entry)
+				}
+			}
+			expired = append(expired, evaluateVersions(rule, versions, now)...)
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, version := range expired {
+			if err := chore.expireVersion(ctx, objectLocation(bucket, version.ObjectKey), versioning, version); err != nil {
+				return err
+			}
+		}
+
+		if !more {
+			return nil
+		}
+	}
+}
+
+// objectVersion is an object version selected for expiration.
+type objectVersion struct {
+	metabase.ObjectEntry
+	// Current is true when the version is the latest version of the object.
+	Current bool
+}
+
+// evaluateVersions returns committed versions of a single object, which
+// should be expired according to the rule. Versions are ordered by version.
+func evaluateVersions(rule metabase.LifecycleRule, versions []metabase.ObjectEntry, now time.Time) (expired []objectVersion) {
+	for i, version := range versions {
+		if version.Status != metabase.Committed {
+			continue
+		}
+
+		if i == len(versions)-1 {
+			if rule.Expired(version.CreatedAt, now) {
+				expired = append(expired, objectVersion{ObjectEntry: version, Current: true})
+			}
+			continue
+		}
+
+		// the version became noncurrent when the next version was created.
+		if rule.NoncurrentExpired(versions[i+1].CreatedAt, now) {
+			expired = append(expired, objectVersion{ObjectEntry: version})
+		}
+	}
+	return expired
+}
+
+// expireVersion deletes the object version. Current versions in versioned
+// buckets are hidden by a delete marker, the same way as they're deleted by
+// the metainfo endpoint.
+func (chore *Chore) expireVersion(ctx context.Context, location metabase.ObjectLocation, versioning metainfo.Versioning, version objectVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if version.Current && versioning != metainfo.Unversioned {
+		// a new version could have been committed since the iteration.
+		latest, err := chore.metabase.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+			ObjectLocation: location,
+		})
+		if err != nil {
+			if storj.ErrObjectNotFound.Has(err) {
+				return nil
+			}
+			return Error.Wrap(err)
+		}
+		if latest.Version != version.Version {
+			return nil
+		}
+	}
+
+	deleteVersion := !version.Current || versioning != metainfo.VersioningEnabled
+	if deleteVersion {
+		result, err := chore.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: location,
+			Version:        version.Version,
+		})
+		if err != nil {
+			switch {
+			case metabase.ErrObjectLock.Has(err):
+				// lifecycle rules don't override object lock.
+				mon.Meter("lifecycle_locked_objects").Mark(1)
+				return nil
+			case storj.ErrObjectNotFound.Has(err):
+				return nil
+			default:
+				return Error.Wrap(err)
+			}
+		}
+
+		mon.Meter("lifecycle_expired_objects").Mark(1)
+		chore.deletePieces(ctx, result.Segments)
+	}
+
+	if version.Current && versioning != metainfo.Unversioned {
+		_, err := chore.metabase.CreateDeleteMarker(ctx, metabase.CreateDeleteMarker{
+			ObjectLocation: location,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Meter("lifecycle_delete_markers").Mark(1)
+	}
+
+	return nil
+}
+
+func objectLocation(bucket metabase.BucketLocation, key metabase.ObjectKey) metabase.ObjectLocation {
+	return metabase.ObjectLocation{
+		ProjectID:  bucket.ProjectID,
+		BucketName: bucket.BucketName,
+		ObjectKey:  key,
+	}
+}
+
+// deletePieces deletes the pieces of deleted segments from the storage nodes.
+// Failures are only logged, garbage collection takes care of the leftovers.
+func (chore *Chore) deletePieces(ctx context.Context, segments []metabase.DeletedSegmentInfo) {
+	nodesPieces := map[storj.NodeID][]storj.PieceID{}
+	for _, segment := range segments {
+		for _, piece := range segment.Pieces {
+			pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+			nodesPieces[piece.StorageNode] = append(nodesPieces[piece.StorageNode], pieceID)
+		}
+	}
+
+	var requests []piecedeletion.Request
+	for node, pieces := range nodesPieces {
+		requests = append(requests, piecedeletion.Request{
+			Node: storj.NodeURL{
+				ID: node,
+			},
+			Pieces: pieces,
+		})
+	}
+
+	if err := chore.pieceDeletion.Delete(ctx, requests, piecesDeletionSuccessThreshold); err != nil {
+		chore.log.Error("failed to delete pieces", zap.Error(err))
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlifecycle contains the chore which applies bucket lifecycle rules.
+
+The lifecycle rules are stored with the buckets and match objects by their
+encrypted key prefix. The chore iterates objects of every bucket with lifecycle
+rules and:
+
+  - aborts pending objects older than AbortPendingAfterHours,
+  - expires current versions older than ExpireAfterDays, versioned buckets keep
+    the version and add a delete marker instead,
+  - deletes versions which are noncurrent for more than NoncurrentExpireAfterDays.
+
+Objects are deleted through the same metabase delete paths as the metainfo
+endpoint uses, hence locked objects are kept, and pieces are deleted from the
+storage nodes through the piecedeletion service.
+*/
+package bucketlifecycle
@@ -0,0 +1,87 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+// checkExceedsBucketUploadLimits returns an error when the storage, segment
+// count or, for new objects, the object count limit of the bucket is exceeded.
+func (endpoint *Endpoint) checkExceedsBucketUploadLimits(ctx context.Context, bucket metabase.BucketLocation, newObject bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limitName, err := endpoint.projectUsage.ExceedsBucketUploadLimits(ctx, bucket, newObject)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving bucket usage failed; bucket limits won't be enforced",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Bucket limit exceeded",
+			zap.String("Limit", limitName),
+			zap.Stringer("Project ID", bucket.ProjectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+	}
+
+	return nil
+}
+
+// checkExceedsBucketBandwidthUsage returns an error when the monthly bandwidth
+// limit of the bucket is exceeded.
+func (endpoint *Endpoint) checkExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBucketBandwidthUsage(ctx, bucket)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving bucket bandwidth total failed; bucket bandwidth limit won't be enforced",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Monthly bucket bandwidth limit exceeded",
+			zap.Stringer("Limit", limit),
+			zap.Stringer("Project ID", bucket.ProjectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+	}
+
+	return nil
+}
+
+// addBucketStorageUsage tracks the storage, object count and segment count of
+// a bucket for its limits.
+func (endpoint *Endpoint) addBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation, increment accounting.BucketStorageUsage) {
+	if err := endpoint.projectUsage.AddBucketStorageUsage(ctx, bucket, increment); err != nil {
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected are the bucket
+		// limits.
+		endpoint.log.Error("Could not track the bucket storage usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+}
+
+// updateBucketBandwidthUsage tracks the bandwidth of a bucket for its limit.
+func (endpoint *Endpoint) updateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64) {
+	if err := endpoint.projectUsage.UpdateBucketBandwidthUsage(ctx, bucket, increment); err != nil {
+		// log it and continue. it's most likely our own fault that we couldn't
+		// track it, and the only thing that will be affected is the bucket
+		// bandwidth limit.
+		endpoint.log.Error("Could not track the bucket bandwidth usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+}
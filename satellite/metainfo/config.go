@@ -103,10 +103,11 @@ func (rs *RSConfig) Set(s string) error {
 
 // RateLimiterConfig is a configuration struct for endpoint rate limiting.
 type RateLimiterConfig struct {
-	Enabled         bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
-	Rate            float64       `help:"request rate per project per second." releaseDefault:"1000" devDefault:"100"`
-	CacheCapacity   int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10"`
-	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
+	Enabled          bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
+	Rate             float64       `help:"request rate per project per second." releaseDefault:"1000" devDefault:"100"`
+	CacheCapacity    int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10"`
+	KeyCacheCapacity int           `help:"number of api keys with a rate limit to cache." releaseDefault:"10000" devDefault:"10"`
+	CacheExpiration  time.Duration `help:"how long to cache the projects and api keys limiter." releaseDefault:"10m" devDefault:"10s"`
 }
 
 // ProjectLimitConfig is a configuration struct for default project limits.
@@ -185,6 +186,16 @@ type MetabaseDB interface {
 	DeleteExpiredObjects(ctx context.Context, opts metabase.DeleteExpiredObjects) error
 	// DeleteObjectLatestVersion deletes latest object version.
 	DeleteObjectLatestVersion(ctx context.Context, opts metabase.DeleteObjectLatestVersion) (result metabase.DeleteObjectResult, err error)
+	// DeleteObjectExactVersion deletes an exact object version.
+	DeleteObjectExactVersion(ctx context.Context, opts metabase.DeleteObjectExactVersion) (result metabase.DeleteObjectResult, err error)
+	// CreateDeleteMarker hides the object by adding a delete marker as the latest version.
+	CreateDeleteMarker(ctx context.Context, opts metabase.CreateDeleteMarker) (marker metabase.Object, err error)
+	// SetObjectRetention changes the retention of a committed object version.
+	SetObjectRetention(ctx context.Context, opts metabase.SetObjectRetention) error
+	// SetObjectLegalHold places or lifts a legal hold of a committed object version.
+	SetObjectLegalHold(ctx context.Context, opts metabase.SetObjectLegalHold) error
+	// BeginObjectNextVersion adds a pending object to the database, with automatically assigned version.
+	BeginObjectNextVersion(ctx context.Context, opts metabase.BeginObjectNextVersion) (committed metabase.Version, err error)
 	// BeginObjectExactVersion adds a pending object to the database, with specific version.
 	BeginObjectExactVersion(ctx context.Context, opts metabase.BeginObjectExactVersion) (committed metabase.Object, err error)
 	// CommitObject adds a pending object to the database.
@@ -195,6 +206,8 @@ type MetabaseDB interface {
 	CommitSegment(ctx context.Context, opts metabase.CommitSegment) (err error)
 	// CommitInlineSegment commits inline segment to the database.
 	CommitInlineSegment(ctx context.Context, opts metabase.CommitInlineSegment) (err error)
+	// GetObjectExactVersion returns object information for exact version.
+	GetObjectExactVersion(ctx context.Context, opts metabase.GetObjectExactVersion) (_ metabase.Object, err error)
 	// GetObjectLatestVersion returns object information for latest version.
 	GetObjectLatestVersion(ctx context.Context, opts metabase.GetObjectLatestVersion) (_ metabase.Object, err error)
 	// GetSegmentByLocation returns a information about segment on the specified location.
@@ -213,6 +226,8 @@ type MetabaseDB interface {
 	IterateObjectsAllVersions(ctx context.Context, opts metabase.IterateObjects, fn func(context.Context, metabase.ObjectsIterator) error) (err error)
 	// IterateObjectsAllVersionsWithStatus iterates through all versions of all objects with specified status.
 	IterateObjectsAllVersionsWithStatus(ctx context.Context, opts metabase.IterateObjectsWithStatus, fn func(context.Context, metabase.ObjectsIterator) error) (err error)
+	// IterateObjectsLatestVersion iterates through the latest committed version of all objects.
+	IterateObjectsLatestVersion(ctx context.Context, opts metabase.IterateObjectsWithStatus, fn func(context.Context, metabase.ObjectsIterator) error) (err error)
 	// IteratePendingObjectsByKey iterates through all StreamID for a given ObjectKey.
 	IteratePendingObjectsByKey(ctx context.Context, opts metabase.IteratePendingObjectsByKey, fn func(context.Context, metabase.ObjectsIterator) error) (err error)
 	// IterateLoopObjects iterates through all objects in metabase for metainfo loop purpose.
@@ -224,6 +239,14 @@ type MetabaseDB interface {
 	BucketEmpty(ctx context.Context, opts metabase.BucketEmpty) (empty bool, err error)
 	// UpdateSegmentPieces updates pieces for specified segment. If provided old pieces won't match current database state update will fail.
 	UpdateSegmentPieces(ctx context.Context, opts metabase.UpdateSegmentPieces) (err error)
+	// BeginMoveObject collects all data needed to begin an object move procedure.
+	BeginMoveObject(ctx context.Context, opts metabase.BeginMoveObject) (result metabase.BeginMoveCopyResults, err error)
+	// FinishMoveObject moves an object to the new location and replaces the segment keys.
+	FinishMoveObject(ctx context.Context, opts metabase.FinishMoveObject) (object metabase.Object, err error)
+	// BeginCopyObject collects all data needed to begin an object copy procedure.
+	BeginCopyObject(ctx context.Context, opts metabase.BeginCopyObject) (result metabase.BeginMoveCopyResults, err error)
+	// FinishCopyObject creates a copy of an object which references the same pieces.
+	FinishCopyObject(ctx context.Context, opts metabase.FinishCopyObject) (object metabase.Object, err error)
 	// EnsureNodeAliases ensures that the supplied node ID-s have a alias.
 	// It's safe to concurrently try and create node ID-s for the same NodeID.
 	EnsureNodeAliases(ctx context.Context, opts metabase.EnsureNodeAliases) (err error)
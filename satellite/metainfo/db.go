@@ -5,13 +5,75 @@ package metainfo
 
 import (
 	"context"
+	"time"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
 )
 
+// Versioning is the versioning state of a bucket.
+type Versioning int
+
+const (
+	// Unversioned means that the bucket keeps only a single version of an object.
+	// Committing an object replaces the previous one and deleting removes it.
+	Unversioned Versioning = 0
+	// VersioningEnabled means that committing an object keeps the older versions
+	// and deleting an object adds a delete marker.
+	VersioningEnabled Versioning = 1
+	// VersioningSuspended means that committing an object replaces the latest
+	// version and deleting an object replaces the latest version with a delete marker.
+	// Versions created while versioning was enabled are kept.
+	VersioningSuspended Versioning = 2
+)
+
+// String returns a string representation of the versioning state.
+func (versioning Versioning) String() string {
+	switch versioning {
+	case Unversioned:
+		return "unversioned"
+	case VersioningEnabled:
+		return "enabled"
+	case VersioningSuspended:
+		return "suspended"
+	default:
+		return "unknown"
+	}
+}
+
+// DefaultRetention is the retention applied to objects committed into a bucket.
+type DefaultRetention struct {
+	Mode metabase.RetentionMode
+	Days int
+}
+
+// IsZero returns whether no default retention is configured.
+func (retention DefaultRetention) IsZero() bool {
+	return retention.Mode == metabase.NoRetention
+}
+
+// Retention returns the object retention for an object committed at the specified time.
+func (retention DefaultRetention) Retention(committedAt time.Time) metabase.Retention {
+	if retention.IsZero() {
+		return metabase.Retention{}
+	}
+	retainUntil := committedAt.AddDate(0, 0, retention.Days)
+	return metabase.Retention{
+		Mode:        retention.Mode,
+		RetainUntil: &retainUntil,
+	}
+}
+
+// BucketLifecycle contains the lifecycle rules of a bucket.
+type BucketLifecycle struct {
+	metabase.BucketLocation
+	Rules []metabase.LifecycleRule
+}
+
 // BucketsDB is the interface for the database to interact with buckets.
 //
 // architecture: Database
@@ -32,4 +94,26 @@ type BucketsDB interface {
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// GetBucketVersioning returns the versioning state of a bucket.
+	GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (versioning Versioning, err error)
+	// UpdateBucketVersioning updates the versioning state of a bucket.
+	UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) (err error)
+	// GetBucketDefaultRetention returns the default retention of a bucket.
+	GetBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID) (retention DefaultRetention, err error)
+	// UpdateBucketDefaultRetention updates the default retention of a bucket.
+	UpdateBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID, retention DefaultRetention) (err error)
+	// GetBucketLifecycle returns the lifecycle rules of a bucket.
+	GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (rules []metabase.LifecycleRule, err error)
+	// UpdateBucketLifecycle replaces the lifecycle rules of a bucket, no rules removes the lifecycle configuration.
+	UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []metabase.LifecycleRule) (err error)
+	// ListBucketLifecycles returns buckets with lifecycle rules ordered by project ID and bucket name, starting after the cursor.
+	ListBucketLifecycles(ctx context.Context, cursor metabase.BucketLocation, limit int) (lifecycles []BucketLifecycle, err error)
+	// GetBucketPlacement returns the placement constraints of a bucket.
+	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement nodeselection.Placement, err error)
+	// UpdateBucketPlacement replaces the placement constraints of a bucket, the zero placement removes the constraints.
+	UpdateBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement nodeselection.Placement) (err error)
+	// GetBucketLimits returns the limits of a bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits accounting.BucketLimits, err error)
+	// UpdateBucketLimits replaces the limits of a bucket, a nil limit removes the limit.
+	UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits accounting.BucketLimits) (err error)
 }
@@ -14,7 +14,11 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -208,3 +212,195 @@ func TestListBucketsNotAllowed(t *testing.T) {
 		}
 	})
 }
+
+func TestBucketVersioning(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		versioning, err := bucketsDB.GetBucketVersioning(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, metainfo.Unversioned, versioning)
+
+		err = bucketsDB.UpdateBucketVersioning(ctx, []byte("testbucket"), project.ID, metainfo.VersioningEnabled)
+		require.NoError(t, err)
+
+		versioning, err = bucketsDB.GetBucketVersioning(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, metainfo.VersioningEnabled, versioning)
+
+		_, err = bucketsDB.GetBucketVersioning(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketVersioning(ctx, []byte("missing"), project.ID, metainfo.VersioningEnabled)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
+func TestBucketDefaultRetention(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		retention, err := bucketsDB.GetBucketDefaultRetention(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, retention.IsZero())
+
+		expected := metainfo.DefaultRetention{Mode: metabase.ComplianceMode, Days: 30}
+		err = bucketsDB.UpdateBucketDefaultRetention(ctx, []byte("testbucket"), project.ID, expected)
+		require.NoError(t, err)
+
+		retention, err = bucketsDB.GetBucketDefaultRetention(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, retention)
+
+		_, err = bucketsDB.GetBucketDefaultRetention(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketDefaultRetention(ctx, []byte("missing"), project.ID, expected)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
+func TestBucketLifecycle(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		for _, name := range []string{"bucket-a", "bucket-b", "bucket-c"} {
+			_, err = bucketsDB.CreateBucket(ctx, newTestBucket(name, project.ID))
+			require.NoError(t, err)
+		}
+
+		rules, err := bucketsDB.GetBucketLifecycle(ctx, []byte("bucket-a"), project.ID)
+		require.NoError(t, err)
+		require.Empty(t, rules)
+
+		expected := []metabase.LifecycleRule{
+			{ID: "logs", Prefix: []byte("logs/"), ExpireAfterDays: 30},
+			{ID: "uploads", AbortPendingAfterHours: 24, NoncurrentExpireAfterDays: 7},
+		}
+		for _, name := range []string{"bucket-a", "bucket-c"} {
+			err = bucketsDB.UpdateBucketLifecycle(ctx, []byte(name), project.ID, expected)
+			require.NoError(t, err)
+		}
+
+		rules, err = bucketsDB.GetBucketLifecycle(ctx, []byte("bucket-a"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, rules)
+
+		lifecycles, err := bucketsDB.ListBucketLifecycles(ctx, metabase.BucketLocation{}, 10)
+		require.NoError(t, err)
+		require.Equal(t, []metainfo.BucketLifecycle{
+			{BucketLocation: metabase.BucketLocation{ProjectID: project.ID, BucketName: "bucket-a"}, Rules: expected},
+			{BucketLocation: metabase.BucketLocation{ProjectID: project.ID, BucketName: "bucket-c"}, Rules: expected},
+		}, lifecycles)
+
+		lifecycles, err = bucketsDB.ListBucketLifecycles(ctx, lifecycles[0].BucketLocation, 10)
+		require.NoError(t, err)
+		require.Len(t, lifecycles, 1)
+		require.Equal(t, "bucket-c", lifecycles[0].BucketName)
+
+		// removing the rules excludes the bucket from the listing.
+		err = bucketsDB.UpdateBucketLifecycle(ctx, []byte("bucket-c"), project.ID, nil)
+		require.NoError(t, err)
+
+		lifecycles, err = bucketsDB.ListBucketLifecycles(ctx, metabase.BucketLocation{}, 10)
+		require.NoError(t, err)
+		require.Len(t, lifecycles, 1)
+		require.Equal(t, "bucket-a", lifecycles[0].BucketName)
+
+		_, err = bucketsDB.GetBucketLifecycle(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketLifecycle(ctx, []byte("missing"), project.ID, expected)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
+func TestBucketPlacement(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		placement, err := bucketsDB.GetBucketPlacement(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, placement.IsZero())
+
+		expected := nodeselection.Placement{
+			AllowedCountries:  nodeselection.EUCountries,
+			ExcludedCountries: []string{"DE"},
+		}
+		err = bucketsDB.UpdateBucketPlacement(ctx, []byte("testbucket"), project.ID, expected)
+		require.NoError(t, err)
+
+		placement, err = bucketsDB.GetBucketPlacement(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, placement)
+
+		// the zero placement removes the constraints.
+		err = bucketsDB.UpdateBucketPlacement(ctx, []byte("testbucket"), project.ID, nodeselection.Placement{})
+		require.NoError(t, err)
+
+		placement, err = bucketsDB.GetBucketPlacement(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, placement.IsZero())
+
+		_, err = bucketsDB.GetBucketPlacement(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketPlacement(ctx, []byte("missing"), project.ID, expected)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
+func TestBucketLimits(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := db.Buckets()
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		limits, err := bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+
+		storage, objects := int64(1000), int64(10)
+		expected := accounting.BucketLimits{Storage: &storage, Objects: &objects}
+		err = bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, expected)
+		require.NoError(t, err)
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, limits)
+
+		// nil limits remove the limits.
+		err = bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, accounting.BucketLimits{})
+		require.NoError(t, err)
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+
+		_, err = bucketsDB.GetBucketLimits(ctx, []byte("missing"), project.ID)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = bucketsDB.UpdateBucketLimits(ctx, []byte("missing"), project.ID, expected)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
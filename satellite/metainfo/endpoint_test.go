@@ -7,12 +7,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/errs2"
+	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
@@ -21,7 +23,10 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/uplink/private/etag"
 	"storj.io/uplink/private/metainfo"
 	"storj.io/uplink/private/multipart"
@@ -560,3 +565,558 @@ func TestCommitSegment_Validation(t *testing.T) {
 		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
 	})
 }
+
+func TestEndpoint_BucketPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		// the RS total share count is 6, hence exactly 6 nodes are allowed.
+		excluded := map[storj.NodeID]bool{}
+		for i, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+
+			country := "DE"
+			if i < 2 {
+				country = "US"
+				excluded[node.ID()] = true
+			}
+			require.NoError(t, satellite.Overlay.DB.TestNodeCountryCode(ctx, node.ID(), country))
+		}
+		require.NoError(t, satellite.Overlay.Service.UploadSelectionCache.Refresh(ctx))
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		getPlacement := func(bucket string) (*internalpb.Placement, error) {
+			resp, err := endpoint.GetBucketPlacement(ctx, &internalpb.GetBucketPlacementRequest{
+				Header: header,
+				Bucket: []byte(bucket),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Placement, nil
+		}
+		setPlacement := func(placement *internalpb.Placement) error {
+			_, err := endpoint.SetBucketPlacement(ctx, &internalpb.SetBucketPlacementRequest{
+				Header:    header,
+				Bucket:    []byte("testbucket"),
+				Placement: placement,
+			})
+			return err
+		}
+
+		placement, err := getPlacement("testbucket")
+		require.NoError(t, err)
+		require.Empty(t, placement.AllowedCountries)
+		require.Empty(t, placement.ExcludedCountries)
+
+		_, err = getPlacement("missing")
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		err = setPlacement(&internalpb.Placement{ExcludedCountries: []string{"us"}})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		expected := &internalpb.Placement{ExcludedCountries: []string{"US"}}
+		require.NoError(t, setPlacement(expected))
+
+		placement, err = getPlacement("testbucket")
+		require.NoError(t, err)
+		require.Equal(t, expected, placement)
+
+		for i := 0; i < 5; i++ {
+			err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object"+strconv.Itoa(i), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := satellite.Metainfo.Metabase.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 5)
+		for _, segment := range segments {
+			require.NotEmpty(t, segment.Pieces)
+			for _, piece := range segment.Pieces {
+				require.False(t, excluded[piece.StorageNode], "piece stored on an excluded node")
+			}
+		}
+
+		// the placement cannot be changed once the bucket contains data.
+		err = setPlacement(&internalpb.Placement{})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
+func TestEndpoint_MoveObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		for _, key := range []string{"source", "target"} {
+			err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", key, testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		source, target := objects[0], objects[1]
+
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		beginResp, err := endpoint.BeginMoveObject(ctx, &internalpb.ObjectBeginMoveRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("testbucket"),
+			NewEncryptedObjectKey: []byte(target.ObjectKey),
+		})
+		require.NoError(t, err)
+
+		_, err = endpoint.FinishMoveObject(ctx, &internalpb.ObjectFinishMoveRequest{
+			Header:                header,
+			StreamId:              beginResp.StreamId,
+			NewBucket:             []byte("testbucket"),
+			NewEncryptedObjectKey: []byte(target.ObjectKey),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.NoError(t, err)
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, target.ObjectKey, objects[0].ObjectKey)
+		require.Equal(t, source.StreamID, objects[0].StreamID)
+
+		segments, err := satellite.Metainfo.Metabase.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		for _, segment := range segments {
+			require.Equal(t, source.StreamID, segment.StreamID)
+		}
+	})
+}
+
+func TestEndpoint_MoveObjectBucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		projectID := planet.Uplinks[0].Projects[0].ID
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		limits := map[string]int64{"testbucket": 10, "fullbucket": 1, "emptybucket": 10}
+		for name, objects := range limits {
+			objects := objects
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, name))
+			err := satellite.DB.Buckets().UpdateBucketLimits(ctx, []byte(name), projectID, accounting.BucketLimits{
+				Objects: &objects,
+			})
+			require.NoError(t, err)
+		}
+
+		for _, name := range []string{"testbucket", "fullbucket"} {
+			err := planet.Uplinks[0].Upload(ctx, satellite, name, "object", testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		source := objects[0]
+
+		beginResp, err := endpoint.BeginMoveObject(ctx, &internalpb.ObjectBeginMoveRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("emptybucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+		})
+		require.NoError(t, err)
+
+		// the limits of a bucket which differs from the one in begin are checked as well.
+		_, err = endpoint.FinishMoveObject(ctx, &internalpb.ObjectFinishMoveRequest{
+			Header:                header,
+			StreamId:              beginResp.StreamId,
+			NewBucket:             []byte("fullbucket"),
+			NewEncryptedObjectKey: []byte("other"),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		_, err = endpoint.FinishMoveObject(ctx, &internalpb.ObjectFinishMoveRequest{
+			Header:                header,
+			StreamId:              beginResp.StreamId,
+			NewBucket:             []byte("emptybucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.NoError(t, err)
+
+		// the usage of the object moves with it.
+		usage, err := satellite.LiveAccounting.Cache.GetBucketStorageUsage(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "testbucket"})
+		require.NoError(t, err)
+		require.Equal(t, accounting.BucketStorageUsage{}, usage)
+
+		usage, err = satellite.LiveAccounting.Cache.GetBucketStorageUsage(ctx, metabase.BucketLocation{ProjectID: projectID, BucketName: "emptybucket"})
+		require.NoError(t, err)
+		require.Equal(t, accounting.BucketStorageUsage{
+			Storage:  source.TotalEncryptedSize,
+			Objects:  1,
+			Segments: int64(source.SegmentCount),
+		}, usage)
+	})
+}
+
+func TestEndpoint_CopyObject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		projectID := planet.Uplinks[0].Projects[0].ID
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "source", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "otherbucket"))
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		source := objects[0]
+
+		beginResp, err := endpoint.BeginCopyObject(ctx, &internalpb.ObjectBeginCopyRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("otherbucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+		})
+		require.NoError(t, err)
+		require.Len(t, beginResp.SegmentKeys, int(source.SegmentCount))
+
+		// the stream id is signed by the satellite.
+		_, err = endpoint.FinishCopyObject(ctx, &internalpb.ObjectFinishCopyRequest{
+			Header:                header,
+			StreamId:              testrand.Bytes(32),
+			NewBucket:             []byte("otherbucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		finishResp, err := endpoint.FinishCopyObject(ctx, &internalpb.ObjectFinishCopyRequest{
+			Header:                header,
+			StreamId:              beginResp.StreamId,
+			NewBucket:             []byte("otherbucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte("otherbucket"), finishResp.Object.Bucket)
+
+		copies, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "otherbucket")
+		require.NoError(t, err)
+		require.Len(t, copies, 1)
+		require.NotEqual(t, source.StreamID, copies[0].StreamID)
+
+		sourceObjects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Equal(t, objects, sourceObjects)
+
+		// the existing copy cannot be replaced without delete permission.
+		restricted, err := planet.Uplinks[0].APIKey[satellite.ID()].Restrict(macaroon.WithNonce(macaroon.Caveat{
+			DisallowDeletes: true,
+		}))
+		require.NoError(t, err)
+		restrictedHeader := &pb.RequestHeader{ApiKey: restricted.SerializeRaw()}
+
+		beginResp, err = endpoint.BeginCopyObject(ctx, &internalpb.ObjectBeginCopyRequest{
+			Header:                restrictedHeader,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("otherbucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+		})
+		require.NoError(t, err)
+
+		_, err = endpoint.FinishCopyObject(ctx, &internalpb.ObjectFinishCopyRequest{
+			Header:                restrictedHeader,
+			StreamId:              beginResp.StreamId,
+			NewBucket:             []byte("otherbucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		unchanged, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "otherbucket")
+		require.NoError(t, err)
+		require.Equal(t, copies, unchanged)
+	})
+}
+
+func TestEndpoint_MoveCopyObjectPlacement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		projectID := planet.Uplinks[0].Projects[0].ID
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "source", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "eubucket"))
+
+		_, err = endpoint.SetBucketPlacement(ctx, &internalpb.SetBucketPlacementRequest{
+			Header:    header,
+			Bucket:    []byte("eubucket"),
+			Placement: &internalpb.Placement{AllowedCountries: nodeselection.EUCountries},
+		})
+		require.NoError(t, err)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		source := objects[0]
+
+		// the pieces of the source object may be stored anywhere.
+		_, err = endpoint.BeginMoveObject(ctx, &internalpb.ObjectBeginMoveRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("eubucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		_, err = endpoint.BeginCopyObject(ctx, &internalpb.ObjectBeginCopyRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("eubucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		// finish rejects the target bucket as well.
+		beginResp, err := endpoint.BeginCopyObject(ctx, &internalpb.ObjectBeginCopyRequest{
+			Header:                header,
+			Bucket:                []byte("testbucket"),
+			EncryptedObjectKey:    []byte(source.ObjectKey),
+			NewBucket:             []byte("testbucket"),
+			NewEncryptedObjectKey: []byte("copy"),
+		})
+		require.NoError(t, err)
+
+		_, err = endpoint.FinishCopyObject(ctx, &internalpb.ObjectFinishCopyRequest{
+			Header:                header,
+			StreamId:              beginResp.StreamId,
+			NewBucket:             []byte("eubucket"),
+			NewEncryptedObjectKey: []byte(source.ObjectKey),
+			NewSegmentKeys:        beginResp.SegmentKeys,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		copies, err := satellite.Metainfo.Metabase.TestingAllCommittedObjects(ctx, projectID, "eubucket")
+		require.NoError(t, err)
+		require.Empty(t, copies)
+	})
+}
+
+func TestEndpoint_BucketVersioning(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		projectID := planet.Uplinks[0].Projects[0].ID
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		getResp, err := endpoint.GetBucketVersioning(ctx, &internalpb.GetBucketVersioningRequest{
+			Header: header,
+			Bucket: []byte("testbucket"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, internalpb.Versioning_UNVERSIONED, getResp.Versioning)
+
+		_, err = endpoint.SetBucketVersioning(ctx, &internalpb.SetBucketVersioningRequest{
+			Header:     header,
+			Bucket:     []byte("testbucket"),
+			Versioning: internalpb.Versioning_ENABLED,
+		})
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketVersioning(ctx, &internalpb.GetBucketVersioningRequest{
+			Header: header,
+			Bucket: []byte("testbucket"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, internalpb.Versioning_ENABLED, getResp.Versioning)
+
+		// versioning can only be suspended once it was enabled.
+		_, err = endpoint.SetBucketVersioning(ctx, &internalpb.SetBucketVersioningRequest{
+			Header:     header,
+			Bucket:     []byte("testbucket"),
+			Versioning: internalpb.Versioning_UNVERSIONED,
+		})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		for i := 0; i < 2; i++ {
+			err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		listResp, err := endpoint.ListObjectVersions(ctx, &internalpb.ObjectListVersionsRequest{
+			Header: header,
+			Bucket: []byte("testbucket"),
+		})
+		require.NoError(t, err)
+		require.Len(t, listResp.Items, 2)
+		require.False(t, listResp.More)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		for _, object := range objects {
+			require.Equal(t, projectID, object.ProjectID)
+
+			versionResp, err := endpoint.GetObjectVersion(ctx, &internalpb.ObjectGetVersionRequest{
+				Header:             header,
+				Bucket:             []byte("testbucket"),
+				EncryptedObjectKey: []byte(object.ObjectKey),
+				Version:            int64(object.Version),
+			})
+			require.NoError(t, err)
+			require.Equal(t, int32(object.Version), versionResp.Object.Version)
+		}
+	})
+}
+
+func TestEndpoint_ObjectLock(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		header := &pb.RequestHeader{
+			ApiKey: planet.Uplinks[0].APIKey[satellite.ID()].SerializeRaw(),
+		}
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := satellite.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		object := objects[0]
+
+		_, err = endpoint.SetObjectLegalHold(ctx, &internalpb.ObjectSetLegalHoldRequest{
+			Header:             header,
+			Bucket:             []byte("testbucket"),
+			EncryptedObjectKey: []byte(object.ObjectKey),
+			Version:            int64(object.Version),
+		})
+		require.NoError(t, err)
+
+		lockResp, err := endpoint.GetObjectLock(ctx, &internalpb.ObjectGetLockRequest{
+			Header:             header,
+			Bucket:             []byte("testbucket"),
+			EncryptedObjectKey: []byte(object.ObjectKey),
+			Version:            int64(object.Version),
+		})
+		require.NoError(t, err)
+		require.True(t, lockResp.LegalHold)
+		require.Equal(t, internalpb.RetentionMode_NO_RETENTION, lockResp.Retention.Mode)
+
+		// locked objects are reported differently from missing permissions.
+		_, err = endpoint.BeginDeleteObject(ctx, &pb.ObjectBeginDeleteRequest{
+			Header:        header,
+			Bucket:        []byte("testbucket"),
+			EncryptedPath: []byte(object.ObjectKey),
+		})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		objects, err = satellite.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+	})
+}
+
+func TestEndpoint_BucketLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		endpoint := satellite.Metainfo.Endpoint2
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		rules := []*internalpb.LifecycleRule{
+			{Id: "logs", EncryptedPrefix: []byte("encrypted"), ExpireAfterDays: 30},
+			{Id: "uploads", AbortPendingAfterHours: 24},
+		}
+
+		// lifecycle rules delete objects, so delete permission is required.
+		restricted, err := apiKey.Restrict(macaroon.WithNonce(macaroon.Caveat{
+			DisallowDeletes: true,
+		}))
+		require.NoError(t, err)
+
+		_, err = endpoint.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: &pb.RequestHeader{ApiKey: restricted.SerializeRaw()},
+			Bucket: []byte("testbucket"),
+			Rules:  rules,
+		})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+		_, err = endpoint.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: header,
+			Bucket: []byte("testbucket"),
+			Rules:  rules,
+		})
+		require.NoError(t, err)
+
+		getResp, err := endpoint.GetBucketLifecycle(ctx, &internalpb.GetBucketLifecycleRequest{
+			Header: header,
+			Bucket: []byte("testbucket"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, rules, getResp.Rules)
+
+		_, err = endpoint.SetBucketLifecycle(ctx, &internalpb.SetBucketLifecycleRequest{
+			Header: header,
+			Bucket: []byte("testbucket"),
+			Rules:  []*internalpb.LifecycleRule{{Id: "invalid"}},
+		})
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket.
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *internalpb.GetBucketLifecycleRequest) (resp *internalpb.GetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	rules, err := endpoint.metainfo.GetBucketLifecycle(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	resp = &internalpb.GetBucketLifecycleResponse{}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, &internalpb.LifecycleRule{
+			Id:                        rule.ID,
+			EncryptedPrefix:           rule.Prefix,
+			ExpireAfterDays:           int32(rule.ExpireAfterDays),
+			AbortPendingAfterHours:    int32(rule.AbortPendingAfterHours),
+			NoncurrentExpireAfterDays: int32(rule.NoncurrentExpireAfterDays),
+		})
+	}
+	return resp, nil
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket. Lifecycle rules
+// delete objects, hence both write and delete permissions are required.
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *internalpb.SetBucketLifecycleRequest) (resp *internalpb.SetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Bucket,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: req.Bucket,
+				Time:   now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	rules := make([]metabase.LifecycleRule, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = metabase.LifecycleRule{
+			ID:                        rule.Id,
+			Prefix:                    rule.EncryptedPrefix,
+			ExpireAfterDays:           int(rule.ExpireAfterDays),
+			AbortPendingAfterHours:    int(rule.AbortPendingAfterHours),
+			NoncurrentExpireAfterDays: int(rule.NoncurrentExpireAfterDays),
+		}
+	}
+
+	err = endpoint.metainfo.UpdateBucketLifecycle(ctx, req.Bucket, keyInfo.ProjectID, rules)
+	if err != nil {
+		if ErrInvalidLifecycle.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	endpoint.log.Info("Bucket Lifecycle", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Int("rules", len(rules)), zap.String("operation", "put"), zap.String("type", "bucket"))
+	mon.Meter("req_set_bucket_lifecycle").Mark(1)
+
+	return &internalpb.SetBucketLifecycleResponse{}, nil
+}
@@ -392,6 +392,9 @@ type CommitObject struct {
 	EncryptedMetadata             []byte
 	EncryptedMetadataNonce        []byte
 	EncryptedMetadataEncryptedKey []byte
+
+	// Retention is applied to the committed object (optional).
+	Retention Retention
 }
 
 // CommitObject adds a pending object to the database.
@@ -406,6 +409,10 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		return Object{}, ErrInvalidRequest.New("Encryption.BlockSize is negative or zero")
 	}
 
+	if err := opts.Retention.Verify(); err != nil {
+		return Object{}, err
+	}
+
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
 		segments, err := fetchSegmentsForCommit(ctx, tx, opts.StreamID)
 		if err != nil {
@@ -454,6 +461,9 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 				fixed_segment_size   = $12,
 				zombie_deletion_deadline = NULL,
 
+				retention_mode = $14,
+				retain_until   = $15,
+
 				-- TODO should we allow to override existing encryption parameters or return error if don't match with opts?
 				encryption = CASE
 					WHEN objects.encryption = 0 AND $13 <> 0 THEN $13
@@ -477,6 +487,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			totalEncryptedSize,
 			fixedSegmentSize,
 			encryptionParameters{&opts.Encryption},
+			opts.Retention.Mode, opts.Retention.RetainUntil,
 		).
 			Scan(
 				&object.CreatedAt, &object.ExpiresAt,
@@ -505,6 +516,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		object.TotalPlainSize = totalPlainSize
 		object.TotalEncryptedSize = totalEncryptedSize
 		object.FixedSegmentSize = fixedSegmentSize
+		object.Retention = opts.Retention
 		return nil
 	})
 	if err != nil {
@@ -550,7 +562,8 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 			object_key   = $3 AND
 			version      = $4 AND
 			stream_id    = $5 AND
-			status       = `+committedStatus,
+			status       = `+committedStatus+` AND
+			NOT `+objectLockedCondition,
 		opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID,
 		opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
 	if err != nil {
@@ -563,6 +576,9 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 	}
 
 	if affected == 0 {
+		if err := db.checkObjectLock(ctx, db.db, opts.Location(), opts.Version); err != nil {
+			return err
+		}
 		return storj.ErrObjectNotFound.Wrap(
 			Error.New("object with specified version and committed status is missing"),
 		)
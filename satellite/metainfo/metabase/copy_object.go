@@ -0,0 +1,415 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/private/dbutil/txutil"
+	"storj.io/storj/private/tagsql"
+)
+
+// BeginCopyObject contains arguments necessary for starting an object copy.
+type BeginCopyObject struct {
+	Version Version
+	ObjectLocation
+}
+
+// Verify verifies begin copy object fields.
+func (opts *BeginCopyObject) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// BeginCopyObject collects all data needed to begin an object copy procedure.
+func (db *DB) BeginCopyObject(ctx context.Context, opts BeginCopyObject) (result BeginMoveCopyResults, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return BeginMoveCopyResults{}, err
+	}
+
+	return db.beginMoveCopyObject(ctx, opts.ObjectLocation, opts.Version)
+}
+
+// FinishCopyObject contains arguments necessary for finishing an object copy.
+type FinishCopyObject struct {
+	ObjectStream
+
+	NewStreamID                  uuid.UUID
+	NewBucket                    string
+	NewSegmentKeys               []EncryptedKeyAndNonce
+	NewEncryptedObjectKey        ObjectKey
+	NewEncryptedMetadataKeyNonce []byte
+	NewEncryptedMetadataKey      []byte
+
+	// NewVersioned keeps the existing object in the new location as an older
+	// version, otherwise its latest committed version is replaced.
+	NewVersioned bool
+	// NewDisallowDelete fails the copy with ErrPermissionDenied, when an existing
+	// object in the new location would be replaced.
+	NewDisallowDelete bool
+
+	// NewRetention is applied to the copy, usually the default retention of
+	// the new bucket (optional).
+	NewRetention Retention
+
+	// DeletePieces is called with the segments of the replaced object after the
+	// copy has been committed.
+	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
+}
+
+// Verify verifies finish copy object fields.
+func (opts *FinishCopyObject) Verify() error {
+	if err := opts.ObjectStream.Verify(); err != nil {
+		return err
+	}
+
+	switch {
+	case opts.Version <= 0:
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	case opts.NewStreamID.IsZero():
+		return ErrInvalidRequest.New("NewStreamID is missing")
+	case opts.NewStreamID == opts.StreamID:
+		return ErrInvalidRequest.New("NewStreamID is the same as StreamID")
+	case len(opts.NewBucket) == 0:
+		return ErrInvalidRequest.New("NewBucket is missing")
+	case len(opts.NewEncryptedObjectKey) == 0:
+		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	case len(opts.NewEncryptedMetadataKey) == 0 && len(opts.NewEncryptedMetadataKeyNonce) > 0:
+		return ErrInvalidRequest.New("NewEncryptedMetadataKey is missing")
+	case len(opts.NewEncryptedMetadataKeyNonce) == 0 && len(opts.NewEncryptedMetadataKey) > 0:
+		return ErrInvalidRequest.New("NewEncryptedMetadataKeyNonce is missing")
+	}
+
+	if err := opts.NewRetention.Verify(); err != nil {
+		return err
+	}
+
+	return verifySegmentKeys(opts.NewSegmentKeys)
+}
+
+// FinishCopyObject creates a copy of an object at the new location. The copy
+// references the same pieces as the original object, only the segment keys
+// are replaced with the re-encrypted ones. An existing object in the new location
+// is replaced within the same transaction.
+func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	var replaced DeleteObjectResult
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		replaced, err = db.replaceMoveCopyTarget(ctx, tx, ObjectLocation{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.NewBucket,
+			ObjectKey:  opts.NewEncryptedObjectKey,
+		}, opts.NewVersioned, opts.NewDisallowDelete)
+		if err != nil {
+			return err
+		}
+
+		object = Object{}
+		err = tx.QueryRow(ctx, `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				expires_at, status, segment_count,
+				encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_metadata_nonce,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				zombie_deletion_deadline,
+				retention_mode, retain_until
+			)
+			SELECT
+				$1, $6, $7,
+				coalesce((
+					SELECT version + 1
+					FROM objects
+					WHERE project_id = $1 AND bucket_name = $6 AND object_key = $7
+					ORDER BY version DESC
+					LIMIT 1
+				), 1),
+				$8,
+				expires_at, status, segment_count,
+				encrypted_metadata, $9, $10,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				NULL,
+				$11, $12
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				stream_id    = $5 AND
+				status       = `+committedStatus+`
+			RETURNING
+				version, created_at, expires_at,
+				segment_count,
+				encrypted_metadata,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID,
+			[]byte(opts.NewBucket), []byte(opts.NewEncryptedObjectKey), opts.NewStreamID,
+			opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce,
+			opts.NewRetention.Mode, opts.NewRetention.RetainUntil).
+			Scan(
+				&object.Version, &object.CreatedAt, &object.ExpiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadata,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+			)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.Wrap(Error.New("object with specified version and committed status is missing"))
+			}
+			return Error.New("unable to copy object: %w", err)
+		}
+
+		if int(object.SegmentCount) != len(opts.NewSegmentKeys) {
+			return ErrInvalidRequest.New("wrong amount of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), object.SegmentCount)
+		}
+
+		if object.SegmentCount > 0 {
+			var positions []int64
+			var keys, nonces [][]byte
+			for _, key := range opts.NewSegmentKeys {
+				positions = append(positions, int64(key.Position.Encode()))
+				keys = append(keys, key.EncryptedKey)
+				nonces = append(nonces, key.EncryptedKeyNonce)
+			}
+
+			insertResult, err := tx.Exec(ctx, `
+				INSERT INTO segments (
+					stream_id, position,
+					root_piece_id, encrypted_key_nonce, encrypted_key,
+					encrypted_size, plain_offset, plain_size, encrypted_etag,
+					redundancy,
+					inline_data, remote_alias_pieces
+				)
+				SELECT
+					$2, segments.position,
+					segments.root_piece_id, P.encrypted_key_nonce, P.encrypted_key,
+					segments.encrypted_size, segments.plain_offset, segments.plain_size, segments.encrypted_etag,
+					segments.redundancy,
+					segments.inline_data, segments.remote_alias_pieces
+				FROM segments
+				JOIN (SELECT unnest($3::INT8[]), unnest($4::BYTEA[]), unnest($5::BYTEA[])) as P(position, encrypted_key_nonce, encrypted_key)
+					ON segments.position = P.position
+				WHERE segments.stream_id = $1
+			`, opts.StreamID, opts.NewStreamID, pgutil.Int8Array(positions), pgutil.ByteaArray(nonces), pgutil.ByteaArray(keys))
+			if err != nil {
+				return Error.New("unable to copy segments: %w", err)
+			}
+
+			affected, err := insertResult.RowsAffected()
+			if err != nil {
+				return Error.New("failed to get rows affected: %w", err)
+			}
+			if affected != int64(object.SegmentCount) {
+				return ErrInvalidRequest.New("segments and database does not match: %v != %v", affected, object.SegmentCount)
+			}
+
+			// All copies of an object are tracked against a single ancestor, so that
+			// the shared pieces are deleted only together with the last reference.
+			_, err = tx.Exec(ctx, `
+				INSERT INTO segment_copies (
+					stream_id, ancestor_stream_id
+				) VALUES (
+					$1, coalesce((SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $2), $2)
+				)
+			`, opts.NewStreamID, opts.StreamID)
+			if err != nil {
+				return Error.New("unable to insert segment copy: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	if opts.DeletePieces != nil && len(replaced.Segments) > 0 {
+		if err := opts.DeletePieces(ctx, replaced.Segments); err != nil {
+			return Object{}, Error.Wrap(err)
+		}
+	}
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.NewBucket
+	object.ObjectKey = opts.NewEncryptedObjectKey
+	object.StreamID = opts.NewStreamID
+	object.Status = Committed
+	object.EncryptedMetadataNonce = opts.NewEncryptedMetadataKeyNonce
+	object.EncryptedMetadataEncryptedKey = opts.NewEncryptedMetadataKey
+	object.Retention = opts.NewRetention
+
+	return object, nil
+}
+
+// releaseSegmentCopies removes the deleted streams from the copies bookkeeping
+// within the transaction that deleted them. When an ancestor is deleted one of
+// its remaining copies becomes the new ancestor.
+//
+// It returns root piece ids of segments that are still referenced by other
+// streams, their pieces must not be deleted from storage nodes.
+func (db *DB) releaseSegmentCopies(ctx context.Context, tx tagsql.Tx, deleted []uuid.UUID) (referenced map[storj.PieceID]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(deleted) == 0 {
+		return nil, nil
+	}
+
+	deletedIDs := make([][]byte, len(deleted))
+	isDeleted := make(map[uuid.UUID]bool, len(deleted))
+	for i, id := range deleted {
+		deletedIDs[i] = deleted[i][:]
+		isDeleted[id] = true
+	}
+
+	// ancestor stream id -> copies of the ancestor
+	groups := map[uuid.UUID][]uuid.UUID{}
+	err = withRows(tx.Query(ctx, `
+		SELECT stream_id, ancestor_stream_id
+		FROM segment_copies
+		WHERE
+			stream_id = ANY($1::BYTEA[]) OR
+			ancestor_stream_id = ANY($1::BYTEA[])
+	`, pgutil.ByteaArray(deletedIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID, ancestorID uuid.UUID
+			if err := rows.Scan(&streamID, &ancestorID); err != nil {
+				return Error.New("unable to scan segment copies: %w", err)
+			}
+			groups[ancestorID] = append(groups[ancestorID], streamID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM segment_copies
+		WHERE stream_id = ANY($1::BYTEA[])
+	`, pgutil.ByteaArray(deletedIDs))
+	if err != nil {
+		return nil, Error.New("unable to delete segment copies: %w", err)
+	}
+
+	var survivors [][]byte
+	for ancestorID, copies := range groups {
+		ancestorID := ancestorID
+		if !isDeleted[ancestorID] {
+			survivors = append(survivors, ancestorID[:])
+			continue
+		}
+
+		var promoted uuid.UUID
+		for _, copyID := range copies {
+			if !isDeleted[copyID] {
+				promoted = copyID
+				break
+			}
+		}
+		if promoted.IsZero() {
+			continue
+		}
+		survivors = append(survivors, promoted[:])
+
+		_, err = tx.Exec(ctx, `
+			DELETE FROM segment_copies WHERE stream_id = $1
+		`, promoted)
+		if err != nil {
+			return nil, Error.New("unable to promote segment copy: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE segment_copies SET ancestor_stream_id = $2
+			WHERE ancestor_stream_id = $1
+		`, ancestorID, promoted)
+		if err != nil {
+			return nil, Error.New("unable to promote segment copy: %w", err)
+		}
+	}
+
+	if len(survivors) == 0 {
+		return nil, nil
+	}
+
+	referenced = map[storj.PieceID]struct{}{}
+	err = withRows(tx.Query(ctx, `
+		SELECT root_piece_id
+		FROM segments
+		WHERE stream_id = ANY($1::BYTEA[])
+	`, pgutil.ByteaArray(survivors)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var rootPieceID storj.PieceID
+			if err := rows.Scan(&rootPieceID); err != nil {
+				return Error.New("unable to scan referenced segments: %w", err)
+			}
+			referenced[rootPieceID] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return referenced, nil
+}
+
+// withoutReferencedSegments releases the copies of the deleted objects and
+// filters out segments whose pieces are still referenced by other objects.
+func (db *DB) withoutReferencedSegments(ctx context.Context, tx tagsql.Tx, objects []Object, segments []DeletedSegmentInfo) (_ []DeletedSegmentInfo, err error) {
+	streamIDs := make([]uuid.UUID, 0, len(objects))
+	for _, object := range objects {
+		streamIDs = append(streamIDs, object.StreamID)
+	}
+
+	referenced, err := db.releaseSegmentCopies(ctx, tx, streamIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterReferencedSegments(segments, referenced), nil
+}
+
+// filterReferencedSegments removes segments which are still referenced.
+func filterReferencedSegments(segments []DeletedSegmentInfo, referenced map[storj.PieceID]struct{}) []DeletedSegmentInfo {
+	if len(referenced) == 0 {
+		return segments
+	}
+
+	filtered := segments[:0]
+	for _, segment := range segments {
+		if _, ok := referenced[segment.RootPieceID]; ok {
+			continue
+		}
+		filtered = append(filtered, segment)
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
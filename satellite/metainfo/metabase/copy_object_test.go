@@ -0,0 +1,155 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestFinishCopyObject(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+		newBucketName := "New bucket name"
+
+		newKeys := []metabase.EncryptedKeyAndNonce{
+			{Position: metabase.SegmentPosition{Index: 0}, EncryptedKeyNonce: []byte{10}, EncryptedKey: []byte{11}},
+			{Position: metabase.SegmentPosition{Index: 1}, EncryptedKeyNonce: []byte{12}, EncryptedKey: []byte{13}},
+		}
+
+		t.Run("new stream id missing", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: metabase.ObjectKey(testrand.Bytes(16)),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewStreamID is missing",
+			}.Check(ctx, t, db)
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("object missing", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewStreamID:           testrand.UUID(),
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: metabase.ObjectKey(testrand.Bytes(16)),
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object with specified version and committed status is missing",
+			}.Check(ctx, t, db)
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("copy shares pieces until last reference is deleted", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			createObject(ctx, t, db, obj, 2)
+
+			copyObject := FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewStreamID:           testrand.UUID(),
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: metabase.ObjectKey(testrand.Bytes(16)),
+					NewSegmentKeys:        newKeys,
+				},
+			}.Check(ctx, t, db)
+
+			originalObject := GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					Version:        1,
+					ObjectLocation: obj.Location(),
+				},
+			}
+
+			// deleting the original keeps the pieces, since the copy references them.
+			result, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				Version:        1,
+				ObjectLocation: originalObject.Opts.ObjectLocation,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result.Objects) != 1 || len(result.Segments) != 0 {
+				t.Fatalf("unexpected deletion result: %v", result)
+			}
+
+			// deleting the last reference returns the pieces for deletion.
+			DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					Version:        copyObject.Version,
+					ObjectLocation: copyObject.Location(),
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{copyObject},
+					Segments: []metabase.DeletedSegmentInfo{
+						{RootPieceID: storj.PieceID{1}, Pieces: metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}}},
+						{RootPieceID: storj.PieceID{1}, Pieces: metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}}},
+					},
+				},
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("copy gets the new retention", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			createObject(ctx, t, db, obj, 2)
+
+			retainUntil := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+			retention := metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: &retainUntil}
+
+			copyObject := FinishCopyObject{
+				Opts: metabase.FinishCopyObject{
+					ObjectStream:          obj,
+					NewStreamID:           testrand.UUID(),
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: metabase.ObjectKey(testrand.Bytes(16)),
+					NewSegmentKeys:        newKeys,
+					NewRetention:          retention,
+				},
+			}.Check(ctx, t, db)
+			require.Equal(t, retention, copyObject.Retention)
+
+			object, err := db.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+				Version:        copyObject.Version,
+				ObjectLocation: copyObject.Location(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, metabase.ComplianceMode, object.Retention.Mode)
+			require.NotNil(t, object.Retention.RetainUntil)
+			require.WithinDuration(t, retainUntil, *object.Retention.RetainUntil, time.Microsecond)
+
+			// the locked copy can't be deleted.
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				Version:        copyObject.Version,
+				ObjectLocation: copyObject.Location(),
+			})
+			require.True(t, metabase.ErrObjectLock.Has(err))
+
+			// the original object isn't locked.
+			_, err = db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				Version:        1,
+				ObjectLocation: obj.Location(),
+			})
+			require.NoError(t, err)
+		})
+	})
+}
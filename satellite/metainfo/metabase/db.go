@@ -79,6 +79,7 @@ func (db *DB) DestroyTables(ctx context.Context) error {
 	_, err := db.db.ExecContext(ctx, `
 		DROP TABLE IF EXISTS objects;
 		DROP TABLE IF EXISTS segments;
+		DROP TABLE IF EXISTS segment_copies;
 		DROP TABLE IF EXISTS node_aliases;
 		DROP SEQUENCE IF EXISTS node_alias_seq;
 	`)
@@ -307,6 +308,28 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE segments ADD COLUMN encrypted_etag BYTEA default NULL`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add segment_copies table",
+				Version:     10,
+				Action: migrate.SQL{
+					`CREATE TABLE segment_copies (
+						stream_id          BYTEA NOT NULL PRIMARY KEY,
+						ancestor_stream_id BYTEA NOT NULL
+					)`,
+					`CREATE INDEX segment_copies_ancestor_stream_id_index ON segment_copies (ancestor_stream_id)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add retention and legal hold columns to objects table",
+				Version:     11,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN retention_mode INT2 NOT NULL DEFAULT 0`,
+					`ALTER TABLE objects ADD COLUMN retain_until TIMESTAMPTZ`,
+					`ALTER TABLE objects ADD COLUMN legal_hold BOOLEAN NOT NULL DEFAULT false`,
+				},
+			},
 		},
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/dbutil"
 	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/private/dbutil/txutil"
 	"storj.io/storj/private/tagsql"
 )
 
@@ -99,7 +100,8 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 	if err := opts.Verify(); err != nil {
 		return DeleteObjectResult{}, err
 	}
-	err = withRows(db.db.Query(ctx, `
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = withRows(tx.Query(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
@@ -107,7 +109,8 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 					bucket_name  = $2 AND
 					object_key   = $3 AND
 					version      = $4 AND
-					status       = `+committedStatus+`
+					status       = `+committedStatus+` AND
+					NOT `+objectLockedCondition+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -131,7 +134,13 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
 		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		result.Segments, err = db.withoutReferencedSegments(ctx, tx, result.Objects, result.Segments)
 		return err
 	})
 	if err != nil {
@@ -139,6 +148,9 @@ func (db *DB) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 	}
 
 	if len(result.Objects) == 0 {
+		if err := db.checkObjectLock(ctx, db.db, opts.ObjectLocation, opts.Version); err != nil {
+			return DeleteObjectResult{}, err
+		}
 		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
 	}
 
@@ -176,7 +188,8 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(db.db.Query(ctx, `
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = withRows(tx.Query(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
@@ -209,7 +222,13 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
 		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		result.Segments, err = db.withoutReferencedSegments(ctx, tx, result.Objects, result.Segments)
 		return err
 	})
 
@@ -224,6 +243,20 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 	return result, nil
 }
 
+// newerLockedVersionQuery finds locked committed versions which aren't older than
+// the version that is being deleted, hence a locked latest version isn't skipped
+// in favor of an older one.
+const newerLockedVersionQuery = `
+	SELECT 1 FROM objects AS locked
+	WHERE
+		locked.project_id  = objects.project_id AND
+		locked.bucket_name = objects.bucket_name AND
+		locked.object_key  = objects.object_key AND
+		locked.version    >= objects.version AND
+		locked.status      = ` + committedStatus + ` AND
+		(locked.legal_hold OR (locked.retain_until IS NOT NULL AND locked.retain_until > now()))
+`
+
 // DeleteObjectLatestVersion deletes latest object version.
 func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLatestVersion) (result DeleteObjectResult, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -232,6 +265,29 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 		return DeleteObjectResult{}, err
 	}
 
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		result, err = db.deleteObjectLatestVersion(ctx, tx, opts.ObjectLocation)
+		return err
+	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	if len(result.Objects) == 0 {
+		if err := db.checkObjectLock(ctx, db.db, opts.ObjectLocation, 0); err != nil {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+	}
+
+	return result, nil
+}
+
+// deleteObjectLatestVersion deletes the latest committed object version within
+// the transaction. Copies of the deleted object are released.
+func (db *DB) deleteObjectLatestVersion(ctx context.Context, tx tagsql.Tx, location ObjectLocation) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	var query string
 	switch db.implementation {
 	case dbutil.Cockroach:
@@ -242,7 +298,8 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = $3 AND
-					status       = ` + committedStatus + `
+					status       = ` + committedStatus + ` AND
+					NOT EXISTS (` + newerLockedVersionQuery + `)
 				ORDER BY version DESC
 				LIMIT 1
 				RETURNING
@@ -285,7 +342,8 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 							status       = ` + committedStatus + `
 						ORDER BY version DESC LIMIT 1
 					) AND
-					status       = ` + committedStatus + `
+					status       = ` + committedStatus + ` AND
+					NOT EXISTS (` + newerLockedVersionQuery + `)
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -312,19 +370,18 @@ func (db *DB) DeleteObjectLatestVersion(ctx context.Context, opts DeleteObjectLa
 	default:
 		return DeleteObjectResult{}, Error.New("invalid dbType: %v", db.implementation)
 	}
-	err = withRows(db.db.Query(ctx, query, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+	err = withRows(tx.Query(ctx, query, location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey)))(func(rows tagsql.Rows) error {
+		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, location, rows)
 		return err
 	})
-
 	if err != nil {
 		return DeleteObjectResult{}, err
 	}
 
-	if len(result.Objects) == 0 {
-		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
+	result.Segments, err = db.withoutReferencedSegments(ctx, tx, result.Objects, result.Segments)
+	if err != nil {
+		return DeleteObjectResult{}, err
 	}
-
 	return result, nil
 }
 
@@ -336,13 +393,20 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 		return DeleteObjectResult{}, err
 	}
 
-	err = withRows(db.db.Query(ctx, `
+	err = db.checkObjectsLock(ctx, opts.Bucket(), [][]byte{[]byte(opts.ObjectKey)})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = withRows(tx.Query(ctx, `
 			WITH deleted_objects AS (
 				DELETE FROM objects
 				WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
-				object_key   = $3
+				object_key   = $3 AND
+				NOT `+objectLockedCondition+`
 				RETURNING
 					version, stream_id,
 					created_at, expires_at,
@@ -366,7 +430,13 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 			FROM deleted_objects
 			LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
 		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			result.Objects, result.Segments, err = db.scanObjectDeletion(ctx, opts.ObjectLocation, rows)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		result.Segments, err = db.withoutReferencedSegments(ctx, tx, result.Objects, result.Segments)
 		return err
 	})
 
@@ -408,14 +478,22 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 	sort.Slice(objectKeys, func(i, j int) bool {
 		return bytes.Compare(objectKeys[i], objectKeys[j]) < 0
 	})
-	err = withRows(db.db.Query(ctx, `
+
+	err = db.checkObjectsLock(ctx, opts.Locations[0].Bucket(), objectKeys)
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		err = withRows(tx.Query(ctx, `
 				WITH deleted_objects AS (
 					DELETE FROM objects
 					WHERE
 					project_id   = $1 AND
 					bucket_name  = $2 AND
 					object_key   = ANY ($3) AND
-					status       = `+committedStatus+`
+					status       = `+committedStatus+` AND
+					NOT `+objectLockedCondition+`
 					RETURNING
 						project_id, bucket_name,
 						object_key, version, stream_id,
@@ -441,7 +519,13 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 				FROM deleted_objects
 				LEFT JOIN deleted_segments ON deleted_objects.stream_id = deleted_segments.stream_id
 			`, projectID, []byte(bucketName), pgutil.ByteaArray(objectKeys)))(func(rows tagsql.Rows) error {
-		result.Objects, result.Segments, err = db.scanMultipleObjectsDeletion(ctx, rows)
+			result.Objects, result.Segments, err = db.scanMultipleObjectsDeletion(ctx, rows)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		result.Segments, err = db.withoutReferencedSegments(ctx, tx, result.Objects, result.Segments)
 		return err
 	})
 
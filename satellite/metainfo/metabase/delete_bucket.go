@@ -10,6 +10,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/private/dbutil"
+	"storj.io/storj/private/dbutil/txutil"
 	"storj.io/storj/private/tagsql"
 )
 
@@ -26,6 +27,7 @@ type DeleteBucketObjects struct {
 }
 
 // DeleteBucketObjects deletes all objects in the specified bucket.
+// It returns ErrObjectLock when the bucket contains locked objects.
 func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects) (deletedObjectCount int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -33,6 +35,12 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 		return 0, err
 	}
 
+	// locked objects would prevent the bucket from being deleted,
+	// hence nothing is deleted.
+	if err := db.checkObjectsLock(ctx, opts.Bucket, nil); err != nil {
+		return 0, err
+	}
+
 	batchSize := opts.BatchSize
 	if batchSize <= 0 || batchSize > deleteBatchSizeLimit {
 		batchSize = deleteBatchSizeLimit
@@ -44,7 +52,7 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 		query = `
 		WITH deleted_objects AS (
 			DELETE FROM objects
-			WHERE project_id = $1 AND bucket_name = $2 LIMIT $3
+			WHERE project_id = $1 AND bucket_name = $2 AND NOT ` + objectLockedCondition + ` LIMIT $3
 			RETURNING objects.stream_id
 		)
 		DELETE FROM segments
@@ -57,7 +65,7 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			DELETE FROM objects
 			WHERE stream_id IN (
 				SELECT stream_id FROM objects
-				WHERE project_id = $1 AND bucket_name = $2
+				WHERE project_id = $1 AND bucket_name = $2 AND NOT ` + objectLockedCondition + `
 				LIMIT $3
 			)
 			RETURNING objects.stream_id
@@ -73,28 +81,48 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 	// TODO: fix the count for objects without segments
 
 	var deleteSegments []DeletedSegmentInfo
+	var deletedStreamIDs []uuid.UUID
 	for {
-		deleteSegments = deleteSegments[:0]
-		err = withRows(db.db.Query(ctx, query,
-			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), batchSize))(func(rows tagsql.Rows) error {
-			ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
-			for rows.Next() {
-				var streamID uuid.UUID
-				var segment DeletedSegmentInfo
-				var aliasPieces AliasPieces
-				err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces)
-				if err != nil {
-					return Error.Wrap(err)
+		var objectCount int64
+		err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+			deleteSegments = deleteSegments[:0]
+			deletedStreamIDs = deletedStreamIDs[:0]
+			err = withRows(tx.Query(ctx, query,
+				opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), batchSize))(func(rows tagsql.Rows) error {
+				ids := map[uuid.UUID]struct{}{} // TODO: avoid map here
+				for rows.Next() {
+					var streamID uuid.UUID
+					var segment DeletedSegmentInfo
+					var aliasPieces AliasPieces
+					err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces)
+					if err != nil {
+						return Error.Wrap(err)
+					}
+					segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+					if err != nil {
+						return Error.Wrap(err)
+					}
+
+					ids[streamID] = struct{}{}
+					deleteSegments = append(deleteSegments, segment)
 				}
-				segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
-				if err != nil {
-					return Error.Wrap(err)
+				for id := range ids {
+					deletedStreamIDs = append(deletedStreamIDs, id)
 				}
+				objectCount = int64(len(ids))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
 
-				ids[streamID] = struct{}{}
-				deleteSegments = append(deleteSegments, segment)
+			// copies are released within the same transaction, so that the
+			// shared pieces aren't deleted while another object references them.
+			referenced, err := db.releaseSegmentCopies(ctx, tx, deletedStreamIDs)
+			if err != nil {
+				return err
 			}
-			deletedObjectCount += int64(len(ids))
+			deleteSegments = filterReferencedSegments(deleteSegments, referenced)
 			return nil
 		})
 		if err != nil {
@@ -103,11 +131,12 @@ func (db *DB) DeleteBucketObjects(ctx context.Context, opts DeleteBucketObjects)
 			}
 			return deletedObjectCount, Error.Wrap(err)
 		}
-		if len(deleteSegments) == 0 {
+		deletedObjectCount += objectCount
+		if objectCount == 0 {
 			return deletedObjectCount, nil
 		}
 
-		if opts.DeletePieces != nil {
+		if opts.DeletePieces != nil && len(deleteSegments) > 0 {
 			err = opts.DeletePieces(ctx, deleteSegments)
 			if err != nil {
 				return deletedObjectCount, Error.Wrap(err)
@@ -9,12 +9,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/jackc/pgx/v4"
-	"github.com/jackc/pgx/v4/stdlib"
-	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
 	"storj.io/storj/private/dbutil"
+	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/private/dbutil/txutil"
 	"storj.io/storj/private/tagsql"
 )
 
@@ -30,6 +30,7 @@ type DeleteExpiredObjects struct {
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
+// Locked objects are kept until their retention expires and legal hold is lifted.
 func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObjects) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -67,6 +68,7 @@ func (db *DB) deleteExpiredObjectsBatch(ctx context.Context, startAfter ObjectSt
 			WHERE
 				(project_id, bucket_name, object_key, version) > ($1, $2, $3, $4)
 				AND expires_at < $5
+				AND NOT ` + objectLockedCondition + `
 				ORDER BY project_id, bucket_name, object_key, version
 			LIMIT $6;`
 
@@ -118,60 +120,59 @@ func (db *DB) deleteExpiredObjects(ctx context.Context, expiredObjects []ObjectS
 		return nil
 	}
 
-	conn, err := db.db.Conn(ctx)
-	if err != nil {
-		return Error.New("unable to get the raw conn: %w", err)
+	projectIDs := make([][]byte, len(expiredObjects))
+	bucketNames := make([][]byte, len(expiredObjects))
+	objectKeys := make([][]byte, len(expiredObjects))
+	versions := make([]int64, len(expiredObjects))
+	streamIDs := make([][]byte, len(expiredObjects))
+	for i, obj := range expiredObjects {
+		obj := obj
+		projectIDs[i] = obj.ProjectID[:]
+		bucketNames[i] = []byte(obj.BucketName)
+		objectKeys[i] = []byte(obj.ObjectKey)
+		versions[i] = int64(obj.Version)
+		streamIDs[i] = obj.StreamID[:]
 	}
-	defer func() { err = errs.Combine(err, conn.Close()) }()
-
-	err = conn.Raw(ctx, func(driverConn interface{}) (err error) {
-		defer func() {
-			if closable, ok := driverConn.(interface{ Close() error }); ok {
-				err = errs.Combine(err, closable.Close())
-			}
-		}()
-
-		var pgxconn *pgx.Conn
-		switch conn := driverConn.(type) {
-		case interface{ StdlibConn() *stdlib.Conn }:
-			pgxconn = conn.StdlibConn().Conn()
-		case *stdlib.Conn:
-			pgxconn = conn.Conn()
-		default:
-			return Error.New("invalid raw conn driver %T", driverConn)
-		}
 
-		var batch pgx.Batch
-		for _, obj := range expiredObjects {
-			obj := obj
-
-			batch.Queue(`START TRANSACTION`)
-			batch.Queue(`
+	// Pieces of expired objects are collected by garbage collection, however
+	// copies of the expired objects need to be released in the same transaction.
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		var deleted []uuid.UUID
+		err = withRows(tx.Query(ctx, `
+			WITH deleted_objects AS (
 				DELETE FROM objects
-				WHERE (project_id, bucket_name, object_key, version) = ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4)
-					AND stream_id = $5::BYTEA
-			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
-			batch.Queue(`
+				WHERE
+					(project_id, bucket_name, object_key, version, stream_id) IN (
+						SELECT unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::BYTEA[]), unnest($4::INT8[]), unnest($5::BYTEA[])
+					) AND
+					NOT `+objectLockedCondition+`
+				RETURNING stream_id
+			), deleted_segments AS (
 				DELETE FROM segments
-				WHERE segments.stream_id = $1::BYTEA
-			`, obj.StreamID)
-			batch.Queue(`COMMIT TRANSACTION`)
-		}
-
-		results := pgxconn.SendBatch(ctx, &batch)
-		defer func() { err = errs.Combine(err, results.Close()) }()
-
-		var errlist errs.Group
-		for i := 0; i < batch.Len(); i++ {
-			_, err := results.Exec()
-			errlist.Add(err)
+				WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
+				RETURNING segments.stream_id
+			)
+			SELECT stream_id FROM deleted_objects
+		`, pgutil.ByteaArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys),
+			pgutil.Int8Array(versions), pgutil.ByteaArray(streamIDs)))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var streamID uuid.UUID
+				if err := rows.Scan(&streamID); err != nil {
+					return err
+				}
+				deleted = append(deleted, streamID)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
-		return errlist.Err()
+		_, err = db.releaseSegmentCopies(ctx, tx, deleted)
+		return err
 	})
 	if err != nil {
 		return Error.New("unable to delete expired objects: %w", err)
 	}
-
 	return nil
 }
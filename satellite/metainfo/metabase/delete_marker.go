@@ -0,0 +1,69 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+)
+
+// CreateDeleteMarker contains arguments necessary for deleting an object in a versioned bucket.
+type CreateDeleteMarker struct {
+	ObjectLocation
+}
+
+// CreateDeleteMarker hides the object by adding a delete marker as the latest version.
+// Older versions of the object are kept and can still be retrieved by version.
+func (db *DB) CreateDeleteMarker(ctx context.Context, opts CreateDeleteMarker) (marker Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	// delete markers don't have any data, however stream_id is still required
+	// to be unique for every version.
+	streamID, err := uuid.New()
+	if err != nil {
+		return Object{}, Error.Wrap(err)
+	}
+
+	marker = Object{
+		ObjectStream: ObjectStream{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.BucketName,
+			ObjectKey:  opts.ObjectKey,
+			StreamID:   streamID,
+		},
+		Status: DeleteMarker,
+	}
+
+	err = db.db.QueryRow(ctx, `
+		INSERT INTO objects (
+			project_id, bucket_name, object_key, version, stream_id,
+			status,
+			zombie_deletion_deadline
+		) VALUES (
+			$1, $2, $3,
+				coalesce((
+					SELECT version + 1
+					FROM objects
+					WHERE project_id = $1 AND bucket_name = $2 AND object_key = $3
+					ORDER BY version DESC
+					LIMIT 1
+				), 1),
+			$4,
+			`+deleteMarkerStatus+`,
+			NULL
+		)
+		RETURNING version, created_at
+	`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), streamID).
+		Scan(&marker.Version, &marker.CreatedAt)
+	if err != nil {
+		return Object{}, Error.New("unable to insert delete marker: %w", err)
+	}
+
+	return marker, nil
+}
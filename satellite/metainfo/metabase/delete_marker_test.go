@@ -0,0 +1,172 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestCreateDeleteMarker(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+
+		for _, test := range invalidObjectLocations(obj.Location()) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer DeleteAll{}.Check(ctx, t, db)
+				CreateDeleteMarker{
+					Opts: metabase.CreateDeleteMarker{
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+				Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("missing object", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: obj.Location(),
+				},
+				Version: 1,
+			}.Check(ctx, t, db)
+
+			GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object is deleted",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("hides older versions", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 1)
+
+			CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: obj.Location(),
+				},
+				Version: 2,
+			}.Check(ctx, t, db)
+
+			GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object is deleted",
+			}.Check(ctx, t, db)
+
+			GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					Version:        1,
+					ObjectLocation: obj.Location(),
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			// a new version makes the object visible again.
+			newer := obj
+			newer.Version = 3
+			newer.StreamID = testrand.UUID()
+			newObject := createObject(ctx, t, db, newer, 0)
+
+			GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				Result: newObject,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestIterateObjectsLatestVersion(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("only committed status", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  testrand.UUID(),
+					BucketName: "sj://mybucket",
+					Recursive:  true,
+					Status:     metabase.Pending,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Status 1 is not supported",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("older versions and delete markers", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			projectID, bucketName := testrand.UUID(), "bucket"
+
+			versioned := randObjectStream()
+			versioned.ProjectID = projectID
+			versioned.BucketName = bucketName
+			versioned.ObjectKey = "a"
+			createObject(ctx, t, db, versioned, 0)
+
+			versioned.Version = 2
+			versioned.StreamID = testrand.UUID()
+			latest := createObject(ctx, t, db, versioned, 0)
+
+			deleted := randObjectStream()
+			deleted.ProjectID = projectID
+			deleted.BucketName = bucketName
+			deleted.ObjectKey = "b"
+			createObject(ctx, t, db, deleted, 0)
+
+			CreateDeleteMarker{
+				Opts: metabase.CreateDeleteMarker{
+					ObjectLocation: deleted.Location(),
+				},
+				Version: 2,
+			}.Check(ctx, t, db)
+
+			IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  projectID,
+					BucketName: bucketName,
+					Recursive:  true,
+					Status:     metabase.Committed,
+				},
+				Result: []metabase.ObjectEntry{{
+					ObjectKey:  latest.ObjectKey,
+					Version:    latest.Version,
+					StreamID:   latest.StreamID,
+					CreatedAt:  latest.CreatedAt,
+					Status:     metabase.Committed,
+					Encryption: defaultTestEncryption,
+				}},
+			}.Check(ctx, t, db)
+
+			// cursor skips all the versions of the key.
+			IterateObjectsLatestVersion{
+				Opts: metabase.IterateObjectsWithStatus{
+					ProjectID:  projectID,
+					BucketName: bucketName,
+					Recursive:  true,
+					Status:     metabase.Committed,
+					Cursor:     metabase.IterateCursor{Key: "a", Version: 1},
+				},
+				Result: nil,
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -73,7 +73,8 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retention_mode, retain_until, legal_hold
 		FROM objects
 		WHERE
 			project_id   = $1 AND
@@ -89,6 +90,7 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.Retention.Mode, &object.Retention.RetainUntil, &object.LegalHold,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -121,31 +123,34 @@ func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVe
 		return Object{}, err
 	}
 
+	// the latest version is hidden when it's a delete marker.
 	object := Object{}
 	err = db.db.QueryRow(ctx, `
 		SELECT
-			stream_id, version,
+			stream_id, version, status,
 			created_at, expires_at,
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			retention_mode, retain_until, legal_hold
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
-			status       = `+committedStatus+`
+			status       IN (`+committedStatus+`, `+deleteMarkerStatus+`)
 		ORDER BY version desc
 		LIMIT 1
 	`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey)).
 		Scan(
-			&object.StreamID, &object.Version,
+			&object.StreamID, &object.Version, &object.Status,
 			&object.CreatedAt, &object.ExpiresAt,
 			&object.SegmentCount,
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&object.Retention.Mode, &object.Retention.RetainUntil, &object.LegalHold,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -154,12 +159,14 @@ func (db *DB) GetObjectLatestVersion(ctx context.Context, opts GetObjectLatestVe
 		return Object{}, Error.New("unable to query object status: %w", err)
 	}
 
+	if object.Status == DeleteMarker {
+		return Object{}, storj.ErrObjectNotFound.Wrap(Error.New("object is deleted"))
+	}
+
 	object.ProjectID = opts.ProjectID
 	object.BucketName = opts.BucketName
 	object.ObjectKey = opts.ObjectKey
 
-	object.Status = Committed
-
 	return object, nil
 }
 
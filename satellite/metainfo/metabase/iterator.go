@@ -32,6 +32,9 @@ type objectsIterator struct {
 	cursor          iterateCursor
 	inclusiveCursor bool
 
+	// latestOnly skips versions which have a newer committed version or delete marker.
+	latestOnly bool
+
 	skipPrefix  ObjectKey
 	doNextQuery func(context.Context, *objectsIterator) (_ tagsql.Rows, err error)
 }
@@ -108,6 +111,40 @@ func iterateAllVersionsWithStatus(ctx context.Context, db *DB, opts IterateObjec
 	return iterate(ctx, it, fn)
 }
 
+func iterateLatestVersion(ctx context.Context, db *DB, opts IterateObjectsWithStatus, fn func(context.Context, ObjectsIterator) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	it := &objectsIterator{
+		db: db,
+
+		projectID:       opts.ProjectID,
+		bucketName:      []byte(opts.BucketName),
+		status:          opts.Status,
+		prefix:          opts.Prefix,
+		prefixLimit:     prefixLimit(opts.Prefix),
+		batchSize:       opts.BatchSize,
+		recursive:       opts.Recursive,
+		includePrefixes: true,
+		latestOnly:      true,
+
+		curIndex: 0,
+		cursor: iterateCursor{
+			Key:     opts.Cursor.Key,
+			Version: MaxVersion,
+		},
+		doNextQuery: doNextQueryAllVersionsWithStatus,
+	}
+
+	// start from either the cursor or prefix, depending on which is larger
+	if lessKey(it.cursor.Key, opts.Prefix) {
+		it.cursor.Key = opts.Prefix
+		it.cursor.Version = -1
+		it.inclusiveCursor = true
+	}
+
+	return iterate(ctx, it, fn)
+}
+
 func iteratePendingObjectsByKey(ctx context.Context, db *DB, opts IteratePendingObjectsByKey, fn func(context.Context, ObjectsIterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -329,6 +366,7 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 				(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 				AND (project_id, bucket_name) < ($1, $7)
 				AND status = $3
+				`+latestVersionFilter(it)+`
 				ORDER BY (project_id, bucket_name, object_key, version) ASC
 			LIMIT $6
 			`, it.projectID, it.bucketName,
@@ -354,6 +392,7 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 			(project_id, bucket_name, object_key, version) `+cursorCompare+` ($1, $2, $4, $5)
 			AND (project_id, bucket_name, object_key) < ($1, $2, $6)
 			AND status = $3
+			`+latestVersionFilter(it)+`
 			ORDER BY (project_id, bucket_name, object_key, version) ASC
 		LIMIT $7
 	`, it.projectID, it.bucketName,
@@ -365,6 +404,23 @@ func doNextQueryAllVersionsWithStatus(ctx context.Context, it *objectsIterator)
 	)
 }
 
+// latestVersionFilter returns the condition for skipping versions hidden by
+// a newer version or delete marker, when the iterator lists only the latest versions.
+func latestVersionFilter(it *objectsIterator) string {
+	if !it.latestOnly {
+		return ""
+	}
+	return `AND NOT EXISTS (
+		SELECT 1 FROM objects AS newer
+		WHERE
+			newer.project_id  = objects.project_id AND
+			newer.bucket_name = objects.bucket_name AND
+			newer.object_key  = objects.object_key AND
+			newer.version     > objects.version AND
+			newer.status IN (` + committedStatus + `, ` + deleteMarkerStatus + `)
+	)`
+}
+
 // nextBucket returns the lexicographically next bucket.
 func nextBucket(b []byte) []byte {
 	xs := make([]byte, len(b)+1)
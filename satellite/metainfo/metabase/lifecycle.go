@@ -0,0 +1,85 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"time"
+)
+
+// MaxLifecycleRules is the maximum number of lifecycle rules of a bucket.
+const MaxLifecycleRules = 100
+
+// LifecycleRule defines actions applied by the lifecycle chore to objects
+// with the specified prefix. Zero values disable the corresponding action.
+type LifecycleRule struct {
+	ID string `json:"id"`
+	// Prefix is the encrypted object key prefix the rule applies to.
+	Prefix []byte `json:"prefix,omitempty"`
+
+	// ExpireAfterDays removes the current object version the specified number
+	// of days after it was created.
+	ExpireAfterDays int `json:"expireAfterDays,omitempty"`
+	// AbortPendingAfterHours removes pending objects the specified number of
+	// hours after the upload was started.
+	AbortPendingAfterHours int `json:"abortPendingAfterHours,omitempty"`
+	// NoncurrentExpireAfterDays removes object versions the specified number
+	// of days after they were replaced by a newer version or a delete marker.
+	NoncurrentExpireAfterDays int `json:"noncurrentExpireAfterDays,omitempty"`
+}
+
+// Verify verifies lifecycle rule fields.
+func (rule *LifecycleRule) Verify() error {
+	switch {
+	case rule.ID == "":
+		return ErrInvalidRequest.New("rule ID missing")
+	case len(rule.ID) > 255:
+		return ErrInvalidRequest.New("rule ID is too long")
+	case rule.ExpireAfterDays < 0:
+		return ErrInvalidRequest.New("ExpireAfterDays is negative")
+	case rule.AbortPendingAfterHours < 0:
+		return ErrInvalidRequest.New("AbortPendingAfterHours is negative")
+	case rule.NoncurrentExpireAfterDays < 0:
+		return ErrInvalidRequest.New("NoncurrentExpireAfterDays is negative")
+	case rule.ExpireAfterDays == 0 && rule.AbortPendingAfterHours == 0 && rule.NoncurrentExpireAfterDays == 0:
+		return ErrInvalidRequest.New("rule %q doesn't define any action", rule.ID)
+	}
+	return nil
+}
+
+// Expired returns whether a current object version created at the specified
+// time should be removed.
+func (rule *LifecycleRule) Expired(createdAt, now time.Time) bool {
+	return rule.ExpireAfterDays > 0 && !now.Before(createdAt.AddDate(0, 0, rule.ExpireAfterDays))
+}
+
+// PendingExpired returns whether a pending object created at the specified
+// time should be removed.
+func (rule *LifecycleRule) PendingExpired(createdAt, now time.Time) bool {
+	return rule.AbortPendingAfterHours > 0 && !now.Before(createdAt.Add(time.Duration(rule.AbortPendingAfterHours)*time.Hour))
+}
+
+// NoncurrentExpired returns whether an object version which was replaced at
+// the specified time should be removed.
+func (rule *LifecycleRule) NoncurrentExpired(replacedAt, now time.Time) bool {
+	return rule.NoncurrentExpireAfterDays > 0 && !now.Before(replacedAt.AddDate(0, 0, rule.NoncurrentExpireAfterDays))
+}
+
+// VerifyLifecycleRules verifies the lifecycle rules of a bucket.
+func VerifyLifecycleRules(rules []LifecycleRule) error {
+	if len(rules) > MaxLifecycleRules {
+		return ErrInvalidRequest.New("too many lifecycle rules: %d, limit is %d", len(rules), MaxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(rules))
+	for i := range rules {
+		if err := rules[i].Verify(); err != nil {
+			return err
+		}
+		if _, ok := ids[rules[i].ID]; ok {
+			return ErrInvalidRequest.New("duplicate rule ID %q", rules[i].ID)
+		}
+		ids[rules[i].ID] = struct{}{}
+	}
+	return nil
+}
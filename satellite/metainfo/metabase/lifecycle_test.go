@@ -0,0 +1,74 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestVerifyLifecycleRules(t *testing.T) {
+	for _, tc := range []struct {
+		rules   []metabase.LifecycleRule
+		errText string
+	}{
+		{rules: nil},
+		{rules: []metabase.LifecycleRule{{ID: "a", ExpireAfterDays: 1}, {ID: "b", AbortPendingAfterHours: 1}}},
+		{
+			rules:   []metabase.LifecycleRule{{ExpireAfterDays: 1}},
+			errText: "rule ID missing",
+		},
+		{
+			rules:   []metabase.LifecycleRule{{ID: "a"}},
+			errText: `rule "a" doesn't define any action`,
+		},
+		{
+			rules:   []metabase.LifecycleRule{{ID: "a", NoncurrentExpireAfterDays: -1}},
+			errText: "NoncurrentExpireAfterDays is negative",
+		},
+		{
+			rules:   []metabase.LifecycleRule{{ID: "a", ExpireAfterDays: 1}, {ID: "a", ExpireAfterDays: 2}},
+			errText: `duplicate rule ID "a"`,
+		},
+		{
+			rules:   make([]metabase.LifecycleRule, metabase.MaxLifecycleRules+1),
+			errText: "too many lifecycle rules: 101, limit is 100",
+		},
+	} {
+		err := metabase.VerifyLifecycleRules(tc.rules)
+		if tc.errText == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+		require.EqualError(t, err, metabase.ErrInvalidRequest.New("%s", tc.errText).Error())
+	}
+}
+
+func TestLifecycleRuleExpiration(t *testing.T) {
+	now := time.Now()
+	rule := metabase.LifecycleRule{
+		ID:                        "rule",
+		Prefix:                    []byte("logs/"),
+		ExpireAfterDays:           2,
+		AbortPendingAfterHours:    3,
+		NoncurrentExpireAfterDays: 1,
+	}
+
+	require.True(t, rule.Expired(now.AddDate(0, 0, -2), now))
+	require.False(t, rule.Expired(now.AddDate(0, 0, -1), now))
+
+	require.True(t, rule.PendingExpired(now.Add(-3*time.Hour), now))
+	require.False(t, rule.PendingExpired(now.Add(-2*time.Hour), now))
+
+	require.True(t, rule.NoncurrentExpired(now.AddDate(0, 0, -1), now))
+	require.False(t, rule.NoncurrentExpired(now.Add(-time.Hour), now))
+
+	// disabled actions never expire anything.
+	require.False(t, (&metabase.LifecycleRule{ID: "none"}).Expired(now.AddDate(-1, 0, 0), now))
+}
@@ -0,0 +1,360 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/private/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/private/dbutil/txutil"
+	"storj.io/storj/private/tagsql"
+)
+
+// ErrPermissionDenied is returned when an existing object in the new location
+// of a move or copy is not allowed to be replaced.
+var ErrPermissionDenied = errs.Class("metabase: permission denied")
+
+// EncryptedKeyAndNonce holds single segment encrypted key.
+type EncryptedKeyAndNonce struct {
+	Position          SegmentPosition
+	EncryptedKeyNonce []byte
+	EncryptedKey      []byte
+}
+
+// BeginMoveCopyResults holds all data needed to re-encrypt the keys of an object
+// that is going to be moved or copied.
+type BeginMoveCopyResults struct {
+	StreamID uuid.UUID
+	Version  Version
+
+	EncryptedMetadata         []byte
+	EncryptedMetadataKeyNonce []byte
+	EncryptedMetadataKey      []byte
+	EncryptedKeysNonces       []EncryptedKeyAndNonce
+	EncryptionParameters      storj.EncryptionParameters
+}
+
+// BeginMoveObject contains arguments necessary for starting an object move.
+type BeginMoveObject struct {
+	Version Version
+	ObjectLocation
+}
+
+// Verify verifies begin move object fields.
+func (opts *BeginMoveObject) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// BeginMoveObject collects all data needed to begin an object move procedure.
+func (db *DB) BeginMoveObject(ctx context.Context, opts BeginMoveObject) (result BeginMoveCopyResults, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return BeginMoveCopyResults{}, err
+	}
+
+	return db.beginMoveCopyObject(ctx, opts.ObjectLocation, opts.Version)
+}
+
+// beginMoveCopyObject returns the object and its segment keys, which need to be re-encrypted
+// by the client for the new location.
+func (db *DB) beginMoveCopyObject(ctx context.Context, location ObjectLocation, version Version) (result BeginMoveCopyResults, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := db.GetObjectExactVersion(ctx, GetObjectExactVersion{
+		Version:        version,
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return BeginMoveCopyResults{}, err
+	}
+
+	result.StreamID = object.StreamID
+	result.Version = object.Version
+	result.EncryptionParameters = object.Encryption
+	result.EncryptedMetadata = object.EncryptedMetadata
+	result.EncryptedMetadataKeyNonce = object.EncryptedMetadataNonce
+	result.EncryptedMetadataKey = object.EncryptedMetadataEncryptedKey
+
+	err = withRows(db.db.Query(ctx, `
+		SELECT
+			position, encrypted_key_nonce, encrypted_key
+		FROM segments
+		WHERE stream_id = $1
+		ORDER BY stream_id, position ASC
+	`, object.StreamID))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var keys EncryptedKeyAndNonce
+
+			err = rows.Scan(&keys.Position, &keys.EncryptedKeyNonce, &keys.EncryptedKey)
+			if err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+
+			result.EncryptedKeysNonces = append(result.EncryptedKeysNonces, keys)
+		}
+		return nil
+	})
+	if err != nil {
+		return BeginMoveCopyResults{}, Error.New("unable to fetch object segments: %w", err)
+	}
+
+	return result, nil
+}
+
+// FinishMoveObject contains arguments necessary for finishing an object move.
+type FinishMoveObject struct {
+	ObjectStream
+
+	NewBucket                    string
+	NewSegmentKeys               []EncryptedKeyAndNonce
+	NewEncryptedObjectKey        ObjectKey
+	NewEncryptedMetadataKeyNonce []byte
+	NewEncryptedMetadataKey      []byte
+
+	// NewVersioned keeps the existing object in the new location as an older
+	// version, otherwise its latest committed version is replaced.
+	NewVersioned bool
+	// NewDisallowDelete fails the move with ErrPermissionDenied, when an existing
+	// object in the new location would be replaced.
+	NewDisallowDelete bool
+
+	// DeletePieces is called with the segments of the replaced object after the
+	// move has been committed.
+	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
+}
+
+// Verify verifies finish move object fields.
+func (opts *FinishMoveObject) Verify() error {
+	if err := opts.ObjectStream.Verify(); err != nil {
+		return err
+	}
+
+	switch {
+	case opts.Version <= 0:
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	case len(opts.NewBucket) == 0:
+		return ErrInvalidRequest.New("NewBucket is missing")
+	case len(opts.NewEncryptedObjectKey) == 0:
+		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	case len(opts.NewEncryptedMetadataKey) == 0 && len(opts.NewEncryptedMetadataKeyNonce) > 0:
+		return ErrInvalidRequest.New("NewEncryptedMetadataKey is missing")
+	case len(opts.NewEncryptedMetadataKeyNonce) == 0 && len(opts.NewEncryptedMetadataKey) > 0:
+		return ErrInvalidRequest.New("NewEncryptedMetadataKeyNonce is missing")
+	}
+
+	return verifySegmentKeys(opts.NewSegmentKeys)
+}
+
+// FinishMoveObject moves an object to the new location and replaces the segment
+// keys with the re-encrypted ones. The pieces of the object are not touched.
+// An existing object in the new location is replaced within the same transaction.
+// It returns the object in the new location.
+func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (object Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return Object{}, err
+	}
+
+	var replaced DeleteObjectResult
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		replaced, err = db.replaceMoveCopyTarget(ctx, tx, ObjectLocation{
+			ProjectID:  opts.ProjectID,
+			BucketName: opts.NewBucket,
+			ObjectKey:  opts.NewEncryptedObjectKey,
+		}, opts.NewVersioned, opts.NewDisallowDelete)
+		if err != nil {
+			return err
+		}
+
+		object = Object{}
+		err = tx.QueryRow(ctx, `
+			UPDATE objects SET
+				bucket_name = $6,
+				object_key  = $7,
+				version     = coalesce((
+					SELECT version + 1
+					FROM objects
+					WHERE project_id = $1 AND bucket_name = $6 AND object_key = $7
+					ORDER BY version DESC
+					LIMIT 1
+				), 1),
+				encrypted_metadata_encrypted_key = $8,
+				encrypted_metadata_nonce         = $9
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				stream_id    = $5 AND
+				status       = `+committedStatus+` AND
+				NOT `+objectLockedCondition+`
+			RETURNING
+				version, created_at, expires_at,
+				segment_count,
+				encrypted_metadata,
+				total_plain_size, total_encrypted_size, fixed_segment_size,
+				encryption,
+				retention_mode, retain_until, legal_hold
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.StreamID,
+			[]byte(opts.NewBucket), []byte(opts.NewEncryptedObjectKey),
+			opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce).
+			Scan(
+				&object.Version, &object.CreatedAt, &object.ExpiresAt,
+				&object.SegmentCount,
+				&object.EncryptedMetadata,
+				&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
+				encryptionParameters{&object.Encryption},
+				&object.Retention.Mode, &object.Retention.RetainUntil, &object.LegalHold,
+			)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				if err := db.checkObjectLock(ctx, tx, opts.Location(), opts.Version); err != nil {
+					return err
+				}
+				return storj.ErrObjectNotFound.Wrap(Error.New("object with specified version and committed status is missing"))
+			}
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return ErrConflict.New("object already exists")
+			}
+			return Error.New("unable to update object: %w", err)
+		}
+
+		if int(object.SegmentCount) != len(opts.NewSegmentKeys) {
+			return ErrInvalidRequest.New("wrong amount of segments keys received (received %d, need %d)", len(opts.NewSegmentKeys), object.SegmentCount)
+		}
+
+		if object.SegmentCount == 0 {
+			return nil
+		}
+
+		var positions []int64
+		var keys, nonces [][]byte
+		for _, key := range opts.NewSegmentKeys {
+			positions = append(positions, int64(key.Position.Encode()))
+			keys = append(keys, key.EncryptedKey)
+			nonces = append(nonces, key.EncryptedKeyNonce)
+		}
+
+		updateResult, err := tx.Exec(ctx, `
+			UPDATE segments SET
+				encrypted_key_nonce = P.encrypted_key_nonce,
+				encrypted_key       = P.encrypted_key
+			FROM (SELECT unnest($2::INT8[]), unnest($3::BYTEA[]), unnest($4::BYTEA[])) as P(position, encrypted_key_nonce, encrypted_key)
+			WHERE
+				segments.stream_id = $1 AND
+				segments.position  = P.position
+		`, opts.StreamID, pgutil.Int8Array(positions), pgutil.ByteaArray(nonces), pgutil.ByteaArray(keys))
+		if err != nil {
+			return Error.New("unable to update segments: %w", err)
+		}
+
+		affected, err := updateResult.RowsAffected()
+		if err != nil {
+			return Error.New("failed to get rows affected: %w", err)
+		}
+		if affected != int64(object.SegmentCount) {
+			return ErrInvalidRequest.New("segments and database does not match: %v != %v", affected, object.SegmentCount)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Object{}, err
+	}
+
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.NewBucket
+	object.ObjectKey = opts.NewEncryptedObjectKey
+	object.StreamID = opts.StreamID
+	object.Status = Committed
+	object.EncryptedMetadataNonce = opts.NewEncryptedMetadataKeyNonce
+	object.EncryptedMetadataEncryptedKey = opts.NewEncryptedMetadataKey
+
+	if opts.DeletePieces != nil && len(replaced.Segments) > 0 {
+		if err := opts.DeletePieces(ctx, replaced.Segments); err != nil {
+			return Object{}, Error.Wrap(err)
+		}
+	}
+	return object, nil
+}
+
+// replaceMoveCopyTarget deletes the latest committed version of the object in
+// the new location of a move or copy within the transaction. Nothing is deleted
+// when the new location keeps older versions.
+func (db *DB) replaceMoveCopyTarget(ctx context.Context, tx tagsql.Tx, location ObjectLocation, versioned, disallowDelete bool) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versioned {
+		return DeleteObjectResult{}, nil
+	}
+
+	if disallowDelete {
+		var exists bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM objects
+				WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					status       = `+committedStatus+`
+			)
+		`, location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey)).Scan(&exists)
+		if err != nil {
+			return DeleteObjectResult{}, Error.New("unable to check existing object: %w", err)
+		}
+		if exists {
+			return DeleteObjectResult{}, ErrPermissionDenied.New("existing object cannot be replaced")
+		}
+		return DeleteObjectResult{}, nil
+	}
+
+	result, err = db.deleteObjectLatestVersion(ctx, tx, location)
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	if len(result.Objects) == 0 {
+		// the latest version may be kept because it's locked.
+		if err := db.checkObjectLock(ctx, tx, location, 0); err != nil {
+			return DeleteObjectResult{}, err
+		}
+	}
+	return result, nil
+}
+
+// verifySegmentKeys verifies that the re-encrypted segment keys are complete
+// and do not contain duplicate positions.
+func verifySegmentKeys(keys []EncryptedKeyAndNonce) error {
+	positions := make(map[SegmentPosition]struct{}, len(keys))
+	for _, key := range keys {
+		if len(key.EncryptedKey) == 0 {
+			return ErrInvalidRequest.New("EncryptedKey missing for segment %v", key.Position)
+		}
+		if len(key.EncryptedKeyNonce) == 0 {
+			return ErrInvalidRequest.New("EncryptedKeyNonce missing for segment %v", key.Position)
+		}
+		if _, ok := positions[key.Position]; ok {
+			return ErrInvalidRequest.New("duplicate segment position %v", key.Position)
+		}
+		positions[key.Position] = struct{}{}
+	}
+	return nil
+}
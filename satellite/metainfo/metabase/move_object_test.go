@@ -0,0 +1,269 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestBeginMoveObject(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+
+		for _, test := range invalidObjectLocations(obj.Location()) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer DeleteAll{}.Check(ctx, t, db)
+				BeginMoveObject{
+					Opts: metabase.BeginMoveObject{
+						Version:        1,
+						ObjectLocation: test.ObjectLocation,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+				Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("object missing", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			BeginMoveObject{
+				Opts: metabase.BeginMoveObject{
+					Version:        1,
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: sql: no rows in result set",
+			}.Check(ctx, t, db)
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("begin move object", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 2)
+
+			BeginMoveObject{
+				Opts: metabase.BeginMoveObject{
+					Version:        1,
+					ObjectLocation: obj.Location(),
+				},
+				Result: metabase.BeginMoveCopyResults{
+					StreamID:             obj.StreamID,
+					Version:              1,
+					EncryptionParameters: object.Encryption,
+					EncryptedKeysNonces: []metabase.EncryptedKeyAndNonce{
+						{Position: metabase.SegmentPosition{Index: 0}, EncryptedKeyNonce: []byte{4}, EncryptedKey: []byte{3}},
+						{Position: metabase.SegmentPosition{Index: 1}, EncryptedKeyNonce: []byte{4}, EncryptedKey: []byte{3}},
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestFinishMoveObject(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+		newBucketName := "New bucket name"
+		newObjectKey := metabase.ObjectKey(testrand.Bytes(16))
+
+		t.Run("new bucket name missing", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewEncryptedObjectKey: newObjectKey,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewBucket is missing",
+			}.Check(ctx, t, db)
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("wrong number of segment keys", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 2)
+
+			FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: newObjectKey,
+					NewSegmentKeys: []metabase.EncryptedKeyAndNonce{
+						{Position: metabase.SegmentPosition{Index: 0}, EncryptedKeyNonce: []byte{1}, EncryptedKey: []byte{2}},
+					},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "wrong amount of segments keys received (received 1, need 2)",
+			}.Check(ctx, t, db)
+
+			GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					Version:        1,
+					ObjectLocation: obj.Location(),
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("finish move object", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 2)
+
+			newKeys := []metabase.EncryptedKeyAndNonce{
+				{Position: metabase.SegmentPosition{Index: 0}, EncryptedKeyNonce: []byte{10}, EncryptedKey: []byte{11}},
+				{Position: metabase.SegmentPosition{Index: 1}, EncryptedKeyNonce: []byte{12}, EncryptedKey: []byte{13}},
+			}
+
+			moved := FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: newObjectKey,
+					NewSegmentKeys:        newKeys,
+				},
+			}.Check(ctx, t, db)
+			require.Equal(t, object.SegmentCount, moved.SegmentCount)
+			require.Equal(t, object.TotalEncryptedSize, moved.TotalEncryptedSize)
+
+			object.BucketName = newBucketName
+			object.ObjectKey = newObjectKey
+
+			GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					Version: 1,
+					ObjectLocation: metabase.ObjectLocation{
+						ProjectID:  obj.ProjectID,
+						BucketName: newBucketName,
+						ObjectKey:  newObjectKey,
+					},
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			BeginMoveObject{
+				Opts: metabase.BeginMoveObject{
+					Version:        1,
+					ObjectLocation: object.Location(),
+				},
+				Result: metabase.BeginMoveCopyResults{
+					StreamID:             obj.StreamID,
+					Version:              1,
+					EncryptionParameters: object.Encryption,
+					EncryptedKeysNonces:  newKeys,
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("replace existing object in new location", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			target := randObjectStream()
+			target.ProjectID = obj.ProjectID
+			target.BucketName = newBucketName
+			target.ObjectKey = newObjectKey
+			createObject(ctx, t, db, target, 2)
+
+			var deleted []metabase.DeletedSegmentInfo
+			FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: newObjectKey,
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						deleted = append(deleted, segments...)
+						return nil
+					},
+				},
+			}.Check(ctx, t, db)
+			require.Len(t, deleted, 2)
+
+			object.BucketName = newBucketName
+			object.ObjectKey = newObjectKey
+
+			Verify{
+				Objects: []metabase.RawObject{metabase.RawObject(object)},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("replace existing object disallowed", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			target := randObjectStream()
+			target.ProjectID = obj.ProjectID
+			target.BucketName = newBucketName
+			target.ObjectKey = newObjectKey
+			targetObject := createObject(ctx, t, db, target, 0)
+
+			FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: newObjectKey,
+					NewDisallowDelete:     true,
+				},
+				ErrClass: &metabase.ErrPermissionDenied,
+				ErrText:  "existing object cannot be replaced",
+			}.Check(ctx, t, db)
+
+			Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					metabase.RawObject(targetObject),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("keep existing object in versioned location", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			target := randObjectStream()
+			target.ProjectID = obj.ProjectID
+			target.BucketName = newBucketName
+			target.ObjectKey = newObjectKey
+			targetObject := createObject(ctx, t, db, target, 0)
+
+			FinishMoveObject{
+				Opts: metabase.FinishMoveObject{
+					ObjectStream:          obj,
+					NewBucket:             newBucketName,
+					NewEncryptedObjectKey: newObjectKey,
+					NewVersioned:          true,
+					NewDisallowDelete:     true,
+				},
+			}.Check(ctx, t, db)
+
+			object.BucketName = newBucketName
+			object.ObjectKey = newObjectKey
+			object.Version = targetObject.Version + 1
+
+			Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(targetObject),
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
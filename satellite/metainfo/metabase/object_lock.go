@@ -0,0 +1,312 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/private/dbutil/txutil"
+	"storj.io/storj/private/tagsql"
+)
+
+// ErrObjectLock is used to indicate that the object is protected by a retention
+// period or a legal hold and cannot be deleted or modified.
+var ErrObjectLock = errs.Class("metabase: object is locked")
+
+// objectLockedCondition matches objects which are protected by a legal hold or
+// by a retention period which hasn't expired yet.
+const objectLockedCondition = `(legal_hold OR (retain_until IS NOT NULL AND retain_until > now()))`
+
+// RetentionMode defines how an object retention can be lifted.
+type RetentionMode byte
+
+const (
+	// NoRetention means that the object isn't retained.
+	NoRetention = RetentionMode(0)
+	// GovernanceMode retention can be shortened or lifted with a governance override.
+	GovernanceMode = RetentionMode(1)
+	// ComplianceMode retention cannot be shortened or lifted until it expires.
+	ComplianceMode = RetentionMode(2)
+)
+
+// String returns a string representation of the retention mode.
+func (mode RetentionMode) String() string {
+	switch mode {
+	case NoRetention:
+		return "none"
+	case GovernanceMode:
+		return "governance"
+	case ComplianceMode:
+		return "compliance"
+	default:
+		return "unknown"
+	}
+}
+
+// Retention defines until when an object is protected from deletion and modification.
+type Retention struct {
+	Mode        RetentionMode
+	RetainUntil *time.Time
+}
+
+// Verify verifies retention fields.
+func (retention Retention) Verify() error {
+	switch retention.Mode {
+	case NoRetention:
+		if retention.RetainUntil != nil {
+			return ErrInvalidRequest.New("RetainUntil set without retention mode")
+		}
+	case GovernanceMode, ComplianceMode:
+		if retention.RetainUntil == nil || retention.RetainUntil.IsZero() {
+			return ErrInvalidRequest.New("RetainUntil missing")
+		}
+	default:
+		return ErrInvalidRequest.New("invalid retention mode: %d", retention.Mode)
+	}
+	return nil
+}
+
+// Active returns whether the retention protects the object at the specified time.
+func (retention Retention) Active(now time.Time) bool {
+	return retention.Mode != NoRetention && retention.RetainUntil != nil && retention.RetainUntil.After(now)
+}
+
+// IsLocked returns whether the object is protected from deletion and modification
+// at the specified time.
+func (obj *Object) IsLocked(now time.Time) bool {
+	return obj.LegalHold || obj.Retention.Active(now)
+}
+
+// SetObjectRetention contains arguments necessary for changing the retention of an object.
+type SetObjectRetention struct {
+	ObjectLocation
+	Version Version
+
+	Retention Retention
+
+	// BypassGovernance allows to shorten or lift a retention in governance mode.
+	BypassGovernance bool
+}
+
+// Verify verifies set object retention fields.
+func (opts *SetObjectRetention) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return opts.Retention.Verify()
+}
+
+// SetObjectRetention changes the retention of a committed object version.
+//
+// An active retention can always be extended. Retention in governance mode can
+// only be shortened or lifted with BypassGovernance, and retention in compliance
+// mode cannot be shortened or lifted at all.
+func (db *DB) SetObjectRetention(ctx context.Context, opts SetObjectRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		var current Retention
+		var now time.Time
+		err = tx.QueryRow(ctx, `
+			SELECT retention_mode, retain_until, now()
+			FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       = `+committedStatus+`
+			FOR UPDATE
+		`, opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version).
+			Scan(&current.Mode, &current.RetainUntil, &now)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storj.ErrObjectNotFound.Wrap(Error.New("object with specified version and committed status is missing"))
+			}
+			return Error.New("unable to query object retention: %w", err)
+		}
+
+		if err := verifyRetentionChange(current, opts.Retention, opts.BypassGovernance, now); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE objects SET
+				retention_mode = $5,
+				retain_until   = $6
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				object_key   = $3 AND
+				version      = $4 AND
+				status       = `+committedStatus,
+			opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version,
+			opts.Retention.Mode, opts.Retention.RetainUntil)
+		if err != nil {
+			return Error.New("unable to update object retention: %w", err)
+		}
+		return nil
+	})
+}
+
+// verifyRetentionChange checks whether the active retention can be replaced with the new one.
+func verifyRetentionChange(current, next Retention, bypassGovernance bool, now time.Time) error {
+	if !current.Active(now) {
+		return nil
+	}
+
+	shortened := next.RetainUntil == nil || next.RetainUntil.Before(*current.RetainUntil)
+
+	switch current.Mode {
+	case ComplianceMode:
+		if shortened || next.Mode != ComplianceMode {
+			return ErrObjectLock.New("retention in compliance mode cannot be shortened or lifted")
+		}
+	case GovernanceMode:
+		if (shortened || next.Mode == NoRetention) && !bypassGovernance {
+			return ErrObjectLock.New("retention in governance mode can be shortened or lifted only with governance override")
+		}
+	}
+	return nil
+}
+
+// SetObjectLegalHold contains arguments necessary for placing or lifting a legal hold.
+type SetObjectLegalHold struct {
+	ObjectLocation
+	Version Version
+
+	Enabled bool
+}
+
+// Verify verifies set object legal hold fields.
+func (opts *SetObjectLegalHold) Verify() error {
+	if err := opts.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if opts.Version <= 0 {
+		return ErrInvalidRequest.New("Version invalid: %v", opts.Version)
+	}
+	return nil
+}
+
+// SetObjectLegalHold places or lifts a legal hold of a committed object version.
+func (db *DB) SetObjectLegalHold(ctx context.Context, opts SetObjectLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE objects SET
+			legal_hold = $5
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			status       = `+committedStatus,
+		opts.ProjectID, []byte(opts.BucketName), []byte(opts.ObjectKey), opts.Version, opts.Enabled)
+	if err != nil {
+		return Error.New("unable to update object legal hold: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("failed to get rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return storj.ErrObjectNotFound.Wrap(
+			Error.New("object with specified version and committed status is missing"),
+		)
+	}
+
+	return nil
+}
+
+// queryRower is the part of tagsql.DB and tagsql.Tx needed for checking object locks.
+type queryRower interface {
+	QueryRow(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// checkObjectLock returns ErrObjectLock when the committed object version is locked.
+// When version is zero, the latest committed version is checked. Callers within
+// a transaction pass the transaction, so that the check sees its changes.
+func (db *DB) checkObjectLock(ctx context.Context, q queryRower, location ObjectLocation, version Version) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var locked bool
+	err = q.QueryRow(ctx, `
+		SELECT `+objectLockedCondition+`
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			($4 = 0 OR version = $4) AND
+			status       = `+committedStatus+`
+		ORDER BY version DESC
+		LIMIT 1
+	`, location.ProjectID, []byte(location.BucketName), []byte(location.ObjectKey), version).
+		Scan(&locked)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Error.New("unable to check object lock: %w", err)
+	}
+
+	if locked {
+		return ErrObjectLock.New("object is protected by retention or legal hold")
+	}
+	return nil
+}
+
+// checkObjectsLock returns ErrObjectLock when any committed version of the objects is locked.
+// When objectKeys is nil, all objects in the bucket are checked.
+func (db *DB) checkObjectsLock(ctx context.Context, bucket BucketLocation, objectKeys [][]byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var keysArg interface{}
+	if objectKeys != nil {
+		keysArg = pgutil.ByteaArray(objectKeys)
+	}
+
+	var locked bool
+	err = db.db.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM objects
+			WHERE
+				project_id   = $1 AND
+				bucket_name  = $2 AND
+				($3::BYTEA[] IS NULL OR object_key = ANY ($3::BYTEA[])) AND
+				status       = `+committedStatus+` AND
+				`+objectLockedCondition+`
+		)
+	`, bucket.ProjectID, []byte(bucket.BucketName), keysArg).
+		Scan(&locked)
+	if err != nil {
+		return Error.New("unable to check object lock: %w", err)
+	}
+
+	if locked {
+		return ErrObjectLock.New("objects are protected by retention or legal hold")
+	}
+	return nil
+}
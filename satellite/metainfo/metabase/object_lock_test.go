@@ -0,0 +1,454 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestSetObjectRetention(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+
+		now := time.Now()
+		retainUntil := now.Add(time.Hour)
+		shorter := now.Add(time.Minute)
+		longer := now.Add(2 * time.Hour)
+
+		for _, test := range invalidObjectLocations(obj.Location()) {
+			test := test
+			t.Run(test.Name, func(t *testing.T) {
+				defer DeleteAll{}.Check(ctx, t, db)
+				SetObjectRetention{
+					Opts: metabase.SetObjectRetention{
+						ObjectLocation: test.ObjectLocation,
+						Version:        1,
+					},
+					ErrClass: test.ErrClass,
+					ErrText:  test.ErrText,
+				}.Check(ctx, t, db)
+				Verify{}.Check(ctx, t, db)
+			})
+		}
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Version invalid: 0",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        1,
+					Retention:      metabase.Retention{Mode: metabase.GovernanceMode},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "RetainUntil missing",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        1,
+					Retention:      metabase.Retention{RetainUntil: &retainUntil},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "RetainUntil set without retention mode",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        1,
+					Retention:      metabase.Retention{Mode: 3, RetainUntil: &retainUntil},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "invalid retention mode: 3",
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("missing object", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        1,
+					Retention:      metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &retainUntil},
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object with specified version and committed status is missing",
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("governance mode", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Retention:      metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &retainUntil},
+				},
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Retention:      metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &shorter},
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  "retention in governance mode can be shortened or lifted only with governance override",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  "retention in governance mode can be shortened or lifted only with governance override",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Retention:      metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &longer},
+				},
+			}.Check(ctx, t, db)
+
+			object.Retention = metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &longer}
+			GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation:   obj.Location(),
+					Version:          obj.Version,
+					BypassGovernance: true,
+				},
+			}.Check(ctx, t, db)
+
+			object.Retention = metabase.Retention{}
+			GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("compliance mode", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Retention:      metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: &retainUntil},
+				},
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation:   obj.Location(),
+					Version:          obj.Version,
+					BypassGovernance: true,
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  "retention in compliance mode cannot be shortened or lifted",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation:   obj.Location(),
+					Version:          obj.Version,
+					Retention:        metabase.Retention{Mode: metabase.GovernanceMode, RetainUntil: &longer},
+					BypassGovernance: true,
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  "retention in compliance mode cannot be shortened or lifted",
+			}.Check(ctx, t, db)
+
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Retention:      metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: &longer},
+				},
+			}.Check(ctx, t, db)
+
+			object.Retention = metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: &longer}
+			GetObjectExactVersion{
+				Opts: metabase.GetObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestSetObjectLegalHold(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+
+		t.Run("missing object", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: obj.Location(),
+					Version:        1,
+					Enabled:        true,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object with specified version and committed status is missing",
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("place and lift", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Enabled:        true,
+				},
+			}.Check(ctx, t, db)
+
+			object.LegalHold = true
+			GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+
+			SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Enabled:        false,
+				},
+			}.Check(ctx, t, db)
+
+			object.LegalHold = false
+			GetObjectLatestVersion{
+				Opts: metabase.GetObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				Result: object,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestObjectLockPreventsDeletion(t *testing.T) {
+	All(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := randObjectStream()
+
+		lockedErrText := "object is protected by retention or legal hold"
+		lockedObjectsErrText := "objects are protected by retention or legal hold"
+
+		placeLegalHold := func(t *testing.T, obj metabase.ObjectStream, enabled bool) {
+			SetObjectLegalHold{
+				Opts: metabase.SetObjectLegalHold{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Enabled:        enabled,
+				},
+			}.Check(ctx, t, db)
+		}
+
+		t.Run("legal hold", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+			placeLegalHold(t, obj, true)
+
+			DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedErrText,
+			}.Check(ctx, t, db)
+
+			DeleteObjectLatestVersion{
+				Opts: metabase.DeleteObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedErrText,
+			}.Check(ctx, t, db)
+
+			DeleteObjectAnyStatusAllVersions{
+				Opts: metabase.DeleteObjectAnyStatusAllVersions{
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedObjectsErrText,
+			}.Check(ctx, t, db)
+
+			DeleteObjectsAllVersions{
+				Opts: metabase.DeleteObjectsAllVersions{
+					Locations: []metabase.ObjectLocation{obj.Location()},
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedObjectsErrText,
+			}.Check(ctx, t, db)
+
+			DeleteBucketObjects{
+				Opts: metabase.DeleteBucketObjects{
+					Bucket: obj.Location().Bucket(),
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedObjectsErrText,
+			}.Check(ctx, t, db)
+
+			UpdateObjectMetadata{
+				Opts: metabase.UpdateObjectMetadata{
+					ObjectStream:      obj,
+					EncryptedMetadata: []byte{1},
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedErrText,
+			}.Check(ctx, t, db)
+
+			placeLegalHold(t, obj, false)
+
+			object.LegalHold = false
+			DeleteObjectExactVersion{
+				Opts: metabase.DeleteObjectExactVersion{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("expired retention", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createObject(ctx, t, db, obj, 0)
+
+			retainUntil := time.Now().Add(-time.Hour)
+			SetObjectRetention{
+				Opts: metabase.SetObjectRetention{
+					ObjectLocation: obj.Location(),
+					Version:        obj.Version,
+					Retention:      metabase.Retention{Mode: metabase.ComplianceMode, RetainUntil: &retainUntil},
+				},
+			}.Check(ctx, t, db)
+
+			DeleteObjectLatestVersion{
+				Opts: metabase.DeleteObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("locked latest version", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			older := createObject(ctx, t, db, obj, 0)
+
+			latestObj := obj
+			latestObj.Version = obj.Version + 1
+			latest := createObject(ctx, t, db, latestObj, 0)
+			placeLegalHold(t, latestObj, true)
+
+			// the older unlocked version must not be deleted instead.
+			DeleteObjectLatestVersion{
+				Opts: metabase.DeleteObjectLatestVersion{
+					ObjectLocation: obj.Location(),
+				},
+				ErrClass: &metabase.ErrObjectLock,
+				ErrText:  lockedErrText,
+			}.Check(ctx, t, db)
+
+			latest.LegalHold = true
+			Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(older),
+					metabase.RawObject(latest),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("expired object with legal hold", func(t *testing.T) {
+			defer DeleteAll{}.Check(ctx, t, db)
+
+			object := createExpiredObject(ctx, t, db, obj, 0, time.Now().Add(-time.Hour))
+			placeLegalHold(t, obj, true)
+
+			DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: time.Now(),
+				},
+			}.Check(ctx, t, db)
+
+			object.LegalHold = true
+			Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+
+			placeLegalHold(t, obj, false)
+
+			DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: time.Now(),
+				},
+			}.Check(ctx, t, db)
+
+			Verify{}.Check(ctx, t, db)
+		})
+	})
+}
@@ -39,6 +39,11 @@ type RawObject struct {
 	// This is as a safeguard against objects that failed to upload and the client has not indicated
 	// whether they want to continue uploading or delete the already uploaded data.
 	ZombieDeletionDeadline *time.Time
+
+	// Retention protects the object from deletion and modification until it expires.
+	Retention Retention
+	// LegalHold protects the object from deletion and modification until it's lifted.
+	LegalHold bool
 }
 
 // RawSegment defines the full segment that is stored in the database. It should be rarely used directly.
@@ -94,6 +99,7 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 	_, err = db.db.ExecContext(ctx, `
 		DELETE FROM objects;
 		DELETE FROM segments;
+		DELETE FROM segment_copies;
 		DELETE FROM node_aliases;
 		SELECT setval('node_alias_seq', 1, false);
 	`)
@@ -113,7 +119,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
 			encryption,
-			zombie_deletion_deadline
+			zombie_deletion_deadline,
+			retention_mode, retain_until, legal_hold
 		FROM objects
 		ORDER BY project_id ASC, bucket_name ASC, object_key ASC, version ASC
 	`)
@@ -146,6 +153,8 @@ func (db *DB) testingGetAllObjects(ctx context.Context) (_ []RawObject, err erro
 
 			encryptionParameters{&obj.Encryption},
 			&obj.ZombieDeletionDeadline,
+
+			&obj.Retention.Mode, &obj.Retention.RetainUntil, &obj.LegalHold,
 		)
 		if err != nil {
 			return nil, Error.New("testingGetAllObjects scan failed: %w", err)
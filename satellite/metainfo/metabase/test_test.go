@@ -759,3 +759,124 @@ func invalidSegmentLocations(base metabase.SegmentLocation) []invalidSegmentLoca
 
 	return tests
 }
+
+type BeginMoveObject struct {
+	Opts     metabase.BeginMoveObject
+	Result   metabase.BeginMoveCopyResults
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step BeginMoveObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BeginMoveObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
+type FinishMoveObject struct {
+	Opts     metabase.FinishMoveObject
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step FinishMoveObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	object, err := db.FinishMoveObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	if err == nil {
+		require.Equal(t, step.Opts.StreamID, object.StreamID)
+		require.Equal(t, step.Opts.NewBucket, object.BucketName)
+		require.Equal(t, step.Opts.NewEncryptedObjectKey, object.ObjectKey)
+	}
+	return object
+}
+
+type BeginCopyObject struct {
+	Opts     metabase.BeginCopyObject
+	Result   metabase.BeginMoveCopyResults
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step BeginCopyObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BeginCopyObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
+type FinishCopyObject struct {
+	Opts     metabase.FinishCopyObject
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step FinishCopyObject) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	object, err := db.FinishCopyObject(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	if err == nil {
+		require.Equal(t, step.Opts.NewStreamID, object.StreamID)
+		require.Equal(t, step.Opts.NewBucket, object.BucketName)
+		require.Equal(t, step.Opts.NewEncryptedObjectKey, object.ObjectKey)
+	}
+	return object
+}
+
+type CreateDeleteMarker struct {
+	Opts     metabase.CreateDeleteMarker
+	Version  metabase.Version
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step CreateDeleteMarker) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.Object {
+	marker, err := db.CreateDeleteMarker(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+	if err == nil {
+		require.Equal(t, step.Version, marker.Version)
+		require.Equal(t, metabase.DeleteMarker, marker.Status)
+	}
+	return marker
+}
+
+type IterateObjectsLatestVersion struct {
+	Opts metabase.IterateObjectsWithStatus
+
+	Result   []metabase.ObjectEntry
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step IterateObjectsLatestVersion) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	var result IterateCollector
+
+	err := db.IterateObjectsLatestVersion(ctx, step.Opts, result.Add)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, []metabase.ObjectEntry(result), cmpopts.EquateApproxTime(5*time.Second))
+	require.Zero(t, diff)
+}
+
+type SetObjectRetention struct {
+	Opts     metabase.SetObjectRetention
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step SetObjectRetention) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.SetObjectRetention(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+type SetObjectLegalHold struct {
+	Opts     metabase.SetObjectLegalHold
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+func (step SetObjectLegalHold) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	err := db.SetObjectLegalHold(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
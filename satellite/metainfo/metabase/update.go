@@ -13,6 +13,8 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/dbutil/txutil"
+	"storj.io/storj/private/tagsql"
 	"storj.io/storj/storage"
 )
 
@@ -74,8 +76,11 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 		return Error.New("unable to convert pieces to aliases: %w", err)
 	}
 
-	var resultPieces AliasPieces
-	err = db.db.QueryRow(ctx, `
+	// Copies of the object reference the same pieces, so they need to follow
+	// the changes, e.g. after repair, within the same transaction.
+	return txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		var resultPieces AliasPieces
+		err = tx.QueryRow(ctx, `
 		UPDATE segments SET
 			remote_alias_pieces = CASE
 				WHEN remote_alias_pieces = $3 THEN $4
@@ -94,17 +99,42 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 			position      = $2
 		RETURNING remote_alias_pieces
 		`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt).
-		Scan(&resultPieces)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrSegmentNotFound.New("segment missing")
+			Scan(&resultPieces)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrSegmentNotFound.New("segment missing")
+			}
+			return Error.New("unable to update segment pieces: %w", err)
 		}
-		return Error.New("unable to update segment pieces: %w", err)
-	}
 
-	if !EqualAliasPieces(newPieces, resultPieces) {
-		return storage.ErrValueChanged.New("segment remote_alias_pieces field was changed")
-	}
+		if !EqualAliasPieces(newPieces, resultPieces) {
+			return storage.ErrValueChanged.New("segment remote_alias_pieces field was changed")
+		}
+
+		_, err = tx.ExecContext(ctx, `
+		WITH ancestor AS (
+			SELECT coalesce((SELECT ancestor_stream_id FROM segment_copies WHERE stream_id = $1), $1) AS stream_id
+		)
+		UPDATE segments SET
+			remote_alias_pieces = $4,
+			redundancy          = $5,
+			repaired_at         = CASE
+				WHEN $7 = true THEN $6
+				ELSE repaired_at
+			END
+		WHERE
+			stream_id <> $1 AND
+			position   = $2 AND
+			remote_alias_pieces = $3 AND
+			(
+				stream_id IN (SELECT stream_id FROM ancestor) OR
+				stream_id IN (SELECT stream_id FROM segment_copies WHERE ancestor_stream_id IN (SELECT stream_id FROM ancestor))
+			)
+		`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt)
+		if err != nil {
+			return Error.New("unable to update segment copies pieces: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
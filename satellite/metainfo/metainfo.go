@@ -24,6 +24,7 @@ import (
 	"storj.io/common/uuid"
 	lrucache "storj.io/storj/pkg/cache"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/apikeyusage"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/internalpb"
@@ -73,10 +74,12 @@ type Endpoint struct {
 	partners             *rewards.PartnersService
 	pointerVerification  *pointerverification.Service
 	projectUsage         *accounting.Service
+	apiKeyUsage          *apikeyusage.Service
 	projects             console.Projects
 	apiKeys              APIKeys
 	satellite            signing.Signer
 	limiterCache         *lrucache.ExpiringLRU
+	keyLimiterCache      *lrucache.ExpiringLRU
 	encInlineSegmentSize int64 // max inline segment size + encryption overhead
 	revocations          revocation.DB
 	defaultRS            *pb.RedundancyScheme
@@ -88,7 +91,7 @@ type Endpoint struct {
 func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion.Service,
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB,
 	partners *rewards.PartnersService, peerIdentities overlay.PeerIdentities,
-	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects,
+	apiKeys APIKeys, projectUsage *accounting.Service, apiKeyUsage *apikeyusage.Service, projects console.Projects,
 	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
@@ -120,12 +123,17 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, deletePieces *piecedeletion
 		pointerVerification: pointerverification.NewService(peerIdentities),
 		apiKeys:             apiKeys,
 		projectUsage:        projectUsage,
+		apiKeyUsage:         apiKeyUsage,
 		projects:            projects,
 		satellite:           satellite,
 		limiterCache: lrucache.New(lrucache.Options{
 			Capacity:   config.RateLimiter.CacheCapacity,
 			Expiration: config.RateLimiter.CacheExpiration,
 		}),
+		keyLimiterCache: lrucache.New(lrucache.Options{
+			Capacity:   config.RateLimiter.KeyCacheCapacity,
+			Expiration: config.RateLimiter.CacheExpiration,
+		}),
 		encInlineSegmentSize: encInlineSegmentSize,
 		revocations:          revocations,
 		defaultRS:            defaultRSScheme,
@@ -375,6 +383,9 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 func (endpoint *Endpoint) deleteBucketNotEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]byte, int64, error) {
 	deletedCount, err := endpoint.deleteBucketObjects(ctx, projectID, bucketName)
 	if err != nil {
+		if metabase.ErrObjectLock.Has(err) {
+			return nil, 0, endpoint.convertObjectLockError(err)
+		}
 		return nil, 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
@@ -585,41 +596,48 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
-	exists, err := endpoint.metainfo.HasBucket(ctx, req.Bucket, keyInfo.ProjectID)
+	versioning, err := endpoint.getBucketVersioning(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
-		endpoint.log.Error("unable to check bucket", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if !exists {
-		return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		return nil, err
 	}
 
-	_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:            macaroon.ActionDelete,
-		Bucket:        req.Bucket,
-		EncryptedPath: req.EncryptedPath,
-		Time:          time.Now(),
-	})
-	canDelete := err == nil
+	location := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+	}
 
-	if canDelete {
-		_, err = endpoint.DeleteObjectAnyStatus(ctx, metabase.ObjectLocation{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-		})
-		if err != nil && !storj.ErrObjectNotFound.Has(err) {
-			return nil, err
-		}
-	} else {
-		_, err = endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
-			ObjectLocation: metabase.ObjectLocation{
-				ProjectID:  keyInfo.ProjectID,
-				BucketName: string(req.Bucket),
-				ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-			},
+	// with versioning enabled the existing object is kept as an older version.
+	if versioning != VersioningEnabled {
+		_, err = endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+			Op:            macaroon.ActionDelete,
+			Bucket:        req.Bucket,
+			EncryptedPath: req.EncryptedPath,
+			Time:          time.Now(),
 		})
-		if err == nil {
-			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		canDelete := err == nil
+
+		switch {
+		case canDelete && versioning == VersioningSuspended:
+			_, err = endpoint.deleteLatestCommittedVersion(ctx, location)
+			if err != nil && !storj.ErrObjectNotFound.Has(err) {
+				return nil, endpoint.convertObjectLockError(err)
+			}
+		case canDelete:
+			_, err = endpoint.DeleteObjectAnyStatus(ctx, location)
+			if err != nil && !storj.ErrObjectNotFound.Has(err) {
+				if metabase.ErrObjectLock.Has(err) {
+					return nil, endpoint.convertObjectLockError(err)
+				}
+				return nil, err
+			}
+		default:
+			_, err = endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+				ObjectLocation: location,
+			})
+			if err == nil {
+				return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+			}
 		}
 	}
 
@@ -627,6 +645,11 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, err
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)}
+	if err := endpoint.checkExceedsBucketUploadLimits(ctx, bucket, true); err != nil {
+		return nil, err
+	}
+
 	// use only satellite values for Redundancy Scheme
 	pbRS := endpoint.defaultRS
 	streamID, err := uuid.New()
@@ -648,17 +671,31 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		expiresAt = &req.ExpiresAt
 	}
 
-	object, err := endpoint.metainfo.metabaseDB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
-		ObjectStream: metabase.ObjectStream{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(req.Bucket),
-			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
-			StreamID:   streamID,
-			Version:    metabase.Version(1),
-		},
-		ExpiresAt:  expiresAt,
-		Encryption: encryptionParameters,
-	})
+	objectStream := metabase.ObjectStream{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		StreamID:   streamID,
+		Version:    metabase.Version(1),
+	}
+
+	var object metabase.Object
+	if versioning == Unversioned {
+		object, err = endpoint.metainfo.metabaseDB.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+			ObjectStream: objectStream,
+			ExpiresAt:    expiresAt,
+			Encryption:   encryptionParameters,
+		})
+	} else {
+		// versioned buckets keep older versions, hence the new object gets the next version.
+		objectStream.Version = metabase.NextVersion
+		object = metabase.Object{ObjectStream: objectStream, CreatedAt: time.Now()}
+		object.Version, err = endpoint.metainfo.metabaseDB.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+			ObjectStream: objectStream,
+			ExpiresAt:    expiresAt,
+			Encryption:   encryptionParameters,
+		})
+	}
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -741,25 +778,36 @@ func (endpoint *Endpoint) commitObject(ctx context.Context, req *pb.ObjectCommit
 		encryption.BlockSize = streamMeta.EncryptionBlockSize
 	}
 
+	objectStream := metabase.ObjectStream{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(streamID.Bucket),
+		ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
+		StreamID:   id,
+		Version:    metabase.Version(streamID.Version),
+	}
+
+	retention, err := endpoint.bucketDefaultRetention(ctx, objectStream.Location())
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
 	_, err = endpoint.metainfo.metabaseDB.CommitObject(ctx, metabase.CommitObject{
-		ObjectStream: metabase.ObjectStream{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(streamID.Bucket),
-			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
-			StreamID:   id,
-			Version:    metabase.Version(1),
-		},
+		ObjectStream:                  objectStream,
 		EncryptedMetadata:             req.EncryptedMetadata,
 		EncryptedMetadataNonce:        req.EncryptedMetadataNonce[:],
 		EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
 
 		Encryption: encryption,
+		Retention:  retention,
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	endpoint.addBucketStorageUsage(ctx, objectStream.Location().Bucket(), accounting.BucketStorageUsage{Objects: 1})
+
 	return &pb.ObjectCommitResponse{}, nil
 }
 
@@ -877,6 +925,15 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)}
+	if err := endpoint.checkExceedsBucketBandwidthUsage(ctx, bucket); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.checkExceedsAPIKeyBandwidthUsage(ctx, keyInfo); err != nil {
+		return nil, err
+	}
+
 	// get the object information
 
 	object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
@@ -947,6 +1004,8 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			// bandwidth limits.
 			endpoint.log.Error("Could not track the new project's bandwidth usage", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Error(err))
 		}
+		endpoint.updateBucketBandwidthUsage(ctx, bucket, int64(segment.EncryptedSize))
+		endpoint.apiKeyUsage.AddEgress(ctx, keyInfo, int64(segment.EncryptedSize))
 
 		encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 		if err != nil {
@@ -1161,9 +1220,15 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		cursor = string(prefix) + cursor
 	}
 
+	// committed objects are listed only by their latest version, so that
+	// older versions in versioned buckets stay hidden.
+	iterate := endpoint.metainfo.metabaseDB.IterateObjectsAllVersionsWithStatus
+	if status == metabase.Committed {
+		iterate = endpoint.metainfo.metabaseDB.IterateObjectsLatestVersion
+	}
+
 	resp = &pb.ObjectListResponse{}
-	// TODO: Replace with IterateObjectsLatestVersion when ready
-	err = endpoint.metainfo.metabaseDB.IterateObjectsAllVersionsWithStatus(ctx,
+	err = iterate(ctx,
 		metabase.IterateObjectsWithStatus{
 			ProjectID:  keyInfo.ProjectID,
 			BucketName: string(req.Bucket),
@@ -1354,9 +1419,17 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 			}
 		}
 	} else {
-		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedPath))
+		deletedObjects, err = endpoint.deleteObjectVersioned(ctx, metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedPath),
+		})
 	}
 	if err != nil {
+		// locked objects are not deleted, hence the error is always returned.
+		if metabase.ErrObjectLock.Has(err) {
+			return nil, endpoint.convertObjectLockError(err)
+		}
 		if !canRead && !canList {
 			// No error info is returned if neither Read, nor List permission is granted
 			return &pb.ObjectBeginDeleteResponse{}, nil
@@ -1504,6 +1577,11 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, err
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkExceedsBucketUploadLimits(ctx, bucket, false); err != nil {
+		return nil, err
+	}
+
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(streamID.Redundancy)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1511,15 +1589,20 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 
 	maxPieceSize := eestream.CalcPieceSize(req.MaxOrderLimit, redundancy)
 
+	placement, err := endpoint.metainfo.GetBucketPlacement(ctx, streamID.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: redundancy.TotalCount(),
+		Placement:      placement,
 	}
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucket, nodes, streamID.ExpirationDate, maxPieceSize)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1543,7 +1626,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		Position: metabase.SegmentPosition{
 			Part:  uint32(req.Position.PartNumber),
@@ -1590,6 +1673,31 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	return resp, err
 }
 
+// recordUploadResults records the outcome of the piece uploads for scoring
+// nodes by node selection. Nodes which didn't return a valid piece hash,
+// including the cancelled long tail, are recorded as failures.
+//
+// The latency is measured on the satellite from issuing the order limits until
+// the commit, since the piece hash timestamps come from the node clocks.
+func (endpoint *Endpoint) recordUploadResults(segmentID *internalpb.SegmentID, originalLimits []*pb.OrderLimit, validPieces []*pb.SegmentPieceUploadResult) {
+	latency := time.Since(segmentID.CreationDate)
+
+	succeeded := make(map[storj.NodeID]struct{}, len(validPieces))
+	for _, result := range validPieces {
+		succeeded[result.NodeId] = struct{}{}
+		endpoint.overlay.UploadSelectionCache.RecordUploadSuccess(result.NodeId, latency)
+	}
+
+	for _, limit := range originalLimits {
+		if limit == nil {
+			continue
+		}
+		if _, ok := succeeded[limit.StorageNodeId]; !ok {
+			endpoint.overlay.UploadSelectionCache.RecordUploadFailure(limit.StorageNodeId)
+		}
+	}
+}
+
 func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentCommitRequest, savePointer bool) (_ *pb.Pointer, resp *pb.SegmentCommitResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -1652,6 +1760,8 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "pointer verification failed: %s", err)
 	}
 
+	endpoint.recordUploadResults(segmentID, originalLimits, validPieces)
+
 	if len(validPieces) < int(rs.OptimalShares) {
 		endpoint.log.Debug("Number of valid pieces is less than the success threshold",
 			zap.Int("totalReceivedPieces", len(req.UploadResult)),
@@ -1695,7 +1805,7 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		EncryptedKey:      req.EncryptedKey,
 		EncryptedKeyNonce: req.EncryptedKeyNonce[:],
@@ -1749,6 +1859,8 @@ func (endpoint *Endpoint) commitSegment(ctx context.Context, req *pb.SegmentComm
 			zap.Error(err),
 		)
 	}
+	endpoint.addBucketStorageUsage(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)},
+		accounting.BucketStorageUsage{Storage: segmentSize, Segments: 1})
 
 	err = endpoint.metainfo.metabaseDB.CommitSegment(ctx, mbCommitSegment)
 	if err != nil {
@@ -1809,6 +1921,11 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, nil, err
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkExceedsBucketUploadLimits(ctx, bucket, false); err != nil {
+		return nil, nil, err
+	}
+
 	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, keyInfo.ProjectID, inlineUsed); err != nil {
 		// log it and continue. it's most likely our own fault that we couldn't
 		// track it, and the only thing that will be affected is our per-project
@@ -1818,6 +1935,7 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 			zap.Error(err),
 		)
 	}
+	endpoint.addBucketStorageUsage(ctx, bucket, accounting.BucketStorageUsage{Storage: inlineUsed, Segments: 1})
 
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
@@ -1830,7 +1948,7 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 			BucketName: string(streamID.Bucket),
 			ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
 			StreamID:   id,
-			Version:    1,
+			Version:    metabase.Version(streamID.Version),
 		},
 		EncryptedKey:      req.EncryptedKey,
 		EncryptedKeyNonce: req.EncryptedKeyNonce.Bytes(),
@@ -1853,7 +1971,6 @@ func (endpoint *Endpoint) makeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 	err = endpoint.orders.UpdatePutInlineOrder(ctx, bucket, inlineUsed)
 	if err != nil {
 		return nil, nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -1984,6 +2101,14 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	if err := endpoint.checkExceedsBucketBandwidthUsage(ctx, bucket); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.checkExceedsAPIKeyBandwidthUsage(ctx, keyInfo); err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -2026,6 +2151,8 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 			zap.Error(err),
 		)
 	}
+	endpoint.updateBucketBandwidthUsage(ctx, bucket, int64(segment.EncryptedSize))
+	endpoint.apiKeyUsage.AddEgress(ctx, keyInfo, int64(segment.EncryptedSize))
 
 	encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 	if err != nil {
@@ -1349,6 +1349,82 @@ func TestRateLimit_ProjectRateLimitOverrideCachedExpired(t *testing.T) {
 	})
 }
 
+func TestRateLimit_APIKeyRateLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.RateLimiter.Rate = 100
+				config.Metainfo.RateLimiter.CacheExpiration = 500 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		keyInfo, err := satellite.DB.Console().APIKeys().GetByHead(ctx, ul.APIKey[satellite.ID()].Head())
+		require.NoError(t, err)
+
+		rateLimit := 2
+		err = satellite.DB.Console().APIKeys().UpdateLimits(ctx, keyInfo.ID, &rateLimit, nil)
+		require.NoError(t, err)
+
+		// TODO find a way to reset limiter before test is executed, currently
+		// testplanet is doing one additional request to get access
+		time.Sleep(1 * time.Second)
+
+		var group errs2.Group
+		for i := 0; i <= rateLimit; i++ {
+			group.Go(func() error {
+				return ul.CreateBucket(ctx, satellite, testrand.BucketName())
+			})
+		}
+		groupErrs := group.Wait()
+		require.Len(t, groupErrs, 1)
+	})
+}
+
+func TestAPIKeyUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		ul := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		apiKeyUsage := satellite.Accounting.APIKeyUsage
+
+		apiKeyUsage.Loop.Pause()
+
+		keyInfo, err := satellite.DB.Console().APIKeys().GetByHead(ctx, ul.APIKey[satellite.ID()].Head())
+		require.NoError(t, err)
+
+		data := testrand.Bytes(100 * memory.KiB)
+		err = ul.Upload(ctx, satellite, "testbucket", "test/path", data)
+		require.NoError(t, err)
+
+		_, err = ul.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+
+		require.NoError(t, apiKeyUsage.Flush(ctx))
+
+		now := time.Now()
+		usage, err := satellite.DB.Console().APIKeys().GetUsageByProjectID(ctx, keyInfo.ProjectID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+		require.Equal(t, keyInfo.ID, usage[0].ID)
+		require.NotZero(t, usage[0].Requests)
+		require.NotZero(t, usage[0].Egress)
+
+		// limit the key to the egress which it has already used
+		bandwidthLimit := usage[0].Egress
+		err = satellite.DB.Console().APIKeys().UpdateLimits(ctx, keyInfo.ID, nil, &bandwidthLimit)
+		require.NoError(t, err)
+
+		_, err = ul.Download(ctx, satellite, "testbucket", "test/path")
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+	})
+}
+
 func TestBucketEmptinessBeforeDelete(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
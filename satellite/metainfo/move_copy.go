@@ -0,0 +1,521 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/context2"
+	"storj.io/common/macaroon"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+// BeginMoveObject validates that the object can be moved to the new location and
+// returns the object keys, which need to be re-encrypted by the client.
+func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *internalpb.ObjectBeginMoveRequest) (resp *internalpb.ObjectBeginMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateMoveCopyAuth(ctx, req.Header,
+		req.Bucket, req.EncryptedObjectKey, req.NewBucket, req.NewEncryptedObjectKey,
+		macaroon.ActionDelete, nil, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// moving the object within the bucket doesn't change the bucket usage.
+	if !bytes.Equal(req.Bucket, req.NewBucket) {
+		err = endpoint.checkExceedsBucketUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	location, version, err := endpoint.getMoveCopySource(ctx, keyInfo, req.Bucket, req.EncryptedObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.metainfo.metabaseDB.BeginMoveObject(ctx, metabase.BeginMoveObject{
+		Version:        version,
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return nil, endpoint.convertMoveCopyError(err)
+	}
+
+	streamID, err := endpoint.packMoveCopyStreamID(ctx, req.Bucket, req.EncryptedObjectKey, result, now)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.log.Info("Object Move", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "begin_move"), zap.String("type", "object"))
+	mon.Meter("req_begin_move_object").Mark(1)
+
+	return &internalpb.ObjectBeginMoveResponse{
+		StreamId:                  streamID,
+		EncryptedMetadataKeyNonce: result.EncryptedMetadataKeyNonce,
+		EncryptedMetadataKey:      result.EncryptedMetadataKey,
+		SegmentKeys:               convertKeysToProto(result.EncryptedKeysNonces),
+		EncryptionParameters: &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(result.EncryptionParameters.CipherSuite),
+			BlockSize:   int64(result.EncryptionParameters.BlockSize),
+		},
+	}, nil
+}
+
+// FinishMoveObject moves the object to the new location. An existing object in
+// the new location is replaced, unless the bucket keeps older versions.
+func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *internalpb.ObjectFinishMoveRequest) (resp *internalpb.ObjectFinishMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamID, err := endpoint.unmarshalMoveCopyStreamID(ctx, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	var canDelete bool
+	keyInfo, err := endpoint.validateMoveCopyAuth(ctx, req.Header,
+		streamID.Bucket, streamID.EncryptedPath, req.NewBucket, req.NewEncryptedObjectKey,
+		macaroon.ActionDelete, &canDelete, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// the new bucket may differ from the one checked in BeginMoveObject.
+	if !bytes.Equal(streamID.Bucket, req.NewBucket) {
+		err = endpoint.checkExceedsBucketUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	versioning, err := endpoint.getBucketVersioning(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStream, err := moveCopyObjectStream(keyInfo.ProjectID, streamID)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	object, err := endpoint.metainfo.metabaseDB.FinishMoveObject(ctx, metabase.FinishMoveObject{
+		ObjectStream:                 objectStream,
+		NewBucket:                    string(req.NewBucket),
+		NewSegmentKeys:               convertKeysFromProto(req.NewSegmentKeys),
+		NewEncryptedObjectKey:        metabase.ObjectKey(req.NewEncryptedObjectKey),
+		NewEncryptedMetadataKeyNonce: req.NewEncryptedMetadataKeyNonce,
+		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+		NewVersioned:                 versioning == VersioningEnabled,
+		NewDisallowDelete:            !canDelete,
+		DeletePieces:                 endpoint.deleteReplacedPieces,
+	})
+	if err != nil {
+		return nil, endpoint.convertMoveCopyError(err)
+	}
+
+	if !bytes.Equal(streamID.Bucket, req.NewBucket) {
+		usage := accounting.BucketStorageUsage{
+			Storage:  object.TotalEncryptedSize,
+			Objects:  1,
+			Segments: int64(object.SegmentCount),
+		}
+		endpoint.addBucketStorageUsage(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: object.BucketName}, usage)
+		endpoint.addBucketStorageUsage(ctx, objectStream.Location().Bucket(), accounting.BucketStorageUsage{}.Sub(usage))
+	}
+
+	endpoint.log.Info("Object Move", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "finish_move"), zap.String("type", "object"))
+	mon.Meter("req_finish_move_object").Mark(1)
+
+	return &internalpb.ObjectFinishMoveResponse{}, nil
+}
+
+// BeginCopyObject validates that the object can be copied to the new location and
+// returns the object keys, which need to be re-encrypted by the client.
+func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *internalpb.ObjectBeginCopyRequest) (resp *internalpb.ObjectBeginCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateMoveCopyAuth(ctx, req.Header,
+		req.Bucket, req.EncryptedObjectKey, req.NewBucket, req.NewEncryptedObjectKey,
+		macaroon.ActionRead, nil, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.checkExceedsStorageUsage(ctx, keyInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	err = endpoint.checkExceedsBucketUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	location, version, err := endpoint.getMoveCopySource(ctx, keyInfo, req.Bucket, req.EncryptedObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.metainfo.metabaseDB.BeginCopyObject(ctx, metabase.BeginCopyObject{
+		Version:        version,
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return nil, endpoint.convertMoveCopyError(err)
+	}
+
+	streamID, err := endpoint.packMoveCopyStreamID(ctx, req.Bucket, req.EncryptedObjectKey, result, now)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.log.Info("Object Copy", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "begin_copy"), zap.String("type", "object"))
+	mon.Meter("req_begin_copy_object").Mark(1)
+
+	return &internalpb.ObjectBeginCopyResponse{
+		StreamId:                  streamID,
+		EncryptedMetadataKeyNonce: result.EncryptedMetadataKeyNonce,
+		EncryptedMetadataKey:      result.EncryptedMetadataKey,
+		SegmentKeys:               convertKeysToProto(result.EncryptedKeysNonces),
+		EncryptionParameters: &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(result.EncryptionParameters.CipherSuite),
+			BlockSize:   int64(result.EncryptionParameters.BlockSize),
+		},
+	}, nil
+}
+
+// FinishCopyObject creates a copy of the object in the new location. The copy
+// shares the pieces with the original object. An existing object in the new
+// location is replaced, unless the bucket keeps older versions.
+func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *internalpb.ObjectFinishCopyRequest) (resp *internalpb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamID, err := endpoint.unmarshalMoveCopyStreamID(ctx, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	var canDelete bool
+	keyInfo, err := endpoint.validateMoveCopyAuth(ctx, req.Header,
+		streamID.Bucket, streamID.EncryptedPath, req.NewBucket, req.NewEncryptedObjectKey,
+		macaroon.ActionRead, &canDelete, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// the new bucket may differ from the one checked in BeginCopyObject.
+	err = endpoint.checkExceedsBucketUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	versioning, err := endpoint.getBucketVersioning(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectStream, err := moveCopyObjectStream(keyInfo.ProjectID, streamID)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	retention, err := endpoint.bucketDefaultRetention(ctx, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.NewBucket),
+		ObjectKey:  metabase.ObjectKey(req.NewEncryptedObjectKey),
+	})
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	object, err := endpoint.metainfo.metabaseDB.FinishCopyObject(ctx, metabase.FinishCopyObject{
+		ObjectStream:                 objectStream,
+		NewStreamID:                  newStreamID,
+		NewBucket:                    string(req.NewBucket),
+		NewSegmentKeys:               convertKeysFromProto(req.NewSegmentKeys),
+		NewEncryptedObjectKey:        metabase.ObjectKey(req.NewEncryptedObjectKey),
+		NewEncryptedMetadataKeyNonce: req.NewEncryptedMetadataKeyNonce,
+		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
+		NewVersioned:                 versioning == VersioningEnabled,
+		NewDisallowDelete:            !canDelete,
+		NewRetention:                 retention,
+		DeletePieces:                 endpoint.deleteReplacedPieces,
+	})
+	if err != nil {
+		return nil, endpoint.convertMoveCopyError(err)
+	}
+
+	endpoint.addBucketStorageUsage(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: object.BucketName}, accounting.BucketStorageUsage{
+		Storage:  object.TotalEncryptedSize,
+		Objects:  1,
+		Segments: int64(object.SegmentCount),
+	})
+
+	protoObject, err := endpoint.objectToProto(ctx, object, endpoint.defaultRS)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("Object Copy", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "finish_copy"), zap.String("type", "object"))
+	mon.Meter("req_finish_copy_object").Mark(1)
+
+	return &internalpb.ObjectFinishCopyResponse{
+		Object: protoObject,
+	}, nil
+}
+
+// validateMoveCopyAuth checks that the source object can be accessed with the
+// specified action and that the new location can be written. When canDelete is
+// set, it reports whether an existing object in the new location may be replaced.
+func (endpoint *Endpoint) validateMoveCopyAuth(ctx context.Context, header *pb.RequestHeader, bucket, encryptedPath, newBucket, newEncryptedPath []byte, sourceAction macaroon.ActionType, canDelete *bool, now time.Time) (_ *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	permissions := []verifyPermission{
+		{
+			action: macaroon.Action{
+				Op:            sourceAction,
+				Bucket:        bucket,
+				EncryptedPath: encryptedPath,
+				Time:          now,
+			},
+		},
+		{
+			action: macaroon.Action{
+				Op:            macaroon.ActionWrite,
+				Bucket:        newBucket,
+				EncryptedPath: newEncryptedPath,
+				Time:          now,
+			},
+		},
+	}
+	if canDelete != nil {
+		permissions = append(permissions, verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionDelete,
+				Bucket:        newBucket,
+				EncryptedPath: newEncryptedPath,
+				Time:          now,
+			},
+			actionPermitted: canDelete,
+			optional:        true,
+		})
+	}
+
+	keyInfo, err := endpoint.validateAuthN(ctx, header, permissions...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range [][]byte{bucket, newBucket} {
+		if err := endpoint.validateBucket(ctx, name); err != nil {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(newEncryptedPath) == 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "new object key is missing")
+	}
+
+	if bytes.Equal(bucket, newBucket) && bytes.Equal(encryptedPath, newEncryptedPath) {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "source and target object are the same")
+	}
+
+	exists, err := endpoint.metainfo.HasBucket(ctx, newBucket, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	} else if !exists {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, "target bucket not found: "+string(newBucket))
+	}
+
+	if !bytes.Equal(bucket, newBucket) {
+		if err := endpoint.checkMoveCopyPlacement(ctx, keyInfo.ProjectID, bucket, newBucket); err != nil {
+			return nil, err
+		}
+	}
+
+	return keyInfo, nil
+}
+
+// checkMoveCopyPlacement returns FailedPrecondition when the pieces stored
+// under the placement of the source bucket could violate the placement of the
+// target bucket.
+func (endpoint *Endpoint) checkMoveCopyPlacement(ctx context.Context, projectID uuid.UUID, bucket, newBucket []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	placements := make([]nodeselection.Placement, 2)
+	for i, name := range [][]byte{bucket, newBucket} {
+		placements[i], err = endpoint.metainfo.GetBucketPlacement(ctx, name, projectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return rpcstatus.Error(rpcstatus.NotFound, "bucket not found: "+string(name))
+			}
+			endpoint.log.Error("unable to get bucket placement", zap.Error(err))
+			return rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+	}
+
+	if !placements[1].SatisfiedBy(placements[0]) {
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, "placement of the target bucket doesn't match the source bucket")
+	}
+	return nil
+}
+
+// getMoveCopySource returns the location and the latest committed version of
+// the source object.
+func (endpoint *Endpoint) getMoveCopySource(ctx context.Context, keyInfo *console.APIKeyInfo, bucket, encryptedPath []byte) (_ metabase.ObjectLocation, _ metabase.Version, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	location := metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(bucket),
+		ObjectKey:  metabase.ObjectKey(encryptedPath),
+	}
+	object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return metabase.ObjectLocation{}, 0, endpoint.convertMoveCopyError(err)
+	}
+	return location, object.Version, nil
+}
+
+// packMoveCopyStreamID signs the stream id of the source object, which is
+// passed back by the client when finishing the move or copy.
+func (endpoint *Endpoint) packMoveCopyStreamID(ctx context.Context, bucket, encryptedPath []byte, result metabase.BeginMoveCopyResults, now time.Time) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamID, err := endpoint.packStreamID(ctx, &internalpb.StreamID{
+		Bucket:        bucket,
+		EncryptedPath: encryptedPath,
+		Version:       int32(result.Version),
+		StreamId:      result.StreamID[:],
+		CreationDate:  now,
+		EncryptionParameters: &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(result.EncryptionParameters.CipherSuite),
+			BlockSize:   int64(result.EncryptionParameters.BlockSize),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return streamID, nil
+}
+
+// unmarshalMoveCopyStreamID verifies the stream id returned by BeginMoveObject
+// or BeginCopyObject.
+func (endpoint *Endpoint) unmarshalMoveCopyStreamID(ctx context.Context, streamID []byte) (_ *internalpb.StreamID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satStreamID, err := endpoint.unmarshalSatStreamID(ctx, streamID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if satStreamID.CreationDate.Before(time.Now().Add(-satIDExpiration)) {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "stream ID expired")
+	}
+
+	return satStreamID, nil
+}
+
+// deleteReplacedPieces deletes the pieces of an object replaced by a move or
+// copy. Failures are left to the garbage collector.
+func (endpoint *Endpoint) deleteReplacedPieces(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+	// We should ignore client cancelling and always try to delete segments.
+	ctx = context2.WithoutCancellation(ctx)
+
+	endpoint.deleteSegmentPieces(ctx, segments)
+	return nil
+}
+
+// moveCopyObjectStream returns the source object stream from the verified stream id.
+func moveCopyObjectStream(projectID uuid.UUID, streamID *internalpb.StreamID) (metabase.ObjectStream, error) {
+	id, err := uuid.FromBytes(streamID.StreamId)
+	if err != nil {
+		return metabase.ObjectStream{}, err
+	}
+
+	return metabase.ObjectStream{
+		ProjectID:  projectID,
+		BucketName: string(streamID.Bucket),
+		ObjectKey:  metabase.ObjectKey(streamID.EncryptedPath),
+		Version:    metabase.Version(streamID.Version),
+		StreamID:   id,
+	}, nil
+}
+
+func convertKeysToProto(keys []metabase.EncryptedKeyAndNonce) []*internalpb.EncryptedKeyAndNonce {
+	result := make([]*internalpb.EncryptedKeyAndNonce, len(keys))
+	for i, key := range keys {
+		result[i] = &internalpb.EncryptedKeyAndNonce{
+			Position: &pb.SegmentPosition{
+				PartNumber: int32(key.Position.Part),
+				Index:      int32(key.Position.Index),
+			},
+			EncryptedKeyNonce: key.EncryptedKeyNonce,
+			EncryptedKey:      key.EncryptedKey,
+		}
+	}
+	return result
+}
+
+func convertKeysFromProto(keys []*internalpb.EncryptedKeyAndNonce) []metabase.EncryptedKeyAndNonce {
+	result := make([]metabase.EncryptedKeyAndNonce, len(keys))
+	for i, key := range keys {
+		result[i] = metabase.EncryptedKeyAndNonce{
+			EncryptedKeyNonce: key.EncryptedKeyNonce,
+			EncryptedKey:      key.EncryptedKey,
+		}
+		if key.Position != nil {
+			result[i].Position = metabase.SegmentPosition{
+				Part:  uint32(key.Position.PartNumber),
+				Index: uint32(key.Position.Index),
+			}
+		}
+	}
+	return result
+}
+
+// convertMoveCopyError converts metabase errors to rpc errors.
+func (endpoint *Endpoint) convertMoveCopyError(err error) error {
+	switch {
+	case storj.ErrObjectNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case metabase.ErrInvalidRequest.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	case metabase.ErrConflict.Has(err):
+		return rpcstatus.Error(rpcstatus.AlreadyExists, err.Error())
+	case metabase.ErrPermissionDenied.Has(err):
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+	case metabase.ErrObjectLock.Has(err):
+		return endpoint.convertObjectLockError(err)
+	default:
+		endpoint.log.Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
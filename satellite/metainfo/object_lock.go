@@ -0,0 +1,244 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+// GetBucketDefaultRetention returns the default retention of a bucket.
+func (endpoint *Endpoint) GetBucketDefaultRetention(ctx context.Context, req *internalpb.GetBucketDefaultRetentionRequest) (resp *internalpb.GetBucketDefaultRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	retention, err := endpoint.metainfo.GetBucketDefaultRetention(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	return &internalpb.GetBucketDefaultRetentionResponse{
+		Retention: &internalpb.DefaultRetention{
+			Mode: internalpb.RetentionMode(retention.Mode),
+			Days: int32(retention.Days),
+		},
+	}, nil
+}
+
+// SetBucketDefaultRetention updates the default retention of a bucket.
+func (endpoint *Endpoint) SetBucketDefaultRetention(ctx context.Context, req *internalpb.SetBucketDefaultRetentionRequest) (resp *internalpb.SetBucketDefaultRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	var retention DefaultRetention
+	if req.Retention != nil {
+		retention = DefaultRetention{
+			Mode: metabase.RetentionMode(req.Retention.Mode),
+			Days: int(req.Retention.Days),
+		}
+	}
+
+	err = endpoint.metainfo.UpdateBucketDefaultRetention(ctx, req.Bucket, keyInfo.ProjectID, retention)
+	if err != nil {
+		if ErrInvalidRetention.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	endpoint.log.Info("Bucket Default Retention", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Stringer("mode", retention.Mode), zap.Int("days", retention.Days), zap.String("operation", "put"), zap.String("type", "bucket"))
+	mon.Meter("req_set_bucket_default_retention").Mark(1)
+
+	return &internalpb.SetBucketDefaultRetentionResponse{}, nil
+}
+
+// GetObjectLock returns the retention and legal hold of a committed object version.
+func (endpoint *Endpoint) GetObjectLock(ctx context.Context, req *internalpb.ObjectGetLockRequest) (resp *internalpb.ObjectGetLockResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	object, err := endpoint.metainfo.metabaseDB.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		Version: metabase.Version(req.Version),
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+		},
+	})
+	if err != nil {
+		return nil, endpoint.convertObjectLockError(err)
+	}
+
+	return &internalpb.ObjectGetLockResponse{
+		Retention: &internalpb.Retention{
+			Mode:        internalpb.RetentionMode(object.Retention.Mode),
+			RetainUntil: object.Retention.RetainUntil,
+		},
+		LegalHold: object.LegalHold,
+	}, nil
+}
+
+// SetObjectRetention changes the retention of a committed object version.
+// Active retention can only be extended, lifting it requires an admin governance override.
+func (endpoint *Endpoint) SetObjectRetention(ctx context.Context, req *internalpb.ObjectSetRetentionRequest) (resp *internalpb.ObjectSetRetentionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	var retention metabase.Retention
+	if req.Retention != nil {
+		retention = metabase.Retention{
+			Mode:        metabase.RetentionMode(req.Retention.Mode),
+			RetainUntil: req.Retention.RetainUntil,
+		}
+	}
+
+	err = endpoint.metainfo.metabaseDB.SetObjectRetention(ctx, metabase.SetObjectRetention{
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+		},
+		Version:   metabase.Version(req.Version),
+		Retention: retention,
+	})
+	if err != nil {
+		return nil, endpoint.convertObjectLockError(err)
+	}
+
+	endpoint.log.Info("Object Retention", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Stringer("mode", retention.Mode), zap.String("operation", "put"), zap.String("type", "object"))
+	mon.Meter("req_set_object_retention").Mark(1)
+
+	return &internalpb.ObjectSetRetentionResponse{}, nil
+}
+
+// SetObjectLegalHold places a legal hold on a committed object version.
+// Lifting a legal hold requires an admin governance override.
+func (endpoint *Endpoint) SetObjectLegalHold(ctx context.Context, req *internalpb.ObjectSetLegalHoldRequest) (resp *internalpb.ObjectSetLegalHoldResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.metainfo.metabaseDB.SetObjectLegalHold(ctx, metabase.SetObjectLegalHold{
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+		},
+		Version: metabase.Version(req.Version),
+		Enabled: true,
+	})
+	if err != nil {
+		return nil, endpoint.convertObjectLockError(err)
+	}
+
+	endpoint.log.Info("Object Legal Hold", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "put"), zap.String("type", "object"))
+	mon.Meter("req_set_object_legal_hold").Mark(1)
+
+	return &internalpb.ObjectSetLegalHoldResponse{}, nil
+}
+
+// bucketDefaultRetention returns the retention for an object committed now into the bucket.
+func (endpoint *Endpoint) bucketDefaultRetention(ctx context.Context, location metabase.ObjectLocation) (_ metabase.Retention, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// missing buckets don't have any default retention, this keeps commits
+	// into concurrently deleted buckets unchanged.
+	retention, err := endpoint.metainfo.GetBucketDefaultRetention(ctx, []byte(location.BucketName), location.ProjectID)
+	if err != nil && !storj.ErrBucketNotFound.Has(err) {
+		return metabase.Retention{}, err
+	}
+	return retention.Retention(time.Now()), nil
+}
+
+// convertObjectLockError converts metabase errors to rpc errors. Locked objects
+// are reported with FailedPrecondition, so that clients can tell them apart
+// from requests denied because of the API key.
+func (endpoint *Endpoint) convertObjectLockError(err error) error {
+	switch {
+	case metabase.ErrObjectLock.Has(err):
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+	case storj.ErrObjectNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case metabase.ErrInvalidRequest.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	default:
+		endpoint.log.Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
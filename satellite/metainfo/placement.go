@@ -0,0 +1,87 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+// GetBucketPlacement returns the placement constraints of a bucket.
+func (endpoint *Endpoint) GetBucketPlacement(ctx context.Context, req *internalpb.GetBucketPlacementRequest) (resp *internalpb.GetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	placement, err := endpoint.metainfo.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	return &internalpb.GetBucketPlacementResponse{
+		Placement: &internalpb.Placement{
+			AllowedCountries:  placement.AllowedCountries,
+			ExcludedCountries: placement.ExcludedCountries,
+		},
+	}, nil
+}
+
+// SetBucketPlacement replaces the placement constraints of an empty bucket.
+func (endpoint *Endpoint) SetBucketPlacement(ctx context.Context, req *internalpb.SetBucketPlacementRequest) (resp *internalpb.SetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	var placement nodeselection.Placement
+	if req.Placement != nil {
+		placement = nodeselection.Placement{
+			AllowedCountries:  req.Placement.AllowedCountries,
+			ExcludedCountries: req.Placement.ExcludedCountries,
+		}
+	}
+
+	err = endpoint.metainfo.UpdateBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID, placement)
+	if err != nil {
+		if ErrInvalidPlacement.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	endpoint.log.Info("Bucket Placement", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Strings("allowed", placement.AllowedCountries), zap.Strings("excluded", placement.ExcludedCountries), zap.String("operation", "put"), zap.String("type", "bucket"))
+	mon.Meter("req_set_bucket_placement").Mark(1)
+
+	return &internalpb.SetBucketPlacementResponse{}, nil
+}
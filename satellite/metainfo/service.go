@@ -15,6 +15,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/storage"
 	"storj.io/uplink/private/storage/meta"
 )
@@ -22,6 +23,14 @@ import (
 var (
 	// ErrBucketNotEmpty is returned when bucket is required to be empty for an operation.
 	ErrBucketNotEmpty = errs.Class("bucket not empty")
+	// ErrInvalidVersioning is returned when the bucket versioning state cannot be changed.
+	ErrInvalidVersioning = errs.Class("invalid versioning state")
+	// ErrInvalidRetention is returned when the bucket default retention is invalid.
+	ErrInvalidRetention = errs.Class("invalid retention")
+	// ErrInvalidLifecycle is returned when the bucket lifecycle rules are invalid.
+	ErrInvalidLifecycle = errs.Class("invalid lifecycle")
+	// ErrInvalidPlacement is returned when the bucket placement is invalid or cannot be changed.
+	ErrInvalidPlacement = errs.Class("invalid placement")
 )
 
 // Service provides the metainfo service dependencies.
@@ -464,3 +473,103 @@ func (s *Service) CountBuckets(ctx context.Context, projectID uuid.UUID) (count
 	defer mon.Task()(&ctx)(&err)
 	return s.bucketsDB.CountBuckets(ctx, projectID)
 }
+
+// GetBucketVersioning returns the versioning state of a bucket.
+func (s *Service) GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketVersioning(ctx, bucketName, projectID)
+}
+
+// UpdateBucketVersioning updates the versioning state of a bucket.
+// Once enabled, versioning can only be suspended.
+func (s *Service) UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning Versioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch versioning {
+	case VersioningEnabled, VersioningSuspended:
+	case Unversioned:
+		current, err := s.bucketsDB.GetBucketVersioning(ctx, bucketName, projectID)
+		if err != nil {
+			return err
+		}
+		if current != Unversioned {
+			return ErrInvalidVersioning.New("versioning cannot be disabled once enabled, it can only be suspended")
+		}
+	default:
+		return ErrInvalidVersioning.New("%d", versioning)
+	}
+
+	return s.bucketsDB.UpdateBucketVersioning(ctx, bucketName, projectID, versioning)
+}
+
+// GetBucketDefaultRetention returns the default retention of a bucket.
+func (s *Service) GetBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ DefaultRetention, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketDefaultRetention(ctx, bucketName, projectID)
+}
+
+// UpdateBucketDefaultRetention updates the default retention of a bucket.
+// Objects which are already committed keep their retention.
+func (s *Service) UpdateBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID, retention DefaultRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch retention.Mode {
+	case metabase.NoRetention:
+		if retention.Days != 0 {
+			return ErrInvalidRetention.New("days set without retention mode")
+		}
+	case metabase.GovernanceMode, metabase.ComplianceMode:
+		if retention.Days <= 0 {
+			return ErrInvalidRetention.New("days must be positive, got %d", retention.Days)
+		}
+	default:
+		return ErrInvalidRetention.New("unknown mode %d", retention.Mode)
+	}
+
+	return s.bucketsDB.UpdateBucketDefaultRetention(ctx, bucketName, projectID, retention)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket.
+func (s *Service) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ []metabase.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketLifecycle(ctx, bucketName, projectID)
+}
+
+// UpdateBucketLifecycle replaces the lifecycle rules of a bucket.
+// No rules removes the lifecycle configuration.
+func (s *Service) UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []metabase.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := metabase.VerifyLifecycleRules(rules); err != nil {
+		return ErrInvalidLifecycle.Wrap(err)
+	}
+
+	return s.bucketsDB.UpdateBucketLifecycle(ctx, bucketName, projectID, rules)
+}
+
+// GetBucketPlacement returns the placement constraints of a bucket.
+func (s *Service) GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ nodeselection.Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.bucketsDB.GetBucketPlacement(ctx, bucketName, projectID)
+}
+
+// UpdateBucketPlacement replaces the placement constraints of a bucket.
+// The placement can only be changed while the bucket is empty, otherwise
+// existing data could be stored outside of the allowed region.
+func (s *Service) UpdateBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement nodeselection.Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := placement.Verify(); err != nil {
+		return ErrInvalidPlacement.Wrap(err)
+	}
+
+	empty, err := s.IsBucketEmpty(ctx, projectID, bucketName)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return ErrInvalidPlacement.New("placement can only be changed for empty buckets")
+	}
+
+	return s.bucketsDB.UpdateBucketPlacement(ctx, bucketName, projectID, placement)
+}
@@ -19,7 +19,6 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
-	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/metainfo/metabase"
@@ -61,6 +60,37 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHe
 	return keyInfo, nil
 }
 
+type verifyPermission struct {
+	action          macaroon.Action
+	actionPermitted *bool
+	optional        bool
+}
+
+// validateAuthN validates things like API key, user permissions and rate limit
+// for multiple actions and always returns valid rpc error. The API key is
+// validated and counted only once.
+func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestHeader, permissions ...verifyPermission) (_ *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, keyInfo, err := endpoint.validateBasic(ctx, header)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		err = key.Check(ctx, keyInfo.Secret, permission.action, endpoint.revocations)
+		if permission.actionPermitted != nil {
+			*permission.actionPermitted = err == nil
+		}
+		if err != nil && !permission.optional {
+			endpoint.log.Debug("unauthorized request", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		}
+	}
+
+	return keyInfo, nil
+}
+
 func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -76,11 +106,13 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
-	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
+	if err = endpoint.checkRate(ctx, keyInfo); err != nil {
 		endpoint.log.Debug("rate check failed", zap.Error(err))
 		return nil, nil, err
 	}
 
+	endpoint.apiKeyUsage.AddRequest(ctx, keyInfo)
+
 	return key, keyInfo, nil
 }
 
@@ -110,11 +142,14 @@ func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.Request
 	return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized attempt to revoke macaroon")
 }
 
-func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (err error) {
+// checkRate checks the request rate of the project and, when the api key has
+// its own rate limit, the request rate of the api key.
+func (endpoint *Endpoint) checkRate(ctx context.Context, keyInfo *console.APIKeyInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !endpoint.config.RateLimiter.Enabled {
 		return nil
 	}
+	projectID := keyInfo.ProjectID
 	limiter, err := endpoint.limiterCache.Get(projectID.String(), func() (interface{}, error) {
 		limit := rate.Limit(endpoint.config.RateLimiter.Rate)
 
@@ -144,6 +179,53 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
 	}
 
+	if keyInfo.RateLimit == nil || *keyInfo.RateLimit <= 0 {
+		return nil
+	}
+
+	keyLimiter, err := endpoint.keyLimiterCache.Get(string(keyInfo.Head), func() (interface{}, error) {
+		limit := rate.Limit(*keyInfo.RateLimit)
+		return rate.NewLimiter(limit, int(limit)), nil
+	})
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	if !keyLimiter.(*rate.Limiter).Allow() {
+		endpoint.log.Warn("too many requests for api key",
+			zap.Stringer("projectID", projectID),
+			zap.Stringer("apiKeyID", keyInfo.ID),
+			zap.Float64("limit", float64(keyLimiter.(*rate.Limiter).Limit())))
+
+		mon.Event("metainfo_api_key_rate_limit_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+	}
+
+	return nil
+}
+
+// checkExceedsAPIKeyBandwidthUsage returns an error when the monthly bandwidth
+// limit of the api key is exceeded.
+func (endpoint *Endpoint) checkExceedsAPIKeyBandwidthUsage(ctx context.Context, keyInfo *console.APIKeyInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exceeded, err := endpoint.apiKeyUsage.ExceedsBandwidthLimit(ctx, keyInfo)
+	if err != nil {
+		endpoint.log.Error(
+			"Retrieving api key bandwidth total failed; api key bandwidth limit won't be enforced",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Error(err),
+		)
+	} else if exceeded {
+		endpoint.log.Error("Monthly api key bandwidth limit exceeded",
+			zap.Int64("Limit", *keyInfo.BandwidthLimit),
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Stringer("API Key ID", keyInfo.ID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
+	}
+
 	return nil
 }
 
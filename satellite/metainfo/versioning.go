@@ -0,0 +1,298 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+// GetBucketVersioning returns the versioning state of a bucket.
+func (endpoint *Endpoint) GetBucketVersioning(ctx context.Context, req *internalpb.GetBucketVersioningRequest) (resp *internalpb.GetBucketVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	versioning, err := endpoint.metainfo.GetBucketVersioning(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	return &internalpb.GetBucketVersioningResponse{
+		Versioning: internalpb.Versioning(versioning),
+	}, nil
+}
+
+// SetBucketVersioning updates the versioning state of a bucket.
+func (endpoint *Endpoint) SetBucketVersioning(ctx context.Context, req *internalpb.SetBucketVersioningRequest) (resp *internalpb.SetBucketVersioningResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	versioning := Versioning(req.Versioning)
+	err = endpoint.metainfo.UpdateBucketVersioning(ctx, req.Bucket, keyInfo.ProjectID, versioning)
+	if err != nil {
+		if ErrInvalidVersioning.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		return nil, endpoint.convertBucketError(err)
+	}
+
+	endpoint.log.Info("Bucket Versioning", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Stringer("versioning", versioning), zap.String("operation", "put"), zap.String("type", "bucket"))
+	mon.Meter("req_set_bucket_versioning").Mark(1)
+
+	return &internalpb.SetBucketVersioningResponse{}, nil
+}
+
+// ListObjectVersions lists all committed versions and delete markers of the objects
+// in a bucket, ordered by key and version.
+func (endpoint *Endpoint) ListObjectVersions(ctx context.Context, req *internalpb.ObjectListVersionsRequest) (resp *internalpb.ObjectListVersionsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := req.Bucket
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionList,
+		Bucket:        bucket,
+		EncryptedPath: req.EncryptedPrefix,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit < 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+	}
+	if limit == 0 || limit > metabase.MaxListLimit {
+		limit = metabase.MaxListLimit
+	}
+
+	var prefix metabase.ObjectKey
+	if len(req.EncryptedPrefix) != 0 {
+		prefix = metabase.ObjectKey(req.EncryptedPrefix)
+		if prefix[len(prefix)-1] != metabase.Delimiter {
+			prefix += metabase.ObjectKey(metabase.Delimiter)
+		}
+	}
+	cursor := metabase.IterateCursor{
+		Version: metabase.Version(req.VersionCursor),
+	}
+	if len(req.EncryptedCursor) != 0 {
+		cursor.Key = prefix + metabase.ObjectKey(req.EncryptedCursor)
+	}
+
+	resp = &internalpb.ObjectListVersionsResponse{}
+
+	err = endpoint.metainfo.metabaseDB.IterateObjectsAllVersions(ctx,
+		metabase.IterateObjects{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(bucket),
+			Prefix:     prefix,
+			Cursor:     cursor,
+			BatchSize:  limit + 1,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			entry := metabase.ObjectEntry{}
+			for it.Next(ctx, &entry) {
+				if entry.Status != metabase.Committed && entry.Status != metabase.DeleteMarker {
+					continue
+				}
+				if len(resp.Items) >= limit {
+					resp.More = true
+					return nil
+				}
+				item, err := endpoint.objectEntryToProtoListItem(ctx, bucket, entry, prefix)
+				if err != nil {
+					return err
+				}
+				resp.Items = append(resp.Items, item)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if metabase.ErrInvalidRequest.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("unable to list object versions", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("Object List Versions", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "list_versions"), zap.String("type", "object"))
+	mon.Meter("req_list_object_versions").Mark(1)
+
+	return resp, nil
+}
+
+// GetObjectVersion gets the metadata of a specific committed object version.
+func (endpoint *Endpoint) GetObjectVersion(ctx context.Context, req *internalpb.ObjectGetVersionRequest) (resp *internalpb.ObjectGetVersionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedObjectKey,
+		Time:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	mbObject, err := endpoint.metainfo.metabaseDB.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
+		Version: metabase.Version(req.Version),
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  keyInfo.ProjectID,
+			BucketName: string(req.Bucket),
+			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+		},
+	})
+	if err != nil {
+		switch {
+		case storj.ErrObjectNotFound.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		case metabase.ErrInvalidRequest.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	object, err := endpoint.objectToProto(ctx, mbObject, nil)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("Object Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get_version"), zap.String("type", "object"))
+	mon.Meter("req_get_object_version").Mark(1)
+
+	return &internalpb.ObjectGetVersionResponse{
+		Object: object,
+	}, nil
+}
+
+// getBucketVersioning returns the versioning state of an existing bucket.
+func (endpoint *Endpoint) getBucketVersioning(ctx context.Context, bucket []byte, projectID uuid.UUID) (_ Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versioning, err := endpoint.metainfo.GetBucketVersioning(ctx, bucket, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return Unversioned, rpcstatus.Error(rpcstatus.NotFound, "bucket not found: non-existing-bucket")
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return Unversioned, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return versioning, nil
+}
+
+// deleteLatestCommittedVersion deletes the latest version of the object, unless
+// it's hidden by a delete marker.
+func (endpoint *Endpoint) deleteLatestCommittedVersion(ctx context.Context, location metabase.ObjectLocation) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := endpoint.metainfo.metabaseDB.GetObjectLatestVersion(ctx, metabase.GetObjectLatestVersion{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := endpoint.metainfo.metabaseDB.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+		Version:        object.Version,
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.deleteObjectsPieces(ctx, result)
+}
+
+// deleteObjectVersioned deletes the object according to the bucket versioning state.
+// In versioned buckets, the object is hidden by a delete marker.
+func (endpoint *Endpoint) deleteObjectVersioned(ctx context.Context, location metabase.ObjectLocation) (deletedObjects []*pb.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// missing buckets are handled as unversioned, to keep the delete behavior
+	// of unversioned buckets unchanged.
+	versioning, err := endpoint.metainfo.GetBucketVersioning(ctx, []byte(location.BucketName), location.ProjectID)
+	if err != nil && !storj.ErrBucketNotFound.Has(err) {
+		return nil, err
+	}
+
+	switch versioning {
+	case VersioningEnabled:
+		// older versions are kept, so nothing is deleted from the storage nodes.
+	case VersioningSuspended:
+		deletedObjects, err = endpoint.deleteLatestCommittedVersion(ctx, location)
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return nil, err
+		}
+	default:
+		return endpoint.DeleteCommittedObject(ctx, location.ProjectID, location.BucketName, location.ObjectKey)
+	}
+
+	_, err = endpoint.metainfo.metabaseDB.CreateDeleteMarker(ctx, metabase.CreateDeleteMarker{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedObjects, nil
+}
+
+// convertBucketError converts buckets db errors to rpc errors.
+func (endpoint *Endpoint) convertBucketError(err error) error {
+	if storj.ErrBucketNotFound.Has(err) {
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	}
+	endpoint.log.Error("internal", zap.Error(err))
+	return rpcstatus.Error(rpcstatus.Internal, err.Error())
+}
@@ -10,15 +10,26 @@ import (
 // Node defines necessary information for node-selection.
 type Node struct {
 	storj.NodeURL
-	LastNet    string
-	LastIPPort string
+	LastNet     string
+	LastIPPort  string
+	CountryCode string
+
+	// FreeDisk, Email and Wallet are used by selection strategies and
+	// operator-distinct selection.
+	FreeDisk int64
+	Email    string
+	Wallet   string
 }
 
 // Clone returns a deep clone of the selected node.
 func (node *Node) Clone() *Node {
 	return &Node{
-		NodeURL:    node.NodeURL,
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		NodeURL:     node.NodeURL,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		FreeDisk:    node.FreeDisk,
+		Email:       node.Email,
+		Wallet:      node.Wallet,
 	}
 }
@@ -0,0 +1,129 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"sort"
+	"strings"
+)
+
+// EUCountries contains the ISO 3166-1 alpha-2 codes of the European Union member states.
+var EUCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI",
+	"FR", "GR", "HR", "HU", "IE", "IT", "LT", "LU", "LV", "MT",
+	"NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// Placement defines where nodes storing the data are allowed to be located.
+// Countries are identified by ISO 3166-1 alpha-2 codes.
+//
+// The zero value doesn't constrain the selection. When any constraint is
+// defined, nodes with an unknown country are never selected.
+type Placement struct {
+	// AllowedCountries restricts the selection to nodes in the countries,
+	// empty allows all countries.
+	AllowedCountries []string `json:"allowedCountries,omitempty"`
+	// ExcludedCountries excludes nodes in the countries from the selection.
+	ExcludedCountries []string `json:"excludedCountries,omitempty"`
+}
+
+// IsZero returns whether the placement doesn't constrain the selection.
+func (placement Placement) IsZero() bool {
+	return len(placement.AllowedCountries) == 0 && len(placement.ExcludedCountries) == 0
+}
+
+// Verify verifies the country codes of the placement.
+func (placement Placement) Verify() error {
+	for _, code := range placement.AllowedCountries {
+		if !validCountryCode(code) {
+			return Error.New("invalid allowed country code %q", code)
+		}
+	}
+	for _, code := range placement.ExcludedCountries {
+		if !validCountryCode(code) {
+			return Error.New("invalid excluded country code %q", code)
+		}
+	}
+	return nil
+}
+
+// Allowed returns whether a node in the country is allowed by the placement.
+func (placement Placement) Allowed(countryCode string) bool {
+	if placement.IsZero() {
+		return true
+	}
+	if countryCode == "" {
+		return false
+	}
+	if len(placement.AllowedCountries) > 0 && !containsCountry(placement.AllowedCountries, countryCode) {
+		return false
+	}
+	return !containsCountry(placement.ExcludedCountries, countryCode)
+}
+
+// SatisfiedBy returns whether every node allowed by the other placement is
+// also allowed by the placement, e.g. whether data stored under the other
+// placement can be referenced by a bucket with the placement.
+func (placement Placement) SatisfiedBy(other Placement) bool {
+	if placement.IsZero() {
+		return true
+	}
+	if other.IsZero() {
+		return false
+	}
+
+	if len(other.AllowedCountries) > 0 {
+		for _, code := range other.AllowedCountries {
+			if containsCountry(other.ExcludedCountries, code) {
+				continue
+			}
+			if !placement.Allowed(code) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// other allows every country, except the excluded ones.
+	if len(placement.AllowedCountries) > 0 {
+		return false
+	}
+	for _, code := range placement.ExcludedCountries {
+		if !containsCountry(other.ExcludedCountries, code) {
+			return false
+		}
+	}
+	return true
+}
+
+// key returns a canonical representation of the placement.
+func (placement Placement) key() string {
+	allowed := append([]string{}, placement.AllowedCountries...)
+	excluded := append([]string{}, placement.ExcludedCountries...)
+	sort.Strings(allowed)
+	sort.Strings(excluded)
+	return strings.Join(allowed, ",") + "/" + strings.Join(excluded, ",")
+}
+
+// validCountryCode returns whether code looks like an upper-case ISO 3166-1 alpha-2 code.
+func validCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < 'A' || code[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func containsCountry(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
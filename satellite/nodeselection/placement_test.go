@@ -0,0 +1,66 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/nodeselection"
+)
+
+func TestPlacement(t *testing.T) {
+	var unconstrained nodeselection.Placement
+	require.True(t, unconstrained.IsZero())
+	require.NoError(t, unconstrained.Verify())
+	require.True(t, unconstrained.Allowed(""))
+	require.True(t, unconstrained.Allowed("US"))
+
+	eu := nodeselection.Placement{AllowedCountries: nodeselection.EUCountries}
+	require.False(t, eu.IsZero())
+	require.NoError(t, eu.Verify())
+	require.True(t, eu.Allowed("DE"))
+	require.False(t, eu.Allowed("US"))
+	require.False(t, eu.Allowed(""))
+
+	notUS := nodeselection.Placement{ExcludedCountries: []string{"US"}}
+	require.NoError(t, notUS.Verify())
+	require.True(t, notUS.Allowed("DE"))
+	require.False(t, notUS.Allowed("US"))
+	require.False(t, notUS.Allowed(""))
+
+	euNotDE := nodeselection.Placement{AllowedCountries: nodeselection.EUCountries, ExcludedCountries: []string{"DE"}}
+	require.True(t, euNotDE.Allowed("FR"))
+	require.False(t, euNotDE.Allowed("DE"))
+
+	for _, test := range []struct {
+		target, source nodeselection.Placement
+		satisfied      bool
+	}{
+		{unconstrained, unconstrained, true},
+		{unconstrained, eu, true},
+		{eu, unconstrained, false},
+		{eu, eu, true},
+		{eu, euNotDE, true},
+		{euNotDE, eu, false},
+		{notUS, eu, true},
+		{eu, notUS, false},
+		{notUS, notUS, true},
+		{nodeselection.Placement{ExcludedCountries: []string{"US", "CA"}}, notUS, false},
+		{notUS, nodeselection.Placement{ExcludedCountries: []string{"US", "CA"}}, true},
+		{euNotDE, nodeselection.Placement{AllowedCountries: []string{"FR", "DE"}, ExcludedCountries: []string{"DE"}}, true},
+	} {
+		require.Equal(t, test.satisfied, test.target.SatisfiedBy(test.source), test)
+	}
+
+	for _, invalid := range []nodeselection.Placement{
+		{AllowedCountries: []string{"de"}},
+		{AllowedCountries: []string{"DEU"}},
+		{ExcludedCountries: []string{""}},
+		{ExcludedCountries: []string{"1A"}},
+	} {
+		require.Error(t, invalid.Verify(), invalid)
+	}
+}
@@ -108,6 +108,15 @@ func (subnets SelectBySubnet) Select(n int, excludedIDs []storj.NodeID, excluded
 	return selected
 }
 
+// representatives returns a random node from every subnet.
+func (subnets SelectBySubnet) representatives() []*Node {
+	nodes := make([]*Node, 0, len(subnets))
+	for _, subnet := range subnets {
+		nodes = append(nodes, subnet.Nodes[mathrand.Intn(len(subnet.Nodes))])
+	}
+	return nodes
+}
+
 // ContainsID returns whether ids contains id.
 func ContainsID(ids []storj.NodeID, id storj.NodeID) bool {
 	for _, k := range ids {
@@ -20,8 +20,22 @@ type State struct {
 	mu sync.RWMutex
 
 	stats Stats
-	// netByID returns subnet based on storj.NodeID
-	netByID map[storj.NodeID]string
+	// nodeByID returns the node based on storj.NodeID
+	nodeByID map[storj.NodeID]*Node
+	// all contains selectors for nodes without placement constraints.
+	all *selectors
+
+	// reputable and new are used for creating selectors for placements.
+	reputable []*Node
+	new       []*Node
+
+	placementMu sync.Mutex
+	// placements contains lazily created selectors for placement constraints.
+	placements map[string]*selectors
+}
+
+// selectors contains selectors for a set of nodes.
+type selectors struct {
 	// nonDistinct contains selectors for non-distinct selection.
 	nonDistinct struct {
 		Reputable SelectByID
@@ -34,6 +48,17 @@ type State struct {
 	}
 }
 
+// newSelectors creates selectors for the reputable and new nodes.
+func newSelectors(reputableNodes, newNodes []*Node) *selectors {
+	s := &selectors{}
+	s.nonDistinct.Reputable = SelectByID(reputableNodes)
+	s.nonDistinct.New = SelectByID(newNodes)
+
+	s.distinct.Reputable = SelectBySubnetFromNodes(reputableNodes)
+	s.distinct.New = SelectBySubnetFromNodes(newNodes)
+	return s
+}
+
 // Stats contains state information.
 type Stats struct {
 	New       int
@@ -56,26 +81,25 @@ type Selector interface {
 func NewState(reputableNodes, newNodes []*Node) *State {
 	state := &State{}
 
-	state.netByID = map[storj.NodeID]string{}
+	state.nodeByID = map[storj.NodeID]*Node{}
 	for _, node := range reputableNodes {
-		state.netByID[node.ID] = node.LastNet
+		state.nodeByID[node.ID] = node
 	}
 	for _, node := range newNodes {
-		state.netByID[node.ID] = node.LastNet
+		state.nodeByID[node.ID] = node
 	}
 
-	state.nonDistinct.Reputable = SelectByID(reputableNodes)
-	state.nonDistinct.New = SelectByID(newNodes)
-
-	state.distinct.Reputable = SelectBySubnetFromNodes(reputableNodes)
-	state.distinct.New = SelectBySubnetFromNodes(newNodes)
+	state.all = newSelectors(reputableNodes, newNodes)
+	state.reputable = reputableNodes
+	state.new = newNodes
+	state.placements = map[string]*selectors{}
 
 	state.stats = Stats{
-		New:       state.nonDistinct.New.Count(),
-		Reputable: state.nonDistinct.Reputable.Count(),
+		New:       state.all.nonDistinct.New.Count(),
+		Reputable: state.all.nonDistinct.Reputable.Count(),
 
-		NewDistinct:       state.distinct.New.Count(),
-		ReputableDistinct: state.distinct.Reputable.Count(),
+		NewDistinct:       state.all.distinct.New.Count(),
+		ReputableDistinct: state.all.distinct.Reputable.Count(),
 	}
 
 	return state
@@ -87,6 +111,13 @@ type Request struct {
 	NewFraction float64
 	Distinct    bool
 	ExcludedIDs []storj.NodeID
+	Placement   Placement
+
+	// Strategy picks the nodes, nil selects nodes uniformly.
+	Strategy Strategy
+	// DistinctOperator ensures that at most one node per operator email or
+	// wallet is selected, including the nodes in ExcludedIDs.
+	DistinctOperator bool
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
@@ -105,18 +136,31 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	var reputableNodes Selector
 	var newNodes Selector
 
+	selectors := state.selectorsFor(request.Placement)
+
 	if request.Distinct {
 		excludedNets = map[string]struct{}{}
 		for _, id := range request.ExcludedIDs {
-			if net, ok := state.netByID[id]; ok {
-				excludedNets[net] = struct{}{}
+			if node, ok := state.nodeByID[id]; ok {
+				excludedNets[node.LastNet] = struct{}{}
 			}
 		}
-		reputableNodes = state.distinct.Reputable
-		newNodes = state.distinct.New
+	}
+
+	if request.Strategy != nil || request.DistinctOperator {
+		selected = state.selectWithStrategy(selectors, request, newCount, excludedNets)
+		if len(selected) < totalCount {
+			return selected, ErrNotEnoughNodes.New("requested from cache %d, found %d", totalCount, len(selected))
+		}
+		return selected, nil
+	}
+
+	if request.Distinct {
+		reputableNodes = selectors.distinct.Reputable
+		newNodes = selectors.distinct.New
 	} else {
-		reputableNodes = state.nonDistinct.Reputable
-		newNodes = state.nonDistinct.New
+		reputableNodes = selectors.nonDistinct.Reputable
+		newNodes = selectors.nonDistinct.New
 	}
 
 	// Get a random selection of new nodes out of the cache first so that if there aren't
@@ -135,6 +179,75 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	return selected, nil
 }
 
+// selectWithStrategy selects nodes using the strategy of the request.
+func (state *State) selectWithStrategy(selectors *selectors, request Request, newCount int, excludedNets map[string]struct{}) []*Node {
+	strategy := request.Strategy
+	if strategy == nil {
+		strategy = UniformStrategy{}
+	}
+
+	filter := &Filter{
+		ExcludedIDs:  request.ExcludedIDs,
+		ExcludedNets: excludedNets,
+	}
+	if request.DistinctOperator {
+		filter.ExcludedOperators = map[string]struct{}{}
+		for _, id := range request.ExcludedIDs {
+			if node, ok := state.nodeByID[id]; ok {
+				for _, key := range operatorKeys(node) {
+					filter.ExcludedOperators[key] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var reputableNodes, newNodes []*Node
+	if request.Distinct {
+		// strategies choose between subnets, hence only a single random node
+		// per subnet is considered.
+		reputableNodes = selectors.distinct.Reputable.representatives()
+		newNodes = selectors.distinct.New.representatives()
+	} else {
+		reputableNodes = selectors.nonDistinct.Reputable
+		newNodes = selectors.nonDistinct.New
+	}
+
+	selected := strategy.Select(newNodes, newCount, filter)
+	selected = append(selected, strategy.Select(reputableNodes, request.Count-len(selected), filter)...)
+	return selected
+}
+
+// selectorsFor returns selectors containing only nodes allowed by the placement.
+func (state *State) selectorsFor(placement Placement) *selectors {
+	if placement.IsZero() {
+		return state.all
+	}
+
+	key := placement.key()
+
+	state.placementMu.Lock()
+	defer state.placementMu.Unlock()
+
+	if s, ok := state.placements[key]; ok {
+		return s
+	}
+
+	s := newSelectors(filterNodes(state.reputable, placement), filterNodes(state.new, placement))
+	state.placements[key] = s
+	return s
+}
+
+// filterNodes returns nodes allowed by the placement.
+func filterNodes(nodes []*Node, placement Placement) []*Node {
+	var filtered []*Node
+	for _, node := range nodes {
+		if placement.Allowed(node.CountryCode) {
+			filtered = append(filtered, node)
+		}
+	}
+	return filtered
+}
+
 // Stats returns state information.
 func (state *State) Stats() Stats {
 	state.mu.RLock()
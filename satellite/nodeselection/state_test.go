@@ -161,6 +161,68 @@ func TestState_Select_Concurrent(t *testing.T) {
 	require.NoError(t, group.Wait())
 }
 
+func TestState_Select_Placement(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	reputableNodes := joinNodes(
+		withCountry(createRandomNodes(2, "1.0.1"), "DE"),
+		withCountry(createRandomNodes(2, "1.0.2"), "US"),
+		createRandomNodes(2, "1.0.3"),
+	)
+	newNodes := joinNodes(
+		withCountry(createRandomNodes(2, "1.0.4"), "FR"),
+		withCountry(createRandomNodes(2, "1.0.5"), "US"),
+	)
+
+	state := nodeselection.NewState(reputableNodes, newNodes)
+
+	{ // select only nodes in the EU
+		selected, err := state.Select(ctx, nodeselection.Request{
+			Count:       4,
+			NewFraction: 0.5,
+			Placement:   nodeselection.Placement{AllowedCountries: nodeselection.EUCountries},
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 4)
+		for _, node := range selected {
+			require.Contains(t, []string{"DE", "FR"}, node.CountryCode)
+		}
+	}
+
+	{ // there are not enough distinct EU subnets
+		selected, err := state.Select(ctx, nodeselection.Request{
+			Count:       3,
+			NewFraction: 0.5,
+			Distinct:    true,
+			Placement:   nodeselection.Placement{AllowedCountries: nodeselection.EUCountries},
+		})
+		require.Error(t, err)
+		require.Len(t, selected, 2)
+	}
+
+	{ // nodes with unknown country are not selected when excluding countries
+		selected, err := state.Select(ctx, nodeselection.Request{
+			Count:     8,
+			Placement: nodeselection.Placement{ExcludedCountries: []string{"US"}},
+		})
+		require.Error(t, err)
+		require.Len(t, selected, 2)
+		for _, node := range selected {
+			require.Equal(t, "DE", node.CountryCode)
+		}
+	}
+
+	{ // without placement all nodes are selectable
+		selected, err := state.Select(ctx, nodeselection.Request{
+			Count:       10,
+			NewFraction: 0.5,
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 10)
+	}
+}
+
 // createRandomNodes creates n random nodes all in the subnet.
 func createRandomNodes(n int, subnet string) []*nodeselection.Node {
 	xs := make([]*nodeselection.Node, n)
@@ -203,3 +265,11 @@ next:
 
 	return xs
 }
+
+// withCountry sets the country code of the nodes.
+func withCountry(nodes []*nodeselection.Node, countryCode string) []*nodeselection.Node {
+	for _, node := range nodes {
+		node.CountryCode = countryCode
+	}
+	return nodes
+}
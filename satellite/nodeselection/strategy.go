@@ -0,0 +1,222 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"math"
+	mathrand "math/rand" // Using mathrand here because crypto-graphic randomness is not required and simplifies code.
+	"sort"
+	"strings"
+
+	"storj.io/common/storj"
+)
+
+// Strategy names used for configuring the node selection.
+const (
+	// StrategyUniform selects nodes with equal probability.
+	StrategyUniform = "uniform"
+	// StrategyPowerOfTwo selects the better scoring node of two random nodes.
+	StrategyPowerOfTwo = "power-of-two"
+	// StrategyFreeSpace selects nodes with probability proportional to their free disk space.
+	StrategyFreeSpace = "free-space"
+)
+
+// Strategy defines how nodes are picked from the candidates.
+type Strategy interface {
+	// Select selects up to n nodes from the candidates, which are accepted by the filter.
+	Select(candidates []*Node, n int, filter *Filter) []*Node
+}
+
+// Scorer scores nodes for the power-of-two strategy, higher is better.
+type Scorer interface {
+	Score(id storj.NodeID) float64
+}
+
+// NewStrategy returns the strategy with the specified name. The scorer is
+// only used by the power-of-two strategy.
+func NewStrategy(name string, scorer Scorer) (Strategy, error) {
+	switch name {
+	case StrategyUniform, "":
+		return UniformStrategy{}, nil
+	case StrategyPowerOfTwo:
+		return PowerOfTwoStrategy{Scorer: scorer}, nil
+	case StrategyFreeSpace:
+		return FreeSpaceStrategy{}, nil
+	default:
+		return nil, Error.New("unknown node selection strategy %q", name)
+	}
+}
+
+// Filter contains the constraints of a single selection request. Accepting
+// a node marks its subnet and operator as used.
+type Filter struct {
+	ExcludedIDs []storj.NodeID
+	// ExcludedNets is nil when subnets don't need to be distinct.
+	ExcludedNets map[string]struct{}
+	// ExcludedOperators contains operator emails and wallets, it's nil when
+	// operators don't need to be distinct.
+	ExcludedOperators map[string]struct{}
+}
+
+// Accept returns whether the node can be selected.
+func (filter *Filter) Accept(node *Node) bool {
+	if ContainsID(filter.ExcludedIDs, node.ID) {
+		return false
+	}
+	if filter.ExcludedNets != nil {
+		if _, excluded := filter.ExcludedNets[node.LastNet]; excluded {
+			return false
+		}
+	}
+	if filter.ExcludedOperators != nil {
+		for _, key := range operatorKeys(node) {
+			if _, excluded := filter.ExcludedOperators[key]; excluded {
+				return false
+			}
+		}
+	}
+
+	if filter.ExcludedNets != nil {
+		filter.ExcludedNets[node.LastNet] = struct{}{}
+	}
+	if filter.ExcludedOperators != nil {
+		for _, key := range operatorKeys(node) {
+			filter.ExcludedOperators[key] = struct{}{}
+		}
+	}
+	return true
+}
+
+// operatorKeys returns the keys identifying the operator of the node.
+func operatorKeys(node *Node) []string {
+	var keys []string
+	if node.Email != "" {
+		keys = append(keys, "email:"+strings.ToLower(node.Email))
+	}
+	if node.Wallet != "" {
+		keys = append(keys, "wallet:"+strings.ToLower(node.Wallet))
+	}
+	return keys
+}
+
+// UniformStrategy selects nodes with equal probability.
+type UniformStrategy struct{}
+
+var _ Strategy = UniformStrategy{}
+
+// Select selects up to n nodes.
+func (UniformStrategy) Select(candidates []*Node, n int, filter *Filter) []*Node {
+	if n <= 0 {
+		return nil
+	}
+
+	selected := []*Node{}
+	for _, idx := range mathrand.Perm(len(candidates)) {
+		node := candidates[idx]
+		if !filter.Accept(node) {
+			continue
+		}
+
+		selected = append(selected, node.Clone())
+		if len(selected) >= n {
+			break
+		}
+	}
+	return selected
+}
+
+// PowerOfTwoStrategy picks two random nodes and selects the one with the
+// higher score, which avoids herding on the best nodes while still preferring
+// them.
+type PowerOfTwoStrategy struct {
+	Scorer Scorer
+}
+
+var _ Strategy = PowerOfTwoStrategy{}
+
+// Select selects up to n nodes.
+func (strategy PowerOfTwoStrategy) Select(candidates []*Node, n int, filter *Filter) []*Node {
+	if n <= 0 {
+		return nil
+	}
+
+	pool := make([]*Node, len(candidates))
+	copy(pool, candidates)
+
+	// take removes the node at index i from the pool.
+	take := func(i int) *Node {
+		node := pool[i]
+		last := len(pool) - 1
+		pool[i], pool[last] = pool[last], nil
+		pool = pool[:last]
+		return node
+	}
+
+	selected := []*Node{}
+	for len(pool) > 0 && len(selected) < n {
+		i := mathrand.Intn(len(pool))
+		if len(pool) > 1 && strategy.Scorer != nil {
+			k := mathrand.Intn(len(pool) - 1)
+			if k >= i {
+				k++
+			}
+			if strategy.Scorer.Score(pool[k].ID) > strategy.Scorer.Score(pool[i].ID) {
+				i = k
+			}
+		}
+
+		node := take(i)
+		if filter.Accept(node) {
+			selected = append(selected, node.Clone())
+		}
+	}
+	return selected
+}
+
+// FreeSpaceStrategy selects nodes with probability proportional to their
+// free disk space.
+type FreeSpaceStrategy struct{}
+
+var _ Strategy = FreeSpaceStrategy{}
+
+// Select selects up to n nodes.
+func (FreeSpaceStrategy) Select(candidates []*Node, n int, filter *Filter) []*Node {
+	if n <= 0 {
+		return nil
+	}
+
+	// weighted random sampling without replacement (Efraimidis-Spirakis),
+	// every node gets the key log(u)/weight and nodes are taken by descending key.
+	type keyed struct {
+		key  float64
+		node *Node
+	}
+	order := make([]keyed, len(candidates))
+	for i, node := range candidates {
+		weight := float64(node.FreeDisk)
+		if weight < 1 {
+			weight = 1
+		}
+		order[i] = keyed{
+			key:  math.Log(1-mathrand.Float64()) / weight,
+			node: node,
+		}
+	}
+	sort.Slice(order, func(i, k int) bool {
+		return order[i].key > order[k].key
+	})
+
+	selected := []*Node{}
+	for _, item := range order {
+		if !filter.Accept(item.node) {
+			continue
+		}
+
+		selected = append(selected, item.node.Clone())
+		if len(selected) >= n {
+			break
+		}
+	}
+	return selected
+}
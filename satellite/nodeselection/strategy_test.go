@@ -0,0 +1,209 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+func TestNewStrategy(t *testing.T) {
+	for _, name := range []string{"", nodeselection.StrategyUniform, nodeselection.StrategyPowerOfTwo, nodeselection.StrategyFreeSpace} {
+		strategy, err := nodeselection.NewStrategy(name, nil)
+		require.NoError(t, err, name)
+		require.NotNil(t, strategy, name)
+	}
+
+	_, err := nodeselection.NewStrategy("random", nil)
+	require.Error(t, err)
+}
+
+func TestUploadTracker(t *testing.T) {
+	tracker := nodeselection.NewUploadTracker()
+
+	fast, slow, failing, unknown := testrand.NodeID(), testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	for i := 0; i < 10; i++ {
+		tracker.Success(fast, 100*time.Millisecond)
+		tracker.Success(slow, 5*time.Second)
+		tracker.Failure(failing)
+	}
+
+	require.Equal(t, 1.0, tracker.Score(unknown))
+	require.Greater(t, tracker.Score(fast), tracker.Score(slow))
+	require.Greater(t, tracker.Score(fast), tracker.Score(failing))
+	require.Less(t, tracker.Score(failing), 0.5)
+
+	// departed nodes are evicted.
+	tracker.Retain([]*nodeselection.Node{
+		{NodeURL: storj.NodeURL{ID: fast}},
+		{NodeURL: storj.NodeURL{ID: unknown}},
+	})
+	require.Equal(t, 1, tracker.Len())
+	require.Equal(t, 1.0, tracker.Score(slow))
+	require.Less(t, tracker.Score(fast), 1.0)
+}
+
+func TestState_Select_DistinctOperator(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var nodes []*nodeselection.Node
+	for i := 0; i < 10; i++ {
+		node := createRandomNodes(1, fmt.Sprintf("1.0.%d", i))[0]
+		// every operator runs two nodes, odd operators share a wallet.
+		node.Email = fmt.Sprintf("operator%d@example.test", i/2)
+		if i%4 >= 2 {
+			node.Wallet = "0x01"
+		}
+		nodes = append(nodes, node)
+	}
+
+	state := nodeselection.NewState(nodes, nil)
+
+	for i := 0; i < 100; i++ {
+		selected, err := state.Select(ctx, nodeselection.Request{
+			Count:            3,
+			Distinct:         true,
+			DistinctOperator: true,
+			ExcludedIDs:      []storj.NodeID{nodes[0].ID},
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 3)
+
+		emails := map[string]bool{nodes[0].Email: true}
+		wallets := map[string]bool{}
+		for _, node := range selected {
+			require.False(t, emails[node.Email], "duplicate email %q", node.Email)
+			emails[node.Email] = true
+			if node.Wallet != "" {
+				require.False(t, wallets[node.Wallet], "duplicate wallet %q", node.Wallet)
+				wallets[node.Wallet] = true
+			}
+		}
+	}
+
+	// there are only 4 distinct operators excluding the excluded one.
+	_, err := state.Select(ctx, nodeselection.Request{
+		Count:            5,
+		DistinctOperator: true,
+		ExcludedIDs:      []storj.NodeID{nodes[0].ID},
+	})
+	require.True(t, nodeselection.ErrNotEnoughNodes.Has(err))
+}
+
+// TestStrategies_Simulation compares the piece distribution of the strategies
+// over a synthetic node set.
+func TestStrategies_Simulation(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const (
+		nodeCount    = 100
+		segmentCount = 2000
+		piecesCount  = 20
+	)
+
+	// nodes differ in free disk space and upload performance, every
+	// operator runs four nodes.
+	tracker := nodeselection.NewUploadTracker()
+	nodes := make([]*nodeselection.Node, nodeCount)
+	for i := range nodes {
+		node := createRandomNodes(1, fmt.Sprintf("1.%d.%d", i/250, i%250))[0]
+		node.FreeDisk = int64(1+i%10) * memory.TB.Int64()
+		node.Email = fmt.Sprintf("operator%d@example.test", i/4)
+		nodes[i] = node
+
+		for k := 0; k < 20; k++ {
+			if k%10 < i%10 {
+				tracker.Failure(node.ID)
+			} else {
+				tracker.Success(node.ID, time.Duration(i%10)*100*time.Millisecond)
+			}
+		}
+	}
+
+	state := nodeselection.NewState(nodes, nil)
+
+	type result struct {
+		name    string
+		request nodeselection.Request
+		pieces  map[storj.NodeID]int
+	}
+
+	results := []*result{
+		{name: "uniform", request: nodeselection.Request{Strategy: nodeselection.UniformStrategy{}}},
+		{name: "power-of-two", request: nodeselection.Request{Strategy: nodeselection.PowerOfTwoStrategy{Scorer: tracker}}},
+		{name: "free-space", request: nodeselection.Request{Strategy: nodeselection.FreeSpaceStrategy{}}},
+		{name: "operator-distinct", request: nodeselection.Request{DistinctOperator: true}},
+	}
+
+	for _, r := range results {
+		r.pieces = map[storj.NodeID]int{}
+		r.request.Count = piecesCount
+		r.request.Distinct = true
+
+		for i := 0; i < segmentCount; i++ {
+			selected, err := state.Select(ctx, r.request)
+			require.NoError(t, err, r.name)
+			require.Len(t, selected, piecesCount, r.name)
+
+			operators := map[string]bool{}
+			for _, node := range selected {
+				r.pieces[node.ID]++
+				if r.request.DistinctOperator {
+					require.False(t, operators[node.Email], r.name)
+					operators[node.Email] = true
+				}
+			}
+		}
+	}
+
+	// piecesBy sums pieces by the node index modulo 10, which determines the
+	// free disk space and the upload performance of the node.
+	piecesBy := func(r *result) [10]int {
+		var sums [10]int
+		for i, node := range nodes {
+			sums[i%10] += r.pieces[node.ID]
+		}
+		return sums
+	}
+
+	for _, r := range results {
+		counts := make([]int, 0, len(nodes))
+		for _, node := range nodes {
+			counts = append(counts, r.pieces[node.ID])
+		}
+		sort.Ints(counts)
+		t.Logf("%-18s min=%5d median=%5d max=%5d by class=%v",
+			r.name, counts[0], counts[len(counts)/2], counts[len(counts)-1], piecesBy(r))
+	}
+
+	total := segmentCount * piecesCount
+	expected := total / 10
+
+	uniform := piecesBy(results[0])
+	for _, count := range uniform {
+		require.InDelta(t, expected, count, float64(expected)/5)
+	}
+
+	// power-of-two prefers fast and reliable nodes.
+	powerOfTwo := piecesBy(results[1])
+	require.Greater(t, powerOfTwo[0], powerOfTwo[9])
+	require.Greater(t, powerOfTwo[0], expected)
+
+	// free-space prefers nodes with more free disk.
+	freeSpace := piecesBy(results[2])
+	require.Greater(t, freeSpace[9], freeSpace[0])
+	require.Greater(t, freeSpace[9], expected)
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// trackerDecay is the weight of a new upload outcome in the moving averages.
+const trackerDecay = 0.1
+
+// UploadTracker keeps exponentially weighted moving averages of recent upload
+// success rate and latency of nodes. It's used as a Scorer by the
+// power-of-two strategy.
+type UploadTracker struct {
+	mu    sync.Mutex
+	stats map[storj.NodeID]*uploadStats
+}
+
+type uploadStats struct {
+	successRate float64
+	latency     float64 // seconds
+}
+
+var _ Scorer = (*UploadTracker)(nil)
+
+// NewUploadTracker returns a new upload tracker.
+func NewUploadTracker() *UploadTracker {
+	return &UploadTracker{
+		stats: map[storj.NodeID]*uploadStats{},
+	}
+}
+
+// Success records a successful upload to the node, which took the specified duration.
+func (tracker *UploadTracker) Success(id storj.NodeID, duration time.Duration) {
+	if duration < 0 {
+		duration = 0
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	stats := tracker.get(id)
+	stats.successRate += trackerDecay * (1 - stats.successRate)
+	stats.latency += trackerDecay * (duration.Seconds() - stats.latency)
+}
+
+// Failure records a failed or cancelled upload to the node.
+func (tracker *UploadTracker) Failure(id storj.NodeID) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	stats := tracker.get(id)
+	stats.successRate -= trackerDecay * stats.successRate
+}
+
+// Score returns the score of the node, higher is better. Nodes without
+// recorded uploads get the best possible score, so they are tried out.
+func (tracker *UploadTracker) Score(id storj.NodeID) float64 {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	stats, ok := tracker.stats[id]
+	if !ok {
+		return 1
+	}
+	return stats.successRate / (1 + stats.latency)
+}
+
+// Retain removes the stats of nodes which aren't in the specified nodes,
+// e.g. nodes which left the network or were disqualified.
+func (tracker *UploadTracker) Retain(nodes []*Node) {
+	keep := make(map[storj.NodeID]struct{}, len(nodes))
+	for _, node := range nodes {
+		keep[node.ID] = struct{}{}
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	for id := range tracker.stats {
+		if _, ok := keep[id]; !ok {
+			delete(tracker.stats, id)
+		}
+	}
+}
+
+// Len returns the number of nodes with recorded uploads.
+func (tracker *UploadTracker) Len() int {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	return len(tracker.stats)
+}
+
+// get returns the stats of the node, creating them when missing.
+func (tracker *UploadTracker) get(id storj.NodeID) *uploadStats {
+	stats, ok := tracker.stats[id]
+	if !ok {
+		stats = &uploadStats{successRate: 1}
+		tracker.stats[id] = stats
+	}
+	return stats
+}
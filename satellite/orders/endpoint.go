@@ -48,6 +48,13 @@ type DB interface {
 	GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, from, to time.Time) (int64, error)
 	// GetStorageNodeBandwidth gets total storage node bandwidth from period of time
 	GetStorageNodeBandwidth(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (int64, error)
+
+	// InsertSettlementOutcome stores the outcome of a window settlement by a storage node.
+	InsertSettlementOutcome(ctx context.Context, outcome SettlementOutcome) error
+	// GetSettlementOutcomes returns the settlement outcomes of a storage node for the windows starting in [from, to).
+	GetSettlementOutcomes(ctx context.Context, nodeID storj.NodeID, from, to time.Time) ([]SettlementOutcome, error)
+	// DeleteSettlementOutcomesBefore deletes the settlement outcomes settled before the given time.
+	DeleteSettlementOutcomesBefore(ctx context.Context, before time.Time) (deleted int64, err error)
 }
 
 // SerialDeleteOptions are option when deleting from serial tables.
@@ -228,6 +235,8 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	storagenodeSettled := map[int32]int64{}
 	bucketSettled := map[bucketIDAction]int64{}
 	seenSerials := map[storj.SerialNumber]struct{}{}
+	rejected := map[SettlementRejectReason]int{}
+	var acceptedCount, unknownBucketCount int
 
 	var window int64
 	var request *pb.SettlementRequest
@@ -246,6 +255,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		orderLimit := request.Limit
 		if orderLimit == nil {
 			log.Debug("request.OrderLimit is nil")
+			rejected[RejectInvalid]++
 			continue
 		}
 		if window == 0 {
@@ -254,28 +264,33 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		order := request.Order
 		if order == nil {
 			log.Debug("request.Order is nil")
+			rejected[RejectInvalid]++
 			continue
 		}
 		serialNum := order.SerialNumber
 
 		// don't process orders that aren't valid
-		if !endpoint.isValid(ctx, log, order, orderLimit, peer.ID, window) {
+		if reason, ok := endpoint.isValid(ctx, log, order, orderLimit, peer.ID, window); !ok {
+			rejected[reason]++
 			continue
 		}
 
 		// don't process orders with serial numbers we've already seen
 		if _, ok := seenSerials[serialNum]; ok {
 			log.Debug("seen serial", zap.String("serial number", serialNum.String()))
+			rejected[RejectAlreadySettled]++
 			continue
 		}
 		seenSerials[serialNum] = struct{}{}
 
 		storagenodeSettled[int32(orderLimit.Action)] += order.Amount
+		acceptedCount++
 
 		metadata, err := endpoint.ordersService.DecryptOrderMetadata(ctx, orderLimit)
 		if err != nil {
 			log.Debug("decrypt order metadata err:", zap.Error(err))
 			mon.Event("bucketinfo_from_orders_metadata_error_1")
+			unknownBucketCount++
 			continue
 		}
 
@@ -286,6 +301,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 			if err != nil {
 				log.Debug("decrypt order: ParseCompactBucketPrefix", zap.Error(err))
 				mon.Event("bucketinfo_from_orders_metadata_error_compact")
+				unknownBucketCount++
 				continue
 			}
 		case len(metadata.ProjectBucketPrefix) > 0:
@@ -293,11 +309,13 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 			if err != nil {
 				log.Debug("decrypt order: ParseBucketPrefix", zap.Error(err))
 				mon.Event("bucketinfo_from_orders_metadata_error_uncompact")
+				unknownBucketCount++
 				continue
 			}
 		default:
 			log.Debug("decrypt order: project bucket prefix missing", zap.Error(err))
 			mon.Event("bucketinfo_from_orders_metadata_error_default")
+			unknownBucketCount++
 			continue
 		}
 
@@ -307,6 +325,7 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 				zap.String("projectID", bucketInfo.ProjectID.String()),
 			)
 			mon.Event("bucketinfo_from_orders_metadata_error_3")
+			unknownBucketCount++
 			continue
 		}
 
@@ -320,6 +339,15 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	if len(storagenodeSettled) == 0 {
 		log.Debug("no orders were successfully processed", zap.Int("received count", receivedCount))
 		status = pb.SettlementWithWindowResponse_REJECTED
+		if window != 0 {
+			endpoint.recordSettlementOutcome(ctx, log, SettlementOutcome{
+				StorageNodeID:  peer.ID,
+				IntervalStart:  time.Unix(0, window),
+				Status:         status,
+				OrdersReceived: receivedCount,
+				Rejected:       rejected,
+			})
+		}
 		return stream.SendAndClose(&pb.SettlementWithWindowResponse{
 			Status:        status,
 			ActionSettled: storagenodeSettled,
@@ -351,51 +379,88 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		mon.Event("orders_already_processed")
 	}
 
-	if status == pb.SettlementWithWindowResponse_REJECTED {
+	var amountSettled int64
+	for _, amount := range storagenodeSettled {
+		amountSettled += amount
+	}
+
+	switch {
+	case status == pb.SettlementWithWindowResponse_REJECTED:
 		storagenodeSettled = map[int32]int64{}
+		// the window was already settled with different amounts, which
+		// includes the orders with an unknown bucket.
+		rejected[RejectAlreadySettled] += acceptedCount
+		acceptedCount, unknownBucketCount, amountSettled = 0, 0, 0
+	case alreadyProcessed:
+		// the orders were settled by an earlier attempt, so nothing is
+		// settled by this one.
+		acceptedCount, unknownBucketCount, amountSettled = 0, 0, 0
 	}
+
+	endpoint.recordSettlementOutcome(ctx, log, SettlementOutcome{
+		StorageNodeID:       peer.ID,
+		IntervalStart:       time.Unix(0, window),
+		Status:              status,
+		AlreadyProcessed:    alreadyProcessed,
+		OrdersReceived:      receivedCount,
+		OrdersAccepted:      acceptedCount,
+		OrdersUnknownBucket: unknownBucketCount,
+		AmountSettled:       amountSettled,
+		Rejected:            rejected,
+	})
+
 	return stream.SendAndClose(&pb.SettlementWithWindowResponse{
 		Status:        status,
 		ActionSettled: storagenodeSettled,
 	})
 }
 
+// isValid verifies the order, returning the reason when it has to be rejected.
 func (endpoint *Endpoint) isValid(ctx context.Context, log *zap.Logger, order *pb.Order,
-	orderLimit *pb.OrderLimit, peerID storj.NodeID, window int64) bool {
+	orderLimit *pb.OrderLimit, peerID storj.NodeID, window int64) (SettlementRejectReason, bool) {
 	if orderLimit.StorageNodeId != peerID {
 		log.Debug("storage node id mismatch")
 		mon.Event("order_not_valid_storagenodeid")
-		return false
+		return RejectInvalid, false
 	}
 	// check expiration first before the signatures so that we can throw out the large amount
 	// of expired orders being sent to us before doing expensive signature verification.
 	if orderLimit.OrderExpiration.Before(time.Now().UTC()) {
 		log.Debug("invalid settlement: order limit expired")
 		mon.Event("order_not_valid_expired")
-		return false
+		return RejectExpired, false
 	}
 	// satellite verifies that it signed the order limit
 	if err := signing.VerifyOrderLimitSignature(ctx, endpoint.satelliteSignee, orderLimit); err != nil {
 		log.Debug("invalid settlement: unable to verify order limit")
 		mon.Event("order_not_valid_satellite_signature")
-		return false
+		return RejectBadSignature, false
 	}
 	// satellite verifies that the order signature matches pub key in order limit
 	if err := signing.VerifyUplinkOrderSignature(ctx, orderLimit.UplinkPublicKey, order); err != nil {
 		log.Debug("invalid settlement: unable to verify order")
 		mon.Event("order_not_valid_uplink_signature")
-		return false
+		return RejectBadSignature, false
 	}
 	if orderLimit.SerialNumber != order.SerialNumber {
 		log.Debug("invalid settlement: invalid serial number")
 		mon.Event("order_not_valid_serialnum_mismatch")
-		return false
+		return RejectInvalid, false
 	}
 	// verify the 1 hr windows match
 	if window != date.TruncateToHourInNano(orderLimit.OrderCreation) {
 		log.Debug("invalid settlement: window mismatch")
 		mon.Event("order_not_valid_window_mismatch")
-		return false
+		return RejectInvalid, false
+	}
+	return "", true
+}
+
+// recordSettlementOutcome stores the outcome of the settlement. A failure is
+// only logged, since the settlement itself has already been processed.
+func (endpoint *Endpoint) recordSettlementOutcome(ctx context.Context, log *zap.Logger, outcome SettlementOutcome) {
+	outcome.SettledAt = time.Now()
+	if err := endpoint.DB.InsertSettlementOutcome(ctx, outcome); err != nil {
+		log.Warn("failed to record settlement outcome", zap.Error(err))
 	}
-	return true
 }
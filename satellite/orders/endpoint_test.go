@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/orders"
 )
 
 func TestSettlementWithWindowEndpointManyOrders(t *testing.T) {
@@ -293,6 +294,23 @@ func TestSettlementWithWindowEndpointSingleOrder(t *testing.T) {
 				require.Equal(t, dataAmount, newBbw)
 			}()
 		}
+
+		// every settlement of the window has its outcome recorded
+		report, err := orders.GetSettlementReport(ctx, ordersDB, storagenode.ID(), now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, report.Outcomes, len(testCases))
+		require.Equal(t, 1, report.AcceptedWindows)
+		require.Equal(t, 1, report.RejectedWindows)
+		require.Equal(t, 1, report.RetriedWindows)
+		require.Equal(t, 2, report.OrdersReceived)
+		require.Equal(t, 1, report.OrdersAccepted)
+		require.Equal(t, dataAmount, report.AmountSettled)
+		require.Equal(t, 1, report.Rejected[orders.RejectAlreadySettled])
+
+		require.False(t, report.Outcomes[0].AlreadyProcessed)
+		require.True(t, report.Outcomes[1].AlreadyProcessed)
+		require.Zero(t, report.Outcomes[1].AmountSettled)
+		require.Equal(t, pb.SettlementWithWindowResponse_REJECTED, report.Outcomes[2].Status)
 	})
 }
 
@@ -0,0 +1,123 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+)
+
+// SettlementRejectReason describes why an order was not settled.
+type SettlementRejectReason string
+
+const (
+	// RejectExpired is used when the order limit expired before the order was settled.
+	RejectExpired = SettlementRejectReason("expired")
+	// RejectBadSignature is used when either the satellite signature of the order limit
+	// or the uplink signature of the order could not be verified.
+	RejectBadSignature = SettlementRejectReason("bad_signature")
+	// RejectAlreadySettled is used when the serial number was already seen in the window
+	// or when the window was already settled with different amounts.
+	RejectAlreadySettled = SettlementRejectReason("already_settled")
+	// RejectInvalid is used when the order is malformed or doesn't belong to the
+	// storage node or the window.
+	RejectInvalid = SettlementRejectReason("invalid")
+)
+
+// SettlementRejectReasons lists all the reject reasons in a stable order.
+var SettlementRejectReasons = []SettlementRejectReason{
+	RejectExpired,
+	RejectBadSignature,
+	RejectAlreadySettled,
+	RejectInvalid,
+}
+
+// SettlementOutcome is the outcome of a single settlement of a window by a storage node.
+//
+// When the window was already processed, the settlement is a retry and nothing
+// is settled again, so the accepted orders and the settled amount are zero.
+type SettlementOutcome struct {
+	StorageNodeID    storj.NodeID
+	IntervalStart    time.Time
+	SettledAt        time.Time
+	Status           pb.SettlementWithWindowResponse_Status
+	AlreadyProcessed bool
+
+	OrdersReceived int
+	OrdersAccepted int
+	// OrdersUnknownBucket is the number of accepted orders whose bucket could not
+	// be determined. They are settled for the storage node, but their bandwidth
+	// isn't attributed to any bucket.
+	OrdersUnknownBucket int
+	AmountSettled       int64
+	Rejected            map[SettlementRejectReason]int
+}
+
+// SettlementReport summarizes the settlement history of a storage node for a period.
+type SettlementReport struct {
+	StorageNodeID storj.NodeID
+	From          time.Time
+	To            time.Time
+
+	AcceptedWindows int
+	RejectedWindows int
+	// RetriedWindows counts the settlements of windows that were already
+	// processed. Their orders aren't included in the totals.
+	RetriedWindows int
+
+	OrdersReceived      int
+	OrdersAccepted      int
+	OrdersUnknownBucket int
+	AmountSettled       int64
+	Rejected            map[SettlementRejectReason]int
+
+	Outcomes []SettlementOutcome
+}
+
+// GetSettlementReport returns the settlement history of the storage node for
+// the windows starting in [from, to).
+func GetSettlementReport(ctx context.Context, db DB, nodeID storj.NodeID, from, to time.Time) (_ SettlementReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	outcomes, err := db.GetSettlementOutcomes(ctx, nodeID, from, to)
+	if err != nil {
+		return SettlementReport{}, Error.Wrap(err)
+	}
+
+	report := SettlementReport{
+		StorageNodeID: nodeID,
+		From:          from,
+		To:            to,
+		Rejected:      make(map[SettlementRejectReason]int, len(SettlementRejectReasons)),
+		Outcomes:      outcomes,
+	}
+	for _, reason := range SettlementRejectReasons {
+		report.Rejected[reason] = 0
+	}
+
+	for _, outcome := range outcomes {
+		switch {
+		case outcome.AlreadyProcessed:
+			report.RetriedWindows++
+			continue
+		case outcome.Status == pb.SettlementWithWindowResponse_ACCEPTED:
+			report.AcceptedWindows++
+		default:
+			report.RejectedWindows++
+		}
+
+		report.OrdersReceived += outcome.OrdersReceived
+		report.OrdersAccepted += outcome.OrdersAccepted
+		report.OrdersUnknownBucket += outcome.OrdersUnknownBucket
+		report.AmountSettled += outcome.AmountSettled
+		for reason, count := range outcome.Rejected {
+			report.Rejected[reason] += count
+		}
+	}
+
+	return report, nil
+}
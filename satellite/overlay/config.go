@@ -24,6 +24,12 @@ type Config struct {
 	NodeSelectionCache   UploadSelectionCacheConfig
 	UpdateStatsBatchSize int `help:"number of update requests to process per transaction" default:"100"`
 	AuditHistory         AuditHistoryConfig
+	GeoIP                GeoIPConfig
+}
+
+// GeoIPConfig is a configuration struct for resolving the country of nodes.
+type GeoIPConfig struct {
+	DB string `help:"path to a CSV database of first_ip,last_ip,country_code ranges used to resolve node countries, empty disables resolving" default:""`
 }
 
 // AsOfSystemTimeConfig is a configuration struct to enable 'AS OF SYSTEM TIME' for CRDB queries.
@@ -41,6 +47,8 @@ type NodeSelectionConfig struct {
 	OnlineWindow     time.Duration `help:"the amount of time without seeing a node before its considered offline" default:"4h"`
 	DistinctIP       bool          `help:"require distinct IPs when choosing nodes for upload" releaseDefault:"true" devDefault:"false"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum must have to be selected for upload" default:"500.00MB"`
+	Strategy         string        `help:"node selection strategy for uploads: uniform, power-of-two or free-space" default:"uniform"`
+	DistinctOperator bool          `help:"require distinct operator emails and wallets when choosing nodes for upload" default:"false"`
 
 	AuditReputationRepairWeight float64       `help:"weight to apply to audit reputation for total repair reputation calculation" default:"1.0"`
 	AuditReputationUplinkWeight float64       `help:"weight to apply to audit reputation for total uplink reputation calculation" default:"1.0"`
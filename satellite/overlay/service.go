@@ -14,7 +14,9 @@ import (
 
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
 )
 
 // ErrEmptyNode is returned when the nodeID is empty.
@@ -100,6 +102,8 @@ type DB interface {
 	TestVetNode(ctx context.Context, nodeID storj.NodeID) (vettedTime *time.Time, err error)
 	// TestUnvetNode directly sets a node's vetted_at timestamp to null to make testing easier
 	TestUnvetNode(ctx context.Context, nodeID storj.NodeID) (err error)
+	// TestNodeCountryCode directly sets a node's country code to make testing easier
+	TestNodeCountryCode(ctx context.Context, nodeID storj.NodeID, countryCode string) (err error)
 
 	// AuditHistoryDB includes operations for interfacing with the audit history table.
 	AuditHistoryDB
@@ -120,6 +124,8 @@ type NodeCheckInInfo struct {
 	Operator   *pb.NodeOperator
 	Capacity   *pb.NodeCapacity
 	Version    *pb.NodeVersion
+	// CountryCode is resolved from LastIPPort by the overlay service, empty when unknown.
+	CountryCode string
 }
 
 // InfoResponse contains node dossier info requested from the storage node.
@@ -136,6 +142,7 @@ type FindStorageNodesRequest struct {
 	ExcludedIDs            []storj.NodeID
 	MinimumVersion         string        // semver or empty
 	AsOfSystemTimeInterval time.Duration // only used for CRDB queries
+	Placement              nodeselection.Placement
 }
 
 // NodeCriteria are the requirements for selecting nodes.
@@ -146,7 +153,9 @@ type NodeCriteria struct {
 	MinimumVersion         string   // semver or empty
 	OnlineWindow           time.Duration
 	DistinctIP             bool
+	DistinctOperator       bool
 	AsOfSystemTimeInterval time.Duration // only used for CRDB queries
+	Placement              nodeselection.Placement
 }
 
 // AuditType is an enum representing the outcome of a particular audit reported to the overlay.
@@ -246,10 +255,16 @@ type NodeLastContact struct {
 
 // SelectedNode is used as a result for creating orders limits.
 type SelectedNode struct {
-	ID         storj.NodeID
-	Address    *pb.NodeAddress
-	LastNet    string
-	LastIPPort string
+	ID          storj.NodeID
+	Address     *pb.NodeAddress
+	LastNet     string
+	LastIPPort  string
+	CountryCode string
+
+	// FreeDisk, Email and Wallet are only loaded for upload selection.
+	FreeDisk int64
+	Email    string
+	Wallet   string
 }
 
 // Clone returns a deep clone of the selected node.
@@ -260,8 +275,12 @@ func (node *SelectedNode) Clone() *SelectedNode {
 			Transport: node.Address.Transport,
 			Address:   node.Address.Address,
 		},
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		FreeDisk:    node.FreeDisk,
+		Email:       node.Email,
+		Wallet:      node.Wallet,
 	}
 }
 
@@ -272,6 +291,7 @@ type Service struct {
 	log    *zap.Logger
 	db     DB
 	config Config
+	geoip  geoip.IPToCountry
 
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
@@ -282,11 +302,24 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 	if err := config.Node.AsOfSystemTime.isValid(); err != nil {
 		return nil, err
 	}
+	if _, err := nodeselection.NewStrategy(config.Node.Strategy, nil); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var ipToCountry geoip.IPToCountry
+	if config.GeoIP.DB != "" {
+		database, err := geoip.Open(config.GeoIP.DB)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ipToCountry = database
+	}
 
 	return &Service{
 		log:    log,
 		db:     db,
 		config: config,
+		geoip:  ipToCountry,
 
 		UploadSelectionCache: NewUploadSelectionCache(log, db,
 			config.NodeSelectionCache.Staleness, config.Node,
@@ -301,7 +334,12 @@ func NewService(log *zap.Logger, db DB, config Config) (*Service, error) {
 }
 
 // Close closes resources.
-func (service *Service) Close() error { return nil }
+func (service *Service) Close() error {
+	if service.geoip != nil {
+		return service.geoip.Close()
+	}
+	return nil
+}
 
 // Get looks up the provided nodeID from the overlay.
 func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (_ *NodeDossier, err error) {
@@ -399,7 +437,9 @@ func (service *Service) FindStorageNodesWithPreferences(ctx context.Context, req
 		MinimumVersion:         preferences.MinimumVersion,
 		OnlineWindow:           preferences.OnlineWindow,
 		DistinctIP:             preferences.DistinctIP,
+		DistinctOperator:       preferences.DistinctOperator,
 		AsOfSystemTimeInterval: req.AsOfSystemTimeInterval,
+		Placement:              req.Placement,
 	}
 	nodes, err = service.db.SelectStorageNodes(ctx, totalNeededNodes, newNodeCount, &criteria)
 	if err != nil {
@@ -477,6 +517,28 @@ func (service *Service) UpdateStats(ctx context.Context, request *UpdateRequest)
 	return service.db.UpdateStats(ctx, request, time.Now())
 }
 
+// ApplyLostPiecesReport updates the audit reputation of a node which reported lost pieces.
+// The report counts as a failed audit with the weight scaled by the number of pieces and
+// the given factor, so that nodes admitting lost pieces are penalized less than nodes
+// failing audits for them.
+func (service *Service) ApplyLostPiecesReport(ctx context.Context, nodeID storj.NodeID, pieces int, weight float64) (stats *NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	request := &UpdateRequest{
+		NodeID:       nodeID,
+		AuditOutcome: AuditFailure,
+
+		AuditLambda:              service.config.Node.AuditReputationLambda,
+		AuditWeight:              service.config.Node.AuditReputationWeight * weight * float64(pieces),
+		AuditDQ:                  service.config.Node.AuditReputationDQ,
+		SuspensionGracePeriod:    service.config.Node.SuspensionGracePeriod,
+		SuspensionDQEnabled:      service.config.Node.SuspensionDQEnabled,
+		AuditsRequiredForVetting: service.config.Node.AuditCount,
+		AuditHistory:             service.config.AuditHistory,
+	}
+	return service.db.UpdateStats(ctx, request, time.Now())
+}
+
 // UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 func (service *Service) UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *InfoResponse) (stats *NodeDossier, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -484,11 +546,39 @@ func (service *Service) UpdateNodeInfo(ctx context.Context, node storj.NodeID, n
 }
 
 // UpdateCheckIn updates a single storagenode's check-in info.
+// The country of the node is resolved from LastIPPort, when a GeoIP database is configured.
 func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo, timestamp time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	node.CountryCode = service.lookupCountry(node.NodeID, node.LastIPPort)
 	return service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 }
 
+// lookupCountry returns the country of the node at lastIPPort, empty when it's unknown.
+func (service *Service) lookupCountry(nodeID storj.NodeID, lastIPPort string) string {
+	if service.geoip == nil || lastIPPort == "" {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(lastIPPort)
+	if err != nil {
+		host = lastIPPort
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		service.log.Debug("unable to parse node ip", zap.Stringer("Node ID", nodeID), zap.String("address", lastIPPort))
+		return ""
+	}
+
+	country, err := service.geoip.LookupIPCountry(ip)
+	if err != nil {
+		service.log.Warn("unable to resolve node country", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return ""
+	}
+	return country
+}
+
 // GetMissingPieces returns the list of offline nodes.
 func (service *Service) GetMissingPieces(ctx context.Context, pieces metabase.Pieces) (missingPieces []uint16, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -6,6 +6,7 @@ package overlay_test
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"reflect"
 	"sort"
 	"testing"
@@ -24,6 +25,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -656,6 +658,67 @@ func TestUpdateCheckIn(t *testing.T) {
 }
 
 // TestSuspendedSelection ensures that suspended nodes are not selected by SelectStorageNodes.
+func TestUpdateCheckInCountryCode(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		geoipDB := ctx.File("geoip.csv")
+		require.NoError(t, ioutil.WriteFile(geoipDB, []byte("10.0.0.0,10.0.0.255,DE\n10.0.1.0,10.0.1.255,US\n"), 0644))
+
+		config := overlay.Config{
+			Node:                 testNodeSelectionConfig(1, false),
+			UpdateStatsBatchSize: 100,
+			AuditHistory:         testAuditHistoryConfig(),
+			GeoIP:                overlay.GeoIPConfig{DB: geoipDB},
+		}
+		service, err := overlay.NewService(zaptest.NewLogger(t), db.OverlayCache(), config)
+		require.NoError(t, err)
+		defer ctx.Check(service.Close)
+
+		var nodeIDs []storj.NodeID
+		for i, address := range []string{"10.0.0.1:8080", "10.0.1.1:8080", "10.0.2.1:8080"} {
+			nodeID := storj.NodeID{byte(i + 1)}
+			err := service.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:     nodeID,
+				Address:    &pb.NodeAddress{Address: address},
+				LastIPPort: address,
+				LastNet:    address[:6],
+				IsUp:       true,
+				Capacity:   &pb.NodeCapacity{FreeDisk: memory.GiB.Int64()},
+				Operator:   &pb.NodeOperator{Email: "test@email.com", Wallet: "0x123"},
+				Version:    &pb.NodeVersion{Version: "v1.0.0", Release: true},
+			}, time.Now())
+			require.NoError(t, err)
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+
+		for _, tt := range []struct {
+			placement nodeselection.Placement
+			expected  []storj.NodeID
+		}{
+			{nodeselection.Placement{AllowedCountries: nodeselection.EUCountries}, nodeIDs[:1]},
+			{nodeselection.Placement{ExcludedCountries: []string{"DE"}}, nodeIDs[1:2]},
+			{nodeselection.Placement{}, nodeIDs},
+		} {
+			selected, err := service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{
+				RequestedCount: len(tt.expected),
+				Placement:      tt.placement,
+			}, &config.Node)
+			require.NoError(t, err)
+
+			var selectedIDs []storj.NodeID
+			for _, node := range selected {
+				selectedIDs = append(selectedIDs, node.ID)
+			}
+			require.ElementsMatch(t, tt.expected, selectedIDs)
+		}
+
+		_, err = service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 2,
+			Placement:      nodeselection.Placement{AllowedCountries: []string{"DE"}},
+		}, &config.Node)
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+	})
+}
+
 func TestSuspendedSelection(t *testing.T) {
 	totalNodes := 10
 
@@ -839,6 +902,25 @@ func TestVetAndUnvetNode(t *testing.T) {
 	})
 }
 
+func TestApplyLostPiecesReport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].Overlay.Service
+		few, many := planet.StorageNodes[0].ID(), planet.StorageNodes[1].ID()
+
+		fewStats, err := service.ApplyLostPiecesReport(ctx, few, 1, 0.5)
+		require.NoError(t, err)
+		manyStats, err := service.ApplyLostPiecesReport(ctx, many, 10, 0.5)
+		require.NoError(t, err)
+
+		// the penalty grows with the number of reported pieces
+		require.Greater(t, manyStats.AuditReputationBeta, fewStats.AuditReputationBeta)
+		require.Less(t, manyStats.AuditReputationAlpha/(manyStats.AuditReputationAlpha+manyStats.AuditReputationBeta),
+			fewStats.AuditReputationAlpha/(fewStats.AuditReputationAlpha+fewStats.AuditReputationBeta))
+	})
+}
+
 func offlineSuspendNode(ctx context.Context, oc overlay.DB, config *overlay.AuditHistoryConfig, nodeID storj.NodeID) error {
 	updateReq := &overlay.UpdateRequest{
 		NodeID:       nodeID,
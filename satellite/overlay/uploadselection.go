@@ -38,6 +38,10 @@ type UploadSelectionCache struct {
 	selectionConfig NodeSelectionConfig
 	staleness       time.Duration
 
+	// strategy is nil for the default uniform selection.
+	strategy nodeselection.Strategy
+	tracker  *nodeselection.UploadTracker
+
 	mu          sync.RWMutex
 	lastRefresh time.Time
 	state       *nodeselection.State
@@ -45,12 +49,24 @@ type UploadSelectionCache struct {
 
 // NewUploadSelectionCache creates a new cache that keeps a list of all the storage nodes that are qualified to store data.
 func NewUploadSelectionCache(log *zap.Logger, db UploadSelectionDB, staleness time.Duration, config NodeSelectionConfig) *UploadSelectionCache {
-	return &UploadSelectionCache{
+	cache := &UploadSelectionCache{
 		log:             log,
 		db:              db,
 		staleness:       staleness,
 		selectionConfig: config,
+		tracker:         nodeselection.NewUploadTracker(),
 	}
+
+	if config.Strategy != "" && config.Strategy != nodeselection.StrategyUniform {
+		strategy, err := nodeselection.NewStrategy(config.Strategy, cache.tracker)
+		if err != nil {
+			log.Error("invalid node selection strategy, using uniform selection", zap.Error(err))
+		} else {
+			cache.strategy = strategy
+		}
+	}
+
+	return cache
 }
 
 // Refresh populates the cache with all of the reputableNodes and newNode nodes
@@ -78,8 +94,13 @@ func (cache *UploadSelectionCache) refresh(ctx context.Context) (state *nodesele
 		return cache.state, err
 	}
 
+	reputable, unvetted := convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes)
+
 	cache.lastRefresh = time.Now().UTC()
-	cache.state = nodeselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	cache.state = nodeselection.NewState(reputable, unvetted)
+
+	// nodes which aren't selectable anymore don't need the upload stats.
+	cache.tracker.Retain(append(reputable, unvetted...))
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
@@ -110,6 +131,10 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		NewFraction: cache.selectionConfig.NewNodeFraction,
 		Distinct:    cache.selectionConfig.DistinctIP,
 		ExcludedIDs: req.ExcludedIDs,
+		Placement:   req.Placement,
+
+		Strategy:         cache.strategy,
+		DistinctOperator: cache.selectionConfig.DistinctOperator,
 	})
 	if nodeselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -118,6 +143,17 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 	return convNodesToSelectedNodes(selected), err
 }
 
+// RecordUploadSuccess records a successful piece upload to the node, which
+// is used for scoring nodes by the power-of-two strategy.
+func (cache *UploadSelectionCache) RecordUploadSuccess(id storj.NodeID, duration time.Duration) {
+	cache.tracker.Success(id, duration)
+}
+
+// RecordUploadFailure records a failed or cancelled piece upload to the node.
+func (cache *UploadSelectionCache) RecordUploadFailure(id storj.NodeID) {
+	cache.tracker.Failure(id)
+}
+
 // Size returns how many reputable nodes and new nodes are in the cache.
 func (cache *UploadSelectionCache) Size() (reputableNodeCount int, newNodeCount int) {
 	cache.mu.RLock()
@@ -135,10 +171,14 @@ func (cache *UploadSelectionCache) Size() (reputableNodeCount int, newNodeCount
 func convNodesToSelectedNodes(nodes []*nodeselection.Node) (xs []*SelectedNode) {
 	for _, n := range nodes {
 		xs = append(xs, &SelectedNode{
-			ID:         n.ID,
-			Address:    &pb.NodeAddress{Address: n.Address},
-			LastNet:    n.LastNet,
-			LastIPPort: n.LastIPPort,
+			ID:          n.ID,
+			Address:     &pb.NodeAddress{Address: n.Address},
+			LastNet:     n.LastNet,
+			LastIPPort:  n.LastIPPort,
+			CountryCode: n.CountryCode,
+			FreeDisk:    n.FreeDisk,
+			Email:       n.Email,
+			Wallet:      n.Wallet,
 		})
 	}
 	return xs
@@ -151,8 +191,12 @@ func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*nodeselection.Node)
 				ID:      n.ID,
 				Address: n.Address.Address,
 			},
-			LastNet:    n.LastNet,
-			LastIPPort: n.LastIPPort,
+			LastNet:     n.LastNet,
+			LastIPPort:  n.LastIPPort,
+			CountryCode: n.CountryCode,
+			FreeDisk:    n.FreeDisk,
+			Email:       n.Email,
+			Wallet:      n.Wallet,
 		})
 	}
 	return xs
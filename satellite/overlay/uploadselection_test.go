@@ -21,6 +21,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -226,6 +227,49 @@ func TestGetNodes(t *testing.T) {
 	})
 }
 
+func TestGetNodesPlacement(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := overlay.NewUploadSelectionCache(zap.NewNop(),
+			db.OverlayCache(),
+			lowStaleness,
+			nodeSelectionConfig,
+		)
+
+		// add 4 reputable nodes to the database, the last one with an unknown country
+		nodeIDs := addNodesToNodesTable(ctx, t, db.OverlayCache(), 4, 4)
+		require.Len(t, nodeIDs, 4)
+		countries := []string{"DE", "FR", "US", ""}
+		for i, id := range nodeIDs {
+			require.NoError(t, db.OverlayCache().TestNodeCountryCode(ctx, id, countries[i]))
+		}
+
+		eu := nodeselection.Placement{AllowedCountries: nodeselection.EUCountries}
+		selectedNodes, err := cache.GetNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 2,
+			Placement:      eu,
+		})
+		require.NoError(t, err)
+		require.Len(t, selectedNodes, 2)
+		for _, node := range selectedNodes {
+			require.Contains(t, nodeIDs[:2], node.ID)
+		}
+
+		_, err = cache.GetNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 3,
+			Placement:      eu,
+		})
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		// nodes with an unknown country are never selected with a placement
+		selectedNodes, err = cache.GetNodes(ctx, overlay.FindStorageNodesRequest{
+			RequestedCount: 2,
+			Placement:      nodeselection.Placement{ExcludedCountries: []string{"DE"}},
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, nodeIDs[1:3], []storj.NodeID{selectedNodes[0].ID, selectedNodes[1].ID})
+	})
+}
+
 func TestGetNodesConcurrent(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -5,32 +5,43 @@ package satellite
 
 import (
 	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
 
 	hw "github.com/jtolds/monkit-hw/v2"
 	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
 
 	"storj.io/common/identity"
 	"storj.io/private/debug"
 	"storj.io/storj/pkg/server"
+	"storj.io/storj/private/post"
+	"storj.io/storj/private/post/oauth2"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/apikeyusage"
 	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/adminauth"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/usagealerts"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlifecycle"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
@@ -40,7 +51,9 @@ import (
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/durability"
 	"storj.io/storj/satellite/repair/irreparable"
+	"storj.io/storj/satellite/repair/lostpieces"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/revocation"
@@ -81,6 +94,10 @@ type DB interface {
 	RepairQueue() queue.RepairQueue
 	// Irreparable returns database for failed repairs
 	Irreparable() irreparable.DB
+	// DurabilityHistograms returns database for durability histograms computed by the checker
+	DurabilityHistograms() durability.DB
+	// LostPieces returns database for pieces reported as lost by storage nodes
+	LostPieces() lostpieces.DB
 	// Console returns database for satellite console
 	Console() console.DB
 	// Orders returns database for orders
@@ -101,6 +118,8 @@ type DB interface {
 	Revocation() revocation.DB
 	// NodeAPIVersion tracks nodes observed api usage
 	NodeAPIVersion() nodeapiversion.DB
+	// AdminAuth returns database for the admin api tokens and audit log
+	AdminAuth() adminauth.DB
 }
 
 // Config is the global config satellite.
@@ -118,14 +137,18 @@ type Config struct {
 	Metainfo metainfo.Config
 	Orders   orders.Config
 
-	Checker  checker.Config
-	Repairer repairer.Config
-	Audit    audit.Config
+	Checker           checker.Config
+	Repairer          repairer.Config
+	RepairCoordinator repairer.CoordinatorConfig
+	LostPieces        lostpieces.Config
+	Audit             audit.Config
 
 	GarbageCollection gc.Config
 
 	ExpiredDeletion expireddeletion.Config
 
+	BucketLifecycle bucketlifecycle.Config
+
 	Tally            tally.Config
 	Rollup           rollup.Config
 	RollupArchive    rolluparchive.Config
@@ -138,6 +161,8 @@ type Config struct {
 
 	Console consoleweb.Config
 
+	UsageAlerts usagealerts.Config
+
 	Version version_checker.Config
 
 	GracefulExit gracefulexit.Config
@@ -148,5 +173,71 @@ type Config struct {
 
 	ProjectLimit accounting.ProjectLimitConfig
 
+	APIKeyUsage apikeyusage.Config
+
 	Analytics analytics.Config
 }
+
+// setupMailService creates the mail service with the sender of the mail config.
+func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, error) {
+	// TODO(yar): test multiple satellites using same OAUTH credentials
+	mailConfig := config.Mail
+
+	// validate from mail address
+	from, err := mail.ParseAddress(mailConfig.From)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate smtp server address
+	host, _, err := net.SplitHostPort(mailConfig.SMTPServerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var sender mailservice.Sender
+	switch mailConfig.AuthType {
+	case "oauth2":
+		creds := oauth2.Credentials{
+			ClientID:     mailConfig.ClientID,
+			ClientSecret: mailConfig.ClientSecret,
+			TokenURI:     mailConfig.TokenURI,
+		}
+		token, err := oauth2.RefreshToken(context.TODO(), creds, mailConfig.RefreshToken)
+		if err != nil {
+			return nil, err
+		}
+
+		sender = &post.SMTPSender{
+			From: *from,
+			Auth: &oauth2.Auth{
+				UserEmail: from.Address,
+				Storage:   oauth2.NewTokenStore(creds, *token),
+			},
+			ServerAddress: mailConfig.SMTPServerAddress,
+		}
+	case "plain":
+		sender = &post.SMTPSender{
+			From:          *from,
+			Auth:          smtp.PlainAuth("", mailConfig.Login, mailConfig.Password, host),
+			ServerAddress: mailConfig.SMTPServerAddress,
+		}
+	case "login":
+		sender = &post.SMTPSender{
+			From: *from,
+			Auth: post.LoginAuth{
+				Username: mailConfig.Login,
+				Password: mailConfig.Password,
+			},
+			ServerAddress: mailConfig.SMTPServerAddress,
+		}
+	default:
+		sender = &simulate.LinkClicker{}
+	}
+
+	return mailservice.New(
+		log.Named("mail:service"),
+		sender,
+		mailConfig.TemplatePath,
+	)
+}
@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/satellite/metainfo/metaloop"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/durability"
 	"storj.io/storj/satellite/repair/irreparable"
 	"storj.io/storj/satellite/repair/queue"
 )
@@ -39,6 +40,7 @@ type Checker struct {
 	logger          *zap.Logger
 	repairQueue     queue.RepairQueue
 	irrdb           irreparable.DB
+	durabilityDB    durability.DB
 	metabase        metainfo.MetabaseDB
 	metaLoop        *metaloop.Service
 	nodestate       *ReliabilityCache
@@ -50,12 +52,13 @@ type Checker struct {
 }
 
 // NewChecker creates a new instance of checker.
-func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, irrdb irreparable.DB, metabase metainfo.MetabaseDB, metaLoop *metaloop.Service, overlay *overlay.Service, config Config) *Checker {
+func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, irrdb irreparable.DB, durabilityDB durability.DB, metabase metainfo.MetabaseDB, metaLoop *metaloop.Service, overlay *overlay.Service, config Config) *Checker {
 	return &Checker{
 		logger: logger,
 
 		repairQueue:     repairQueue,
 		irrdb:           irrdb,
+		durabilityDB:    durabilityDB,
 		metabase:        metabase,
 		metaLoop:        metaLoop,
 		nodestate:       NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
@@ -133,6 +136,7 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		repairOverrides:  checker.repairOverrides,
 		nodeFailureRate:  checker.nodeFailureRate,
 		getNodesEstimate: checker.getNodesEstimate,
+		durability:       durability.NewCollector(),
 		log:              checker.logger,
 	}
 	err = checker.metaLoop.Join(ctx, observer)
@@ -149,6 +153,11 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		return Error.Wrap(err)
 	}
 
+	err = checker.durabilityDB.Replace(ctx, observer.durability.Histograms(), time.Now())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	checker.statsCollector.collectAggregates()
 
 	mon.IntVal("remote_files_checked").Observe(observer.monStats.objectsChecked)                               //mon:locked
@@ -275,6 +284,7 @@ type checkerObserver struct {
 	repairOverrides  RepairOverridesMap
 	nodeFailureRate  float64
 	getNodesEstimate func(ctx context.Context) (int, error)
+	durability       *durability.Collector
 	log              *zap.Logger
 
 	// we need to delay counting objects to ensure they get associated with the correct redundancy only once
@@ -368,6 +378,13 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *metaloop
 
 	required, repairThreshold, successThreshold, _ := obs.loadRedundancy(segment.Redundancy)
 
+	obs.durability.Add(segment.Location.ProjectID, segment.Location.BucketName, durability.Redundancy{
+		RequiredShares: int16(required),
+		RepairShares:   int16(repairThreshold),
+		OptimalShares:  int16(successThreshold),
+		TotalShares:    segment.Redundancy.TotalShares,
+	}, numHealthy)
+
 	segmentHealth := repair.SegmentHealth(numHealthy, required, totalNumNodes, obs.nodeFailureRate)
 	mon.FloatVal("checker_segment_health").Observe(segmentHealth) //mon:locked
 	stats.segmentHealth.Observe(segmentHealth)
@@ -17,6 +17,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/repair/durability"
 	"storj.io/storj/storage"
 )
 
@@ -278,6 +279,57 @@ func TestCleanRepairQueue(t *testing.T) {
 	})
 }
 
+func TestDurabilityHistograms(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		checker := planet.Satellites[0].Repair.Checker
+		durabilityDB := planet.Satellites[0].DB.DurabilityHistograms()
+
+		checker.Loop.Pause()
+		planet.Satellites[0].Repair.Repairer.Loop.Pause()
+
+		rs := storj.RedundancyScheme{
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      256,
+		}
+
+		projectID := planet.Uplinks[0].Projects[0].ID
+		location := metabase.SegmentLocation{
+			ProjectID:  projectID,
+			BucketName: "test-bucket",
+		}
+
+		for x := 0; x < 3; x++ {
+			location.ObjectKey = metabase.ObjectKey(fmt.Sprintf("healthy-%d", x))
+			insertSegment(ctx, t, planet, rs, location, createPieces(planet, rs), time.Time{})
+		}
+		location.ObjectKey = "injured"
+		insertSegment(ctx, t, planet, rs, location, createLostPieces(planet, rs), time.Time{})
+
+		location.BucketName = "other-bucket"
+		location.ObjectKey = "healthy"
+		insertSegment(ctx, t, planet, rs, location, createPieces(planet, rs), time.Time{})
+
+		checker.Loop.TriggerWait()
+
+		histograms, computedAt, err := durabilityDB.List(ctx, projectID, "test-bucket")
+		require.NoError(t, err)
+		require.False(t, computedAt.IsZero())
+		require.Len(t, histograms, 1)
+		require.Equal(t, []int64{0, 0, 1, 0, 3}, histograms[0].HealthyCounts)
+		require.EqualValues(t, 3, histograms[0].Redundancy.RepairShares)
+
+		report, err := durability.GetReport(ctx, durabilityDB, projectID, "")
+		require.NoError(t, err)
+		require.Len(t, report.Histograms, 1)
+		require.Equal(t, durability.Summary{NeedsRepair: 1, Healthy: 4}, report.Histograms[0].Summary())
+	})
+}
+
 func createPieces(planet *testplanet.Planet, rs storj.RedundancyScheme) metabase.Pieces {
 	pieces := make(metabase.Pieces, rs.OptimalShares)
 	for i := range pieces {
@@ -0,0 +1,69 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package durability_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/repair/durability"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		durabilityDB := db.DurabilityHistograms()
+
+		projectID := testrand.UUID()
+		otherProjectID := testrand.UUID()
+		rs := durability.Redundancy{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 5}
+
+		histograms, computedAt, err := durabilityDB.List(ctx, projectID, "")
+		require.NoError(t, err)
+		require.Empty(t, histograms)
+		require.True(t, computedAt.IsZero())
+
+		first := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+		err = durabilityDB.Replace(ctx, []durability.Histogram{
+			{ProjectID: projectID, BucketName: "alpha", Redundancy: rs, HealthyCounts: []int64{0, 0, 1, 2, 3}},
+			{ProjectID: projectID, BucketName: "beta", Redundancy: rs, HealthyCounts: []int64{0, 0, 0, 0, 7}},
+			{ProjectID: otherProjectID, BucketName: "alpha", Redundancy: rs, HealthyCounts: []int64{1}},
+		}, first)
+		require.NoError(t, err)
+
+		histograms, computedAt, err = durabilityDB.List(ctx, projectID, "")
+		require.NoError(t, err)
+		require.Len(t, histograms, 2)
+		require.True(t, first.Equal(computedAt))
+
+		histograms, _, err = durabilityDB.List(ctx, projectID, "alpha")
+		require.NoError(t, err)
+		require.Equal(t, []durability.Histogram{
+			{ProjectID: projectID, BucketName: "alpha", Redundancy: rs, HealthyCounts: []int64{0, 0, 1, 2, 3}},
+		}, histograms)
+
+		// the next checker loop replaces all histograms.
+		second := time.Now().UTC().Truncate(time.Microsecond)
+		err = durabilityDB.Replace(ctx, []durability.Histogram{
+			{ProjectID: projectID, BucketName: "beta", Redundancy: rs, HealthyCounts: []int64{0, 0, 0, 1, 7}},
+		}, second)
+		require.NoError(t, err)
+
+		histograms, computedAt, err = durabilityDB.List(ctx, projectID, "")
+		require.NoError(t, err)
+		require.True(t, second.Equal(computedAt))
+		require.Equal(t, []durability.Histogram{
+			{ProjectID: projectID, BucketName: "beta", Redundancy: rs, HealthyCounts: []int64{0, 0, 0, 1, 7}},
+		}, histograms)
+
+		histograms, _, err = durabilityDB.List(ctx, otherProjectID, "")
+		require.NoError(t, err)
+		require.Empty(t, histograms)
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package durability contains histograms of segments by healthy piece
+// count, which are computed by the checker.
+package durability
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// Error is the default error class for durability histograms.
+var Error = errs.Class("durability")
+
+// DB stores the durability histograms computed by the last checker loop.
+//
+// architecture: Database
+type DB interface {
+	// Replace replaces all histograms with the ones computed by a checker loop.
+	Replace(ctx context.Context, histograms []Histogram, computedAt time.Time) error
+	// List returns the histograms of a project, or only of a bucket when
+	// bucketName isn't empty.
+	List(ctx context.Context, projectID uuid.UUID, bucketName string) (histograms []Histogram, computedAt time.Time, err error)
+}
+
+// Redundancy contains the thresholds of a redundancy scheme, the repair
+// threshold includes checker overrides.
+type Redundancy struct {
+	RequiredShares int16
+	RepairShares   int16
+	OptimalShares  int16
+	TotalShares    int16
+}
+
+// Histogram contains the number of segments of a bucket with the same
+// redundancy by healthy piece count.
+type Histogram struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Redundancy Redundancy
+
+	// HealthyCounts contains the number of segments indexed by the number of
+	// healthy pieces.
+	HealthyCounts []int64
+}
+
+// Add adds a segment with numHealthy healthy pieces.
+func (histogram *Histogram) Add(numHealthy int) {
+	if numHealthy < 0 {
+		numHealthy = 0
+	}
+	for len(histogram.HealthyCounts) <= numHealthy {
+		histogram.HealthyCounts = append(histogram.HealthyCounts, 0)
+	}
+	histogram.HealthyCounts[numHealthy]++
+}
+
+// Merge adds the counts of other to the histogram.
+func (histogram *Histogram) Merge(other Histogram) {
+	for len(histogram.HealthyCounts) < len(other.HealthyCounts) {
+		histogram.HealthyCounts = append(histogram.HealthyCounts, 0)
+	}
+	for numHealthy, count := range other.HealthyCounts {
+		histogram.HealthyCounts[numHealthy] += count
+	}
+}
+
+// Summary contains the number of segments relative to the redundancy thresholds.
+type Summary struct {
+	// Lost is the number of segments with fewer healthy pieces than required.
+	Lost int64
+	// NeedsRepair is the number of segments at or below the repair threshold.
+	NeedsRepair int64
+	// BelowOptimal is the number of segments above the repair threshold and
+	// below the success threshold.
+	BelowOptimal int64
+	// Healthy is the number of segments at or above the success threshold.
+	Healthy int64
+}
+
+// Summary summarizes the histogram relative to the redundancy thresholds,
+// the same way the checker decides whether a segment needs repair.
+func (histogram *Histogram) Summary() Summary {
+	var summary Summary
+	required := int(histogram.Redundancy.RequiredShares)
+	repair := int(histogram.Redundancy.RepairShares)
+	optimal := int(histogram.Redundancy.OptimalShares)
+
+	for numHealthy, count := range histogram.HealthyCounts {
+		switch {
+		case numHealthy < required:
+			summary.Lost += count
+		case numHealthy <= repair && numHealthy < optimal:
+			summary.NeedsRepair += count
+		case numHealthy < optimal:
+			summary.BelowOptimal += count
+		default:
+			summary.Healthy += count
+		}
+	}
+	return summary
+}
+
+// Report contains the histograms of a project or a bucket.
+type Report struct {
+	// ComputedAt is the time the checker loop finished, zero when no
+	// histograms have been computed yet.
+	ComputedAt time.Time
+	// Histograms contains a histogram for every redundancy scheme, merged
+	// over the buckets.
+	Histograms []Histogram
+}
+
+// NewReport returns a report merging the histograms by redundancy.
+func NewReport(computedAt time.Time, histograms []Histogram) Report {
+	byRedundancy := map[Redundancy]*Histogram{}
+	for _, histogram := range histograms {
+		merged, ok := byRedundancy[histogram.Redundancy]
+		if !ok {
+			merged = &Histogram{
+				ProjectID:  histogram.ProjectID,
+				BucketName: histogram.BucketName,
+				Redundancy: histogram.Redundancy,
+			}
+			byRedundancy[histogram.Redundancy] = merged
+		} else if merged.BucketName != histogram.BucketName {
+			merged.BucketName = ""
+		}
+		merged.Merge(histogram)
+	}
+
+	report := Report{ComputedAt: computedAt}
+	for _, histogram := range byRedundancy {
+		report.Histograms = append(report.Histograms, *histogram)
+	}
+	sort.Slice(report.Histograms, func(i, k int) bool {
+		a, b := report.Histograms[i].Redundancy, report.Histograms[k].Redundancy
+		if a.RequiredShares != b.RequiredShares {
+			return a.RequiredShares < b.RequiredShares
+		}
+		if a.RepairShares != b.RepairShares {
+			return a.RepairShares < b.RepairShares
+		}
+		if a.OptimalShares != b.OptimalShares {
+			return a.OptimalShares < b.OptimalShares
+		}
+		return a.TotalShares < b.TotalShares
+	})
+	return report
+}
+
+// GetReport returns the report of a project, or only of a bucket when
+// bucketName isn't empty.
+func GetReport(ctx context.Context, db DB, projectID uuid.UUID, bucketName string) (Report, error) {
+	histograms, computedAt, err := db.List(ctx, projectID, bucketName)
+	if err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+	return NewReport(computedAt, histograms), nil
+}
+
+// collectorKey identifies a histogram in the collector.
+type collectorKey struct {
+	projectID  uuid.UUID
+	bucketName string
+	redundancy Redundancy
+}
+
+// Collector collects histograms during a checker loop.
+type Collector struct {
+	histograms map[collectorKey]*Histogram
+}
+
+// NewCollector returns a new collector.
+func NewCollector() *Collector {
+	return &Collector{
+		histograms: map[collectorKey]*Histogram{},
+	}
+}
+
+// Add adds a segment with numHealthy healthy pieces.
+func (collector *Collector) Add(projectID uuid.UUID, bucketName string, redundancy Redundancy, numHealthy int) {
+	key := collectorKey{projectID: projectID, bucketName: bucketName, redundancy: redundancy}
+	histogram, ok := collector.histograms[key]
+	if !ok {
+		histogram = &Histogram{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+			Redundancy: redundancy,
+		}
+		collector.histograms[key] = histogram
+	}
+	histogram.Add(numHealthy)
+}
+
+// Histograms returns the collected histograms.
+func (collector *Collector) Histograms() []Histogram {
+	histograms := make([]Histogram, 0, len(collector.histograms))
+	for _, histogram := range collector.histograms {
+		histograms = append(histograms, *histogram)
+	}
+	return histograms
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package durability_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/repair/durability"
+)
+
+func TestHistogramSummary(t *testing.T) {
+	histogram := durability.Histogram{
+		Redundancy: durability.Redundancy{RequiredShares: 2, RepairShares: 3, OptimalShares: 5, TotalShares: 6},
+	}
+	for _, numHealthy := range []int{0, 1, 2, 3, 3, 4, 5, 6, 6} {
+		histogram.Add(numHealthy)
+	}
+
+	require.Equal(t, []int64{1, 1, 1, 2, 1, 1, 2}, histogram.HealthyCounts)
+	require.Equal(t, durability.Summary{
+		Lost:         2,
+		NeedsRepair:  3,
+		BelowOptimal: 1,
+		Healthy:      3,
+	}, histogram.Summary())
+}
+
+func TestNewReport(t *testing.T) {
+	projectID := testrand.UUID()
+	rs1 := durability.Redundancy{RequiredShares: 2, RepairShares: 3, OptimalShares: 4, TotalShares: 4}
+	rs2 := durability.Redundancy{RequiredShares: 29, RepairShares: 52, OptimalShares: 80, TotalShares: 110}
+
+	collector := durability.NewCollector()
+	collector.Add(projectID, "alpha", rs1, 4)
+	collector.Add(projectID, "alpha", rs1, 4)
+	collector.Add(projectID, "alpha", rs2, 80)
+	collector.Add(projectID, "beta", rs1, 3)
+	collector.Add(projectID, "beta", rs1, 1)
+	require.Len(t, collector.Histograms(), 3)
+
+	computedAt := time.Now()
+	report := durability.NewReport(computedAt, collector.Histograms())
+	require.Equal(t, computedAt, report.ComputedAt)
+	require.Len(t, report.Histograms, 2)
+
+	require.Equal(t, rs1, report.Histograms[0].Redundancy)
+	require.Equal(t, "", report.Histograms[0].BucketName)
+	require.Equal(t, []int64{0, 1, 0, 1, 2}, report.Histograms[0].HealthyCounts)
+	require.Equal(t, durability.Summary{Lost: 1, NeedsRepair: 1, Healthy: 2}, report.Histograms[0].Summary())
+
+	require.Equal(t, rs2, report.Histograms[1].Redundancy)
+	require.Equal(t, "alpha", report.Histograms[1].BucketName)
+	require.Equal(t, durability.Summary{Healthy: 1}, report.Histograms[1].Summary())
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lostpieces
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/metainfo/metaloop"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// Chore removes pieces reported as lost from their segments and queues the
+// segments for repair.
+//
+// Reports only contain the piece IDs, which are derived from the root piece
+// ID of the segment, so the chore joins the metainfo loop to find the
+// segments.
+//
+// architecture: Chore
+type Chore struct {
+	log             *zap.Logger
+	config          Config
+	db              DB
+	metabase        metainfo.MetabaseDB
+	repairQueue     queue.RepairQueue
+	overlay         *overlay.Service
+	metainfoLoop    *metaloop.Service
+	nodeFailureRate float64
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new lost pieces chore.
+func NewChore(log *zap.Logger, config Config, db DB, metabase metainfo.MetabaseDB, repairQueue queue.RepairQueue, overlay *overlay.Service, loop *metaloop.Service, nodeFailureRate float64) *Chore {
+	return &Chore{
+		log:             log,
+		config:          config,
+		db:              db,
+		metabase:        metabase,
+		repairQueue:     repairQueue,
+		overlay:         overlay,
+		metainfoLoop:    loop,
+		nodeFailureRate: nodeFailureRate,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RemoveLostPieces(ctx)
+		if err != nil {
+			chore.log.Error("error removing lost pieces", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// RemoveLostPieces removes the reported pieces from their segments and
+// queues the segments for repair.
+func (chore *Chore) RemoveLostPieces(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reports, err := chore.db.List(ctx, chore.config.ListLimit)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(reports) == 0 {
+		return nil
+	}
+
+	observer := newObserver(reports)
+	err = chore.metainfoLoop.Join(ctx, observer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	reliable, err := chore.overlay.Reliable(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// reports of pieces which aren't part of any segment are done as well.
+	done := make([]Report, 0, len(reports))
+	failed := make(map[nodePiece]struct{})
+	for _, segment := range observer.segments {
+		err := chore.removePieces(ctx, segment, len(reliable))
+		if err != nil {
+			chore.log.Warn("unable to remove lost pieces from segment",
+				zap.Stringer("Stream ID", segment.streamID),
+				zap.Uint64("Position", segment.position.Encode()),
+				zap.Error(err))
+			for _, report := range segment.reports {
+				failed[nodePiece{report.NodeID, report.PieceID}] = struct{}{}
+			}
+		}
+	}
+	for _, report := range reports {
+		if _, ok := failed[nodePiece{report.NodeID, report.PieceID}]; !ok {
+			done = append(done, report)
+		}
+	}
+
+	return Error.Wrap(chore.db.Delete(ctx, done))
+}
+
+// removePieces removes the lost pieces from the current version of the
+// segment and inserts the segment into the repair queue.
+func (chore *Chore) removePieces(ctx context.Context, lost *lostSegment, numNodes int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment, err := chore.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: lost.streamID,
+		Position: lost.position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	newPieces := make(metabase.Pieces, 0, len(segment.Pieces))
+	var lostPieces []int32
+	for _, piece := range segment.Pieces {
+		if lost.contains(piece) {
+			lostPieces = append(lostPieces, int32(piece.Number))
+			continue
+		}
+		newPieces = append(newPieces, piece)
+	}
+	if len(lostPieces) == 0 {
+		return nil
+	}
+
+	err = chore.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: segment.Redundancy,
+		NewPieces:     newPieces,
+	})
+	if err != nil {
+		return err
+	}
+	mon.Meter("lost_pieces_removed").Mark(len(lostPieces))
+
+	segmentHealth := repair.SegmentHealth(len(newPieces), int(segment.Redundancy.RequiredShares), numNodes, chore.nodeFailureRate)
+	_, err = chore.repairQueue.Insert(ctx, &internalpb.InjuredSegment{
+		Path:         lost.location.Encode(),
+		LostPieces:   lostPieces,
+		InsertedTime: time.Now().UTC(),
+	}, segmentHealth)
+	return err
+}
+
+type nodePiece struct {
+	nodeID  storj.NodeID
+	pieceID storj.PieceID
+}
+
+// lostSegment is a segment with pieces reported as lost.
+type lostSegment struct {
+	location metabase.SegmentLocation
+	streamID uuid.UUID
+	position metabase.SegmentPosition
+	pieces   metabase.Pieces
+	reports  []Report
+}
+
+// contains returns whether the piece was reported as lost.
+func (segment *lostSegment) contains(piece metabase.Piece) bool {
+	for _, lost := range segment.pieces {
+		if lost == piece {
+			return true
+		}
+	}
+	return false
+}
+
+var _ metaloop.Observer = (*observer)(nil)
+
+// observer finds the segments of the reported pieces.
+type observer struct {
+	reports  map[nodePiece]Report
+	nodes    map[storj.NodeID]struct{}
+	segments []*lostSegment
+}
+
+func newObserver(reports []Report) *observer {
+	obs := &observer{
+		reports: make(map[nodePiece]Report, len(reports)),
+		nodes:   make(map[storj.NodeID]struct{}),
+	}
+	for _, report := range reports {
+		obs.reports[nodePiece{report.NodeID, report.PieceID}] = report
+		obs.nodes[report.NodeID] = struct{}{}
+	}
+	return obs
+}
+
+// LoopStarted is called at each start of a loop.
+func (obs *observer) LoopStarted(context.Context, metaloop.LoopInfo) error {
+	return nil
+}
+
+// Object is called for each object.
+func (obs *observer) Object(context.Context, *metaloop.Object) error {
+	return nil
+}
+
+// InlineSegment is called for each inline segment.
+func (obs *observer) InlineSegment(context.Context, *metaloop.Segment) error {
+	return nil
+}
+
+// RemoteSegment collects the segment when any of its pieces were reported.
+func (obs *observer) RemoteSegment(ctx context.Context, segment *metaloop.Segment) error {
+	var lost *lostSegment
+	for _, piece := range segment.Pieces {
+		if _, ok := obs.nodes[piece.StorageNode]; !ok {
+			continue
+		}
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		report, ok := obs.reports[nodePiece{piece.StorageNode, pieceID}]
+		if !ok {
+			continue
+		}
+		if lost == nil {
+			lost = &lostSegment{
+				location: segment.Location,
+				streamID: segment.StreamID,
+				position: segment.Position,
+			}
+			obs.segments = append(obs.segments, lost)
+		}
+		lost.pieces = append(lost.pieces, piece)
+		lost.reports = append(lost.reports, report)
+	}
+	return nil
+}
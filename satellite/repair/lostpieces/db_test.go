@@ -0,0 +1,60 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lostpieces_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/repair/lostpieces"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		lostPiecesDB := db.LostPieces()
+
+		nodeID := testrand.NodeID()
+		otherNodeID := testrand.NodeID()
+		pieceA, pieceB := testrand.PieceID(), testrand.PieceID()
+
+		first := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+		second := first.Add(time.Minute)
+
+		require.NoError(t, lostPiecesDB.Insert(ctx, nodeID, []storj.PieceID{pieceA, pieceB}, first))
+		require.NoError(t, lostPiecesDB.Insert(ctx, otherNodeID, []storj.PieceID{pieceA}, second))
+		// reporting the same piece again is ignored
+		require.NoError(t, lostPiecesDB.Insert(ctx, nodeID, []storj.PieceID{pieceA}, second))
+
+		reports, err := lostPiecesDB.List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 3)
+		require.Equal(t, otherNodeID, reports[2].NodeID)
+		for _, report := range reports[:2] {
+			require.Equal(t, nodeID, report.NodeID)
+			require.True(t, first.Equal(report.ReportedAt))
+		}
+
+		reports, err = lostPiecesDB.List(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+
+		require.NoError(t, lostPiecesDB.Delete(ctx, []lostpieces.Report{
+			{NodeID: nodeID, PieceID: pieceA},
+			{NodeID: otherNodeID, PieceID: pieceA},
+		}))
+
+		reports, err = lostPiecesDB.List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.Equal(t, nodeID, reports[0].NodeID)
+		require.Equal(t, pieceB, reports[0].PieceID)
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lostpieces
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/pkg/cache"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Endpoint receives reports of lost pieces from storage nodes.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	internalpb.DRPCLostPiecesUnimplementedServer
+
+	log      *zap.Logger
+	db       DB
+	overlay  *overlay.Service
+	config   Config
+	limiters *cache.ExpiringLRU
+}
+
+// NewEndpoint creates a new lost pieces endpoint.
+func NewEndpoint(log *zap.Logger, db DB, overlay *overlay.Service, config Config) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		db:      db,
+		overlay: overlay,
+		config:  config,
+		limiters: cache.New(cache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.ReportInterval,
+		}),
+	}
+}
+
+// ReportLostPieces stores the pieces reported as lost by the node, so that
+// they are removed from their segments, and penalizes the audit reputation of
+// the node less than failed audits for the pieces would.
+func (endpoint *Endpoint) ReportLostPieces(ctx context.Context, req *internalpb.ReportLostPiecesRequest) (_ *internalpb.ReportLostPiecesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !endpoint.config.Enabled {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "reporting lost pieces is disabled")
+	}
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	nodeID := peer.ID
+
+	pieceIDs := uniquePieceIDs(req.PieceIds)
+	if len(pieceIDs) == 0 {
+		return &internalpb.ReportLostPiecesResponse{}, nil
+	}
+	if len(pieceIDs) > endpoint.config.MaxPiecesPerReport {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "too many pieces in report: %d > %d", len(pieceIDs), endpoint.config.MaxPiecesPerReport)
+	}
+
+	node, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("overlay.Get failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "node is disqualified or has exited")
+	}
+	// updating the reputation clears the containment of the node, which would
+	// allow a contained node to skip its pending audit.
+	if node.Contained {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "node has a pending audit")
+	}
+
+	allowed, err := endpoint.allow(nodeID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+	if !allowed {
+		mon.Event("lost_pieces_report_rate_limited")
+		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "too many reports")
+	}
+
+	err = endpoint.db.Insert(ctx, nodeID, pieceIDs, time.Now())
+	if err != nil {
+		endpoint.log.Error("unable to store lost pieces", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	_, err = endpoint.overlay.ApplyLostPiecesReport(ctx, nodeID, len(pieceIDs), endpoint.config.ReputationWeight)
+	if err != nil {
+		endpoint.log.Error("unable to update reputation", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	mon.IntVal("lost_pieces_reported").Observe(int64(len(pieceIDs)))
+	endpoint.log.Info("node reported lost pieces", zap.Stringer("Node ID", nodeID), zap.Int("Count", len(pieceIDs)))
+
+	return &internalpb.ReportLostPiecesResponse{
+		AcceptedCount: int32(len(pieceIDs)),
+	}, nil
+}
+
+// allow returns whether the node may report lost pieces now.
+func (endpoint *Endpoint) allow(nodeID storj.NodeID) (bool, error) {
+	limiter, err := endpoint.limiters.Get(nodeID.String(), func() (interface{}, error) {
+		return rate.NewLimiter(rate.Every(endpoint.config.ReportInterval), 1), nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return limiter.(*rate.Limiter).Allow(), nil
+}
+
+// uniquePieceIDs returns the piece IDs without duplicates.
+func uniquePieceIDs(pieceIDs []storj.PieceID) []storj.PieceID {
+	seen := make(map[storj.PieceID]struct{}, len(pieceIDs))
+	unique := make([]storj.PieceID, 0, len(pieceIDs))
+	for _, pieceID := range pieceIDs {
+		if _, ok := seen[pieceID]; ok {
+			continue
+		}
+		seen[pieceID] = struct{}{}
+		unique = append(unique, pieceID)
+	}
+	return unique
+}
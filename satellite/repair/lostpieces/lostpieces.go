@@ -0,0 +1,58 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package lostpieces handles pieces which storage nodes reported as lost.
+//
+// Nodes report pieces which are missing or failed verification through the
+// endpoint. The reports are stored and a chore removes the pieces from their
+// segments and queues the segments for repair.
+package lostpieces
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	// Error is the default error class for lost pieces.
+	Error = errs.Class("lost pieces")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for lost piece reports.
+type Config struct {
+	Enabled            bool          `help:"whether storage nodes are allowed to report lost pieces" default:"true"`
+	Interval           time.Duration `help:"how often reported pieces are removed from their segments" releaseDefault:"1h" devDefault:"1m"`
+	ListLimit          int           `help:"the maximum number of reported pieces handled by a single metainfo loop" default:"100000"`
+	MaxPiecesPerReport int           `help:"the maximum number of pieces accepted in a single report" default:"1000"`
+	ReportInterval     time.Duration `help:"the minimum time between two reports of the same node" releaseDefault:"1h" devDefault:"0s"`
+	ReputationWeight   float64       `help:"the weight of a reported piece relative to a failed audit when updating the audit reputation of the reporting node" default:"0.5"`
+	CacheCapacity      int           `help:"the number of nodes to track the report rate of" default:"10000"`
+}
+
+// Report is a piece which a node reported as lost.
+type Report struct {
+	NodeID     storj.NodeID
+	PieceID    storj.PieceID
+	ReportedAt time.Time
+}
+
+// DB stores pieces reported as lost until they are removed from their
+// segments.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores pieces reported as lost by the node. Pieces which have
+	// been reported before are ignored.
+	Insert(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) error
+	// List returns up to limit reports, oldest first.
+	List(ctx context.Context, limit int) ([]Report, error)
+	// Delete removes the reports.
+	Delete(ctx context.Context, reports []Report) error
+}
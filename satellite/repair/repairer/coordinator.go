@@ -0,0 +1,567 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/metainfo/pointerverification"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/irreparable"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/storage"
+	"storj.io/uplink/private/eestream"
+)
+
+var (
+	// ErrInvalidJobResult is returned when a worker returns a result which doesn't
+	// match the job it was given.
+	ErrInvalidJobResult = errs.Class("invalid repair job result")
+)
+
+// maxSelectAttempts is the number of segments the coordinator pops from the
+// repair queue while looking for one which needs repair.
+const maxSelectAttempts = 10
+
+// CoordinatorConfig contains configurable values for the delegated repair coordinator.
+type CoordinatorConfig struct {
+	Enabled        bool          `help:"whether to hand out repair jobs to delegated repair workers" default:"false"`
+	AllowedWorkers string        `help:"comma-separated list of node IDs of the repair workers allowed to request jobs" default:""`
+	JobTimeout     time.Duration `help:"time limit for a delegated repair job, from handing it out to receiving its result" default:"45m"`
+	ComeBackIn     time.Duration `help:"how long workers should wait before asking for a job again when the repair queue is empty" default:"30s"`
+}
+
+// Coordinator hands out repair jobs to workers, which don't have access to
+// the satellite databases, and applies the results of the jobs.
+//
+// The coordinator doesn't keep any state about handed out jobs. The job ID
+// is the key of the segment and the worker returns the PUT order limits
+// signed by the satellite, which binds the returned piece hashes to the
+// segment. Workers are not trusted: piece hashes must be signed by the
+// storage nodes and pieces which the worker claims to be invalid are only
+// removed when the segment stays above the optimal threshold without them.
+//
+// architecture: Endpoint
+type Coordinator struct {
+	internalpb.DRPCRepairCoordinatorUnimplementedServer
+
+	log      *zap.Logger
+	config   CoordinatorConfig
+	queue    queue.RepairQueue
+	irrDB    irreparable.DB
+	metabase metainfo.MetabaseDB
+	buckets  metainfo.BucketsDB
+	orders   *orders.Service
+	overlay  *overlay.Service
+	verifier *pointerverification.Service
+	signee   signing.Signee
+	workers  map[storj.NodeID]struct{}
+
+	multiplierOptimalThreshold float64
+	repairOverrides            checker.RepairOverridesMap
+
+	nowFn func() time.Time
+}
+
+// NewCoordinator creates a new delegated repair coordinator.
+func NewCoordinator(
+	log *zap.Logger, config CoordinatorConfig, queue queue.RepairQueue, irrDB irreparable.DB,
+	metabase metainfo.MetabaseDB, buckets metainfo.BucketsDB, orders *orders.Service,
+	overlay *overlay.Service, peerIdentities overlay.PeerIdentities,
+	excessOptimalThreshold float64, repairOverrides checker.RepairOverrides,
+	satelliteSignee signing.Signee,
+) (*Coordinator, error) {
+	workers := make(map[storj.NodeID]struct{})
+	for _, value := range strings.Split(config.AllowedWorkers, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		workerID, err := storj.NodeIDFromString(value)
+		if err != nil {
+			return nil, Error.New("invalid repair worker ID %q: %w", value, err)
+		}
+		workers[workerID] = struct{}{}
+	}
+
+	if excessOptimalThreshold < 0 {
+		excessOptimalThreshold = 0
+	}
+
+	return &Coordinator{
+		log:      log,
+		config:   config,
+		queue:    queue,
+		irrDB:    irrDB,
+		metabase: metabase,
+		buckets:  buckets,
+		orders:   orders,
+		overlay:  overlay,
+		verifier: pointerverification.NewService(peerIdentities),
+		signee:   satelliteSignee,
+		workers:  workers,
+
+		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
+		repairOverrides:            repairOverrides.GetMap(),
+
+		nowFn: time.Now,
+	}, nil
+}
+
+// RepairJob applies the result of the previous job of the worker and hands
+// out a new job.
+func (coordinator *Coordinator) RepairJob(ctx context.Context, req *internalpb.RepairJobRequest) (_ *internalpb.RepairJobResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !coordinator.config.Enabled {
+		return nil, rpcstatus.Error(rpcstatus.Unimplemented, "delegated repair is disabled")
+	}
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	if _, ok := coordinator.workers[peer.ID]; !ok {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "unknown repair worker")
+	}
+	log := coordinator.log.With(zap.Stringer("Worker ID", peer.ID))
+
+	if req.LastJobResult != nil {
+		err := coordinator.applyResult(ctx, log, req.LastJobResult)
+		if err != nil {
+			if ErrInvalidJobResult.Has(err) {
+				mon.Meter("delegated_repair_result_rejected").Mark(1)
+				log.Warn("rejected repair job result", zap.Error(err))
+			} else {
+				log.Error("unable to apply repair job result", zap.Error(err))
+			}
+		}
+	}
+
+	job, err := coordinator.nextJob(ctx, log)
+	if err != nil {
+		log.Error("unable to create repair job", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if job == nil {
+		return &internalpb.RepairJobResponse{
+			ComeBackInMillis: int32(coordinator.config.ComeBackIn / time.Millisecond),
+		}, nil
+	}
+
+	mon.Meter("delegated_repair_jobs_created").Mark(1)
+	return &internalpb.RepairJobResponse{NewJob: job}, nil
+}
+
+// nextJob pops segments from the repair queue until it finds one which needs
+// repair. It returns nil when there is nothing to repair.
+func (coordinator *Coordinator) nextJob(ctx context.Context, log *zap.Logger) (_ *internalpb.RepairJobDefinition, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i := 0; i < maxSelectAttempts; i++ {
+		seg, err := coordinator.queue.Select(ctx)
+		if err != nil {
+			if storage.ErrEmptyQueue.Has(err) {
+				return nil, nil
+			}
+			return nil, Error.Wrap(err)
+		}
+
+		job, shouldDelete, err := coordinator.createJob(ctx, seg)
+		if irreparableErr, ok := err.(*irreparableError); ok {
+			log.Error("segment could not be repaired! adding to irreparableDB for more attention", zap.Error(err))
+			err = coordinator.irrDB.IncrementRepairAttempts(ctx, &internalpb.IrreparableSegment{
+				Path:               seg.GetPath(),
+				LostPieces:         irreparableErr.piecesRequired - irreparableErr.piecesAvailable,
+				LastRepairAttempt:  coordinator.nowFn().Unix(),
+				RepairAttemptCount: int64(1),
+			})
+			if err != nil {
+				log.Error("failed to add segment to irreparableDB! will leave in repair queue", zap.Error(err))
+				shouldDelete = false
+			}
+		} else if err != nil {
+			log.Error("unable to create repair job for segment", zap.Error(err))
+		}
+
+		if shouldDelete {
+			if err := coordinator.queue.Delete(ctx, seg); err != nil {
+				log.Error("failed to remove segment from queue", zap.Error(err))
+			}
+		}
+		if job != nil {
+			return job, nil
+		}
+	}
+	return nil, nil
+}
+
+// createJob creates the job for repairing the segment. When the segment
+// doesn't need repair, it returns a nil job.
+func (coordinator *Coordinator) createJob(ctx context.Context, seg *internalpb.InjuredSegment) (job *internalpb.RepairJobDefinition, shouldDelete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	path := storj.Path(seg.GetPath())
+	segmentLocation, err := metabase.ParseSegmentKey(metabase.SegmentKey(path))
+	if err != nil {
+		return nil, false, metainfoGetError.Wrap(err)
+	}
+
+	segment, err := coordinator.metabase.GetSegmentByLocation(ctx, metabase.GetSegmentByLocation{
+		SegmentLocation: segmentLocation,
+	})
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			mon.Meter("delegated_repair_unnecessary").Mark(1)
+			return nil, true, nil
+		}
+		return nil, false, metainfoGetError.Wrap(err)
+	}
+	if segment.Inline() {
+		return nil, true, invalidRepairError.New("cannot repair inline segment")
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return nil, true, invalidRepairError.New("invalid redundancy strategy: %w", err)
+	}
+
+	missingPieces, err := coordinator.overlay.GetMissingPieces(ctx, segment.Pieces)
+	if err != nil {
+		return nil, false, overlayQueryError.New("error identifying missing pieces: %w", err)
+	}
+
+	numHealthy := len(segment.Pieces) - len(missingPieces)
+	if numHealthy < int(segment.Redundancy.RequiredShares) {
+		mon.Meter("delegated_repair_nodes_unavailable").Mark(1)
+		return nil, true, &irreparableError{
+			path:            path,
+			piecesAvailable: int32(numHealthy),
+			piecesRequired:  int32(segment.Redundancy.RequiredShares),
+		}
+	}
+
+	pbRedundancy := &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_SchemeType(segment.Redundancy.Algorithm),
+		ErasureShareSize: segment.Redundancy.ShareSize,
+		MinReq:           int32(segment.Redundancy.RequiredShares),
+		RepairThreshold:  int32(segment.Redundancy.RepairShares),
+		SuccessThreshold: int32(segment.Redundancy.OptimalShares),
+		Total:            int32(segment.Redundancy.TotalShares),
+	}
+
+	repairThreshold := int32(segment.Redundancy.RepairShares)
+	if overrideValue := coordinator.repairOverrides.GetOverrideValuePB(pbRedundancy); overrideValue != 0 {
+		repairThreshold = overrideValue
+	}
+	if numHealthy > int(repairThreshold) {
+		mon.Meter("delegated_repair_unnecessary").Mark(1)
+		return nil, true, nil
+	}
+
+	lostPiecesSet := sliceToSet(missingPieces)
+
+	var healthyPieces metabase.Pieces
+	var excludeNodeIDs storj.NodeIDList
+	for _, piece := range segment.Pieces {
+		excludeNodeIDs = append(excludeNodeIDs, piece.StorageNode)
+		if !lostPiecesSet[piece.Number] {
+			healthyPieces = append(healthyPieces, piece)
+		}
+	}
+
+	bucket := segmentLocation.Bucket()
+
+	getOrderLimits, getPrivateKey, err := coordinator.orders.CreateGetRepairOrderLimits(ctx, bucket, segment, healthyPieces)
+	if err != nil {
+		return nil, false, orderLimitFailureError.New("could not create GET_REPAIR order limits: %w", err)
+	}
+
+	healthyCount := nonNilCount(getOrderLimits)
+	totalNeeded := math.Ceil(float64(redundancy.OptimalThreshold()) * coordinator.multiplierOptimalThreshold)
+	requestCount := int(totalNeeded) - healthyCount
+
+	placement, err := coordinator.buckets.GetBucketPlacement(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			mon.Meter("delegated_repair_unnecessary").Mark(1)
+			return nil, true, nil
+		}
+		return nil, false, metainfoGetError.Wrap(err)
+	}
+
+	newNodes, err := coordinator.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: requestCount,
+		ExcludedIDs:    excludeNodeIDs,
+		Placement:      placement,
+	})
+	if err != nil {
+		return nil, false, overlayQueryError.Wrap(err)
+	}
+
+	putLimits, putPrivateKey, err := coordinator.orders.CreatePutRepairOrderLimits(ctx, bucket, segment, getOrderLimits, newNodes, coordinator.multiplierOptimalThreshold)
+	if err != nil {
+		return nil, false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
+	}
+
+	return &internalpb.RepairJobDefinition{
+		JobId:             seg.GetPath(),
+		GetOrders:         EncodeJobLimits(getOrderLimits),
+		PrivateKeyForGet:  getPrivateKey.Bytes(),
+		PutOrders:         EncodeJobLimits(putLimits),
+		PrivateKeyForPut:  putPrivateKey.Bytes(),
+		Redundancy:        pbRedundancy,
+		SegmentSize:       int64(segment.EncryptedSize),
+		DesiredPieceCount: int32(redundancy.OptimalThreshold()),
+		ExpirationTime:    coordinator.nowFn().Add(coordinator.config.JobTimeout),
+	}, false, nil
+}
+
+// applyResult verifies the result of a job and updates the pieces of the
+// segment. The segment is left in the repair queue when the job failed.
+func (coordinator *Coordinator) applyResult(ctx context.Context, log *zap.Logger, result *internalpb.RepairJobResult) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seg := &internalpb.InjuredSegment{Path: result.JobId}
+	log = log.With(zap.Binary("Segment", result.JobId))
+
+	segmentLocation, err := metabase.ParseSegmentKey(metabase.SegmentKey(result.JobId))
+	if err != nil {
+		return ErrInvalidJobResult.Wrap(err)
+	}
+
+	if result.ReconstructError != "" {
+		mon.Meter("delegated_repair_reconstruct_failed").Mark(1)
+		log.Info("worker could not reconstruct segment",
+			zap.Int32("Pieces Retrieved", result.IrreparablePiecesRetrieved),
+			zap.String("Error", result.ReconstructError))
+		return nil
+	}
+
+	segment, err := coordinator.metabase.GetSegmentByLocation(ctx, metabase.GetSegmentByLocation{
+		SegmentLocation: segmentLocation,
+	})
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			// the uploaded pieces will be removed by garbage collection.
+			return Error.Wrap(coordinator.queue.Delete(ctx, seg))
+		}
+		return metainfoGetError.Wrap(err)
+	}
+
+	limits, err := coordinator.verifyPutLimits(ctx, segment, result.PutOrders)
+	if err != nil {
+		return err
+	}
+
+	uploadResults := make([]*pb.SegmentPieceUploadResult, 0, len(result.NewPiecesStored))
+	for _, hash := range result.NewPiecesStored {
+		if hash == nil {
+			continue
+		}
+		pieceNum := -1
+		for i, limit := range limits {
+			if limit != nil && limit.PieceId == hash.PieceId {
+				pieceNum = i
+				break
+			}
+		}
+		if pieceNum < 0 {
+			return ErrInvalidJobResult.New("piece hash %s doesn't match any order limit", hash.PieceId)
+		}
+		uploadResults = append(uploadResults, &pb.SegmentPieceUploadResult{
+			PieceNum: int32(pieceNum),
+			NodeId:   limits[pieceNum].StorageNodeId,
+			Hash:     hash,
+		})
+	}
+
+	if len(uploadResults) == 0 {
+		mon.Meter("delegated_repair_store_failed").Mark(1)
+		log.Info("worker could not store repaired pieces", zap.String("Error", result.StoreError))
+		return nil
+	}
+
+	if err := coordinator.verifier.VerifySizes(ctx, segment.Redundancy, int64(segment.EncryptedSize), uploadResults); err != nil {
+		return ErrInvalidJobResult.Wrap(err)
+	}
+
+	valid, invalid, err := coordinator.verifier.SelectValidPieces(ctx, uploadResults, limits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, piece := range invalid {
+		log.Warn("invalid repaired piece",
+			zap.Stringer("Node ID", piece.NodeID),
+			zap.Int32("Piece Number", piece.PieceNum),
+			zap.Error(piece.Reason))
+	}
+
+	missingPieces, err := coordinator.overlay.GetMissingPieces(ctx, segment.Pieces)
+	if err != nil {
+		return overlayQueryError.New("error identifying missing pieces: %w", err)
+	}
+	lostPiecesSet := sliceToSet(missingPieces)
+
+	var healthyPieces, unhealthyPieces metabase.Pieces
+	for _, piece := range segment.Pieces {
+		if lostPiecesSet[piece.Number] {
+			unhealthyPieces = append(unhealthyPieces, piece)
+		} else {
+			healthyPieces = append(healthyPieces, piece)
+		}
+	}
+
+	var repairedPieces metabase.Pieces
+	repairedMap := make(map[uint16]bool)
+	for _, piece := range valid {
+		number := uint16(piece.PieceNum)
+		repairedPieces = append(repairedPieces, metabase.Piece{
+			Number:      number,
+			StorageNode: piece.NodeId,
+		})
+		repairedMap[number] = true
+	}
+
+	healthyAfterRepair := len(healthyPieces) + len(repairedPieces)
+
+	var toRemove metabase.Pieces
+	for _, piece := range unhealthyPieces {
+		// on partial repair keep the unhealthy pieces which weren't replaced.
+		if healthyAfterRepair >= int(segment.Redundancy.OptimalShares) || repairedMap[piece.Number] {
+			toRemove = append(toRemove, piece)
+		}
+	}
+
+	// the worker is not trusted, so the pieces it reports as invalid are only
+	// removed when the segment remains fully healthy without them.
+	var reported metabase.Pieces
+	for _, number := range result.DeletePieceNums {
+		for _, piece := range healthyPieces {
+			if int32(piece.Number) == number {
+				reported = append(reported, piece)
+			}
+		}
+	}
+	if len(reported) > 0 {
+		if healthyAfterRepair-len(reported) >= int(segment.Redundancy.OptimalShares) {
+			toRemove = append(toRemove, reported...)
+		} else {
+			log.Info("keeping pieces reported as invalid by worker", zap.Int("Count", len(reported)))
+		}
+	}
+
+	newPieces, err := updatePieces(segment.Pieces, repairedPieces, toRemove)
+	if err != nil {
+		return repairPutError.Wrap(err)
+	}
+
+	err = coordinator.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segmentLocation.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: segment.Redundancy,
+		NewPieces:     newPieces,
+
+		NewRepairedAt: coordinator.nowFn(),
+	})
+	if err != nil {
+		return metainfoPutError.Wrap(err)
+	}
+
+	switch {
+	case healthyAfterRepair <= int(segment.Redundancy.RepairShares):
+		mon.Meter("delegated_repair_failed").Mark(1)
+	case healthyAfterRepair < int(segment.Redundancy.OptimalShares):
+		mon.Meter("delegated_repair_partial").Mark(1)
+	default:
+		mon.Meter("delegated_repair_success").Mark(1)
+	}
+
+	return Error.Wrap(coordinator.queue.Delete(ctx, seg))
+}
+
+// verifyPutLimits verifies that the PUT order limits returned by the worker
+// were created by this satellite for the segment. It returns the limits
+// indexed by piece number.
+func (coordinator *Coordinator) verifyPutLimits(ctx context.Context, segment metabase.Segment, addressedLimits []*pb.AddressedOrderLimit) (_ []*pb.OrderLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(addressedLimits) > int(segment.Redundancy.TotalShares) {
+		return nil, ErrInvalidJobResult.New("too many order limits: %d > %d", len(addressedLimits), segment.Redundancy.TotalShares)
+	}
+
+	now := coordinator.nowFn()
+	limits := make([]*pb.OrderLimit, len(addressedLimits))
+	for i, addressedLimit := range DecodeJobLimits(addressedLimits) {
+		if addressedLimit == nil {
+			continue
+		}
+		limit := addressedLimit.Limit
+
+		if err := signing.VerifyOrderLimitSignature(ctx, coordinator.signee, limit); err != nil {
+			return nil, ErrInvalidJobResult.New("order limit signature: %w", err)
+		}
+		if limit.SatelliteId != coordinator.signee.ID() || limit.Action != pb.PieceAction_PUT_REPAIR {
+			return nil, ErrInvalidJobResult.New("order limit is not a repair upload of this satellite")
+		}
+		if now.Sub(limit.OrderCreation) > coordinator.config.JobTimeout {
+			return nil, ErrInvalidJobResult.New("job expired")
+		}
+		if limit.PieceId != segment.RootPieceID.Derive(limit.StorageNodeId, int32(i)) {
+			return nil, ErrInvalidJobResult.New("order limit for piece %d doesn't belong to the segment", i)
+		}
+		limits[i] = limit
+	}
+	return limits, nil
+}
+
+// SetNow allows tests to have the server act as if the current time is whatever they want.
+func (coordinator *Coordinator) SetNow(nowFn func() time.Time) {
+	coordinator.nowFn = nowFn
+}
+
+// EncodeJobLimits converts order limits indexed by piece number to the form
+// sent in repair jobs. Protobuf can't represent nil entries in repeated
+// fields, so missing limits are sent as empty limits.
+func EncodeJobLimits(limits []*pb.AddressedOrderLimit) []*pb.AddressedOrderLimit {
+	encoded := make([]*pb.AddressedOrderLimit, len(limits))
+	for i, limit := range limits {
+		if limit == nil {
+			limit = &pb.AddressedOrderLimit{}
+		}
+		encoded[i] = limit
+	}
+	return encoded
+}
+
+// DecodeJobLimits converts order limits from repair jobs back to limits
+// indexed by piece number.
+func DecodeJobLimits(limits []*pb.AddressedOrderLimit) []*pb.AddressedOrderLimit {
+	decoded := make([]*pb.AddressedOrderLimit, len(limits))
+	for i, limit := range limits {
+		if limit == nil || limit.Limit == nil {
+			continue
+		}
+		decoded[i] = limit
+	}
+	return decoded
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metainfo/metabase"
+)
+
+func TestJobLimitsRoundTrip(t *testing.T) {
+	limits := []*pb.AddressedOrderLimit{
+		nil,
+		{Limit: &pb.OrderLimit{PieceId: testrand.PieceID()}},
+		nil,
+	}
+
+	data, err := pb.Marshal(&internalpb.RepairJobDefinition{
+		GetOrders: EncodeJobLimits(limits),
+	})
+	require.NoError(t, err)
+
+	var job internalpb.RepairJobDefinition
+	require.NoError(t, pb.Unmarshal(data, &job))
+
+	decoded := DecodeJobLimits(job.GetOrders)
+	require.Len(t, decoded, len(limits))
+	require.Nil(t, decoded[0])
+	require.Equal(t, limits[1].Limit.PieceId, decoded[1].Limit.PieceId)
+	require.Nil(t, decoded[2])
+}
+
+func TestCoordinatorVerifyPutLimits(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	satelliteIdentity := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	satellite := satelliteIdentity.ID
+	signer := signing.SignerFromFullIdentity(satelliteIdentity)
+	coordinator := &Coordinator{
+		log:    zaptest.NewLogger(t),
+		config: CoordinatorConfig{JobTimeout: time.Hour},
+		signee: signing.SigneeFromPeerIdentity(satelliteIdentity.PeerIdentity()),
+		nowFn:  time.Now,
+	}
+
+	segment := metabase.Segment{
+		RootPieceID: testrand.PieceID(),
+		Redundancy:  storj.RedundancyScheme{TotalShares: 3},
+	}
+	node := testrand.NodeID()
+
+	sign := func(limit *pb.OrderLimit) *pb.AddressedOrderLimit {
+		signed, err := signing.SignOrderLimit(ctx, signer, limit)
+		require.NoError(t, err)
+		return &pb.AddressedOrderLimit{Limit: signed}
+	}
+	newLimit := func(pieceNum int32) *pb.OrderLimit {
+		return &pb.OrderLimit{
+			SatelliteId:   satellite,
+			StorageNodeId: node,
+			PieceId:       segment.RootPieceID.Derive(node, pieceNum),
+			Action:        pb.PieceAction_PUT_REPAIR,
+			OrderCreation: time.Now(),
+		}
+	}
+
+	limits, err := coordinator.verifyPutLimits(ctx, segment, EncodeJobLimits([]*pb.AddressedOrderLimit{
+		nil, sign(newLimit(1)), nil,
+	}))
+	require.NoError(t, err)
+	require.Nil(t, limits[0])
+	require.NotNil(t, limits[1])
+
+	// limit for a different piece number
+	_, err = coordinator.verifyPutLimits(ctx, segment, []*pb.AddressedOrderLimit{sign(newLimit(1))})
+	require.True(t, ErrInvalidJobResult.Has(err))
+
+	// download limit
+	download := newLimit(0)
+	download.Action = pb.PieceAction_GET_REPAIR
+	_, err = coordinator.verifyPutLimits(ctx, segment, []*pb.AddressedOrderLimit{sign(download)})
+	require.True(t, ErrInvalidJobResult.Has(err))
+
+	// expired job
+	expired := newLimit(0)
+	expired.OrderCreation = time.Now().Add(-2 * time.Hour)
+	_, err = coordinator.verifyPutLimits(ctx, segment, []*pb.AddressedOrderLimit{sign(expired)})
+	require.True(t, ErrInvalidJobResult.Has(err))
+
+	// tampered limit
+	tampered := sign(newLimit(0))
+	tampered.Limit.Limit++
+	_, err = coordinator.verifyPutLimits(ctx, segment, []*pb.AddressedOrderLimit{tampered})
+	require.True(t, ErrInvalidJobResult.Has(err))
+
+	// too many limits
+	_, err = coordinator.verifyPutLimits(ctx, segment, make([]*pb.AddressedOrderLimit, 4))
+	require.True(t, ErrInvalidJobResult.Has(err))
+}
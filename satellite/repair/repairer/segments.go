@@ -53,6 +53,7 @@ type SegmentRepairer struct {
 	log            *zap.Logger
 	statsCollector *statsCollector
 	metabase       metainfo.MetabaseDB
+	buckets        metainfo.BucketsDB
 	orders         *orders.Service
 	overlay        *overlay.Service
 	ec             *ECRepairer
@@ -75,7 +76,7 @@ type SegmentRepairer struct {
 // threshould to determine the maximum limit of nodes to upload repaired pieces,
 // when negative, 0 is applied.
 func NewSegmentRepairer(
-	log *zap.Logger, metabase metainfo.MetabaseDB, orders *orders.Service,
+	log *zap.Logger, metabase metainfo.MetabaseDB, buckets metainfo.BucketsDB, orders *orders.Service,
 	overlay *overlay.Service, dialer rpc.Dialer, timeout time.Duration,
 	excessOptimalThreshold float64, repairOverrides checker.RepairOverrides,
 	downloadTimeout time.Duration, inMemoryRepair bool,
@@ -90,6 +91,7 @@ func NewSegmentRepairer(
 		log:                        log,
 		statsCollector:             newStatsCollector(),
 		metabase:                   metabase,
+		buckets:                    buckets,
 		orders:                     orders,
 		overlay:                    overlay,
 		ec:                         NewECRepairer(log.Named("ec repairer"), dialer, satelliteSignee, downloadTimeout, inMemoryRepair),
@@ -247,10 +249,22 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, path storj.Path) (s
 		minSuccessfulNeeded = redundancy.OptimalThreshold() - len(healthyPieces)
 	}
 
+	// new pieces must honor the bucket placement as the original upload did
+	placement, err := repairer.buckets.GetBucketPlacement(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			mon.Meter("repair_unnecessary").Mark(1)
+			repairer.log.Debug("bucket was deleted")
+			return true, nil
+		}
+		return false, metainfoGetError.Wrap(err)
+	}
+
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		ExcludedIDs:    excludeNodeIDs,
+		Placement:      placement,
 	}
 	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -0,0 +1,192 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/rpc"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/uplink/private/eestream"
+)
+
+// WorkerConfig contains configurable values for delegated repair workers.
+type WorkerConfig struct {
+	Satellite       string        `help:"node URL of the satellite coordinating the repair jobs" default:""`
+	Concurrency     int           `help:"number of repair jobs to run concurrently" default:"1"`
+	Timeout         time.Duration `help:"time limit for uploading repaired pieces to new storage nodes" default:"5m0s"`
+	DownloadTimeout time.Duration `help:"time limit for downloading pieces from a node for repair" default:"5m0s"`
+	InMemoryRepair  bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+	RetryInterval   time.Duration `help:"how long to wait before reconnecting after the satellite could not be reached" default:"1m0s"`
+}
+
+// Worker repairs segments on behalf of a satellite. It asks the coordinator
+// of the satellite for jobs, so it only needs an identity and no access to
+// the satellite databases.
+//
+// architecture: Worker
+type Worker struct {
+	log       *zap.Logger
+	dialer    rpc.Dialer
+	satellite storj.NodeURL
+	config    WorkerConfig
+}
+
+// NewWorker creates a new delegated repair worker.
+func NewWorker(log *zap.Logger, dialer rpc.Dialer, config WorkerConfig) (*Worker, error) {
+	satellite, err := storj.ParseNodeURL(config.Satellite)
+	if err != nil {
+		return nil, Error.New("invalid satellite node URL: %w", err)
+	}
+	if satellite.ID.IsZero() {
+		return nil, Error.New("satellite node URL must contain the node ID")
+	}
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+
+	return &Worker{
+		log:       log,
+		dialer:    dialer,
+		satellite: satellite,
+		config:    config,
+	}, nil
+}
+
+// Run runs the worker until the context is canceled.
+func (worker *Worker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < worker.config.Concurrency; i++ {
+		group.Go(func() error {
+			for {
+				err := worker.session(ctx)
+				if ctx.Err() != nil {
+					return nil
+				}
+				worker.log.Error("repair session failed", zap.Error(err))
+				if !sync2.Sleep(ctx, worker.config.RetryInterval) {
+					return nil
+				}
+			}
+		})
+	}
+	return group.Wait()
+}
+
+// session connects to the satellite and processes jobs until an error occurs.
+func (worker *Worker) session(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := worker.dialer.DialNodeURL(ctx, worker.satellite)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	peer, err := conn.PeerIdentity()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	ec := NewECRepairer(worker.log.Named("ec repairer"), worker.dialer, signing.SigneeFromPeerIdentity(peer), worker.config.DownloadTimeout, worker.config.InMemoryRepair)
+
+	client := internalpb.NewDRPCRepairCoordinatorClient(conn)
+
+	var result *internalpb.RepairJobResult
+	for {
+		resp, err := client.RepairJob(ctx, &internalpb.RepairJobRequest{
+			LastJobResult: result,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		result = nil
+
+		if resp.NewJob == nil {
+			if !sync2.Sleep(ctx, time.Duration(resp.ComeBackInMillis)*time.Millisecond) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		result, err = worker.Process(ctx, ec, resp.NewJob)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Process downloads the healthy pieces of the job, reconstructs the segment
+// and uploads the missing pieces.
+func (worker *Worker) Process(ctx context.Context, ec *ECRepairer, job *internalpb.RepairJobDefinition) (_ *internalpb.RepairJobResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithDeadline(ctx, job.ExpirationTime)
+	defer cancel()
+
+	result := &internalpb.RepairJobResult{
+		JobId:     job.JobId,
+		PutOrders: job.PutOrders,
+	}
+	path := storj.Path(job.JobId)
+
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(job.Redundancy)
+	if err != nil {
+		result.ReconstructError = err.Error()
+		return result, nil
+	}
+	getKey, err := storj.PiecePrivateKeyFromBytes(job.PrivateKeyForGet)
+	if err != nil {
+		result.ReconstructError = err.Error()
+		return result, nil
+	}
+	putKey, err := storj.PiecePrivateKeyFromBytes(job.PrivateKeyForPut)
+	if err != nil {
+		result.StoreError = err.Error()
+		return result, nil
+	}
+
+	getLimits := DecodeJobLimits(job.GetOrders)
+	putLimits := DecodeJobLimits(job.PutOrders)
+
+	segmentReader, failedPieces, err := ec.Get(ctx, getLimits, getKey, redundancy, job.SegmentSize, path)
+	for _, piece := range failedPieces {
+		result.DeletePieceNums = append(result.DeletePieceNums, piece.PieceNum)
+	}
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if irreparableErr, ok := err.(*irreparableError); ok {
+			result.IrreparablePiecesRetrieved = irreparableErr.piecesAvailable
+		}
+		result.ReconstructError = err.Error()
+		return result, nil
+	}
+	defer func() { err = errs.Combine(err, segmentReader.Close()) }()
+
+	successfulNeeded := int(job.DesiredPieceCount) - nonNilCount(getLimits) + len(failedPieces)
+	_, hashes, err := ec.Repair(ctx, putLimits, putKey, redundancy, segmentReader, worker.config.Timeout, path, successfulNeeded)
+	if err != nil {
+		result.StoreError = err.Error()
+		return result, nil
+	}
+	for _, hash := range hashes {
+		if hash != nil {
+			result.NewPiecesStored = append(result.NewPiecesStored, hash)
+		}
+	}
+
+	worker.log.Debug("repaired segment", zap.Int("Pieces Stored", len(result.NewPiecesStored)))
+	return result, nil
+}
@@ -171,6 +171,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		peer.SegmentRepairer = repairer.NewSegmentRepairer(
 			log.Named("segment-repair"),
 			metabaseDB,
+			bucketsDB,
 			peer.Orders.Service,
 			peer.Overlay,
 			peer.Dialer,
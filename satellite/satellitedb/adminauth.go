@@ -0,0 +1,246 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/adminauth"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+var _ adminauth.DB = (*adminAuthDB)(nil)
+
+// maxAuditEntries is the maximum number of audit log entries returned at once.
+const maxAuditEntries = 1000
+
+type adminAuthDB struct {
+	db *satelliteDB
+}
+
+// CreateToken stores a new token with the hash of its secret.
+func (db *adminAuthDB) CreateToken(ctx context.Context, token adminauth.Token, secretHash []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	createdAt := token.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	optional := dbx.AdminToken_Create_Fields{}
+	if token.ExpiresAt != nil {
+		optional.ExpiresAt = dbx.AdminToken_ExpiresAt(token.ExpiresAt.UTC())
+	}
+
+	return Error.Wrap(db.db.CreateNoReturn_AdminToken(ctx,
+		dbx.AdminToken_Id(token.ID[:]),
+		dbx.AdminToken_Name(token.Name),
+		dbx.AdminToken_Scopes(adminauth.FormatScopes(token.Scopes)),
+		dbx.AdminToken_SecretHash(secretHash),
+		dbx.AdminToken_CreatedAt(createdAt.UTC()),
+		optional))
+}
+
+// GetTokenBySecretHash returns the token with the hash of the secret.
+func (db *adminAuthDB) GetTokenBySecretHash(ctx context.Context, secretHash []byte) (_ adminauth.Token, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxToken, err := db.db.Get_AdminToken_By_SecretHash(ctx, dbx.AdminToken_SecretHash(secretHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return adminauth.Token{}, sql.ErrNoRows
+		}
+		return adminauth.Token{}, Error.Wrap(err)
+	}
+
+	return adminTokenFromDBX(dbxToken)
+}
+
+// ListTokens returns all tokens ordered by name.
+func (db *adminAuthDB) ListTokens(ctx context.Context) (_ []adminauth.Token, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxTokens, err := db.db.All_AdminToken_OrderBy_Asc_Name(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	tokens := make([]adminauth.Token, 0, len(dbxTokens))
+	for _, dbxToken := range dbxTokens {
+		token, err := adminTokenFromDBX(dbxToken)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+// RevokeToken revokes the token, so that it can't be used anymore.
+func (db *adminAuthDB) RevokeToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxToken, err := db.db.Update_AdminToken_By_Id_And_RevokedAt_Is_Null(ctx,
+		dbx.AdminToken_Id(id[:]),
+		dbx.AdminToken_Update_Fields{
+			RevokedAt: dbx.AdminToken_RevokedAt(revokedAt.UTC()),
+		})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbxToken == nil {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// adminTokenFromDBX converts a dbx admin token to adminauth.Token.
+func adminTokenFromDBX(dbxToken *dbx.AdminToken) (adminauth.Token, error) {
+	id, err := uuid.FromBytes(dbxToken.Id)
+	if err != nil {
+		return adminauth.Token{}, Error.Wrap(err)
+	}
+
+	scopes, err := adminauth.ParseScopes(dbxToken.Scopes)
+	if err != nil {
+		return adminauth.Token{}, Error.Wrap(err)
+	}
+
+	return adminauth.Token{
+		ID:        id,
+		Name:      dbxToken.Name,
+		Scopes:    scopes,
+		CreatedAt: dbxToken.CreatedAt,
+		ExpiresAt: dbxToken.ExpiresAt,
+		RevokedAt: dbxToken.RevokedAt,
+	}, nil
+}
+
+// AppendAuditEntry appends an entry to the audit log and returns its id.
+func (db *adminAuthDB) AppendAuditEntry(ctx context.Context, entry adminauth.AuditEntry) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	optional := dbx.AdminAuditLog_Create_Fields{}
+	if entry.TokenID != nil {
+		optional.TokenId = dbx.AdminAuditLog_TokenId(entry.TokenID[:])
+	}
+	if entry.UserEmail != "" {
+		optional.UserEmail = dbx.AdminAuditLog_UserEmail(entry.UserEmail)
+	}
+	if entry.ProjectID != nil {
+		optional.ProjectId = dbx.AdminAuditLog_ProjectId(entry.ProjectID[:])
+	}
+	if entry.StatusCode != 0 {
+		optional.StatusCode = dbx.AdminAuditLog_StatusCode(entry.StatusCode)
+	}
+
+	dbxEntry, err := db.db.Create_AdminAuditLog(ctx,
+		dbx.AdminAuditLog_CreatedAt(createdAt.UTC()),
+		dbx.AdminAuditLog_Actor(entry.Actor),
+		dbx.AdminAuditLog_Method(entry.Method),
+		dbx.AdminAuditLog_Path(entry.Path),
+		dbx.AdminAuditLog_Details(entry.Details),
+		optional)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return dbxEntry.Id, nil
+}
+
+// SetAuditStatus sets the status code of an entry, which has none yet.
+func (db *adminAuthDB) SetAuditStatus(ctx context.Context, id int64, statusCode int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.UpdateNoReturn_AdminAuditLog_By_Id_And_StatusCode_Is_Null(ctx,
+		dbx.AdminAuditLog_Id(id),
+		dbx.AdminAuditLog_Update_Fields{
+			StatusCode: dbx.AdminAuditLog_StatusCode(statusCode),
+		}))
+}
+
+// ListAuditEntries returns the entries of the audit log matching the filter, newest first.
+func (db *adminAuthDB) ListAuditEntries(ctx context.Context, filter adminauth.AuditFilter) (entries []adminauth.AuditEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, strings.ReplaceAll(condition, "?", "$"+strconv.Itoa(len(args))))
+	}
+
+	if filter.Actor != "" {
+		where("actor = ?", filter.Actor)
+	}
+	if filter.UserEmail != "" {
+		where("user_email = ?", filter.UserEmail)
+	}
+	if filter.ProjectID != nil {
+		where("project_id = ?", filter.ProjectID[:])
+	}
+	if !filter.Since.IsZero() {
+		where("created_at >= ?", filter.Since.UTC())
+	}
+	if !filter.Before.IsZero() {
+		where("created_at < ?", filter.Before.UTC())
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > maxAuditEntries {
+		limit = maxAuditEntries
+	}
+	args = append(args, limit)
+
+	query := `
+		SELECT id, created_at, actor, token_id, method, path, user_email, project_id, details, status_code
+		FROM admin_audit_logs
+	`
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+	query += "ORDER BY created_at DESC, id DESC LIMIT $" + strconv.Itoa(len(args))
+
+	rows, err := db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var entry adminauth.AuditEntry
+		var tokenID, projectID uuid.NullUUID
+		var userEmail sql.NullString
+		var statusCode sql.NullInt64
+		err := rows.Scan(&entry.ID, &entry.CreatedAt, &entry.Actor, &tokenID, &entry.Method, &entry.Path,
+			&userEmail, &projectID, &entry.Details, &statusCode)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if tokenID.Valid {
+			entry.TokenID = &tokenID.UUID
+		}
+		if projectID.Valid {
+			entry.ProjectID = &projectID.UUID
+		}
+		entry.UserEmail = userEmail.String
+		entry.StatusCode = int(statusCode.Int64)
+
+		entries = append(entries, entry)
+	}
+	return entries, Error.Wrap(rows.Err())
+}
@@ -4,13 +4,17 @@
 package satellitedb
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/uuid"
 	"storj.io/storj/pkg/cache"
+	"storj.io/storj/private/dbutil/pgutil"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -205,6 +209,20 @@ func (keys *apikeys) Update(ctx context.Context, key console.APIKeyInfo) (err er
 	)
 }
 
+// UpdateLimits implements satellite.APIKeys.
+func (keys *apikeys) UpdateLimits(ctx context.Context, id uuid.UUID, rateLimit *int, bandwidthLimit *int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return keys.methods.UpdateNoReturn_ApiKey_By_Id(
+		ctx,
+		dbx.ApiKey_Id(id[:]),
+		dbx.ApiKey_Update_Fields{
+			RateLimit:      dbx.ApiKey_RateLimit_Raw(rateLimit),
+			BandwidthLimit: dbx.ApiKey_BandwidthLimit_Raw(bandwidthLimit),
+		},
+	)
+}
+
 // Delete implements satellite.APIKeys.
 func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -212,6 +230,91 @@ func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	return err
 }
 
+// AddUsage implements satellite.APIKeys.
+func (keys *apikeys) AddUsage(ctx context.Context, rollups []console.APIKeyUsageRollup) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	// the rollups are upserted in a single batch, which dbx doesn't support.
+	// they are sorted to avoid deadlocks between concurrent batches.
+	sort.SliceStable(rollups, func(i, j int) bool {
+		if c := bytes.Compare(rollups[i].Head, rollups[j].Head); c != 0 {
+			return c < 0
+		}
+		return rollups[i].IntervalStart.Before(rollups[j].IntervalStart)
+	})
+
+	var heads [][]byte
+	var projectIDs []uuid.UUID
+	var intervals []time.Time
+	var requests, egress []int64
+	for _, rollup := range rollups {
+		heads = append(heads, rollup.Head)
+		projectIDs = append(projectIDs, rollup.ProjectID)
+		intervals = append(intervals, rollup.IntervalStart.UTC())
+		requests = append(requests, rollup.Requests)
+		egress = append(egress, rollup.Egress)
+	}
+
+	_, err = keys.db.ExecContext(ctx, `
+		INSERT INTO api_key_usage_rollups (head, project_id, interval_start, requests, egress)
+		SELECT unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::timestamptz[]), unnest($4::int8[]), unnest($5::int8[])
+		ON CONFLICT (head, interval_start)
+		DO UPDATE SET
+			requests = api_key_usage_rollups.requests + EXCLUDED.requests,
+			egress = api_key_usage_rollups.egress + EXCLUDED.egress
+	`, pgutil.ByteaArray(heads), pgutil.UUIDArray(projectIDs), pgutil.TimestampTZArray(intervals),
+		pgutil.Int8Array(requests), pgutil.Int8Array(egress))
+	return Error.Wrap(err)
+}
+
+// GetEgress implements satellite.APIKeys.
+func (keys *apikeys) GetEgress(ctx context.Context, head []byte, since time.Time) (egress int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = keys.db.QueryRowContext(ctx, keys.db.Rebind(`
+		SELECT COALESCE(SUM(egress), 0)
+		FROM api_key_usage_rollups
+		WHERE head = ? AND interval_start >= ?
+	`), head, since.UTC()).Scan(&egress)
+	return egress, Error.Wrap(err)
+}
+
+// GetUsageByProjectID implements satellite.APIKeys.
+func (keys *apikeys) GetUsageByProjectID(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []console.APIKeyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := keys.db.QueryContext(ctx, keys.db.Rebind(`
+		SELECT ak.id, COALESCE(ak.name, ''), SUM(r.requests), SUM(r.egress)
+		FROM api_key_usage_rollups r
+		LEFT JOIN api_keys ak ON ak.head = r.head
+		WHERE r.project_id = ? AND r.interval_start >= ? AND r.interval_start < ?
+		GROUP BY r.head, ak.id, ak.name
+		ORDER BY SUM(r.egress) DESC, SUM(r.requests) DESC
+	`), projectID[:], since.UTC(), before.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var usages []console.APIKeyUsage
+	for rows.Next() {
+		var usage console.APIKeyUsage
+		var id uuid.NullUUID
+		err = rows.Scan(&id, &usage.Name, &usage.Requests, &usage.Egress)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		usage.ID = id.UUID
+		usages = append(usages, usage)
+	}
+
+	return usages, Error.Wrap(rows.Err())
+}
+
 // fromDBXAPIKey converts dbx.ApiKey to satellite.APIKeyInfo.
 func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -228,9 +331,13 @@ func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo,
 	result := &console.APIKeyInfo{
 		ID:        id,
 		ProjectID: projectID,
+		Head:      key.Head,
 		Name:      key.Name,
 		CreatedAt: key.CreatedAt,
 		Secret:    key.Secret,
+
+		RateLimit:      key.RateLimit,
+		BandwidthLimit: key.BandwidthLimit,
 	}
 
 	if key.PartnerId != nil {
@@ -6,12 +6,18 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/metabase"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -225,6 +231,247 @@ func (db *bucketsDB) CountBuckets(ctx context.Context, projectID uuid.UUID) (cou
 	return int(count64), nil
 }
 
+// GetBucketVersioning returns the versioning state of a bucket.
+func (db *bucketsDB) GetBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ metainfo.Versioning, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return metainfo.Unversioned, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return metainfo.Unversioned, storj.ErrBucket.Wrap(err)
+	}
+	return metainfo.Versioning(row.Versioning), nil
+}
+
+// UpdateBucketVersioning updates the versioning state of a bucket.
+func (db *bucketsDB) UpdateBucketVersioning(ctx context.Context, bucketName []byte, projectID uuid.UUID, versioning metainfo.Versioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.updateBucket(ctx, bucketName, projectID, dbx.BucketMetainfo_Update_Fields{
+		Versioning: dbx.BucketMetainfo_Versioning(int(versioning)),
+	})
+}
+
+// updateBucket updates the fields of a bucket.
+func (db *bucketsDB) updateBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID, update dbx.BucketMetainfo_Update_Fields) (err error) {
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		update,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// GetBucketDefaultRetention returns the default retention of a bucket.
+func (db *bucketsDB) GetBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ metainfo.DefaultRetention, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return metainfo.DefaultRetention{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return metainfo.DefaultRetention{}, storj.ErrBucket.Wrap(err)
+	}
+	return metainfo.DefaultRetention{
+		Mode: metabase.RetentionMode(row.DefaultRetentionMode),
+		Days: row.DefaultRetentionDays,
+	}, nil
+}
+
+// UpdateBucketDefaultRetention updates the default retention of a bucket.
+func (db *bucketsDB) UpdateBucketDefaultRetention(ctx context.Context, bucketName []byte, projectID uuid.UUID, retention metainfo.DefaultRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.updateBucket(ctx, bucketName, projectID, dbx.BucketMetainfo_Update_Fields{
+		DefaultRetentionMode: dbx.BucketMetainfo_DefaultRetentionMode(int(retention.Mode)),
+		DefaultRetentionDays: dbx.BucketMetainfo_DefaultRetentionDays(retention.Days),
+	})
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket.
+func (db *bucketsDB) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ []metabase.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	return decodeLifecycleRules(row.LifecycleRules)
+}
+
+// UpdateBucketLifecycle replaces the lifecycle rules of a bucket.
+func (db *bucketsDB) UpdateBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []metabase.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// buckets without rules are stored as NULL, so the lifecycle chore can skip them.
+	lifecycleRules := dbx.BucketMetainfo_LifecycleRules_Null()
+	if len(rules) > 0 {
+		data, err := json.Marshal(rules)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		lifecycleRules = dbx.BucketMetainfo_LifecycleRules(data)
+	}
+
+	return db.updateBucket(ctx, bucketName, projectID, dbx.BucketMetainfo_Update_Fields{
+		LifecycleRules: lifecycleRules,
+	})
+}
+
+// ListBucketLifecycles returns buckets with lifecycle rules ordered by project ID
+// and bucket name, starting after the cursor.
+//
+// The query isn't generated by dbx, because dbx doesn't support cursors over
+// multiple columns.
+func (db *bucketsDB) ListBucketLifecycles(ctx context.Context, cursor metabase.BucketLocation, limit int) (_ []metainfo.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT project_id, name, lifecycle_rules FROM bucket_metainfos
+		WHERE
+			lifecycle_rules IS NOT NULL AND
+			(project_id, name) > ($1, $2)
+		ORDER BY project_id, name
+		LIMIT $3
+	`, cursor.ProjectID[:], []byte(cursor.BucketName), limit)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var lifecycles []metainfo.BucketLifecycle
+	for rows.Next() {
+		var projectID uuid.UUID
+		var name, data []byte
+		if err := rows.Scan(&projectID, &name, &data); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+
+		rules, err := decodeLifecycleRules(data)
+		if err != nil {
+			return nil, err
+		}
+
+		lifecycles = append(lifecycles, metainfo.BucketLifecycle{
+			BucketLocation: metabase.BucketLocation{
+				ProjectID:  projectID,
+				BucketName: string(name),
+			},
+			Rules: rules,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	return lifecycles, nil
+}
+
+func decodeLifecycleRules(data []byte) (rules []metabase.LifecycleRule, err error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	return rules, nil
+}
+
+// GetBucketPlacement returns the placement constraints of a bucket.
+func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement nodeselection.Placement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nodeselection.Placement{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return nodeselection.Placement{}, storj.ErrBucket.Wrap(err)
+	}
+	if len(row.Placement) == 0 {
+		return nodeselection.Placement{}, nil
+	}
+	if err := json.Unmarshal(row.Placement, &placement); err != nil {
+		return nodeselection.Placement{}, storj.ErrBucket.Wrap(err)
+	}
+	return placement, nil
+}
+
+// UpdateBucketPlacement replaces the placement constraints of a bucket.
+func (db *bucketsDB) UpdateBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID, placement nodeselection.Placement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxPlacement := dbx.BucketMetainfo_Placement_Null()
+	if !placement.IsZero() {
+		data, err := json.Marshal(placement)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		dbxPlacement = dbx.BucketMetainfo_Placement(data)
+	}
+
+	return db.updateBucket(ctx, bucketName, projectID, dbx.BucketMetainfo_Update_Fields{
+		Placement: dbxPlacement,
+	})
+}
+
+// GetBucketLimits returns the limits of a bucket.
+func (db *bucketsDB) GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ accounting.BucketLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketMetainfo_StorageLimit_BucketMetainfo_BandwidthLimit_BucketMetainfo_ObjectLimit_BucketMetainfo_SegmentLimit_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accounting.BucketLimits{}, storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+		return accounting.BucketLimits{}, storj.ErrBucket.Wrap(err)
+	}
+	return accounting.BucketLimits{
+		Storage:   row.StorageLimit,
+		Bandwidth: row.BandwidthLimit,
+		Objects:   row.ObjectLimit,
+		Segments:  row.SegmentLimit,
+	}, nil
+}
+
+// UpdateBucketLimits replaces the limits of a bucket.
+func (db *bucketsDB) UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits accounting.BucketLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.updateBucket(ctx, bucketName, projectID, dbx.BucketMetainfo_Update_Fields{
+		StorageLimit:   dbx.BucketMetainfo_StorageLimit_Raw(limits.Storage),
+		BandwidthLimit: dbx.BucketMetainfo_BandwidthLimit_Raw(limits.Bandwidth),
+		ObjectLimit:    dbx.BucketMetainfo_ObjectLimit_Raw(limits.Objects),
+		SegmentLimit:   dbx.BucketMetainfo_SegmentLimit_Raw(limits.Segments),
+	})
+}
+
 func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err error) {
 	id, err := uuid.FromBytes(dbxBucket.Id)
 	if err != nil {
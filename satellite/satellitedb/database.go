@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/private/tagsql"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/adminauth"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/compensation"
@@ -28,7 +29,9 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/durability"
 	"storj.io/storj/satellite/repair/irreparable"
+	"storj.io/storj/satellite/repair/lostpieces"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -217,6 +220,16 @@ func (dbc *satelliteDBCollection) Irreparable() irreparable.DB {
 	return &irreparableDB{db: dbc.getByName("irreparable")}
 }
 
+// DurabilityHistograms returns database for durability histograms computed by the checker.
+func (dbc *satelliteDBCollection) DurabilityHistograms() durability.DB {
+	return &durabilityHistogramsDB{db: dbc.getByName("durabilityhistograms")}
+}
+
+// LostPieces returns database for pieces reported as lost by storage nodes.
+func (dbc *satelliteDBCollection) LostPieces() lostpieces.DB {
+	return &lostPiecesDB{db: dbc.getByName("lostpieces")}
+}
+
 // Revocation returns the database to deal with macaroon revocation.
 func (dbc *satelliteDBCollection) Revocation() revocation.DB {
 	db := dbc.getByName("revocation")
@@ -278,6 +291,11 @@ func (dbc *satelliteDBCollection) Compensation() compensation.DB {
 	return &compensationDB{db: dbc.getByName("compensation")}
 }
 
+// AdminAuth returns database for the admin api tokens and audit log.
+func (dbc *satelliteDBCollection) AdminAuth() adminauth.DB {
+	return &adminAuthDB{db: dbc.getByName("adminauth")}
+}
+
 // NodeAPIVersion returns database for storage node api version lower bounds.
 func (dbc *satelliteDBCollection) NodeAPIVersion() nodeapiversion.DB {
 	return &nodeAPIVersionDB{db: dbc.getByName("nodeapiversion")}
@@ -336,6 +336,39 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE admin_audit_logs (
+	id bigserial NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	actor text NOT NULL,
+	token_id bytea,
+	method text NOT NULL,
+	path text NOT NULL,
+	user_email text,
+	project_id bytea,
+	details text NOT NULL,
+	status_code integer,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE admin_tokens (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	scopes text NOT NULL,
+	secret_hash bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone,
+	revoked_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( name ),
+	UNIQUE ( secret_hash )
+);
+CREATE TABLE api_key_usage_rollups (
+	head bytea NOT NULL,
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	requests bigint NOT NULL,
+	egress bigint NOT NULL,
+	PRIMARY KEY ( head, interval_start )
+);
 CREATE TABLE audit_histories (
 	node_id bytea NOT NULL,
 	history bytea NOT NULL,
@@ -418,6 +451,17 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE durability_histograms (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	required_shares integer NOT NULL,
+	repair_shares integer NOT NULL,
+	optimal_shares integer NOT NULL,
+	total_shares integer NOT NULL,
+	healthy_counts bytea NOT NULL,
+	computed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, required_shares, repair_shares, optimal_shares, total_shares )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -462,6 +506,7 @@ CREATE TABLE nodes (
 	address text NOT NULL DEFAULT '',
 	last_net text NOT NULL,
 	last_ip_port text,
+	country_code text,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -566,6 +611,12 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE reported_lost_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE reset_password_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea NOT NULL,
@@ -640,6 +691,22 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_settlement_outcomes (
+	storagenode_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	settled_at timestamp with time zone NOT NULL,
+	status integer NOT NULL,
+	already_processed boolean NOT NULL,
+	orders_received integer NOT NULL,
+	orders_accepted integer NOT NULL,
+	orders_unknown_bucket integer NOT NULL,
+	amount_settled bigint NOT NULL,
+	rejected_expired integer NOT NULL,
+	rejected_bad_signature integer NOT NULL,
+	rejected_already_settled integer NOT NULL,
+	rejected_invalid integer NOT NULL,
+	PRIMARY KEY ( storagenode_id, interval_start, settled_at )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -689,6 +756,12 @@ CREATE TABLE users (
 	working_on text,
 	is_professional boolean NOT NULL DEFAULT false,
 	employee_count text,
+	mfa_enabled boolean NOT NULL DEFAULT false,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
+	mfa_last_counter bigint NOT NULL DEFAULT 0,
+	mfa_failed_attempts integer NOT NULL DEFAULT 0,
+	mfa_locked_until timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -706,6 +779,8 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
+	rate_limit integer,
+	bandwidth_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -726,6 +801,15 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning integer NOT NULL DEFAULT 0,
+	default_retention_mode integer NOT NULL DEFAULT 0,
+	default_retention_days integer NOT NULL DEFAULT 0,
+	lifecycle_rules bytea,
+	placement bytea,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	object_limit bigint,
+	segment_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -733,8 +817,36 @@ CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer NOT NULL DEFAULT 2,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_usage_alerts (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold )
+);
+CREATE TABLE project_usage_notifications (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	used bigint NOT NULL,
+	usage_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	sent_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE sso_identities (
+	issuer text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( issuer, subject )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -754,6 +866,10 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE INDEX admin_audit_logs_created_at_index ON admin_audit_logs ( created_at );
+CREATE INDEX admin_audit_logs_user_email_index ON admin_audit_logs ( user_email );
+CREATE INDEX admin_audit_logs_project_id_index ON admin_audit_logs ( project_id );
+CREATE INDEX api_key_usage_rollups_project_id_interval_start_index ON api_key_usage_rollups ( project_id, interval_start );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time );
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start );
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id );
@@ -766,6 +882,7 @@ CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_h
 CREATE INDEX injuredsegments_updated_at_index ON injuredsegments ( updated_at );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success );
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id );
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start );
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start );
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period );
@@ -887,6 +1004,39 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE admin_audit_logs (
+	id bigserial NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	actor text NOT NULL,
+	token_id bytea,
+	method text NOT NULL,
+	path text NOT NULL,
+	user_email text,
+	project_id bytea,
+	details text NOT NULL,
+	status_code integer,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE admin_tokens (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	scopes text NOT NULL,
+	secret_hash bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone,
+	revoked_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( name ),
+	UNIQUE ( secret_hash )
+);
+CREATE TABLE api_key_usage_rollups (
+	head bytea NOT NULL,
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	requests bigint NOT NULL,
+	egress bigint NOT NULL,
+	PRIMARY KEY ( head, interval_start )
+);
 CREATE TABLE audit_histories (
 	node_id bytea NOT NULL,
 	history bytea NOT NULL,
@@ -969,6 +1119,17 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE durability_histograms (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	required_shares integer NOT NULL,
+	repair_shares integer NOT NULL,
+	optimal_shares integer NOT NULL,
+	total_shares integer NOT NULL,
+	healthy_counts bytea NOT NULL,
+	computed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, required_shares, repair_shares, optimal_shares, total_shares )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1013,6 +1174,7 @@ CREATE TABLE nodes (
 	address text NOT NULL DEFAULT '',
 	last_net text NOT NULL,
 	last_ip_port text,
+	country_code text,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -1117,6 +1279,12 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE reported_lost_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE reset_password_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea NOT NULL,
@@ -1191,6 +1359,22 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_settlement_outcomes (
+	storagenode_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	settled_at timestamp with time zone NOT NULL,
+	status integer NOT NULL,
+	already_processed boolean NOT NULL,
+	orders_received integer NOT NULL,
+	orders_accepted integer NOT NULL,
+	orders_unknown_bucket integer NOT NULL,
+	amount_settled bigint NOT NULL,
+	rejected_expired integer NOT NULL,
+	rejected_bad_signature integer NOT NULL,
+	rejected_already_settled integer NOT NULL,
+	rejected_invalid integer NOT NULL,
+	PRIMARY KEY ( storagenode_id, interval_start, settled_at )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -1240,6 +1424,12 @@ CREATE TABLE users (
 	working_on text,
 	is_professional boolean NOT NULL DEFAULT false,
 	employee_count text,
+	mfa_enabled boolean NOT NULL DEFAULT false,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
+	mfa_last_counter bigint NOT NULL DEFAULT 0,
+	mfa_failed_attempts integer NOT NULL DEFAULT 0,
+	mfa_locked_until timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -1257,6 +1447,8 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
+	rate_limit integer,
+	bandwidth_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -1277,6 +1469,15 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning integer NOT NULL DEFAULT 0,
+	default_retention_mode integer NOT NULL DEFAULT 0,
+	default_retention_days integer NOT NULL DEFAULT 0,
+	lifecycle_rules bytea,
+	placement bytea,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	object_limit bigint,
+	segment_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -1284,8 +1485,36 @@ CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer NOT NULL DEFAULT 2,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_usage_alerts (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold )
+);
+CREATE TABLE project_usage_notifications (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	used bigint NOT NULL,
+	usage_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	sent_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE sso_identities (
+	issuer text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( issuer, subject )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -1305,6 +1534,10 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE INDEX admin_audit_logs_created_at_index ON admin_audit_logs ( created_at );
+CREATE INDEX admin_audit_logs_user_email_index ON admin_audit_logs ( user_email );
+CREATE INDEX admin_audit_logs_project_id_index ON admin_audit_logs ( project_id );
+CREATE INDEX api_key_usage_rollups_project_id_interval_start_index ON api_key_usage_rollups ( project_id, interval_start );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time );
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start );
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id );
@@ -1317,6 +1550,7 @@ CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_h
 CREATE INDEX injuredsegments_updated_at_index ON injuredsegments ( updated_at );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success );
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id );
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start );
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start );
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period );
@@ -1603,1989 +1837,2714 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type AuditHistory struct {
-	NodeId  []byte
-	History []byte
+type AdminAuditLog struct {
+	Id         int64
+	CreatedAt  time.Time
+	Actor      string
+	TokenId    []byte
+	Method     string
+	Path       string
+	UserEmail  *string
+	ProjectId  []byte
+	Details    string
+	StatusCode *int
 }
 
-func (AuditHistory) _Table() string { return "audit_histories" }
+func (AdminAuditLog) _Table() string { return "admin_audit_logs" }
 
-type AuditHistory_Update_Fields struct {
-	History AuditHistory_History_Field
+type AdminAuditLog_Create_Fields struct {
+	TokenId    AdminAuditLog_TokenId_Field
+	UserEmail  AdminAuditLog_UserEmail_Field
+	ProjectId  AdminAuditLog_ProjectId_Field
+	StatusCode AdminAuditLog_StatusCode_Field
 }
 
-type AuditHistory_NodeId_Field struct {
+type AdminAuditLog_Update_Fields struct {
+	StatusCode AdminAuditLog_StatusCode_Field
+}
+
+type AdminAuditLog_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func AuditHistory_NodeId(v []byte) AuditHistory_NodeId_Field {
-	return AuditHistory_NodeId_Field{_set: true, _value: v}
+func AdminAuditLog_Id(v int64) AdminAuditLog_Id_Field {
+	return AdminAuditLog_Id_Field{_set: true, _value: v}
 }
 
-func (f AuditHistory_NodeId_Field) value() interface{} {
+func (f AdminAuditLog_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AuditHistory_NodeId_Field) _Column() string { return "node_id" }
+func (AdminAuditLog_Id_Field) _Column() string { return "id" }
 
-type AuditHistory_History_Field struct {
+type AdminAuditLog_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func AuditHistory_History(v []byte) AuditHistory_History_Field {
-	return AuditHistory_History_Field{_set: true, _value: v}
+func AdminAuditLog_CreatedAt(v time.Time) AdminAuditLog_CreatedAt_Field {
+	return AdminAuditLog_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f AuditHistory_History_Field) value() interface{} {
+func (f AdminAuditLog_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AuditHistory_History_Field) _Column() string { return "history" }
-
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
-
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
-}
+func (AdminAuditLog_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketBandwidthRollup_BucketName_Field struct {
+type AdminAuditLog_Actor_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func AdminAuditLog_Actor(v string) AdminAuditLog_Actor_Field {
+	return AdminAuditLog_Actor_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f AdminAuditLog_Actor_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+func (AdminAuditLog_Actor_Field) _Column() string { return "actor" }
 
-type BucketBandwidthRollup_ProjectId_Field struct {
+type AdminAuditLog_TokenId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func AdminAuditLog_TokenId(v []byte) AdminAuditLog_TokenId_Field {
+	return AdminAuditLog_TokenId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+func AdminAuditLog_TokenId_Raw(v []byte) AdminAuditLog_TokenId_Field {
+	if v == nil {
+		return AdminAuditLog_TokenId_Null()
+	}
+	return AdminAuditLog_TokenId(v)
+}
+
+func AdminAuditLog_TokenId_Null() AdminAuditLog_TokenId_Field {
+	return AdminAuditLog_TokenId_Field{_set: true, _null: true}
+}
+
+func (f AdminAuditLog_TokenId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AdminAuditLog_TokenId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (AdminAuditLog_TokenId_Field) _Column() string { return "token_id" }
 
-type BucketBandwidthRollup_IntervalStart_Field struct {
+type AdminAuditLog_Method_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func AdminAuditLog_Method(v string) AdminAuditLog_Method_Field {
+	return AdminAuditLog_Method_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f AdminAuditLog_Method_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (AdminAuditLog_Method_Field) _Column() string { return "method" }
 
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
+type AdminAuditLog_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func AdminAuditLog_Path(v string) AdminAuditLog_Path_Field {
+	return AdminAuditLog_Path_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f AdminAuditLog_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (AdminAuditLog_Path_Field) _Column() string { return "path" }
 
-type BucketBandwidthRollup_Action_Field struct {
+type AdminAuditLog_UserEmail_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value *string
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+func AdminAuditLog_UserEmail(v string) AdminAuditLog_UserEmail_Field {
+	return AdminAuditLog_UserEmail_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+func AdminAuditLog_UserEmail_Raw(v *string) AdminAuditLog_UserEmail_Field {
+	if v == nil {
+		return AdminAuditLog_UserEmail_Null()
+	}
+	return AdminAuditLog_UserEmail(*v)
+}
+
+func AdminAuditLog_UserEmail_Null() AdminAuditLog_UserEmail_Field {
+	return AdminAuditLog_UserEmail_Field{_set: true, _null: true}
+}
+
+func (f AdminAuditLog_UserEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AdminAuditLog_UserEmail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (AdminAuditLog_UserEmail_Field) _Column() string { return "user_email" }
 
-type BucketBandwidthRollup_Inline_Field struct {
+type AdminAuditLog_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func AdminAuditLog_ProjectId(v []byte) AdminAuditLog_ProjectId_Field {
+	return AdminAuditLog_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func AdminAuditLog_ProjectId_Raw(v []byte) AdminAuditLog_ProjectId_Field {
+	if v == nil {
+		return AdminAuditLog_ProjectId_Null()
+	}
+	return AdminAuditLog_ProjectId(v)
+}
+
+func AdminAuditLog_ProjectId_Null() AdminAuditLog_ProjectId_Field {
+	return AdminAuditLog_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f AdminAuditLog_ProjectId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AdminAuditLog_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (AdminAuditLog_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type AdminAuditLog_Details_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func AdminAuditLog_Details(v string) AdminAuditLog_Details_Field {
+	return AdminAuditLog_Details_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f AdminAuditLog_Details_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (AdminAuditLog_Details_Field) _Column() string { return "details" }
 
-type BucketBandwidthRollup_Settled_Field struct {
+type AdminAuditLog_StatusCode_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *int
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func AdminAuditLog_StatusCode(v int) AdminAuditLog_StatusCode_Field {
+	return AdminAuditLog_StatusCode_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func AdminAuditLog_StatusCode_Raw(v *int) AdminAuditLog_StatusCode_Field {
+	if v == nil {
+		return AdminAuditLog_StatusCode_Null()
+	}
+	return AdminAuditLog_StatusCode(*v)
+}
+
+func AdminAuditLog_StatusCode_Null() AdminAuditLog_StatusCode_Field {
+	return AdminAuditLog_StatusCode_Field{_set: true, _null: true}
+}
+
+func (f AdminAuditLog_StatusCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AdminAuditLog_StatusCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (AdminAuditLog_StatusCode_Field) _Column() string { return "status_code" }
 
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type AdminToken struct {
+	Id         []byte
+	Name       string
+	Scopes     string
+	SecretHash []byte
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
 }
 
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+func (AdminToken) _Table() string { return "admin_tokens" }
 
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
+type AdminToken_Create_Fields struct {
+	ExpiresAt AdminToken_ExpiresAt_Field
+	RevokedAt AdminToken_RevokedAt_Field
 }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type AdminToken_Update_Fields struct {
+	RevokedAt AdminToken_RevokedAt_Field
+}
+
+type AdminToken_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func AdminToken_Id(v []byte) AdminToken_Id_Field {
+	return AdminToken_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f AdminToken_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (AdminToken_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type AdminToken_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func AdminToken_Name(v string) AdminToken_Name_Field {
+	return AdminToken_Name_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f AdminToken_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (AdminToken_Name_Field) _Column() string { return "name" }
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type AdminToken_Scopes_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func AdminToken_Scopes(v string) AdminToken_Scopes_Field {
+	return AdminToken_Scopes_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f AdminToken_Scopes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (AdminToken_Scopes_Field) _Column() string { return "scopes" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type AdminToken_SecretHash_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func AdminToken_SecretHash(v []byte) AdminToken_SecretHash_Field {
+	return AdminToken_SecretHash_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f AdminToken_SecretHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (AdminToken_SecretHash_Field) _Column() string { return "secret_hash" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type AdminToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func AdminToken_CreatedAt(v time.Time) AdminToken_CreatedAt_Field {
+	return AdminToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f AdminToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (AdminToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type AdminToken_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func AdminToken_ExpiresAt(v time.Time) AdminToken_ExpiresAt_Field {
+	return AdminToken_ExpiresAt_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func AdminToken_ExpiresAt_Raw(v *time.Time) AdminToken_ExpiresAt_Field {
+	if v == nil {
+		return AdminToken_ExpiresAt_Null()
 	}
-	return f._value
+	return AdminToken_ExpiresAt(*v)
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
-
-type BucketBandwidthRollupArchive_Allocated_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
+func AdminToken_ExpiresAt_Null() AdminToken_ExpiresAt_Field {
+	return AdminToken_ExpiresAt_Field{_set: true, _null: true}
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
-}
+func (f AdminToken_ExpiresAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f AdminToken_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (AdminToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type AdminToken_RevokedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value *time.Time
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func AdminToken_RevokedAt(v time.Time) AdminToken_RevokedAt_Field {
+	return AdminToken_RevokedAt_Field{_set: true, _value: &v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func AdminToken_RevokedAt_Raw(v *time.Time) AdminToken_RevokedAt_Field {
+	if v == nil {
+		return AdminToken_RevokedAt_Null()
+	}
+	return AdminToken_RevokedAt(*v)
+}
+
+func AdminToken_RevokedAt_Null() AdminToken_RevokedAt_Field {
+	return AdminToken_RevokedAt_Field{_set: true, _null: true}
+}
+
+func (f AdminToken_RevokedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AdminToken_RevokedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (AdminToken_RevokedAt_Field) _Column() string { return "revoked_at" }
 
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	Inline              uint64
-	Remote              uint64
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
+type ApiKeyUsageRollup struct {
+	Head          []byte
+	ProjectId     []byte
+	IntervalStart time.Time
+	Requests      int64
+	Egress        int64
 }
 
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+func (ApiKeyUsageRollup) _Table() string { return "api_key_usage_rollups" }
 
-type BucketStorageTally_Update_Fields struct {
+type ApiKeyUsageRollup_Update_Fields struct {
 }
 
-type BucketStorageTally_BucketName_Field struct {
+type ApiKeyUsageRollup_Head_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func ApiKeyUsageRollup_Head(v []byte) ApiKeyUsageRollup_Head_Field {
+	return ApiKeyUsageRollup_Head_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f ApiKeyUsageRollup_Head_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (ApiKeyUsageRollup_Head_Field) _Column() string { return "head" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type ApiKeyUsageRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func ApiKeyUsageRollup_ProjectId(v []byte) ApiKeyUsageRollup_ProjectId_Field {
+	return ApiKeyUsageRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f ApiKeyUsageRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (ApiKeyUsageRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type ApiKeyUsageRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
-}
-
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
-
-type BucketStorageTally_Inline_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
-}
-
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func ApiKeyUsageRollup_IntervalStart(v time.Time) ApiKeyUsageRollup_IntervalStart_Field {
+	return ApiKeyUsageRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f ApiKeyUsageRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (ApiKeyUsageRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketStorageTally_Remote_Field struct {
+type ApiKeyUsageRollup_Requests_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+func ApiKeyUsageRollup_Requests(v int64) ApiKeyUsageRollup_Requests_Field {
+	return ApiKeyUsageRollup_Requests_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Remote_Field) value() interface{} {
+func (f ApiKeyUsageRollup_Requests_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+func (ApiKeyUsageRollup_Requests_Field) _Column() string { return "requests" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type ApiKeyUsageRollup_Egress_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func ApiKeyUsageRollup_Egress(v int64) ApiKeyUsageRollup_Egress_Field {
+	return ApiKeyUsageRollup_Egress_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f ApiKeyUsageRollup_Egress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (ApiKeyUsageRollup_Egress_Field) _Column() string { return "egress" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
-	_set   bool
-	_null  bool
-	_value uint
+type AuditHistory struct {
+	NodeId  []byte
+	History []byte
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
-}
+func (AuditHistory) _Table() string { return "audit_histories" }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type AuditHistory_Update_Fields struct {
+	History AuditHistory_History_Field
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
-
-type BucketStorageTally_ObjectCount_Field struct {
+type AuditHistory_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func AuditHistory_NodeId(v []byte) AuditHistory_NodeId_Field {
+	return AuditHistory_NodeId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+func (f AuditHistory_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+func (AuditHistory_NodeId_Field) _Column() string { return "node_id" }
 
-type BucketStorageTally_MetadataSize_Field struct {
+type AuditHistory_History_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
-	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+func AuditHistory_History(v []byte) AuditHistory_History_Field {
+	return AuditHistory_History_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+func (f AuditHistory_History_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+func (AuditHistory_History_Field) _Column() string { return "history" }
 
-type CoinpaymentsTransaction struct {
-	Id        string
-	UserId    []byte
-	Address   string
-	Amount    []byte
-	Received  []byte
-	Status    int
-	Key       string
-	Timeout   int
-	CreatedAt time.Time
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
 
-type CoinpaymentsTransaction_Update_Fields struct {
-	Received CoinpaymentsTransaction_Received_Field
-	Status   CoinpaymentsTransaction_Status_Field
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
 }
 
-type CoinpaymentsTransaction_Id_Field struct {
+type BucketBandwidthRollup_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CoinpaymentsTransaction_Amount_Field struct {
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func CoinpaymentsTransaction_Amount(v []byte) CoinpaymentsTransaction_Amount_Field {
-	return CoinpaymentsTransaction_Amount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Amount_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Amount_Field) _Column() string { return "amount" }
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type CoinpaymentsTransaction_Received_Field struct {
+type BucketBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func CoinpaymentsTransaction_Received(v []byte) CoinpaymentsTransaction_Received_Field {
-	return CoinpaymentsTransaction_Received_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Received_Field) value() interface{} {
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Received_Field) _Column() string { return "received" }
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
-	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type CoinpaymentsTransaction_Key_Field struct {
+type BucketBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
-	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_Timeout_Field struct {
+type BucketBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
-	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type CoinpaymentsTransaction_CreatedAt_Field struct {
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
+}
+
+type BucketBandwidthRollupArchive_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
-	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
-
-type Coupon struct {
-	Id             []byte
-	UserId         []byte
-	Amount         int64
-	Description    string
-	Type           int
-	Status         int
-	Duration       int64
-	BillingPeriods *int64
-	CouponCodeName *string
-	CreatedAt      time.Time
-}
-
-func (Coupon) _Table() string { return "coupons" }
-
-type Coupon_Create_Fields struct {
-	BillingPeriods Coupon_BillingPeriods_Field
-	CouponCodeName Coupon_CouponCodeName_Field
-}
-
-type Coupon_Update_Fields struct {
-	Status Coupon_Status_Field
-}
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Coupon_Id_Field struct {
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Coupon_Id(v []byte) Coupon_Id_Field {
-	return Coupon_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Id_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
 
-type Coupon_UserId_Field struct {
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Coupon_UserId(v []byte) Coupon_UserId_Field {
-	return Coupon_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Coupon_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Coupon_Amount_Field struct {
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func Coupon_Amount(v int64) Coupon_Amount_Field {
-	return Coupon_Amount_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Amount_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Amount_Field) _Column() string { return "amount" }
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type Coupon_Description_Field struct {
+type BucketBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint
 }
 
-func Coupon_Description(v string) Coupon_Description_Field {
-	return Coupon_Description_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Description_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Description_Field) _Column() string { return "description" }
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type Coupon_Type_Field struct {
+type BucketBandwidthRollupArchive_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func Coupon_Type(v int) Coupon_Type_Field {
-	return Coupon_Type_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Type_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Type_Field) _Column() string { return "type" }
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
 
-type Coupon_Status_Field struct {
+type BucketBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func Coupon_Status(v int) Coupon_Status_Field {
-	return Coupon_Status_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Status_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Status_Field) _Column() string { return "status" }
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type Coupon_Duration_Field struct {
+type BucketBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func Coupon_Duration(v int64) Coupon_Duration_Field {
-	return Coupon_Duration_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Duration_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Duration_Field) _Column() string { return "duration" }
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type Coupon_BillingPeriods_Field struct {
-	_set   bool
-	_null  bool
-	_value *int64
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	Inline              uint64
+	Remote              uint64
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
 }
 
-func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
-}
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
 
-func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
-	if v == nil {
-		return Coupon_BillingPeriods_Null()
-	}
-	return Coupon_BillingPeriods(*v)
+type BucketStorageTally_Update_Fields struct {
 }
 
-func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+type BucketStorageTally_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+}
 
-func (f Coupon_BillingPeriods_Field) value() interface{} {
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Coupon_CouponCodeName_Field struct {
+type BucketStorageTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
-}
-
-func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
-	if v == nil {
-		return Coupon_CouponCodeName_Null()
-	}
-	return Coupon_CouponCodeName(*v)
+	_value []byte
 }
 
-func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _null: true}
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Coupon_CouponCodeName_Field) value() interface{} {
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
 
-type Coupon_CreatedAt_Field struct {
+type BucketStorageTally_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
-	return Coupon_CreatedAt_Field{_set: true, _value: v}
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Coupon_CreatedAt_Field) value() interface{} {
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
-
-type CouponCode struct {
-	Id             []byte
-	Name           string
-	Amount         int64
-	Description    string
-	Type           int
-	BillingPeriods *int64
-	CreatedAt      time.Time
-}
-
-func (CouponCode) _Table() string { return "coupon_codes" }
-
-type CouponCode_Create_Fields struct {
-	BillingPeriods CouponCode_BillingPeriods_Field
-}
-
-type CouponCode_Update_Fields struct {
-}
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CouponCode_Id_Field struct {
+type BucketStorageTally_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func CouponCode_Id(v []byte) CouponCode_Id_Field {
-	return CouponCode_Id_Field{_set: true, _value: v}
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Id_Field) value() interface{} {
+func (f BucketStorageTally_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Id_Field) _Column() string { return "id" }
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
 
-type CouponCode_Name_Field struct {
+type BucketStorageTally_Remote_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CouponCode_Name(v string) CouponCode_Name_Field {
-	return CouponCode_Name_Field{_set: true, _value: v}
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Name_Field) value() interface{} {
+func (f BucketStorageTally_Remote_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Name_Field) _Column() string { return "name" }
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
 
-type CouponCode_Amount_Field struct {
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func CouponCode_Amount(v int64) CouponCode_Amount_Field {
-	return CouponCode_Amount_Field{_set: true, _value: v}
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Amount_Field) value() interface{} {
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Amount_Field) _Column() string { return "amount" }
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
 
-type CouponCode_Description_Field struct {
+type BucketStorageTally_InlineSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint
 }
 
-func CouponCode_Description(v string) CouponCode_Description_Field {
-	return CouponCode_Description_Field{_set: true, _value: v}
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Description_Field) value() interface{} {
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Description_Field) _Column() string { return "description" }
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
 
-type CouponCode_Type_Field struct {
+type BucketStorageTally_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func CouponCode_Type(v int) CouponCode_Type_Field {
-	return CouponCode_Type_Field{_set: true, _value: v}
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Type_Field) value() interface{} {
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Type_Field) _Column() string { return "type" }
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
 
-type CouponCode_BillingPeriods_Field struct {
+type BucketStorageTally_MetadataSize_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value uint64
 }
 
-func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
+func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
+	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
 }
 
-func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
-	if v == nil {
-		return CouponCode_BillingPeriods_Null()
+func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return CouponCode_BillingPeriods(*v)
+	return f._value
 }
 
-func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
+func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+
+type CoinpaymentsTransaction struct {
+	Id        string
+	UserId    []byte
+	Address   string
+	Amount    []byte
+	Received  []byte
+	Status    int
+	Key       string
+	Timeout   int
+	CreatedAt time.Time
 }
 
-func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
 
-func (f CouponCode_BillingPeriods_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type CoinpaymentsTransaction_Update_Fields struct {
+	Received CoinpaymentsTransaction_Received_Field
+	Status   CoinpaymentsTransaction_Status_Field
 }
 
-func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
-
-type CouponCode_CreatedAt_Field struct {
+type CoinpaymentsTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
-	return CouponCode_CreatedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_CreatedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
-
-type CouponUsage struct {
-	CouponId []byte
-	Amount   int64
-	Status   int
-	Period   time.Time
-}
-
-func (CouponUsage) _Table() string { return "coupon_usages" }
-
-type CouponUsage_Update_Fields struct {
-	Status CouponUsage_Status_Field
-}
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
 
-type CouponUsage_CouponId_Field struct {
+type CoinpaymentsTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
-	return CouponUsage_CouponId_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_CouponId_Field) value() interface{} {
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type CouponUsage_Amount_Field struct {
+type CoinpaymentsTransaction_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
-	return CouponUsage_Amount_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Amount_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
 
-type CouponUsage_Status_Field struct {
+type CoinpaymentsTransaction_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func CouponUsage_Status(v int) CouponUsage_Status_Field {
-	return CouponUsage_Status_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Amount(v []byte) CoinpaymentsTransaction_Amount_Field {
+	return CoinpaymentsTransaction_Amount_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Status_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Status_Field) _Column() string { return "status" }
+func (CoinpaymentsTransaction_Amount_Field) _Column() string { return "amount" }
 
-type CouponUsage_Period_Field struct {
+type CoinpaymentsTransaction_Received_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
-	return CouponUsage_Period_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Received(v []byte) CoinpaymentsTransaction_Received_Field {
+	return CoinpaymentsTransaction_Received_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Period_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Received_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Period_Field) _Column() string { return "period" }
+func (CoinpaymentsTransaction_Received_Field) _Column() string { return "received" }
 
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
+type CoinpaymentsTransaction_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+}
 
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type GracefulExitProgress_NodeId_Field struct {
+func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+
+type CoinpaymentsTransaction_Key_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
+	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type CoinpaymentsTransaction_Timeout_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
+	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type CoinpaymentsTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
+	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
+type Coupon struct {
+	Id             []byte
+	UserId         []byte
+	Amount         int64
+	Description    string
+	Type           int
+	Status         int
+	Duration       int64
+	BillingPeriods *int64
+	CouponCodeName *string
+	CreatedAt      time.Time
+}
+
+func (Coupon) _Table() string { return "coupons" }
+
+type Coupon_Create_Fields struct {
+	BillingPeriods Coupon_BillingPeriods_Field
+	CouponCodeName Coupon_CouponCodeName_Field
+}
+
+type Coupon_Update_Fields struct {
+	Status Coupon_Status_Field
+}
+
+type Coupon_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
-	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+func Coupon_Id(v []byte) Coupon_Id_Field {
+	return Coupon_Id_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+func (f Coupon_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+func (Coupon_Id_Field) _Column() string { return "id" }
 
-type GracefulExitProgress_UpdatedAt_Field struct {
+type Coupon_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
-	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+func Coupon_UserId(v []byte) Coupon_UserId_Field {
+	return Coupon_UserId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+func (f Coupon_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type GracefulExitTransferQueue struct {
-	NodeId              []byte
-	Path                []byte
-	PieceNum            int
-	RootPieceId         []byte
-	DurabilityRatio     float64
-	QueuedAt            time.Time
-	RequestedAt         *time.Time
-	LastFailedAt        *time.Time
-	LastFailedCode      *int
-	FailedCount         *int
-	FinishedAt          *time.Time
-	OrderLimitSendCount int
-}
-
-func (GracefulExitTransferQueue) _Table() string { return "graceful_exit_transfer_queue" }
-
-type GracefulExitTransferQueue_Create_Fields struct {
-	RootPieceId         GracefulExitTransferQueue_RootPieceId_Field
-	RequestedAt         GracefulExitTransferQueue_RequestedAt_Field
-	LastFailedAt        GracefulExitTransferQueue_LastFailedAt_Field
-	LastFailedCode      GracefulExitTransferQueue_LastFailedCode_Field
-	FailedCount         GracefulExitTransferQueue_FailedCount_Field
-	FinishedAt          GracefulExitTransferQueue_FinishedAt_Field
-	OrderLimitSendCount GracefulExitTransferQueue_OrderLimitSendCount_Field
-}
-
-type GracefulExitTransferQueue_Update_Fields struct {
-	DurabilityRatio     GracefulExitTransferQueue_DurabilityRatio_Field
-	RequestedAt         GracefulExitTransferQueue_RequestedAt_Field
-	LastFailedAt        GracefulExitTransferQueue_LastFailedAt_Field
-	LastFailedCode      GracefulExitTransferQueue_LastFailedCode_Field
-	FailedCount         GracefulExitTransferQueue_FailedCount_Field
-	FinishedAt          GracefulExitTransferQueue_FinishedAt_Field
-	OrderLimitSendCount GracefulExitTransferQueue_OrderLimitSendCount_Field
-}
+func (Coupon_UserId_Field) _Column() string { return "user_id" }
 
-type GracefulExitTransferQueue_NodeId_Field struct {
+type Coupon_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func GracefulExitTransferQueue_NodeId(v []byte) GracefulExitTransferQueue_NodeId_Field {
-	return GracefulExitTransferQueue_NodeId_Field{_set: true, _value: v}
+func Coupon_Amount(v int64) Coupon_Amount_Field {
+	return Coupon_Amount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_NodeId_Field) value() interface{} {
+func (f Coupon_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_NodeId_Field) _Column() string { return "node_id" }
+func (Coupon_Amount_Field) _Column() string { return "amount" }
 
-type GracefulExitTransferQueue_Path_Field struct {
+type Coupon_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func GracefulExitTransferQueue_Path(v []byte) GracefulExitTransferQueue_Path_Field {
-	return GracefulExitTransferQueue_Path_Field{_set: true, _value: v}
+func Coupon_Description(v string) Coupon_Description_Field {
+	return Coupon_Description_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_Path_Field) value() interface{} {
+func (f Coupon_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_Path_Field) _Column() string { return "path" }
+func (Coupon_Description_Field) _Column() string { return "description" }
 
-type GracefulExitTransferQueue_PieceNum_Field struct {
+type Coupon_Type_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func GracefulExitTransferQueue_PieceNum(v int) GracefulExitTransferQueue_PieceNum_Field {
-	return GracefulExitTransferQueue_PieceNum_Field{_set: true, _value: v}
+func Coupon_Type(v int) Coupon_Type_Field {
+	return Coupon_Type_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_PieceNum_Field) value() interface{} {
+func (f Coupon_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_PieceNum_Field) _Column() string { return "piece_num" }
+func (Coupon_Type_Field) _Column() string { return "type" }
 
-type GracefulExitTransferQueue_RootPieceId_Field struct {
+type Coupon_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func GracefulExitTransferQueue_RootPieceId(v []byte) GracefulExitTransferQueue_RootPieceId_Field {
-	return GracefulExitTransferQueue_RootPieceId_Field{_set: true, _value: v}
-}
-
-func GracefulExitTransferQueue_RootPieceId_Raw(v []byte) GracefulExitTransferQueue_RootPieceId_Field {
-	if v == nil {
-		return GracefulExitTransferQueue_RootPieceId_Null()
-	}
-	return GracefulExitTransferQueue_RootPieceId(v)
-}
-
-func GracefulExitTransferQueue_RootPieceId_Null() GracefulExitTransferQueue_RootPieceId_Field {
-	return GracefulExitTransferQueue_RootPieceId_Field{_set: true, _null: true}
+	_value int
 }
 
-func (f GracefulExitTransferQueue_RootPieceId_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func Coupon_Status(v int) Coupon_Status_Field {
+	return Coupon_Status_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_RootPieceId_Field) value() interface{} {
+func (f Coupon_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_RootPieceId_Field) _Column() string { return "root_piece_id" }
+func (Coupon_Status_Field) _Column() string { return "status" }
 
-type GracefulExitTransferQueue_DurabilityRatio_Field struct {
+type Coupon_Duration_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func GracefulExitTransferQueue_DurabilityRatio(v float64) GracefulExitTransferQueue_DurabilityRatio_Field {
-	return GracefulExitTransferQueue_DurabilityRatio_Field{_set: true, _value: v}
+func Coupon_Duration(v int64) Coupon_Duration_Field {
+	return Coupon_Duration_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_DurabilityRatio_Field) value() interface{} {
+func (f Coupon_Duration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+func (Coupon_Duration_Field) _Column() string { return "duration" }
 
-type GracefulExitTransferQueue_QueuedAt_Field struct {
+type Coupon_BillingPeriods_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int64
 }
 
-func GracefulExitTransferQueue_QueuedAt(v time.Time) GracefulExitTransferQueue_QueuedAt_Field {
-	return GracefulExitTransferQueue_QueuedAt_Field{_set: true, _value: v}
+func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitTransferQueue_QueuedAt_Field) value() interface{} {
+func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
+	if v == nil {
+		return Coupon_BillingPeriods_Null()
+	}
+	return Coupon_BillingPeriods(*v)
+}
+
+func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+}
+
+func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Coupon_BillingPeriods_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_QueuedAt_Field) _Column() string { return "queued_at" }
+func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
 
-type GracefulExitTransferQueue_RequestedAt_Field struct {
+type Coupon_CouponCodeName_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *string
 }
 
-func GracefulExitTransferQueue_RequestedAt(v time.Time) GracefulExitTransferQueue_RequestedAt_Field {
-	return GracefulExitTransferQueue_RequestedAt_Field{_set: true, _value: &v}
+func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
 }
 
-func GracefulExitTransferQueue_RequestedAt_Raw(v *time.Time) GracefulExitTransferQueue_RequestedAt_Field {
+func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
 	if v == nil {
-		return GracefulExitTransferQueue_RequestedAt_Null()
+		return Coupon_CouponCodeName_Null()
 	}
-	return GracefulExitTransferQueue_RequestedAt(*v)
+	return Coupon_CouponCodeName(*v)
 }
 
-func GracefulExitTransferQueue_RequestedAt_Null() GracefulExitTransferQueue_RequestedAt_Field {
-	return GracefulExitTransferQueue_RequestedAt_Field{_set: true, _null: true}
+func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitTransferQueue_RequestedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f GracefulExitTransferQueue_RequestedAt_Field) value() interface{} {
+func (f Coupon_CouponCodeName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_RequestedAt_Field) _Column() string { return "requested_at" }
+func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
 
-type GracefulExitTransferQueue_LastFailedAt_Field struct {
+type Coupon_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func GracefulExitTransferQueue_LastFailedAt(v time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
-	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _value: &v}
+func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
+	return Coupon_CreatedAt_Field{_set: true, _value: v}
 }
 
-func GracefulExitTransferQueue_LastFailedAt_Raw(v *time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
-	if v == nil {
-		return GracefulExitTransferQueue_LastFailedAt_Null()
+func (f Coupon_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitTransferQueue_LastFailedAt(*v)
+	return f._value
 }
 
-func GracefulExitTransferQueue_LastFailedAt_Null() GracefulExitTransferQueue_LastFailedAt_Field {
-	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _null: true}
+func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
+
+type CouponCode struct {
+	Id             []byte
+	Name           string
+	Amount         int64
+	Description    string
+	Type           int
+	BillingPeriods *int64
+	CreatedAt      time.Time
 }
 
-func (f GracefulExitTransferQueue_LastFailedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (CouponCode) _Table() string { return "coupon_codes" }
+
+type CouponCode_Create_Fields struct {
+	BillingPeriods CouponCode_BillingPeriods_Field
 }
 
-func (f GracefulExitTransferQueue_LastFailedAt_Field) value() interface{} {
+type CouponCode_Update_Fields struct {
+}
+
+type CouponCode_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CouponCode_Id(v []byte) CouponCode_Id_Field {
+	return CouponCode_Id_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+func (CouponCode_Id_Field) _Column() string { return "id" }
 
-type GracefulExitTransferQueue_LastFailedCode_Field struct {
+type CouponCode_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func GracefulExitTransferQueue_LastFailedCode(v int) GracefulExitTransferQueue_LastFailedCode_Field {
-	return GracefulExitTransferQueue_LastFailedCode_Field{_set: true, _value: &v}
+func CouponCode_Name(v string) CouponCode_Name_Field {
+	return CouponCode_Name_Field{_set: true, _value: v}
 }
 
-func GracefulExitTransferQueue_LastFailedCode_Raw(v *int) GracefulExitTransferQueue_LastFailedCode_Field {
-	if v == nil {
-		return GracefulExitTransferQueue_LastFailedCode_Null()
+func (f CouponCode_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitTransferQueue_LastFailedCode(*v)
+	return f._value
 }
 
-func GracefulExitTransferQueue_LastFailedCode_Null() GracefulExitTransferQueue_LastFailedCode_Field {
-	return GracefulExitTransferQueue_LastFailedCode_Field{_set: true, _null: true}
+func (CouponCode_Name_Field) _Column() string { return "name" }
+
+type CouponCode_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f GracefulExitTransferQueue_LastFailedCode_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CouponCode_Amount(v int64) CouponCode_Amount_Field {
+	return CouponCode_Amount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_LastFailedCode_Field) value() interface{} {
+func (f CouponCode_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+func (CouponCode_Amount_Field) _Column() string { return "amount" }
 
-type GracefulExitTransferQueue_FailedCount_Field struct {
+type CouponCode_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func GracefulExitTransferQueue_FailedCount(v int) GracefulExitTransferQueue_FailedCount_Field {
-	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _value: &v}
+func CouponCode_Description(v string) CouponCode_Description_Field {
+	return CouponCode_Description_Field{_set: true, _value: v}
 }
 
-func GracefulExitTransferQueue_FailedCount_Raw(v *int) GracefulExitTransferQueue_FailedCount_Field {
-	if v == nil {
-		return GracefulExitTransferQueue_FailedCount_Null()
+func (f CouponCode_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitTransferQueue_FailedCount(*v)
+	return f._value
 }
 
-func GracefulExitTransferQueue_FailedCount_Null() GracefulExitTransferQueue_FailedCount_Field {
-	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _null: true}
+func (CouponCode_Description_Field) _Column() string { return "description" }
+
+type CouponCode_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f GracefulExitTransferQueue_FailedCount_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CouponCode_Type(v int) CouponCode_Type_Field {
+	return CouponCode_Type_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_FailedCount_Field) value() interface{} {
+func (f CouponCode_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_FailedCount_Field) _Column() string { return "failed_count" }
+func (CouponCode_Type_Field) _Column() string { return "type" }
 
-type GracefulExitTransferQueue_FinishedAt_Field struct {
+type CouponCode_BillingPeriods_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func GracefulExitTransferQueue_FinishedAt(v time.Time) GracefulExitTransferQueue_FinishedAt_Field {
-	return GracefulExitTransferQueue_FinishedAt_Field{_set: true, _value: &v}
+func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
 }
 
-func GracefulExitTransferQueue_FinishedAt_Raw(v *time.Time) GracefulExitTransferQueue_FinishedAt_Field {
+func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
 	if v == nil {
-		return GracefulExitTransferQueue_FinishedAt_Null()
+		return CouponCode_BillingPeriods_Null()
 	}
-	return GracefulExitTransferQueue_FinishedAt(*v)
+	return CouponCode_BillingPeriods(*v)
 }
 
-func GracefulExitTransferQueue_FinishedAt_Null() GracefulExitTransferQueue_FinishedAt_Field {
-	return GracefulExitTransferQueue_FinishedAt_Field{_set: true, _null: true}
+func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitTransferQueue_FinishedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f GracefulExitTransferQueue_FinishedAt_Field) value() interface{} {
+func (f CouponCode_BillingPeriods_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_FinishedAt_Field) _Column() string { return "finished_at" }
+func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
 
-type GracefulExitTransferQueue_OrderLimitSendCount_Field struct {
+type CouponCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func GracefulExitTransferQueue_OrderLimitSendCount(v int) GracefulExitTransferQueue_OrderLimitSendCount_Field {
-	return GracefulExitTransferQueue_OrderLimitSendCount_Field{_set: true, _value: v}
+func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
+	return CouponCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitTransferQueue_OrderLimitSendCount_Field) value() interface{} {
+func (f CouponCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitTransferQueue_OrderLimitSendCount_Field) _Column() string {
-	return "order_limit_send_count"
+func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
+
+type CouponUsage struct {
+	CouponId []byte
+	Amount   int64
+	Status   int
+	Period   time.Time
 }
 
-type Injuredsegment struct {
-	Path          []byte
-	Data          []byte
-	Attempted     *time.Time
-	UpdatedAt     time.Time
-	SegmentHealth float64
+func (CouponUsage) _Table() string { return "coupon_usages" }
+
+type CouponUsage_Update_Fields struct {
+	Status CouponUsage_Status_Field
 }
 
-func (Injuredsegment) _Table() string { return "injuredsegments" }
+type CouponUsage_CouponId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-type Injuredsegment_Create_Fields struct {
-	Attempted     Injuredsegment_Attempted_Field
-	UpdatedAt     Injuredsegment_UpdatedAt_Field
-	SegmentHealth Injuredsegment_SegmentHealth_Field
+func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
+	return CouponUsage_CouponId_Field{_set: true, _value: v}
 }
 
-type Injuredsegment_Update_Fields struct {
-	Attempted Injuredsegment_Attempted_Field
-	UpdatedAt Injuredsegment_UpdatedAt_Field
+func (f CouponUsage_CouponId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Injuredsegment_Path_Field struct {
+func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+
+type CouponUsage_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Injuredsegment_Path(v []byte) Injuredsegment_Path_Field {
-	return Injuredsegment_Path_Field{_set: true, _value: v}
+func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
+	return CouponUsage_Amount_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Path_Field) value() interface{} {
+func (f CouponUsage_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Path_Field) _Column() string { return "path" }
+func (CouponUsage_Amount_Field) _Column() string { return "amount" }
 
-type Injuredsegment_Data_Field struct {
+type CouponUsage_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
-	return Injuredsegment_Data_Field{_set: true, _value: v}
+func CouponUsage_Status(v int) CouponUsage_Status_Field {
+	return CouponUsage_Status_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Data_Field) value() interface{} {
+func (f CouponUsage_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Data_Field) _Column() string { return "data" }
+func (CouponUsage_Status_Field) _Column() string { return "status" }
 
-type Injuredsegment_Attempted_Field struct {
+type CouponUsage_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func Injuredsegment_Attempted(v time.Time) Injuredsegment_Attempted_Field {
-	return Injuredsegment_Attempted_Field{_set: true, _value: &v}
+func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
+	return CouponUsage_Period_Field{_set: true, _value: v}
 }
 
-func Injuredsegment_Attempted_Raw(v *time.Time) Injuredsegment_Attempted_Field {
-	if v == nil {
-		return Injuredsegment_Attempted_Null()
+func (f CouponUsage_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Injuredsegment_Attempted(*v)
+	return f._value
 }
 
-func Injuredsegment_Attempted_Null() Injuredsegment_Attempted_Field {
-	return Injuredsegment_Attempted_Field{_set: true, _null: true}
+func (CouponUsage_Period_Field) _Column() string { return "period" }
+
+type DurabilityHistogram struct {
+	ProjectId      []byte
+	BucketName     []byte
+	RequiredShares int
+	RepairShares   int
+	OptimalShares  int
+	TotalShares    int
+	HealthyCounts  []byte
+	ComputedAt     time.Time
 }
 
-func (f Injuredsegment_Attempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (DurabilityHistogram) _Table() string { return "durability_histograms" }
 
-func (f Injuredsegment_Attempted_Field) value() interface{} {
+type DurabilityHistogram_Update_Fields struct {
+}
+
+type DurabilityHistogram_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func DurabilityHistogram_ProjectId(v []byte) DurabilityHistogram_ProjectId_Field {
+	return DurabilityHistogram_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f DurabilityHistogram_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
+func (DurabilityHistogram_ProjectId_Field) _Column() string { return "project_id" }
 
-type Injuredsegment_UpdatedAt_Field struct {
+type DurabilityHistogram_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Injuredsegment_UpdatedAt(v time.Time) Injuredsegment_UpdatedAt_Field {
-	return Injuredsegment_UpdatedAt_Field{_set: true, _value: v}
+func DurabilityHistogram_BucketName(v []byte) DurabilityHistogram_BucketName_Field {
+	return DurabilityHistogram_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_UpdatedAt_Field) value() interface{} {
+func (f DurabilityHistogram_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (DurabilityHistogram_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Injuredsegment_SegmentHealth_Field struct {
+type DurabilityHistogram_RequiredShares_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int
 }
 
-func Injuredsegment_SegmentHealth(v float64) Injuredsegment_SegmentHealth_Field {
-	return Injuredsegment_SegmentHealth_Field{_set: true, _value: v}
+func DurabilityHistogram_RequiredShares(v int) DurabilityHistogram_RequiredShares_Field {
+	return DurabilityHistogram_RequiredShares_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_SegmentHealth_Field) value() interface{} {
+func (f DurabilityHistogram_RequiredShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_SegmentHealth_Field) _Column() string { return "segment_health" }
+func (DurabilityHistogram_RequiredShares_Field) _Column() string { return "required_shares" }
 
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
+type DurabilityHistogram_RepairShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
+func DurabilityHistogram_RepairShares(v int) DurabilityHistogram_RepairShares_Field {
+	return DurabilityHistogram_RepairShares_Field{_set: true, _value: v}
+}
 
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+func (f DurabilityHistogram_RepairShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Irreparabledb_Segmentpath_Field struct {
+func (DurabilityHistogram_RepairShares_Field) _Column() string { return "repair_shares" }
+
+type DurabilityHistogram_OptimalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func DurabilityHistogram_OptimalShares(v int) DurabilityHistogram_OptimalShares_Field {
+	return DurabilityHistogram_OptimalShares_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f DurabilityHistogram_OptimalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (DurabilityHistogram_OptimalShares_Field) _Column() string { return "optimal_shares" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type DurabilityHistogram_TotalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func DurabilityHistogram_TotalShares(v int) DurabilityHistogram_TotalShares_Field {
+	return DurabilityHistogram_TotalShares_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func (f DurabilityHistogram_TotalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (DurabilityHistogram_TotalShares_Field) _Column() string { return "total_shares" }
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type DurabilityHistogram_HealthyCounts_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func DurabilityHistogram_HealthyCounts(v []byte) DurabilityHistogram_HealthyCounts_Field {
+	return DurabilityHistogram_HealthyCounts_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func (f DurabilityHistogram_HealthyCounts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (DurabilityHistogram_HealthyCounts_Field) _Column() string { return "healthy_counts" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type DurabilityHistogram_ComputedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func DurabilityHistogram_ComputedAt(v time.Time) DurabilityHistogram_ComputedAt_Field {
+	return DurabilityHistogram_ComputedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f DurabilityHistogram_ComputedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (DurabilityHistogram_ComputedAt_Field) _Column() string { return "computed_at" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
 
-type Node struct {
-	Id                          []byte
-	Address                     string
-	LastNet                     string
-	LastIpPort                  *string
-	Protocol                    int
-	Type                        int
-	Email                       string
-	Wallet                      string
-	WalletFeatures              string
-	FreeDisk                    int64
+type GracefulExitProgress_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitProgress_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitProgress_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GracefulExitTransferQueue struct {
+	NodeId              []byte
+	Path                []byte
+	PieceNum            int
+	RootPieceId         []byte
+	DurabilityRatio     float64
+	QueuedAt            time.Time
+	RequestedAt         *time.Time
+	LastFailedAt        *time.Time
+	LastFailedCode      *int
+	FailedCount         *int
+	FinishedAt          *time.Time
+	OrderLimitSendCount int
+}
+
+func (GracefulExitTransferQueue) _Table() string { return "graceful_exit_transfer_queue" }
+
+type GracefulExitTransferQueue_Create_Fields struct {
+	RootPieceId         GracefulExitTransferQueue_RootPieceId_Field
+	RequestedAt         GracefulExitTransferQueue_RequestedAt_Field
+	LastFailedAt        GracefulExitTransferQueue_LastFailedAt_Field
+	LastFailedCode      GracefulExitTransferQueue_LastFailedCode_Field
+	FailedCount         GracefulExitTransferQueue_FailedCount_Field
+	FinishedAt          GracefulExitTransferQueue_FinishedAt_Field
+	OrderLimitSendCount GracefulExitTransferQueue_OrderLimitSendCount_Field
+}
+
+type GracefulExitTransferQueue_Update_Fields struct {
+	DurabilityRatio     GracefulExitTransferQueue_DurabilityRatio_Field
+	RequestedAt         GracefulExitTransferQueue_RequestedAt_Field
+	LastFailedAt        GracefulExitTransferQueue_LastFailedAt_Field
+	LastFailedCode      GracefulExitTransferQueue_LastFailedCode_Field
+	FailedCount         GracefulExitTransferQueue_FailedCount_Field
+	FinishedAt          GracefulExitTransferQueue_FinishedAt_Field
+	OrderLimitSendCount GracefulExitTransferQueue_OrderLimitSendCount_Field
+}
+
+type GracefulExitTransferQueue_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitTransferQueue_NodeId(v []byte) GracefulExitTransferQueue_NodeId_Field {
+	return GracefulExitTransferQueue_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitTransferQueue_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitTransferQueue_Path(v []byte) GracefulExitTransferQueue_Path_Field {
+	return GracefulExitTransferQueue_Path_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_Path_Field) _Column() string { return "path" }
+
+type GracefulExitTransferQueue_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitTransferQueue_PieceNum(v int) GracefulExitTransferQueue_PieceNum_Field {
+	return GracefulExitTransferQueue_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitTransferQueue_RootPieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitTransferQueue_RootPieceId(v []byte) GracefulExitTransferQueue_RootPieceId_Field {
+	return GracefulExitTransferQueue_RootPieceId_Field{_set: true, _value: v}
+}
+
+func GracefulExitTransferQueue_RootPieceId_Raw(v []byte) GracefulExitTransferQueue_RootPieceId_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_RootPieceId_Null()
+	}
+	return GracefulExitTransferQueue_RootPieceId(v)
+}
+
+func GracefulExitTransferQueue_RootPieceId_Null() GracefulExitTransferQueue_RootPieceId_Field {
+	return GracefulExitTransferQueue_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_RootPieceId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_RootPieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_RootPieceId_Field) _Column() string { return "root_piece_id" }
+
+type GracefulExitTransferQueue_DurabilityRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func GracefulExitTransferQueue_DurabilityRatio(v float64) GracefulExitTransferQueue_DurabilityRatio_Field {
+	return GracefulExitTransferQueue_DurabilityRatio_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_DurabilityRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+
+type GracefulExitTransferQueue_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitTransferQueue_QueuedAt(v time.Time) GracefulExitTransferQueue_QueuedAt_Field {
+	return GracefulExitTransferQueue_QueuedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type GracefulExitTransferQueue_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitTransferQueue_RequestedAt(v time.Time) GracefulExitTransferQueue_RequestedAt_Field {
+	return GracefulExitTransferQueue_RequestedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_RequestedAt_Raw(v *time.Time) GracefulExitTransferQueue_RequestedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_RequestedAt_Null()
+	}
+	return GracefulExitTransferQueue_RequestedAt(*v)
+}
+
+func GracefulExitTransferQueue_RequestedAt_Null() GracefulExitTransferQueue_RequestedAt_Field {
+	return GracefulExitTransferQueue_RequestedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type GracefulExitTransferQueue_LastFailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitTransferQueue_LastFailedAt(v time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
+	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_LastFailedAt_Raw(v *time.Time) GracefulExitTransferQueue_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_LastFailedAt_Null()
+	}
+	return GracefulExitTransferQueue_LastFailedAt(*v)
+}
+
+func GracefulExitTransferQueue_LastFailedAt_Null() GracefulExitTransferQueue_LastFailedAt_Field {
+	return GracefulExitTransferQueue_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_LastFailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+
+type GracefulExitTransferQueue_LastFailedCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitTransferQueue_LastFailedCode(v int) GracefulExitTransferQueue_LastFailedCode_Field {
+	return GracefulExitTransferQueue_LastFailedCode_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_LastFailedCode_Raw(v *int) GracefulExitTransferQueue_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_LastFailedCode_Null()
+	}
+	return GracefulExitTransferQueue_LastFailedCode(*v)
+}
+
+func GracefulExitTransferQueue_LastFailedCode_Null() GracefulExitTransferQueue_LastFailedCode_Field {
+	return GracefulExitTransferQueue_LastFailedCode_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_LastFailedCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+
+type GracefulExitTransferQueue_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitTransferQueue_FailedCount(v int) GracefulExitTransferQueue_FailedCount_Field {
+	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_FailedCount_Raw(v *int) GracefulExitTransferQueue_FailedCount_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_FailedCount_Null()
+	}
+	return GracefulExitTransferQueue_FailedCount(*v)
+}
+
+func GracefulExitTransferQueue_FailedCount_Null() GracefulExitTransferQueue_FailedCount_Field {
+	return GracefulExitTransferQueue_FailedCount_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_FailedCount_Field) _Column() string { return "failed_count" }
+
+type GracefulExitTransferQueue_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitTransferQueue_FinishedAt(v time.Time) GracefulExitTransferQueue_FinishedAt_Field {
+	return GracefulExitTransferQueue_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitTransferQueue_FinishedAt_Raw(v *time.Time) GracefulExitTransferQueue_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitTransferQueue_FinishedAt_Null()
+	}
+	return GracefulExitTransferQueue_FinishedAt(*v)
+}
+
+func GracefulExitTransferQueue_FinishedAt_Null() GracefulExitTransferQueue_FinishedAt_Field {
+	return GracefulExitTransferQueue_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitTransferQueue_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitTransferQueue_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type GracefulExitTransferQueue_OrderLimitSendCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitTransferQueue_OrderLimitSendCount(v int) GracefulExitTransferQueue_OrderLimitSendCount_Field {
+	return GracefulExitTransferQueue_OrderLimitSendCount_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransferQueue_OrderLimitSendCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransferQueue_OrderLimitSendCount_Field) _Column() string {
+	return "order_limit_send_count"
+}
+
+type Injuredsegment struct {
+	Path          []byte
+	Data          []byte
+	Attempted     *time.Time
+	UpdatedAt     time.Time
+	SegmentHealth float64
+}
+
+func (Injuredsegment) _Table() string { return "injuredsegments" }
+
+type Injuredsegment_Create_Fields struct {
+	Attempted     Injuredsegment_Attempted_Field
+	UpdatedAt     Injuredsegment_UpdatedAt_Field
+	SegmentHealth Injuredsegment_SegmentHealth_Field
+}
+
+type Injuredsegment_Update_Fields struct {
+	Attempted Injuredsegment_Attempted_Field
+	UpdatedAt Injuredsegment_UpdatedAt_Field
+}
+
+type Injuredsegment_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Injuredsegment_Path(v []byte) Injuredsegment_Path_Field {
+	return Injuredsegment_Path_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Path_Field) _Column() string { return "path" }
+
+type Injuredsegment_Data_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
+	return Injuredsegment_Data_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Data_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Data_Field) _Column() string { return "data" }
+
+type Injuredsegment_Attempted_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Injuredsegment_Attempted(v time.Time) Injuredsegment_Attempted_Field {
+	return Injuredsegment_Attempted_Field{_set: true, _value: &v}
+}
+
+func Injuredsegment_Attempted_Raw(v *time.Time) Injuredsegment_Attempted_Field {
+	if v == nil {
+		return Injuredsegment_Attempted_Null()
+	}
+	return Injuredsegment_Attempted(*v)
+}
+
+func Injuredsegment_Attempted_Null() Injuredsegment_Attempted_Field {
+	return Injuredsegment_Attempted_Field{_set: true, _null: true}
+}
+
+func (f Injuredsegment_Attempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Injuredsegment_Attempted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Attempted_Field) _Column() string { return "attempted" }
+
+type Injuredsegment_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Injuredsegment_UpdatedAt(v time.Time) Injuredsegment_UpdatedAt_Field {
+	return Injuredsegment_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Injuredsegment_SegmentHealth_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Injuredsegment_SegmentHealth(v float64) Injuredsegment_SegmentHealth_Field {
+	return Injuredsegment_SegmentHealth_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_SegmentHealth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_SegmentHealth_Field) _Column() string { return "segment_health" }
+
+type Irreparabledb struct {
+	Segmentpath        []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+}
+
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
+
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+}
+
+type Irreparabledb_Segmentpath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+
+type Irreparabledb_Segmentdetail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+
+type Irreparabledb_PiecesLostCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+
+type Irreparabledb_SegDamagedUnixSec_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+
+type Irreparabledb_RepairAttemptCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+
+type Node struct {
+	Id                          []byte
+	Address                     string
+	LastNet                     string
+	LastIpPort                  *string
+	CountryCode                 *string
+	Protocol                    int
+	Type                        int
+	Email                       string
+	Wallet                      string
+	WalletFeatures              string
+	FreeDisk                    int64
 	PieceCount                  int64
 	Major                       int64
 	Minor                       int64
@@ -3618,4794 +4577,6106 @@ type Node struct {
 	ExitSuccess                 bool
 }
 
-func (Node) _Table() string { return "nodes" }
+func (Node) _Table() string { return "nodes" }
+
+type Node_Create_Fields struct {
+	Address                     Node_Address_Field
+	LastIpPort                  Node_LastIpPort_Field
+	CountryCode                 Node_CountryCode_Field
+	Protocol                    Node_Protocol_Field
+	Type                        Node_Type_Field
+	WalletFeatures              Node_WalletFeatures_Field
+	FreeDisk                    Node_FreeDisk_Field
+	Major                       Node_Major_Field
+	Minor                       Node_Minor_Field
+	Patch                       Node_Patch_Field
+	Hash                        Node_Hash_Field
+	Timestamp                   Node_Timestamp_Field
+	Release                     Node_Release_Field
+	Latency90                   Node_Latency90_Field
+	AuditSuccessCount           Node_AuditSuccessCount_Field
+	TotalAuditCount             Node_TotalAuditCount_Field
+	VettedAt                    Node_VettedAt_Field
+	LastContactSuccess          Node_LastContactSuccess_Field
+	LastContactFailure          Node_LastContactFailure_Field
+	Contained                   Node_Contained_Field
+	Disqualified                Node_Disqualified_Field
+	Suspended                   Node_Suspended_Field
+	UnknownAuditSuspended       Node_UnknownAuditSuspended_Field
+	OfflineSuspended            Node_OfflineSuspended_Field
+	UnderReview                 Node_UnderReview_Field
+	OnlineScore                 Node_OnlineScore_Field
+	AuditReputationAlpha        Node_AuditReputationAlpha_Field
+	AuditReputationBeta         Node_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Node_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Node_UnknownAuditReputationBeta_Field
+	ExitInitiatedAt             Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt         Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt              Node_ExitFinishedAt_Field
+	ExitSuccess                 Node_ExitSuccess_Field
+}
+
+type Node_Update_Fields struct {
+	Address                     Node_Address_Field
+	LastNet                     Node_LastNet_Field
+	LastIpPort                  Node_LastIpPort_Field
+	CountryCode                 Node_CountryCode_Field
+	Protocol                    Node_Protocol_Field
+	Type                        Node_Type_Field
+	Email                       Node_Email_Field
+	Wallet                      Node_Wallet_Field
+	WalletFeatures              Node_WalletFeatures_Field
+	FreeDisk                    Node_FreeDisk_Field
+	PieceCount                  Node_PieceCount_Field
+	Major                       Node_Major_Field
+	Minor                       Node_Minor_Field
+	Patch                       Node_Patch_Field
+	Hash                        Node_Hash_Field
+	Timestamp                   Node_Timestamp_Field
+	Release                     Node_Release_Field
+	Latency90                   Node_Latency90_Field
+	AuditSuccessCount           Node_AuditSuccessCount_Field
+	TotalAuditCount             Node_TotalAuditCount_Field
+	VettedAt                    Node_VettedAt_Field
+	LastContactSuccess          Node_LastContactSuccess_Field
+	LastContactFailure          Node_LastContactFailure_Field
+	Contained                   Node_Contained_Field
+	Disqualified                Node_Disqualified_Field
+	Suspended                   Node_Suspended_Field
+	UnknownAuditSuspended       Node_UnknownAuditSuspended_Field
+	OfflineSuspended            Node_OfflineSuspended_Field
+	UnderReview                 Node_UnderReview_Field
+	OnlineScore                 Node_OnlineScore_Field
+	AuditReputationAlpha        Node_AuditReputationAlpha_Field
+	AuditReputationBeta         Node_AuditReputationBeta_Field
+	UnknownAuditReputationAlpha Node_UnknownAuditReputationAlpha_Field
+	UnknownAuditReputationBeta  Node_UnknownAuditReputationBeta_Field
+	ExitInitiatedAt             Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt         Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt              Node_ExitFinishedAt_Field
+	ExitSuccess                 Node_ExitSuccess_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
+}
+
+func (f Node_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Address_Field) _Column() string { return "address" }
+
+type Node_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
+}
+
+func (f Node_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastNet_Field) _Column() string { return "last_net" }
+
+type Node_LastIpPort_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_LastIpPort(v string) Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _value: &v}
+}
+
+func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
+	if v == nil {
+		return Node_LastIpPort_Null()
+	}
+	return Node_LastIpPort(*v)
+}
+
+func Node_LastIpPort_Null() Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _null: true}
+}
+
+func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastIpPort_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+
+type Node_CountryCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_CountryCode(v string) Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _value: &v}
+}
+
+func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
+	if v == nil {
+		return Node_CountryCode_Null()
+	}
+	return Node_CountryCode(*v)
+}
+
+func Node_CountryCode_Null() Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _null: true}
+}
+
+func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_CountryCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CountryCode_Field) _Column() string { return "country_code" }
+
+type Node_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocol_Field) _Column() string { return "protocol" }
+
+type Node_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
+}
+
+func (f Node_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Type_Field) _Column() string { return "type" }
+
+type Node_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
+}
+
+func (f Node_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Email_Field) _Column() string { return "email" }
+
+type Node_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
+}
+
+func (f Node_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_WalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
+	return Node_WalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f Node_WalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+
+type Node_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type Node_PieceCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_PieceCount(v int64) Node_PieceCount_Field {
+	return Node_PieceCount_Field{_set: true, _value: v}
+}
+
+func (f Node_PieceCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+
+type Node_Major_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
+}
+
+func (f Node_Major_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Major_Field) _Column() string { return "major" }
+
+type Node_Minor_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
+}
+
+func (f Node_Minor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Minor_Field) _Column() string { return "minor" }
+
+type Node_Patch_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
+}
+
+func (f Node_Patch_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Patch_Field) _Column() string { return "patch" }
+
+type Node_Hash_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
+}
+
+func (f Node_Hash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Hash_Field) _Column() string { return "hash" }
+
+type Node_Timestamp_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
+}
+
+func (f Node_Timestamp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+
+type Node_Release_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
+}
+
+func (f Node_Release_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Release_Field) _Column() string { return "release" }
+
+type Node_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
+}
+
+func (f Node_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
+
+type Node_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
+	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Node_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
+	return Node_TotalAuditCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+
+type Node_VettedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_VettedAt(v time.Time) Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _value: &v}
+}
+
+func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
+	if v == nil {
+		return Node_VettedAt_Null()
+	}
+	return Node_VettedAt(*v)
+}
+
+func Node_VettedAt_Null() Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_VettedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node_LastContactSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactFailure_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+
+type Node_Contained_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Contained(v bool) Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _value: v}
+}
+
+func (f Node_Contained_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Contained_Field) _Column() string { return "contained" }
+
+type Node_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
+	}
+	return Node_Disqualified(*v)
+}
+
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+
+type Node_Suspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Suspended(v time.Time) Node_Suspended_Field {
+	return Node_Suspended_Field{_set: true, _value: &v}
+}
+
+func Node_Suspended_Raw(v *time.Time) Node_Suspended_Field {
+	if v == nil {
+		return Node_Suspended_Null()
+	}
+	return Node_Suspended(*v)
+}
+
+func Node_Suspended_Null() Node_Suspended_Field {
+	return Node_Suspended_Field{_set: true, _null: true}
+}
+
+func (f Node_Suspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Suspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Suspended_Field) _Column() string { return "suspended" }
+
+type Node_UnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Node_UnknownAuditSuspended_Null()
+	}
+	return Node_UnknownAuditSuspended(*v)
+}
+
+func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+
+type Node_OfflineSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
+	if v == nil {
+		return Node_OfflineSuspended_Null()
+	}
+	return Node_OfflineSuspended(*v)
+}
+
+func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_OfflineSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+
+type Node_UnderReview_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnderReview(v time.Time) Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _value: &v}
+}
+
+func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
+	if v == nil {
+		return Node_UnderReview_Null()
+	}
+	return Node_UnderReview(*v)
+}
+
+func Node_UnderReview_Null() Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnderReview_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnderReview_Field) _Column() string { return "under_review" }
+
+type Node_OnlineScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_OnlineScore(v float64) Node_OnlineScore_Field {
+	return Node_OnlineScore_Field{_set: true, _value: v}
+}
+
+func (f Node_OnlineScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_OnlineScore_Field) _Column() string { return "online_score" }
+
+type Node_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+
+type Node_AuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+
+type Node_UnknownAuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UnknownAuditReputationAlpha(v float64) Node_UnknownAuditReputationAlpha_Field {
+	return Node_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_UnknownAuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
+}
+
+type Node_UnknownAuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UnknownAuditReputationBeta(v float64) Node_UnknownAuditReputationBeta_Field {
+	return Node_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_UnknownAuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditReputationBeta_Field) _Column() string { return "unknown_audit_reputation_beta" }
+
+type Node_ExitInitiatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
+	if v == nil {
+		return Node_ExitInitiatedAt_Null()
+	}
+	return Node_ExitInitiatedAt(*v)
+}
+
+func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitInitiatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+
+type Node_ExitLoopCompletedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+}
 
-type Node_Create_Fields struct {
-	Address                     Node_Address_Field
-	LastIpPort                  Node_LastIpPort_Field
-	Protocol                    Node_Protocol_Field
-	Type                        Node_Type_Field
-	WalletFeatures              Node_WalletFeatures_Field
-	FreeDisk                    Node_FreeDisk_Field
-	Major                       Node_Major_Field
-	Minor                       Node_Minor_Field
-	Patch                       Node_Patch_Field
-	Hash                        Node_Hash_Field
-	Timestamp                   Node_Timestamp_Field
-	Release                     Node_Release_Field
-	Latency90                   Node_Latency90_Field
-	AuditSuccessCount           Node_AuditSuccessCount_Field
-	TotalAuditCount             Node_TotalAuditCount_Field
-	VettedAt                    Node_VettedAt_Field
-	LastContactSuccess          Node_LastContactSuccess_Field
-	LastContactFailure          Node_LastContactFailure_Field
-	Contained                   Node_Contained_Field
-	Disqualified                Node_Disqualified_Field
-	Suspended                   Node_Suspended_Field
-	UnknownAuditSuspended       Node_UnknownAuditSuspended_Field
-	OfflineSuspended            Node_OfflineSuspended_Field
-	UnderReview                 Node_UnderReview_Field
-	OnlineScore                 Node_OnlineScore_Field
-	AuditReputationAlpha        Node_AuditReputationAlpha_Field
-	AuditReputationBeta         Node_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Node_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Node_UnknownAuditReputationBeta_Field
-	ExitInitiatedAt             Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt         Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt              Node_ExitFinishedAt_Field
-	ExitSuccess                 Node_ExitSuccess_Field
+func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+	if v == nil {
+		return Node_ExitLoopCompletedAt_Null()
+	}
+	return Node_ExitLoopCompletedAt(*v)
 }
 
-type Node_Update_Fields struct {
-	Address                     Node_Address_Field
-	LastNet                     Node_LastNet_Field
-	LastIpPort                  Node_LastIpPort_Field
-	Protocol                    Node_Protocol_Field
-	Type                        Node_Type_Field
-	Email                       Node_Email_Field
-	Wallet                      Node_Wallet_Field
-	WalletFeatures              Node_WalletFeatures_Field
-	FreeDisk                    Node_FreeDisk_Field
-	PieceCount                  Node_PieceCount_Field
-	Major                       Node_Major_Field
-	Minor                       Node_Minor_Field
-	Patch                       Node_Patch_Field
-	Hash                        Node_Hash_Field
-	Timestamp                   Node_Timestamp_Field
-	Release                     Node_Release_Field
-	Latency90                   Node_Latency90_Field
-	AuditSuccessCount           Node_AuditSuccessCount_Field
-	TotalAuditCount             Node_TotalAuditCount_Field
-	VettedAt                    Node_VettedAt_Field
-	LastContactSuccess          Node_LastContactSuccess_Field
-	LastContactFailure          Node_LastContactFailure_Field
-	Contained                   Node_Contained_Field
-	Disqualified                Node_Disqualified_Field
-	Suspended                   Node_Suspended_Field
-	UnknownAuditSuspended       Node_UnknownAuditSuspended_Field
-	OfflineSuspended            Node_OfflineSuspended_Field
-	UnderReview                 Node_UnderReview_Field
-	OnlineScore                 Node_OnlineScore_Field
-	AuditReputationAlpha        Node_AuditReputationAlpha_Field
-	AuditReputationBeta         Node_AuditReputationBeta_Field
-	UnknownAuditReputationAlpha Node_UnknownAuditReputationAlpha_Field
-	UnknownAuditReputationBeta  Node_UnknownAuditReputationBeta_Field
-	ExitInitiatedAt             Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt         Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt              Node_ExitFinishedAt_Field
-	ExitSuccess                 Node_ExitSuccess_Field
+func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+
+type Node_ExitFinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+	if v == nil {
+		return Node_ExitFinishedAt_Null()
+	}
+	return Node_ExitFinishedAt(*v)
+}
+
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitFinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+
+type Node_ExitSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_ExitSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+
+type NodeApiVersion struct {
+	Id         []byte
+	ApiVersion int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (NodeApiVersion) _Table() string { return "node_api_versions" }
+
+type NodeApiVersion_Update_Fields struct {
+	ApiVersion NodeApiVersion_ApiVersion_Field
+}
+
+type NodeApiVersion_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
+	return NodeApiVersion_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+
+type NodeApiVersion_ApiVersion_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
+	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+
+type NodeApiVersion_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
+	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeApiVersion_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
+	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Offer struct {
+	Id                        int
+	Name                      string
+	Description               string
+	AwardCreditInCents        int
+	InviteeCreditInCents      int
+	AwardCreditDurationDays   *int
+	InviteeCreditDurationDays *int
+	RedeemableCap             *int
+	ExpiresAt                 time.Time
+	CreatedAt                 time.Time
+	Status                    int
+	Type                      int
+}
+
+func (Offer) _Table() string { return "offers" }
+
+type Offer_Create_Fields struct {
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
 }
 
-type Node_Id_Field struct {
+type Offer_Update_Fields struct {
+	Name                      Offer_Name_Field
+	Description               Offer_Description_Field
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
+	ExpiresAt                 Offer_ExpiresAt_Field
+	Status                    Offer_Status_Field
+	Type                      Offer_Type_Field
+}
+
+type Offer_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func Offer_Id(v int) Offer_Id_Field {
+	return Offer_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f Offer_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (Offer_Id_Field) _Column() string { return "id" }
 
-type Node_Address_Field struct {
+type Offer_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func Offer_Name(v string) Offer_Name_Field {
+	return Offer_Name_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f Offer_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (Offer_Name_Field) _Column() string { return "name" }
 
-type Node_LastNet_Field struct {
+type Offer_Description_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func Offer_Description(v string) Offer_Description_Field {
+	return Offer_Description_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f Offer_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (Offer_Description_Field) _Column() string { return "description" }
 
-type Node_LastIpPort_Field struct {
+type Offer_AwardCreditInCents_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func Node_LastIpPort(v string) Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _value: &v}
-}
-
-func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
-	if v == nil {
-		return Node_LastIpPort_Null()
-	}
-	return Node_LastIpPort(*v)
+	_value int
 }
 
-func Node_LastIpPort_Null() Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _null: true}
+func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
+	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
 }
 
-func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_LastIpPort_Field) value() interface{} {
+func (f Offer_AwardCreditInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
 
-type Node_Protocol_Field struct {
+type Offer_InviteeCreditInCents_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
+	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f Offer_InviteeCreditInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
 
-type Node_Type_Field struct {
+type Offer_AwardCreditDurationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
+	if v == nil {
+		return Offer_AwardCreditDurationDays_Null()
+	}
+	return Offer_AwardCreditDurationDays(*v)
+}
+
+func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
+}
+
+func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
 
-type Node_Email_Field struct {
+type Offer_InviteeCreditDurationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
+	if v == nil {
+		return Offer_InviteeCreditDurationDays_Null()
+	}
+	return Offer_InviteeCreditDurationDays(*v)
+}
+
+func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
+}
+
+func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
 
-type Node_Wallet_Field struct {
+type Offer_RedeemableCap_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _value: &v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
+	if v == nil {
+		return Offer_RedeemableCap_Null()
+	}
+	return Offer_RedeemableCap(*v)
+}
+
+func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _null: true}
+}
+
+func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Offer_RedeemableCap_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
 
-type Node_WalletFeatures_Field struct {
+type Offer_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
-	return Node_WalletFeatures_Field{_set: true, _value: v}
+func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_WalletFeatures_Field) value() interface{} {
+func (f Offer_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_FreeDisk_Field struct {
+type Offer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
+	return Offer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f Offer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_PieceCount_Field struct {
+type Offer_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func Node_PieceCount(v int64) Node_PieceCount_Field {
-	return Node_PieceCount_Field{_set: true, _value: v}
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
 }
 
-func (f Node_PieceCount_Field) value() interface{} {
+func (f Offer_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+func (Offer_Status_Field) _Column() string { return "status" }
 
-type Node_Major_Field struct {
+type Offer_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func (f Offer_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (Offer_Type_Field) _Column() string { return "type" }
 
-type Node_Minor_Field struct {
+type PeerIdentity struct {
+	NodeId           []byte
+	LeafSerialNumber []byte
+	Chain            []byte
+	UpdatedAt        time.Time
+}
+
+func (PeerIdentity) _Table() string { return "peer_identities" }
+
+type PeerIdentity_Update_Fields struct {
+	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
+	Chain            PeerIdentity_Chain_Field
+}
+
+type PeerIdentity_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
+	return PeerIdentity_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
+func (f PeerIdentity_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
+func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Patch_Field struct {
+type PeerIdentity_LeafSerialNumber_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
+func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
+	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
 }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
 
-type Node_Hash_Field struct {
+type PeerIdentity_Chain_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
+func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
+	return PeerIdentity_Chain_Field{_set: true, _value: v}
 }
 
-func (f Node_Hash_Field) value() interface{} {
+func (f PeerIdentity_Chain_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
+func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
 
-type Node_Timestamp_Field struct {
+type PeerIdentity_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
+func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
+	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Node_Release_Field struct {
+type PendingAudits struct {
+	NodeId            []byte
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+	Path              []byte
+}
+
+func (PendingAudits) _Table() string { return "pending_audits" }
+
+type PendingAudits_Update_Fields struct {
+	ReverifyCount PendingAudits_ReverifyCount_Field
+}
+
+type PendingAudits_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value []byte
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
+func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
+	return PendingAudits_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_Release_Field) value() interface{} {
+func (f PendingAudits_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
 
-type Node_Latency90_Field struct {
+type PendingAudits_PieceId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
+	return PendingAudits_PieceId_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f PendingAudits_PieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
 
-type Node_AuditSuccessCount_Field struct {
+type PendingAudits_StripeIndex_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
-	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
+	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessCount_Field) value() interface{} {
+func (f PendingAudits_StripeIndex_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
 
-type Node_TotalAuditCount_Field struct {
+type PendingAudits_ShareSize_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
-	return Node_TotalAuditCount_Field{_set: true, _value: v}
+func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
+	return PendingAudits_ShareSize_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalAuditCount_Field) value() interface{} {
+func (f PendingAudits_ShareSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
 
-type Node_VettedAt_Field struct {
+type PendingAudits_ExpectedShareHash_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_VettedAt(v time.Time) Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _value: &v}
-}
-
-func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
-	if v == nil {
-		return Node_VettedAt_Null()
-	}
-	return Node_VettedAt(*v)
+	_value []byte
 }
 
-func Node_VettedAt_Null() Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _null: true}
+func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
+	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
 }
 
-func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_VettedAt_Field) value() interface{} {
+func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
 
-type Node_CreatedAt_Field struct {
+type PendingAudits_ReverifyCount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
+	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f PendingAudits_ReverifyCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-type Node_UpdatedAt_Field struct {
+type PendingAudits_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func PendingAudits_Path(v []byte) PendingAudits_Path_Field {
+	return PendingAudits_Path_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f PendingAudits_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (PendingAudits_Path_Field) _Column() string { return "path" }
 
-type Node_LastContactSuccess_Field struct {
+type Project struct {
+	Id             []byte
+	Name           string
+	Description    string
+	UsageLimit     *int64
+	BandwidthLimit *int64
+	RateLimit      *int
+	MaxBuckets     *int
+	PartnerId      []byte
+	OwnerId        []byte
+	CreatedAt      time.Time
+}
+
+func (Project) _Table() string { return "projects" }
+
+type Project_Create_Fields struct {
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	RateLimit      Project_RateLimit_Field
+	MaxBuckets     Project_MaxBuckets_Field
+	PartnerId      Project_PartnerId_Field
+}
+
+type Project_Update_Fields struct {
+	Name           Project_Name_Field
+	Description    Project_Description_Field
+	UsageLimit     Project_UsageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+	RateLimit      Project_RateLimit_Field
+	MaxBuckets     Project_MaxBuckets_Field
+}
+
+type Project_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f Project_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (Project_Id_Field) _Column() string { return "id" }
 
-type Node_LastContactFailure_Field struct {
+type Project_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f Project_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (Project_Name_Field) _Column() string { return "name" }
 
-type Node_Contained_Field struct {
+type Project_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value string
 }
 
-func Node_Contained(v bool) Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _value: v}
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
 }
 
-func (f Node_Contained_Field) value() interface{} {
+func (f Project_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Contained_Field) _Column() string { return "contained" }
+func (Project_Description_Field) _Column() string { return "description" }
 
-type Node_Disqualified_Field struct {
+type Project_UsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func Node_Disqualified(v time.Time) Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _value: &v}
+func Project_UsageLimit(v int64) Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _value: &v}
 }
 
-func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
 	if v == nil {
-		return Node_Disqualified_Null()
+		return Project_UsageLimit_Null()
 	}
-	return Node_Disqualified(*v)
+	return Project_UsageLimit(*v)
 }
 
-func Node_Disqualified_Null() Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _null: true}
+func Project_UsageLimit_Null() Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _null: true}
 }
 
-func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_Disqualified_Field) value() interface{} {
+func (f Project_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type Node_Suspended_Field struct {
+type Project_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func Node_Suspended(v time.Time) Node_Suspended_Field {
-	return Node_Suspended_Field{_set: true, _value: &v}
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func Node_Suspended_Raw(v *time.Time) Node_Suspended_Field {
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
 	if v == nil {
-		return Node_Suspended_Null()
+		return Project_BandwidthLimit_Null()
 	}
-	return Node_Suspended(*v)
+	return Project_BandwidthLimit(*v)
 }
 
-func Node_Suspended_Null() Node_Suspended_Field {
-	return Node_Suspended_Field{_set: true, _null: true}
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func (f Node_Suspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_Suspended_Field) value() interface{} {
+func (f Project_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Suspended_Field) _Column() string { return "suspended" }
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type Node_UnknownAuditSuspended_Field struct {
+type Project_RateLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+func Project_RateLimit(v int) Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _value: &v}
 }
 
-func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
+func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
 	if v == nil {
-		return Node_UnknownAuditSuspended_Null()
+		return Project_RateLimit_Null()
 	}
-	return Node_UnknownAuditSuspended(*v)
+	return Project_RateLimit(*v)
 }
 
-func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+func Project_RateLimit_Null() Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _null: true}
 }
 
-func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+func (f Project_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type Node_OfflineSuspended_Field struct {
+type Project_MaxBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _value: &v}
+func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _value: &v}
 }
 
-func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
+func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
 	if v == nil {
-		return Node_OfflineSuspended_Null()
+		return Project_MaxBuckets_Null()
 	}
-	return Node_OfflineSuspended(*v)
+	return Project_MaxBuckets(*v)
 }
 
-func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _null: true}
+func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _null: true}
 }
 
-func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_OfflineSuspended_Field) value() interface{} {
+func (f Project_MaxBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
 
-type Node_UnderReview_Field struct {
+type Project_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Node_UnderReview(v time.Time) Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _value: &v}
+func Project_PartnerId(v []byte) Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _value: v}
 }
 
-func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
+func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
 	if v == nil {
-		return Node_UnderReview_Null()
+		return Project_PartnerId_Null()
 	}
-	return Node_UnderReview(*v)
+	return Project_PartnerId(v)
 }
 
-func Node_UnderReview_Null() Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _null: true}
+func Project_PartnerId_Null() Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _null: true}
 }
 
-func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_UnderReview_Field) value() interface{} {
+func (f Project_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnderReview_Field) _Column() string { return "under_review" }
+func (Project_PartnerId_Field) _Column() string { return "partner_id" }
 
-type Node_OnlineScore_Field struct {
+type Project_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Node_OnlineScore(v float64) Node_OnlineScore_Field {
-	return Node_OnlineScore_Field{_set: true, _value: v}
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f Node_OnlineScore_Field) value() interface{} {
+func (f Project_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_OnlineScore_Field) _Column() string { return "online_score" }
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Node_AuditReputationAlpha_Field struct {
+type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
-	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditReputationAlpha_Field) value() interface{} {
+func (f Project_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_AuditReputationBeta_Field struct {
+type ProjectBandwidthRollup struct {
+	ProjectId       []byte
+	IntervalMonth   time.Time
+	EgressAllocated uint64
+}
+
+func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
+
+type ProjectBandwidthRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
+}
+
+type ProjectBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
-	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
+	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditReputationBeta_Field) value() interface{} {
+func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type Node_UnknownAuditReputationAlpha_Field struct {
+type ProjectBandwidthRollup_IntervalMonth_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func Node_UnknownAuditReputationAlpha(v float64) Node_UnknownAuditReputationAlpha_Field {
-	return Node_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
+	v = toDate(v)
+	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
 }
 
-func (f Node_UnknownAuditReputationAlpha_Field) value() interface{} {
+func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditReputationAlpha_Field) _Column() string {
-	return "unknown_audit_reputation_alpha"
-}
+func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
 
-type Node_UnknownAuditReputationBeta_Field struct {
+type ProjectBandwidthRollup_EgressAllocated_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value uint64
 }
 
-func Node_UnknownAuditReputationBeta(v float64) Node_UnknownAuditReputationBeta_Field {
-	return Node_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
+	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (f Node_UnknownAuditReputationBeta_Field) value() interface{} {
+func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditReputationBeta_Field) _Column() string { return "unknown_audit_reputation_beta" }
+func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
 
-type Node_ExitInitiatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type RegistrationToken struct {
+	Secret       []byte
+	OwnerId      []byte
+	ProjectLimit int
+	CreatedAt    time.Time
 }
 
-func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+func (RegistrationToken) _Table() string { return "registration_tokens" }
+
+type RegistrationToken_Create_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
 }
 
-func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
-	if v == nil {
-		return Node_ExitInitiatedAt_Null()
-	}
-	return Node_ExitInitiatedAt(*v)
+type RegistrationToken_Update_Fields struct {
+	OwnerId RegistrationToken_OwnerId_Field
 }
 
-func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+type RegistrationToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
+	return RegistrationToken_Secret_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitInitiatedAt_Field) value() interface{} {
+func (f RegistrationToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
 
-type Node_ExitLoopCompletedAt_Field struct {
+type RegistrationToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
 	if v == nil {
-		return Node_ExitLoopCompletedAt_Null()
+		return RegistrationToken_OwnerId_Null()
 	}
-	return Node_ExitLoopCompletedAt(*v)
+	return RegistrationToken_OwnerId(v)
 }
 
-func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
+	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+func (f RegistrationToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Node_ExitFinishedAt_Field struct {
+type RegistrationToken_ProjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
+	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
 }
 
-func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
-	if v == nil {
-		return Node_ExitFinishedAt_Null()
+func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Node_ExitFinishedAt(*v)
+	return f._value
 }
 
-func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+
+type RegistrationToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
+	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Node_ExitFinishedAt_Field) value() interface{} {
+func (f RegistrationToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_ExitSuccess_Field struct {
+type ReportedLostPiece struct {
+	NodeId     []byte
+	PieceId    []byte
+	ReportedAt time.Time
+}
+
+func (ReportedLostPiece) _Table() string { return "reported_lost_pieces" }
+
+type ReportedLostPiece_Update_Fields struct {
+}
+
+type ReportedLostPiece_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value []byte
 }
 
-func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
-	return Node_ExitSuccess_Field{_set: true, _value: v}
+func ReportedLostPiece_NodeId(v []byte) ReportedLostPiece_NodeId_Field {
+	return ReportedLostPiece_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_ExitSuccess_Field) value() interface{} {
+func (f ReportedLostPiece_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+func (ReportedLostPiece_NodeId_Field) _Column() string { return "node_id" }
 
-type NodeApiVersion struct {
-	Id         []byte
-	ApiVersion int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+type ReportedLostPiece_PieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (NodeApiVersion) _Table() string { return "node_api_versions" }
+func ReportedLostPiece_PieceId(v []byte) ReportedLostPiece_PieceId_Field {
+	return ReportedLostPiece_PieceId_Field{_set: true, _value: v}
+}
 
-type NodeApiVersion_Update_Fields struct {
-	ApiVersion NodeApiVersion_ApiVersion_Field
+func (f ReportedLostPiece_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type NodeApiVersion_Id_Field struct {
+func (ReportedLostPiece_PieceId_Field) _Column() string { return "piece_id" }
+
+type ReportedLostPiece_ReportedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
-	return NodeApiVersion_Id_Field{_set: true, _value: v}
+func ReportedLostPiece_ReportedAt(v time.Time) ReportedLostPiece_ReportedAt_Field {
+	return ReportedLostPiece_ReportedAt_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_Id_Field) value() interface{} {
+func (f ReportedLostPiece_ReportedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+func (ReportedLostPiece_ReportedAt_Field) _Column() string { return "reported_at" }
 
-type NodeApiVersion_ApiVersion_Field struct {
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
+}
+
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
+}
+
+type ResetPasswordToken_Secret_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
-	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
 
-type NodeApiVersion_CreatedAt_Field struct {
+type ResetPasswordToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
-	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
 
-type NodeApiVersion_UpdatedAt_Field struct {
+type ResetPasswordToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
-	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Offer struct {
-	Id                        int
-	Name                      string
-	Description               string
-	AwardCreditInCents        int
-	InviteeCreditInCents      int
-	AwardCreditDurationDays   *int
-	InviteeCreditDurationDays *int
-	RedeemableCap             *int
-	ExpiresAt                 time.Time
-	CreatedAt                 time.Time
-	Status                    int
-	Type                      int
+type Revocation struct {
+	Revoked  []byte
+	ApiKeyId []byte
 }
 
-func (Offer) _Table() string { return "offers" }
-
-type Offer_Create_Fields struct {
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-}
+func (Revocation) _Table() string { return "revocations" }
 
-type Offer_Update_Fields struct {
-	Name                      Offer_Name_Field
-	Description               Offer_Description_Field
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-	ExpiresAt                 Offer_ExpiresAt_Field
-	Status                    Offer_Status_Field
-	Type                      Offer_Type_Field
+type Revocation_Update_Fields struct {
 }
 
-type Offer_Id_Field struct {
+type Revocation_Revoked_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_Id(v int) Offer_Id_Field {
-	return Offer_Id_Field{_set: true, _value: v}
+func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
+	return Revocation_Revoked_Field{_set: true, _value: v}
 }
 
-func (f Offer_Id_Field) value() interface{} {
+func (f Revocation_Revoked_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Id_Field) _Column() string { return "id" }
+func (Revocation_Revoked_Field) _Column() string { return "revoked" }
 
-type Offer_Name_Field struct {
+type Revocation_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Offer_Name(v string) Offer_Name_Field {
-	return Offer_Name_Field{_set: true, _value: v}
+func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
+	return Revocation_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f Offer_Name_Field) value() interface{} {
+func (f Revocation_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Name_Field) _Column() string { return "name" }
+func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type Offer_Description_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type StoragenodeBandwidthRollup struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-func Offer_Description(v string) Offer_Description_Field {
-	return Offer_Description_Field{_set: true, _value: v}
-}
+func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
 
-func (f Offer_Description_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StoragenodeBandwidthRollup_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
 }
 
-func (Offer_Description_Field) _Column() string { return "description" }
+type StoragenodeBandwidthRollup_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+	Settled   StoragenodeBandwidthRollup_Settled_Field
+}
 
-type Offer_AwardCreditInCents_Field struct {
+type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
-	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
+	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f Offer_AwardCreditInCents_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
+func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type Offer_InviteeCreditInCents_Field struct {
+type StoragenodeBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
-	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
+	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditInCents_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
+func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Offer_AwardCreditDurationDays_Field struct {
+type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value uint
 }
 
-func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
+func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
-	if v == nil {
-		return Offer_AwardCreditDurationDays_Null()
+func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_AwardCreditDurationDays(*v)
+	return f._value
 }
 
-func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
+func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+
+type StoragenodeBandwidthRollup_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
 }
 
-func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
+	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type Offer_InviteeCreditDurationDays_Field struct {
+type StoragenodeBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *uint64
 }
 
-func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
+func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
 }
 
-func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
+func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
 	if v == nil {
-		return Offer_InviteeCreditDurationDays_Null()
+		return StoragenodeBandwidthRollup_Allocated_Null()
 	}
-	return Offer_InviteeCreditDurationDays(*v)
+	return StoragenodeBandwidthRollup_Allocated(*v)
 }
 
-func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
+func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
+func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type Offer_RedeemableCap_Field struct {
+type StoragenodeBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value uint64
 }
 
-func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _value: &v}
+func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
+	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
-	if v == nil {
-		return Offer_RedeemableCap_Null()
+func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_RedeemableCap(*v)
+	return f._value
 }
 
-func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _null: true}
-}
+func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+type StoragenodeBandwidthRollupArchive struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
+}
 
-func (f Offer_RedeemableCap_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+func (StoragenodeBandwidthRollupArchive) _Table() string {
+	return "storagenode_bandwidth_rollup_archives"
 }
 
-func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+type StoragenodeBandwidthRollupArchive_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+}
 
-type Offer_ExpiresAt_Field struct {
+type StoragenodeBandwidthRollupArchive_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
+}
+
+type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f Offer_ExpiresAt_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
+	return "storagenode_id"
+}
 
-type Offer_CreatedAt_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
-	return Offer_CreatedAt_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreatedAt_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
+	return "interval_start"
+}
 
-type Offer_Status_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
+func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
+}
 
-type Offer_Type_Field struct {
+type StoragenodeBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
+	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Type_Field) _Column() string { return "type" }
+func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type PeerIdentity struct {
-	NodeId           []byte
-	LeafSerialNumber []byte
-	Chain            []byte
-	UpdatedAt        time.Time
+type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
+	_set   bool
+	_null  bool
+	_value *uint64
 }
 
-func (PeerIdentity) _Table() string { return "peer_identities" }
+func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
+}
 
-type PeerIdentity_Update_Fields struct {
-	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
-	Chain            PeerIdentity_Chain_Field
+func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollupArchive_Allocated_Null()
+	}
+	return StoragenodeBandwidthRollupArchive_Allocated(*v)
 }
 
-type PeerIdentity_NodeId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
 }
 
-func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
-	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f PeerIdentity_NodeId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type PeerIdentity_LeafSerialNumber_Field struct {
+type StoragenodeBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
-	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
+	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type PeerIdentity_Chain_Field struct {
+type StoragenodeBandwidthRollupPhase2 struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
+}
+
+func (StoragenodeBandwidthRollupPhase2) _Table() string {
+	return "storagenode_bandwidth_rollups_phase2"
+}
+
+type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+}
+
+type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
+}
+
+type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
-	return PeerIdentity_Chain_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_Chain_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type PeerIdentity_UpdatedAt_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
-	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type PendingAudits struct {
-	NodeId            []byte
-	PieceId           []byte
-	StripeIndex       int64
-	ShareSize         int64
-	ExpectedShareHash []byte
-	ReverifyCount     int64
-	Path              []byte
-}
-
-func (PendingAudits) _Table() string { return "pending_audits" }
-
-type PendingAudits_Update_Fields struct {
-	ReverifyCount PendingAudits_ReverifyCount_Field
-}
+func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type PendingAudits_NodeId_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
-	return PendingAudits_NodeId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_NodeId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
+}
 
-type PendingAudits_PieceId_Field struct {
+type StoragenodeBandwidthRollupPhase2_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
-	return PendingAudits_PieceId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
+	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_PieceId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
 
-type PendingAudits_StripeIndex_Field struct {
+type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *uint64
 }
 
-func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
-	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f PendingAudits_StripeIndex_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
 	}
-	return f._value
+	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
 }
 
-func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
-
-type PendingAudits_ShareSize_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
 }
 
-func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
-	return PendingAudits_ShareSize_Field{_set: true, _value: v}
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f PendingAudits_ShareSize_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
 
-type PendingAudits_ExpectedShareHash_Field struct {
+type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
-	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
+	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
+
+type StoragenodePayment struct {
+	Id        int64
+	CreatedAt time.Time
+	NodeId    []byte
+	Period    string
+	Amount    int64
+	Receipt   *string
+	Notes     *string
+}
+
+func (StoragenodePayment) _Table() string { return "storagenode_payments" }
+
+type StoragenodePayment_Create_Fields struct {
+	Receipt StoragenodePayment_Receipt_Field
+	Notes   StoragenodePayment_Notes_Field
+}
+
+type StoragenodePayment_Update_Fields struct {
+}
 
-type PendingAudits_ReverifyCount_Field struct {
+type StoragenodePayment_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
-	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
+func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
+	return StoragenodePayment_Id_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_ReverifyCount_Field) value() interface{} {
+func (f StoragenodePayment_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (StoragenodePayment_Id_Field) _Column() string { return "id" }
 
-type PendingAudits_Path_Field struct {
+type StoragenodePayment_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func PendingAudits_Path(v []byte) PendingAudits_Path_Field {
-	return PendingAudits_Path_Field{_set: true, _value: v}
+func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
+	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f PendingAudits_Path_Field) value() interface{} {
+func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PendingAudits_Path_Field) _Column() string { return "path" }
-
-type Project struct {
-	Id             []byte
-	Name           string
-	Description    string
-	UsageLimit     *int64
-	BandwidthLimit *int64
-	RateLimit      *int
-	MaxBuckets     *int
-	PartnerId      []byte
-	OwnerId        []byte
-	CreatedAt      time.Time
-}
-
-func (Project) _Table() string { return "projects" }
-
-type Project_Create_Fields struct {
-	UsageLimit     Project_UsageLimit_Field
-	BandwidthLimit Project_BandwidthLimit_Field
-	RateLimit      Project_RateLimit_Field
-	MaxBuckets     Project_MaxBuckets_Field
-	PartnerId      Project_PartnerId_Field
-}
-
-type Project_Update_Fields struct {
-	Name           Project_Name_Field
-	Description    Project_Description_Field
-	UsageLimit     Project_UsageLimit_Field
-	BandwidthLimit Project_BandwidthLimit_Field
-	RateLimit      Project_RateLimit_Field
-	MaxBuckets     Project_MaxBuckets_Field
-}
+func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_Id_Field struct {
+type StoragenodePayment_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
+	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f StoragenodePayment_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
 
-type Project_Name_Field struct {
+type StoragenodePayment_Period_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
+	return StoragenodePayment_Period_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f StoragenodePayment_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (StoragenodePayment_Period_Field) _Column() string { return "period" }
 
-type Project_Description_Field struct {
+type StoragenodePayment_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
+	return StoragenodePayment_Amount_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f StoragenodePayment_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
 
-type Project_UsageLimit_Field struct {
+type StoragenodePayment_Receipt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *string
 }
 
-func Project_UsageLimit(v int64) Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _value: &v}
+func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
 }
 
-func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
 	if v == nil {
-		return Project_UsageLimit_Null()
+		return StoragenodePayment_Receipt_Null()
 	}
-	return Project_UsageLimit(*v)
+	return StoragenodePayment_Receipt(*v)
 }
 
-func Project_UsageLimit_Null() Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _null: true}
+func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
 }
 
-func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_UsageLimit_Field) value() interface{} {
+func (f StoragenodePayment_Receipt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
 
-type Project_BandwidthLimit_Field struct {
+type StoragenodePayment_Notes_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *string
 }
 
-func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
 }
 
-func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
 	if v == nil {
-		return Project_BandwidthLimit_Null()
+		return StoragenodePayment_Notes_Null()
 	}
-	return Project_BandwidthLimit(*v)
+	return StoragenodePayment_Notes(*v)
 }
 
-func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _null: true}
+func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _null: true}
 }
 
-func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_BandwidthLimit_Field) value() interface{} {
+func (f StoragenodePayment_Notes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
 
-type Project_RateLimit_Field struct {
+type StoragenodePaystub struct {
+	Period         string
+	NodeId         []byte
+	CreatedAt      time.Time
+	Codes          string
+	UsageAtRest    float64
+	UsageGet       int64
+	UsagePut       int64
+	UsageGetRepair int64
+	UsagePutRepair int64
+	UsageGetAudit  int64
+	CompAtRest     int64
+	CompGet        int64
+	CompPut        int64
+	CompGetRepair  int64
+	CompPutRepair  int64
+	CompGetAudit   int64
+	SurgePercent   int64
+	Held           int64
+	Owed           int64
+	Disposed       int64
+	Paid           int64
+	Distributed    int64
+}
+
+func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
+
+type StoragenodePaystub_Update_Fields struct {
+}
+
+type StoragenodePaystub_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Project_RateLimit(v int) Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _value: &v}
+func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
+	return StoragenodePaystub_Period_Field{_set: true, _value: v}
 }
 
-func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
-	if v == nil {
-		return Project_RateLimit_Null()
+func (f StoragenodePaystub_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_RateLimit(*v)
+	return f._value
 }
 
-func Project_RateLimit_Null() Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _null: true}
+func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
+
+type StoragenodePaystub_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
+	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
+}
 
-func (f Project_RateLimit_Field) value() interface{} {
+func (f StoragenodePaystub_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
 
-type Project_MaxBuckets_Field struct {
+type StoragenodePaystub_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _value: &v}
+func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
+	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
-	if v == nil {
-		return Project_MaxBuckets_Null()
+func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_MaxBuckets(*v)
+	return f._value
 }
 
-func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _null: true}
+func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
+
+type StoragenodePaystub_Codes_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
+	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
+}
 
-func (f Project_MaxBuckets_Field) value() interface{} {
+func (f StoragenodePaystub_Codes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
 
-type Project_PartnerId_Field struct {
+type StoragenodePaystub_UsageAtRest_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func Project_PartnerId(v []byte) Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
+	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
 }
 
-func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
-	if v == nil {
-		return Project_PartnerId_Null()
+func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_PartnerId(v)
+	return f._value
 }
 
-func Project_PartnerId_Null() Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _null: true}
+func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
+
+type StoragenodePaystub_UsageGet_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
+	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
+}
 
-func (f Project_PartnerId_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PartnerId_Field) _Column() string { return "partner_id" }
+func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
 
-type Project_OwnerId_Field struct {
+type StoragenodePaystub_UsagePut_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Project_OwnerId(v []byte) Project_OwnerId_Field {
-	return Project_OwnerId_Field{_set: true, _value: v}
+func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
+	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
 }
 
-func (f Project_OwnerId_Field) value() interface{} {
+func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
 
-type Project_CreatedAt_Field struct {
+type StoragenodePaystub_UsageGetRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
+	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
 
-type ProjectBandwidthRollup struct {
-	ProjectId       []byte
-	IntervalMonth   time.Time
-	EgressAllocated uint64
+type StoragenodePaystub_UsagePutRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
+func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
+	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
+}
 
-type ProjectBandwidthRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
+func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ProjectBandwidthRollup_ProjectId_Field struct {
+func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
+
+type StoragenodePaystub_UsageGetAudit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
-	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
+	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
 
-type ProjectBandwidthRollup_IntervalMonth_Field struct {
+type StoragenodePaystub_CompAtRest_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
-	v = toDate(v)
-	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
+func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
+	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
+func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
+func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
 
-type ProjectBandwidthRollup_EgressAllocated_Field struct {
+type StoragenodePaystub_CompGet_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
-	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
+func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
+	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
+func (f StoragenodePaystub_CompGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
 
-type RegistrationToken struct {
-	Secret       []byte
-	OwnerId      []byte
-	ProjectLimit int
-	CreatedAt    time.Time
+type StoragenodePaystub_CompPut_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (RegistrationToken) _Table() string { return "registration_tokens" }
-
-type RegistrationToken_Create_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
+func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
+	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
 }
 
-type RegistrationToken_Update_Fields struct {
-	OwnerId RegistrationToken_OwnerId_Field
+func (f StoragenodePaystub_CompPut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type RegistrationToken_Secret_Field struct {
+func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
+
+type StoragenodePaystub_CompGetRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func RegistrationToken_Secret(v []byte) RegistrationToken_Secret_Field {
-	return RegistrationToken_Secret_Field{_set: true, _value: v}
+func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
+	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_Secret_Field) value() interface{} {
+func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_Secret_Field) _Column() string { return "secret" }
+func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
 
-type RegistrationToken_OwnerId_Field struct {
+type StoragenodePaystub_CompPutRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func RegistrationToken_OwnerId(v []byte) RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _value: v}
+func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
+	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
 }
 
-func RegistrationToken_OwnerId_Raw(v []byte) RegistrationToken_OwnerId_Field {
-	if v == nil {
-		return RegistrationToken_OwnerId_Null()
+func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return RegistrationToken_OwnerId(v)
+	return f._value
 }
 
-func RegistrationToken_OwnerId_Null() RegistrationToken_OwnerId_Field {
-	return RegistrationToken_OwnerId_Field{_set: true, _null: true}
+func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+
+type StoragenodePaystub_CompGetAudit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f RegistrationToken_OwnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
+	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+}
 
-func (f RegistrationToken_OwnerId_Field) value() interface{} {
+func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
 
-type RegistrationToken_ProjectLimit_Field struct {
+type StoragenodePaystub_SurgePercent_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func RegistrationToken_ProjectLimit(v int) RegistrationToken_ProjectLimit_Field {
-	return RegistrationToken_ProjectLimit_Field{_set: true, _value: v}
+func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
+	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_ProjectLimit_Field) value() interface{} {
+func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_ProjectLimit_Field) _Column() string { return "project_limit" }
+func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
 
-type RegistrationToken_CreatedAt_Field struct {
+type StoragenodePaystub_Held_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func RegistrationToken_CreatedAt(v time.Time) RegistrationToken_CreatedAt_Field {
-	return RegistrationToken_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
+	return StoragenodePaystub_Held_Field{_set: true, _value: v}
 }
 
-func (f RegistrationToken_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaystub_Held_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
 
-type ResetPasswordToken struct {
-	Secret    []byte
-	OwnerId   []byte
-	CreatedAt time.Time
+type StoragenodePaystub_Owed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
+	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
+}
 
-type ResetPasswordToken_Update_Fields struct {
-	OwnerId ResetPasswordToken_OwnerId_Field
+func (f StoragenodePaystub_Owed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type ResetPasswordToken_Secret_Field struct {
+func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
+
+type StoragenodePaystub_Disposed_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
-	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
+	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_Secret_Field) value() interface{} {
+func (f StoragenodePaystub_Disposed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
 
-type ResetPasswordToken_OwnerId_Field struct {
+type StoragenodePaystub_Paid_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
-	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
+	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+func (f StoragenodePaystub_Paid_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
 
-type ResetPasswordToken_CreatedAt_Field struct {
+type StoragenodePaystub_Distributed_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
-	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
+	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaystub_Distributed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
 
-type Revocation struct {
-	Revoked  []byte
-	ApiKeyId []byte
+type StoragenodeSettlementOutcome struct {
+	StoragenodeId          []byte
+	IntervalStart          time.Time
+	SettledAt              time.Time
+	Status                 int
+	AlreadyProcessed       bool
+	OrdersReceived         int
+	OrdersAccepted         int
+	OrdersUnknownBucket    int
+	AmountSettled          int64
+	RejectedExpired        int
+	RejectedBadSignature   int
+	RejectedAlreadySettled int
+	RejectedInvalid        int
 }
 
-func (Revocation) _Table() string { return "revocations" }
+func (StoragenodeSettlementOutcome) _Table() string { return "storagenode_settlement_outcomes" }
 
-type Revocation_Update_Fields struct {
+type StoragenodeSettlementOutcome_Update_Fields struct {
 }
 
-type Revocation_Revoked_Field struct {
+type StoragenodeSettlementOutcome_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
-	return Revocation_Revoked_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_StoragenodeId(v []byte) StoragenodeSettlementOutcome_StoragenodeId_Field {
+	return StoragenodeSettlementOutcome_StoragenodeId_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementOutcome_StoragenodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementOutcome_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+
+type StoragenodeSettlementOutcome_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeSettlementOutcome_IntervalStart(v time.Time) StoragenodeSettlementOutcome_IntervalStart_Field {
+	return StoragenodeSettlementOutcome_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Revocation_Revoked_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_Revoked_Field) _Column() string { return "revoked" }
+func (StoragenodeSettlementOutcome_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Revocation_ApiKeyId_Field struct {
+type StoragenodeSettlementOutcome_SettledAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
-	return Revocation_ApiKeyId_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_SettledAt(v time.Time) StoragenodeSettlementOutcome_SettledAt_Field {
+	return StoragenodeSettlementOutcome_SettledAt_Field{_set: true, _value: v}
 }
 
-func (f Revocation_ApiKeyId_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_SettledAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
+func (StoragenodeSettlementOutcome_SettledAt_Field) _Column() string { return "settled_at" }
 
-type StoragenodeBandwidthRollup struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type StoragenodeSettlementOutcome_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
-
-type StoragenodeBandwidthRollup_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
+func StoragenodeSettlementOutcome_Status(v int) StoragenodeSettlementOutcome_Status_Field {
+	return StoragenodeSettlementOutcome_Status_Field{_set: true, _value: v}
 }
 
-type StoragenodeBandwidthRollup_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
-	Settled   StoragenodeBandwidthRollup_Settled_Field
+func (f StoragenodeSettlementOutcome_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+func (StoragenodeSettlementOutcome_Status_Field) _Column() string { return "status" }
+
+type StoragenodeSettlementOutcome_AlreadyProcessed_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
-	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_AlreadyProcessed(v bool) StoragenodeSettlementOutcome_AlreadyProcessed_Field {
+	return StoragenodeSettlementOutcome_AlreadyProcessed_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_AlreadyProcessed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (StoragenodeSettlementOutcome_AlreadyProcessed_Field) _Column() string {
+	return "already_processed"
+}
 
-type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+type StoragenodeSettlementOutcome_OrdersReceived_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
-	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_OrdersReceived(v int) StoragenodeSettlementOutcome_OrdersReceived_Field {
+	return StoragenodeSettlementOutcome_OrdersReceived_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_OrdersReceived_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (StoragenodeSettlementOutcome_OrdersReceived_Field) _Column() string { return "orders_received" }
 
-type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+type StoragenodeSettlementOutcome_OrdersAccepted_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int
 }
 
-func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_OrdersAccepted(v int) StoragenodeSettlementOutcome_OrdersAccepted_Field {
+	return StoragenodeSettlementOutcome_OrdersAccepted_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_OrdersAccepted_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (StoragenodeSettlementOutcome_OrdersAccepted_Field) _Column() string { return "orders_accepted" }
 
-type StoragenodeBandwidthRollup_Action_Field struct {
+type StoragenodeSettlementOutcome_OrdersUnknownBucket_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int
 }
 
-func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
-	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_OrdersUnknownBucket(v int) StoragenodeSettlementOutcome_OrdersUnknownBucket_Field {
+	return StoragenodeSettlementOutcome_OrdersUnknownBucket_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_OrdersUnknownBucket_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (StoragenodeSettlementOutcome_OrdersUnknownBucket_Field) _Column() string {
+	return "orders_unknown_bucket"
+}
 
-type StoragenodeBandwidthRollup_Allocated_Field struct {
+type StoragenodeSettlementOutcome_AmountSettled_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
+func StoragenodeSettlementOutcome_AmountSettled(v int64) StoragenodeSettlementOutcome_AmountSettled_Field {
+	return StoragenodeSettlementOutcome_AmountSettled_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollup_Allocated_Null()
+func (f StoragenodeSettlementOutcome_AmountSettled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollup_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
+func (StoragenodeSettlementOutcome_AmountSettled_Field) _Column() string { return "amount_settled" }
+
+type StoragenodeSettlementOutcome_RejectedExpired_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StoragenodeSettlementOutcome_RejectedExpired(v int) StoragenodeSettlementOutcome_RejectedExpired_Field {
+	return StoragenodeSettlementOutcome_RejectedExpired_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_RejectedExpired_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (StoragenodeSettlementOutcome_RejectedExpired_Field) _Column() string { return "rejected_expired" }
 
-type StoragenodeBandwidthRollup_Settled_Field struct {
+type StoragenodeSettlementOutcome_RejectedBadSignature_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int
 }
 
-func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
-	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_RejectedBadSignature(v int) StoragenodeSettlementOutcome_RejectedBadSignature_Field {
+	return StoragenodeSettlementOutcome_RejectedBadSignature_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_RejectedBadSignature_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (StoragenodeSettlementOutcome_RejectedBadSignature_Field) _Column() string {
+	return "rejected_bad_signature"
+}
 
-type StoragenodeBandwidthRollupArchive struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type StoragenodeSettlementOutcome_RejectedAlreadySettled_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (StoragenodeBandwidthRollupArchive) _Table() string {
-	return "storagenode_bandwidth_rollup_archives"
+func StoragenodeSettlementOutcome_RejectedAlreadySettled(v int) StoragenodeSettlementOutcome_RejectedAlreadySettled_Field {
+	return StoragenodeSettlementOutcome_RejectedAlreadySettled_Field{_set: true, _value: v}
 }
 
-type StoragenodeBandwidthRollupArchive_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+func (f StoragenodeSettlementOutcome_RejectedAlreadySettled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StoragenodeBandwidthRollupArchive_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
-	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
+func (StoragenodeSettlementOutcome_RejectedAlreadySettled_Field) _Column() string {
+	return "rejected_already_settled"
 }
 
-type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
+type StoragenodeSettlementOutcome_RejectedInvalid_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodeSettlementOutcome_RejectedInvalid(v int) StoragenodeSettlementOutcome_RejectedInvalid_Field {
+	return StoragenodeSettlementOutcome_RejectedInvalid_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
+func (f StoragenodeSettlementOutcome_RejectedInvalid_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
-	return "storagenode_id"
+func (StoragenodeSettlementOutcome_RejectedInvalid_Field) _Column() string { return "rejected_invalid" }
+
+type StoragenodeStorageTally struct {
+	NodeId          []byte
+	IntervalEndTime time.Time
+	DataTotal       float64
 }
 
-type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
+func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
+
+type StoragenodeStorageTally_Update_Fields struct {
+}
+
+type StoragenodeStorageTally_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
+	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
-	return "interval_start"
-}
+func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
+type StoragenodeStorageTally_IntervalEndTime_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
+	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type StoragenodeBandwidthRollupArchive_Action_Field struct {
+type StoragenodeStorageTally_DataTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value float64
 }
 
-func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
-	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
+	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
 
-type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
+type StripeCustomer struct {
+	UserId     []byte
+	CustomerId string
+	CreatedAt  time.Time
+}
+
+func (StripeCustomer) _Table() string { return "stripe_customers" }
+
+type StripeCustomer_Update_Fields struct {
+}
+
+type StripeCustomer_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
+func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
+	return StripeCustomer_UserId_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupArchive_Allocated_Null()
+func (f StripeCustomer_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollupArchive_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
+func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
+
+type StripeCustomer_CustomerId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
+	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f StripeCustomer_CustomerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
 
-type StoragenodeBandwidthRollupArchive_Settled_Field struct {
+type StripeCustomer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
-	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
+	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f StripeCustomer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
-
-type StoragenodeBandwidthRollupPhase2 struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
-}
+func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
 
-func (StoragenodeBandwidthRollupPhase2) _Table() string {
-	return "storagenode_bandwidth_rollups_phase2"
+type StripecoinpaymentsInvoiceProjectRecord struct {
+	Id          []byte
+	ProjectId   []byte
+	Storage     float64
+	Egress      int64
+	Objects     int64
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	State       int
+	CreatedAt   time.Time
 }
 
-type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
+	return "stripecoinpayments_invoice_project_records"
 }
 
-type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
-	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
+type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
+	State StripecoinpaymentsInvoiceProjectRecord_State_Field
 }
 
-type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
+func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value float64
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
 
-type StoragenodeBandwidthRollupPhase2_Action_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
-	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
+func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
 
-type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
-}
-
-func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
-}
-
-func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
-	}
-	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
-}
-
-func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
+	_value int64
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
+func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
 
-type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
-	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
-
-type StoragenodePayment struct {
-	Id        int64
-	CreatedAt time.Time
-	NodeId    []byte
-	Period    string
-	Amount    int64
-	Receipt   *string
-	Notes     *string
-}
-
-func (StoragenodePayment) _Table() string { return "storagenode_payments" }
-
-type StoragenodePayment_Create_Fields struct {
-	Receipt StoragenodePayment_Receipt_Field
-	Notes   StoragenodePayment_Notes_Field
-}
-
-type StoragenodePayment_Update_Fields struct {
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
+	return "period_start"
 }
 
-type StoragenodePayment_Id_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
-	return StoragenodePayment_Id_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Id_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Id_Field) _Column() string { return "id" }
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
 
-type StoragenodePayment_CreatedAt_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
-	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
+func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
 
-type StoragenodePayment_NodeId_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
-	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_NodeId_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
+func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePayment_Period_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type StripecoinpaymentsTxConversionRate struct {
+	TxId      string
+	Rate      []byte
+	CreatedAt time.Time
 }
 
-func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
-	return StoragenodePayment_Period_Field{_set: true, _value: v}
+func (StripecoinpaymentsTxConversionRate) _Table() string {
+	return "stripecoinpayments_tx_conversion_rates"
 }
 
-func (f StoragenodePayment_Period_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StripecoinpaymentsTxConversionRate_Update_Fields struct {
 }
 
-func (StoragenodePayment_Period_Field) _Column() string { return "period" }
-
-type StoragenodePayment_Amount_Field struct {
+type StripecoinpaymentsTxConversionRate_TxId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
-	return StoragenodePayment_Amount_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
+	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Amount_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
+func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
 
-type StoragenodePayment_Receipt_Field struct {
+type StripecoinpaymentsTxConversionRate_Rate_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
-}
-
-func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
-	if v == nil {
-		return StoragenodePayment_Receipt_Null()
-	}
-	return StoragenodePayment_Receipt(*v)
+	_value []byte
 }
 
-func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+func StripecoinpaymentsTxConversionRate_Rate(v []byte) StripecoinpaymentsTxConversionRate_Rate_Field {
+	return StripecoinpaymentsTxConversionRate_Rate_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f StoragenodePayment_Receipt_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_Rate_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
+func (StripecoinpaymentsTxConversionRate_Rate_Field) _Column() string { return "rate" }
 
-type StoragenodePayment_Notes_Field struct {
+type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
-}
-
-func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
-	if v == nil {
-		return StoragenodePayment_Notes_Null()
-	}
-	return StoragenodePayment_Notes(*v)
+	_value time.Time
 }
 
-func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _null: true}
+func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
+	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f StoragenodePayment_Notes_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
+func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub struct {
-	Period         string
-	NodeId         []byte
-	CreatedAt      time.Time
-	Codes          string
-	UsageAtRest    float64
-	UsageGet       int64
-	UsagePut       int64
-	UsageGetRepair int64
-	UsagePutRepair int64
-	UsageGetAudit  int64
-	CompAtRest     int64
-	CompGet        int64
-	CompPut        int64
-	CompGetRepair  int64
-	CompPutRepair  int64
-	CompGetAudit   int64
-	SurgePercent   int64
-	Held           int64
-	Owed           int64
-	Disposed       int64
-	Paid           int64
-	Distributed    int64
+type User struct {
+	Id                []byte
+	Email             string
+	NormalizedEmail   string
+	FullName          string
+	ShortName         *string
+	PasswordHash      []byte
+	Status            int
+	PartnerId         []byte
+	CreatedAt         time.Time
+	ProjectLimit      int
+	Position          *string
+	CompanyName       *string
+	CompanySize       *int
+	WorkingOn         *string
+	IsProfessional    bool
+	EmployeeCount     *string
+	MfaEnabled        bool
+	MfaSecretKey      *string
+	MfaRecoveryCodes  *string
+	MfaLastCounter    int64
+	MfaFailedAttempts int
+	MfaLockedUntil    *time.Time
 }
 
-func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
+func (User) _Table() string { return "users" }
 
-type StoragenodePaystub_Update_Fields struct {
+type User_Create_Fields struct {
+	ShortName         User_ShortName_Field
+	PartnerId         User_PartnerId_Field
+	ProjectLimit      User_ProjectLimit_Field
+	Position          User_Position_Field
+	CompanyName       User_CompanyName_Field
+	CompanySize       User_CompanySize_Field
+	WorkingOn         User_WorkingOn_Field
+	IsProfessional    User_IsProfessional_Field
+	EmployeeCount     User_EmployeeCount_Field
+	MfaEnabled        User_MfaEnabled_Field
+	MfaSecretKey      User_MfaSecretKey_Field
+	MfaRecoveryCodes  User_MfaRecoveryCodes_Field
+	MfaLastCounter    User_MfaLastCounter_Field
+	MfaFailedAttempts User_MfaFailedAttempts_Field
+	MfaLockedUntil    User_MfaLockedUntil_Field
+}
+
+type User_Update_Fields struct {
+	Email             User_Email_Field
+	NormalizedEmail   User_NormalizedEmail_Field
+	FullName          User_FullName_Field
+	ShortName         User_ShortName_Field
+	PasswordHash      User_PasswordHash_Field
+	Status            User_Status_Field
+	ProjectLimit      User_ProjectLimit_Field
+	Position          User_Position_Field
+	CompanyName       User_CompanyName_Field
+	CompanySize       User_CompanySize_Field
+	WorkingOn         User_WorkingOn_Field
+	IsProfessional    User_IsProfessional_Field
+	EmployeeCount     User_EmployeeCount_Field
+	MfaEnabled        User_MfaEnabled_Field
+	MfaSecretKey      User_MfaSecretKey_Field
+	MfaRecoveryCodes  User_MfaRecoveryCodes_Field
+	MfaLastCounter    User_MfaLastCounter_Field
+	MfaFailedAttempts User_MfaFailedAttempts_Field
+	MfaLockedUntil    User_MfaLockedUntil_Field
 }
 
-type StoragenodePaystub_Period_Field struct {
+type User_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
-	return StoragenodePaystub_Period_Field{_set: true, _value: v}
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Period_Field) value() interface{} {
+func (f User_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
+func (User_Id_Field) _Column() string { return "id" }
 
-type StoragenodePaystub_NodeId_Field struct {
+type User_Email_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
-	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_NodeId_Field) value() interface{} {
+func (f User_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
+func (User_Email_Field) _Column() string { return "email" }
 
-type StoragenodePaystub_CreatedAt_Field struct {
+type User_NormalizedEmail_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
-	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
+func User_NormalizedEmail(v string) User_NormalizedEmail_Field {
+	return User_NormalizedEmail_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
+func (f User_NormalizedEmail_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
+func (User_NormalizedEmail_Field) _Column() string { return "normalized_email" }
 
-type StoragenodePaystub_Codes_Field struct {
+type User_FullName_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
-	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
+func User_FullName(v string) User_FullName_Field {
+	return User_FullName_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Codes_Field) value() interface{} {
+func (f User_FullName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
+func (User_FullName_Field) _Column() string { return "full_name" }
 
-type StoragenodePaystub_UsageAtRest_Field struct {
+type User_ShortName_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value *string
 }
 
-func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
-	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
+func User_ShortName(v string) User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_ShortName_Raw(v *string) User_ShortName_Field {
+	if v == nil {
+		return User_ShortName_Null()
 	}
-	return f._value
+	return User_ShortName(*v)
 }
 
-func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
-
-type StoragenodePaystub_UsageGet_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func User_ShortName_Null() User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _null: true}
 }
 
-func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
-	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
-}
+func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
+func (f User_ShortName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
+func (User_ShortName_Field) _Column() string { return "short_name" }
 
-type StoragenodePaystub_UsagePut_Field struct {
+type User_PasswordHash_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
-	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
+func (f User_PasswordHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
 
-type StoragenodePaystub_UsageGetRepair_Field struct {
+type User_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
-	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
+func User_Status(v int) User_Status_Field {
+	return User_Status_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
+func (f User_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
+func (User_Status_Field) _Column() string { return "status" }
 
-type StoragenodePaystub_UsagePutRepair_Field struct {
+type User_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
-	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
+func User_PartnerId(v []byte) User_PartnerId_Field {
+	return User_PartnerId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_PartnerId_Raw(v []byte) User_PartnerId_Field {
+	if v == nil {
+		return User_PartnerId_Null()
 	}
-	return f._value
+	return User_PartnerId(v)
 }
 
-func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
-
-type StoragenodePaystub_UsageGetAudit_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func User_PartnerId_Null() User_PartnerId_Field {
+	return User_PartnerId_Field{_set: true, _null: true}
 }
 
-func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
-	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
-}
+func (f User_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
+func (f User_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
+func (User_PartnerId_Field) _Column() string { return "partner_id" }
 
-type StoragenodePaystub_CompAtRest_Field struct {
+type User_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
-	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
+func User_CreatedAt(v time.Time) User_CreatedAt_Field {
+	return User_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
+func (f User_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
+func (User_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_CompGet_Field struct {
+type User_ProjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
-	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
+func User_ProjectLimit(v int) User_ProjectLimit_Field {
+	return User_ProjectLimit_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGet_Field) value() interface{} {
+func (f User_ProjectLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
+func (User_ProjectLimit_Field) _Column() string { return "project_limit" }
 
-type StoragenodePaystub_CompPut_Field struct {
+type User_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
-	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
+func User_Position(v string) User_Position_Field {
+	return User_Position_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_CompPut_Field) value() interface{} {
+func User_Position_Raw(v *string) User_Position_Field {
+	if v == nil {
+		return User_Position_Null()
+	}
+	return User_Position(*v)
+}
+
+func User_Position_Null() User_Position_Field {
+	return User_Position_Field{_set: true, _null: true}
+}
+
+func (f User_Position_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
+func (User_Position_Field) _Column() string { return "position" }
 
-type StoragenodePaystub_CompGetRepair_Field struct {
+type User_CompanyName_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
-	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
+func User_CompanyName(v string) User_CompanyName_Field {
+	return User_CompanyName_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
+func User_CompanyName_Raw(v *string) User_CompanyName_Field {
+	if v == nil {
+		return User_CompanyName_Null()
+	}
+	return User_CompanyName(*v)
+}
+
+func User_CompanyName_Null() User_CompanyName_Field {
+	return User_CompanyName_Field{_set: true, _null: true}
+}
+
+func (f User_CompanyName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_CompanyName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
+func (User_CompanyName_Field) _Column() string { return "company_name" }
 
-type StoragenodePaystub_CompPutRepair_Field struct {
+type User_CompanySize_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *int
 }
 
-func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
-	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
+func User_CompanySize(v int) User_CompanySize_Field {
+	return User_CompanySize_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+func User_CompanySize_Raw(v *int) User_CompanySize_Field {
+	if v == nil {
+		return User_CompanySize_Null()
+	}
+	return User_CompanySize(*v)
+}
+
+func User_CompanySize_Null() User_CompanySize_Field {
+	return User_CompanySize_Field{_set: true, _null: true}
+}
+
+func (f User_CompanySize_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_CompanySize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+func (User_CompanySize_Field) _Column() string { return "company_size" }
 
-type StoragenodePaystub_CompGetAudit_Field struct {
+type User_WorkingOn_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
-	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+func User_WorkingOn(v string) User_WorkingOn_Field {
+	return User_WorkingOn_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
+func User_WorkingOn_Raw(v *string) User_WorkingOn_Field {
+	if v == nil {
+		return User_WorkingOn_Null()
+	}
+	return User_WorkingOn(*v)
+}
+
+func User_WorkingOn_Null() User_WorkingOn_Field {
+	return User_WorkingOn_Field{_set: true, _null: true}
+}
+
+func (f User_WorkingOn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_WorkingOn_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
+func (User_WorkingOn_Field) _Column() string { return "working_on" }
 
-type StoragenodePaystub_SurgePercent_Field struct {
+type User_IsProfessional_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value bool
 }
 
-func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
-	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
+func User_IsProfessional(v bool) User_IsProfessional_Field {
+	return User_IsProfessional_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
+func (f User_IsProfessional_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
+func (User_IsProfessional_Field) _Column() string { return "is_professional" }
 
-type StoragenodePaystub_Held_Field struct {
+type User_EmployeeCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
-	return StoragenodePaystub_Held_Field{_set: true, _value: v}
+func User_EmployeeCount(v string) User_EmployeeCount_Field {
+	return User_EmployeeCount_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_Held_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_EmployeeCount_Raw(v *string) User_EmployeeCount_Field {
+	if v == nil {
+		return User_EmployeeCount_Null()
 	}
-	return f._value
+	return User_EmployeeCount(*v)
 }
 
-func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
-
-type StoragenodePaystub_Owed_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func User_EmployeeCount_Null() User_EmployeeCount_Field {
+	return User_EmployeeCount_Field{_set: true, _null: true}
 }
 
-func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
-	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
-}
+func (f User_EmployeeCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodePaystub_Owed_Field) value() interface{} {
+func (f User_EmployeeCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
+func (User_EmployeeCount_Field) _Column() string { return "employee_count" }
 
-type StoragenodePaystub_Disposed_Field struct {
+type User_MfaEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value bool
 }
 
-func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
-	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
+func User_MfaEnabled(v bool) User_MfaEnabled_Field {
+	return User_MfaEnabled_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Disposed_Field) value() interface{} {
+func (f User_MfaEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
+func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
 
-type StoragenodePaystub_Paid_Field struct {
+type User_MfaSecretKey_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *string
 }
 
-func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
-	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
+func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_Paid_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
+	if v == nil {
+		return User_MfaSecretKey_Null()
 	}
-	return f._value
+	return User_MfaSecretKey(*v)
 }
 
-func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
-
-type StoragenodePaystub_Distributed_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _null: true}
 }
 
-func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
-	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
-}
+func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodePaystub_Distributed_Field) value() interface{} {
+func (f User_MfaSecretKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
-
-type StoragenodeStorageTally struct {
-	NodeId          []byte
-	IntervalEndTime time.Time
-	DataTotal       float64
-}
-
-func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
-
-type StoragenodeStorageTally_Update_Fields struct {
-}
+func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
 
-type StoragenodeStorageTally_NodeId_Field struct {
+type User_MfaRecoveryCodes_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
-	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
+	if v == nil {
+		return User_MfaRecoveryCodes_Null()
 	}
-	return f._value
+	return User_MfaRecoveryCodes(*v)
 }
 
-func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
-
-type StoragenodeStorageTally_IntervalEndTime_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
 }
 
-func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
-	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
-}
+func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+func (f User_MfaRecoveryCodes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
 
-type StoragenodeStorageTally_DataTotal_Field struct {
+type User_MfaLastCounter_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
-	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+func User_MfaLastCounter(v int64) User_MfaLastCounter_Field {
+	return User_MfaLastCounter_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+func (f User_MfaLastCounter_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
-
-type StripeCustomer struct {
-	UserId     []byte
-	CustomerId string
-	CreatedAt  time.Time
-}
-
-func (StripeCustomer) _Table() string { return "stripe_customers" }
-
-type StripeCustomer_Update_Fields struct {
-}
+func (User_MfaLastCounter_Field) _Column() string { return "mfa_last_counter" }
 
-type StripeCustomer_UserId_Field struct {
+type User_MfaFailedAttempts_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
-	return StripeCustomer_UserId_Field{_set: true, _value: v}
+func User_MfaFailedAttempts(v int) User_MfaFailedAttempts_Field {
+	return User_MfaFailedAttempts_Field{_set: true, _value: v}
 }
 
-func (f StripeCustomer_UserId_Field) value() interface{} {
+func (f User_MfaFailedAttempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
+func (User_MfaFailedAttempts_Field) _Column() string { return "mfa_failed_attempts" }
 
-type StripeCustomer_CustomerId_Field struct {
+type User_MfaLockedUntil_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *time.Time
 }
 
-func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
-	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
+func User_MfaLockedUntil(v time.Time) User_MfaLockedUntil_Field {
+	return User_MfaLockedUntil_Field{_set: true, _value: &v}
 }
 
-func (f StripeCustomer_CustomerId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_MfaLockedUntil_Raw(v *time.Time) User_MfaLockedUntil_Field {
+	if v == nil {
+		return User_MfaLockedUntil_Null()
 	}
-	return f._value
+	return User_MfaLockedUntil(*v)
 }
 
-func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
-
-type StripeCustomer_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func User_MfaLockedUntil_Null() User_MfaLockedUntil_Field {
+	return User_MfaLockedUntil_Field{_set: true, _null: true}
 }
 
-func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
-	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
-}
+func (f User_MfaLockedUntil_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripeCustomer_CreatedAt_Field) value() interface{} {
+func (f User_MfaLockedUntil_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
+func (User_MfaLockedUntil_Field) _Column() string { return "mfa_locked_until" }
 
-type StripecoinpaymentsInvoiceProjectRecord struct {
-	Id          []byte
+type ValueAttribution struct {
 	ProjectId   []byte
-	Storage     float64
-	Egress      int64
-	Objects     int64
-	PeriodStart time.Time
-	PeriodEnd   time.Time
-	State       int
-	CreatedAt   time.Time
+	BucketName  []byte
+	PartnerId   []byte
+	LastUpdated time.Time
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
-	return "stripecoinpayments_invoice_project_records"
-}
+func (ValueAttribution) _Table() string { return "value_attributions" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
-	State StripecoinpaymentsInvoiceProjectRecord_State_Field
+type ValueAttribution_Update_Fields struct {
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
+type ValueAttribution_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
+func ValueAttribution_ProjectId(v []byte) ValueAttribution_ProjectId_Field {
+	return ValueAttribution_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
+func (f ValueAttribution_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
+func (ValueAttribution_ProjectId_Field) _Column() string { return "project_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
+type ValueAttribution_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
+func ValueAttribution_BucketName(v []byte) ValueAttribution_BucketName_Field {
+	return ValueAttribution_BucketName_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
+func (f ValueAttribution_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
+func (ValueAttribution_BucketName_Field) _Column() string { return "bucket_name" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
+type ValueAttribution_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
+func ValueAttribution_PartnerId(v []byte) ValueAttribution_PartnerId_Field {
+	return ValueAttribution_PartnerId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
+func (f ValueAttribution_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
+func (ValueAttribution_PartnerId_Field) _Column() string { return "partner_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
+type ValueAttribution_LastUpdated_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
+func ValueAttribution_LastUpdated(v time.Time) ValueAttribution_LastUpdated_Field {
+	return ValueAttribution_LastUpdated_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
+func (f ValueAttribution_LastUpdated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
+func (ValueAttribution_LastUpdated_Field) _Column() string { return "last_updated" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
+type ApiKey struct {
+	Id             []byte
+	ProjectId      []byte
+	Head           []byte
+	Name           string
+	Secret         []byte
+	PartnerId      []byte
+	CreatedAt      time.Time
+	RateLimit      *int
+	BandwidthLimit *int64
+}
+
+func (ApiKey) _Table() string { return "api_keys" }
+
+type ApiKey_Create_Fields struct {
+	PartnerId      ApiKey_PartnerId_Field
+	RateLimit      ApiKey_RateLimit_Field
+	BandwidthLimit ApiKey_BandwidthLimit_Field
+}
+
+type ApiKey_Update_Fields struct {
+	Name           ApiKey_Name_Field
+	RateLimit      ApiKey_RateLimit_Field
+	BandwidthLimit ApiKey_BandwidthLimit_Field
+}
+
+type ApiKey_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: v}
+func ApiKey_Id(v []byte) ApiKey_Id_Field {
+	return ApiKey_Id_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
+func (f ApiKey_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
+func (ApiKey_Id_Field) _Column() string { return "id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
+type ApiKey_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
+func (f ApiKey_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
-	return "period_start"
-}
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
+type ApiKey_Head_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
+func ApiKey_Head(v []byte) ApiKey_Head_Field {
+	return ApiKey_Head_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
+func (f ApiKey_Head_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
+func (ApiKey_Head_Field) _Column() string { return "head" }
 
-type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
+type ApiKey_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
+func (f ApiKey_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
+func (ApiKey_Name_Field) _Column() string { return "name" }
 
-type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
+type ApiKey_Secret_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
+func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
+	return ApiKey_Secret_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
+func (f ApiKey_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
+func (ApiKey_Secret_Field) _Column() string { return "secret" }
 
-type StripecoinpaymentsTxConversionRate struct {
-	TxId      string
-	Rate      []byte
-	CreatedAt time.Time
+type ApiKey_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (StripecoinpaymentsTxConversionRate) _Table() string {
-	return "stripecoinpayments_tx_conversion_rates"
+func ApiKey_PartnerId(v []byte) ApiKey_PartnerId_Field {
+	return ApiKey_PartnerId_Field{_set: true, _value: v}
 }
 
-type StripecoinpaymentsTxConversionRate_Update_Fields struct {
+func ApiKey_PartnerId_Raw(v []byte) ApiKey_PartnerId_Field {
+	if v == nil {
+		return ApiKey_PartnerId_Null()
+	}
+	return ApiKey_PartnerId(v)
 }
 
-type StripecoinpaymentsTxConversionRate_TxId_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func ApiKey_PartnerId_Null() ApiKey_PartnerId_Field {
+	return ApiKey_PartnerId_Field{_set: true, _null: true}
 }
 
-func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
-	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
-}
+func (f ApiKey_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+func (f ApiKey_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+func (ApiKey_PartnerId_Field) _Column() string { return "partner_id" }
 
-type StripecoinpaymentsTxConversionRate_Rate_Field struct {
+type ApiKey_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StripecoinpaymentsTxConversionRate_Rate(v []byte) StripecoinpaymentsTxConversionRate_Rate_Field {
-	return StripecoinpaymentsTxConversionRate_Rate_Field{_set: true, _value: v}
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_Rate_Field) value() interface{} {
+func (f ApiKey_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_Rate_Field) _Column() string { return "rate" }
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
+type ApiKey_RateLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
-	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+func ApiKey_RateLimit(v int) ApiKey_RateLimit_Field {
+	return ApiKey_RateLimit_Field{_set: true, _value: &v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
+func ApiKey_RateLimit_Raw(v *int) ApiKey_RateLimit_Field {
+	if v == nil {
+		return ApiKey_RateLimit_Null()
+	}
+	return ApiKey_RateLimit(*v)
+}
+
+func ApiKey_RateLimit_Null() ApiKey_RateLimit_Field {
+	return ApiKey_RateLimit_Field{_set: true, _null: true}
+}
+
+func (f ApiKey_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ApiKey_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
+func (ApiKey_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type User struct {
-	Id              []byte
-	Email           string
-	NormalizedEmail string
-	FullName        string
-	ShortName       *string
-	PasswordHash    []byte
-	Status          int
-	PartnerId       []byte
-	CreatedAt       time.Time
-	ProjectLimit    int
-	Position        *string
-	CompanyName     *string
-	CompanySize     *int
-	WorkingOn       *string
-	IsProfessional  bool
-	EmployeeCount   *string
+type ApiKey_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
 }
 
-func (User) _Table() string { return "users" }
-
-type User_Create_Fields struct {
-	ShortName      User_ShortName_Field
-	PartnerId      User_PartnerId_Field
-	ProjectLimit   User_ProjectLimit_Field
-	Position       User_Position_Field
-	CompanyName    User_CompanyName_Field
-	CompanySize    User_CompanySize_Field
-	WorkingOn      User_WorkingOn_Field
-	IsProfessional User_IsProfessional_Field
-	EmployeeCount  User_EmployeeCount_Field
+func ApiKey_BandwidthLimit(v int64) ApiKey_BandwidthLimit_Field {
+	return ApiKey_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-type User_Update_Fields struct {
-	Email           User_Email_Field
-	NormalizedEmail User_NormalizedEmail_Field
-	FullName        User_FullName_Field
-	ShortName       User_ShortName_Field
-	PasswordHash    User_PasswordHash_Field
-	Status          User_Status_Field
-	ProjectLimit    User_ProjectLimit_Field
-	Position        User_Position_Field
-	CompanyName     User_CompanyName_Field
-	CompanySize     User_CompanySize_Field
-	WorkingOn       User_WorkingOn_Field
-	IsProfessional  User_IsProfessional_Field
-	EmployeeCount   User_EmployeeCount_Field
+func ApiKey_BandwidthLimit_Raw(v *int64) ApiKey_BandwidthLimit_Field {
+	if v == nil {
+		return ApiKey_BandwidthLimit_Null()
+	}
+	return ApiKey_BandwidthLimit(*v)
 }
 
-type User_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func ApiKey_BandwidthLimit_Null() ApiKey_BandwidthLimit_Field {
+	return ApiKey_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
-}
+func (f ApiKey_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_Id_Field) value() interface{} {
+func (f ApiKey_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (ApiKey_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type User_Email_Field struct {
+type BucketMetainfo struct {
+	Id                              []byte
+	ProjectId                       []byte
+	Name                            []byte
+	PartnerId                       []byte
+	PathCipher                      int
+	CreatedAt                       time.Time
+	DefaultSegmentSize              int
+	DefaultEncryptionCipherSuite    int
+	DefaultEncryptionBlockSize      int
+	DefaultRedundancyAlgorithm      int
+	DefaultRedundancyShareSize      int
+	DefaultRedundancyRequiredShares int
+	DefaultRedundancyRepairShares   int
+	DefaultRedundancyOptimalShares  int
+	DefaultRedundancyTotalShares    int
+	Versioning                      int
+	DefaultRetentionMode            int
+	DefaultRetentionDays            int
+	LifecycleRules                  []byte
+	Placement                       []byte
+	StorageLimit                    *int64
+	BandwidthLimit                  *int64
+	ObjectLimit                     *int64
+	SegmentLimit                    *int64
+}
+
+func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
+
+type BucketMetainfo_Create_Fields struct {
+	PartnerId            BucketMetainfo_PartnerId_Field
+	Versioning           BucketMetainfo_Versioning_Field
+	DefaultRetentionMode BucketMetainfo_DefaultRetentionMode_Field
+	DefaultRetentionDays BucketMetainfo_DefaultRetentionDays_Field
+	LifecycleRules       BucketMetainfo_LifecycleRules_Field
+	Placement            BucketMetainfo_Placement_Field
+	StorageLimit         BucketMetainfo_StorageLimit_Field
+	BandwidthLimit       BucketMetainfo_BandwidthLimit_Field
+	ObjectLimit          BucketMetainfo_ObjectLimit_Field
+	SegmentLimit         BucketMetainfo_SegmentLimit_Field
+}
+
+type BucketMetainfo_Update_Fields struct {
+	PartnerId                       BucketMetainfo_PartnerId_Field
+	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
+	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
+	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
+	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
+	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
+	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
+	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
+	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
+	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
+	Versioning                      BucketMetainfo_Versioning_Field
+	DefaultRetentionMode            BucketMetainfo_DefaultRetentionMode_Field
+	DefaultRetentionDays            BucketMetainfo_DefaultRetentionDays_Field
+	LifecycleRules                  BucketMetainfo_LifecycleRules_Field
+	Placement                       BucketMetainfo_Placement_Field
+	StorageLimit                    BucketMetainfo_StorageLimit_Field
+	BandwidthLimit                  BucketMetainfo_BandwidthLimit_Field
+	ObjectLimit                     BucketMetainfo_ObjectLimit_Field
+	SegmentLimit                    BucketMetainfo_SegmentLimit_Field
+}
+
+type BucketMetainfo_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: v}
+func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
+	return BucketMetainfo_Id_Field{_set: true, _value: v}
 }
 
-func (f User_Email_Field) value() interface{} {
+func (f BucketMetainfo_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+func (BucketMetainfo_Id_Field) _Column() string { return "id" }
 
-type User_NormalizedEmail_Field struct {
+type BucketMetainfo_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func User_NormalizedEmail(v string) User_NormalizedEmail_Field {
-	return User_NormalizedEmail_Field{_set: true, _value: v}
+func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
+	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f User_NormalizedEmail_Field) value() interface{} {
+func (f BucketMetainfo_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_NormalizedEmail_Field) _Column() string { return "normalized_email" }
+func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
 
-type User_FullName_Field struct {
+type BucketMetainfo_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func User_FullName(v string) User_FullName_Field {
-	return User_FullName_Field{_set: true, _value: v}
+func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
+	return BucketMetainfo_Name_Field{_set: true, _value: v}
 }
 
-func (f User_FullName_Field) value() interface{} {
+func (f BucketMetainfo_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FullName_Field) _Column() string { return "full_name" }
+func (BucketMetainfo_Name_Field) _Column() string { return "name" }
 
-type User_ShortName_Field struct {
+type BucketMetainfo_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func User_ShortName(v string) User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _value: &v}
+func BucketMetainfo_PartnerId(v []byte) BucketMetainfo_PartnerId_Field {
+	return BucketMetainfo_PartnerId_Field{_set: true, _value: v}
 }
 
-func User_ShortName_Raw(v *string) User_ShortName_Field {
+func BucketMetainfo_PartnerId_Raw(v []byte) BucketMetainfo_PartnerId_Field {
 	if v == nil {
-		return User_ShortName_Null()
+		return BucketMetainfo_PartnerId_Null()
 	}
-	return User_ShortName(*v)
+	return BucketMetainfo_PartnerId(v)
 }
 
-func User_ShortName_Null() User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _null: true}
+func BucketMetainfo_PartnerId_Null() BucketMetainfo_PartnerId_Field {
+	return BucketMetainfo_PartnerId_Field{_set: true, _null: true}
 }
 
-func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_ShortName_Field) value() interface{} {
+func (f BucketMetainfo_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ShortName_Field) _Column() string { return "short_name" }
+func (BucketMetainfo_PartnerId_Field) _Column() string { return "partner_id" }
 
-type User_PasswordHash_Field struct {
+type BucketMetainfo_PathCipher_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
+	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func (f BucketMetainfo_PathCipher_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
 
-type User_Status_Field struct {
+type BucketMetainfo_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func User_Status(v int) User_Status_Field {
-	return User_Status_Field{_set: true, _value: v}
+func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
+	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f User_Status_Field) value() interface{} {
+func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Status_Field) _Column() string { return "status" }
+func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
 
-type User_PartnerId_Field struct {
+type BucketMetainfo_DefaultSegmentSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func User_PartnerId(v []byte) User_PartnerId_Field {
-	return User_PartnerId_Field{_set: true, _value: v}
-}
-
-func User_PartnerId_Raw(v []byte) User_PartnerId_Field {
-	if v == nil {
-		return User_PartnerId_Null()
-	}
-	return User_PartnerId(v)
+	_value int
 }
 
-func User_PartnerId_Null() User_PartnerId_Field {
-	return User_PartnerId_Field{_set: true, _null: true}
+func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
+	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
 }
 
-func (f User_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_PartnerId_Field) value() interface{} {
+func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PartnerId_Field) _Column() string { return "partner_id" }
+func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
 
-type User_CreatedAt_Field struct {
+type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func User_CreatedAt(v time.Time) User_CreatedAt_Field {
-	return User_CreatedAt_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
+	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
 }
 
-func (f User_CreatedAt_Field) value() interface{} {
+func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
+	return "default_encryption_cipher_suite"
+}
 
-type User_ProjectLimit_Field struct {
+type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func User_ProjectLimit(v int) User_ProjectLimit_Field {
-	return User_ProjectLimit_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
+	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
 }
 
-func (f User_ProjectLimit_Field) value() interface{} {
+func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectLimit_Field) _Column() string { return "project_limit" }
+func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
+	return "default_encryption_block_size"
+}
 
-type User_Position_Field struct {
+type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int
 }
 
-func User_Position(v string) User_Position_Field {
-	return User_Position_Field{_set: true, _value: &v}
+func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
+	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
 }
 
-func User_Position_Raw(v *string) User_Position_Field {
-	if v == nil {
-		return User_Position_Null()
+func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_Position(*v)
+	return f._value
 }
 
-func User_Position_Null() User_Position_Field {
-	return User_Position_Field{_set: true, _null: true}
+func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
+	return "default_redundancy_algorithm"
 }
 
-func (f User_Position_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
-func (f User_Position_Field) value() interface{} {
+func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
+	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Position_Field) _Column() string { return "position" }
+func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
+	return "default_redundancy_share_size"
+}
 
-type User_CompanyName_Field struct {
+type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int
 }
 
-func User_CompanyName(v string) User_CompanyName_Field {
-	return User_CompanyName_Field{_set: true, _value: &v}
+func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
+	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
 }
 
-func User_CompanyName_Raw(v *string) User_CompanyName_Field {
-	if v == nil {
-		return User_CompanyName_Null()
+func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_CompanyName(*v)
+	return f._value
 }
 
-func User_CompanyName_Null() User_CompanyName_Field {
-	return User_CompanyName_Field{_set: true, _null: true}
+func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
+	return "default_redundancy_required_shares"
 }
 
-func (f User_CompanyName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
-func (f User_CompanyName_Field) value() interface{} {
+func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
+	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CompanyName_Field) _Column() string { return "company_name" }
+func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
+	return "default_redundancy_repair_shares"
+}
 
-type User_CompanySize_Field struct {
+type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int
 }
 
-func User_CompanySize(v int) User_CompanySize_Field {
-	return User_CompanySize_Field{_set: true, _value: &v}
+func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
+	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
 }
 
-func User_CompanySize_Raw(v *int) User_CompanySize_Field {
-	if v == nil {
-		return User_CompanySize_Null()
+func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_CompanySize(*v)
+	return f._value
 }
 
-func User_CompanySize_Null() User_CompanySize_Field {
-	return User_CompanySize_Field{_set: true, _null: true}
+func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
+	return "default_redundancy_optimal_shares"
 }
 
-func (f User_CompanySize_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
 
-func (f User_CompanySize_Field) value() interface{} {
+func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
+	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+}
+
+func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CompanySize_Field) _Column() string { return "company_size" }
+func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
+	return "default_redundancy_total_shares"
+}
 
-type User_WorkingOn_Field struct {
+type BucketMetainfo_Versioning_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int
 }
 
-func User_WorkingOn(v string) User_WorkingOn_Field {
-	return User_WorkingOn_Field{_set: true, _value: &v}
+func BucketMetainfo_Versioning(v int) BucketMetainfo_Versioning_Field {
+	return BucketMetainfo_Versioning_Field{_set: true, _value: v}
 }
 
-func User_WorkingOn_Raw(v *string) User_WorkingOn_Field {
-	if v == nil {
-		return User_WorkingOn_Null()
+func (f BucketMetainfo_Versioning_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_WorkingOn(*v)
+	return f._value
 }
 
-func User_WorkingOn_Null() User_WorkingOn_Field {
-	return User_WorkingOn_Field{_set: true, _null: true}
+func (BucketMetainfo_Versioning_Field) _Column() string { return "versioning" }
+
+type BucketMetainfo_DefaultRetentionMode_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f User_WorkingOn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketMetainfo_DefaultRetentionMode(v int) BucketMetainfo_DefaultRetentionMode_Field {
+	return BucketMetainfo_DefaultRetentionMode_Field{_set: true, _value: v}
+}
 
-func (f User_WorkingOn_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRetentionMode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_WorkingOn_Field) _Column() string { return "working_on" }
+func (BucketMetainfo_DefaultRetentionMode_Field) _Column() string { return "default_retention_mode" }
 
-type User_IsProfessional_Field struct {
+type BucketMetainfo_DefaultRetentionDays_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value int
 }
 
-func User_IsProfessional(v bool) User_IsProfessional_Field {
-	return User_IsProfessional_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRetentionDays(v int) BucketMetainfo_DefaultRetentionDays_Field {
+	return BucketMetainfo_DefaultRetentionDays_Field{_set: true, _value: v}
 }
 
-func (f User_IsProfessional_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRetentionDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_IsProfessional_Field) _Column() string { return "is_professional" }
+func (BucketMetainfo_DefaultRetentionDays_Field) _Column() string { return "default_retention_days" }
 
-type User_EmployeeCount_Field struct {
+type BucketMetainfo_LifecycleRules_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func User_EmployeeCount(v string) User_EmployeeCount_Field {
-	return User_EmployeeCount_Field{_set: true, _value: &v}
+func BucketMetainfo_LifecycleRules(v []byte) BucketMetainfo_LifecycleRules_Field {
+	return BucketMetainfo_LifecycleRules_Field{_set: true, _value: v}
 }
 
-func User_EmployeeCount_Raw(v *string) User_EmployeeCount_Field {
+func BucketMetainfo_LifecycleRules_Raw(v []byte) BucketMetainfo_LifecycleRules_Field {
 	if v == nil {
-		return User_EmployeeCount_Null()
+		return BucketMetainfo_LifecycleRules_Null()
 	}
-	return User_EmployeeCount(*v)
+	return BucketMetainfo_LifecycleRules(v)
 }
 
-func User_EmployeeCount_Null() User_EmployeeCount_Field {
-	return User_EmployeeCount_Field{_set: true, _null: true}
+func BucketMetainfo_LifecycleRules_Null() BucketMetainfo_LifecycleRules_Field {
+	return BucketMetainfo_LifecycleRules_Field{_set: true, _null: true}
 }
 
-func (f User_EmployeeCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_LifecycleRules_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f User_EmployeeCount_Field) value() interface{} {
+func (f BucketMetainfo_LifecycleRules_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_EmployeeCount_Field) _Column() string { return "employee_count" }
-
-type ValueAttribution struct {
-	ProjectId   []byte
-	BucketName  []byte
-	PartnerId   []byte
-	LastUpdated time.Time
-}
-
-func (ValueAttribution) _Table() string { return "value_attributions" }
-
-type ValueAttribution_Update_Fields struct {
-}
+func (BucketMetainfo_LifecycleRules_Field) _Column() string { return "lifecycle_rules" }
 
-type ValueAttribution_ProjectId_Field struct {
+type BucketMetainfo_Placement_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ValueAttribution_ProjectId(v []byte) ValueAttribution_ProjectId_Field {
-	return ValueAttribution_ProjectId_Field{_set: true, _value: v}
+func BucketMetainfo_Placement(v []byte) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_ProjectId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_Placement_Raw(v []byte) BucketMetainfo_Placement_Field {
+	if v == nil {
+		return BucketMetainfo_Placement_Null()
 	}
-	return f._value
+	return BucketMetainfo_Placement(v)
 }
 
-func (ValueAttribution_ProjectId_Field) _Column() string { return "project_id" }
-
-type ValueAttribution_BucketName_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
 }
 
-func ValueAttribution_BucketName(v []byte) ValueAttribution_BucketName_Field {
-	return ValueAttribution_BucketName_Field{_set: true, _value: v}
-}
+func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ValueAttribution_BucketName_Field) value() interface{} {
+func (f BucketMetainfo_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
 
-type ValueAttribution_PartnerId_Field struct {
+type BucketMetainfo_StorageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func ValueAttribution_PartnerId(v []byte) ValueAttribution_PartnerId_Field {
-	return ValueAttribution_PartnerId_Field{_set: true, _value: v}
+func BucketMetainfo_StorageLimit(v int64) BucketMetainfo_StorageLimit_Field {
+	return BucketMetainfo_StorageLimit_Field{_set: true, _value: &v}
 }
 
-func (f ValueAttribution_PartnerId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_StorageLimit_Raw(v *int64) BucketMetainfo_StorageLimit_Field {
+	if v == nil {
+		return BucketMetainfo_StorageLimit_Null()
 	}
-	return f._value
+	return BucketMetainfo_StorageLimit(*v)
 }
 
-func (ValueAttribution_PartnerId_Field) _Column() string { return "partner_id" }
-
-type ValueAttribution_LastUpdated_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func BucketMetainfo_StorageLimit_Null() BucketMetainfo_StorageLimit_Field {
+	return BucketMetainfo_StorageLimit_Field{_set: true, _null: true}
 }
 
-func ValueAttribution_LastUpdated(v time.Time) ValueAttribution_LastUpdated_Field {
-	return ValueAttribution_LastUpdated_Field{_set: true, _value: v}
+func (f BucketMetainfo_StorageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f ValueAttribution_LastUpdated_Field) value() interface{} {
+func (f BucketMetainfo_StorageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_LastUpdated_Field) _Column() string { return "last_updated" }
+func (BucketMetainfo_StorageLimit_Field) _Column() string { return "storage_limit" }
 
-type ApiKey struct {
-	Id        []byte
-	ProjectId []byte
-	Head      []byte
-	Name      string
-	Secret    []byte
-	PartnerId []byte
-	CreatedAt time.Time
+type BucketMetainfo_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
 }
 
-func (ApiKey) _Table() string { return "api_keys" }
-
-type ApiKey_Create_Fields struct {
-	PartnerId ApiKey_PartnerId_Field
+func BucketMetainfo_BandwidthLimit(v int64) BucketMetainfo_BandwidthLimit_Field {
+	return BucketMetainfo_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-type ApiKey_Update_Fields struct {
-	Name ApiKey_Name_Field
+func BucketMetainfo_BandwidthLimit_Raw(v *int64) BucketMetainfo_BandwidthLimit_Field {
+	if v == nil {
+		return BucketMetainfo_BandwidthLimit_Null()
+	}
+	return BucketMetainfo_BandwidthLimit(*v)
 }
 
-type ApiKey_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func BucketMetainfo_BandwidthLimit_Null() BucketMetainfo_BandwidthLimit_Field {
+	return BucketMetainfo_BandwidthLimit_Field{_set: true, _null: true}
 }
 
-func ApiKey_Id(v []byte) ApiKey_Id_Field {
-	return ApiKey_Id_Field{_set: true, _value: v}
+func (f BucketMetainfo_BandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f ApiKey_Id_Field) value() interface{} {
+func (f BucketMetainfo_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Id_Field) _Column() string { return "id" }
+func (BucketMetainfo_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type ApiKey_ProjectId_Field struct {
+type BucketMetainfo_ObjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
-	return ApiKey_ProjectId_Field{_set: true, _value: v}
+func BucketMetainfo_ObjectLimit(v int64) BucketMetainfo_ObjectLimit_Field {
+	return BucketMetainfo_ObjectLimit_Field{_set: true, _value: &v}
 }
 
-func (f ApiKey_ProjectId_Field) value() interface{} {
+func BucketMetainfo_ObjectLimit_Raw(v *int64) BucketMetainfo_ObjectLimit_Field {
+	if v == nil {
+		return BucketMetainfo_ObjectLimit_Null()
+	}
+	return BucketMetainfo_ObjectLimit(*v)
+}
+
+func BucketMetainfo_ObjectLimit_Null() BucketMetainfo_ObjectLimit_Field {
+	return BucketMetainfo_ObjectLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_ObjectLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_ObjectLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketMetainfo_ObjectLimit_Field) _Column() string { return "object_limit" }
 
-type ApiKey_Head_Field struct {
+type BucketMetainfo_SegmentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int64
 }
 
-func ApiKey_Head(v []byte) ApiKey_Head_Field {
-	return ApiKey_Head_Field{_set: true, _value: v}
+func BucketMetainfo_SegmentLimit(v int64) BucketMetainfo_SegmentLimit_Field {
+	return BucketMetainfo_SegmentLimit_Field{_set: true, _value: &v}
 }
 
-func (f ApiKey_Head_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_SegmentLimit_Raw(v *int64) BucketMetainfo_SegmentLimit_Field {
+	if v == nil {
+		return BucketMetainfo_SegmentLimit_Null()
 	}
-	return f._value
+	return BucketMetainfo_SegmentLimit(*v)
 }
 
-func (ApiKey_Head_Field) _Column() string { return "head" }
-
-type ApiKey_Name_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func BucketMetainfo_SegmentLimit_Null() BucketMetainfo_SegmentLimit_Field {
+	return BucketMetainfo_SegmentLimit_Field{_set: true, _null: true}
 }
 
-func ApiKey_Name(v string) ApiKey_Name_Field {
-	return ApiKey_Name_Field{_set: true, _value: v}
+func (f BucketMetainfo_SegmentLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f ApiKey_Name_Field) value() interface{} {
+func (f BucketMetainfo_SegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Name_Field) _Column() string { return "name" }
+func (BucketMetainfo_SegmentLimit_Field) _Column() string { return "segment_limit" }
 
-type ApiKey_Secret_Field struct {
+type ProjectMember struct {
+	MemberId  []byte
+	ProjectId []byte
+	CreatedAt time.Time
+	Role      int
+}
+
+func (ProjectMember) _Table() string { return "project_members" }
+
+type ProjectMember_Create_Fields struct {
+	Role ProjectMember_Role_Field
+}
+
+type ProjectMember_Update_Fields struct {
+	Role ProjectMember_Role_Field
+}
+
+type ProjectMember_MemberId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
-	return ApiKey_Secret_Field{_set: true, _value: v}
+func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
+	return ProjectMember_MemberId_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Secret_Field) value() interface{} {
+func (f ProjectMember_MemberId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Secret_Field) _Column() string { return "secret" }
+func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
 
-type ApiKey_PartnerId_Field struct {
+type ProjectMember_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ApiKey_PartnerId(v []byte) ApiKey_PartnerId_Field {
-	return ApiKey_PartnerId_Field{_set: true, _value: v}
+func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
+	return ProjectMember_ProjectId_Field{_set: true, _value: v}
 }
 
-func ApiKey_PartnerId_Raw(v []byte) ApiKey_PartnerId_Field {
-	if v == nil {
-		return ApiKey_PartnerId_Null()
+func (f ProjectMember_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return ApiKey_PartnerId(v)
+	return f._value
 }
 
-func ApiKey_PartnerId_Null() ApiKey_PartnerId_Field {
-	return ApiKey_PartnerId_Field{_set: true, _null: true}
+func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectMember_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f ApiKey_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
+	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f ApiKey_PartnerId_Field) value() interface{} {
+func (f ProjectMember_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_PartnerId_Field) _Column() string { return "partner_id" }
+func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ApiKey_CreatedAt_Field struct {
+type ProjectMember_Role_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
-	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+func ProjectMember_Role(v int) ProjectMember_Role_Field {
+	return ProjectMember_Role_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_CreatedAt_Field) value() interface{} {
+func (f ProjectMember_Role_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectMember_Role_Field) _Column() string { return "role" }
 
-type BucketMetainfo struct {
-	Id                              []byte
-	ProjectId                       []byte
-	Name                            []byte
-	PartnerId                       []byte
-	PathCipher                      int
-	CreatedAt                       time.Time
-	DefaultSegmentSize              int
-	DefaultEncryptionCipherSuite    int
-	DefaultEncryptionBlockSize      int
-	DefaultRedundancyAlgorithm      int
-	DefaultRedundancyShareSize      int
-	DefaultRedundancyRequiredShares int
-	DefaultRedundancyRepairShares   int
-	DefaultRedundancyOptimalShares  int
-	DefaultRedundancyTotalShares    int
+type ProjectUsageAlert struct {
+	ProjectId []byte
+	Kind      string
+	Threshold int64
+	CreatedAt time.Time
 }
 
-func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
-
-type BucketMetainfo_Create_Fields struct {
-	PartnerId BucketMetainfo_PartnerId_Field
-}
+func (ProjectUsageAlert) _Table() string { return "project_usage_alerts" }
 
-type BucketMetainfo_Update_Fields struct {
-	PartnerId                       BucketMetainfo_PartnerId_Field
-	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
-	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
-	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
-	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
-	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
-	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
-	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
-	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
-	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
+type ProjectUsageAlert_Update_Fields struct {
 }
 
-type BucketMetainfo_Id_Field struct {
+type ProjectUsageAlert_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
-	return BucketMetainfo_Id_Field{_set: true, _value: v}
+func ProjectUsageAlert_ProjectId(v []byte) ProjectUsageAlert_ProjectId_Field {
+	return ProjectUsageAlert_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_Id_Field) value() interface{} {
+func (f ProjectUsageAlert_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Id_Field) _Column() string { return "id" }
+func (ProjectUsageAlert_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketMetainfo_ProjectId_Field struct {
+type ProjectUsageAlert_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
-	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
+func ProjectUsageAlert_Kind(v string) ProjectUsageAlert_Kind_Field {
+	return ProjectUsageAlert_Kind_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_ProjectId_Field) value() interface{} {
+func (f ProjectUsageAlert_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectUsageAlert_Kind_Field) _Column() string { return "kind" }
 
-type BucketMetainfo_Name_Field struct {
+type ProjectUsageAlert_Threshold_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
-	return BucketMetainfo_Name_Field{_set: true, _value: v}
+func ProjectUsageAlert_Threshold(v int64) ProjectUsageAlert_Threshold_Field {
+	return ProjectUsageAlert_Threshold_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_Name_Field) value() interface{} {
+func (f ProjectUsageAlert_Threshold_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Name_Field) _Column() string { return "name" }
+func (ProjectUsageAlert_Threshold_Field) _Column() string { return "threshold" }
 
-type BucketMetainfo_PartnerId_Field struct {
+type ProjectUsageAlert_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketMetainfo_PartnerId(v []byte) BucketMetainfo_PartnerId_Field {
-	return BucketMetainfo_PartnerId_Field{_set: true, _value: v}
+func ProjectUsageAlert_CreatedAt(v time.Time) ProjectUsageAlert_CreatedAt_Field {
+	return ProjectUsageAlert_CreatedAt_Field{_set: true, _value: v}
 }
 
-func BucketMetainfo_PartnerId_Raw(v []byte) BucketMetainfo_PartnerId_Field {
-	if v == nil {
-		return BucketMetainfo_PartnerId_Null()
+func (f ProjectUsageAlert_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return BucketMetainfo_PartnerId(v)
+	return f._value
 }
 
-func BucketMetainfo_PartnerId_Null() BucketMetainfo_PartnerId_Field {
-	return BucketMetainfo_PartnerId_Field{_set: true, _null: true}
+func (ProjectUsageAlert_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectUsageNotification struct {
+	Id          []byte
+	ProjectId   []byte
+	Kind        string
+	Threshold   int64
+	PeriodStart time.Time
+	Used        int64
+	UsageLimit  int64
+	CreatedAt   time.Time
+	SentAt      *time.Time
 }
 
-func (f BucketMetainfo_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (ProjectUsageNotification) _Table() string { return "project_usage_notifications" }
 
-func (f BucketMetainfo_PartnerId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type ProjectUsageNotification_Create_Fields struct {
+	SentAt ProjectUsageNotification_SentAt_Field
 }
 
-func (BucketMetainfo_PartnerId_Field) _Column() string { return "partner_id" }
+type ProjectUsageNotification_Update_Fields struct {
+	SentAt ProjectUsageNotification_SentAt_Field
+}
 
-type BucketMetainfo_PathCipher_Field struct {
+type ProjectUsageNotification_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
-	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
+func ProjectUsageNotification_Id(v []byte) ProjectUsageNotification_Id_Field {
+	return ProjectUsageNotification_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_PathCipher_Field) value() interface{} {
+func (f ProjectUsageNotification_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
+func (ProjectUsageNotification_Id_Field) _Column() string { return "id" }
 
-type BucketMetainfo_CreatedAt_Field struct {
+type ProjectUsageNotification_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
-	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
+func ProjectUsageNotification_ProjectId(v []byte) ProjectUsageNotification_ProjectId_Field {
+	return ProjectUsageNotification_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
+func (f ProjectUsageNotification_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectUsageNotification_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketMetainfo_DefaultSegmentSize_Field struct {
+type ProjectUsageNotification_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
-	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
+func ProjectUsageNotification_Kind(v string) ProjectUsageNotification_Kind_Field {
+	return ProjectUsageNotification_Kind_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
+func (f ProjectUsageNotification_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
+func (ProjectUsageNotification_Kind_Field) _Column() string { return "kind" }
 
-type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
+type ProjectUsageNotification_Threshold_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
-	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
+func ProjectUsageNotification_Threshold(v int64) ProjectUsageNotification_Threshold_Field {
+	return ProjectUsageNotification_Threshold_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+func (f ProjectUsageNotification_Threshold_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
-	return "default_encryption_cipher_suite"
-}
+func (ProjectUsageNotification_Threshold_Field) _Column() string { return "threshold" }
 
-type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+type ProjectUsageNotification_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
-	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+func ProjectUsageNotification_PeriodStart(v time.Time) ProjectUsageNotification_PeriodStart_Field {
+	return ProjectUsageNotification_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
+func (f ProjectUsageNotification_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
-	return "default_encryption_block_size"
-}
+func (ProjectUsageNotification_PeriodStart_Field) _Column() string { return "period_start" }
 
-type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
+type ProjectUsageNotification_Used_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
-	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
+func ProjectUsageNotification_Used(v int64) ProjectUsageNotification_Used_Field {
+	return ProjectUsageNotification_Used_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+func (f ProjectUsageNotification_Used_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
-	return "default_redundancy_algorithm"
-}
+func (ProjectUsageNotification_Used_Field) _Column() string { return "used" }
 
-type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+type ProjectUsageNotification_UsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
-	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+func ProjectUsageNotification_UsageLimit(v int64) ProjectUsageNotification_UsageLimit_Field {
+	return ProjectUsageNotification_UsageLimit_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
+func (f ProjectUsageNotification_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
-	return "default_redundancy_share_size"
-}
+func (ProjectUsageNotification_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
+type ProjectUsageNotification_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
-	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
+func ProjectUsageNotification_CreatedAt(v time.Time) ProjectUsageNotification_CreatedAt_Field {
+	return ProjectUsageNotification_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+func (f ProjectUsageNotification_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
-	return "default_redundancy_required_shares"
-}
+func (ProjectUsageNotification_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+type ProjectUsageNotification_SentAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
-}
-
-func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
-	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+	_value *time.Time
 }
 
-func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+func ProjectUsageNotification_SentAt(v time.Time) ProjectUsageNotification_SentAt_Field {
+	return ProjectUsageNotification_SentAt_Field{_set: true, _value: &v}
 }
 
-func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
-	return "default_redundancy_repair_shares"
+func ProjectUsageNotification_SentAt_Raw(v *time.Time) ProjectUsageNotification_SentAt_Field {
+	if v == nil {
+		return ProjectUsageNotification_SentAt_Null()
+	}
+	return ProjectUsageNotification_SentAt(*v)
 }
 
-type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func ProjectUsageNotification_SentAt_Null() ProjectUsageNotification_SentAt_Field {
+	return ProjectUsageNotification_SentAt_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
-	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+func (f ProjectUsageNotification_SentAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+func (f ProjectUsageNotification_SentAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
-	return "default_redundancy_optimal_shares"
+func (ProjectUsageNotification_SentAt_Field) _Column() string { return "sent_at" }
+
+type SsoIdentity struct {
+	Issuer    string
+	Subject   string
+	UserId    []byte
+	CreatedAt time.Time
 }
 
-type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+func (SsoIdentity) _Table() string { return "sso_identities" }
+
+type SsoIdentity_Update_Fields struct {
+}
+
+type SsoIdentity_Issuer_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
-	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+func SsoIdentity_Issuer(v string) SsoIdentity_Issuer_Field {
+	return SsoIdentity_Issuer_Field{_set: true, _value: v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+func (f SsoIdentity_Issuer_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
-	return "default_redundancy_total_shares"
-}
-
-type ProjectMember struct {
-	MemberId  []byte
-	ProjectId []byte
-	CreatedAt time.Time
-}
-
-func (ProjectMember) _Table() string { return "project_members" }
-
-type ProjectMember_Update_Fields struct {
-}
+func (SsoIdentity_Issuer_Field) _Column() string { return "issuer" }
 
-type ProjectMember_MemberId_Field struct {
+type SsoIdentity_Subject_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func ProjectMember_MemberId(v []byte) ProjectMember_MemberId_Field {
-	return ProjectMember_MemberId_Field{_set: true, _value: v}
+func SsoIdentity_Subject(v string) SsoIdentity_Subject_Field {
+	return SsoIdentity_Subject_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_MemberId_Field) value() interface{} {
+func (f SsoIdentity_Subject_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_MemberId_Field) _Column() string { return "member_id" }
+func (SsoIdentity_Subject_Field) _Column() string { return "subject" }
 
-type ProjectMember_ProjectId_Field struct {
+type SsoIdentity_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ProjectMember_ProjectId(v []byte) ProjectMember_ProjectId_Field {
-	return ProjectMember_ProjectId_Field{_set: true, _value: v}
+func SsoIdentity_UserId(v []byte) SsoIdentity_UserId_Field {
+	return SsoIdentity_UserId_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_ProjectId_Field) value() interface{} {
+func (f SsoIdentity_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
+func (SsoIdentity_UserId_Field) _Column() string { return "user_id" }
 
-type ProjectMember_CreatedAt_Field struct {
+type SsoIdentity_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ProjectMember_CreatedAt(v time.Time) ProjectMember_CreatedAt_Field {
-	return ProjectMember_CreatedAt_Field{_set: true, _value: v}
+func SsoIdentity_CreatedAt(v time.Time) SsoIdentity_CreatedAt_Field {
+	return SsoIdentity_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f ProjectMember_CreatedAt_Field) value() interface{} {
+func (f SsoIdentity_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectMember_CreatedAt_Field) _Column() string { return "created_at" }
+func (SsoIdentity_CreatedAt_Field) _Column() string { return "created_at" }
 
 type StripecoinpaymentsApplyBalanceIntent struct {
 	TxId      string
@@ -9118,6 +11389,11 @@ type CustomerId_Row struct {
 	CustomerId string
 }
 
+type DefaultRetentionMode_DefaultRetentionDays_Row struct {
+	DefaultRetentionMode int
+	DefaultRetentionDays int
+}
+
 type Id_PieceCount_Row struct {
 	Id         []byte
 	PieceCount int64
@@ -9131,6 +11407,10 @@ type LeafSerialNumber_Row struct {
 	LeafSerialNumber []byte
 }
 
+type LifecycleRules_Row struct {
+	LifecycleRules []byte
+}
+
 type MaxBuckets_Row struct {
 	MaxBuckets *int
 }
@@ -9184,18 +11464,37 @@ type Paged_StoragenodeBandwidthRollup_By_StoragenodeId_And_IntervalStart_Greater
 	_set                  bool
 }
 
+type Placement_Row struct {
+	Placement []byte
+}
+
 type ProjectLimit_Row struct {
 	ProjectLimit int
 }
 
+type StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row struct {
+	StorageLimit   *int64
+	BandwidthLimit *int64
+	ObjectLimit    *int64
+	SegmentLimit   *int64
+}
+
 type UsageLimit_Row struct {
 	UsageLimit *int64
 }
 
+type UserId_Row struct {
+	UserId []byte
+}
+
 type Value_Row struct {
 	Value time.Time
 }
 
+type Versioning_Row struct {
+	Versioning int
+}
+
 func (obj *pgxImpl) Create_ValueAttribution(ctx context.Context,
 	value_attribution_project_id ValueAttribution_ProjectId_Field,
 	value_attribution_bucket_name ValueAttribution_BucketName_Field,
@@ -9256,6 +11555,32 @@ func (obj *pgxImpl) CreateNoReturn_Irreparabledb(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) CreateNoReturn_ReportedLostPiece(ctx context.Context,
+	reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+	reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field,
+	reported_lost_piece_reported_at ReportedLostPiece_ReportedAt_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := reported_lost_piece_node_id.value()
+	__piece_id_val := reported_lost_piece_piece_id.value()
+	__reported_at_val := reported_lost_piece_reported_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reported_lost_pieces ( node_id, piece_id, reported_at ) VALUES ( ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __piece_id_val, __reported_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) CreateNoReturn_AccountingTimestamps(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field,
 	accounting_timestamps_value AccountingTimestamps_Value_Field) (
@@ -9330,15 +11655,18 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 	__company_size_val := optional.CompanySize.value()
 	__working_on_val := optional.WorkingOn.value()
 	__employee_count_val := optional.EmployeeCount.value()
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
+	__mfa_locked_until_val := optional.MfaLockedUntil.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, status, partner_id, created_at, position, company_name, company_size, working_on, employee_count")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, status, partner_id, created_at, position, company_name, company_size, working_on, employee_count, mfa_secret_key, mfa_recovery_codes, mfa_locked_until")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __partner_id_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val)
+	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __partner_id_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_locked_until_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -9355,6 +11683,24 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
 	}
 
+	if optional.MfaEnabled._set {
+		__values = append(__values, optional.MfaEnabled.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("mfa_enabled"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.MfaLastCounter._set {
+		__values = append(__values, optional.MfaLastCounter.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("mfa_last_counter"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.MfaFailedAttempts._set {
+		__values = append(__values, optional.MfaFailedAttempts.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("mfa_failed_attempts"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
 	if len(__optional_columns.SQLs) == 0 {
 		if __columns.SQL == nil {
 			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
@@ -9367,7 +11713,7 @@ func (obj *pgxImpl) Create_User(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9415,7 +11761,8 @@ func (obj *pgxImpl) Create_Project(ctx context.Context,
 
 func (obj *pgxImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	optional ProjectMember_Create_Fields) (
 	project_member *ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -9424,16 +11771,37 @@ func (obj *pgxImpl) Create_ProjectMember(ctx context.Context,
 	__project_id_val := project_member_project_id.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("member_id, project_id, created_at")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO project_members "), __clause, __sqlbundle_Literal(" RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
 
 	var __values []interface{}
 	__values = append(__values, __member_id_val, __project_id_val, __created_at_val)
 
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.Role._set {
+		__values = append(__values, optional.Role.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("role"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	project_member = &ProjectMember{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9441,6 +11809,103 @@ func (obj *pgxImpl) Create_ProjectMember(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) CreateNoReturn_SsoIdentity(ctx context.Context,
+	sso_identity_issuer SsoIdentity_Issuer_Field,
+	sso_identity_subject SsoIdentity_Subject_Field,
+	sso_identity_user_id SsoIdentity_UserId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__issuer_val := sso_identity_issuer.value()
+	__subject_val := sso_identity_subject.value()
+	__user_id_val := sso_identity_user_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO sso_identities ( issuer, subject, user_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __issuer_val, __subject_val, __user_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) CreateNoReturn_ProjectUsageAlert(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field,
+	project_usage_alert_kind ProjectUsageAlert_Kind_Field,
+	project_usage_alert_threshold ProjectUsageAlert_Threshold_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_usage_alert_project_id.value()
+	__kind_val := project_usage_alert_kind.value()
+	__threshold_val := project_usage_alert_threshold.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_usage_alerts ( project_id, kind, threshold, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __project_id_val, __kind_val, __threshold_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) CreateNoReturn_ProjectUsageNotification(ctx context.Context,
+	project_usage_notification_id ProjectUsageNotification_Id_Field,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	project_usage_notification_kind ProjectUsageNotification_Kind_Field,
+	project_usage_notification_threshold ProjectUsageNotification_Threshold_Field,
+	project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field,
+	project_usage_notification_used ProjectUsageNotification_Used_Field,
+	project_usage_notification_usage_limit ProjectUsageNotification_UsageLimit_Field,
+	optional ProjectUsageNotification_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_usage_notification_id.value()
+	__project_id_val := project_usage_notification_project_id.value()
+	__kind_val := project_usage_notification_kind.value()
+	__threshold_val := project_usage_notification_threshold.value()
+	__period_start_val := project_usage_notification_period_start.value()
+	__used_val := project_usage_notification_used.value()
+	__usage_limit_val := project_usage_notification_usage_limit.value()
+	__created_at_val := __now
+	__sent_at_val := optional.SentAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_usage_notifications ( id, project_id, kind, threshold, period_start, used, usage_limit, created_at, sent_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __project_id_val, __kind_val, __threshold_val, __period_start_val, __used_val, __usage_limit_val, __created_at_val, __sent_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
@@ -9459,17 +11924,19 @@ func (obj *pgxImpl) Create_ApiKey(ctx context.Context,
 	__secret_val := api_key_secret.value()
 	__partner_id_val := optional.PartnerId.value()
 	__created_at_val := __now
+	__rate_limit_val := optional.RateLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, created_at, rate_limit, bandwidth_limit ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __created_at_val)
+	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __created_at_val, __rate_limit_val, __bandwidth_limit_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -9592,6 +12059,52 @@ func (obj *pgxImpl) Create_StoragenodeBandwidthRollup(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) CreateNoReturn_StoragenodeSettlementOutcome(ctx context.Context,
+	storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+	storagenode_settlement_outcome_interval_start StoragenodeSettlementOutcome_IntervalStart_Field,
+	storagenode_settlement_outcome_settled_at StoragenodeSettlementOutcome_SettledAt_Field,
+	storagenode_settlement_outcome_status StoragenodeSettlementOutcome_Status_Field,
+	storagenode_settlement_outcome_already_processed StoragenodeSettlementOutcome_AlreadyProcessed_Field,
+	storagenode_settlement_outcome_orders_received StoragenodeSettlementOutcome_OrdersReceived_Field,
+	storagenode_settlement_outcome_orders_accepted StoragenodeSettlementOutcome_OrdersAccepted_Field,
+	storagenode_settlement_outcome_orders_unknown_bucket StoragenodeSettlementOutcome_OrdersUnknownBucket_Field,
+	storagenode_settlement_outcome_amount_settled StoragenodeSettlementOutcome_AmountSettled_Field,
+	storagenode_settlement_outcome_rejected_expired StoragenodeSettlementOutcome_RejectedExpired_Field,
+	storagenode_settlement_outcome_rejected_bad_signature StoragenodeSettlementOutcome_RejectedBadSignature_Field,
+	storagenode_settlement_outcome_rejected_already_settled StoragenodeSettlementOutcome_RejectedAlreadySettled_Field,
+	storagenode_settlement_outcome_rejected_invalid StoragenodeSettlementOutcome_RejectedInvalid_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__storagenode_id_val := storagenode_settlement_outcome_storagenode_id.value()
+	__interval_start_val := storagenode_settlement_outcome_interval_start.value()
+	__settled_at_val := storagenode_settlement_outcome_settled_at.value()
+	__status_val := storagenode_settlement_outcome_status.value()
+	__already_processed_val := storagenode_settlement_outcome_already_processed.value()
+	__orders_received_val := storagenode_settlement_outcome_orders_received.value()
+	__orders_accepted_val := storagenode_settlement_outcome_orders_accepted.value()
+	__orders_unknown_bucket_val := storagenode_settlement_outcome_orders_unknown_bucket.value()
+	__amount_settled_val := storagenode_settlement_outcome_amount_settled.value()
+	__rejected_expired_val := storagenode_settlement_outcome_rejected_expired.value()
+	__rejected_bad_signature_val := storagenode_settlement_outcome_rejected_bad_signature.value()
+	__rejected_already_settled_val := storagenode_settlement_outcome_rejected_already_settled.value()
+	__rejected_invalid_val := storagenode_settlement_outcome_rejected_invalid.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO storagenode_settlement_outcomes ( storagenode_id, interval_start, settled_at, status, already_processed, orders_received, orders_accepted, orders_unknown_bucket, amount_settled, rejected_expired, rejected_bad_signature, rejected_already_settled, rejected_invalid ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __storagenode_id_val, __interval_start_val, __settled_at_val, __status_val, __already_processed_val, __orders_received_val, __orders_accepted_val, __orders_unknown_bucket_val, __amount_settled_val, __rejected_expired_val, __rejected_bad_signature_val, __rejected_already_settled_val, __rejected_invalid_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxImpl) ReplaceNoReturn_StoragenodePaystub(ctx context.Context,
 	storagenode_paystub_period StoragenodePaystub_Period_Field,
 	storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
@@ -9810,17 +12323,56 @@ func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_repair_shares_val := bucket_metainfo_default_redundancy_repair_shares.value()
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
+	__lifecycle_rules_val := optional.LifecycleRules.value()
+	__placement_val := optional.Placement.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__object_limit_val := optional.ObjectLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, lifecycle_rules, placement, storage_limit, bandwidth_limit, object_limit, segment_limit")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_metainfos "), __clause, __sqlbundle_Literal(" RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __lifecycle_rules_val, __placement_val, __storage_limit_val, __bandwidth_limit_val, __object_limit_val, __segment_limit_val)
 
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.Versioning._set {
+		__values = append(__values, optional.Versioning.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("versioning"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.DefaultRetentionMode._set {
+		__values = append(__values, optional.DefaultRetentionMode.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("default_retention_mode"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.DefaultRetentionDays._set {
+		__values = append(__values, optional.DefaultRetentionDays.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("default_retention_days"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -10070,31 +12622,100 @@ func (obj *pgxImpl) Create_CouponUsage(ctx context.Context,
 
 }
 
-func (obj *pgxImpl) ReplaceNoReturn_NodeApiVersion(ctx context.Context,
-	node_api_version_id NodeApiVersion_Id_Field,
-	node_api_version_api_version NodeApiVersion_ApiVersion_Field) (
-	err error) {
+func (obj *pgxImpl) ReplaceNoReturn_NodeApiVersion(ctx context.Context,
+	node_api_version_id NodeApiVersion_Id_Field,
+	node_api_version_api_version NodeApiVersion_ApiVersion_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_api_version_id.value()
+	__api_version_val := node_api_version_api_version.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_api_versions ( id, api_version, created_at, updated_at ) VALUES ( ?, ?, ?, ? ) ON CONFLICT ( id ) DO UPDATE SET id = EXCLUDED.id, api_version = EXCLUDED.api_version, created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __api_version_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) CreateNoReturn_AdminToken(ctx context.Context,
+	admin_token_id AdminToken_Id_Field,
+	admin_token_name AdminToken_Name_Field,
+	admin_token_scopes AdminToken_Scopes_Field,
+	admin_token_secret_hash AdminToken_SecretHash_Field,
+	admin_token_created_at AdminToken_CreatedAt_Field,
+	optional AdminToken_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__id_val := admin_token_id.value()
+	__name_val := admin_token_name.value()
+	__scopes_val := admin_token_scopes.value()
+	__secret_hash_val := admin_token_secret_hash.value()
+	__created_at_val := admin_token_created_at.value()
+	__expires_at_val := optional.ExpiresAt.value()
+	__revoked_at_val := optional.RevokedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_tokens ( id, name, scopes, secret_hash, created_at, expires_at, revoked_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __name_val, __scopes_val, __secret_hash_val, __created_at_val, __expires_at_val, __revoked_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) Create_AdminAuditLog(ctx context.Context,
+	admin_audit_log_created_at AdminAuditLog_CreatedAt_Field,
+	admin_audit_log_actor AdminAuditLog_Actor_Field,
+	admin_audit_log_method AdminAuditLog_Method_Field,
+	admin_audit_log_path AdminAuditLog_Path_Field,
+	admin_audit_log_details AdminAuditLog_Details_Field,
+	optional AdminAuditLog_Create_Fields) (
+	admin_audit_log *AdminAuditLog, err error) {
 	defer mon.Task()(&ctx)(&err)
+	__created_at_val := admin_audit_log_created_at.value()
+	__actor_val := admin_audit_log_actor.value()
+	__token_id_val := optional.TokenId.value()
+	__method_val := admin_audit_log_method.value()
+	__path_val := admin_audit_log_path.value()
+	__user_email_val := optional.UserEmail.value()
+	__project_id_val := optional.ProjectId.value()
+	__details_val := admin_audit_log_details.value()
+	__status_code_val := optional.StatusCode.value()
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := node_api_version_id.value()
-	__api_version_val := node_api_version_api_version.value()
-	__created_at_val := __now
-	__updated_at_val := __now
-
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_api_versions ( id, api_version, created_at, updated_at ) VALUES ( ?, ?, ?, ? ) ON CONFLICT ( id ) DO UPDATE SET id = EXCLUDED.id, api_version = EXCLUDED.api_version, created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_audit_logs ( created_at, actor, token_id, method, path, user_email, project_id, details, status_code ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING admin_audit_logs.id, admin_audit_logs.created_at, admin_audit_logs.actor, admin_audit_logs.token_id, admin_audit_logs.method, admin_audit_logs.path, admin_audit_logs.user_email, admin_audit_logs.project_id, admin_audit_logs.details, admin_audit_logs.status_code")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __api_version_val, __created_at_val, __updated_at_val)
+	__values = append(__values, __created_at_val, __actor_val, __token_id_val, __method_val, __path_val, __user_email_val, __project_id_val, __details_val, __status_code_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	admin_audit_log = &AdminAuditLog{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_audit_log.Id, &admin_audit_log.CreatedAt, &admin_audit_log.Actor, &admin_audit_log.TokenId, &admin_audit_log.Method, &admin_audit_log.Path, &admin_audit_log.UserEmail, &admin_audit_log.ProjectId, &admin_audit_log.Details, &admin_audit_log.StatusCode)
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return admin_audit_log, nil
 
 }
 
@@ -10214,6 +12835,166 @@ func (obj *pgxImpl) Limited_Irreparabledb_By_Segmentpath_Greater_OrderBy_Asc_Seg
 
 }
 
+func (obj *pgxImpl) Has_ReportedLostPiece_By_NodeId_And_PieceId(ctx context.Context,
+	reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+	reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field) (
+	has bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT EXISTS( SELECT 1 FROM reported_lost_pieces WHERE reported_lost_pieces.node_id = ? AND reported_lost_pieces.piece_id = ? )")
+
+	var __values []interface{}
+	__values = append(__values, reported_lost_piece_node_id.value(), reported_lost_piece_piece_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&has)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+	return has, nil
+
+}
+
+func (obj *pgxImpl) Limited_ReportedLostPiece_OrderBy_Asc_ReportedAt(ctx context.Context,
+	limit int, offset int64) (
+	rows []*ReportedLostPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT reported_lost_pieces.node_id, reported_lost_pieces.piece_id, reported_lost_pieces.reported_at FROM reported_lost_pieces ORDER BY reported_lost_pieces.reported_at LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ReportedLostPiece, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				reported_lost_piece := &ReportedLostPiece{}
+				err = __rows.Scan(&reported_lost_piece.NodeId, &reported_lost_piece.PieceId, &reported_lost_piece.ReportedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, reported_lost_piece)
+			}
+			err = __rows.Err()
+			if err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_DurabilityHistogram_By_ProjectId(ctx context.Context,
+	durability_histogram_project_id DurabilityHistogram_ProjectId_Field) (
+	rows []*DurabilityHistogram, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT durability_histograms.project_id, durability_histograms.bucket_name, durability_histograms.required_shares, durability_histograms.repair_shares, durability_histograms.optimal_shares, durability_histograms.total_shares, durability_histograms.healthy_counts, durability_histograms.computed_at FROM durability_histograms WHERE durability_histograms.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, durability_histogram_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*DurabilityHistogram, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				durability_histogram := &DurabilityHistogram{}
+				err = __rows.Scan(&durability_histogram.ProjectId, &durability_histogram.BucketName, &durability_histogram.RequiredShares, &durability_histogram.RepairShares, &durability_histogram.OptimalShares, &durability_histogram.TotalShares, &durability_histogram.HealthyCounts, &durability_histogram.ComputedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, durability_histogram)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_DurabilityHistogram_By_ProjectId_And_BucketName(ctx context.Context,
+	durability_histogram_project_id DurabilityHistogram_ProjectId_Field,
+	durability_histogram_bucket_name DurabilityHistogram_BucketName_Field) (
+	rows []*DurabilityHistogram, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT durability_histograms.project_id, durability_histograms.bucket_name, durability_histograms.required_shares, durability_histograms.repair_shares, durability_histograms.optimal_shares, durability_histograms.total_shares, durability_histograms.healthy_counts, durability_histograms.computed_at FROM durability_histograms WHERE durability_histograms.project_id = ? AND durability_histograms.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, durability_histogram_project_id.value(), durability_histogram_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*DurabilityHistogram, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				durability_histogram := &DurabilityHistogram{}
+				err = __rows.Scan(&durability_histogram.ProjectId, &durability_histogram.BucketName, &durability_histogram.RequiredShares, &durability_histogram.RepairShares, &durability_histogram.OptimalShares, &durability_histogram.TotalShares, &durability_histogram.HealthyCounts, &durability_histogram.ComputedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, durability_histogram)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field) (
 	row *Value_Row, err error) {
@@ -10244,7 +13025,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -10253,7 +13034,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -10309,9 +13090,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -10338,7 +13119,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -10433,7 +13214,7 @@ func (obj *pgxImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(ctx contex
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -10457,7 +13238,7 @@ func (obj *pgxImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(ctx contex
 			}
 
 			user = &User{}
-			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 			if err != nil {
 				return nil, err
 			}
@@ -10491,7 +13272,7 @@ func (obj *pgxImpl) Get_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -10500,7 +13281,7 @@ func (obj *pgxImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err != nil {
 		return (*User)(nil), obj.makeErr(err)
 	}
@@ -10614,39 +13395,174 @@ func (obj *pgxImpl) Get_Project_MaxBuckets_By_Id(ctx context.Context,
 	if err != nil {
 		return (*MaxBuckets_Row)(nil), obj.makeErr(err)
 	}
-	return row, nil
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_Project_BandwidthLimit_Project_UsageLimit_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	row *BandwidthLimit_UsageLimit_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.bandwidth_limit, projects.usage_limit FROM projects WHERE projects.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &BandwidthLimit_UsageLimit_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.BandwidthLimit, &row.UsageLimit)
+	if err != nil {
+		return (*BandwidthLimit_UsageLimit_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) All_Project(ctx context.Context) (
+	rows []*Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*Project, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				project := &Project{}
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_created_at_less Project_CreatedAt_Field) (
+	rows []*Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+
+	var __values []interface{}
+	__values = append(__values, project_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*Project, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				project := &Project{}
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
 
 }
 
-func (obj *pgxImpl) Get_Project_BandwidthLimit_Project_UsageLimit_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
-	row *BandwidthLimit_UsageLimit_Row, err error) {
+func (obj *pgxImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_owner_id Project_OwnerId_Field) (
+	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.bandwidth_limit, projects.usage_limit FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
 
 	var __values []interface{}
-	__values = append(__values, project_id.value())
+	__values = append(__values, project_owner_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &BandwidthLimit_UsageLimit_Row{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.BandwidthLimit, &row.UsageLimit)
-	if err != nil {
-		return (*BandwidthLimit_UsageLimit_Row)(nil), obj.makeErr(err)
+	for {
+		rows, err = func() (rows []*Project, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				project := &Project{}
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
 	}
-	return row, nil
 
 }
 
-func (obj *pgxImpl) All_Project(ctx context.Context) (
+func (obj *pgxImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -10683,16 +13599,19 @@ func (obj *pgxImpl) All_Project(ctx context.Context) (
 
 }
 
-func (obj *pgxImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
-	project_created_at_less Project_CreatedAt_Field) (
+func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_created_at_less Project_CreatedAt_Field,
+	limit int, offset int64) (
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
 
+	__values = append(__values, limit, offset)
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
@@ -10712,7 +13631,8 @@ func (obj *pgxImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx cont
 				}
 				rows = append(rows, project)
 			}
-			if err := __rows.Err(); err != nil {
+			err = __rows.Err()
+			if err != nil {
 				return nil, err
 			}
 			return rows, nil
@@ -10728,21 +13648,21 @@ func (obj *pgxImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx cont
 
 }
 
-func (obj *pgxImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Context,
-	project_owner_id Project_OwnerId_Field) (
-	rows []*Project, err error) {
+func (obj *pgxImpl) All_ProjectMember_By_MemberId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_owner_id.value())
+	__values = append(__values, project_member_member_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*Project, err error) {
+		rows, err = func() (rows []*ProjectMember, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -10750,12 +13670,12 @@ func (obj *pgxImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Con
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				project_member := &ProjectMember{}
+				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project)
+				rows = append(rows, project_member)
 			}
 			if err := __rows.Err(); err != nil {
 				return nil, err
@@ -10773,21 +13693,65 @@ func (obj *pgxImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Con
 
 }
 
-func (obj *pgxImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*Project, err error) {
+func (obj *pgxImpl) Get_SsoIdentity_UserId_By_Issuer_And_Subject(ctx context.Context,
+	sso_identity_issuer SsoIdentity_Issuer_Field,
+	sso_identity_subject SsoIdentity_Subject_Field) (
+	row *UserId_Row, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT sso_identities.user_id FROM sso_identities WHERE sso_identities.issuer = ? AND sso_identities.subject = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, sso_identity_issuer.value(), sso_identity_subject.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &UserId_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.UserId)
+	if err != nil {
+		return (*UserId_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Has_SsoIdentity_By_UserId(ctx context.Context,
+	sso_identity_user_id SsoIdentity_UserId_Field) (
+	has bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT EXISTS( SELECT 1 FROM sso_identities WHERE sso_identities.user_id = ? )")
+
+	var __values []interface{}
+	__values = append(__values, sso_identity_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&has)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+	return has, nil
+
+}
+
+func (obj *pgxImpl) All_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+	rows []*ProjectUsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_usage_alerts.project_id, project_usage_alerts.kind, project_usage_alerts.threshold, project_usage_alerts.created_at FROM project_usage_alerts WHERE project_usage_alerts.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_usage_alert_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*Project, err error) {
+		rows, err = func() (rows []*ProjectUsageAlert, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -10795,12 +13759,12 @@ func (obj *pgxImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Na
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				project_usage_alert := &ProjectUsageAlert{}
+				err = __rows.Scan(&project_usage_alert.ProjectId, &project_usage_alert.Kind, &project_usage_alert.Threshold, &project_usage_alert.CreatedAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project)
+				rows = append(rows, project_usage_alert)
 			}
 			if err := __rows.Err(); err != nil {
 				return nil, err
@@ -10818,16 +13782,16 @@ func (obj *pgxImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Na
 
 }
 
-func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
-	project_created_at_less Project_CreatedAt_Field,
+func (obj *pgxImpl) Limited_ProjectUsageNotification_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
 	limit int, offset int64) (
-	rows []*Project, err error) {
+	rows []*ProjectUsageNotification, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_usage_notifications.id, project_usage_notifications.project_id, project_usage_notifications.kind, project_usage_notifications.threshold, project_usage_notifications.period_start, project_usage_notifications.used, project_usage_notifications.usage_limit, project_usage_notifications.created_at, project_usage_notifications.sent_at FROM project_usage_notifications WHERE project_usage_notifications.project_id = ? ORDER BY project_usage_notifications.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, project_created_at_less.value())
+	__values = append(__values, project_usage_notification_project_id.value())
 
 	__values = append(__values, limit, offset)
 
@@ -10835,7 +13799,7 @@ func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*Project, err error) {
+		rows, err = func() (rows []*ProjectUsageNotification, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -10843,12 +13807,12 @@ func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				project_usage_notification := &ProjectUsageNotification{}
+				err = __rows.Scan(&project_usage_notification.Id, &project_usage_notification.ProjectId, &project_usage_notification.Kind, &project_usage_notification.Threshold, &project_usage_notification.PeriodStart, &project_usage_notification.Used, &project_usage_notification.UsageLimit, &project_usage_notification.CreatedAt, &project_usage_notification.SentAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project)
+				rows = append(rows, project_usage_notification)
 			}
 			err = __rows.Err()
 			if err != nil {
@@ -10867,21 +13831,22 @@ func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 
 }
 
-func (obj *pgxImpl) All_ProjectMember_By_MemberId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*ProjectMember, err error) {
+func (obj *pgxImpl) All_ProjectUsageNotification_By_ProjectId_And_PeriodStart_And_SentAt_Is_Null(ctx context.Context,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field) (
+	rows []*ProjectUsageNotification, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_usage_notifications.id, project_usage_notifications.project_id, project_usage_notifications.kind, project_usage_notifications.threshold, project_usage_notifications.period_start, project_usage_notifications.used, project_usage_notifications.usage_limit, project_usage_notifications.created_at, project_usage_notifications.sent_at FROM project_usage_notifications WHERE project_usage_notifications.project_id = ? AND project_usage_notifications.period_start = ? AND project_usage_notifications.sent_at is NULL")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, project_usage_notification_project_id.value(), project_usage_notification_period_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ProjectMember, err error) {
+		rows, err = func() (rows []*ProjectUsageNotification, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -10889,12 +13854,12 @@ func (obj *pgxImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project_member := &ProjectMember{}
-				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+				project_usage_notification := &ProjectUsageNotification{}
+				err = __rows.Scan(&project_usage_notification.Id, &project_usage_notification.ProjectId, &project_usage_notification.Kind, &project_usage_notification.Threshold, &project_usage_notification.PeriodStart, &project_usage_notification.Used, &project_usage_notification.UsageLimit, &project_usage_notification.CreatedAt, &project_usage_notification.SentAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project_member)
+				rows = append(rows, project_usage_notification)
 			}
 			if err := __rows.Err(); err != nil {
 				return nil, err
@@ -10917,7 +13882,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -10926,7 +13891,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -10939,7 +13904,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Head(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit FROM api_keys WHERE api_keys.head = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_head.value())
@@ -10948,7 +13913,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Head(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -10962,7 +13927,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_name.value(), api_key_project_id.value())
@@ -10971,7 +13936,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -11484,6 +14449,53 @@ func (obj *pgxImpl) Paged_StoragenodeBandwidthRollupPhase2_By_StoragenodeId_And_
 
 }
 
+func (obj *pgxImpl) All_StoragenodeSettlementOutcome_By_StoragenodeId_And_IntervalStart_GreaterOrEqual_And_IntervalStart_Less_OrderBy_Asc_IntervalStart(ctx context.Context,
+	storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+	storagenode_settlement_outcome_interval_start_greater_or_equal StoragenodeSettlementOutcome_IntervalStart_Field,
+	storagenode_settlement_outcome_interval_start_less StoragenodeSettlementOutcome_IntervalStart_Field) (
+	rows []*StoragenodeSettlementOutcome, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_settlement_outcomes.storagenode_id, storagenode_settlement_outcomes.interval_start, storagenode_settlement_outcomes.settled_at, storagenode_settlement_outcomes.status, storagenode_settlement_outcomes.already_processed, storagenode_settlement_outcomes.orders_received, storagenode_settlement_outcomes.orders_accepted, storagenode_settlement_outcomes.orders_unknown_bucket, storagenode_settlement_outcomes.amount_settled, storagenode_settlement_outcomes.rejected_expired, storagenode_settlement_outcomes.rejected_bad_signature, storagenode_settlement_outcomes.rejected_already_settled, storagenode_settlement_outcomes.rejected_invalid FROM storagenode_settlement_outcomes WHERE storagenode_settlement_outcomes.storagenode_id = ? AND storagenode_settlement_outcomes.interval_start >= ? AND storagenode_settlement_outcomes.interval_start < ? ORDER BY storagenode_settlement_outcomes.interval_start")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_settlement_outcome_storagenode_id.value(), storagenode_settlement_outcome_interval_start_greater_or_equal.value(), storagenode_settlement_outcome_interval_start_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*StoragenodeSettlementOutcome, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				storagenode_settlement_outcome := &StoragenodeSettlementOutcome{}
+				err = __rows.Scan(&storagenode_settlement_outcome.StoragenodeId, &storagenode_settlement_outcome.IntervalStart, &storagenode_settlement_outcome.SettledAt, &storagenode_settlement_outcome.Status, &storagenode_settlement_outcome.AlreadyProcessed, &storagenode_settlement_outcome.OrdersReceived, &storagenode_settlement_outcome.OrdersAccepted, &storagenode_settlement_outcome.OrdersUnknownBucket, &storagenode_settlement_outcome.AmountSettled, &storagenode_settlement_outcome.RejectedExpired, &storagenode_settlement_outcome.RejectedBadSignature, &storagenode_settlement_outcome.RejectedAlreadySettled, &storagenode_settlement_outcome.RejectedInvalid)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, storagenode_settlement_outcome)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) All_StoragenodeStorageTally(ctx context.Context) (
 	rows []*StoragenodeStorageTally, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -11924,7 +14936,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -11933,7 +14945,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -11958,7 +14970,122 @@ func (obj *pgxImpl) Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx context.Cont
 	row = &Id_Row{}
 	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Id)
 	if err != nil {
-		return (*Id_Row)(nil), obj.makeErr(err)
+		return (*Id_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.versioning FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Versioning_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Versioning)
+	if err != nil {
+		return (*Versioning_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *DefaultRetentionMode_DefaultRetentionDays_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &DefaultRetentionMode_DefaultRetentionDays_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.DefaultRetentionMode, &row.DefaultRetentionDays)
+	if err != nil {
+		return (*DefaultRetentionMode_DefaultRetentionDays_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleRules_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.lifecycle_rules FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &LifecycleRules_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.LifecycleRules)
+	if err != nil {
+		return (*LifecycleRules_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Placement_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Placement_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Placement)
+	if err != nil {
+		return (*Placement_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketMetainfo_StorageLimit_BucketMetainfo_BandwidthLimit_BucketMetainfo_ObjectLimit_BucketMetainfo_SegmentLimit_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.StorageLimit, &row.BandwidthLimit, &row.ObjectLimit, &row.SegmentLimit)
+	if err != nil {
+		return (*StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row)(nil), obj.makeErr(err)
 	}
 	return row, nil
 
@@ -11993,7 +15120,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -12013,7 +15140,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -12043,7 +15170,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -12063,7 +15190,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -12668,6 +15795,71 @@ func (obj *pgxImpl) Has_NodeApiVersion_By_Id_And_ApiVersion_GreaterOrEqual(ctx c
 
 }
 
+func (obj *pgxImpl) Get_AdminToken_By_SecretHash(ctx context.Context,
+	admin_token_secret_hash AdminToken_SecretHash_Field) (
+	admin_token *AdminToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_tokens.id, admin_tokens.name, admin_tokens.scopes, admin_tokens.secret_hash, admin_tokens.created_at, admin_tokens.expires_at, admin_tokens.revoked_at FROM admin_tokens WHERE admin_tokens.secret_hash = ?")
+
+	var __values []interface{}
+	__values = append(__values, admin_token_secret_hash.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_token = &AdminToken{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_token.Id, &admin_token.Name, &admin_token.Scopes, &admin_token.SecretHash, &admin_token.CreatedAt, &admin_token.ExpiresAt, &admin_token.RevokedAt)
+	if err != nil {
+		return (*AdminToken)(nil), obj.makeErr(err)
+	}
+	return admin_token, nil
+
+}
+
+func (obj *pgxImpl) All_AdminToken_OrderBy_Asc_Name(ctx context.Context) (
+	rows []*AdminToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_tokens.id, admin_tokens.name, admin_tokens.scopes, admin_tokens.secret_hash, admin_tokens.created_at, admin_tokens.expires_at, admin_tokens.revoked_at FROM admin_tokens ORDER BY admin_tokens.name")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*AdminToken, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				admin_token := &AdminToken{}
+				err = __rows.Scan(&admin_token.Id, &admin_token.Name, &admin_token.Scopes, &admin_token.SecretHash, &admin_token.CreatedAt, &admin_token.ExpiresAt, &admin_token.RevokedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, admin_token)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) UpdateNoReturn_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -12696,53 +15888,276 @@ func (obj *pgxImpl) UpdateNoReturn_Irreparabledb_By_Segmentpath(ctx context.Cont
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("seg_damaged_unix_sec = ?"))
 	}
 
-	if update.RepairAttemptCount._set {
-		__values = append(__values, update.RepairAttemptCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
+	if update.RepairAttemptCount._set {
+		__values = append(__values, update.RepairAttemptCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, irreparabledb_segmentpath.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	update AccountingTimestamps_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Value._set {
+		__values = append(__values, update.Value.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, accounting_timestamps_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.LastIpPort._set {
+		__values = append(__values, update.LastIpPort.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.Type._set {
+		__values = append(__values, update.Type.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("type = ?"))
+	}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+
+	if update.WalletFeatures._set {
+		__values = append(__values, update.WalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.PieceCount._set {
+		__values = append(__values, update.PieceCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	}
+
+	if update.Major._set {
+		__values = append(__values, update.Major.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	}
+
+	if update.Minor._set {
+		__values = append(__values, update.Minor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	}
+
+	if update.Patch._set {
+		__values = append(__values, update.Patch.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	}
+
+	if update.Hash._set {
+		__values = append(__values, update.Hash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hash = ?"))
+	}
+
+	if update.Timestamp._set {
+		__values = append(__values, update.Timestamp.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("timestamp = ?"))
+	}
+
+	if update.Release._set {
+		__values = append(__values, update.Release.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.Suspended._set {
+		__values = append(__values, update.Suspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	}
+
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
-	__args = append(__args, irreparabledb_segmentpath.value())
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return obj.makeErr(err)
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
 	}
-	return nil
-}
 
-func (obj *pgxImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	update AccountingTimestamps_Update_Fields) (
-	err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __sets = &__sqlbundle_Hole{}
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ?")}}
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
 
-	if update.Value._set {
-		__values = append(__values, update.Value.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
-	__args = append(__args, accounting_timestamps_name.value())
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -12750,21 +16165,25 @@ func (obj *pgxImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Cont
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	node = &Node{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return node, nil
 }
 
-func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
+func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
-	node *Node, err error) {
+	err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -12785,6 +16204,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
 	}
 
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -12968,258 +16392,431 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxImpl) Update_AuditHistory_By_NodeId(ctx context.Context,
+	audit_history_node_id AuditHistory_NodeId_Field,
+	update AuditHistory_Update_Fields) (
+	audit_history *AuditHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE audit_histories SET "), __sets, __sqlbundle_Literal(" WHERE audit_histories.node_id = ? RETURNING audit_histories.node_id, audit_histories.history")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.History._set {
+		__values = append(__values, update.History.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("history = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, audit_history_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	audit_history = &AuditHistory{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&audit_history.NodeId, &audit_history.History)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return audit_history, nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	err error) {
+func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
+	user_id User_Id_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
 	}
 
-	if update.LastNet._set {
-		__values = append(__values, update.LastNet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	if update.NormalizedEmail._set {
+		__values = append(__values, update.NormalizedEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("normalized_email = ?"))
 	}
 
-	if update.LastIpPort._set {
-		__values = append(__values, update.LastIpPort.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	if update.FullName._set {
+		__values = append(__values, update.FullName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("full_name = ?"))
 	}
 
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	if update.ShortName._set {
+		__values = append(__values, update.ShortName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("short_name = ?"))
 	}
 
-	if update.Type._set {
-		__values = append(__values, update.Type.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("type = ?"))
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
+	}
+
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	}
+
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
+	}
+
+	if update.Position._set {
+		__values = append(__values, update.Position.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("position = ?"))
+	}
+
+	if update.CompanyName._set {
+		__values = append(__values, update.CompanyName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_name = ?"))
+	}
+
+	if update.CompanySize._set {
+		__values = append(__values, update.CompanySize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_size = ?"))
+	}
+
+	if update.WorkingOn._set {
+		__values = append(__values, update.WorkingOn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
+	}
+
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
+	}
+
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
+	}
+
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
+	}
+
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
+	}
+
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return user, nil
+}
+
+func (obj *pgxImpl) Update_User_By_Id_And_MfaLastCounter_Less(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_last_counter_less User_MfaLastCounter_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? AND users.mfa_last_counter < ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
 	if update.Email._set {
 		__values = append(__values, update.Email.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
 	}
 
-	if update.Wallet._set {
-		__values = append(__values, update.Wallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	if update.NormalizedEmail._set {
+		__values = append(__values, update.NormalizedEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("normalized_email = ?"))
 	}
 
-	if update.WalletFeatures._set {
-		__values = append(__values, update.WalletFeatures.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	if update.FullName._set {
+		__values = append(__values, update.FullName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("full_name = ?"))
 	}
 
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	if update.ShortName._set {
+		__values = append(__values, update.ShortName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("short_name = ?"))
 	}
 
-	if update.PieceCount._set {
-		__values = append(__values, update.PieceCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
 	}
 
-	if update.Major._set {
-		__values = append(__values, update.Major.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
-	if update.Minor._set {
-		__values = append(__values, update.Minor.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
 	}
 
-	if update.Patch._set {
-		__values = append(__values, update.Patch.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	if update.Position._set {
+		__values = append(__values, update.Position.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("position = ?"))
 	}
 
-	if update.Hash._set {
-		__values = append(__values, update.Hash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hash = ?"))
+	if update.CompanyName._set {
+		__values = append(__values, update.CompanyName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_name = ?"))
 	}
 
-	if update.Timestamp._set {
-		__values = append(__values, update.Timestamp.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("timestamp = ?"))
+	if update.CompanySize._set {
+		__values = append(__values, update.CompanySize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_size = ?"))
 	}
 
-	if update.Release._set {
-		__values = append(__values, update.Release.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	if update.WorkingOn._set {
+		__values = append(__values, update.WorkingOn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
 	}
 
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
 	}
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
 	}
 
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
 	}
 
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
 	}
 
-	if update.LastContactSuccess._set {
-		__values = append(__values, update.LastContactSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
 	}
 
-	if update.LastContactFailure._set {
-		__values = append(__values, update.LastContactFailure.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
 	}
 
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
 	}
 
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
 	}
 
-	if update.Suspended._set {
-		__values = append(__values, update.Suspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
 	}
 
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
+	__args = append(__args, user_id.value(), user_mfa_last_counter_less.value())
 
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	user = &User{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return user, nil
+}
 
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+func (obj *pgxImpl) Update_User_By_Id_And_MfaFailedAttempts(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_failed_attempts User_MfaFailedAttempts_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? AND users.mfa_failed_attempts = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
 	}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	if update.NormalizedEmail._set {
+		__values = append(__values, update.NormalizedEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("normalized_email = ?"))
 	}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.FullName._set {
+		__values = append(__values, update.FullName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("full_name = ?"))
 	}
 
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.ShortName._set {
+		__values = append(__values, update.ShortName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("short_name = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
 	}
 
-	if update.ExitInitiatedAt._set {
-		__values = append(__values, update.ExitInitiatedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
-	if update.ExitLoopCompletedAt._set {
-		__values = append(__values, update.ExitLoopCompletedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
 	}
 
-	if update.ExitFinishedAt._set {
-		__values = append(__values, update.ExitFinishedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	if update.Position._set {
+		__values = append(__values, update.Position.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("position = ?"))
 	}
 
-	if update.ExitSuccess._set {
-		__values = append(__values, update.ExitSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	if update.CompanyName._set {
+		__values = append(__values, update.CompanyName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_name = ?"))
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
+	if update.CompanySize._set {
+		__values = append(__values, update.CompanySize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_size = ?"))
+	}
 
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	if update.WorkingOn._set {
+		__values = append(__values, update.WorkingOn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
+	}
 
-	__args = append(__args, node_id.value())
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
+	}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return obj.makeErr(err)
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
 	}
-	return nil
-}
 
-func (obj *pgxImpl) Update_AuditHistory_By_NodeId(ctx context.Context,
-	audit_history_node_id AuditHistory_NodeId_Field,
-	update AuditHistory_Update_Fields) (
-	audit_history *AuditHistory, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __sets = &__sqlbundle_Hole{}
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE audit_histories SET "), __sets, __sqlbundle_Literal(" WHERE audit_histories.node_id = ? RETURNING audit_histories.node_id, audit_histories.history")}}
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
+	}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
+	}
 
-	if update.History._set {
-		__values = append(__values, update.History.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("history = ?"))
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, audit_history_node_id.value())
+	__args = append(__args, user_id.value(), user_mfa_failed_attempts.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -13227,25 +16824,26 @@ func (obj *pgxImpl) Update_AuditHistory_By_NodeId(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	audit_history = &AuditHistory{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&audit_history.NodeId, &audit_history.History)
+	user = &User{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return audit_history, nil
+	return user, nil
 }
 
-func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
+func (obj *pgxImpl) Update_User_By_Id_And_MfaRecoveryCodes(ctx context.Context,
 	user_id User_Id_Field,
+	user_mfa_recovery_codes User_MfaRecoveryCodes_Field,
 	update User_Update_Fields) (
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? AND users.mfa_recovery_codes = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -13306,21 +16904,51 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
 	}
 
-	if update.IsProfessional._set {
-		__values = append(__values, update.IsProfessional.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
+	}
+
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
+	}
+
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
+	}
+
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
 	}
 
-	if update.EmployeeCount._set {
-		__values = append(__values, update.EmployeeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, user_id.value())
+	__args = append(__args, user_id.value(), user_mfa_recovery_codes.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -13329,7 +16957,7 @@ func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -13405,6 +17033,85 @@ func (obj *pgxImpl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *pgxImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_ProjectUsageNotification_By_Id(ctx context.Context,
+	project_usage_notification_id ProjectUsageNotification_Id_Field,
+	update ProjectUsageNotification_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_usage_notifications SET "), __sets, __sqlbundle_Literal(" WHERE project_usage_notifications.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.SentAt._set {
+		__values = append(__values, update.SentAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sent_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, project_usage_notification_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
@@ -13423,6 +17130,16 @@ func (obj *pgxImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.RateLimit._set {
+		__values = append(__values, update.RateLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rate_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -13534,7 +17251,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -13590,6 +17307,51 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_total_shares = ?"))
 	}
 
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+
+	if update.DefaultRetentionMode._set {
+		__values = append(__values, update.DefaultRetentionMode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_mode = ?"))
+	}
+
+	if update.DefaultRetentionDays._set {
+		__values = append(__values, update.DefaultRetentionDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_days = ?"))
+	}
+
+	if update.LifecycleRules._set {
+		__values = append(__values, update.LifecycleRules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_rules = ?"))
+	}
+
+	if update.Placement._set {
+		__values = append(__values, update.Placement.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
+	}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.ObjectLimit._set {
+		__values = append(__values, update.ObjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -13603,7 +17365,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -13891,6 +17653,84 @@ func (obj *pgxImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx
 	return nil
 }
 
+func (obj *pgxImpl) Update_AdminToken_By_Id_And_RevokedAt_Is_Null(ctx context.Context,
+	admin_token_id AdminToken_Id_Field,
+	update AdminToken_Update_Fields) (
+	admin_token *AdminToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_tokens SET "), __sets, __sqlbundle_Literal(" WHERE admin_tokens.id = ? AND admin_tokens.revoked_at is NULL RETURNING admin_tokens.id, admin_tokens.name, admin_tokens.scopes, admin_tokens.secret_hash, admin_tokens.created_at, admin_tokens.expires_at, admin_tokens.revoked_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.RevokedAt._set {
+		__values = append(__values, update.RevokedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("revoked_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, admin_token_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_token = &AdminToken{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_token.Id, &admin_token.Name, &admin_token.Scopes, &admin_token.SecretHash, &admin_token.CreatedAt, &admin_token.ExpiresAt, &admin_token.RevokedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return admin_token, nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_AdminAuditLog_By_Id_And_StatusCode_Is_Null(ctx context.Context,
+	admin_audit_log_id AdminAuditLog_Id_Field,
+	update AdminAuditLog_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_audit_logs SET "), __sets, __sqlbundle_Literal(" WHERE admin_audit_logs.id = ? AND admin_audit_logs.status_code is NULL")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.StatusCode._set {
+		__values = append(__values, update.StatusCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status_code = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, admin_audit_log_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxImpl) Delete_PendingAudits_By_NodeId(ctx context.Context,
 	pending_audits_node_id PendingAudits_NodeId_Field) (
 	deleted bool, err error) {
@@ -13945,6 +17785,33 @@ func (obj *pgxImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_DurabilityHistogram_By_ComputedAt_Less(ctx context.Context,
+	durability_histogram_computed_at_less DurabilityHistogram_ComputedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM durability_histograms WHERE durability_histograms.computed_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, durability_histogram_computed_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *pgxImpl) Delete_Injuredsegment_By_UpdatedAt_Less(ctx context.Context,
 	injuredsegment_updated_at_less Injuredsegment_UpdatedAt_Field) (
 	count int64, err error) {
@@ -14054,6 +17921,33 @@ func (obj *pgxImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.C
 
 }
 
+func (obj *pgxImpl) Delete_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_usage_alerts WHERE project_usage_alerts.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_usage_alert_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *pgxImpl) Delete_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	deleted bool, err error) {
@@ -14081,6 +17975,33 @@ func (obj *pgxImpl) Delete_ApiKey_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_StoragenodeSettlementOutcome_By_SettledAt_Less(ctx context.Context,
+	storagenode_settlement_outcome_settled_at_less StoragenodeSettlementOutcome_SettledAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM storagenode_settlement_outcomes WHERE storagenode_settlement_outcomes.settled_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_settlement_outcome_settled_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *pgxImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
 	reset_password_token_secret ResetPasswordToken_Secret_Field) (
 	deleted bool, err error) {
@@ -14280,14 +18201,44 @@ func (impl pgxImpl) isConstraintError(err error) (
 			return e.ConstraintName, true
 		}
 	}
-	return "", false
-}
+	return "", false
+}
+
+func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sso_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM user_credits;")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_notifications;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -14297,7 +18248,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM stripecoinpayments_apply_balance_intents;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_alerts;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -14392,6 +18343,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_settlement_outcomes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -14462,6 +18423,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reported_lost_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -14582,6 +18553,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_histograms;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -14662,6 +18643,36 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_usage_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -14752,6 +18763,32 @@ func (obj *pgxcockroachImpl) CreateNoReturn_Irreparabledb(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) CreateNoReturn_ReportedLostPiece(ctx context.Context,
+	reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+	reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field,
+	reported_lost_piece_reported_at ReportedLostPiece_ReportedAt_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := reported_lost_piece_node_id.value()
+	__piece_id_val := reported_lost_piece_piece_id.value()
+	__reported_at_val := reported_lost_piece_reported_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reported_lost_pieces ( node_id, piece_id, reported_at ) VALUES ( ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __piece_id_val, __reported_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) CreateNoReturn_AccountingTimestamps(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field,
 	accounting_timestamps_value AccountingTimestamps_Value_Field) (
@@ -14826,15 +18863,18 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 	__company_size_val := optional.CompanySize.value()
 	__working_on_val := optional.WorkingOn.value()
 	__employee_count_val := optional.EmployeeCount.value()
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
+	__mfa_locked_until_val := optional.MfaLockedUntil.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, status, partner_id, created_at, position, company_name, company_size, working_on, employee_count")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, email, normalized_email, full_name, short_name, password_hash, status, partner_id, created_at, position, company_name, company_size, working_on, employee_count, mfa_secret_key, mfa_recovery_codes, mfa_locked_until")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO users "), __clause, __sqlbundle_Literal(" RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __partner_id_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val)
+	__values = append(__values, __id_val, __email_val, __normalized_email_val, __full_name_val, __short_name_val, __password_hash_val, __status_val, __partner_id_val, __created_at_val, __position_val, __company_name_val, __company_size_val, __working_on_val, __employee_count_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_locked_until_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -14851,6 +18891,24 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
 	}
 
+	if optional.MfaEnabled._set {
+		__values = append(__values, optional.MfaEnabled.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("mfa_enabled"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.MfaLastCounter._set {
+		__values = append(__values, optional.MfaLastCounter.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("mfa_last_counter"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.MfaFailedAttempts._set {
+		__values = append(__values, optional.MfaFailedAttempts.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("mfa_failed_attempts"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
 	if len(__optional_columns.SQLs) == 0 {
 		if __columns.SQL == nil {
 			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
@@ -14863,7 +18921,7 @@ func (obj *pgxcockroachImpl) Create_User(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -14911,7 +18969,8 @@ func (obj *pgxcockroachImpl) Create_Project(ctx context.Context,
 
 func (obj *pgxcockroachImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	optional ProjectMember_Create_Fields) (
 	project_member *ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -14920,16 +18979,37 @@ func (obj *pgxcockroachImpl) Create_ProjectMember(ctx context.Context,
 	__project_id_val := project_member_project_id.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("member_id, project_id, created_at")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO project_members "), __clause, __sqlbundle_Literal(" RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
 
 	var __values []interface{}
 	__values = append(__values, __member_id_val, __project_id_val, __created_at_val)
 
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.Role._set {
+		__values = append(__values, optional.Role.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("role"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	project_member = &ProjectMember{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -14937,6 +19017,103 @@ func (obj *pgxcockroachImpl) Create_ProjectMember(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) CreateNoReturn_SsoIdentity(ctx context.Context,
+	sso_identity_issuer SsoIdentity_Issuer_Field,
+	sso_identity_subject SsoIdentity_Subject_Field,
+	sso_identity_user_id SsoIdentity_UserId_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__issuer_val := sso_identity_issuer.value()
+	__subject_val := sso_identity_subject.value()
+	__user_id_val := sso_identity_user_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO sso_identities ( issuer, subject, user_id, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __issuer_val, __subject_val, __user_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxcockroachImpl) CreateNoReturn_ProjectUsageAlert(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field,
+	project_usage_alert_kind ProjectUsageAlert_Kind_Field,
+	project_usage_alert_threshold ProjectUsageAlert_Threshold_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_usage_alert_project_id.value()
+	__kind_val := project_usage_alert_kind.value()
+	__threshold_val := project_usage_alert_threshold.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_usage_alerts ( project_id, kind, threshold, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __project_id_val, __kind_val, __threshold_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxcockroachImpl) CreateNoReturn_ProjectUsageNotification(ctx context.Context,
+	project_usage_notification_id ProjectUsageNotification_Id_Field,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	project_usage_notification_kind ProjectUsageNotification_Kind_Field,
+	project_usage_notification_threshold ProjectUsageNotification_Threshold_Field,
+	project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field,
+	project_usage_notification_used ProjectUsageNotification_Used_Field,
+	project_usage_notification_usage_limit ProjectUsageNotification_UsageLimit_Field,
+	optional ProjectUsageNotification_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_usage_notification_id.value()
+	__project_id_val := project_usage_notification_project_id.value()
+	__kind_val := project_usage_notification_kind.value()
+	__threshold_val := project_usage_notification_threshold.value()
+	__period_start_val := project_usage_notification_period_start.value()
+	__used_val := project_usage_notification_used.value()
+	__usage_limit_val := project_usage_notification_usage_limit.value()
+	__created_at_val := __now
+	__sent_at_val := optional.SentAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_usage_notifications ( id, project_id, kind, threshold, period_start, used, usage_limit, created_at, sent_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __project_id_val, __kind_val, __threshold_val, __period_start_val, __used_val, __usage_limit_val, __created_at_val, __sent_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
@@ -14955,17 +19132,19 @@ func (obj *pgxcockroachImpl) Create_ApiKey(ctx context.Context,
 	__secret_val := api_key_secret.value()
 	__partner_id_val := optional.PartnerId.value()
 	__created_at_val := __now
+	__rate_limit_val := optional.RateLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, created_at, rate_limit, bandwidth_limit ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __created_at_val)
+	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __created_at_val, __rate_limit_val, __bandwidth_limit_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -15088,6 +19267,52 @@ func (obj *pgxcockroachImpl) Create_StoragenodeBandwidthRollup(ctx context.Conte
 
 }
 
+func (obj *pgxcockroachImpl) CreateNoReturn_StoragenodeSettlementOutcome(ctx context.Context,
+	storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+	storagenode_settlement_outcome_interval_start StoragenodeSettlementOutcome_IntervalStart_Field,
+	storagenode_settlement_outcome_settled_at StoragenodeSettlementOutcome_SettledAt_Field,
+	storagenode_settlement_outcome_status StoragenodeSettlementOutcome_Status_Field,
+	storagenode_settlement_outcome_already_processed StoragenodeSettlementOutcome_AlreadyProcessed_Field,
+	storagenode_settlement_outcome_orders_received StoragenodeSettlementOutcome_OrdersReceived_Field,
+	storagenode_settlement_outcome_orders_accepted StoragenodeSettlementOutcome_OrdersAccepted_Field,
+	storagenode_settlement_outcome_orders_unknown_bucket StoragenodeSettlementOutcome_OrdersUnknownBucket_Field,
+	storagenode_settlement_outcome_amount_settled StoragenodeSettlementOutcome_AmountSettled_Field,
+	storagenode_settlement_outcome_rejected_expired StoragenodeSettlementOutcome_RejectedExpired_Field,
+	storagenode_settlement_outcome_rejected_bad_signature StoragenodeSettlementOutcome_RejectedBadSignature_Field,
+	storagenode_settlement_outcome_rejected_already_settled StoragenodeSettlementOutcome_RejectedAlreadySettled_Field,
+	storagenode_settlement_outcome_rejected_invalid StoragenodeSettlementOutcome_RejectedInvalid_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__storagenode_id_val := storagenode_settlement_outcome_storagenode_id.value()
+	__interval_start_val := storagenode_settlement_outcome_interval_start.value()
+	__settled_at_val := storagenode_settlement_outcome_settled_at.value()
+	__status_val := storagenode_settlement_outcome_status.value()
+	__already_processed_val := storagenode_settlement_outcome_already_processed.value()
+	__orders_received_val := storagenode_settlement_outcome_orders_received.value()
+	__orders_accepted_val := storagenode_settlement_outcome_orders_accepted.value()
+	__orders_unknown_bucket_val := storagenode_settlement_outcome_orders_unknown_bucket.value()
+	__amount_settled_val := storagenode_settlement_outcome_amount_settled.value()
+	__rejected_expired_val := storagenode_settlement_outcome_rejected_expired.value()
+	__rejected_bad_signature_val := storagenode_settlement_outcome_rejected_bad_signature.value()
+	__rejected_already_settled_val := storagenode_settlement_outcome_rejected_already_settled.value()
+	__rejected_invalid_val := storagenode_settlement_outcome_rejected_invalid.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO storagenode_settlement_outcomes ( storagenode_id, interval_start, settled_at, status, already_processed, orders_received, orders_accepted, orders_unknown_bucket, amount_settled, rejected_expired, rejected_bad_signature, rejected_already_settled, rejected_invalid ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __storagenode_id_val, __interval_start_val, __settled_at_val, __status_val, __already_processed_val, __orders_received_val, __orders_accepted_val, __orders_unknown_bucket_val, __amount_settled_val, __rejected_expired_val, __rejected_bad_signature_val, __rejected_already_settled_val, __rejected_invalid_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
 func (obj *pgxcockroachImpl) ReplaceNoReturn_StoragenodePaystub(ctx context.Context,
 	storagenode_paystub_period StoragenodePaystub_Period_Field,
 	storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
@@ -15306,17 +19531,56 @@ func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_repair_shares_val := bucket_metainfo_default_redundancy_repair_shares.value()
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
+	__lifecycle_rules_val := optional.LifecycleRules.value()
+	__placement_val := optional.Placement.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__object_limit_val := optional.ObjectLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, lifecycle_rules, placement, storage_limit, bandwidth_limit, object_limit, segment_limit")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares")
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO bucket_metainfos "), __clause, __sqlbundle_Literal(" RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __lifecycle_rules_val, __placement_val, __storage_limit_val, __bandwidth_limit_val, __object_limit_val, __segment_limit_val)
+
+	__optional_columns := __sqlbundle_Literals{Join: ", "}
+	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
+
+	if optional.Versioning._set {
+		__values = append(__values, optional.Versioning.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("versioning"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.DefaultRetentionMode._set {
+		__values = append(__values, optional.DefaultRetentionMode.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("default_retention_mode"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
+
+	if optional.DefaultRetentionDays._set {
+		__values = append(__values, optional.DefaultRetentionDays.value())
+		__optional_columns.SQLs = append(__optional_columns.SQLs, __sqlbundle_Literal("default_retention_days"))
+		__optional_placeholders.SQLs = append(__optional_placeholders.SQLs, __sqlbundle_Literal("?"))
+	}
 
+	if len(__optional_columns.SQLs) == 0 {
+		if __columns.SQL == nil {
+			__clause.SQL = __sqlbundle_Literal("DEFAULT VALUES")
+		}
+	} else {
+		__columns.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__columns.SQL, __optional_columns}}
+		__placeholders.SQL = __sqlbundle_Literals{Join: ", ", SQLs: []__sqlbundle_SQL{__placeholders.SQL, __optional_placeholders}}
+	}
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -15560,37 +19824,106 @@ func (obj *pgxcockroachImpl) Create_CouponUsage(ctx context.Context,
 	coupon_usage = &CouponUsage{}
 	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&coupon_usage.CouponId, &coupon_usage.Amount, &coupon_usage.Status, &coupon_usage.Period)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return nil, obj.makeErr(err)
+	}
+	return coupon_usage, nil
+
+}
+
+func (obj *pgxcockroachImpl) ReplaceNoReturn_NodeApiVersion(ctx context.Context,
+	node_api_version_id NodeApiVersion_Id_Field,
+	node_api_version_api_version NodeApiVersion_ApiVersion_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := node_api_version_id.value()
+	__api_version_val := node_api_version_api_version.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO node_api_versions ( id, api_version, created_at, updated_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __api_version_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxcockroachImpl) CreateNoReturn_AdminToken(ctx context.Context,
+	admin_token_id AdminToken_Id_Field,
+	admin_token_name AdminToken_Name_Field,
+	admin_token_scopes AdminToken_Scopes_Field,
+	admin_token_secret_hash AdminToken_SecretHash_Field,
+	admin_token_created_at AdminToken_CreatedAt_Field,
+	optional AdminToken_Create_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__id_val := admin_token_id.value()
+	__name_val := admin_token_name.value()
+	__scopes_val := admin_token_scopes.value()
+	__secret_hash_val := admin_token_secret_hash.value()
+	__created_at_val := admin_token_created_at.value()
+	__expires_at_val := optional.ExpiresAt.value()
+	__revoked_at_val := optional.RevokedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_tokens ( id, name, scopes, secret_hash, created_at, expires_at, revoked_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __name_val, __scopes_val, __secret_hash_val, __created_at_val, __expires_at_val, __revoked_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
 	}
-	return coupon_usage, nil
+	return nil
 
 }
 
-func (obj *pgxcockroachImpl) ReplaceNoReturn_NodeApiVersion(ctx context.Context,
-	node_api_version_id NodeApiVersion_Id_Field,
-	node_api_version_api_version NodeApiVersion_ApiVersion_Field) (
-	err error) {
+func (obj *pgxcockroachImpl) Create_AdminAuditLog(ctx context.Context,
+	admin_audit_log_created_at AdminAuditLog_CreatedAt_Field,
+	admin_audit_log_actor AdminAuditLog_Actor_Field,
+	admin_audit_log_method AdminAuditLog_Method_Field,
+	admin_audit_log_path AdminAuditLog_Path_Field,
+	admin_audit_log_details AdminAuditLog_Details_Field,
+	optional AdminAuditLog_Create_Fields) (
+	admin_audit_log *AdminAuditLog, err error) {
 	defer mon.Task()(&ctx)(&err)
+	__created_at_val := admin_audit_log_created_at.value()
+	__actor_val := admin_audit_log_actor.value()
+	__token_id_val := optional.TokenId.value()
+	__method_val := admin_audit_log_method.value()
+	__path_val := admin_audit_log_path.value()
+	__user_email_val := optional.UserEmail.value()
+	__project_id_val := optional.ProjectId.value()
+	__details_val := admin_audit_log_details.value()
+	__status_code_val := optional.StatusCode.value()
 
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := node_api_version_id.value()
-	__api_version_val := node_api_version_api_version.value()
-	__created_at_val := __now
-	__updated_at_val := __now
-
-	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO node_api_versions ( id, api_version, created_at, updated_at ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_audit_logs ( created_at, actor, token_id, method, path, user_email, project_id, details, status_code ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING admin_audit_logs.id, admin_audit_logs.created_at, admin_audit_logs.actor, admin_audit_logs.token_id, admin_audit_logs.method, admin_audit_logs.path, admin_audit_logs.user_email, admin_audit_logs.project_id, admin_audit_logs.details, admin_audit_logs.status_code")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __api_version_val, __created_at_val, __updated_at_val)
+	__values = append(__values, __created_at_val, __actor_val, __token_id_val, __method_val, __path_val, __user_email_val, __project_id_val, __details_val, __status_code_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	admin_audit_log = &AdminAuditLog{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_audit_log.Id, &admin_audit_log.CreatedAt, &admin_audit_log.Actor, &admin_audit_log.TokenId, &admin_audit_log.Method, &admin_audit_log.Path, &admin_audit_log.UserEmail, &admin_audit_log.ProjectId, &admin_audit_log.Details, &admin_audit_log.StatusCode)
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return admin_audit_log, nil
 
 }
 
@@ -15710,6 +20043,166 @@ func (obj *pgxcockroachImpl) Limited_Irreparabledb_By_Segmentpath_Greater_OrderB
 
 }
 
+func (obj *pgxcockroachImpl) Has_ReportedLostPiece_By_NodeId_And_PieceId(ctx context.Context,
+	reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+	reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field) (
+	has bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT EXISTS( SELECT 1 FROM reported_lost_pieces WHERE reported_lost_pieces.node_id = ? AND reported_lost_pieces.piece_id = ? )")
+
+	var __values []interface{}
+	__values = append(__values, reported_lost_piece_node_id.value(), reported_lost_piece_piece_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&has)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+	return has, nil
+
+}
+
+func (obj *pgxcockroachImpl) Limited_ReportedLostPiece_OrderBy_Asc_ReportedAt(ctx context.Context,
+	limit int, offset int64) (
+	rows []*ReportedLostPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT reported_lost_pieces.node_id, reported_lost_pieces.piece_id, reported_lost_pieces.reported_at FROM reported_lost_pieces ORDER BY reported_lost_pieces.reported_at LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ReportedLostPiece, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				reported_lost_piece := &ReportedLostPiece{}
+				err = __rows.Scan(&reported_lost_piece.NodeId, &reported_lost_piece.PieceId, &reported_lost_piece.ReportedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, reported_lost_piece)
+			}
+			err = __rows.Err()
+			if err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_DurabilityHistogram_By_ProjectId(ctx context.Context,
+	durability_histogram_project_id DurabilityHistogram_ProjectId_Field) (
+	rows []*DurabilityHistogram, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT durability_histograms.project_id, durability_histograms.bucket_name, durability_histograms.required_shares, durability_histograms.repair_shares, durability_histograms.optimal_shares, durability_histograms.total_shares, durability_histograms.healthy_counts, durability_histograms.computed_at FROM durability_histograms WHERE durability_histograms.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, durability_histogram_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*DurabilityHistogram, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				durability_histogram := &DurabilityHistogram{}
+				err = __rows.Scan(&durability_histogram.ProjectId, &durability_histogram.BucketName, &durability_histogram.RequiredShares, &durability_histogram.RepairShares, &durability_histogram.OptimalShares, &durability_histogram.TotalShares, &durability_histogram.HealthyCounts, &durability_histogram.ComputedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, durability_histogram)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_DurabilityHistogram_By_ProjectId_And_BucketName(ctx context.Context,
+	durability_histogram_project_id DurabilityHistogram_ProjectId_Field,
+	durability_histogram_bucket_name DurabilityHistogram_BucketName_Field) (
+	rows []*DurabilityHistogram, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT durability_histograms.project_id, durability_histograms.bucket_name, durability_histograms.required_shares, durability_histograms.repair_shares, durability_histograms.optimal_shares, durability_histograms.total_shares, durability_histograms.healthy_counts, durability_histograms.computed_at FROM durability_histograms WHERE durability_histograms.project_id = ? AND durability_histograms.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, durability_histogram_project_id.value(), durability_histogram_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*DurabilityHistogram, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				durability_histogram := &DurabilityHistogram{}
+				err = __rows.Scan(&durability_histogram.ProjectId, &durability_histogram.BucketName, &durability_histogram.RequiredShares, &durability_histogram.RepairShares, &durability_histogram.OptimalShares, &durability_histogram.TotalShares, &durability_histogram.HealthyCounts, &durability_histogram.ComputedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, durability_histogram)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field) (
 	row *Value_Row, err error) {
@@ -15740,7 +20233,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -15749,7 +20242,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -15805,9 +20298,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -15834,7 +20327,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -15929,7 +20422,7 @@ func (obj *pgxcockroachImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(c
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until FROM users WHERE users.normalized_email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_normalized_email.value())
@@ -15953,7 +20446,7 @@ func (obj *pgxcockroachImpl) Get_User_By_NormalizedEmail_And_Status_Not_Number(c
 			}
 
 			user = &User{}
-			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+			err = __rows.Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 			if err != nil {
 				return nil, err
 			}
@@ -15987,7 +20480,7 @@ func (obj *pgxcockroachImpl) Get_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -15996,7 +20489,7 @@ func (obj *pgxcockroachImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err != nil {
 		return (*User)(nil), obj.makeErr(err)
 	}
@@ -16119,30 +20612,165 @@ func (obj *pgxcockroachImpl) Get_Project_BandwidthLimit_Project_UsageLimit_By_Id
 	row *BandwidthLimit_UsageLimit_Row, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.bandwidth_limit, projects.usage_limit FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.bandwidth_limit, projects.usage_limit FROM projects WHERE projects.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &BandwidthLimit_UsageLimit_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.BandwidthLimit, &row.UsageLimit)
+	if err != nil {
+		return (*BandwidthLimit_UsageLimit_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_Project(ctx context.Context) (
+	rows []*Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*Project, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				project := &Project{}
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_created_at_less Project_CreatedAt_Field) (
+	rows []*Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+
+	var __values []interface{}
+	__values = append(__values, project_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*Project, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				project := &Project{}
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_owner_id Project_OwnerId_Field) (
+	rows []*Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
 
 	var __values []interface{}
-	__values = append(__values, project_id.value())
+	__values = append(__values, project_owner_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &BandwidthLimit_UsageLimit_Row{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.BandwidthLimit, &row.UsageLimit)
-	if err != nil {
-		return (*BandwidthLimit_UsageLimit_Row)(nil), obj.makeErr(err)
+	for {
+		rows, err = func() (rows []*Project, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				project := &Project{}
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
 	}
-	return row, nil
 
 }
 
-func (obj *pgxcockroachImpl) All_Project(ctx context.Context) (
+func (obj *pgxcockroachImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -16179,16 +20807,19 @@ func (obj *pgxcockroachImpl) All_Project(ctx context.Context) (
 
 }
 
-func (obj *pgxcockroachImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
-	project_created_at_less Project_CreatedAt_Field) (
+func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	project_created_at_less Project_CreatedAt_Field,
+	limit int, offset int64) (
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
 
+	__values = append(__values, limit, offset)
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
@@ -16208,7 +20839,8 @@ func (obj *pgxcockroachImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt
 				}
 				rows = append(rows, project)
 			}
-			if err := __rows.Err(); err != nil {
+			err = __rows.Err()
+			if err != nil {
 				return nil, err
 			}
 			return rows, nil
@@ -16224,21 +20856,21 @@ func (obj *pgxcockroachImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt
 
 }
 
-func (obj *pgxcockroachImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Context,
-	project_owner_id Project_OwnerId_Field) (
-	rows []*Project, err error) {
+func (obj *pgxcockroachImpl) All_ProjectMember_By_MemberId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at, project_members.role FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_owner_id.value())
+	__values = append(__values, project_member_member_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*Project, err error) {
+		rows, err = func() (rows []*ProjectMember, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -16246,12 +20878,12 @@ func (obj *pgxcockroachImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx co
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				project_member := &ProjectMember{}
+				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project)
+				rows = append(rows, project_member)
 			}
 			if err := __rows.Err(); err != nil {
 				return nil, err
@@ -16269,21 +20901,65 @@ func (obj *pgxcockroachImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx co
 
 }
 
-func (obj *pgxcockroachImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*Project, err error) {
+func (obj *pgxcockroachImpl) Get_SsoIdentity_UserId_By_Issuer_And_Subject(ctx context.Context,
+	sso_identity_issuer SsoIdentity_Issuer_Field,
+	sso_identity_subject SsoIdentity_Subject_Field) (
+	row *UserId_Row, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT sso_identities.user_id FROM sso_identities WHERE sso_identities.issuer = ? AND sso_identities.subject = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, sso_identity_issuer.value(), sso_identity_subject.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &UserId_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.UserId)
+	if err != nil {
+		return (*UserId_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Has_SsoIdentity_By_UserId(ctx context.Context,
+	sso_identity_user_id SsoIdentity_UserId_Field) (
+	has bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT EXISTS( SELECT 1 FROM sso_identities WHERE sso_identities.user_id = ? )")
+
+	var __values []interface{}
+	__values = append(__values, sso_identity_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&has)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+	return has, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+	rows []*ProjectUsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_usage_alerts.project_id, project_usage_alerts.kind, project_usage_alerts.threshold, project_usage_alerts.created_at FROM project_usage_alerts WHERE project_usage_alerts.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_usage_alert_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*Project, err error) {
+		rows, err = func() (rows []*ProjectUsageAlert, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -16291,12 +20967,12 @@ func (obj *pgxcockroachImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_P
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				project_usage_alert := &ProjectUsageAlert{}
+				err = __rows.Scan(&project_usage_alert.ProjectId, &project_usage_alert.Kind, &project_usage_alert.Threshold, &project_usage_alert.CreatedAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project)
+				rows = append(rows, project_usage_alert)
 			}
 			if err := __rows.Err(); err != nil {
 				return nil, err
@@ -16314,16 +20990,16 @@ func (obj *pgxcockroachImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_P
 
 }
 
-func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
-	project_created_at_less Project_CreatedAt_Field,
+func (obj *pgxcockroachImpl) Limited_ProjectUsageNotification_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
 	limit int, offset int64) (
-	rows []*Project, err error) {
+	rows []*ProjectUsageNotification, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.rate_limit, projects.max_buckets, projects.partner_id, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_usage_notifications.id, project_usage_notifications.project_id, project_usage_notifications.kind, project_usage_notifications.threshold, project_usage_notifications.period_start, project_usage_notifications.used, project_usage_notifications.usage_limit, project_usage_notifications.created_at, project_usage_notifications.sent_at FROM project_usage_notifications WHERE project_usage_notifications.project_id = ? ORDER BY project_usage_notifications.created_at DESC LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, project_created_at_less.value())
+	__values = append(__values, project_usage_notification_project_id.value())
 
 	__values = append(__values, limit, offset)
 
@@ -16331,7 +21007,7 @@ func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_Creat
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*Project, err error) {
+		rows, err = func() (rows []*ProjectUsageNotification, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -16339,12 +21015,12 @@ func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_Creat
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.RateLimit, &project.MaxBuckets, &project.PartnerId, &project.OwnerId, &project.CreatedAt)
+				project_usage_notification := &ProjectUsageNotification{}
+				err = __rows.Scan(&project_usage_notification.Id, &project_usage_notification.ProjectId, &project_usage_notification.Kind, &project_usage_notification.Threshold, &project_usage_notification.PeriodStart, &project_usage_notification.Used, &project_usage_notification.UsageLimit, &project_usage_notification.CreatedAt, &project_usage_notification.SentAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project)
+				rows = append(rows, project_usage_notification)
 			}
 			err = __rows.Err()
 			if err != nil {
@@ -16363,21 +21039,22 @@ func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_Creat
 
 }
 
-func (obj *pgxcockroachImpl) All_ProjectMember_By_MemberId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*ProjectMember, err error) {
+func (obj *pgxcockroachImpl) All_ProjectUsageNotification_By_ProjectId_And_PeriodStart_And_SentAt_Is_Null(ctx context.Context,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field) (
+	rows []*ProjectUsageNotification, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_usage_notifications.id, project_usage_notifications.project_id, project_usage_notifications.kind, project_usage_notifications.threshold, project_usage_notifications.period_start, project_usage_notifications.used, project_usage_notifications.usage_limit, project_usage_notifications.created_at, project_usage_notifications.sent_at FROM project_usage_notifications WHERE project_usage_notifications.project_id = ? AND project_usage_notifications.period_start = ? AND project_usage_notifications.sent_at is NULL")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, project_usage_notification_project_id.value(), project_usage_notification_period_start.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ProjectMember, err error) {
+		rows, err = func() (rows []*ProjectUsageNotification, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -16385,12 +21062,12 @@ func (obj *pgxcockroachImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 			defer __rows.Close()
 
 			for __rows.Next() {
-				project_member := &ProjectMember{}
-				err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+				project_usage_notification := &ProjectUsageNotification{}
+				err = __rows.Scan(&project_usage_notification.Id, &project_usage_notification.ProjectId, &project_usage_notification.Kind, &project_usage_notification.Threshold, &project_usage_notification.PeriodStart, &project_usage_notification.Used, &project_usage_notification.UsageLimit, &project_usage_notification.CreatedAt, &project_usage_notification.SentAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, project_member)
+				rows = append(rows, project_usage_notification)
 			}
 			if err := __rows.Err(); err != nil {
 				return nil, err
@@ -16413,7 +21090,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -16422,7 +21099,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -16435,7 +21112,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Head(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit FROM api_keys WHERE api_keys.head = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_head.value())
@@ -16444,7 +21121,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Head(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -16458,7 +21135,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Contex
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.created_at, api_keys.rate_limit, api_keys.bandwidth_limit FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_name.value(), api_key_project_id.value())
@@ -16467,7 +21144,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Contex
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.CreatedAt, &api_key.RateLimit, &api_key.BandwidthLimit)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -16980,6 +21657,53 @@ func (obj *pgxcockroachImpl) Paged_StoragenodeBandwidthRollupPhase2_By_Storageno
 
 }
 
+func (obj *pgxcockroachImpl) All_StoragenodeSettlementOutcome_By_StoragenodeId_And_IntervalStart_GreaterOrEqual_And_IntervalStart_Less_OrderBy_Asc_IntervalStart(ctx context.Context,
+	storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+	storagenode_settlement_outcome_interval_start_greater_or_equal StoragenodeSettlementOutcome_IntervalStart_Field,
+	storagenode_settlement_outcome_interval_start_less StoragenodeSettlementOutcome_IntervalStart_Field) (
+	rows []*StoragenodeSettlementOutcome, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_settlement_outcomes.storagenode_id, storagenode_settlement_outcomes.interval_start, storagenode_settlement_outcomes.settled_at, storagenode_settlement_outcomes.status, storagenode_settlement_outcomes.already_processed, storagenode_settlement_outcomes.orders_received, storagenode_settlement_outcomes.orders_accepted, storagenode_settlement_outcomes.orders_unknown_bucket, storagenode_settlement_outcomes.amount_settled, storagenode_settlement_outcomes.rejected_expired, storagenode_settlement_outcomes.rejected_bad_signature, storagenode_settlement_outcomes.rejected_already_settled, storagenode_settlement_outcomes.rejected_invalid FROM storagenode_settlement_outcomes WHERE storagenode_settlement_outcomes.storagenode_id = ? AND storagenode_settlement_outcomes.interval_start >= ? AND storagenode_settlement_outcomes.interval_start < ? ORDER BY storagenode_settlement_outcomes.interval_start")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_settlement_outcome_storagenode_id.value(), storagenode_settlement_outcome_interval_start_greater_or_equal.value(), storagenode_settlement_outcome_interval_start_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*StoragenodeSettlementOutcome, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				storagenode_settlement_outcome := &StoragenodeSettlementOutcome{}
+				err = __rows.Scan(&storagenode_settlement_outcome.StoragenodeId, &storagenode_settlement_outcome.IntervalStart, &storagenode_settlement_outcome.SettledAt, &storagenode_settlement_outcome.Status, &storagenode_settlement_outcome.AlreadyProcessed, &storagenode_settlement_outcome.OrdersReceived, &storagenode_settlement_outcome.OrdersAccepted, &storagenode_settlement_outcome.OrdersUnknownBucket, &storagenode_settlement_outcome.AmountSettled, &storagenode_settlement_outcome.RejectedExpired, &storagenode_settlement_outcome.RejectedBadSignature, &storagenode_settlement_outcome.RejectedAlreadySettled, &storagenode_settlement_outcome.RejectedInvalid)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, storagenode_settlement_outcome)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) All_StoragenodeStorageTally(ctx context.Context) (
 	rows []*StoragenodeStorageTally, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -17420,7 +22144,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -17429,7 +22153,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -17451,10 +22175,125 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx con
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &Id_Row{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Id)
+	row = &Id_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Id)
+	if err != nil {
+		return (*Id_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.versioning FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Versioning_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Versioning)
+	if err != nil {
+		return (*Versioning_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *DefaultRetentionMode_DefaultRetentionDays_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &DefaultRetentionMode_DefaultRetentionDays_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.DefaultRetentionMode, &row.DefaultRetentionDays)
+	if err != nil {
+		return (*DefaultRetentionMode_DefaultRetentionDays_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleRules_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.lifecycle_rules FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &LifecycleRules_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.LifecycleRules)
+	if err != nil {
+		return (*LifecycleRules_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Placement_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &Placement_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.Placement)
+	if err != nil {
+		return (*Placement_Row)(nil), obj.makeErr(err)
+	}
+	return row, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_StorageLimit_BucketMetainfo_BandwidthLimit_BucketMetainfo_ObjectLimit_BucketMetainfo_SegmentLimit_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	row = &StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.StorageLimit, &row.BandwidthLimit, &row.ObjectLimit, &row.SegmentLimit)
 	if err != nil {
-		return (*Id_Row)(nil), obj.makeErr(err)
+		return (*StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row)(nil), obj.makeErr(err)
 	}
 	return row, nil
 
@@ -17489,7 +22328,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -17509,7 +22348,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -17539,7 +22378,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -17559,7 +22398,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 				if err != nil {
 					return nil, err
 				}
@@ -18164,6 +23003,71 @@ func (obj *pgxcockroachImpl) Has_NodeApiVersion_By_Id_And_ApiVersion_GreaterOrEq
 
 }
 
+func (obj *pgxcockroachImpl) Get_AdminToken_By_SecretHash(ctx context.Context,
+	admin_token_secret_hash AdminToken_SecretHash_Field) (
+	admin_token *AdminToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_tokens.id, admin_tokens.name, admin_tokens.scopes, admin_tokens.secret_hash, admin_tokens.created_at, admin_tokens.expires_at, admin_tokens.revoked_at FROM admin_tokens WHERE admin_tokens.secret_hash = ?")
+
+	var __values []interface{}
+	__values = append(__values, admin_token_secret_hash.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_token = &AdminToken{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_token.Id, &admin_token.Name, &admin_token.Scopes, &admin_token.SecretHash, &admin_token.CreatedAt, &admin_token.ExpiresAt, &admin_token.RevokedAt)
+	if err != nil {
+		return (*AdminToken)(nil), obj.makeErr(err)
+	}
+	return admin_token, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_AdminToken_OrderBy_Asc_Name(ctx context.Context) (
+	rows []*AdminToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_tokens.id, admin_tokens.name, admin_tokens.scopes, admin_tokens.secret_hash, admin_tokens.created_at, admin_tokens.expires_at, admin_tokens.revoked_at FROM admin_tokens ORDER BY admin_tokens.name")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*AdminToken, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer __rows.Close()
+
+			for __rows.Next() {
+				admin_token := &AdminToken{}
+				err = __rows.Scan(&admin_token.Id, &admin_token.Name, &admin_token.Scopes, &admin_token.SecretHash, &admin_token.CreatedAt, &admin_token.ExpiresAt, &admin_token.RevokedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, admin_token)
+			}
+			if err := __rows.Err(); err != nil {
+				return nil, err
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) UpdateNoReturn_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -18192,53 +23096,276 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Irreparabledb_By_Segmentpath(ctx con
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("seg_damaged_unix_sec = ?"))
 	}
 
-	if update.RepairAttemptCount._set {
-		__values = append(__values, update.RepairAttemptCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
+	if update.RepairAttemptCount._set {
+		__values = append(__values, update.RepairAttemptCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("repair_attempt_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, irreparabledb_segmentpath.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxcockroachImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
+	accounting_timestamps_name AccountingTimestamps_Name_Field,
+	update AccountingTimestamps_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Value._set {
+		__values = append(__values, update.Value.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, accounting_timestamps_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.LastIpPort._set {
+		__values = append(__values, update.LastIpPort.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.Type._set {
+		__values = append(__values, update.Type.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("type = ?"))
+	}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+
+	if update.WalletFeatures._set {
+		__values = append(__values, update.WalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.PieceCount._set {
+		__values = append(__values, update.PieceCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	}
+
+	if update.Major._set {
+		__values = append(__values, update.Major.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	}
+
+	if update.Minor._set {
+		__values = append(__values, update.Minor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	}
+
+	if update.Patch._set {
+		__values = append(__values, update.Patch.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	}
+
+	if update.Hash._set {
+		__values = append(__values, update.Hash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hash = ?"))
+	}
+
+	if update.Timestamp._set {
+		__values = append(__values, update.Timestamp.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("timestamp = ?"))
+	}
+
+	if update.Release._set {
+		__values = append(__values, update.Release.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.Suspended._set {
+		__values = append(__values, update.Suspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	}
+
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
-	__args = append(__args, irreparabledb_segmentpath.value())
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return obj.makeErr(err)
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
 	}
-	return nil
-}
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
-	accounting_timestamps_name AccountingTimestamps_Name_Field,
-	update AccountingTimestamps_Update_Fields) (
-	err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __sets = &__sqlbundle_Hole{}
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE accounting_timestamps SET "), __sets, __sqlbundle_Literal(" WHERE accounting_timestamps.name = ?")}}
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
 
-	if update.Value._set {
-		__values = append(__values, update.Value.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("value = ?"))
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
-	__args = append(__args, accounting_timestamps_name.value())
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -18246,21 +23373,25 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx con
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	node = &Node{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return node, nil
 }
 
-func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
+func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
-	node *Node, err error) {
+	err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.contained, nodes.disqualified, nodes.suspended, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.online_score, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.unknown_audit_reputation_alpha, nodes.unknown_audit_reputation_beta, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -18281,6 +23412,11 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
 	}
 
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
 	if update.Protocol._set {
 		__values = append(__values, update.Protocol.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
@@ -18396,67 +23532,235 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
 	}
 
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	}
+
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	}
+
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	}
+
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
+
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
+
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	}
+
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxcockroachImpl) Update_AuditHistory_By_NodeId(ctx context.Context,
+	audit_history_node_id AuditHistory_NodeId_Field,
+	update AuditHistory_Update_Fields) (
+	audit_history *AuditHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE audit_histories SET "), __sets, __sqlbundle_Literal(" WHERE audit_histories.node_id = ? RETURNING audit_histories.node_id, audit_histories.history")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.History._set {
+		__values = append(__values, update.History.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("history = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, audit_history_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	audit_history = &AuditHistory{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&audit_history.NodeId, &audit_history.History)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_history, nil
+}
+
+func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
+	user_id User_Id_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.NormalizedEmail._set {
+		__values = append(__values, update.NormalizedEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("normalized_email = ?"))
+	}
+
+	if update.FullName._set {
+		__values = append(__values, update.FullName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("full_name = ?"))
+	}
+
+	if update.ShortName._set {
+		__values = append(__values, update.ShortName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("short_name = ?"))
+	}
+
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
+	}
+
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	}
+
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
+	}
+
+	if update.Position._set {
+		__values = append(__values, update.Position.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("position = ?"))
 	}
 
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	if update.CompanyName._set {
+		__values = append(__values, update.CompanyName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_name = ?"))
 	}
 
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	if update.CompanySize._set {
+		__values = append(__values, update.CompanySize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_size = ?"))
 	}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	if update.WorkingOn._set {
+		__values = append(__values, update.WorkingOn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
 	}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
 	}
 
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
 	}
 
-	if update.ExitInitiatedAt._set {
-		__values = append(__values, update.ExitInitiatedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
 	}
 
-	if update.ExitLoopCompletedAt._set {
-		__values = append(__values, update.ExitLoopCompletedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
 	}
 
-	if update.ExitFinishedAt._set {
-		__values = append(__values, update.ExitFinishedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
 	}
 
-	if update.ExitSuccess._set {
-		__values = append(__values, update.ExitSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
+	}
 
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
 
-	__args = append(__args, node_id.value())
+	__args = append(__args, user_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -18464,258 +23768,263 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Contained, &node.Disqualified, &node.Suspended, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.OnlineScore, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UnknownAuditReputationAlpha, &node.UnknownAuditReputationBeta, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess)
+	user = &User{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return user, nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	err error) {
+func (obj *pgxcockroachImpl) Update_User_By_Id_And_MfaLastCounter_Less(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_last_counter_less User_MfaLastCounter_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? AND users.mfa_last_counter < ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
 	}
 
-	if update.LastNet._set {
-		__values = append(__values, update.LastNet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	if update.NormalizedEmail._set {
+		__values = append(__values, update.NormalizedEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("normalized_email = ?"))
 	}
 
-	if update.LastIpPort._set {
-		__values = append(__values, update.LastIpPort.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	if update.FullName._set {
+		__values = append(__values, update.FullName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("full_name = ?"))
 	}
 
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	if update.ShortName._set {
+		__values = append(__values, update.ShortName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("short_name = ?"))
 	}
 
-	if update.Type._set {
-		__values = append(__values, update.Type.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("type = ?"))
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
 	}
 
-	if update.Email._set {
-		__values = append(__values, update.Email.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
-	if update.Wallet._set {
-		__values = append(__values, update.Wallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
 	}
 
-	if update.WalletFeatures._set {
-		__values = append(__values, update.WalletFeatures.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	if update.Position._set {
+		__values = append(__values, update.Position.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("position = ?"))
 	}
 
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	if update.CompanyName._set {
+		__values = append(__values, update.CompanyName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_name = ?"))
 	}
 
-	if update.PieceCount._set {
-		__values = append(__values, update.PieceCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	if update.CompanySize._set {
+		__values = append(__values, update.CompanySize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_size = ?"))
 	}
 
-	if update.Major._set {
-		__values = append(__values, update.Major.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	if update.WorkingOn._set {
+		__values = append(__values, update.WorkingOn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
 	}
 
-	if update.Minor._set {
-		__values = append(__values, update.Minor.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
 	}
 
-	if update.Patch._set {
-		__values = append(__values, update.Patch.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
 	}
 
-	if update.Hash._set {
-		__values = append(__values, update.Hash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hash = ?"))
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
 	}
 
-	if update.Timestamp._set {
-		__values = append(__values, update.Timestamp.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("timestamp = ?"))
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
 	}
 
-	if update.Release._set {
-		__values = append(__values, update.Release.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
 	}
 
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
 	}
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
 	}
 
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
 	}
 
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
 	}
 
-	if update.LastContactSuccess._set {
-		__values = append(__values, update.LastContactSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
-	}
+	__args = append(__args, user_id.value(), user_mfa_last_counter_less.value())
 
-	if update.LastContactFailure._set {
-		__values = append(__values, update.LastContactFailure.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
-	}
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
 
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	user = &User{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
+	if err == sql.ErrNoRows {
+		return nil, nil
 	}
-
-	if update.Suspended._set {
-		__values = append(__values, update.Suspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("suspended = ?"))
+	if err != nil {
+		return nil, obj.makeErr(err)
 	}
+	return user, nil
+}
 
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
+func (obj *pgxcockroachImpl) Update_User_By_Id_And_MfaFailedAttempts(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_failed_attempts User_MfaFailedAttempts_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
 
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? AND users.mfa_failed_attempts = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
 	}
 
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	if update.NormalizedEmail._set {
+		__values = append(__values, update.NormalizedEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("normalized_email = ?"))
 	}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	if update.FullName._set {
+		__values = append(__values, update.FullName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("full_name = ?"))
 	}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.ShortName._set {
+		__values = append(__values, update.ShortName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("short_name = ?"))
 	}
 
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.PasswordHash._set {
+		__values = append(__values, update.PasswordHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("password_hash = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
-	if update.ExitInitiatedAt._set {
-		__values = append(__values, update.ExitInitiatedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
 	}
 
-	if update.ExitLoopCompletedAt._set {
-		__values = append(__values, update.ExitLoopCompletedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	if update.Position._set {
+		__values = append(__values, update.Position.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("position = ?"))
 	}
 
-	if update.ExitFinishedAt._set {
-		__values = append(__values, update.ExitFinishedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	if update.CompanyName._set {
+		__values = append(__values, update.CompanyName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_name = ?"))
 	}
 
-	if update.ExitSuccess._set {
-		__values = append(__values, update.ExitSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	if update.CompanySize._set {
+		__values = append(__values, update.CompanySize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("company_size = ?"))
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	if update.WorkingOn._set {
+		__values = append(__values, update.WorkingOn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("working_on = ?"))
+	}
 
-	__args = append(__args, node_id.value())
+	if update.IsProfessional._set {
+		__values = append(__values, update.IsProfessional.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("is_professional = ?"))
+	}
 
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	if update.EmployeeCount._set {
+		__values = append(__values, update.EmployeeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return obj.makeErr(err)
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
 	}
-	return nil
-}
 
-func (obj *pgxcockroachImpl) Update_AuditHistory_By_NodeId(ctx context.Context,
-	audit_history_node_id AuditHistory_NodeId_Field,
-	update AuditHistory_Update_Fields) (
-	audit_history *AuditHistory, err error) {
-	defer mon.Task()(&ctx)(&err)
-	var __sets = &__sqlbundle_Hole{}
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE audit_histories SET "), __sets, __sqlbundle_Literal(" WHERE audit_histories.node_id = ? RETURNING audit_histories.node_id, audit_histories.history")}}
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
+	}
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []interface{}
-	var __args []interface{}
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
+	}
 
-	if update.History._set {
-		__values = append(__values, update.History.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("history = ?"))
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, audit_history_node_id.value())
+	__args = append(__args, user_id.value(), user_mfa_failed_attempts.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -18723,25 +24032,26 @@ func (obj *pgxcockroachImpl) Update_AuditHistory_By_NodeId(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	audit_history = &AuditHistory{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&audit_history.NodeId, &audit_history.History)
+	user = &User{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return audit_history, nil
+	return user, nil
 }
 
-func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
+func (obj *pgxcockroachImpl) Update_User_By_Id_And_MfaRecoveryCodes(ctx context.Context,
 	user_id User_Id_Field,
+	user_mfa_recovery_codes User_MfaRecoveryCodes_Field,
 	update User_Update_Fields) (
 	user *User, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? AND users.mfa_recovery_codes = ? RETURNING users.id, users.email, users.normalized_email, users.full_name, users.short_name, users.password_hash, users.status, users.partner_id, users.created_at, users.project_limit, users.position, users.company_name, users.company_size, users.working_on, users.is_professional, users.employee_count, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_last_counter, users.mfa_failed_attempts, users.mfa_locked_until")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -18812,11 +24122,41 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("employee_count = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
+	if update.MfaLastCounter._set {
+		__values = append(__values, update.MfaLastCounter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_last_counter = ?"))
+	}
+
+	if update.MfaFailedAttempts._set {
+		__values = append(__values, update.MfaFailedAttempts.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_failed_attempts = ?"))
+	}
+
+	if update.MfaLockedUntil._set {
+		__values = append(__values, update.MfaLockedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_locked_until = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, user_id.value())
+	__args = append(__args, user_id.value(), user_mfa_recovery_codes.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -18825,7 +24165,7 @@ func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&user.Id, &user.Email, &user.NormalizedEmail, &user.FullName, &user.ShortName, &user.PasswordHash, &user.Status, &user.PartnerId, &user.CreatedAt, &user.ProjectLimit, &user.Position, &user.CompanyName, &user.CompanySize, &user.WorkingOn, &user.IsProfessional, &user.EmployeeCount, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaLastCounter, &user.MfaFailedAttempts, &user.MfaLockedUntil)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -18901,6 +24241,85 @@ func (obj *pgxcockroachImpl) Update_Project_By_Id(ctx context.Context,
 	return project, nil
 }
 
+func (obj *pgxcockroachImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.created_at, project_members.role")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt, &project_member.Role)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
+func (obj *pgxcockroachImpl) UpdateNoReturn_ProjectUsageNotification_By_Id(ctx context.Context,
+	project_usage_notification_id ProjectUsageNotification_Id_Field,
+	update ProjectUsageNotification_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_usage_notifications SET "), __sets, __sqlbundle_Literal(" WHERE project_usage_notifications.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.SentAt._set {
+		__values = append(__values, update.SentAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sent_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, project_usage_notification_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
 func (obj *pgxcockroachImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
@@ -18919,6 +24338,16 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.RateLimit._set {
+		__values = append(__values, update.RateLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rate_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -19030,7 +24459,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.versioning, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.lifecycle_rules, bucket_metainfos.placement, bucket_metainfos.storage_limit, bucket_metainfos.bandwidth_limit, bucket_metainfos.object_limit, bucket_metainfos.segment_limit")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -19086,6 +24515,51 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_total_shares = ?"))
 	}
 
+	if update.Versioning._set {
+		__values = append(__values, update.Versioning.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("versioning = ?"))
+	}
+
+	if update.DefaultRetentionMode._set {
+		__values = append(__values, update.DefaultRetentionMode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_mode = ?"))
+	}
+
+	if update.DefaultRetentionDays._set {
+		__values = append(__values, update.DefaultRetentionDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_days = ?"))
+	}
+
+	if update.LifecycleRules._set {
+		__values = append(__values, update.LifecycleRules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("lifecycle_rules = ?"))
+	}
+
+	if update.Placement._set {
+		__values = append(__values, update.Placement.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
+	}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.ObjectLimit._set {
+		__values = append(__values, update.ObjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -19099,7 +24573,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Versioning, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.LifecycleRules, &bucket_metainfo.Placement, &bucket_metainfo.StorageLimit, &bucket_metainfo.BandwidthLimit, &bucket_metainfo.ObjectLimit, &bucket_metainfo.SegmentLimit)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -19301,35 +24775,115 @@ func (obj *pgxcockroachImpl) Update_Coupon_By_Id(ctx context.Context,
 		return nil, nil
 	}
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return nil, obj.makeErr(err)
+	}
+	return coupon, nil
+}
+
+func (obj *pgxcockroachImpl) Update_CouponUsage_By_CouponId_And_Period(ctx context.Context,
+	coupon_usage_coupon_id CouponUsage_CouponId_Field,
+	coupon_usage_period CouponUsage_Period_Field,
+	update CouponUsage_Update_Fields) (
+	coupon_usage *CouponUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE coupon_usages SET "), __sets, __sqlbundle_Literal(" WHERE coupon_usages.coupon_id = ? AND coupon_usages.period = ? RETURNING coupon_usages.coupon_id, coupon_usages.amount, coupon_usages.status, coupon_usages.period")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Status._set {
+		__values = append(__values, update.Status.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, coupon_usage_coupon_id.value(), coupon_usage_period.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	coupon_usage = &CouponUsage{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&coupon_usage.CouponId, &coupon_usage.Amount, &coupon_usage.Status, &coupon_usage.Period)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return coupon_usage, nil
+}
+
+func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
+	node_api_version_id NodeApiVersion_Id_Field,
+	node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
+	update NodeApiVersion_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_api_versions SET "), __sets, __sqlbundle_Literal(" WHERE node_api_versions.id = ? AND node_api_versions.api_version < ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ApiVersion._set {
+		__values = append(__values, update.ApiVersion.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_version = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_api_version_id.value(), node_api_version_api_version_less.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
 	}
-	return coupon, nil
+	return nil
 }
 
-func (obj *pgxcockroachImpl) Update_CouponUsage_By_CouponId_And_Period(ctx context.Context,
-	coupon_usage_coupon_id CouponUsage_CouponId_Field,
-	coupon_usage_period CouponUsage_Period_Field,
-	update CouponUsage_Update_Fields) (
-	coupon_usage *CouponUsage, err error) {
+func (obj *pgxcockroachImpl) Update_AdminToken_By_Id_And_RevokedAt_Is_Null(ctx context.Context,
+	admin_token_id AdminToken_Id_Field,
+	update AdminToken_Update_Fields) (
+	admin_token *AdminToken, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE coupon_usages SET "), __sets, __sqlbundle_Literal(" WHERE coupon_usages.coupon_id = ? AND coupon_usages.period = ? RETURNING coupon_usages.coupon_id, coupon_usages.amount, coupon_usages.status, coupon_usages.period")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_tokens SET "), __sets, __sqlbundle_Literal(" WHERE admin_tokens.id = ? AND admin_tokens.revoked_at is NULL RETURNING admin_tokens.id, admin_tokens.name, admin_tokens.scopes, admin_tokens.secret_hash, admin_tokens.created_at, admin_tokens.expires_at, admin_tokens.revoked_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.Status._set {
-		__values = append(__values, update.Status.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
+	if update.RevokedAt._set {
+		__values = append(__values, update.RevokedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("revoked_at = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, coupon_usage_coupon_id.value(), coupon_usage_period.value())
+	__args = append(__args, admin_token_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -19337,42 +24891,40 @@ func (obj *pgxcockroachImpl) Update_CouponUsage_By_CouponId_And_Period(ctx conte
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	coupon_usage = &CouponUsage{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&coupon_usage.CouponId, &coupon_usage.Amount, &coupon_usage.Status, &coupon_usage.Period)
+	admin_token = &AdminToken{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_token.Id, &admin_token.Name, &admin_token.Scopes, &admin_token.SecretHash, &admin_token.CreatedAt, &admin_token.ExpiresAt, &admin_token.RevokedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return coupon_usage, nil
+	return admin_token, nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
-	node_api_version_id NodeApiVersion_Id_Field,
-	node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
-	update NodeApiVersion_Update_Fields) (
+func (obj *pgxcockroachImpl) UpdateNoReturn_AdminAuditLog_By_Id_And_StatusCode_Is_Null(ctx context.Context,
+	admin_audit_log_id AdminAuditLog_Id_Field,
+	update AdminAuditLog_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_api_versions SET "), __sets, __sqlbundle_Literal(" WHERE node_api_versions.id = ? AND node_api_versions.api_version < ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE admin_audit_logs SET "), __sets, __sqlbundle_Literal(" WHERE admin_audit_logs.id = ? AND admin_audit_logs.status_code is NULL")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.ApiVersion._set {
-		__values = append(__values, update.ApiVersion.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_version = ?"))
+	if update.StatusCode._set {
+		__values = append(__values, update.StatusCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status_code = ?"))
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
 
-	__args = append(__args, node_api_version_id.value(), node_api_version_api_version_less.value())
+	__args = append(__args, admin_audit_log_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -19441,6 +24993,33 @@ func (obj *pgxcockroachImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Con
 
 }
 
+func (obj *pgxcockroachImpl) Delete_DurabilityHistogram_By_ComputedAt_Less(ctx context.Context,
+	durability_histogram_computed_at_less DurabilityHistogram_ComputedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM durability_histograms WHERE durability_histograms.computed_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, durability_histogram_computed_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_Injuredsegment_By_UpdatedAt_Less(ctx context.Context,
 	injuredsegment_updated_at_less Injuredsegment_UpdatedAt_Field) (
 	count int64, err error) {
@@ -19550,6 +25129,33 @@ func (obj *pgxcockroachImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx
 
 }
 
+func (obj *pgxcockroachImpl) Delete_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_usage_alerts WHERE project_usage_alerts.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_usage_alert_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	deleted bool, err error) {
@@ -19577,6 +25183,33 @@ func (obj *pgxcockroachImpl) Delete_ApiKey_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Delete_StoragenodeSettlementOutcome_By_SettledAt_Less(ctx context.Context,
+	storagenode_settlement_outcome_settled_at_less StoragenodeSettlementOutcome_SettledAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM storagenode_settlement_outcomes WHERE storagenode_settlement_outcomes.settled_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_settlement_outcome_settled_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
 	reset_password_token_secret ResetPasswordToken_Secret_Field) (
 	deleted bool, err error) {
@@ -19798,6 +25431,36 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM sso_identities;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_usage_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19888,6 +25551,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_settlement_outcomes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19958,6 +25631,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reported_lost_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20078,6 +25761,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_histograms;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20158,6 +25851,36 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_usage_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20230,6 +25953,15 @@ func (rx *Rx) Rollback() (err error) {
 	return err
 }
 
+func (rx *Rx) All_AdminToken_OrderBy_Asc_Name(ctx context.Context) (
+	rows []*AdminToken, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_AdminToken_OrderBy_Asc_Name(ctx)
+}
+
 func (rx *Rx) All_BucketStorageTally(ctx context.Context) (
 	rows []*BucketStorageTally, err error) {
 	var tx *Tx
@@ -20293,6 +26025,27 @@ func (rx *Rx) All_Coupon_By_UserId_OrderBy_Desc_CreatedAt(ctx context.Context,
 	return tx.All_Coupon_By_UserId_OrderBy_Desc_CreatedAt(ctx, coupon_user_id)
 }
 
+func (rx *Rx) All_DurabilityHistogram_By_ProjectId(ctx context.Context,
+	durability_histogram_project_id DurabilityHistogram_ProjectId_Field) (
+	rows []*DurabilityHistogram, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_DurabilityHistogram_By_ProjectId(ctx, durability_histogram_project_id)
+}
+
+func (rx *Rx) All_DurabilityHistogram_By_ProjectId_And_BucketName(ctx context.Context,
+	durability_histogram_project_id DurabilityHistogram_ProjectId_Field,
+	durability_histogram_bucket_name DurabilityHistogram_BucketName_Field) (
+	rows []*DurabilityHistogram, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_DurabilityHistogram_By_ProjectId_And_BucketName(ctx, durability_histogram_project_id, durability_histogram_bucket_name)
+}
+
 func (rx *Rx) All_Node_Id(ctx context.Context) (
 	rows []*Id_Row, err error) {
 	var tx *Tx
@@ -20330,6 +26083,27 @@ func (rx *Rx) All_ProjectMember_By_MemberId(ctx context.Context,
 	return tx.All_ProjectMember_By_MemberId(ctx, project_member_member_id)
 }
 
+func (rx *Rx) All_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+	rows []*ProjectUsageAlert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectUsageAlert_By_ProjectId(ctx, project_usage_alert_project_id)
+}
+
+func (rx *Rx) All_ProjectUsageNotification_By_ProjectId_And_PeriodStart_And_SentAt_Is_Null(ctx context.Context,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field) (
+	rows []*ProjectUsageNotification, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectUsageNotification_By_ProjectId_And_PeriodStart_And_SentAt_Is_Null(ctx, project_usage_notification_project_id, project_usage_notification_period_start)
+}
+
 func (rx *Rx) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
 	project_created_at_less Project_CreatedAt_Field) (
 	rows []*Project, err error) {
@@ -20402,6 +26176,18 @@ func (rx *Rx) All_StoragenodePaystub_By_NodeId(ctx context.Context,
 	return tx.All_StoragenodePaystub_By_NodeId(ctx, storagenode_paystub_node_id)
 }
 
+func (rx *Rx) All_StoragenodeSettlementOutcome_By_StoragenodeId_And_IntervalStart_GreaterOrEqual_And_IntervalStart_Less_OrderBy_Asc_IntervalStart(ctx context.Context,
+	storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+	storagenode_settlement_outcome_interval_start_greater_or_equal StoragenodeSettlementOutcome_IntervalStart_Field,
+	storagenode_settlement_outcome_interval_start_less StoragenodeSettlementOutcome_IntervalStart_Field) (
+	rows []*StoragenodeSettlementOutcome, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_StoragenodeSettlementOutcome_By_StoragenodeId_And_IntervalStart_GreaterOrEqual_And_IntervalStart_Less_OrderBy_Asc_IntervalStart(ctx, storagenode_settlement_outcome_storagenode_id, storagenode_settlement_outcome_interval_start_greater_or_equal, storagenode_settlement_outcome_interval_start_less)
+}
+
 func (rx *Rx) All_StoragenodeStorageTally(ctx context.Context) (
 	rows []*StoragenodeStorageTally, err error) {
 	var tx *Tx
@@ -20443,6 +26229,22 @@ func (rx *Rx) CreateNoReturn_AccountingTimestamps(ctx context.Context,
 
 }
 
+func (rx *Rx) CreateNoReturn_AdminToken(ctx context.Context,
+	admin_token_id AdminToken_Id_Field,
+	admin_token_name AdminToken_Name_Field,
+	admin_token_scopes AdminToken_Scopes_Field,
+	admin_token_secret_hash AdminToken_SecretHash_Field,
+	admin_token_created_at AdminToken_CreatedAt_Field,
+	optional AdminToken_Create_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_AdminToken(ctx, admin_token_id, admin_token_name, admin_token_scopes, admin_token_secret_hash, admin_token_created_at, optional)
+
+}
+
 func (rx *Rx) CreateNoReturn_BucketStorageTally(ctx context.Context,
 	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
 	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
@@ -20486,7 +26288,51 @@ func (rx *Rx) CreateNoReturn_PeerIdentity(ctx context.Context,
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.CreateNoReturn_PeerIdentity(ctx, peer_identity_node_id, peer_identity_leaf_serial_number, peer_identity_chain)
+	return tx.CreateNoReturn_PeerIdentity(ctx, peer_identity_node_id, peer_identity_leaf_serial_number, peer_identity_chain)
+
+}
+
+func (rx *Rx) CreateNoReturn_ProjectUsageAlert(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field,
+	project_usage_alert_kind ProjectUsageAlert_Kind_Field,
+	project_usage_alert_threshold ProjectUsageAlert_Threshold_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_ProjectUsageAlert(ctx, project_usage_alert_project_id, project_usage_alert_kind, project_usage_alert_threshold)
+
+}
+
+func (rx *Rx) CreateNoReturn_ProjectUsageNotification(ctx context.Context,
+	project_usage_notification_id ProjectUsageNotification_Id_Field,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	project_usage_notification_kind ProjectUsageNotification_Kind_Field,
+	project_usage_notification_threshold ProjectUsageNotification_Threshold_Field,
+	project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field,
+	project_usage_notification_used ProjectUsageNotification_Used_Field,
+	project_usage_notification_usage_limit ProjectUsageNotification_UsageLimit_Field,
+	optional ProjectUsageNotification_Create_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_ProjectUsageNotification(ctx, project_usage_notification_id, project_usage_notification_project_id, project_usage_notification_kind, project_usage_notification_threshold, project_usage_notification_period_start, project_usage_notification_used, project_usage_notification_usage_limit, optional)
+
+}
+
+func (rx *Rx) CreateNoReturn_ReportedLostPiece(ctx context.Context,
+	reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+	reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field,
+	reported_lost_piece_reported_at ReportedLostPiece_ReportedAt_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_ReportedLostPiece(ctx, reported_lost_piece_node_id, reported_lost_piece_piece_id, reported_lost_piece_reported_at)
 
 }
 
@@ -20502,6 +26348,19 @@ func (rx *Rx) CreateNoReturn_Revocation(ctx context.Context,
 
 }
 
+func (rx *Rx) CreateNoReturn_SsoIdentity(ctx context.Context,
+	sso_identity_issuer SsoIdentity_Issuer_Field,
+	sso_identity_subject SsoIdentity_Subject_Field,
+	sso_identity_user_id SsoIdentity_UserId_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_SsoIdentity(ctx, sso_identity_issuer, sso_identity_subject, sso_identity_user_id)
+
+}
+
 func (rx *Rx) CreateNoReturn_StoragenodePayment(ctx context.Context,
 	storagenode_payment_node_id StoragenodePayment_NodeId_Field,
 	storagenode_payment_period StoragenodePayment_Period_Field,
@@ -20516,6 +26375,45 @@ func (rx *Rx) CreateNoReturn_StoragenodePayment(ctx context.Context,
 
 }
 
+func (rx *Rx) CreateNoReturn_StoragenodeSettlementOutcome(ctx context.Context,
+	storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+	storagenode_settlement_outcome_interval_start StoragenodeSettlementOutcome_IntervalStart_Field,
+	storagenode_settlement_outcome_settled_at StoragenodeSettlementOutcome_SettledAt_Field,
+	storagenode_settlement_outcome_status StoragenodeSettlementOutcome_Status_Field,
+	storagenode_settlement_outcome_already_processed StoragenodeSettlementOutcome_AlreadyProcessed_Field,
+	storagenode_settlement_outcome_orders_received StoragenodeSettlementOutcome_OrdersReceived_Field,
+	storagenode_settlement_outcome_orders_accepted StoragenodeSettlementOutcome_OrdersAccepted_Field,
+	storagenode_settlement_outcome_orders_unknown_bucket StoragenodeSettlementOutcome_OrdersUnknownBucket_Field,
+	storagenode_settlement_outcome_amount_settled StoragenodeSettlementOutcome_AmountSettled_Field,
+	storagenode_settlement_outcome_rejected_expired StoragenodeSettlementOutcome_RejectedExpired_Field,
+	storagenode_settlement_outcome_rejected_bad_signature StoragenodeSettlementOutcome_RejectedBadSignature_Field,
+	storagenode_settlement_outcome_rejected_already_settled StoragenodeSettlementOutcome_RejectedAlreadySettled_Field,
+	storagenode_settlement_outcome_rejected_invalid StoragenodeSettlementOutcome_RejectedInvalid_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_StoragenodeSettlementOutcome(ctx, storagenode_settlement_outcome_storagenode_id, storagenode_settlement_outcome_interval_start, storagenode_settlement_outcome_settled_at, storagenode_settlement_outcome_status, storagenode_settlement_outcome_already_processed, storagenode_settlement_outcome_orders_received, storagenode_settlement_outcome_orders_accepted, storagenode_settlement_outcome_orders_unknown_bucket, storagenode_settlement_outcome_amount_settled, storagenode_settlement_outcome_rejected_expired, storagenode_settlement_outcome_rejected_bad_signature, storagenode_settlement_outcome_rejected_already_settled, storagenode_settlement_outcome_rejected_invalid)
+
+}
+
+func (rx *Rx) Create_AdminAuditLog(ctx context.Context,
+	admin_audit_log_created_at AdminAuditLog_CreatedAt_Field,
+	admin_audit_log_actor AdminAuditLog_Actor_Field,
+	admin_audit_log_method AdminAuditLog_Method_Field,
+	admin_audit_log_path AdminAuditLog_Path_Field,
+	admin_audit_log_details AdminAuditLog_Details_Field,
+	optional AdminAuditLog_Create_Fields) (
+	admin_audit_log *AdminAuditLog, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AdminAuditLog(ctx, admin_audit_log_created_at, admin_audit_log_actor, admin_audit_log_method, admin_audit_log_path, admin_audit_log_details, optional)
+
+}
+
 func (rx *Rx) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
@@ -20651,13 +26549,14 @@ func (rx *Rx) Create_Project(ctx context.Context,
 
 func (rx *Rx) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	optional ProjectMember_Create_Fields) (
 	project_member *ProjectMember, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
+	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id, optional)
 
 }
 
@@ -20814,6 +26713,17 @@ func (rx *Rx) Delete_Coupon_By_Id(ctx context.Context,
 	return tx.Delete_Coupon_By_Id(ctx, coupon_id)
 }
 
+func (rx *Rx) Delete_DurabilityHistogram_By_ComputedAt_Less(ctx context.Context,
+	durability_histogram_computed_at_less DurabilityHistogram_ComputedAt_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_DurabilityHistogram_By_ComputedAt_Less(ctx, durability_histogram_computed_at_less)
+
+}
+
 func (rx *Rx) Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
 	graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
 	count int64, err error) {
@@ -20890,6 +26800,17 @@ func (rx *Rx) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context
 	return tx.Delete_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id)
 }
 
+func (rx *Rx) Delete_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+	project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ProjectUsageAlert_By_ProjectId(ctx, project_usage_alert_project_id)
+
+}
+
 func (rx *Rx) Delete_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	deleted bool, err error) {
@@ -20910,6 +26831,17 @@ func (rx *Rx) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
 	return tx.Delete_ResetPasswordToken_By_Secret(ctx, reset_password_token_secret)
 }
 
+func (rx *Rx) Delete_StoragenodeSettlementOutcome_By_SettledAt_Less(ctx context.Context,
+	storagenode_settlement_outcome_settled_at_less StoragenodeSettlementOutcome_SettledAt_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_StoragenodeSettlementOutcome_By_SettledAt_Less(ctx, storagenode_settlement_outcome_settled_at_less)
+
+}
+
 func (rx *Rx) Delete_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	deleted bool, err error) {
@@ -20930,6 +26862,16 @@ func (rx *Rx) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 	return tx.Find_AccountingTimestamps_Value_By_Name(ctx, accounting_timestamps_name)
 }
 
+func (rx *Rx) Get_AdminToken_By_SecretHash(ctx context.Context,
+	admin_token_secret_hash AdminToken_SecretHash_Field) (
+	admin_token *AdminToken, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_AdminToken_By_SecretHash(ctx, admin_token_secret_hash)
+}
+
 func (rx *Rx) Get_ApiKey_By_Head(ctx context.Context,
 	api_key_head ApiKey_Head_Field) (
 	api_key *ApiKey, err error) {
@@ -20982,6 +26924,17 @@ func (rx *Rx) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	return tx.Get_BucketMetainfo_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
+func (rx *Rx) Get_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *DefaultRetentionMode_DefaultRetentionDays_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
 func (rx *Rx) Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -20993,6 +26946,50 @@ func (rx *Rx) Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx context.Context,
 	return tx.Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
+func (rx *Rx) Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *LifecycleRules_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Placement_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_StorageLimit_BucketMetainfo_BandwidthLimit_BucketMetainfo_ObjectLimit_BucketMetainfo_SegmentLimit_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_StorageLimit_BucketMetainfo_BandwidthLimit_BucketMetainfo_ObjectLimit_BucketMetainfo_SegmentLimit_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
+func (rx *Rx) Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+	bucket_metainfo_name BucketMetainfo_Name_Field) (
+	row *Versioning_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+}
+
 func (rx *Rx) Get_CouponCode_By_Name(ctx context.Context,
 	coupon_code_name CouponCode_Name_Field) (
 	coupon_code *CouponCode, err error) {
@@ -21175,6 +27172,17 @@ func (rx *Rx) Get_ResetPasswordToken_By_Secret(ctx context.Context,
 	return tx.Get_ResetPasswordToken_By_Secret(ctx, reset_password_token_secret)
 }
 
+func (rx *Rx) Get_SsoIdentity_UserId_By_Issuer_And_Subject(ctx context.Context,
+	sso_identity_issuer SsoIdentity_Issuer_Field,
+	sso_identity_subject SsoIdentity_Subject_Field) (
+	row *UserId_Row, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_SsoIdentity_UserId_By_Issuer_And_Subject(ctx, sso_identity_issuer, sso_identity_subject)
+}
+
 func (rx *Rx) Get_StoragenodePaystub_By_NodeId_And_Period(ctx context.Context,
 	storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
 	storagenode_paystub_period StoragenodePaystub_Period_Field) (
@@ -21281,6 +27289,27 @@ func (rx *Rx) Has_NodeApiVersion_By_Id_And_ApiVersion_GreaterOrEqual(ctx context
 	return tx.Has_NodeApiVersion_By_Id_And_ApiVersion_GreaterOrEqual(ctx, node_api_version_id, node_api_version_api_version_greater_or_equal)
 }
 
+func (rx *Rx) Has_ReportedLostPiece_By_NodeId_And_PieceId(ctx context.Context,
+	reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+	reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field) (
+	has bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Has_ReportedLostPiece_By_NodeId_And_PieceId(ctx, reported_lost_piece_node_id, reported_lost_piece_piece_id)
+}
+
+func (rx *Rx) Has_SsoIdentity_By_UserId(ctx context.Context,
+	sso_identity_user_id SsoIdentity_UserId_Field) (
+	has bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Has_SsoIdentity_By_UserId(ctx, sso_identity_user_id)
+}
+
 func (rx *Rx) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
@@ -21339,6 +27368,17 @@ func (rx *Rx) Limited_Irreparabledb_By_Segmentpath_Greater_OrderBy_Asc_Segmentpa
 	return tx.Limited_Irreparabledb_By_Segmentpath_Greater_OrderBy_Asc_Segmentpath(ctx, irreparabledb_segmentpath_greater, limit, offset)
 }
 
+func (rx *Rx) Limited_ProjectUsageNotification_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+	project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*ProjectUsageNotification, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_ProjectUsageNotification_By_ProjectId_OrderBy_Desc_CreatedAt(ctx, project_usage_notification_project_id, limit, offset)
+}
+
 func (rx *Rx) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
 	project_created_at_less Project_CreatedAt_Field,
 	limit int, offset int64) (
@@ -21350,6 +27390,16 @@ func (rx *Rx) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx contex
 	return tx.Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx, project_created_at_less, limit, offset)
 }
 
+func (rx *Rx) Limited_ReportedLostPiece_OrderBy_Asc_ReportedAt(ctx context.Context,
+	limit int, offset int64) (
+	rows []*ReportedLostPiece, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_ReportedLostPiece_OrderBy_Asc_ReportedAt(ctx, limit, offset)
+}
+
 func (rx *Rx) Limited_StoragenodePayment_By_NodeId_And_Period_OrderBy_Desc_Id(ctx context.Context,
 	storagenode_payment_node_id StoragenodePayment_NodeId_Field,
 	storagenode_payment_period StoragenodePayment_Period_Field,
@@ -21518,6 +27568,17 @@ func (rx *Rx) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
 	return tx.UpdateNoReturn_AccountingTimestamps_By_Name(ctx, accounting_timestamps_name, update)
 }
 
+func (rx *Rx) UpdateNoReturn_AdminAuditLog_By_Id_And_StatusCode_Is_Null(ctx context.Context,
+	admin_audit_log_id AdminAuditLog_Id_Field,
+	update AdminAuditLog_Update_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.UpdateNoReturn_AdminAuditLog_By_Id_And_StatusCode_Is_Null(ctx, admin_audit_log_id, update)
+}
+
 func (rx *Rx) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	update ApiKey_Update_Fields) (
@@ -21587,6 +27648,28 @@ func (rx *Rx) UpdateNoReturn_PeerIdentity_By_NodeId(ctx context.Context,
 	return tx.UpdateNoReturn_PeerIdentity_By_NodeId(ctx, peer_identity_node_id, update)
 }
 
+func (rx *Rx) UpdateNoReturn_ProjectUsageNotification_By_Id(ctx context.Context,
+	project_usage_notification_id ProjectUsageNotification_Id_Field,
+	update ProjectUsageNotification_Update_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.UpdateNoReturn_ProjectUsageNotification_By_Id(ctx, project_usage_notification_id, update)
+}
+
+func (rx *Rx) Update_AdminToken_By_Id_And_RevokedAt_Is_Null(ctx context.Context,
+	admin_token_id AdminToken_Id_Field,
+	update AdminToken_Update_Fields) (
+	admin_token *AdminToken, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_AdminToken_By_Id_And_RevokedAt_Is_Null(ctx, admin_token_id, update)
+}
+
 func (rx *Rx) Update_AuditHistory_By_NodeId(ctx context.Context,
 	audit_history_node_id AuditHistory_NodeId_Field,
 	update AuditHistory_Update_Fields) (
@@ -21655,6 +27738,18 @@ func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 	return tx.Update_Node_By_Id(ctx, node_id, update)
 }
 
+func (rx *Rx) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -21699,7 +27794,46 @@ func (rx *Rx) Update_User_By_Id(ctx context.Context,
 	return tx.Update_User_By_Id(ctx, user_id, update)
 }
 
+func (rx *Rx) Update_User_By_Id_And_MfaFailedAttempts(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_failed_attempts User_MfaFailedAttempts_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_User_By_Id_And_MfaFailedAttempts(ctx, user_id, user_mfa_failed_attempts, update)
+}
+
+func (rx *Rx) Update_User_By_Id_And_MfaLastCounter_Less(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_last_counter_less User_MfaLastCounter_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_User_By_Id_And_MfaLastCounter_Less(ctx, user_id, user_mfa_last_counter_less, update)
+}
+
+func (rx *Rx) Update_User_By_Id_And_MfaRecoveryCodes(ctx context.Context,
+	user_id User_Id_Field,
+	user_mfa_recovery_codes User_MfaRecoveryCodes_Field,
+	update User_Update_Fields) (
+	user *User, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_User_By_Id_And_MfaRecoveryCodes(ctx, user_id, user_mfa_recovery_codes, update)
+}
+
 type Methods interface {
+	All_AdminToken_OrderBy_Asc_Name(ctx context.Context) (
+		rows []*AdminToken, err error)
+
 	All_BucketStorageTally(ctx context.Context) (
 		rows []*BucketStorageTally, err error)
 
@@ -21727,6 +27861,15 @@ type Methods interface {
 		coupon_user_id Coupon_UserId_Field) (
 		rows []*Coupon, err error)
 
+	All_DurabilityHistogram_By_ProjectId(ctx context.Context,
+		durability_histogram_project_id DurabilityHistogram_ProjectId_Field) (
+		rows []*DurabilityHistogram, err error)
+
+	All_DurabilityHistogram_By_ProjectId_And_BucketName(ctx context.Context,
+		durability_histogram_project_id DurabilityHistogram_ProjectId_Field,
+		durability_histogram_bucket_name DurabilityHistogram_BucketName_Field) (
+		rows []*DurabilityHistogram, err error)
+
 	All_Node_Id(ctx context.Context) (
 		rows []*Id_Row, err error)
 
@@ -21740,6 +27883,15 @@ type Methods interface {
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*ProjectMember, err error)
 
+	All_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+		project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+		rows []*ProjectUsageAlert, err error)
+
+	All_ProjectUsageNotification_By_ProjectId_And_PeriodStart_And_SentAt_Is_Null(ctx context.Context,
+		project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+		project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field) (
+		rows []*ProjectUsageNotification, err error)
+
 	All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
 		project_created_at_less Project_CreatedAt_Field) (
 		rows []*Project, err error)
@@ -21770,6 +27922,12 @@ type Methods interface {
 		storagenode_paystub_node_id StoragenodePaystub_NodeId_Field) (
 		rows []*StoragenodePaystub, err error)
 
+	All_StoragenodeSettlementOutcome_By_StoragenodeId_And_IntervalStart_GreaterOrEqual_And_IntervalStart_Less_OrderBy_Asc_IntervalStart(ctx context.Context,
+		storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+		storagenode_settlement_outcome_interval_start_greater_or_equal StoragenodeSettlementOutcome_IntervalStart_Field,
+		storagenode_settlement_outcome_interval_start_less StoragenodeSettlementOutcome_IntervalStart_Field) (
+		rows []*StoragenodeSettlementOutcome, err error)
+
 	All_StoragenodeStorageTally(ctx context.Context) (
 		rows []*StoragenodeStorageTally, err error)
 
@@ -21786,6 +27944,15 @@ type Methods interface {
 		accounting_timestamps_value AccountingTimestamps_Value_Field) (
 		err error)
 
+	CreateNoReturn_AdminToken(ctx context.Context,
+		admin_token_id AdminToken_Id_Field,
+		admin_token_name AdminToken_Name_Field,
+		admin_token_scopes AdminToken_Scopes_Field,
+		admin_token_secret_hash AdminToken_SecretHash_Field,
+		admin_token_created_at AdminToken_CreatedAt_Field,
+		optional AdminToken_Create_Fields) (
+		err error)
+
 	CreateNoReturn_BucketStorageTally(ctx context.Context,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
@@ -21812,11 +27979,40 @@ type Methods interface {
 		peer_identity_chain PeerIdentity_Chain_Field) (
 		err error)
 
+	CreateNoReturn_ProjectUsageAlert(ctx context.Context,
+		project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field,
+		project_usage_alert_kind ProjectUsageAlert_Kind_Field,
+		project_usage_alert_threshold ProjectUsageAlert_Threshold_Field) (
+		err error)
+
+	CreateNoReturn_ProjectUsageNotification(ctx context.Context,
+		project_usage_notification_id ProjectUsageNotification_Id_Field,
+		project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+		project_usage_notification_kind ProjectUsageNotification_Kind_Field,
+		project_usage_notification_threshold ProjectUsageNotification_Threshold_Field,
+		project_usage_notification_period_start ProjectUsageNotification_PeriodStart_Field,
+		project_usage_notification_used ProjectUsageNotification_Used_Field,
+		project_usage_notification_usage_limit ProjectUsageNotification_UsageLimit_Field,
+		optional ProjectUsageNotification_Create_Fields) (
+		err error)
+
+	CreateNoReturn_ReportedLostPiece(ctx context.Context,
+		reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+		reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field,
+		reported_lost_piece_reported_at ReportedLostPiece_ReportedAt_Field) (
+		err error)
+
 	CreateNoReturn_Revocation(ctx context.Context,
 		revocation_revoked Revocation_Revoked_Field,
 		revocation_api_key_id Revocation_ApiKeyId_Field) (
 		err error)
 
+	CreateNoReturn_SsoIdentity(ctx context.Context,
+		sso_identity_issuer SsoIdentity_Issuer_Field,
+		sso_identity_subject SsoIdentity_Subject_Field,
+		sso_identity_user_id SsoIdentity_UserId_Field) (
+		err error)
+
 	CreateNoReturn_StoragenodePayment(ctx context.Context,
 		storagenode_payment_node_id StoragenodePayment_NodeId_Field,
 		storagenode_payment_period StoragenodePayment_Period_Field,
@@ -21824,6 +28020,31 @@ type Methods interface {
 		optional StoragenodePayment_Create_Fields) (
 		err error)
 
+	CreateNoReturn_StoragenodeSettlementOutcome(ctx context.Context,
+		storagenode_settlement_outcome_storagenode_id StoragenodeSettlementOutcome_StoragenodeId_Field,
+		storagenode_settlement_outcome_interval_start StoragenodeSettlementOutcome_IntervalStart_Field,
+		storagenode_settlement_outcome_settled_at StoragenodeSettlementOutcome_SettledAt_Field,
+		storagenode_settlement_outcome_status StoragenodeSettlementOutcome_Status_Field,
+		storagenode_settlement_outcome_already_processed StoragenodeSettlementOutcome_AlreadyProcessed_Field,
+		storagenode_settlement_outcome_orders_received StoragenodeSettlementOutcome_OrdersReceived_Field,
+		storagenode_settlement_outcome_orders_accepted StoragenodeSettlementOutcome_OrdersAccepted_Field,
+		storagenode_settlement_outcome_orders_unknown_bucket StoragenodeSettlementOutcome_OrdersUnknownBucket_Field,
+		storagenode_settlement_outcome_amount_settled StoragenodeSettlementOutcome_AmountSettled_Field,
+		storagenode_settlement_outcome_rejected_expired StoragenodeSettlementOutcome_RejectedExpired_Field,
+		storagenode_settlement_outcome_rejected_bad_signature StoragenodeSettlementOutcome_RejectedBadSignature_Field,
+		storagenode_settlement_outcome_rejected_already_settled StoragenodeSettlementOutcome_RejectedAlreadySettled_Field,
+		storagenode_settlement_outcome_rejected_invalid StoragenodeSettlementOutcome_RejectedInvalid_Field) (
+		err error)
+
+	Create_AdminAuditLog(ctx context.Context,
+		admin_audit_log_created_at AdminAuditLog_CreatedAt_Field,
+		admin_audit_log_actor AdminAuditLog_Actor_Field,
+		admin_audit_log_method AdminAuditLog_Method_Field,
+		admin_audit_log_path AdminAuditLog_Path_Field,
+		admin_audit_log_details AdminAuditLog_Details_Field,
+		optional AdminAuditLog_Create_Fields) (
+		admin_audit_log *AdminAuditLog, err error)
+
 	Create_ApiKey(ctx context.Context,
 		api_key_id ApiKey_Id_Field,
 		api_key_project_id ApiKey_ProjectId_Field,
@@ -21903,7 +28124,8 @@ type Methods interface {
 
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
-		project_member_project_id ProjectMember_ProjectId_Field) (
+		project_member_project_id ProjectMember_ProjectId_Field,
+		optional ProjectMember_Create_Fields) (
 		project_member *ProjectMember, err error)
 
 	Create_RegistrationToken(ctx context.Context,
@@ -21979,6 +28201,10 @@ type Methods interface {
 		coupon_id Coupon_Id_Field) (
 		deleted bool, err error)
 
+	Delete_DurabilityHistogram_By_ComputedAt_Less(ctx context.Context,
+		durability_histogram_computed_at_less DurabilityHistogram_ComputedAt_Field) (
+		count int64, err error)
+
 	Delete_GracefulExitTransferQueue_By_NodeId(ctx context.Context,
 		graceful_exit_transfer_queue_node_id GracefulExitTransferQueue_NodeId_Field) (
 		count int64, err error)
@@ -22010,6 +28236,10 @@ type Methods interface {
 		project_member_project_id ProjectMember_ProjectId_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectUsageAlert_By_ProjectId(ctx context.Context,
+		project_usage_alert_project_id ProjectUsageAlert_ProjectId_Field) (
+		count int64, err error)
+
 	Delete_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		deleted bool, err error)
@@ -22018,6 +28248,10 @@ type Methods interface {
 		reset_password_token_secret ResetPasswordToken_Secret_Field) (
 		deleted bool, err error)
 
+	Delete_StoragenodeSettlementOutcome_By_SettledAt_Less(ctx context.Context,
+		storagenode_settlement_outcome_settled_at_less StoragenodeSettlementOutcome_SettledAt_Field) (
+		count int64, err error)
+
 	Delete_User_By_Id(ctx context.Context,
 		user_id User_Id_Field) (
 		deleted bool, err error)
@@ -22026,6 +28260,10 @@ type Methods interface {
 		accounting_timestamps_name AccountingTimestamps_Name_Field) (
 		row *Value_Row, err error)
 
+	Get_AdminToken_By_SecretHash(ctx context.Context,
+		admin_token_secret_hash AdminToken_SecretHash_Field) (
+		admin_token *AdminToken, err error)
+
 	Get_ApiKey_By_Head(ctx context.Context,
 		api_key_head ApiKey_Head_Field) (
 		api_key *ApiKey, err error)
@@ -22048,11 +28286,36 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		bucket_metainfo *BucketMetainfo, err error)
 
+	Get_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *DefaultRetentionMode_DefaultRetentionDays_Row, err error)
+
 	Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		row *Id_Row, err error)
 
+	Get_BucketMetainfo_LifecycleRules_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *LifecycleRules_Row, err error)
+
+	Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *Placement_Row, err error)
+
+	Get_BucketMetainfo_StorageLimit_BucketMetainfo_BandwidthLimit_BucketMetainfo_ObjectLimit_BucketMetainfo_SegmentLimit_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *StorageLimit_BandwidthLimit_ObjectLimit_SegmentLimit_Row, err error)
+
+	Get_BucketMetainfo_Versioning_By_ProjectId_And_Name(ctx context.Context,
+		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
+		bucket_metainfo_name BucketMetainfo_Name_Field) (
+		row *Versioning_Row, err error)
+
 	Get_CouponCode_By_Name(ctx context.Context,
 		coupon_code_name CouponCode_Name_Field) (
 		coupon_code *CouponCode, err error)
@@ -22127,6 +28390,11 @@ type Methods interface {
 		reset_password_token_secret ResetPasswordToken_Secret_Field) (
 		reset_password_token *ResetPasswordToken, err error)
 
+	Get_SsoIdentity_UserId_By_Issuer_And_Subject(ctx context.Context,
+		sso_identity_issuer SsoIdentity_Issuer_Field,
+		sso_identity_subject SsoIdentity_Subject_Field) (
+		row *UserId_Row, err error)
+
 	Get_StoragenodePaystub_By_NodeId_And_Period(ctx context.Context,
 		storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
 		storagenode_paystub_period StoragenodePaystub_Period_Field) (
@@ -22173,6 +28441,15 @@ type Methods interface {
 		node_api_version_api_version_greater_or_equal NodeApiVersion_ApiVersion_Field) (
 		has bool, err error)
 
+	Has_ReportedLostPiece_By_NodeId_And_PieceId(ctx context.Context,
+		reported_lost_piece_node_id ReportedLostPiece_NodeId_Field,
+		reported_lost_piece_piece_id ReportedLostPiece_PieceId_Field) (
+		has bool, err error)
+
+	Has_SsoIdentity_By_UserId(ctx context.Context,
+		sso_identity_user_id SsoIdentity_UserId_Field) (
+		has bool, err error)
+
 	Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name_greater_or_equal BucketMetainfo_Name_Field,
@@ -22201,11 +28478,20 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*Irreparabledb, err error)
 
+	Limited_ProjectUsageNotification_By_ProjectId_OrderBy_Desc_CreatedAt(ctx context.Context,
+		project_usage_notification_project_id ProjectUsageNotification_ProjectId_Field,
+		limit int, offset int64) (
+		rows []*ProjectUsageNotification, err error)
+
 	Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
 		project_created_at_less Project_CreatedAt_Field,
 		limit int, offset int64) (
 		rows []*Project, err error)
 
+	Limited_ReportedLostPiece_OrderBy_Asc_ReportedAt(ctx context.Context,
+		limit int, offset int64) (
+		rows []*ReportedLostPiece, err error)
+
 	Limited_StoragenodePayment_By_NodeId_And_Period_OrderBy_Desc_Id(ctx context.Context,
 		storagenode_payment_node_id StoragenodePayment_NodeId_Field,
 		storagenode_payment_period StoragenodePayment_Period_Field,
@@ -22294,6 +28580,11 @@ type Methods interface {
 		update AccountingTimestamps_Update_Fields) (
 		err error)
 
+	UpdateNoReturn_AdminAuditLog_By_Id_And_StatusCode_Is_Null(ctx context.Context,
+		admin_audit_log_id AdminAuditLog_Id_Field,
+		update AdminAuditLog_Update_Fields) (
+		err error)
+
 	UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 		api_key_id ApiKey_Id_Field,
 		update ApiKey_Update_Fields) (
@@ -22327,6 +28618,16 @@ type Methods interface {
 		update PeerIdentity_Update_Fields) (
 		err error)
 
+	UpdateNoReturn_ProjectUsageNotification_By_Id(ctx context.Context,
+		project_usage_notification_id ProjectUsageNotification_Id_Field,
+		update ProjectUsageNotification_Update_Fields) (
+		err error)
+
+	Update_AdminToken_By_Id_And_RevokedAt_Is_Null(ctx context.Context,
+		admin_token_id AdminToken_Id_Field,
+		update AdminToken_Update_Fields) (
+		admin_token *AdminToken, err error)
+
 	Update_AuditHistory_By_NodeId(ctx context.Context,
 		audit_history_node_id AuditHistory_NodeId_Field,
 		update AuditHistory_Update_Fields) (
@@ -22359,6 +28660,12 @@ type Methods interface {
 		update Node_Update_Fields) (
 		node *Node, err error)
 
+	Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_member_id ProjectMember_MemberId_Field,
+		project_member_project_id ProjectMember_ProjectId_Field,
+		update ProjectMember_Update_Fields) (
+		project_member *ProjectMember, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
@@ -22378,6 +28685,24 @@ type Methods interface {
 		user_id User_Id_Field,
 		update User_Update_Fields) (
 		user *User, err error)
+
+	Update_User_By_Id_And_MfaFailedAttempts(ctx context.Context,
+		user_id User_Id_Field,
+		user_mfa_failed_attempts User_MfaFailedAttempts_Field,
+		update User_Update_Fields) (
+		user *User, err error)
+
+	Update_User_By_Id_And_MfaLastCounter_Less(ctx context.Context,
+		user_id User_Id_Field,
+		user_mfa_last_counter_less User_MfaLastCounter_Field,
+		update User_Update_Fields) (
+		user *User, err error)
+
+	Update_User_By_Id_And_MfaRecoveryCodes(ctx context.Context,
+		user_id User_Id_Field,
+		user_mfa_recovery_codes User_MfaRecoveryCodes_Field,
+		update User_Update_Fields) (
+		user *User, err error)
 }
 
 type TxMethods interface {
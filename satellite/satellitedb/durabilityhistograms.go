@@ -0,0 +1,122 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/satellite/repair/durability"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// durabilityHistogramsBatchSize is the number of histograms inserted with a single query.
+const durabilityHistogramsBatchSize = 1000
+
+var _ durability.DB = (*durabilityHistogramsDB)(nil)
+
+type durabilityHistogramsDB struct {
+	db *satelliteDB
+}
+
+// Replace replaces all histograms with the ones computed by a checker loop.
+func (db *durabilityHistogramsDB) Replace(ctx context.Context, histograms []durability.Histogram, computedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Delete_DurabilityHistogram_By_ComputedAt_Less(ctx, dbx.DurabilityHistogram_ComputedAt(computedAt.UTC()))
+		if err != nil {
+			return err
+		}
+
+		// histograms are inserted in batches, which dbx doesn't support.
+
+		for len(histograms) > 0 {
+			batch := histograms
+			if len(batch) > durabilityHistogramsBatchSize {
+				batch = batch[:durabilityHistogramsBatchSize]
+			}
+			histograms = histograms[len(batch):]
+
+			var projectIDs, bucketNames, healthyCounts [][]byte
+			var required, repair, optimal, total []int32
+			for _, histogram := range batch {
+				counts, err := json.Marshal(histogram.HealthyCounts)
+				if err != nil {
+					return err
+				}
+
+				projectID := histogram.ProjectID
+				projectIDs = append(projectIDs, projectID[:])
+				bucketNames = append(bucketNames, []byte(histogram.BucketName))
+				required = append(required, int32(histogram.Redundancy.RequiredShares))
+				repair = append(repair, int32(histogram.Redundancy.RepairShares))
+				optimal = append(optimal, int32(histogram.Redundancy.OptimalShares))
+				total = append(total, int32(histogram.Redundancy.TotalShares))
+				healthyCounts = append(healthyCounts, counts)
+			}
+
+			_, err = tx.Tx.ExecContext(ctx, `
+				INSERT INTO durability_histograms (
+					project_id, bucket_name,
+					required_shares, repair_shares, optimal_shares, total_shares,
+					healthy_counts, computed_at
+				)
+				SELECT
+					unnest($1::bytea[]), unnest($2::bytea[]),
+					unnest($3::int4[]), unnest($4::int4[]), unnest($5::int4[]), unnest($6::int4[]),
+					unnest($7::bytea[]), $8
+			`, pgutil.ByteaArray(projectIDs), pgutil.ByteaArray(bucketNames),
+				pgutil.Int4Array(required), pgutil.Int4Array(repair), pgutil.Int4Array(optimal), pgutil.Int4Array(total),
+				pgutil.ByteaArray(healthyCounts), computedAt.UTC())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// List returns the histograms of a project, or only of a bucket when
+// bucketName isn't empty.
+func (db *durabilityHistogramsDB) List(ctx context.Context, projectID uuid.UUID, bucketName string) (histograms []durability.Histogram, computedAt time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows []*dbx.DurabilityHistogram
+	if bucketName == "" {
+		rows, err = db.db.All_DurabilityHistogram_By_ProjectId(ctx,
+			dbx.DurabilityHistogram_ProjectId(projectID[:]))
+	} else {
+		rows, err = db.db.All_DurabilityHistogram_By_ProjectId_And_BucketName(ctx,
+			dbx.DurabilityHistogram_ProjectId(projectID[:]),
+			dbx.DurabilityHistogram_BucketName([]byte(bucketName)))
+	}
+	if err != nil {
+		return nil, time.Time{}, Error.Wrap(err)
+	}
+
+	for _, row := range rows {
+		histogram := durability.Histogram{
+			ProjectID:  projectID,
+			BucketName: string(row.BucketName),
+			Redundancy: durability.Redundancy{
+				RequiredShares: int16(row.RequiredShares),
+				RepairShares:   int16(row.RepairShares),
+				OptimalShares:  int16(row.OptimalShares),
+				TotalShares:    int16(row.TotalShares),
+			},
+		}
+		if err := json.Unmarshal(row.HealthyCounts, &histogram.HealthyCounts); err != nil {
+			return nil, time.Time{}, Error.Wrap(err)
+		}
+
+		histograms = append(histograms, histogram)
+		computedAt = row.ComputedAt
+	}
+
+	return histograms, computedAt, nil
+}
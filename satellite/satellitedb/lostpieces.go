@@ -0,0 +1,105 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/dbutil/pgutil"
+	"storj.io/storj/satellite/repair/lostpieces"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+var _ lostpieces.DB = (*lostPiecesDB)(nil)
+
+type lostPiecesDB struct {
+	db *satelliteDB
+}
+
+// Insert stores pieces reported as lost by the node. Pieces which have been
+// reported before are ignored.
+func (db *lostPiecesDB) Insert(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(pieceIDs) == 0 {
+		return nil
+	}
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		for _, pieceID := range pieceIDs {
+			reported, err := tx.Has_ReportedLostPiece_By_NodeId_And_PieceId(ctx,
+				dbx.ReportedLostPiece_NodeId(nodeID.Bytes()),
+				dbx.ReportedLostPiece_PieceId(pieceID.Bytes()))
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if reported {
+				continue
+			}
+
+			err = tx.CreateNoReturn_ReportedLostPiece(ctx,
+				dbx.ReportedLostPiece_NodeId(nodeID.Bytes()),
+				dbx.ReportedLostPiece_PieceId(pieceID.Bytes()),
+				dbx.ReportedLostPiece_ReportedAt(reportedAt.UTC()))
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		return nil
+	})
+}
+
+// List returns up to limit reports, oldest first.
+func (db *lostPiecesDB) List(ctx context.Context, limit int) (reports []lostpieces.Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Limited_ReportedLostPiece_OrderBy_Asc_ReportedAt(ctx, limit, 0)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, row := range rows {
+		nodeID, err := storj.NodeIDFromBytes(row.NodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		pieceID, err := storj.PieceIDFromBytes(row.PieceId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		reports = append(reports, lostpieces.Report{
+			NodeID:     nodeID,
+			PieceID:    pieceID,
+			ReportedAt: row.ReportedAt,
+		})
+	}
+	return reports, nil
+}
+
+// Delete removes the reports.
+func (db *lostPiecesDB) Delete(ctx context.Context, reports []lostpieces.Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(reports) == 0 {
+		return nil
+	}
+
+	nodeIDs := make([]storj.NodeID, len(reports))
+	pieceIDs := make([][]byte, len(reports))
+	for i, report := range reports {
+		nodeIDs[i] = report.NodeID
+		pieceIDs[i] = report.PieceID.Bytes()
+	}
+
+	// dbx doesn't support deleting multiple rows by their keys at once.
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM reported_lost_pieces
+		WHERE (node_id, piece_id) IN (
+			SELECT unnest($1::bytea[]), unnest($2::bytea[])
+		)
+	`, pgutil.NodeIDArray(nodeIDs), pgutil.ByteaArray(pieceIDs))
+	return Error.Wrap(err)
+}
@@ -1338,6 +1338,222 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					`UPDATE coupons SET billing_periods = duration;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add versioning to bucket_metainfos",
+				Version:     155,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN versioning integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add default retention to bucket_metainfos",
+				Version:     156,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_retention_mode integer NOT NULL DEFAULT 0;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_retention_days integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add lifecycle rules to bucket_metainfos",
+				Version:     157,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN lifecycle_rules bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add country code to nodes and placement to bucket_metainfos",
+				Version:     158,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN country_code text;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN placement bytea;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add durability_histograms table",
+				Version:     159,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_histograms (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						required_shares integer NOT NULL,
+						repair_shares integer NOT NULL,
+						optimal_shares integer NOT NULL,
+						total_shares integer NOT NULL,
+						healthy_counts bytea NOT NULL,
+						computed_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name, required_shares, repair_shares, optimal_shares, total_shares )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add reported_lost_pieces table",
+				Version:     160,
+				Action: migrate.SQL{
+					`CREATE TABLE reported_lost_pieces (
+						node_id bytea NOT NULL,
+						piece_id bytea NOT NULL,
+						reported_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id, piece_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add limits to bucket_metainfos",
+				Version:     161,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN storage_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN bandwidth_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN object_limit bigint;`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN segment_limit bigint;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add api key limits and usage rollups",
+				Version:     162,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN rate_limit integer;`,
+					`ALTER TABLE api_keys ADD COLUMN bandwidth_limit bigint;`,
+					`CREATE TABLE api_key_usage_rollups (
+						head bytea NOT NULL,
+						project_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						requests bigint NOT NULL,
+						egress bigint NOT NULL,
+						PRIMARY KEY ( head, interval_start )
+					);`,
+					`CREATE INDEX api_key_usage_rollups_project_id_interval_start_index ON api_key_usage_rollups ( project_id, interval_start );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add storagenode_settlement_outcomes table",
+				Version:     163,
+				Action: migrate.SQL{
+					`CREATE TABLE storagenode_settlement_outcomes (
+						storagenode_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						settled_at timestamp with time zone NOT NULL,
+						status integer NOT NULL,
+						already_processed boolean NOT NULL,
+						orders_received integer NOT NULL,
+						orders_accepted integer NOT NULL,
+						orders_unknown_bucket integer NOT NULL,
+						amount_settled bigint NOT NULL,
+						rejected_expired integer NOT NULL,
+						rejected_bad_signature integer NOT NULL,
+						rejected_already_settled integer NOT NULL,
+						rejected_invalid integer NOT NULL,
+						PRIMARY KEY ( storagenode_id, interval_start, settled_at )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add multi-factor authentication columns to users",
+				Version:     164,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD COLUMN mfa_enabled boolean NOT NULL DEFAULT false;`,
+					`ALTER TABLE users ADD COLUMN mfa_secret_key text;`,
+					`ALTER TABLE users ADD COLUMN mfa_recovery_codes text;`,
+					`ALTER TABLE users ADD COLUMN mfa_last_counter bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE users ADD COLUMN mfa_failed_attempts integer NOT NULL DEFAULT 0;`,
+					`ALTER TABLE users ADD COLUMN mfa_locked_until timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add role to project members",
+				Version:     165,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ADD COLUMN role integer NOT NULL DEFAULT 2;`,
+					`UPDATE project_members SET role = 1 WHERE (member_id, project_id) IN (SELECT owner_id, id FROM projects);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add sso_identities table",
+				Version:     166,
+				Action: migrate.SQL{
+					`CREATE TABLE sso_identities (
+						issuer text NOT NULL,
+						subject text NOT NULL,
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( issuer, subject )
+					);`,
+					`CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_tokens and admin_audit_logs tables",
+				Version:     167,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_tokens (
+						id bytea NOT NULL,
+						name text NOT NULL,
+						scopes text NOT NULL,
+						secret_hash bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						expires_at timestamp with time zone,
+						revoked_at timestamp with time zone,
+						PRIMARY KEY ( id ),
+						UNIQUE ( name ),
+						UNIQUE ( secret_hash )
+					);`,
+					`CREATE TABLE admin_audit_logs (
+						id bigserial NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						actor text NOT NULL,
+						token_id bytea,
+						method text NOT NULL,
+						path text NOT NULL,
+						user_email text,
+						project_id bytea,
+						details text NOT NULL,
+						status_code integer,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX admin_audit_logs_created_at_index ON admin_audit_logs ( created_at );`,
+					`CREATE INDEX admin_audit_logs_user_email_index ON admin_audit_logs ( user_email );`,
+					`CREATE INDEX admin_audit_logs_project_id_index ON admin_audit_logs ( project_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_usage_alerts and project_usage_notifications tables",
+				Version:     168,
+				Action: migrate.SQL{
+					`CREATE TABLE project_usage_alerts (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						kind text NOT NULL,
+						threshold bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, kind, threshold )
+					);`,
+					`CREATE TABLE project_usage_notifications (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						kind text NOT NULL,
+						threshold bigint NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						used bigint NOT NULL,
+						usage_limit bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						sent_at timestamp with time zone,
+						PRIMARY KEY ( id ),
+						UNIQUE ( project_id, kind, threshold, period_start )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
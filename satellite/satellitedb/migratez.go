@@ -13,7 +13,7 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     154,
+				Version:     168,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE accounting_rollups (
@@ -32,6 +32,39 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE admin_audit_logs (
+	id bigserial NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	actor text NOT NULL,
+	token_id bytea,
+	method text NOT NULL,
+	path text NOT NULL,
+	user_email text,
+	project_id bytea,
+	details text NOT NULL,
+	status_code integer,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE admin_tokens (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	scopes text NOT NULL,
+	secret_hash bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone,
+	revoked_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( name ),
+	UNIQUE ( secret_hash )
+);
+CREATE TABLE api_key_usage_rollups (
+	head bytea NOT NULL,
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	requests bigint NOT NULL,
+	egress bigint NOT NULL,
+	PRIMARY KEY ( head, interval_start )
+);
 CREATE TABLE audit_histories (
 	node_id bytea NOT NULL,
 	history bytea NOT NULL,
@@ -114,6 +147,17 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE durability_histograms (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	required_shares integer NOT NULL,
+	repair_shares integer NOT NULL,
+	optimal_shares integer NOT NULL,
+	total_shares integer NOT NULL,
+	healthy_counts bytea NOT NULL,
+	computed_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, required_shares, repair_shares, optimal_shares, total_shares )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -158,6 +202,7 @@ CREATE TABLE nodes (
 	address text NOT NULL DEFAULT '',
 	last_net text NOT NULL,
 	last_ip_port text,
+	country_code text,
 	protocol integer NOT NULL DEFAULT 0,
 	type integer NOT NULL DEFAULT 0,
 	email text NOT NULL,
@@ -264,6 +309,12 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE reported_lost_pieces (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, piece_id )
+);
 CREATE TABLE reset_password_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea NOT NULL,
@@ -338,6 +389,22 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_settlement_outcomes (
+	storagenode_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	settled_at timestamp with time zone NOT NULL,
+	status integer NOT NULL,
+	already_processed boolean NOT NULL,
+	orders_received integer NOT NULL,
+	orders_accepted integer NOT NULL,
+	orders_unknown_bucket integer NOT NULL,
+	amount_settled bigint NOT NULL,
+	rejected_expired integer NOT NULL,
+	rejected_bad_signature integer NOT NULL,
+	rejected_already_settled integer NOT NULL,
+	rejected_invalid integer NOT NULL,
+	PRIMARY KEY ( storagenode_id, interval_start, settled_at )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -387,6 +454,12 @@ CREATE TABLE users (
 	working_on text,
 	is_professional boolean NOT NULL DEFAULT false,
 	employee_count text,
+	mfa_enabled boolean NOT NULL DEFAULT false,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
+	mfa_last_counter bigint NOT NULL DEFAULT 0,
+	mfa_failed_attempts integer NOT NULL DEFAULT 0,
+	mfa_locked_until timestamp with time zone,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE value_attributions (
@@ -404,6 +477,8 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	created_at timestamp with time zone NOT NULL,
+	rate_limit integer,
+	bandwidth_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -424,6 +499,15 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_repair_shares integer NOT NULL,
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
+	versioning integer NOT NULL DEFAULT 0,
+	default_retention_mode integer NOT NULL DEFAULT 0,
+	default_retention_days integer NOT NULL DEFAULT 0,
+	lifecycle_rules bytea,
+	placement bytea,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	object_limit bigint,
+	segment_limit bigint,
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
@@ -431,8 +515,36 @@ CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	created_at timestamp with time zone NOT NULL,
+	role integer NOT NULL DEFAULT 2,
 	PRIMARY KEY ( member_id, project_id )
 );
+CREATE TABLE project_usage_alerts (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold )
+);
+CREATE TABLE project_usage_notifications (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold bigint NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	used bigint NOT NULL,
+	usage_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	sent_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE sso_identities (
+	issuer text NOT NULL,
+	subject text NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( issuer, subject )
+);
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -452,6 +564,10 @@ CREATE TABLE user_credits (
 	PRIMARY KEY ( id ),
 	UNIQUE ( id, offer_id )
 );
+CREATE INDEX admin_audit_logs_created_at_index ON admin_audit_logs ( created_at );
+CREATE INDEX admin_audit_logs_user_email_index ON admin_audit_logs ( user_email );
+CREATE INDEX admin_audit_logs_project_id_index ON admin_audit_logs ( project_id );
+CREATE INDEX api_key_usage_rollups_project_id_interval_start_index ON api_key_usage_rollups ( project_id, interval_start );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time );
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start );
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id );
@@ -464,6 +580,7 @@ CREATE INDEX injuredsegments_segment_health_index ON injuredsegments ( segment_h
 CREATE INDEX injuredsegments_updated_at_index ON injuredsegments ( updated_at );
 CREATE INDEX node_last_ip ON nodes ( last_net );
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success );
+CREATE INDEX sso_identities_user_id_index ON sso_identities ( user_id );
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start );
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start );
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period );
@@ -34,6 +34,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, totalNeededNo
 
 	receivedNewNodes := 0
 	receivedNodeNetworks := make(map[string]struct{})
+	receivedOperators := make(map[string]struct{})
 
 	excludedIDs := append([]storj.NodeID{}, criteria.ExcludedIDs...)
 	excludedNetworks := append([]string{}, criteria.ExcludedNetworks...)
@@ -49,6 +50,9 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, totalNeededNo
 			if _, ok := receivedNodeNetworks[node.LastNet]; ok {
 				continue
 			}
+			if criteria.DistinctOperator && !acceptOperator(receivedOperators, node) {
+				continue
+			}
 
 			excludedIDs = append(excludedIDs, node.ID)
 			excludedNetworks = append(excludedNetworks, node.LastNet)
@@ -65,6 +69,9 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, totalNeededNo
 			if _, ok := receivedNodeNetworks[node.LastNet]; ok {
 				continue
 			}
+			if criteria.DistinctOperator && !acceptOperator(receivedOperators, node) {
+				continue
+			}
 
 			excludedIDs = append(excludedIDs, node.ID)
 			excludedNetworks = append(excludedNetworks, node.LastNet)
@@ -90,6 +97,27 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, totalNeededNo
 	return nodes, nil
 }
 
+// acceptOperator returns whether the operator of the node hasn't been
+// selected yet and marks it as selected.
+func acceptOperator(received map[string]struct{}, node *overlay.SelectedNode) bool {
+	var keys []string
+	if node.Email != "" {
+		keys = append(keys, "email:"+strings.ToLower(node.Email))
+	}
+	if node.Wallet != "" {
+		keys = append(keys, "wallet:"+strings.ToLower(node.Wallet))
+	}
+	for _, key := range keys {
+		if _, ok := received[key]; ok {
+			return false
+		}
+	}
+	for _, key := range keys {
+		received[key] = struct{}{}
+	}
+	return true
+}
+
 func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputableNodeCount, newNodeCount int, criteria *overlay.NodeCriteria, excludedIDs []storj.NodeID, excludedNetworks []string) (reputableNodes, newNodes []*overlay.SelectedNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -110,20 +138,20 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 	// Later, the flag allows us to distinguish if a node is new when scanning the db rows.
 	if !criteria.DistinctIP {
 		reputableNodeQuery = partialQuery{
-			selection:  `SELECT last_net, id, address, last_ip_port, false FROM nodes ` + asOf,
+			selection:  `SELECT last_net, id, address, last_ip_port, country_code, email, wallet, false FROM nodes ` + asOf,
 			condition:  reputableNodesCondition,
 			limit:      reputableNodeCount,
 			aostClause: asOf,
 		}
 		newNodeQuery = partialQuery{
-			selection:  `SELECT last_net, id, address, last_ip_port, true FROM nodes ` + asOf,
+			selection:  `SELECT last_net, id, address, last_ip_port, country_code, email, wallet, true FROM nodes ` + asOf,
 			condition:  newNodesCondition,
 			limit:      newNodeCount,
 			aostClause: asOf,
 		}
 	} else {
 		reputableNodeQuery = partialQuery{
-			selection:  `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, false FROM nodes ` + asOf,
+			selection:  `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, country_code, email, wallet, false FROM nodes ` + asOf,
 			condition:  reputableNodesCondition,
 			distinct:   true,
 			limit:      reputableNodeCount,
@@ -131,7 +159,7 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 			aostClause: asOf,
 		}
 		newNodeQuery = partialQuery{
-			selection:  `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, true FROM nodes ` + asOf,
+			selection:  `SELECT DISTINCT ON (last_net) last_net, id, address, last_ip_port, country_code, email, wallet, true FROM nodes ` + asOf,
 			condition:  newNodesCondition,
 			distinct:   true,
 			limit:      newNodeCount,
@@ -151,10 +179,10 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 	for rows.Next() {
 		var node overlay.SelectedNode
 		node.Address = &pb.NodeAddress{Transport: pb.NodeTransport_TCP_TLS_GRPC}
-		var lastIPPort sql.NullString
+		var lastIPPort, countryCode sql.NullString
 		var isNew bool
 
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.LastIPPort, &isNew)
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &lastIPPort, &countryCode, &node.Email, &node.Wallet, &isNew)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -162,6 +190,9 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		if countryCode.Valid {
+			node.CountryCode = countryCode.String
+		}
 
 		if isNew {
 			newNodes = append(newNodes, &node)
@@ -224,6 +255,32 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 		}
 		conds.add(`last_net <> ''`)
 	}
+	if criteria.DistinctOperator && len(excludedIDs) > 0 {
+		conds.add(
+			`not (email <> '' AND lower(email) IN (SELECT lower(email) FROM nodes WHERE id = any(?::bytea[])))`,
+			pgutil.NodeIDArray(excludedIDs),
+		)
+		conds.add(
+			`not (wallet <> '' AND lower(wallet) IN (SELECT lower(wallet) FROM nodes WHERE id = any(?::bytea[])))`,
+			pgutil.NodeIDArray(excludedIDs),
+		)
+	}
+
+	if !criteria.Placement.IsZero() {
+		conds.add(`country_code IS NOT NULL AND country_code <> ''`)
+		if len(criteria.Placement.AllowedCountries) > 0 {
+			conds.add(
+				`country_code = any(?::text[])`,
+				pgutil.TextArray(criteria.Placement.AllowedCountries),
+			)
+		}
+		if len(criteria.Placement.ExcludedCountries) > 0 {
+			conds.add(
+				`not (country_code = any(?::text[]))`,
+				pgutil.TextArray(criteria.Placement.ExcludedCountries),
+			)
+		}
+	}
 	return conds.combine(), nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -353,3 +354,82 @@ func SettledAmountsMatch(rows []*dbx.StoragenodeBandwidthRollup, orderActionAmou
 
 	return reflect.DeepEqual(rowsSumByAction, orderActionAmounts)
 }
+
+// InsertSettlementOutcome stores the outcome of a window settlement by a storage node.
+func (db *ordersDB) InsertSettlementOutcome(ctx context.Context, outcome orders.SettlementOutcome) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.CreateNoReturn_StoragenodeSettlementOutcome(ctx,
+		dbx.StoragenodeSettlementOutcome_StoragenodeId(outcome.StorageNodeID.Bytes()),
+		dbx.StoragenodeSettlementOutcome_IntervalStart(outcome.IntervalStart.UTC()),
+		dbx.StoragenodeSettlementOutcome_SettledAt(outcome.SettledAt.UTC()),
+		dbx.StoragenodeSettlementOutcome_Status(int(outcome.Status)),
+		dbx.StoragenodeSettlementOutcome_AlreadyProcessed(outcome.AlreadyProcessed),
+		dbx.StoragenodeSettlementOutcome_OrdersReceived(outcome.OrdersReceived),
+		dbx.StoragenodeSettlementOutcome_OrdersAccepted(outcome.OrdersAccepted),
+		dbx.StoragenodeSettlementOutcome_OrdersUnknownBucket(outcome.OrdersUnknownBucket),
+		dbx.StoragenodeSettlementOutcome_AmountSettled(outcome.AmountSettled),
+		dbx.StoragenodeSettlementOutcome_RejectedExpired(outcome.Rejected[orders.RejectExpired]),
+		dbx.StoragenodeSettlementOutcome_RejectedBadSignature(outcome.Rejected[orders.RejectBadSignature]),
+		dbx.StoragenodeSettlementOutcome_RejectedAlreadySettled(outcome.Rejected[orders.RejectAlreadySettled]),
+		dbx.StoragenodeSettlementOutcome_RejectedInvalid(outcome.Rejected[orders.RejectInvalid]),
+	)
+	return Error.Wrap(err)
+}
+
+// GetSettlementOutcomes returns the settlement outcomes of a storage node for the windows starting in [from, to).
+func (db *ordersDB) GetSettlementOutcomes(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (_ []orders.SettlementOutcome, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.All_StoragenodeSettlementOutcome_By_StoragenodeId_And_IntervalStart_GreaterOrEqual_And_IntervalStart_Less_OrderBy_Asc_IntervalStart(ctx,
+		dbx.StoragenodeSettlementOutcome_StoragenodeId(nodeID.Bytes()),
+		dbx.StoragenodeSettlementOutcome_IntervalStart(from.UTC()),
+		dbx.StoragenodeSettlementOutcome_IntervalStart(to.UTC()),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	outcomes := make([]orders.SettlementOutcome, 0, len(rows))
+	for _, row := range rows {
+		outcomes = append(outcomes, orders.SettlementOutcome{
+			StorageNodeID:       nodeID,
+			IntervalStart:       row.IntervalStart.UTC(),
+			SettledAt:           row.SettledAt.UTC(),
+			Status:              pb.SettlementWithWindowResponse_Status(row.Status),
+			AlreadyProcessed:    row.AlreadyProcessed,
+			OrdersReceived:      row.OrdersReceived,
+			OrdersAccepted:      row.OrdersAccepted,
+			OrdersUnknownBucket: row.OrdersUnknownBucket,
+			AmountSettled:       row.AmountSettled,
+			Rejected: map[orders.SettlementRejectReason]int{
+				orders.RejectExpired:        row.RejectedExpired,
+				orders.RejectBadSignature:   row.RejectedBadSignature,
+				orders.RejectAlreadySettled: row.RejectedAlreadySettled,
+				orders.RejectInvalid:        row.RejectedInvalid,
+			},
+		})
+	}
+
+	// settlements of the same window are listed in the order they happened.
+	sort.SliceStable(outcomes, func(i, k int) bool {
+		if !outcomes[i].IntervalStart.Equal(outcomes[k].IntervalStart) {
+			return outcomes[i].IntervalStart.Before(outcomes[k].IntervalStart)
+		}
+		return outcomes[i].SettledAt.Before(outcomes[k].SettledAt)
+	})
+
+	return outcomes, nil
+}
+
+// DeleteSettlementOutcomesBefore deletes the settlement outcomes settled before the given time.
+func (db *ordersDB) DeleteSettlementOutcomesBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err = db.db.Delete_StoragenodeSettlementOutcome_By_SettledAt_Less(ctx,
+		dbx.StoragenodeSettlementOutcome_SettledAt(before.UTC()))
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return deleted, nil
+}
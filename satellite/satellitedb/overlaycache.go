@@ -59,7 +59,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	asOf := cache.db.AsOfSystemTimeClause(selectionCfg.AsOfSystemTime.DefaultInterval)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at
+		SELECT id, address, last_net, last_ip_port, country_code, free_disk, email, wallet, vetted_at
 			FROM nodes ` + asOf + `
 			WHERE disqualified IS NULL
 			AND unknown_audit_suspended IS NULL
@@ -100,15 +100,19 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	for rows.Next() {
 		var node overlay.SelectedNode
 		node.Address = &pb.NodeAddress{}
-		var lastIPPort sql.NullString
+		var lastIPPort, countryCode sql.NullString
 		var vettedAt *time.Time
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt)
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &countryCode,
+			&node.FreeDisk, &node.Email, &node.Wallet, &vettedAt)
 		if err != nil {
 			return nil, nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		if countryCode.Valid {
+			node.CountryCode = countryCode.String
+		}
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -1666,6 +1670,11 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		return Error.Wrap(err)
 	}
 
+	var countryCode *string
+	if node.CountryCode != "" {
+		countryCode = &node.CountryCode
+	}
+
 	query := `
 			INSERT INTO nodes
 			(
@@ -1677,7 +1686,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				unknown_audit_reputation_alpha, unknown_audit_reputation_beta,
 				major, minor, patch, hash, timestamp, release,
 				last_ip_port,
-				wallet_features
+				wallet_features,
+				country_code
 			)
 			VALUES (
 				$1, $2, $3, $4, $5,
@@ -1692,7 +1702,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$10, $11,
 				$12, $13, $14, $15, $16, $17,
 				$19,
-				$20
+				$20,
+				$21
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1713,7 +1724,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 					ELSE nodes.last_contact_failure
 				END,
 				last_ip_port=$19,
-				wallet_features=$20;
+				wallet_features=$20,
+				country_code=$21;
 			`
 	_, err = cache.db.ExecContext(ctx, query,
 		// args $1 - $5
@@ -1732,6 +1744,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		node.LastIPPort,
 		// args $20,
 		walletFeatures,
+		// args $21
+		countryCode,
 	)
 	if err != nil {
 		return Error.Wrap(err)
@@ -1767,6 +1781,17 @@ func (cache *overlaycache) TestUnvetNode(ctx context.Context, nodeID storj.NodeI
 	return err
 }
 
+// TestNodeCountryCode directly sets a node's country code to make testing easier.
+func (cache *overlaycache) TestNodeCountryCode(ctx context.Context, nodeID storj.NodeID, countryCode string) (err error) {
+	updateFields := dbx.Node_Update_Fields{
+		CountryCode: dbx.Node_CountryCode_Null(),
+	}
+	if countryCode != "" {
+		updateFields.CountryCode = dbx.Node_CountryCode(countryCode)
+	}
+	return cache.db.UpdateNoReturn_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+}
+
 // IterateAllNodes will call cb on all known nodes (used in restore trash contexts).
 func (cache *overlaycache) IterateAllNodes(ctx context.Context, cb func(context.Context, *overlay.SelectedNode) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
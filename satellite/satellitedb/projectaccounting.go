@@ -151,6 +151,36 @@ func (db *ProjectAccounting) GetProjectAllocatedBandwidth(ctx context.Context, p
 	return *egress, err
 }
 
+// GetBucketStorageUsage returns the bucket storage usage of the most recent tally.
+func (db *ProjectAccounting) GetBucketStorageUsage(ctx context.Context, bucket metabase.BucketLocation) (usage accounting.BucketStorageUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT inline + remote, object_count, inline_segments_count + remote_segments_count
+		FROM bucket_storage_tallies
+		WHERE project_id = ? AND bucket_name = ?
+		ORDER BY interval_start DESC LIMIT 1;`
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), bucket.ProjectID[:], []byte(bucket.BucketName)).Scan(
+		&usage.Storage, &usage.Objects, &usage.Segments,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return accounting.BucketStorageUsage{}, nil
+	}
+	return usage, Error.Wrap(err)
+}
+
+// GetBucketAllocatedBandwidth returns bucket allocated bandwidth for the specified year and month.
+func (db *ProjectAccounting) GetBucketAllocatedBandwidth(ctx context.Context, bucket metabase.BucketLocation, year int, month time.Month) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	query := `SELECT COALESCE(SUM(allocated), 0)
+		FROM bucket_bandwidth_rollups
+		WHERE project_id = ? AND bucket_name = ? AND interval_start >= ? AND interval_start < ? AND action = ?;`
+	var allocated int64
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), bucket.ProjectID[:], []byte(bucket.BucketName), from, from.AddDate(0, 1, 0), pb.PieceAction_GET).Scan(&allocated)
+	return allocated, Error.Wrap(err)
+}
+
 // DeleteProjectAllocatedBandwidthBefore deletes project bandwidth rollups before the given time.
 func (db *ProjectAccounting) DeleteProjectAllocatedBandwidthBefore(ctx context.Context, before time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
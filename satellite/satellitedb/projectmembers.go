@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -85,7 +86,7 @@ func (pm *projectMembers) GetPagedByProjectID(ctx context.Context, projectID uui
 	}
 	// TODO: LIKE is case-sensitive postgres, however this should be case-insensitive and possibly allow typos
 	reboundQuery := pm.db.Rebind(`
-		SELECT pm.*
+		SELECT pm.member_id, pm.project_id, pm.role, pm.created_at
 			FROM project_members pm
 				INNER JOIN users u ON pm.member_id = u.id
 				WHERE pm.project_id = ?
@@ -117,7 +118,7 @@ func (pm *projectMembers) GetPagedByProjectID(ctx context.Context, projectID uui
 	for rows.Next() {
 		pm := console.ProjectMember{}
 
-		err = rows.Scan(&pm.MemberID, &pm.ProjectID, &pm.CreatedAt)
+		err = rows.Scan(&pm.MemberID, &pm.ProjectID, &pm.Role, &pm.CreatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -141,12 +142,15 @@ func (pm *projectMembers) GetPagedByProjectID(ctx context.Context, projectID uui
 	return page, err
 }
 
-// Insert is a method for inserting project member into the database.
-func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID) (_ *console.ProjectMember, err error) {
+// Insert is a method for inserting project member with a role into the database.
+func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (_ *console.ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 	createdProjectMember, err := pm.methods.Create_ProjectMember(ctx,
 		dbx.ProjectMember_MemberId(memberID[:]),
-		dbx.ProjectMember_ProjectId(projectID[:]))
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Create_Fields{
+			Role: dbx.ProjectMember_Role(int(role)),
+		})
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +158,25 @@ func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.U
 	return projectMemberFromDBX(ctx, createdProjectMember)
 }
 
+// UpdateRole is a method for changing the role of a project member.
+func (pm *projectMembers) UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role console.ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	updatedProjectMember, err := pm.methods.Update_ProjectMember_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMember_MemberId(memberID[:]),
+		dbx.ProjectMember_ProjectId(projectID[:]),
+		dbx.ProjectMember_Update_Fields{
+			Role: dbx.ProjectMember_Role(int(role)),
+		})
+	if err != nil {
+		return err
+	}
+	if updatedProjectMember == nil {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 // Delete is a method for deleting project member by memberID and projectID from the database.
 func (pm *projectMembers) Delete(ctx context.Context, memberID, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -186,6 +209,7 @@ func projectMemberFromDBX(ctx context.Context, projectMember *dbx.ProjectMember)
 	return &console.ProjectMember{
 		MemberID:  memberID,
 		ProjectID: projectID,
+		Role:      console.ProjectMemberRole(projectMember.Role),
 		CreatedAt: projectMember.CreatedAt,
 	}, nil
 }
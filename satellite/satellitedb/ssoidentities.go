@@ -0,0 +1,51 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that ssoIdentities implements console.SSOIdentities.
+var _ console.SSOIdentities = (*ssoIdentities)(nil)
+
+// ssoIdentities exposes methods to manage the sso_identities table in database.
+type ssoIdentities struct {
+	db dbx.Methods
+}
+
+// GetUserID returns the id of the user linked to the subject of the issuer.
+func (identities *ssoIdentities) GetUserID(ctx context.Context, issuer, subject string) (_ uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := identities.db.Get_SsoIdentity_UserId_By_Issuer_And_Subject(ctx,
+		dbx.SsoIdentity_Issuer(issuer),
+		dbx.SsoIdentity_Subject(subject))
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return uuid.FromBytes(row.UserId)
+}
+
+// Insert links the subject of the issuer to the user.
+func (identities *ssoIdentities) Insert(ctx context.Context, identity console.SSOIdentity) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return identities.db.CreateNoReturn_SsoIdentity(ctx,
+		dbx.SsoIdentity_Issuer(identity.Issuer),
+		dbx.SsoIdentity_Subject(identity.Subject),
+		dbx.SsoIdentity_UserId(identity.UserID[:]))
+}
+
+// ExistsForUser returns whether the user is linked to any identity provider account.
+func (identities *ssoIdentities) ExistsForUser(ctx context.Context, userID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return identities.db.Has_SsoIdentity_By_UserId(ctx, dbx.SsoIdentity_UserId(userID[:]))
+}
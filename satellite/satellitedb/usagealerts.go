@@ -0,0 +1,189 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that usageAlerts implements console.UsageAlerts.
+var _ console.UsageAlerts = (*usageAlerts)(nil)
+
+// usageAlerts exposes methods to manage the project_usage_alerts and
+// project_usage_notifications tables in database.
+type usageAlerts struct {
+	db dbx.Methods
+}
+
+// GetByProjectID returns the usage alerts of the project.
+func (alerts *usageAlerts) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.UsageAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlerts, err := alerts.db.All_ProjectUsageAlert_By_ProjectId(ctx, dbx.ProjectUsageAlert_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var projectAlerts []console.UsageAlert
+	for _, dbxAlert := range dbxAlerts {
+		alert, err := usageAlertFromDBX(dbxAlert)
+		if err != nil {
+			return nil, err
+		}
+		projectAlerts = append(projectAlerts, alert)
+	}
+
+	sort.Slice(projectAlerts, func(i, k int) bool {
+		if projectAlerts[i].Kind != projectAlerts[k].Kind {
+			return projectAlerts[i].Kind < projectAlerts[k].Kind
+		}
+		return projectAlerts[i].Threshold < projectAlerts[k].Threshold
+	})
+
+	return projectAlerts, nil
+}
+
+// Update replaces the usage alerts of the project.
+func (alerts *usageAlerts) Update(ctx context.Context, projectID uuid.UUID, projectAlerts []console.UsageAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = alerts.db.Delete_ProjectUsageAlert_By_ProjectId(ctx, dbx.ProjectUsageAlert_ProjectId(projectID[:]))
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range projectAlerts {
+		err = alerts.db.CreateNoReturn_ProjectUsageAlert(ctx,
+			dbx.ProjectUsageAlert_ProjectId(projectID[:]),
+			dbx.ProjectUsageAlert_Kind(string(alert.Kind)),
+			dbx.ProjectUsageAlert_Threshold(alert.Threshold))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InsertNotification records a triggered usage alert, which isn't sent yet.
+// It returns false, when the alert was already triggered in the billing period.
+func (alerts *usageAlerts) InsertNotification(ctx context.Context, notification console.UsageNotification) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id := notification.ID
+	if id.IsZero() {
+		id, err = uuid.New()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = alerts.db.CreateNoReturn_ProjectUsageNotification(ctx,
+		dbx.ProjectUsageNotification_Id(id[:]),
+		dbx.ProjectUsageNotification_ProjectId(notification.ProjectID[:]),
+		dbx.ProjectUsageNotification_Kind(string(notification.Kind)),
+		dbx.ProjectUsageNotification_Threshold(notification.Threshold),
+		dbx.ProjectUsageNotification_PeriodStart(notification.PeriodStart.UTC()),
+		dbx.ProjectUsageNotification_Used(notification.Used),
+		dbx.ProjectUsageNotification_UsageLimit(notification.Limit),
+		dbx.ProjectUsageNotification_Create_Fields{})
+	if errs.IsFunc(err, dbx.IsConstraintError) {
+		// the alert was already triggered in the billing period.
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListUnsentNotifications returns the notifications of the project in the
+// billing period starting at periodStart, which weren't sent yet.
+func (alerts *usageAlerts) ListUnsentNotifications(ctx context.Context, projectID uuid.UUID, periodStart time.Time) (_ []console.UsageNotification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxNotifications, err := alerts.db.All_ProjectUsageNotification_By_ProjectId_And_PeriodStart_And_SentAt_Is_Null(ctx,
+		dbx.ProjectUsageNotification_ProjectId(projectID[:]),
+		dbx.ProjectUsageNotification_PeriodStart(periodStart.UTC()))
+	if err != nil {
+		return nil, err
+	}
+
+	return usageNotificationsFromDBX(dbxNotifications)
+}
+
+// MarkNotificationSent records that the notification was sent to the project owner.
+func (alerts *usageAlerts) MarkNotificationSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return alerts.db.UpdateNoReturn_ProjectUsageNotification_By_Id(ctx,
+		dbx.ProjectUsageNotification_Id(id[:]),
+		dbx.ProjectUsageNotification_Update_Fields{
+			SentAt: dbx.ProjectUsageNotification_SentAt(sentAt.UTC()),
+		})
+}
+
+// ListNotifications returns the latest usage notifications of the project, newest first.
+func (alerts *usageAlerts) ListNotifications(ctx context.Context, projectID uuid.UUID, limit int) (_ []console.UsageNotification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxNotifications, err := alerts.db.Limited_ProjectUsageNotification_By_ProjectId_OrderBy_Desc_CreatedAt(ctx,
+		dbx.ProjectUsageNotification_ProjectId(projectID[:]),
+		limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return usageNotificationsFromDBX(dbxNotifications)
+}
+
+// usageAlertFromDBX converts a dbx project usage alert to console.UsageAlert.
+func usageAlertFromDBX(dbxAlert *dbx.ProjectUsageAlert) (console.UsageAlert, error) {
+	projectID, err := uuid.FromBytes(dbxAlert.ProjectId)
+	if err != nil {
+		return console.UsageAlert{}, err
+	}
+
+	return console.UsageAlert{
+		ProjectID: projectID,
+		Kind:      console.UsageAlertKind(dbxAlert.Kind),
+		Threshold: dbxAlert.Threshold,
+		CreatedAt: dbxAlert.CreatedAt,
+	}, nil
+}
+
+// usageNotificationsFromDBX converts dbx project usage notifications to console.UsageNotification.
+func usageNotificationsFromDBX(dbxNotifications []*dbx.ProjectUsageNotification) (_ []console.UsageNotification, err error) {
+	var notifications []console.UsageNotification
+	for _, dbxNotification := range dbxNotifications {
+		var notification console.UsageNotification
+
+		notification.ID, err = uuid.FromBytes(dbxNotification.Id)
+		if err != nil {
+			return nil, err
+		}
+		notification.ProjectID, err = uuid.FromBytes(dbxNotification.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+
+		notification.Kind = console.UsageAlertKind(dbxNotification.Kind)
+		notification.Threshold = dbxNotification.Threshold
+		notification.PeriodStart = dbxNotification.PeriodStart
+		notification.Used = dbxNotification.Used
+		notification.Limit = dbxNotification.UsageLimit
+		notification.CreatedAt = dbxNotification.CreatedAt
+		notification.SentAt = dbxNotification.SentAt
+
+		notifications = append(notifications, notification)
+	}
+	return notifications, nil
+}
@@ -5,7 +5,10 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -118,6 +121,118 @@ func (users *users) GetProjectLimit(ctx context.Context, id uuid.UUID) (limit in
 	return row.ProjectLimit, nil
 }
 
+// UpdateMFA is a method for updating the multi-factor authentication settings of a user.
+func (users *users) UpdateMFA(ctx context.Context, user *console.User) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	recoveryCodes, err := mfaRecoveryCodesToDBX(user.MFARecoveryCodes)
+	if err != nil {
+		return err
+	}
+
+	var secretKey *string
+	if user.MFASecretKey != "" {
+		secretKey = &user.MFASecretKey
+	}
+
+	updated, err := users.db.Update_User_By_Id(ctx, dbx.User_Id(user.ID[:]), dbx.User_Update_Fields{
+		MfaEnabled:       dbx.User_MfaEnabled(user.MFAEnabled),
+		MfaSecretKey:     dbx.User_MfaSecretKey_Raw(secretKey),
+		MfaRecoveryCodes: dbx.User_MfaRecoveryCodes_Raw(recoveryCodes),
+	})
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// UseMFACounter stores the TOTP counter of an accepted passcode of a user. It returns false,
+// when a passcode with the same or a later counter was already accepted.
+func (users *users) UseMFACounter(ctx context.Context, id uuid.UUID, counter int64) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := users.db.Update_User_By_Id_And_MfaLastCounter_Less(ctx,
+		dbx.User_Id(id[:]),
+		dbx.User_MfaLastCounter(counter),
+		dbx.User_Update_Fields{
+			MfaLastCounter: dbx.User_MfaLastCounter(counter),
+		})
+	if err != nil {
+		return false, err
+	}
+	return updated != nil, nil
+}
+
+// UpdateMFARecoveryCodes replaces the recovery codes of a user, when they still equal old.
+// It returns false, when the recovery codes were changed in the meantime.
+func (users *users) UpdateMFARecoveryCodes(ctx context.Context, id uuid.UUID, old, codes []string) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oldCodes, err := mfaRecoveryCodesToDBX(old)
+	if err != nil {
+		return false, err
+	}
+	if oldCodes == nil {
+		// a user without recovery codes has none to replace.
+		return false, nil
+	}
+
+	newCodes, err := mfaRecoveryCodesToDBX(codes)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := users.db.Update_User_By_Id_And_MfaRecoveryCodes(ctx,
+		dbx.User_Id(id[:]),
+		dbx.User_MfaRecoveryCodes(*oldCodes),
+		dbx.User_Update_Fields{
+			MfaRecoveryCodes: dbx.User_MfaRecoveryCodes_Raw(newCodes),
+		})
+	if err != nil {
+		return false, err
+	}
+	return updated != nil, nil
+}
+
+// UpdateMFAFailedAttempts updates the failed MFA attempts and the lockout of a user, when the
+// failed attempts still equal old. It returns false, when they were changed in the meantime.
+func (users *users) UpdateMFAFailedAttempts(ctx context.Context, id uuid.UUID, old, attempts int, lockedUntil time.Time) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.User_Update_Fields{
+		MfaFailedAttempts: dbx.User_MfaFailedAttempts(attempts),
+		MfaLockedUntil:    dbx.User_MfaLockedUntil_Null(),
+	}
+	if !lockedUntil.IsZero() {
+		update.MfaLockedUntil = dbx.User_MfaLockedUntil(lockedUntil)
+	}
+
+	updated, err := users.db.Update_User_By_Id_And_MfaFailedAttempts(ctx,
+		dbx.User_Id(id[:]),
+		dbx.User_MfaFailedAttempts(old),
+		update)
+	if err != nil {
+		return false, err
+	}
+	return updated != nil, nil
+}
+
+// mfaRecoveryCodesToDBX encodes the recovery codes as they're stored, which is nil when there are none.
+func mfaRecoveryCodesToDBX(codes []string) (*string, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(codes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	encoded := string(data)
+	return &encoded, nil
+}
+
 // toUpdateUser creates dbx.User_Update_Fields with only non-empty fields as updatable.
 func toUpdateUser(user *console.User) dbx.User_Update_Fields {
 	update := dbx.User_Update_Fields{
@@ -187,6 +302,23 @@ func userFromDBX(ctx context.Context, user *dbx.User) (_ *console.User, err erro
 		result.EmployeeCount = *user.EmployeeCount
 	}
 
+	result.MFAEnabled = user.MfaEnabled
+	if user.MfaSecretKey != nil {
+		result.MFASecretKey = *user.MfaSecretKey
+	}
+
+	if user.MfaRecoveryCodes != nil {
+		if err := json.Unmarshal([]byte(*user.MfaRecoveryCodes), &result.MFARecoveryCodes); err != nil {
+			return nil, Error.New("invalid recovery codes: %v", err)
+		}
+	}
+
+	result.MFALastCounter = user.MfaLastCounter
+	result.MFAFailedAttempts = user.MfaFailedAttempts
+	if user.MfaLockedUntil != nil {
+		result.MFALockedUntil = *user.MfaLockedUntil
+	}
+
 	return &result, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -98,6 +99,10 @@ func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage")
 // trashdir contains files staged for deletion for a period of time.
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+// quarantinedir contains files which failed verification. They are kept for
+// inspection, but are no longer served.
+func (dir *Dir) quarantinedir() string { return filepath.Join(dir.path, "quarantine") }
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (dir *Dir) CreateVerificationFile(id storj.NodeID) error {
 	f, err := os.Create(filepath.Join(dir.path, verificationFileName))
@@ -411,6 +416,39 @@ func (dir *Dir) TrashWithStorageFormat(ctx context.Context, ref storage.BlobRef,
 	return err
 }
 
+// Quarantine moves the blob specified by ref to the quarantine directory. It
+// returns an os.ErrNotExist error when the blob isn't found.
+func (dir *Dir) Quarantine(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blobsBasePath, err := dir.blobToBasePath(ref)
+	if err != nil {
+		return err
+	}
+	quarantineBasePath, err := dir.refToDirPath(ref, dir.quarantinedir())
+	if err != nil {
+		return err
+	}
+
+	for formatVer := MaxFormatVersionSupported; formatVer >= MinFormatVersionSupported; formatVer-- {
+		blobsVerPath := blobPathForFormatVersion(blobsBasePath, formatVer)
+		if _, err := os.Stat(blobsVerPath); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+
+		quarantineVerPath := blobPathForFormatVersion(quarantineBasePath, formatVer)
+		err = os.MkdirAll(filepath.Dir(quarantineVerPath), dirPermission)
+		if err != nil && !os.IsExist(err) {
+			return err
+		}
+		return rename(blobsVerPath, quarantineVerPath)
+	}
+	return os.ErrNotExist
+}
+
 // ReplaceTrashnow is a helper for tests to replace the trashnow function used
 // when moving files to the trash.
 func (dir *Dir) ReplaceTrashnow(trashnow func() time.Time) {
@@ -687,47 +725,83 @@ func (dir *Dir) WalkNamespace(ctx context.Context, namespace []byte, walkFunc fu
 
 func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path string, walkFunc func(storage.BlobInfo) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	namespaceDir := pathEncoding.EncodeToString(namespace)
-	nsDir := filepath.Join(path, namespaceDir)
-	openDir, err := os.Open(nsDir)
+	keyPrefixes, err := dir.keyPrefixesInPath(ctx, namespace, path)
 	if err != nil {
-		if os.IsNotExist(err) {
-			// job accomplished: there are no blobs in this namespace!
-			return nil
-		}
 		return err
 	}
-	defer func() { err = errs.Combine(err, openDir.Close()) }()
-	for {
-		// check for context done both before and after our readdir() call
-		if err := ctx.Err(); err != nil {
-			return err
-		}
-		subdirNames, err := openDir.Readdirnames(nameBatchSize)
+	for _, keyPrefix := range keyPrefixes {
+		err := dir.walkNamespaceKeyPrefixInPath(ctx, namespace, keyPrefix, path, walkFunc)
 		if err != nil {
-			if errors.Is(err, io.EOF) || os.IsNotExist(err) {
-				return nil
-			}
 			return err
 		}
-		if len(subdirNames) == 0 {
-			return nil
+	}
+	return nil
+}
+
+// keyPrefixesInPath returns the key prefixes of the namespace in sorted order,
+// so that walks can be resumed from a key prefix. There are at most 1024 of
+// them.
+func (dir *Dir) keyPrefixesInPath(ctx context.Context, namespace []byte, path string) (_ []string, err error) {
+	nsDir := filepath.Join(path, pathEncoding.EncodeToString(namespace))
+	openDir, err := os.Open(nsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// job accomplished: there are no blobs in this namespace!
+			return nil, nil
 		}
-		if err := ctx.Err(); err != nil {
-			return err
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, openDir.Close()) }()
+
+	// check for context done both before and after our readdir() call
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	subdirNames, err := openDir.Readdirnames(-1)
+	if err != nil {
+		if errors.Is(err, io.EOF) || os.IsNotExist(err) {
+			return nil, nil
 		}
-		for _, keyPrefix := range subdirNames {
-			if len(keyPrefix) != 2 {
-				// just an invalid subdir; could be garbage of many kinds. probably
-				// don't need to pass on this error
-				continue
-			}
-			err := walkNamespaceWithPrefix(ctx, dir.log, namespace, nsDir, keyPrefix, walkFunc)
-			if err != nil {
-				return err
-			}
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	keyPrefixes := subdirNames[:0]
+	for _, keyPrefix := range subdirNames {
+		if len(keyPrefix) != 2 {
+			// just an invalid subdir; could be garbage of many kinds. probably
+			// don't need to pass on this error
+			continue
 		}
+		keyPrefixes = append(keyPrefixes, keyPrefix)
+	}
+	sort.Strings(keyPrefixes)
+	return keyPrefixes, nil
+}
+
+// walkNamespaceKeyPrefixInPath executes walkFunc for each blob of the namespace
+// with the given key prefix.
+func (dir *Dir) walkNamespaceKeyPrefixInPath(ctx context.Context, namespace []byte, keyPrefix, path string, walkFunc func(storage.BlobInfo) error) (err error) {
+	nsDir := filepath.Join(path, pathEncoding.EncodeToString(namespace))
+	err = walkNamespaceWithPrefix(ctx, dir.log, namespace, nsDir, keyPrefix, walkFunc)
+	if os.IsNotExist(err) {
+		// the key prefix was removed in the meantime.
+		return nil
+	}
+	return err
+}
+
+// KeyPrefix returns the key prefix of the sub-directory holding the blob with
+// the given key. Blobs of a namespace are walked in the sorted order of their
+// key prefixes.
+func KeyPrefix(key []byte) string {
+	encoded := pathEncoding.EncodeToString(key)
+	if len(encoded) < 3 {
+		encoded = "11" + encoded
 	}
+	return encoded[:2]
 }
 
 func decodeBlobInfo(namespace []byte, keyPrefix, keyDir string, keyInfo os.FileInfo) (info storage.BlobInfo, ok bool) {
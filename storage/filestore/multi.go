@@ -0,0 +1,673 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+)
+
+var _ storage.Blobs = (*MultiStore)(nil)
+
+// RootConfig is the location and the allocated space of a single storage
+// directory of a MultiStore.
+type RootConfig struct {
+	Path      string
+	Allocated int64
+}
+
+// ParseRootConfig parses a storage directory given as "path=size", e.g.
+// "/mnt/disk2=2TB".
+func ParseRootConfig(value string) (RootConfig, error) {
+	index := strings.LastIndex(value, "=")
+	if index <= 0 {
+		return RootConfig{}, Error.New("storage directory %q must be given as path=size", value)
+	}
+
+	var allocated memory.Size
+	if err := allocated.Set(value[index+1:]); err != nil {
+		return RootConfig{}, Error.New("invalid allocation for storage directory %q: %v", value, err)
+	}
+	return RootConfig{
+		Path:      value[:index],
+		Allocated: allocated.Int64(),
+	}, nil
+}
+
+// RootStatus describes the state of a single storage directory of a
+// MultiStore.
+type RootStatus struct {
+	Path      string
+	Allocated int64
+	// Used is an estimate of the space used by blobs and trash in this
+	// directory.
+	Used int64
+	// Free is the free space of the disk backing this directory.
+	Free int64
+	// Available is the space that can still be used for new blobs, taking
+	// both the allocation and the free disk space into account.
+	Available int64
+	Writable  bool
+}
+
+// multiRoot is a single storage directory of a MultiStore.
+type multiRoot struct {
+	store     *blobStore
+	allocated int64
+
+	// used and writable are accessed atomically.
+	used     int64
+	writable int32
+}
+
+func (root *multiRoot) isWritable() bool { return atomic.LoadInt32(&root.writable) != 0 }
+
+func (root *multiRoot) setWritable(writable bool) (changed bool) {
+	value := int32(0)
+	if writable {
+		value = 1
+	}
+	return atomic.SwapInt32(&root.writable, value) != value
+}
+
+// MultiStore implements a blob store spanning multiple directories, each with
+// its own allocation. New blobs are placed in the writable directory with the
+// most available space and reads are resolved across all directories.
+//
+// The first directory is the primary one; it's expected to have been set up
+// together with the storage node.
+type MultiStore struct {
+	log   *zap.Logger
+	roots []*multiRoot
+}
+
+// NewMulti creates a blob store spanning the specified directories.
+func NewMulti(log *zap.Logger, dirs []*Dir, allocations []int64, config Config) (*MultiStore, error) {
+	if len(dirs) == 0 {
+		return nil, Error.New("no storage directories")
+	}
+	if len(dirs) != len(allocations) {
+		return nil, Error.New("got %d allocations for %d storage directories", len(allocations), len(dirs))
+	}
+
+	store := &MultiStore{log: log}
+	for i, dir := range dirs {
+		store.roots = append(store.roots, &multiRoot{
+			store:     &blobStore{log: log, dir: dir, config: config},
+			allocated: allocations[i],
+			writable:  1,
+		})
+	}
+	return store, nil
+}
+
+// Close closes the store.
+func (store *MultiStore) Close() (err error) {
+	var group errs.Group
+	for _, root := range store.roots {
+		group.Add(root.store.Close())
+	}
+	return group.Err()
+}
+
+// Roots returns the status of every storage directory. The free space of
+// directories whose disk can't be queried is reported as zero.
+func (store *MultiStore) Roots(ctx context.Context) (_ []RootStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	statuses := make([]RootStatus, 0, len(store.roots))
+	for _, root := range store.roots {
+		var free int64
+		if info, err := root.store.dir.Info(); err == nil {
+			free = info.AvailableSpace
+		}
+		statuses = append(statuses, root.status(free))
+	}
+	return statuses, nil
+}
+
+func (root *multiRoot) status(free int64) RootStatus {
+	used := atomic.LoadInt64(&root.used)
+
+	available := free
+	if root.allocated > 0 && root.allocated-used < available {
+		available = root.allocated - used
+	}
+	if available < 0 {
+		available = 0
+	}
+
+	return RootStatus{
+		Path:      root.store.dir.Path(),
+		Allocated: root.allocated,
+		Used:      used,
+		Free:      free,
+		Available: available,
+		Writable:  root.isWritable(),
+	}
+}
+
+// RefreshUsage recalculates the space used in every storage directory. In
+// between refreshes the usage is tracked as blobs are committed and deleted.
+func (store *MultiStore) RefreshUsage(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		blobsUsed, err := root.store.SpaceUsedForBlobs(ctx)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		trashUsed, err := root.store.SpaceUsedForTrash(ctx)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		atomic.StoreInt64(&root.used, blobsUsed+trashUsed)
+	}
+	return group.Err()
+}
+
+// Open loads blob with the specified hash from the first directory holding it.
+// Every directory is tried, so a failing directory doesn't hide blobs stored
+// in the others.
+func (store *MultiStore) Open(ctx context.Context, ref storage.BlobRef) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, root := range store.roots {
+		reader, err := root.store.Open(ctx, ref)
+		if err == nil {
+			return reader, nil
+		}
+		if !errs.IsFunc(err, os.IsNotExist) {
+			group.Add(Error.New("%q: %v", root.store.dir.Path(), err))
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, err
+	}
+	return nil, os.ErrNotExist
+}
+
+// OpenWithStorageFormat loads the already-located blob, avoiding the potential need to check multiple
+// storage formats to find the blob.
+func (store *MultiStore) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobReader, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, root := range store.roots {
+		reader, err := root.store.OpenWithStorageFormat(ctx, ref, formatVer)
+		if err == nil {
+			return reader, nil
+		}
+		if !errs.IsFunc(err, os.IsNotExist) {
+			group.Add(Error.New("%q: %v", root.store.dir.Path(), err))
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, err
+	}
+	return nil, os.ErrNotExist
+}
+
+// Stat looks up disk metadata on the blob file.
+func (store *MultiStore) Stat(ctx context.Context, ref storage.BlobRef) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, info, err := store.locate(ctx, ref)
+	return info, err
+}
+
+// StatWithStorageFormat looks up disk metadata on the blob file with the given storage format version.
+func (store *MultiStore) StatWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (_ storage.BlobInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var group errs.Group
+	for _, root := range store.roots {
+		info, err := root.store.StatWithStorageFormat(ctx, ref, formatVer)
+		if err == nil {
+			return info, nil
+		}
+		if !errs.IsFunc(err, os.IsNotExist) {
+			group.Add(Error.New("%q: %v", root.store.dir.Path(), err))
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, err
+	}
+	return nil, Error.Wrap(os.ErrNotExist)
+}
+
+// locate returns the directory holding the blob.
+func (store *MultiStore) locate(ctx context.Context, ref storage.BlobRef) (_ *multiRoot, _ storage.BlobInfo, err error) {
+	var group errs.Group
+	for _, root := range store.roots {
+		info, err := root.store.Stat(ctx, ref)
+		if err == nil {
+			return root, info, nil
+		}
+		if !errs.IsFunc(err, os.IsNotExist) {
+			group.Add(Error.New("%q: %v", root.store.dir.Path(), err))
+		}
+	}
+	if err := group.Err(); err != nil {
+		return nil, nil, err
+	}
+	return nil, nil, Error.Wrap(os.ErrNotExist)
+}
+
+// Delete deletes blobs with the specified ref.
+//
+// It doesn't return an error if the blob isn't found for any reason or it cannot
+// be deleted at this moment and it's delayed.
+func (store *MultiStore) Delete(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		info, err := root.store.Stat(ctx, ref)
+		if errs.IsFunc(err, os.IsNotExist) {
+			continue
+		}
+		if err == nil {
+			if stat, err := info.Stat(ctx); err == nil {
+				atomic.AddInt64(&root.used, -stat.Size())
+			}
+		}
+		group.Add(root.store.Delete(ctx, ref))
+	}
+	return group.Err()
+}
+
+// DeleteWithStorageFormat deletes blobs with the specified ref and storage format version.
+func (store *MultiStore) DeleteWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		info, err := root.store.StatWithStorageFormat(ctx, ref, formatVer)
+		if errs.IsFunc(err, os.IsNotExist) {
+			continue
+		}
+		if err == nil {
+			if stat, err := info.Stat(ctx); err == nil {
+				atomic.AddInt64(&root.used, -stat.Size())
+			}
+		}
+		group.Add(root.store.DeleteWithStorageFormat(ctx, ref, formatVer))
+	}
+	return group.Err()
+}
+
+// DeleteNamespace deletes blobs folder of specific satellite in every directory.
+func (store *MultiStore) DeleteNamespace(ctx context.Context, ref []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		used, err := root.store.SpaceUsedForBlobsInNamespace(ctx, ref)
+		if err == nil {
+			atomic.AddInt64(&root.used, -used)
+		}
+		group.Add(root.store.DeleteNamespace(ctx, ref))
+	}
+	return group.Err()
+}
+
+// Trash moves the ref to the trash directory of the directory holding it.
+func (store *MultiStore) Trash(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	root, _, err := store.locate(ctx, ref)
+	if errs.IsFunc(err, os.IsNotExist) {
+		root = store.roots[0]
+	} else if err != nil {
+		return err
+	}
+	return root.store.Trash(ctx, ref)
+}
+
+// Quarantine moves the ref to the quarantine directory of the directory holding it.
+func (store *MultiStore) Quarantine(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	root, info, err := store.locate(ctx, ref)
+	if err != nil {
+		if errs.IsFunc(err, os.IsNotExist) {
+			return os.ErrNotExist
+		}
+		return err
+	}
+	if stat, err := info.Stat(ctx); err == nil {
+		atomic.AddInt64(&root.used, -stat.Size())
+	}
+	return root.store.Quarantine(ctx, ref)
+}
+
+// RestoreTrash moves every piece in the trash back into the regular location.
+func (store *MultiStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		keys, err := root.store.RestoreTrash(ctx, namespace)
+		keysRestored = append(keysRestored, keys...)
+		group.Add(err)
+	}
+	return keysRestored, group.Err()
+}
+
+// EmptyTrash removes all files in trash that have been there longer than trashExpiryDur.
+func (store *MultiStore) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (bytesEmptied int64, keys [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		emptied, deleted, err := root.store.EmptyTrash(ctx, namespace, trashedBefore)
+		atomic.AddInt64(&root.used, -emptied)
+		bytesEmptied += emptied
+		keys = append(keys, deleted...)
+		group.Add(err)
+	}
+	return bytesEmptied, keys, group.Err()
+}
+
+// GarbageCollect tries to delete any files that haven't yet been deleted.
+func (store *MultiStore) GarbageCollect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, root := range store.roots {
+		group.Add(root.store.GarbageCollect(ctx))
+	}
+	return group.Err()
+}
+
+// Create creates a new blob that can be written in the writable directory with
+// the most available space. Directories which fail to create the blob are
+// skipped in favor of the next best one.
+func (store *MultiStore) Create(ctx context.Context, ref storage.BlobRef, size int64) (_ storage.BlobWriter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	candidates, err := store.placement()
+	if err != nil {
+		return nil, err
+	}
+
+	var group errs.Group
+	for _, root := range candidates {
+		writer, err := root.store.Create(ctx, ref, size)
+		if err != nil {
+			store.log.Warn("unable to create blob in storage directory",
+				zap.String("Path", root.store.dir.Path()), zap.Error(err))
+			group.Add(err)
+			continue
+		}
+		return &multiBlobWriter{BlobWriter: writer, root: root}, nil
+	}
+	return nil, Error.New("unable to create blob in any storage directory: %v", group.Err())
+}
+
+// placement returns the writable directories ordered by descending available
+// space. Directories without any available space are excluded.
+func (store *MultiStore) placement() ([]*multiRoot, error) {
+	type candidate struct {
+		root      *multiRoot
+		available int64
+	}
+
+	var candidates []candidate
+	for _, root := range store.roots {
+		if !root.isWritable() {
+			continue
+		}
+		info, err := root.store.dir.Info()
+		if err != nil {
+			store.log.Warn("unable to get disk info of storage directory",
+				zap.String("Path", root.store.dir.Path()), zap.Error(err))
+			continue
+		}
+		status := root.status(info.AvailableSpace)
+		if status.Available <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{root: root, available: status.Available})
+	}
+	if len(candidates) == 0 {
+		return nil, Error.New("no writable storage directory with available space")
+	}
+
+	sort.SliceStable(candidates, func(i, k int) bool {
+		return candidates[i].available > candidates[k].available
+	})
+
+	roots := make([]*multiRoot, 0, len(candidates))
+	for _, candidate := range candidates {
+		roots = append(roots, candidate.root)
+	}
+	return roots, nil
+}
+
+// multiBlobWriter tracks the space used by the directory once the blob is
+// committed.
+type multiBlobWriter struct {
+	storage.BlobWriter
+	root *multiRoot
+}
+
+// Commit ensures that the blob is readable by others.
+func (writer *multiBlobWriter) Commit(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	size, err := writer.BlobWriter.Size()
+	if err != nil {
+		return err
+	}
+	if err := writer.BlobWriter.Commit(ctx); err != nil {
+		return err
+	}
+	atomic.AddInt64(&writer.root.used, size)
+	return nil
+}
+
+// SpaceUsedForBlobs adds up the space used in all namespaces for blob storage.
+func (store *MultiStore) SpaceUsedForBlobs(ctx context.Context) (space int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, root := range store.roots {
+		used, err := root.store.SpaceUsedForBlobs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		space += used
+	}
+	return space, nil
+}
+
+// SpaceUsedForBlobsInNamespace adds up how much is used in the given namespace for blob storage.
+func (store *MultiStore) SpaceUsedForBlobsInNamespace(ctx context.Context, namespace []byte) (space int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, root := range store.roots {
+		used, err := root.store.SpaceUsedForBlobsInNamespace(ctx, namespace)
+		if err != nil {
+			return 0, err
+		}
+		space += used
+	}
+	return space, nil
+}
+
+// SpaceUsedForTrash returns the total space used by the trash.
+func (store *MultiStore) SpaceUsedForTrash(ctx context.Context) (space int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	for _, root := range store.roots {
+		used, err := root.store.SpaceUsedForTrash(ctx)
+		if err != nil {
+			return 0, err
+		}
+		space += used
+	}
+	return space, nil
+}
+
+// FreeSpace returns how much space is left on the disks of the writable
+// directories. Directories sharing a disk are only counted once.
+func (store *MultiStore) FreeSpace() (int64, error) {
+	disks := map[string]bool{}
+
+	var free int64
+	for _, root := range store.roots {
+		if !root.isWritable() {
+			continue
+		}
+		info, err := root.store.dir.Info()
+		if err != nil {
+			return 0, err
+		}
+		if info.ID != "" && disks[info.ID] {
+			continue
+		}
+		disks[info.ID] = true
+		free += info.AvailableSpace
+	}
+	return free, nil
+}
+
+// CheckWritability tests writability of every storage directory by creating
+// and deleting a file. Directories failing the check are taken out of the
+// upload rotation until they pass it again. An error is only returned when no
+// directory is writable.
+func (store *MultiStore) CheckWritability() error {
+	var group errs.Group
+	for _, root := range store.roots {
+		path := root.store.dir.Path()
+
+		err := root.store.CheckWritability()
+		if root.setWritable(err == nil) {
+			if err != nil {
+				store.log.Error("storage directory is not writable, excluding it from uploads",
+					zap.String("Path", path), zap.Error(err))
+			} else {
+				store.log.Info("storage directory is writable again, including it in uploads",
+					zap.String("Path", path))
+			}
+		}
+		if err != nil {
+			group.Add(Error.New("%q: %v", path, err))
+		}
+	}
+
+	for _, root := range store.roots {
+		if root.isWritable() {
+			return nil
+		}
+	}
+	return group.Err()
+}
+
+// ListNamespaces finds all known namespace IDs in use in any storage directory.
+func (store *MultiStore) ListNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	seen := map[string]bool{}
+	for _, root := range store.roots {
+		namespaces, err := root.store.ListNamespaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, namespace := range namespaces {
+			if seen[string(namespace)] {
+				continue
+			}
+			seen[string(namespace)] = true
+			ids = append(ids, namespace)
+		}
+	}
+	return ids, nil
+}
+
+// WalkNamespace executes walkFunc for each locally stored blob in the given namespace of every
+// storage directory. Like with a single directory, blobs are walked in the sorted order of their
+// key prefixes. If walkFunc returns a non-nil error, WalkNamespace will stop iterating and return
+// the error immediately.
+func (store *MultiStore) WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[string]bool{}
+	var keyPrefixes []string
+	for _, root := range store.roots {
+		rootKeyPrefixes, err := root.store.dir.keyPrefixesInPath(ctx, namespace, root.store.dir.blobsdir())
+		if err != nil {
+			return err
+		}
+		for _, keyPrefix := range rootKeyPrefixes {
+			if !seen[keyPrefix] {
+				seen[keyPrefix] = true
+				keyPrefixes = append(keyPrefixes, keyPrefix)
+			}
+		}
+	}
+	sort.Strings(keyPrefixes)
+
+	for _, keyPrefix := range keyPrefixes {
+		for _, root := range store.roots {
+			err := root.store.dir.walkNamespaceKeyPrefixInPath(ctx, namespace, keyPrefix, root.store.dir.blobsdir(), walkFunc)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// TestCreateV0 creates a new V0 blob that can be written in the primary
+// directory. This is ONLY appropriate in test situations.
+func (store *MultiStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
+	return store.roots[0].store.TestCreateV0(ctx, ref)
+}
+
+// CreateVerificationFile creates a file to be used for storage directory verification
+// in every storage directory.
+func (store *MultiStore) CreateVerificationFile(id storj.NodeID) error {
+	var group errs.Group
+	for _, root := range store.roots {
+		group.Add(root.store.CreateVerificationFile(id))
+	}
+	return group.Err()
+}
+
+// VerifyStorageDir verifies that every storage directory is correct by checking for the existence
+// and validity of the verification file.
+//
+// Directories added after the node was set up don't have a verification file yet. It is created
+// for them, as long as they don't contain any blobs.
+func (store *MultiStore) VerifyStorageDir(id storj.NodeID) error {
+	var group errs.Group
+	for i, root := range store.roots {
+		if err := store.verifyRoot(i, root, id); err != nil {
+			group.Add(Error.New("%q: %v", root.store.dir.Path(), err))
+		}
+	}
+	return group.Err()
+}
+
+// verifyRoot verifies a single storage directory, creating the verification
+// file for directories added after the node was set up.
+func (store *MultiStore) verifyRoot(i int, root *multiRoot, id storj.NodeID) error {
+	err := root.store.VerifyStorageDir(id)
+	if i == 0 || err == nil || !os.IsNotExist(err) {
+		return err
+	}
+
+	namespaces, listErr := root.store.ListNamespaces(context.TODO())
+	if listErr != nil || len(namespaces) > 0 {
+		return errs.Combine(err, listErr)
+	}
+	return root.store.CreateVerificationFile(id)
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package filestore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+func TestParseRootConfig(t *testing.T) {
+	for _, tt := range []struct {
+		value    string
+		expected filestore.RootConfig
+		err      bool
+	}{
+		{value: "/mnt/disk", err: true},
+		{value: "=1GB", err: true},
+		{value: "/mnt/disk=2TB", expected: filestore.RootConfig{Path: "/mnt/disk", Allocated: 2 * memory.TB.Int64()}},
+		{value: "/mnt/a=b=1GB", expected: filestore.RootConfig{Path: "/mnt/a=b", Allocated: memory.GB.Int64()}},
+		{value: "/mnt/disk=2XB", err: true},
+		{value: "/mnt/disk=", err: true},
+	} {
+		root, err := filestore.ParseRootConfig(tt.value)
+		if tt.err {
+			require.Error(t, err, tt.value)
+			continue
+		}
+		require.NoError(t, err, tt.value)
+		require.Equal(t, tt.expected, root, tt.value)
+	}
+}
+
+func newMultiStore(t *testing.T, ctx *testcontext.Context, allocations ...int64) *filestore.MultiStore {
+	log := zaptest.NewLogger(t)
+
+	var dirs []*filestore.Dir
+	for i := range allocations {
+		dir, err := filestore.NewDir(log, ctx.Dir("root", string(rune('a'+i))))
+		require.NoError(t, err)
+		dirs = append(dirs, dir)
+	}
+
+	store, err := filestore.NewMulti(log, dirs, allocations, filestore.DefaultConfig)
+	require.NoError(t, err)
+	return store
+}
+
+func writeBlob(ctx *testcontext.Context, t *testing.T, store storage.Blobs, ref storage.BlobRef, data []byte) {
+	writer, err := store.Create(ctx, ref, int64(len(data)))
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Commit(ctx))
+}
+
+func TestMultiStore_Placement(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := newMultiStore(t, ctx, memory.GB.Int64(), 2*memory.GB.Int64())
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	data := testrand.Bytes(memory.KiB)
+
+	var refs []storage.BlobRef
+	for i := 0; i < 4; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+		writeBlob(ctx, t, store, ref, data)
+		refs = append(refs, ref)
+	}
+
+	roots, err := store.Roots(ctx)
+	require.NoError(t, err)
+	require.Len(t, roots, 2)
+	// the second directory has the larger allocation and receives the blobs
+	require.Zero(t, roots[0].Used)
+	require.Equal(t, int64(4*len(data)), roots[1].Used)
+
+	// reads resolve across directories
+	for _, ref := range refs {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		read, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, data, read)
+		require.NoError(t, reader.Close())
+	}
+
+	namespaces, err := store.ListNamespaces(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{namespace}, namespaces)
+
+	var walked int
+	require.NoError(t, store.WalkNamespace(ctx, namespace, func(storage.BlobInfo) error {
+		walked++
+		return nil
+	}))
+	require.Equal(t, len(refs), walked)
+
+	require.NoError(t, store.Delete(ctx, refs[0]))
+	_, err = store.Stat(ctx, refs[0])
+	require.True(t, errs.IsFunc(err, os.IsNotExist))
+
+	roots, err = store.Roots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3*len(data)), roots[1].Used)
+
+	require.NoError(t, store.RefreshUsage(ctx))
+	roots, err = store.Roots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3*len(data)), roots[1].Used)
+}
+
+func TestMultiStore_Allocation(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := newMultiStore(t, ctx, 0, 2*memory.KiB.Int64())
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(32)
+	data := testrand.Bytes(2 * memory.KiB)
+
+	// a directory without allocation is only limited by its disk
+	ref := storage.BlobRef{Namespace: namespace, Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, store, ref, data)
+
+	roots, err := store.Roots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), roots[0].Used)
+	require.Zero(t, roots[1].Used)
+	require.Equal(t, int64(2*memory.KiB), roots[1].Available)
+}
+
+func TestMultiStore_Writability(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := newMultiStore(t, ctx, memory.GB.Int64(), 2*memory.GB.Int64())
+	defer ctx.Check(store.Close)
+
+	roots, err := store.Roots(ctx)
+	require.NoError(t, err)
+
+	// break the second directory
+	require.NoError(t, os.RemoveAll(roots[1].Path))
+	require.NoError(t, store.CheckWritability())
+
+	roots, err = store.Roots(ctx)
+	require.NoError(t, err)
+	require.True(t, roots[0].Writable)
+	require.False(t, roots[1].Writable)
+
+	// uploads go to the remaining directory
+	ref := storage.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	writeBlob(ctx, t, store, ref, testrand.Bytes(memory.KiB))
+	_, err = os.Stat(roots[0].Path)
+	require.NoError(t, err)
+
+	roots, err = store.Roots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, memory.KiB.Int64(), roots[0].Used)
+
+	// the node only fails when no directory is writable
+	require.NoError(t, os.RemoveAll(roots[0].Path))
+	require.Error(t, store.CheckWritability())
+}
+
+func TestMultiStore_VerifyStorageDir(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id := testidentity.MustPregeneratedIdentity(0, storj.LatestIDVersion()).ID
+	other := testidentity.MustPregeneratedIdentity(1, storj.LatestIDVersion()).ID
+
+	log := zaptest.NewLogger(t)
+	primary, err := filestore.NewDir(log, ctx.Dir("primary"))
+	require.NoError(t, err)
+	require.NoError(t, primary.CreateVerificationFile(id))
+
+	extra, err := filestore.NewDir(log, ctx.Dir("extra"))
+	require.NoError(t, err)
+
+	store, err := filestore.NewMulti(log, []*filestore.Dir{primary, extra}, []int64{0, 0}, filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	// the verification file is created in a newly added directory
+	require.NoError(t, store.VerifyStorageDir(id))
+	require.NoError(t, extra.Verify(id))
+
+	// every directory is checked and reported
+	err = store.VerifyStorageDir(other)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), primary.Path())
+	require.Contains(t, err.Error(), extra.Path())
+}
+
+func TestMultiStore_OpenFailingRoot(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := newMultiStore(t, ctx, memory.GB.Int64(), 2*memory.GB.Int64())
+	defer ctx.Check(store.Close)
+
+	ref := storage.BlobRef{Namespace: testrand.Bytes(32), Key: testrand.Bytes(32)}
+	data := testrand.Bytes(memory.KiB)
+	writeBlob(ctx, t, store, ref, data)
+
+	roots, err := store.Roots(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), roots[1].Used)
+
+	// break the first directory, so looking up blobs in it fails
+	blobsDir := filepath.Join(roots[0].Path, "blobs")
+	require.NoError(t, os.RemoveAll(blobsDir))
+	require.NoError(t, ioutil.WriteFile(blobsDir, nil, 0644))
+
+	// blobs in the other directories are still found
+	reader, err := store.Open(ctx, ref)
+	require.NoError(t, err)
+	read, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+	require.NoError(t, reader.Close())
+
+	_, err = store.Stat(ctx, ref)
+	require.NoError(t, err)
+
+	// a missing blob reports the failing directory instead of not found
+	missing := storage.BlobRef{Namespace: ref.Namespace, Key: testrand.Bytes(32)}
+	_, err = store.Open(ctx, missing)
+	require.Error(t, err)
+	require.False(t, errs.IsFunc(err, os.IsNotExist))
+	require.Contains(t, err.Error(), roots[0].Path)
+
+	_, err = store.Stat(ctx, missing)
+	require.Error(t, err)
+	require.False(t, errs.IsFunc(err, os.IsNotExist))
+}
@@ -150,6 +150,16 @@ func (store *blobStore) EmptyTrash(ctx context.Context, namespace []byte, trashe
 	return bytesEmptied, keys, Error.Wrap(err)
 }
 
+// Quarantine moves the ref to the quarantine directory.
+func (store *blobStore) Quarantine(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.dir.Quarantine(ctx, ref)
+	if os.IsNotExist(err) {
+		return err
+	}
+	return Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted.
 func (store *blobStore) GarbageCollect(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
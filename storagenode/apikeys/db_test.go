@@ -49,3 +49,30 @@ func TestAPIKeysDB(t *testing.T) {
 		})
 	})
 }
+
+func TestServiceRotate(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		service := apikeys.NewService(db.APIKeys())
+
+		apiKey, err := service.Issue(ctx)
+		assert.NoError(t, err)
+
+		// the previous key stays valid until it is removed, so that the
+		// new key can be stored before the old one stops working.
+		rotated, err := service.Issue(ctx)
+		assert.NoError(t, err)
+		assert.True(t, apiKey.Secret != rotated.Secret)
+
+		err = service.Check(ctx, apiKey.Secret)
+		assert.NoError(t, err)
+
+		err = service.Remove(ctx, apiKey.Secret)
+		assert.NoError(t, err)
+
+		err = service.Check(ctx, apiKey.Secret)
+		assert.Error(t, err)
+
+		err = service.Check(ctx, rotated.Secret)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import "time"
+
+// ScrubbingInfo stores the piece scrubbing totals of the node.
+type ScrubbingInfo struct {
+	PiecesScrubbed      int64      `json:"piecesScrubbed"`
+	PiecesCorrupt       int64      `json:"piecesCorrupt"`
+	LastPassCompletedAt *time.Time `json:"lastPassCompletedAt"`
+}
@@ -49,6 +49,7 @@ type Service struct {
 	pricingDB      pricing.DB
 	satelliteDB    satellites.DB
 	pieceStore     *pieces.Store
+	scrubDB        pieces.ScrubDB
 	contact        *contact.Service
 
 	estimation *estimatedpayouts.Service
@@ -67,7 +68,7 @@ type Service struct {
 func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Store, version *checker.Service,
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
-	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache, walletFeatures operator.WalletFeatures) (*Service, error) {
+	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache, walletFeatures operator.WalletFeatures, scrubDB pieces.ScrubDB) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -100,6 +101,10 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		return nil, errs.New("estimation service can't be nil")
 	}
 
+	if scrubDB == nil {
+		return nil, errs.New("scrubDB can't be nil")
+	}
+
 	return &Service{
 		log:                log,
 		trust:              trust,
@@ -110,6 +115,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		pricingDB:          pricingDB,
 		satelliteDB:        satelliteDB,
 		pieceStore:         pieceStore,
+		scrubDB:            scrubDB,
 		version:            version,
 		pingStats:          pingStats,
 		allocatedDiskSpace: allocatedDiskSpace,
@@ -141,6 +147,7 @@ type Dashboard struct {
 
 	DiskSpace DiskSpaceInfo `json:"diskSpace"`
 	Bandwidth BandwidthInfo `json:"bandwidth"`
+	Scrubbing ScrubbingInfo `json:"scrubbing"`
 
 	LastPinged time.Time `json:"lastPinged"`
 
@@ -225,6 +232,19 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 		Used: bandwidthUsage,
 	}
 
+	scrubSummary, err := s.scrubDB.Summary(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	data.Scrubbing = ScrubbingInfo{
+		PiecesScrubbed: scrubSummary.PiecesScrubbed,
+		PiecesCorrupt:  scrubSummary.PiecesCorrupt,
+	}
+	if !scrubSummary.LastPassCompletedAt.IsZero() {
+		data.Scrubbing.LastPassCompletedAt = &scrubSummary.LastPassCompletedAt
+	}
+
 	return data, nil
 }
 
@@ -30,6 +30,10 @@ type Service interface {
 	// and progress.
 	ListPendingExits(ctx context.Context) ([]ExitingSatellite, error)
 
+	// InitiateExit starts a graceful exit from the satellite, recording the
+	// space currently used for it as the starting disk usage.
+	InitiateExit(ctx context.Context, satelliteID storj.NodeID) error
+
 	// DeletePiece deletes one piece stored for a satellite, and updates
 	// the deleted byte count for the corresponding graceful exit operation.
 	DeletePiece(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
@@ -105,6 +109,33 @@ func (c *service) ListPendingExits(ctx context.Context) (_ []ExitingSatellite, e
 	return exitingSatellites, nil
 }
 
+// InitiateExit starts a graceful exit from the satellite, recording the
+// space currently used for it as the starting disk usage.
+func (c *service) InitiateExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := c.trust.GetNodeURL(ctx, satelliteID); err != nil {
+		return Error.Wrap(err)
+	}
+
+	exits, err := c.satelliteDB.ListGracefulExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID == satelliteID {
+			return Error.New("graceful exit from satellite %s was already initiated", satelliteID)
+		}
+	}
+
+	_, piecesContentSize, err := c.store.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(c.satelliteDB.InitiateGracefulExit(ctx, satelliteID, c.nowFunc(), piecesContentSize))
+}
+
 // DeletePiece deletes one piece stored for a satellite, and updates
 // the deleted byte count for the corresponding graceful exit operation.
 func (c *service) DeletePiece(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orders.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SettleSatelliteRequest struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettleSatelliteRequest) Reset()         { *m = SettleSatelliteRequest{} }
+func (m *SettleSatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*SettleSatelliteRequest) ProtoMessage()    {}
+func (*SettleSatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{0}
+}
+func (m *SettleSatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleSatelliteRequest.Unmarshal(m, b)
+}
+func (m *SettleSatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleSatelliteRequest.Marshal(b, m, deterministic)
+}
+func (m *SettleSatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleSatelliteRequest.Merge(m, src)
+}
+func (m *SettleSatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_SettleSatelliteRequest.Size(m)
+}
+func (m *SettleSatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleSatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleSatelliteRequest proto.InternalMessageInfo
+
+type SettleSatelliteResponse struct {
+	Windows              []*SettledWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SettleSatelliteResponse) Reset()         { *m = SettleSatelliteResponse{} }
+func (m *SettleSatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*SettleSatelliteResponse) ProtoMessage()    {}
+func (*SettleSatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{1}
+}
+func (m *SettleSatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleSatelliteResponse.Unmarshal(m, b)
+}
+func (m *SettleSatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleSatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *SettleSatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleSatelliteResponse.Merge(m, src)
+}
+func (m *SettleSatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_SettleSatelliteResponse.Size(m)
+}
+func (m *SettleSatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleSatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleSatelliteResponse proto.InternalMessageInfo
+
+func (m *SettleSatelliteResponse) GetWindows() []*SettledWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// SettledWindow contains the outcome of the settlement of a single window.
+type SettledWindow struct {
+	CreatedAtHour        time.Time `protobuf:"bytes,1,opt,name=created_at_hour,json=createdAtHour,proto3,stdtime" json:"created_at_hour"`
+	Count                int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Status               string    `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error                string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SettledWindow) Reset()         { *m = SettledWindow{} }
+func (m *SettledWindow) String() string { return proto.CompactTextString(m) }
+func (*SettledWindow) ProtoMessage()    {}
+func (*SettledWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f5d4cf0fc9e41b, []int{2}
+}
+func (m *SettledWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettledWindow.Unmarshal(m, b)
+}
+func (m *SettledWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettledWindow.Marshal(b, m, deterministic)
+}
+func (m *SettledWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettledWindow.Merge(m, src)
+}
+func (m *SettledWindow) XXX_Size() int {
+	return xxx_messageInfo_SettledWindow.Size(m)
+}
+func (m *SettledWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettledWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettledWindow proto.InternalMessageInfo
+
+func (m *SettledWindow) GetCreatedAtHour() time.Time {
+	if m != nil {
+		return m.CreatedAtHour
+	}
+	return time.Time{}
+}
+
+func (m *SettledWindow) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SettledWindow) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SettledWindow) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SettleSatelliteRequest)(nil), "storagenode.orders.SettleSatelliteRequest")
+	proto.RegisterType((*SettleSatelliteResponse)(nil), "storagenode.orders.SettleSatelliteResponse")
+	proto.RegisterType((*SettledWindow)(nil), "storagenode.orders.SettledWindow")
+}
+
+func init() { proto.RegisterFile("orders.proto", fileDescriptor_e0f5d4cf0fc9e41b) }
+
+var fileDescriptor_e0f5d4cf0fc9e41b = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xc1, 0x6a, 0xdb, 0x40,
+	0x10, 0x86, 0xab, 0xba, 0x75, 0xdb, 0xb5, 0x5d, 0xc3, 0x52, 0x5c, 0xa1, 0x8b, 0x54, 0x43, 0xa9,
+	0x68, 0x61, 0x45, 0x9d, 0x63, 0x4e, 0x31, 0x39, 0xc4, 0x24, 0x24, 0x20, 0x87, 0x04, 0x72, 0x31,
+	0xb2, 0x77, 0xa2, 0xc8, 0xc8, 0x1a, 0x65, 0x77, 0x84, 0xf3, 0x18, 0x79, 0x85, 0xbc, 0x4d, 0x9e,
+	0x21, 0x07, 0xe7, 0x55, 0x82, 0xb4, 0x56, 0x48, 0x6c, 0x02, 0xb9, 0xed, 0x3f, 0xf3, 0xfd, 0xcb,
+	0xfc, 0x3f, 0x6b, 0xa3, 0x92, 0xa0, 0xb4, 0xc8, 0x15, 0x12, 0x72, 0xae, 0x09, 0x55, 0x14, 0x43,
+	0x86, 0x12, 0x84, 0xd9, 0x38, 0x2c, 0xc6, 0x18, 0xcd, 0xde, 0x71, 0x63, 0xc4, 0x38, 0x85, 0xa0,
+	0x52, 0xd3, 0xe2, 0x32, 0xa0, 0x64, 0x01, 0x9a, 0xa2, 0x45, 0x6e, 0x80, 0xfe, 0x21, 0xeb, 0x8d,
+	0x81, 0x28, 0x85, 0x71, 0x44, 0x90, 0xa6, 0x09, 0x41, 0x08, 0xd7, 0x05, 0x68, 0xe2, 0xff, 0x59,
+	0x5b, 0xd7, 0xb3, 0x49, 0x22, 0x6d, 0xcb, 0xb3, 0xfc, 0xf6, 0xf0, 0xfb, 0xfd, 0xca, 0xfd, 0xf0,
+	0xb0, 0x72, 0x9b, 0xc7, 0x28, 0x61, 0xb4, 0x1f, 0xb6, 0x9e, 0x99, 0x91, 0xec, 0x9f, 0xb1, 0x9f,
+	0x5b, 0x9f, 0xe9, 0x1c, 0x33, 0x0d, 0x7c, 0x97, 0x7d, 0x59, 0x26, 0x99, 0xc4, 0xa5, 0xb6, 0x2d,
+	0xaf, 0xe1, 0xb7, 0x06, 0xbf, 0xc4, 0xf6, 0xe9, 0xc2, 0xb8, 0xe5, 0x79, 0x45, 0x86, 0xb5, 0xa3,
+	0x7f, 0x67, 0xb1, 0xce, 0xab, 0x15, 0x3f, 0x62, 0xdd, 0x99, 0x82, 0x88, 0x40, 0x4e, 0x22, 0x9a,
+	0x5c, 0x61, 0xa1, 0xaa, 0xfb, 0x5a, 0x03, 0x47, 0x98, 0xc4, 0xa2, 0x4e, 0x2c, 0x4e, 0xeb, 0xc4,
+	0xc3, 0xaf, 0xe5, 0xed, 0xb7, 0x8f, 0xae, 0x15, 0x76, 0xd6, 0xe6, 0x3d, 0x3a, 0xc0, 0x42, 0xf1,
+	0x1f, 0xec, 0xf3, 0x0c, 0x8b, 0x8c, 0xec, 0x8f, 0x9e, 0xe5, 0x37, 0x42, 0x23, 0x78, 0x8f, 0x35,
+	0x35, 0x45, 0x54, 0x68, 0xbb, 0xe1, 0x59, 0xfe, 0xb7, 0x70, 0xad, 0x4a, 0x1a, 0x94, 0x42, 0x65,
+	0x7f, 0xaa, 0xc6, 0x46, 0x0c, 0x6e, 0x18, 0x2b, 0x2b, 0x39, 0xa9, 0x82, 0xf0, 0x39, 0xeb, 0x6e,
+	0x34, 0xc1, 0xff, 0xbe, 0x1d, 0x78, 0xb3, 0x7b, 0xe7, 0xdf, 0xbb, 0x58, 0x53, 0xed, 0xf0, 0xcf,
+	0xc5, 0xef, 0x92, 0x9e, 0x8b, 0x04, 0x83, 0xea, 0x11, 0xbc, 0x30, 0x07, 0x49, 0x46, 0xa0, 0xb2,
+	0x28, 0xcd, 0xa7, 0xd3, 0x66, 0xd5, 0xc9, 0xce, 0xd3, 0x00, 0x0b, 0xed, 0xf5, 0xa9, 0x43, 0x02,
+	0x00, 0x00,
+}
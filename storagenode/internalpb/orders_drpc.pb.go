@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.20
+// source: orders.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_orders_proto struct{}
+
+func (drpcEncoding_File_orders_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_orders_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_orders_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_orders_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCNodeOrdersClient interface {
+	DRPCConn() drpc.Conn
+
+	SettleSatellite(ctx context.Context, in *SettleSatelliteRequest) (*SettleSatelliteResponse, error)
+}
+
+type drpcNodeOrdersClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeOrdersClient(cc drpc.Conn) DRPCNodeOrdersClient {
+	return &drpcNodeOrdersClient{cc}
+}
+
+func (c *drpcNodeOrdersClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeOrdersClient) SettleSatellite(ctx context.Context, in *SettleSatelliteRequest) (*SettleSatelliteResponse, error) {
+	out := new(SettleSatelliteResponse)
+	err := c.cc.Invoke(ctx, "/storagenode.orders.NodeOrders/SettleSatellite", drpcEncoding_File_orders_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeOrdersServer interface {
+	SettleSatellite(context.Context, *SettleSatelliteRequest) (*SettleSatelliteResponse, error)
+}
+
+type DRPCNodeOrdersUnimplementedServer struct{}
+
+func (s *DRPCNodeOrdersUnimplementedServer) SettleSatellite(context.Context, *SettleSatelliteRequest) (*SettleSatelliteResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+}
+
+type DRPCNodeOrdersDescription struct{}
+
+func (DRPCNodeOrdersDescription) NumMethods() int { return 1 }
+
+func (DRPCNodeOrdersDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/storagenode.orders.NodeOrders/SettleSatellite", drpcEncoding_File_orders_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeOrdersServer).
+					SettleSatellite(
+						ctx,
+						in1.(*SettleSatelliteRequest),
+					)
+			}, DRPCNodeOrdersServer.SettleSatellite, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterNodeOrders(mux drpc.Mux, impl DRPCNodeOrdersServer) error {
+	return mux.Register(impl, DRPCNodeOrdersDescription{})
+}
+
+type DRPCNodeOrders_SettleSatelliteStream interface {
+	drpc.Stream
+	SendAndClose(*SettleSatelliteResponse) error
+}
+
+type drpcNodeOrders_SettleSatelliteStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeOrders_SettleSatelliteStream) SendAndClose(m *SettleSatelliteResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_orders_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lostpieces_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestDB(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		lostPieces := db.LostPieces()
+
+		satelliteID := testrand.NodeID()
+		otherSatelliteID := testrand.NodeID()
+		first, second, third := testrand.PieceID(), testrand.PieceID(), testrand.PieceID()
+		now := time.Now()
+
+		require.NoError(t, lostPieces.Add(ctx, satelliteID, first, now.Add(-2*time.Hour)))
+		require.NoError(t, lostPieces.Add(ctx, satelliteID, second, now.Add(-time.Hour)))
+		require.NoError(t, lostPieces.Add(ctx, otherSatelliteID, third, now))
+		// adding a piece again keeps it once
+		require.NoError(t, lostPieces.Add(ctx, satelliteID, first, now))
+
+		lost, err := lostPieces.ListUnreported(ctx, satelliteID, 10)
+		require.NoError(t, err)
+		require.Len(t, lost, 2)
+		require.Equal(t, first, lost[0].PieceID)
+		require.Equal(t, second, lost[1].PieceID)
+		require.Equal(t, satelliteID, lost[0].SatelliteID)
+
+		lost, err = lostPieces.ListUnreported(ctx, satelliteID, 1)
+		require.NoError(t, err)
+		require.Len(t, lost, 1)
+		require.Equal(t, first, lost[0].PieceID)
+
+		require.NoError(t, lostPieces.SetReported(ctx, satelliteID, []storj.PieceID{first}, now))
+
+		lost, err = lostPieces.ListUnreported(ctx, satelliteID, 10)
+		require.NoError(t, err)
+		require.Len(t, lost, 1)
+		require.Equal(t, second, lost[0].PieceID)
+
+		lost, err = lostPieces.ListUnreported(ctx, otherSatelliteID, 10)
+		require.NoError(t, err)
+		require.Len(t, lost, 1)
+		require.Equal(t, third, lost[0].PieceID)
+	})
+}
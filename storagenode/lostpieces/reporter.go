@@ -0,0 +1,158 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package lostpieces reports pieces which the node has lost to the satellites.
+package lostpieces
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for lost pieces.
+	Error = errs.Class("lost pieces")
+
+	mon = monkit.Package()
+)
+
+// Config defines parameters for reporting lost pieces.
+type Config struct {
+	Interval           time.Duration `help:"how often missing and corrupt pieces are reported to the satellites" releaseDefault:"1h0m0s" devDefault:"1m0s"`
+	MaxPiecesPerReport int           `help:"the maximum number of pieces sent to a satellite in a single report" default:"1000"`
+}
+
+// LostPiece is a piece which is missing or failed verification.
+type LostPiece struct {
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+	DetectedAt  time.Time
+}
+
+// DB stores pieces which are missing or failed verification until they are
+// reported to the satellite.
+//
+// architecture: Database
+type DB interface {
+	// Add records a piece which is missing or failed verification.
+	Add(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, detectedAt time.Time) error
+	// ListUnreported returns up to limit pieces of the satellite which
+	// haven't been reported yet, oldest first.
+	ListUnreported(ctx context.Context, satelliteID storj.NodeID, limit int) ([]LostPiece, error)
+	// SetReported marks the pieces as reported to the satellite.
+	SetReported(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) error
+}
+
+// Reporter is the chore that reports lost pieces to the satellites, so
+// that they can be repaired before the node fails audits for them.
+//
+// architecture: Chore
+type Reporter struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	trust  *trust.Pool
+	db     DB
+	config Config
+
+	Loop *sync2.Cycle
+}
+
+// NewReporter creates a new lost pieces reporter.
+func NewReporter(log *zap.Logger, dialer rpc.Dialer, trust *trust.Pool, db DB, config Config) *Reporter {
+	return &Reporter{
+		log:    log,
+		dialer: dialer,
+		trust:  trust,
+		db:     db,
+		config: config,
+		Loop:   sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the reporter.
+func (reporter *Reporter) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return reporter.Loop.Run(ctx, func(ctx context.Context) error {
+		for _, satelliteID := range reporter.trust.GetSatellites(ctx) {
+			err := reporter.Report(ctx, satelliteID)
+			if err != nil {
+				reporter.log.Error("unable to report lost pieces", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			}
+		}
+		return nil
+	})
+}
+
+// Close stops the reporter.
+func (reporter *Reporter) Close() error {
+	reporter.Loop.Close()
+	return nil
+}
+
+// Report sends the unreported lost pieces of the satellite. When the
+// satellite rejects reports because the node reported too recently, the
+// remaining pieces are left for the next run.
+func (reporter *Reporter) Report(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lost, err := reporter.db.ListUnreported(ctx, satelliteID, reporter.config.MaxPiecesPerReport)
+	if err != nil || len(lost) == 0 {
+		return Error.Wrap(err)
+	}
+
+	nodeurl, err := reporter.trust.GetNodeURL(ctx, satelliteID)
+	if err != nil {
+		return Error.New("unable to find satellite %s: %w", satelliteID, err)
+	}
+
+	conn, err := reporter.dialer.DialNodeURL(ctx, nodeurl)
+	if err != nil {
+		return Error.New("unable to connect to the satellite %s: %w", satelliteID, err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	client := internalpb.NewDRPCLostPiecesClient(conn)
+	for len(lost) > 0 {
+		pieceIDs := make([]storj.PieceID, len(lost))
+		for i, piece := range lost {
+			pieceIDs[i] = piece.PieceID
+		}
+
+		_, err = client.ReportLostPieces(ctx, &internalpb.ReportLostPiecesRequest{
+			PieceIds: pieceIDs,
+		})
+		if err != nil {
+			if errs2.IsRPC(err, rpcstatus.ResourceExhausted) {
+				return nil
+			}
+			return Error.Wrap(err)
+		}
+
+		if err := reporter.db.SetReported(ctx, satelliteID, pieceIDs, time.Now()); err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Meter("lost_pieces_reported").Mark(len(pieceIDs))
+		reporter.log.Info("reported lost pieces", zap.Stringer("Satellite ID", satelliteID), zap.Int("Count", len(pieceIDs)))
+
+		if len(lost) < reporter.config.MaxPiecesPerReport {
+			return nil
+		}
+		lost, err = reporter.db.ListUnreported(ctx, satelliteID, reporter.config.MaxPiecesPerReport)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
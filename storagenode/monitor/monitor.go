@@ -5,6 +5,7 @@ package monitor
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -55,12 +56,19 @@ type Service struct {
 	store                 *pieces.Store
 	contact               *contact.Service
 	usageDB               bandwidth.DB
-	allocatedDiskSpace    int64
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
 	VerifyDirReadableLoop *sync2.Cycle
 	VerifyDirWritableLoop *sync2.Cycle
 	Config                Config
+
+	// writableRoots is the number of writable storage directories, when
+	// pieces span multiple directories.
+	writableRoots int
+
+	// allocatedDiskSpace can be changed at runtime by SetAllocatedDiskSpace.
+	mu                 sync.Mutex
+	allocatedDiskSpace int64
 }
 
 // NewService creates a new storage node monitoring service.
@@ -96,6 +104,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
+	service.mu.Lock()
 	// check your hard drive is big enough
 	// first time setup as a piece node server
 	if totalUsed == 0 && freeDiskSpace < service.allocatedDiskSpace {
@@ -116,14 +125,24 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		service.allocatedDiskSpace = freeDiskSpace + totalUsed
 		service.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", service.allocatedDiskSpace))
 	}
+	allocatedDiskSpace := service.allocatedDiskSpace
+	service.mu.Unlock()
 
 	// Ensure the disk is at least 500GB in size, which is our current minimum required to be an operator
-	if service.allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
+	if allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
 		service.log.Error("Total disk space is less than required minimum", zap.Int64("bytes", service.Config.MinimumDiskSpace.Int64()))
 		return Error.New("disk space requirement not met")
 	}
 
 	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		// the usage of each storage directory is only needed for placing
+		// new pieces, hence the node doesn't wait for it.
+		if err := service.store.RefreshStorageRoots(ctx); err != nil {
+			service.log.Error("error calculating space used by storage directories", zap.Error(err))
+		}
+		return nil
+	})
 	group.Go(func() error {
 		return service.VerifyDirReadableLoop.Run(ctx, func(ctx context.Context) error {
 			err := service.store.VerifyStorageDir(service.contact.Local().ID)
@@ -139,6 +158,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 			if err != nil {
 				return Error.New("error verifying writability of storage directory: %v", err)
 			}
+			service.checkStorageRoots(ctx)
 			return nil
 		})
 	})
@@ -168,6 +188,92 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// SetAllocatedDiskSpace changes the allocated disk space at runtime and
+// reports the new capacity to the satellites. The change is not persisted,
+// so the configured value is used again after a restart.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, allocated int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if allocated < service.Config.MinimumDiskSpace.Int64() {
+		return Error.New("allocated disk space %s is less than the required minimum %s",
+			memory.Size(allocated), service.Config.MinimumDiskSpace)
+	}
+
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	totalUsed, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if allocated > storageStatus.DiskFree+totalUsed {
+		return Error.New("allocated disk space %s is more than the disk can hold %s",
+			memory.Size(allocated), memory.Size(storageStatus.DiskFree+totalUsed))
+	}
+
+	service.mu.Lock()
+	service.allocatedDiskSpace = allocated
+	service.mu.Unlock()
+
+	service.log.Info("Allocated disk space changed", zap.Int64("bytes", allocated))
+	service.NotifyLowDisk()
+
+	return nil
+}
+
+// allocated returns the allocated disk space.
+func (service *Service) allocated() int64 {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.allocatedDiskSpace
+}
+
+// checkStorageRoots notifies satellites about the changed capacity when a
+// storage directory is taken out of, or put back into, the upload rotation.
+func (service *Service) checkStorageRoots(ctx context.Context) {
+	roots, err := service.store.StorageRoots(ctx)
+	if err != nil {
+		service.log.Error("error getting status of storage directories", zap.Error(err))
+		return
+	}
+	if roots == nil {
+		return
+	}
+
+	writable := 0
+	for _, root := range roots {
+		if root.Writable {
+			writable++
+		} else {
+			service.log.Warn("storage directory is excluded from uploads", zap.String("Path", root.Path))
+		}
+	}
+	mon.IntVal("writable_storage_dirs").Observe(int64(writable))
+
+	if service.writableRoots != 0 && service.writableRoots != writable {
+		service.NotifyLowDisk()
+	}
+	service.writableRoots = writable
+}
+
+// availableInRoots returns the space available for new pieces in the writable
+// storage directories. ok is false when pieces are kept in a single directory.
+func (service *Service) availableInRoots(ctx context.Context) (available int64, ok bool, err error) {
+	roots, err := service.store.StorageRoots(ctx)
+	if err != nil || roots == nil {
+		return 0, false, err
+	}
+	for _, root := range roots {
+		if root.Writable {
+			available += root.Available
+		}
+	}
+	return available, true, nil
+}
+
 // NotifyLowDisk reports disk space to satellites if cooldown timer has expired.
 func (service *Service) NotifyLowDisk() {
 	service.cooldown.Trigger()
@@ -203,7 +309,8 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, err
 	}
 
-	freeSpaceForStorj := service.allocatedDiskSpace - usedSpace
+	allocatedDiskSpace := service.allocated()
+	freeSpaceForStorj := allocatedDiskSpace - usedSpace
 
 	diskStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
@@ -213,7 +320,15 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		freeSpaceForStorj = diskStatus.DiskFree
 	}
 
-	mon.IntVal("allocated_space").Observe(service.allocatedDiskSpace)
+	availableInRoots, ok, err := service.availableInRoots(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if ok && availableInRoots < freeSpaceForStorj {
+		freeSpaceForStorj = availableInRoots
+	}
+
+	mon.IntVal("allocated_space").Observe(allocatedDiskSpace)
 	mon.IntVal("used_space").Observe(usedSpace)
 	mon.IntVal("available_space").Observe(freeSpaceForStorj)
 
@@ -240,7 +355,8 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 
 	overused := int64(0)
 
-	available := service.allocatedDiskSpace - (usedForPieces + usedForTrash)
+	allocatedDiskSpace := service.allocated()
+	available := allocatedDiskSpace - (usedForPieces + usedForTrash)
 	if available < 0 {
 		overused = -available
 	}
@@ -248,8 +364,16 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 		available = storageStatus.DiskFree
 	}
 
+	availableInRoots, ok, err := service.availableInRoots(ctx)
+	if err != nil {
+		return DiskSpace{}, Error.Wrap(err)
+	}
+	if ok && availableInRoots < available {
+		available = availableInRoots
+	}
+
 	return DiskSpace{
-		Allocated:     service.allocatedDiskSpace,
+		Allocated:     allocatedDiskSpace,
 		UsedForPieces: usedForPieces,
 		UsedForTrash:  usedForTrash,
 		Free:          storageStatus.DiskFree,
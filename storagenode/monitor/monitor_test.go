@@ -4,6 +4,8 @@
 package monitor_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/internalpb"
 )
 
@@ -43,3 +46,71 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestSetAllocatedDiskSpace(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		monitor := planet.StorageNodes[0].Storage2.Monitor
+		monitor.Loop.Pause()
+
+		minimum := monitor.Config.MinimumDiskSpace.Int64()
+
+		err := monitor.SetAllocatedDiskSpace(ctx, minimum-1)
+		require.Error(t, err)
+
+		err = monitor.SetAllocatedDiskSpace(ctx, minimum)
+		require.NoError(t, err)
+
+		available, err := monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.LessOrEqual(t, available, minimum)
+	})
+}
+
+func TestMonitorStorageRoots(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Storage.ExtraPaths = []string{filepath.Join(config.Storage.Path, "..", "extra") + "=1GB"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		monitor := node.Storage2.Monitor
+		monitor.Loop.Pause()
+
+		monitor.VerifyDirReadableLoop.TriggerWait()
+		monitor.VerifyDirWritableLoop.TriggerWait()
+
+		roots, err := node.Storage2.Store.StorageRoots(ctx)
+		require.NoError(t, err)
+		require.Len(t, roots, 2)
+		require.True(t, roots[0].Writable)
+		require.True(t, roots[1].Writable)
+
+		// the verification file was created in the extra directory
+		require.NoError(t, node.Storage2.Store.VerifyStorageDir(node.ID()))
+
+		// every directory is checked and reported
+		err = node.Storage2.Store.VerifyStorageDir(testrand.NodeID())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), roots[0].Path)
+		require.Contains(t, err.Error(), roots[1].Path)
+
+		// a broken extra directory is excluded from uploads without stopping the node
+		require.NoError(t, os.RemoveAll(roots[1].Path))
+		monitor.VerifyDirWritableLoop.TriggerWait()
+
+		roots, err = node.Storage2.Store.StorageRoots(ctx)
+		require.NoError(t, err)
+		require.True(t, roots[0].Writable)
+		require.False(t, roots[1].Writable)
+
+		err = node.Storage2.Store.VerifyStorageDir(node.ID())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), roots[1].Path)
+		require.NotContains(t, err.Error(), roots[0].Path)
+	})
+}
@@ -54,3 +54,36 @@ func (bandwidth *BandwidthEndpoint) MonthSummary(ctx context.Context, req *multi
 		Used: used,
 	}, nil
 }
+
+// DailySatellite returns daily bandwidth usage per satellite for the given period.
+func (bandwidth *BandwidthEndpoint) DailySatellite(ctx context.Context, req *multinodepb.BandwidthDailySatelliteRequest) (_ *multinodepb.BandwidthDailySatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, bandwidth.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	summaries, err := bandwidth.db.SummaryBySatellite(ctx, req.From, req.To)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	var resp multinodepb.BandwidthDailySatelliteResponse
+	for satelliteID := range summaries {
+		rollups, err := bandwidth.db.GetDailySatelliteRollups(ctx, satelliteID, req.From, req.To)
+		if err != nil {
+			return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+		}
+
+		for _, rollup := range rollups {
+			resp.Rollups = append(resp.Rollups, &multinodepb.BandwidthDailySatelliteResponse_Rollup{
+				SatelliteId:   satelliteID,
+				IntervalStart: rollup.IntervalStart,
+				Egress:        rollup.Egress.Usage + rollup.Egress.Repair + rollup.Egress.Audit,
+				Ingress:       rollup.Ingress.Usage + rollup.Ingress.Repair,
+			})
+		}
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/satellites"
+)
+
+var _ multinodepb.DRPCGracefulExitServer = (*GracefulExitEndpoint)(nil)
+
+// GracefulExitEndpoint implements multinode graceful exit endpoint.
+//
+// architecture: Endpoint
+type GracefulExitEndpoint struct {
+	multinodepb.DRPCGracefulExitUnimplementedServer
+
+	log        *zap.Logger
+	apiKeys    *apikeys.Service
+	service    gracefulexit.Service
+	satellites satellites.DB
+}
+
+// NewGracefulExitEndpoint creates new multinode graceful exit endpoint.
+func NewGracefulExitEndpoint(log *zap.Logger, apiKeys *apikeys.Service, service gracefulexit.Service, satellites satellites.DB) *GracefulExitEndpoint {
+	return &GracefulExitEndpoint{
+		log:        log,
+		apiKeys:    apiKeys,
+		service:    service,
+		satellites: satellites,
+	}
+}
+
+// InitiateExit starts a graceful exit from the satellite.
+func (exit *GracefulExitEndpoint) InitiateExit(ctx context.Context, req *multinodepb.InitiateGracefulExitRequest) (_ *multinodepb.InitiateGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, exit.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = exit.service.InitiateExit(ctx, req.SatelliteId); err != nil {
+		exit.log.Error("initiate graceful exit error", zap.Stringer("Satellite ID", req.SatelliteId), zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, err)
+	}
+
+	return &multinodepb.InitiateGracefulExitResponse{}, nil
+}
+
+// ExitProgress returns the progress of every graceful exit of the node.
+func (exit *GracefulExitEndpoint) ExitProgress(ctx context.Context, req *multinodepb.GracefulExitProgressRequest) (_ *multinodepb.GracefulExitProgressResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, exit.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	exits, err := exit.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	var resp multinodepb.GracefulExitProgressResponse
+	for _, progress := range exits {
+		var percentComplete float32
+		if progress.StartingDiskUsage != 0 {
+			percentComplete = (float32(progress.BytesDeleted) / float32(progress.StartingDiskUsage)) * 100
+		}
+
+		successful := progress.Status == satellites.ExitSucceeded
+		if successful {
+			percentComplete = 100
+		}
+
+		resp.Progress = append(resp.Progress, &multinodepb.GracefulExitProgressResponse_Progress{
+			SatelliteId:       progress.SatelliteID,
+			InitiatedAt:       progress.InitiatedAt,
+			FinishedAt:        progress.FinishedAt,
+			StartingDiskUsage: progress.StartingDiskUsage,
+			BytesDeleted:      progress.BytesDeleted,
+			PercentComplete:   percentComplete,
+			Successful:        successful,
+		})
+	}
+
+	return &resp, nil
+}
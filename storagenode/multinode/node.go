@@ -4,12 +4,14 @@
 package multinode
 
 import (
+	"bytes"
 	"context"
 
 	"go.uber.org/zap"
 
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/private/version"
+	"storj.io/storj/private/multinodeauth"
 	"storj.io/storj/private/multinodepb"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/contact"
@@ -120,3 +122,49 @@ func (node *NodeEndpoint) TrustedSatellites(ctx context.Context, req *multinodep
 
 	return response, nil
 }
+
+// IssueAPIKey issues a new api key. The key used for the request stays valid
+// until it is revoked with RevokeAPIKey.
+func (node *NodeEndpoint) IssueAPIKey(ctx context.Context, req *multinodepb.IssueAPIKeyRequest) (_ *multinodepb.IssueAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, node.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	apiKey, err := node.apiKeys.Issue(ctx)
+	if err != nil {
+		node.log.Error("api key issue internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.IssueAPIKeyResponse{
+		ApiKey: apiKey.Secret[:],
+	}, nil
+}
+
+// RevokeAPIKey revokes the given api key. The key used for the request can't
+// be revoked, so that a key is only revoked once its replacement works.
+func (node *NodeEndpoint) RevokeAPIKey(ctx context.Context, req *multinodepb.RevokeAPIKeyRequest) (_ *multinodepb.RevokeAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, node.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	secret, err := multinodeauth.SecretFromBytes(req.GetApiKey())
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	if bytes.Equal(secret[:], req.GetHeader().GetApiKey()) {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "the api key used for the request can't be revoked")
+	}
+
+	if err = node.apiKeys.Remove(ctx, secret); err != nil {
+		node.log.Error("api key revoke internal error", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.RevokeAPIKeyResponse{}, nil
+}
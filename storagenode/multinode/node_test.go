@@ -0,0 +1,66 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/private/version"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/multinode"
+)
+
+func TestRotateAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		log := zaptest.NewLogger(t)
+		node := planet.StorageNodes[0]
+		service := apikeys.NewService(node.DB.APIKeys())
+		endpoint := multinode.NewNodeEndpoint(log, service, version.Info{}, node.Contact.PingStats, node.DB.Reputation(), node.Storage2.Trust)
+
+		key, err := service.Issue(ctx)
+		require.NoError(t, err)
+
+		issued, err := endpoint.IssueAPIKey(ctx, &multinodepb.IssueAPIKeyRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: key.Secret[:]},
+		})
+		require.NoError(t, err)
+
+		// the key used for the request is still valid after issuing a new one
+		_, err = endpoint.LastContact(ctx, &multinodepb.LastContactRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: key.Secret[:]},
+		})
+		require.NoError(t, err)
+
+		// a key can't revoke itself
+		_, err = endpoint.RevokeAPIKey(ctx, &multinodepb.RevokeAPIKeyRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: issued.ApiKey},
+			ApiKey: issued.ApiKey,
+		})
+		require.Error(t, err)
+
+		_, err = endpoint.RevokeAPIKey(ctx, &multinodepb.RevokeAPIKeyRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: issued.ApiKey},
+			ApiKey: key.Secret[:],
+		})
+		require.NoError(t, err)
+
+		_, err = endpoint.LastContact(ctx, &multinodepb.LastContactRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: key.Secret[:]},
+		})
+		require.Error(t, err)
+
+		_, err = endpoint.LastContact(ctx, &multinodepb.LastContactRequest{
+			Header: &multinodepb.RequestHeader{ApiKey: issued.ApiKey},
+		})
+		require.NoError(t, err)
+	})
+}
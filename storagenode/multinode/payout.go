@@ -82,3 +82,39 @@ func (payout *PayoutEndpoint) EarnedPerSatellite(ctx context.Context, req *multi
 
 	return &resp, nil
 }
+
+// HeldAmountHistory returns the held amount history of every paying satellite.
+func (payout *PayoutEndpoint) HeldAmountHistory(ctx context.Context, req *multinodepb.HeldAmountHistoryRequest) (_ *multinodepb.HeldAmountHistoryResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, payout.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	satelliteIDs, err := payout.db.GetPayingSatellitesIDs(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	var resp multinodepb.HeldAmountHistoryResponse
+	for _, satelliteID := range satelliteIDs {
+		held, err := payout.db.SatellitesHeldbackHistory(ctx, satelliteID)
+		if err != nil {
+			return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+		}
+
+		history := &multinodepb.HeldAmountHistoryResponse_HeldAmountHistory{
+			SatelliteId: satelliteID,
+		}
+		for _, period := range held {
+			history.HeldAmounts = append(history.HeldAmounts, &multinodepb.HeldAmountHistoryResponse_HeldForPeriod{
+				Period: period.Period,
+				Amount: period.Amount,
+			})
+		}
+
+		resp.History = append(resp.History, history)
+	}
+
+	return &resp, nil
+}
@@ -46,3 +46,39 @@ func TestEarnedPerSatellite(t *testing.T) {
 		require.Equal(t, response.EarnedSatellite[0].Total, amount)
 	})
 }
+
+func TestHeldAmountHistory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		log := zaptest.NewLogger(t)
+		service := apikeys.NewService(planet.StorageNodes[0].DB.APIKeys())
+		endpoint := multinode.NewPayoutEndpoint(log, service, planet.StorageNodes[0].DB.Payout())
+
+		satelliteID := testrand.NodeID()
+		for _, stub := range []payouts.PayStub{
+			{SatelliteID: satelliteID, Period: "2021-01", Held: 100},
+			{SatelliteID: satelliteID, Period: "2021-02", Held: 50},
+		} {
+			err := planet.StorageNodes[0].DB.Payout().StorePayStub(ctx, stub)
+			require.NoError(t, err)
+		}
+
+		key, err := service.Issue(ctx)
+		require.NoError(t, err)
+
+		response, err := endpoint.HeldAmountHistory(ctx, &multinodepb.HeldAmountHistoryRequest{
+			Header: &multinodepb.RequestHeader{
+				ApiKey: key.Secret[:],
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, response.History, 1)
+		require.Equal(t, satelliteID, response.History[0].SatelliteId)
+		require.Len(t, response.History[0].HeldAmounts, 2)
+		require.Equal(t, "2021-01", response.History[0].HeldAmounts[0].Period)
+		require.EqualValues(t, 100, response.History[0].HeldAmounts[0].Amount)
+		require.Equal(t, "2021-02", response.History[0].HeldAmounts[1].Period)
+		require.EqualValues(t, 50, response.History[0].HeldAmounts[1].Amount)
+	})
+}
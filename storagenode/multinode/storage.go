@@ -59,3 +59,18 @@ func (storage *StorageEndpoint) DiskSpace(ctx context.Context, req *multinodepb.
 		Overused:   diskSpace.Overused,
 	}, nil
 }
+
+// SetAllocatedDiskSpace changes the allocated disk space of the node.
+func (storage *StorageEndpoint) SetAllocatedDiskSpace(ctx context.Context, req *multinodepb.SetAllocatedDiskSpaceRequest) (_ *multinodepb.SetAllocatedDiskSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, storage.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = storage.monitor.SetAllocatedDiskSpace(ctx, req.Allocated); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	return &multinodepb.SetAllocatedDiskSpaceResponse{}, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+// Endpoint implements private inspector for orders.
+type Endpoint struct {
+	internalpb.DRPCNodeOrdersUnimplementedServer
+
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint creates a new orders endpoint.
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// SettleSatellite immediately sends the unsent orders of a satellite for settlement.
+func (e *Endpoint) SettleSatellite(ctx context.Context, req *internalpb.SettleSatelliteRequest) (_ *internalpb.SettleSatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := e.service.trust.GetNodeURL(ctx, req.SatelliteId); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, "satellite is not trusted")
+	}
+
+	e.log.Info("settling orders on request", zap.Stringer("Satellite ID", req.SatelliteId))
+
+	results, err := e.service.SettleSatellite(ctx, req.SatelliteId, time.Now())
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	resp := &internalpb.SettleSatelliteResponse{}
+	for _, result := range results {
+		window := &internalpb.SettledWindow{
+			CreatedAtHour: result.CreatedAtHour,
+			Count:         int64(result.Count),
+		}
+		if result.Err != nil {
+			window.Error = result.Err.Error()
+		} else {
+			window.Status = result.Status.String()
+		}
+		resp.Windows = append(resp.Windows, window)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ordersfile
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// Contents contains the entries which could be read from an orders file.
+type Contents struct {
+	Infos []*Info
+	// Corrupt is the number of corrupt entries which were skipped.
+	Corrupt int
+	// Truncated is set when the file ends with an incomplete entry.
+	Truncated bool
+}
+
+// ReadAll reads all the readable entries of the unsent or archived orders file at a given path.
+// Corrupt entries are skipped in V1 files. V0 files have no way to find the entry following
+// a corrupt one, so reading stops at the first corrupt entry.
+func ReadAll(path string, version Version) (_ Contents, err error) {
+	of, err := OpenReadable(path, version)
+	if err != nil {
+		return Contents{}, err
+	}
+	defer func() { err = errs.Combine(err, of.Close()) }()
+
+	var contents Contents
+	for {
+		info, err := of.ReadOne()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if ErrEntryCorrupt.Has(err) {
+				contents.Corrupt++
+				if errors.Is(err, io.ErrUnexpectedEOF) {
+					contents.Truncated = true
+					break
+				}
+				if version == V0 {
+					break
+				}
+				continue
+			}
+			return Contents{}, err
+		}
+
+		contents.Infos = append(contents.Infos, info)
+	}
+
+	return contents, nil
+}
+
+// Rewrite replaces the orders file at a given path with a file of the same version,
+// which contains only the given entries. The new file is written next to the old one
+// and renamed over it once it is complete.
+func Rewrite(path string, version Version, satelliteID storj.NodeID, creationTime time.Time, infos []*Info) (err error) {
+	tmpPath := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := removeTemporary(tmpPath); err != nil {
+		return err
+	}
+
+	var of Writable
+	if version == V0 {
+		of, err = OpenWritableV0(tmpPath)
+	} else {
+		of, err = OpenWritableV1(tmpPath, satelliteID, creationTime)
+	}
+	if err != nil {
+		if of != nil {
+			err = errs.Combine(err, Error.Wrap(of.Close()))
+		}
+		return errs.Combine(err, removeTemporary(tmpPath))
+	}
+
+	for _, info := range infos {
+		if err = of.Append(info); err != nil {
+			break
+		}
+	}
+
+	err = errs.Combine(err, Error.Wrap(of.Close()))
+	if err != nil {
+		return errs.Combine(err, removeTemporary(tmpPath))
+	}
+
+	return Error.Wrap(os.Rename(tmpPath, path))
+}
+
+// removeTemporary removes a leftover temporary file, if it exists.
+func removeTemporary(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return Error.Wrap(err)
+	}
+	return nil
+}
@@ -99,6 +99,9 @@ type Service struct {
 	orders      DB
 	trust       *trust.Pool
 
+	// sendMu ensures that a window isn't settled twice at the same time.
+	sendMu sync.Mutex
+
 	Sender  *sync2.Cycle
 	Cleanup *sync2.Cycle
 }
@@ -175,6 +178,9 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 	defer mon.Task()(&ctx)(nil)
 	service.log.Debug("sending")
 
+	service.sendMu.Lock()
+	defer service.sendMu.Unlock()
+
 	errorSatellites := make(map[storj.NodeID]struct{})
 	var errorSatellitesMu sync.Mutex
 
@@ -232,6 +238,56 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 	}
 }
 
+// SettlementResult is the outcome of settling a single window.
+type SettlementResult struct {
+	CreatedAtHour time.Time
+	Count         int
+	Status        pb.SettlementWithWindowResponse_Status
+	Err           error
+}
+
+// SettleSatellite immediately sends every unsent window of the satellite whose
+// order limit grace period has passed. It stops at the first window which
+// couldn't be settled, since the remaining windows would most likely fail too.
+func (service *Service) SettleSatellite(ctx context.Context, satelliteID storj.NodeID, now time.Time) (_ []SettlementResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.sendMu.Lock()
+	defer service.sendMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, service.config.SenderTimeout)
+	defer cancel()
+
+	log := service.log.Named(satelliteID.String())
+
+	results := []SettlementResult{}
+	for {
+		ordersBySatellite, err := service.ordersStore.ListUnsentBySatellite(ctx, now)
+		if err != nil {
+			log.Error("listing orders", zap.Error(err))
+		}
+		unsentInfo, ok := ordersBySatellite[satelliteID]
+		if !ok {
+			return results, nil
+		}
+
+		result := SettlementResult{
+			CreatedAtHour: unsentInfo.CreatedAtHour,
+			Count:         len(unsentInfo.InfoList),
+		}
+
+		result.Status, result.Err = service.settleWindow(ctx, log, satelliteID, unsentInfo.InfoList)
+		if result.Err == nil {
+			result.Err = service.ordersStore.Archive(satelliteID, unsentInfo, time.Now().UTC(), result.Status)
+		}
+
+		results = append(results, result)
+		if result.Err != nil {
+			return results, nil
+		}
+	}
+}
+
 func (service *Service) settleWindow(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, orders []*ordersfile.Info) (status pb.SettlementWithWindowResponse_Status, err error) {
 	defer mon.Task()(&ctx)(&err)
 
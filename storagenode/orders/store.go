@@ -382,6 +382,137 @@ func (store *FileStore) CleanArchive(deleteBefore time.Time) error {
 	return errs.Combine(errList, err)
 }
 
+// WindowInfo describes the orders file of a single window of a satellite.
+type WindowInfo struct {
+	SatelliteID   storj.NodeID
+	CreatedAtHour time.Time
+	Version       ordersfile.Version
+	Path          string
+
+	Archived   bool
+	ArchivedAt time.Time
+	Status     Status
+
+	// Count is the number of readable orders and Amount their total settled amount.
+	Count  int
+	Amount int64
+	// Corrupt is the number of entries which could not be read.
+	Corrupt int
+	// Truncated is set when the file ends with an incomplete entry.
+	Truncated bool
+}
+
+// ListWindows returns the unsent and archived order windows, including the ones
+// which are still open for new orders.
+func (store *FileStore) ListWindows(ctx context.Context) (_ []WindowInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+	store.archiveMu.Lock()
+	defer store.archiveMu.Unlock()
+
+	var errList error
+	windows := []WindowInfo{}
+
+	walk := func(dir string, archived bool) error {
+		return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				errList = errs.Combine(errList, OrderError.Wrap(err))
+				return nil
+			}
+			if info.IsDir() {
+				return nil
+			}
+
+			window := WindowInfo{
+				Path:     path,
+				Archived: archived,
+				Status:   StatusUnsent,
+			}
+			if archived {
+				fileInfo, err := ordersfile.GetArchivedInfo(info)
+				if err != nil {
+					errList = errs.Combine(errList, OrderError.Wrap(err))
+					return nil
+				}
+				window.SatelliteID = fileInfo.SatelliteID
+				window.CreatedAtHour = fileInfo.CreatedAtHour
+				window.Version = fileInfo.Version
+				window.ArchivedAt = fileInfo.ArchivedAt
+				switch fileInfo.StatusText {
+				case pb.SettlementWithWindowResponse_ACCEPTED.String():
+					window.Status = StatusAccepted
+				case pb.SettlementWithWindowResponse_REJECTED.String():
+					window.Status = StatusRejected
+				}
+			} else {
+				fileInfo, err := ordersfile.GetUnsentInfo(info)
+				if err != nil {
+					errList = errs.Combine(errList, OrderError.Wrap(err))
+					return nil
+				}
+				window.SatelliteID = fileInfo.SatelliteID
+				window.CreatedAtHour = fileInfo.CreatedAtHour
+				window.Version = fileInfo.Version
+			}
+
+			contents, err := ordersfile.ReadAll(path, window.Version)
+			if err != nil {
+				errList = errs.Combine(errList, OrderError.Wrap(err))
+				return nil
+			}
+			window.Count = len(contents.Infos)
+			window.Corrupt = contents.Corrupt
+			window.Truncated = contents.Truncated
+			for _, info := range contents.Infos {
+				window.Amount += info.Order.Amount
+			}
+
+			windows = append(windows, window)
+			return nil
+		})
+	}
+
+	err = errs.Combine(walk(store.unsentDir, false), walk(store.archiveDir, true))
+	return windows, errs.Combine(errList, err)
+}
+
+// Repair rewrites the orders file of a window so that it only contains the entries
+// which can be read, dropping corrupt entries and a truncated last entry.
+// It returns the number of orders kept in the file.
+//
+// Repair should only be used while the storage node isn't running, since a running
+// node may still be appending to an unsent window.
+func (store *FileStore) Repair(ctx context.Context, window WindowInfo) (recovered int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+	store.archiveMu.Lock()
+	defer store.archiveMu.Unlock()
+
+	contents, err := ordersfile.ReadAll(window.Path, window.Version)
+	if err != nil {
+		return 0, OrderError.Wrap(err)
+	}
+	if contents.Corrupt == 0 {
+		return len(contents.Infos), nil
+	}
+
+	err = ordersfile.Rewrite(window.Path, window.Version, window.SatelliteID, window.CreatedAtHour, contents.Infos)
+	if err != nil {
+		return 0, OrderError.Wrap(err)
+	}
+
+	store.log.Info("Repaired orders file",
+		zap.String("Path", window.Path),
+		zap.Int("Recovered", len(contents.Infos)),
+		zap.Int("Dropped", contents.Corrupt))
+
+	return len(contents.Infos), nil
+}
+
 // ensureDirectories checks for the existence of the unsent and archived directories, and creates them if they do not exist.
 func (store *FileStore) ensureDirectories() error {
 	if _, err := os.Stat(store.unsentDir); os.IsNotExist(err) {
@@ -440,6 +440,153 @@ func TestOrdersStore_CorruptUnsentV1(t *testing.T) {
 	require.EqualValues(t, sn3, unsent[satellite].InfoList[1].Order.SerialNumber)
 }
 
+func TestOrdersStore_ListWindows(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	now := time.Now()
+	satellite := testrand.NodeID()
+	tomorrow := now.Add(24 * time.Hour)
+
+	// make order limit grace period 1 hour
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, time.Hour)
+	require.NoError(t, err)
+
+	windows, err := ordersStore.ListWindows(ctx)
+	require.NoError(t, err)
+	require.Len(t, windows, 0)
+
+	for i, createdAt := range []time.Time{now.Add(-2 * time.Hour), now} {
+		for k := 0; k < i+1; k++ {
+			sn := testrand.SerialNumber()
+			require.NoError(t, ordersStore.Enqueue(&ordersfile.Info{
+				Limit: &pb.OrderLimit{
+					SerialNumber:  sn,
+					SatelliteId:   satellite,
+					Action:        pb.PieceAction_GET,
+					OrderCreation: createdAt,
+				},
+				Order: &pb.Order{
+					SerialNumber: sn,
+					Amount:       100,
+				},
+			}))
+		}
+	}
+
+	// archive the oldest window
+	unsent, err := ordersStore.ListUnsentBySatellite(ctx, tomorrow)
+	require.NoError(t, err)
+	require.Len(t, unsent, 1)
+	require.NoError(t, ordersStore.Archive(satellite, unsent[satellite], now, pb.SettlementWithWindowResponse_ACCEPTED))
+
+	windows, err = ordersStore.ListWindows(ctx)
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+
+	var archived, pending orders.WindowInfo
+	for _, window := range windows {
+		require.Equal(t, satellite, window.SatelliteID)
+		require.Equal(t, ordersfile.V1, window.Version)
+		require.Zero(t, window.Corrupt)
+		if window.Archived {
+			archived = window
+		} else {
+			pending = window
+		}
+	}
+
+	require.True(t, archived.Archived)
+	require.Equal(t, orders.StatusAccepted, archived.Status)
+	require.Equal(t, 1, archived.Count)
+	require.EqualValues(t, 100, archived.Amount)
+
+	require.False(t, pending.Archived)
+	require.Equal(t, orders.StatusUnsent, pending.Status)
+	require.Equal(t, 2, pending.Count)
+	require.EqualValues(t, 200, pending.Amount)
+}
+
+func TestOrdersStore_RepairTruncatedV1(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	now := time.Now()
+	satellite := testrand.NodeID()
+	tomorrow := now.Add(24 * time.Hour)
+
+	// make order limit grace period 1 hour
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, time.Hour)
+	require.NoError(t, err)
+
+	serialNumbers := []storj.SerialNumber{testrand.SerialNumber(), testrand.SerialNumber(), testrand.SerialNumber()}
+	for _, sn := range serialNumbers {
+		require.NoError(t, ordersStore.Enqueue(&ordersfile.Info{
+			Limit: &pb.OrderLimit{
+				SerialNumber:  sn,
+				SatelliteId:   satellite,
+				Action:        pb.PieceAction_GET,
+				OrderCreation: now,
+			},
+			Order: &pb.Order{
+				SerialNumber: sn,
+				Amount:       1,
+			},
+		}))
+	}
+
+	// corrupt unsent orders file by removing the last byte
+	err = filepath.Walk(filepath.Join(dirName, "unsent"), func(path string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		if info.IsDir() {
+			return nil
+		}
+		return os.Truncate(path, info.Size()-1)
+	})
+	require.NoError(t, err)
+
+	windows, err := ordersStore.ListWindows(ctx)
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	require.Equal(t, 2, windows[0].Count)
+	require.Equal(t, 1, windows[0].Corrupt)
+	require.True(t, windows[0].Truncated)
+
+	recovered, err := ordersStore.Repair(ctx, windows[0])
+	require.NoError(t, err)
+	require.Equal(t, 2, recovered)
+
+	windows, err = ordersStore.ListWindows(ctx)
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	require.Equal(t, 2, windows[0].Count)
+	require.Zero(t, windows[0].Corrupt)
+	require.False(t, windows[0].Truncated)
+
+	// the repaired file can be appended to and sent as usual
+	sn := testrand.SerialNumber()
+	require.NoError(t, ordersStore.Enqueue(&ordersfile.Info{
+		Limit: &pb.OrderLimit{
+			SerialNumber:  sn,
+			SatelliteId:   satellite,
+			Action:        pb.PieceAction_GET,
+			OrderCreation: now,
+		},
+		Order: &pb.Order{
+			SerialNumber: sn,
+			Amount:       1,
+		},
+	}))
+
+	unsent, err := ordersStore.ListUnsentBySatellite(ctx, tomorrow)
+	require.NoError(t, err)
+	require.Len(t, unsent, 1)
+	require.Len(t, unsent[satellite].InfoList, 3)
+	require.EqualValues(t, serialNumbers[0], unsent[satellite].InfoList[0].Order.SerialNumber)
+	require.EqualValues(t, serialNumbers[1], unsent[satellite].InfoList[1].Order.SerialNumber)
+	require.EqualValues(t, sn, unsent[satellite].InfoList[2].Order.SerialNumber)
+}
+
 func TestOrdersStore_V0ToV1(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
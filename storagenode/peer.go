@@ -42,6 +42,7 @@ import (
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/lostpieces"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/multinode"
 	"storj.io/storj/storagenode/nodestats"
@@ -92,6 +93,8 @@ type DB interface {
 	Payout() payouts.DB
 	Pricing() pricing.DB
 	APIKeys() apikeys.DB
+	Scrub() pieces.ScrubDB
+	LostPieces() lostpieces.DB
 
 	Preflight(ctx context.Context) error
 }
@@ -116,6 +119,8 @@ type Config struct {
 
 	Pieces pieces.Config
 
+	LostPieces lostpieces.Config
+
 	Retain retain.Config
 
 	Nodestats nodestats.Config
@@ -135,12 +140,17 @@ func (config *Config) DatabaseConfig() storagenodedb.Config {
 	if dbdir == "" {
 		dbdir = config.Storage.Path
 	}
+	// invalid extra paths are reported by Verify
+	extraRoots, _ := config.Storage.ExtraRoots()
 	return storagenodedb.Config{
 		Storage:   config.Storage.Path,
 		Info:      filepath.Join(dbdir, "piecestore.db"),
 		Info2:     filepath.Join(dbdir, "info.db"),
 		Pieces:    config.Storage.Path,
 		Filestore: config.Filestore,
+
+		PiecesAllocated: config.Storage.AllocatedDiskSpace.Int64(),
+		ExtraPieces:     extraRoots,
 	}
 }
 
@@ -165,6 +175,10 @@ func (config *Config) Verify(log *zap.Logger) error {
 		}
 	}
 
+	if _, err := config.Storage.ExtraRoots(); err != nil {
+		return errs.New("invalid storage.extra-paths: %v", err)
+	}
+
 	return nil
 }
 
@@ -232,21 +246,27 @@ type Peer struct {
 
 	Storage2 struct {
 		// TODO: lift things outside of it to organize better
-		Trust         *trust.Pool
-		Store         *pieces.Store
-		TrashChore    *pieces.TrashChore
-		BlobsCache    *pieces.BlobsUsageCache
-		CacheService  *pieces.CacheService
-		RetainService *retain.Service
-		PieceDeleter  *pieces.Deleter
-		Endpoint      *piecestore.Endpoint
-		Inspector     *inspector.Endpoint
-		Monitor       *monitor.Service
-		Orders        *orders.Service
+		Trust           *trust.Pool
+		Store           *pieces.Store
+		TrashChore      *pieces.TrashChore
+		BlobsCache      *pieces.BlobsUsageCache
+		CacheService    *pieces.CacheService
+		RetainService   *retain.Service
+		Scrubber        *pieces.Scrubber
+		PieceDeleter    *pieces.Deleter
+		Endpoint        *piecestore.Endpoint
+		Inspector       *inspector.Endpoint
+		Monitor         *monitor.Service
+		Orders          *orders.Service
+		OrdersInspector *orders.Endpoint
 	}
 
 	Collector *collector.Service
 
+	LostPieces struct {
+		Reporter *lostpieces.Reporter
+	}
+
 	NodeStats struct {
 		Service *nodestats.Service
 		Cache   *nodestats.Cache
@@ -284,10 +304,11 @@ type Peer struct {
 	Reputation *reputation.Service
 
 	Multinode struct {
-		Storage   *multinode.StorageEndpoint
-		Bandwidth *multinode.BandwidthEndpoint
-		Node      *multinode.NodeEndpoint
-		Payout    *multinode.PayoutEndpoint
+		Storage      *multinode.StorageEndpoint
+		Bandwidth    *multinode.BandwidthEndpoint
+		Node         *multinode.NodeEndpoint
+		Payout       *multinode.PayoutEndpoint
+		GracefulExit *multinode.GracefulExitEndpoint
 	}
 }
 
@@ -426,6 +447,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		}
 	}
 
+	allocatedDiskSpace, err := config.Storage.TotalAllocatedDiskSpace()
+	if err != nil {
+		return nil, errs.Combine(err, peer.Close())
+	}
+
 	{ // setup storage
 		peer.Storage2.BlobsCache = pieces.NewBlobsUsageCache(peer.Log.Named("blobscache"), peer.DB.Pieces())
 
@@ -476,7 +502,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.Store,
 			peer.Contact.Service,
 			peer.DB.Bandwidth(),
-			config.Storage.AllocatedDiskSpace.Int64(),
+			allocatedDiskSpace.Int64(),
 			// TODO: use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
 			peer.Contact.Chore.Trigger,
@@ -501,6 +527,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Storage2.RetainService.Close,
 		})
 
+		if config.Pieces.Scrubber.Enabled {
+			peer.Storage2.Scrubber = pieces.NewScrubber(
+				peer.Log.Named("pieces:scrubber"),
+				peer.Storage2.Store,
+				peer.DB.Scrub(),
+				config.Pieces.Scrubber,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "pieces:scrubber",
+				Run:   peer.Storage2.Scrubber.Run,
+				Close: peer.Storage2.Scrubber.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Pieces Scrubber", peer.Storage2.Scrubber.Loop))
+		}
+
 		peer.UsedSerials = usedserials.NewTable(config.Storage2.MaxUsedSerialsSize)
 
 		peer.OrdersStore, err = orders.NewFileStore(
@@ -524,6 +566,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.OrdersStore,
 			peer.DB.Bandwidth(),
 			peer.UsedSerials,
+			peer.DB.LostPieces(),
 			config.Storage2,
 		)
 		if err != nil {
@@ -562,6 +605,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Orders Sender", peer.Storage2.Orders.Sender))
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Orders Cleanup", peer.Storage2.Orders.Cleanup))
+
+		peer.Storage2.OrdersInspector = orders.NewEndpoint(
+			log.Named("orders:endpoint"),
+			peer.Storage2.Orders,
+		)
+		if err := internalpb.DRPCRegisterNodeOrders(peer.Server.PrivateDRPC(), peer.Storage2.OrdersInspector); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup payouts service.
@@ -642,7 +693,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Bandwidth(),
 			peer.Storage2.Store,
 			peer.Version.Service,
-			config.Storage.AllocatedDiskSpace,
+			allocatedDiskSpace,
 			config.Operator.Wallet,
 			versionInfo,
 			peer.Storage2.Trust,
@@ -655,6 +706,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Estimation.Service,
 			peer.Storage2.BlobsCache,
 			config.Operator.WalletFeatures,
+			peer.DB.Scrub(),
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -771,6 +823,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	peer.Debug.Server.Panel.Add(
 		debug.Cycle("Collector", peer.Collector.Loop))
 
+	peer.LostPieces.Reporter = lostpieces.NewReporter(
+		peer.Log.Named("lostpieces:reporter"),
+		peer.Dialer,
+		peer.Storage2.Trust,
+		peer.DB.LostPieces(),
+		config.LostPieces,
+	)
+	peer.Services.Add(lifecycle.Item{
+		Name:  "lostpieces:reporter",
+		Run:   peer.LostPieces.Reporter.Run,
+		Close: peer.LostPieces.Reporter.Close,
+	})
+	peer.Debug.Server.Panel.Add(
+		debug.Cycle("Lost Pieces Reporter", peer.LostPieces.Reporter.Loop))
+
 	peer.Bandwidth = bandwidth.NewService(peer.Log.Named("bandwidth"), peer.DB.Bandwidth(), config.Bandwidth)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "bandwidth",
@@ -807,6 +874,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			apiKeys,
 			peer.DB.Payout())
 
+		peer.Multinode.GracefulExit = multinode.NewGracefulExitEndpoint(
+			peer.Log.Named("multinode:graceful-exit-endpoint"),
+			apiKeys,
+			peer.GracefulExit.Service,
+			peer.DB.Satellites())
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -819,6 +892,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayout(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterGracefulExit(peer.Server.DRPC(), peer.Multinode.GracefulExit); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
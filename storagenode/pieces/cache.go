@@ -300,6 +300,28 @@ func (blobs *BlobsUsageCache) Trash(ctx context.Context, blobRef storage.BlobRef
 	return nil
 }
 
+// Quarantine moves the blob to the quarantine and updates the cache. The
+// quarantined piece no longer counts towards the space used by pieces.
+func (blobs *BlobsUsageCache) Quarantine(ctx context.Context, blobRef storage.BlobRef) error {
+	pieceTotal, pieceContentSize, err := blobs.pieceSizes(ctx, blobRef)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = blobs.Blobs.Quarantine(ctx, blobRef)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	satelliteID, err := storj.NodeIDFromBytes(blobRef.Namespace)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	blobs.Update(ctx, satelliteID, -pieceTotal, -pieceContentSize, 0)
+	return nil
+}
+
 // EmptyTrash empties the trash and updates the cache.
 func (blobs *BlobsUsageCache) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
 	satelliteID, err := storj.NodeIDFromBytes(namespace)
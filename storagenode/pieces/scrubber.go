@@ -0,0 +1,294 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+// ScrubberConfig defines parameters for the piece scrubber.
+type ScrubberConfig struct {
+	Enabled      bool          `help:"whether to verify the hashes of stored pieces in the background" releaseDefault:"true" devDefault:"false"`
+	Interval     time.Duration `help:"how often to check whether a scrubbing pass should be started or resumed" default:"1h0m0s"`
+	PassInterval time.Duration `help:"minimum time between the end of a scrubbing pass over all pieces of a satellite and the start of the next one" default:"720h0m0s"`
+	Bandwidth    memory.Size   `help:"maximum amount of piece data read per second while scrubbing, 0 means unlimited" default:"5MB"`
+}
+
+// ScrubDB stores the progress of the piece scrubber and the pieces it found
+// to be corrupt.
+//
+// architecture: Database
+type ScrubDB interface {
+	// GetProgress returns the scrubbing progress for the satellite. A zero
+	// value is returned when the satellite hasn't been scrubbed yet.
+	GetProgress(ctx context.Context, satelliteID storj.NodeID) (ScrubProgress, error)
+	// SetProgress stores the scrubbing progress for the satellite.
+	SetProgress(ctx context.Context, progress ScrubProgress) error
+	// AddCorrupt records a piece which failed verification.
+	AddCorrupt(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, detectedAt time.Time) error
+	// Summary returns the scrubbing totals across all satellites.
+	Summary(ctx context.Context) (ScrubSummary, error)
+}
+
+// ScrubProgress is the scrubbing progress for a single satellite.
+type ScrubProgress struct {
+	SatelliteID storj.NodeID
+	// KeyPrefix is the last key prefix which was completely scrubbed in the
+	// current pass.
+	KeyPrefix string
+	// PassStartedAt is the start of the current pass. It's zero when no pass
+	// is in progress.
+	PassStartedAt       time.Time
+	LastPassCompletedAt time.Time
+	PiecesScrubbed      int64
+	PiecesCorrupt       int64
+}
+
+// ScrubSummary contains the scrubbing totals across all satellites.
+type ScrubSummary struct {
+	PiecesScrubbed int64
+	PiecesCorrupt  int64
+	// LastPassCompletedAt is the time the last pass of any satellite completed.
+	LastPassCompletedAt time.Time
+}
+
+// Scrubber is the chore that walks all stored pieces, re-hashes them, and
+// compares the result with the hash stored in the piece header. Pieces whose
+// hash doesn't match are quarantined.
+//
+// Passes over the pieces of a satellite are resumed after restarts, as the
+// progress is stored per key prefix.
+//
+// architecture: Chore
+type Scrubber struct {
+	log     *zap.Logger
+	store   *Store
+	db      ScrubDB
+	config  ScrubberConfig
+	limiter *rate.Limiter
+
+	Loop *sync2.Cycle
+}
+
+// NewScrubber creates a new piece scrubber.
+func NewScrubber(log *zap.Logger, store *Store, db ScrubDB, config ScrubberConfig) *Scrubber {
+	var limiter *rate.Limiter
+	if config.Bandwidth > 0 {
+		limiter = rate.NewLimiter(rate.Limit(config.Bandwidth.Int()), config.Bandwidth.Int())
+	}
+	return &Scrubber{
+		log:     log,
+		store:   store,
+		db:      db,
+		config:  config,
+		limiter: limiter,
+		Loop:    sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the scrubber.
+func (scrubber *Scrubber) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return scrubber.Loop.Run(ctx, func(ctx context.Context) error {
+		err := scrubber.Scrub(ctx)
+		if err != nil {
+			scrubber.log.Error("scrubbing failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the scrubber.
+func (scrubber *Scrubber) Close() error {
+	scrubber.Loop.Close()
+	return nil
+}
+
+// Scrub starts or resumes a pass over the pieces of every satellite, unless
+// the last pass completed less than PassInterval ago.
+func (scrubber *Scrubber) Scrub(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := scrubber.store.getAllStoringSatellites(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, satelliteID := range satellites {
+		err := scrubber.scrubSatellite(ctx, satelliteID)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			group.Add(err)
+		}
+	}
+	return group.Err()
+}
+
+func (scrubber *Scrubber) scrubSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	progress, err := scrubber.db.GetProgress(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if progress.PassStartedAt.IsZero() {
+		if !progress.LastPassCompletedAt.IsZero() && time.Since(progress.LastPassCompletedAt) < scrubber.config.PassInterval {
+			return nil
+		}
+		progress.SatelliteID = satelliteID
+		progress.KeyPrefix = ""
+		progress.PassStartedAt = time.Now()
+		if err := scrubber.db.SetProgress(ctx, progress); err != nil {
+			return Error.Wrap(err)
+		}
+		scrubber.log.Info("starting scrubbing pass", zap.Stringer("Satellite ID", satelliteID))
+	} else {
+		scrubber.log.Info("resuming scrubbing pass", zap.Stringer("Satellite ID", satelliteID),
+			zap.String("Key Prefix", progress.KeyPrefix))
+	}
+
+	var currentKeyPrefix string
+	err = scrubber.store.blobs.WalkNamespace(ctx, satelliteID.Bytes(), func(info storage.BlobInfo) error {
+		keyPrefix := filestore.KeyPrefix(info.BlobRef().Key)
+		if progress.KeyPrefix != "" && keyPrefix <= progress.KeyPrefix {
+			return nil
+		}
+		if currentKeyPrefix != "" && keyPrefix != currentKeyPrefix {
+			progress.KeyPrefix = currentKeyPrefix
+			if err := scrubber.db.SetProgress(ctx, progress); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		currentKeyPrefix = keyPrefix
+
+		// pieces stored with storage format V0 don't have a piece header.
+		if info.StorageFormatVersion() < filestore.FormatV1 {
+			return nil
+		}
+
+		pieceID, err := storj.PieceIDFromBytes(info.BlobRef().Key)
+		if err != nil {
+			return nil
+		}
+
+		corrupt, err := scrubber.verify(ctx, satelliteID, pieceID, info.StorageFormatVersion())
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if errs.IsFunc(err, os.IsNotExist) {
+				// the piece has been deleted in the meantime.
+				return nil
+			}
+			scrubber.log.Warn("unable to scrub piece", zap.Stringer("Satellite ID", satelliteID),
+				zap.Stringer("Piece ID", pieceID), zap.Error(err))
+			return nil
+		}
+
+		progress.PiecesScrubbed++
+		mon.Meter("scrubbed_pieces").Mark(1)
+		if !corrupt {
+			return nil
+		}
+
+		progress.PiecesCorrupt++
+		mon.Meter("corrupt_pieces").Mark(1)
+		scrubber.log.Error("piece failed verification", zap.Stringer("Satellite ID", satelliteID),
+			zap.Stringer("Piece ID", pieceID))
+
+		if err := scrubber.store.Quarantine(ctx, satelliteID, pieceID); err != nil {
+			scrubber.log.Error("unable to quarantine piece", zap.Stringer("Satellite ID", satelliteID),
+				zap.Stringer("Piece ID", pieceID), zap.Error(err))
+		}
+		return Error.Wrap(scrubber.db.AddCorrupt(ctx, satelliteID, pieceID, time.Now()))
+	})
+	if err != nil {
+		// the progress of the completed key prefixes has been stored already.
+		return err
+	}
+
+	progress.KeyPrefix = ""
+	progress.PassStartedAt = time.Time{}
+	progress.LastPassCompletedAt = time.Now()
+	scrubber.log.Info("completed scrubbing pass", zap.Stringer("Satellite ID", satelliteID),
+		zap.Int64("Pieces Scrubbed", progress.PiecesScrubbed), zap.Int64("Pieces Corrupt", progress.PiecesCorrupt))
+	return Error.Wrap(scrubber.db.SetProgress(ctx, progress))
+}
+
+// verify re-hashes the piece and compares the hash with the one stored in the
+// piece header. Pieces with an unreadable header or content are corrupt, while
+// pieces which can't be opened are left alone.
+func (scrubber *Scrubber) verify(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, formatVersion storage.FormatVersion) (corrupt bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := scrubber.store.ReaderWithStorageFormat(ctx, satelliteID, pieceID, formatVersion)
+	if err != nil {
+		return false, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	header, err := reader.GetPieceHeader()
+	if err != nil {
+		return true, nil
+	}
+
+	hash := pkcrypto.NewHash()
+	_, err = io.Copy(hash, scrubber.limitReader(ctx, reader))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		return true, nil
+	}
+
+	return !bytes.Equal(hash.Sum(nil), header.Hash), nil
+}
+
+// limitReader limits the bandwidth used for reading pieces.
+func (scrubber *Scrubber) limitReader(ctx context.Context, reader io.Reader) io.Reader {
+	if scrubber.limiter == nil {
+		return reader
+	}
+	return &rateLimitedReader{ctx: ctx, reader: reader, limiter: scrubber.limiter}
+}
+
+type rateLimitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+// Read reads at most as many bytes as the limiter allows at once and waits
+// until reading them is allowed.
+func (reader *rateLimitedReader) Read(p []byte) (int, error) {
+	if burst := reader.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	n, err := reader.reader.Read(p)
+	if n > 0 {
+		if waitErr := reader.limiter.WaitN(reader.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
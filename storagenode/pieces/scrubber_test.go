@@ -0,0 +1,88 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieces_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestScrubber(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		dir, err := filestore.NewDir(log, ctx.Dir("store"))
+		require.NoError(t, err)
+
+		blobs := filestore.New(log, dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(log, blobs, nil, db.PieceExpirationDB(), nil, pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		writePiece := func(corrupt bool) storj.PieceID {
+			pieceID := testrand.PieceID()
+			writer, err := store.Writer(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+
+			hash := writer.Hash()
+			if corrupt {
+				hash = testrand.Bytes(memory.Size(len(hash)))
+			}
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{Hash: hash}))
+			return pieceID
+		}
+
+		var good []storj.PieceID
+		for i := 0; i < 5; i++ {
+			good = append(good, writePiece(false))
+		}
+		corrupt := writePiece(true)
+
+		scrubber := pieces.NewScrubber(log, store, db.Scrub(), pieces.ScrubberConfig{
+			Interval:     time.Hour,
+			PassInterval: 24 * time.Hour,
+		})
+		defer ctx.Check(scrubber.Close)
+
+		require.NoError(t, scrubber.Scrub(ctx))
+
+		for _, pieceID := range good {
+			reader, err := store.Reader(ctx, satelliteID, pieceID)
+			require.NoError(t, err)
+			require.NoError(t, reader.Close())
+		}
+		_, err = store.Reader(ctx, satelliteID, corrupt)
+		require.True(t, errs.IsFunc(err, os.IsNotExist))
+
+		summary, err := db.Scrub().Summary(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 6, summary.PiecesScrubbed)
+		require.EqualValues(t, 1, summary.PiecesCorrupt)
+		require.False(t, summary.LastPassCompletedAt.IsZero())
+
+		// the next pass doesn't start before the pass interval elapsed
+		require.NoError(t, scrubber.Scrub(ctx))
+		summary, err = db.Scrub().Summary(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 6, summary.PiecesScrubbed)
+	})
+}
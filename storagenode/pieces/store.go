@@ -156,6 +156,8 @@ type SatelliteUsage struct {
 type Config struct {
 	WritePreallocSize memory.Size `help:"file preallocated for uploading" default:"4MiB"`
 	DeleteToTrash     bool        `help:"move pieces to trash upon deletion. Warning: if set to false, you risk disqualification for failed audits if a satellite database is restored from backup." default:"true"`
+
+	Scrubber ScrubberConfig
 }
 
 // DefaultConfig is the default value for the Config.
@@ -319,6 +321,28 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	return Error.Wrap(err)
 }
 
+// Quarantine moves the specified piece, which failed verification, to the
+// quarantine, where it's no longer served.
+func (store *Store) Quarantine(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.blobs.Quarantine(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if store.expirationInfo != nil {
+		_, err = store.expirationInfo.DeleteExpiration(ctx, satellite, pieceID)
+	}
+
+	store.log.Warn("quarantined piece", zap.String("Satellite ID", satellite.String()),
+		zap.String("Piece ID", pieceID.String()))
+
+	return Error.Wrap(err)
+}
+
 // DeleteSatelliteBlobs deletes blobs folder of specific satellite after successful GE.
 func (store *Store) DeleteSatelliteBlobs(ctx context.Context, satellite storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -712,6 +736,40 @@ func (store *Store) CheckWritability() error {
 	return store.blobs.CheckWritability()
 }
 
+// multiStore returns the underlying blob store when pieces span multiple
+// storage directories.
+func (store *Store) multiStore() (*filestore.MultiStore, bool) {
+	blobs := store.blobs
+	if cache, ok := blobs.(*BlobsUsageCache); ok {
+		blobs = cache.Blobs
+	}
+	multi, ok := blobs.(*filestore.MultiStore)
+	return multi, ok
+}
+
+// StorageRoots returns the status of every storage directory when pieces span
+// multiple directories. It returns nil when pieces are kept in a single directory.
+func (store *Store) StorageRoots(ctx context.Context) (_ []filestore.RootStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	multi, ok := store.multiStore()
+	if !ok {
+		return nil, nil
+	}
+	roots, err := multi.Roots(ctx)
+	return roots, Error.Wrap(err)
+}
+
+// RefreshStorageRoots recalculates the space used in every storage directory
+// when pieces span multiple directories.
+func (store *Store) RefreshStorageRoots(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	multi, ok := store.multiStore()
+	if !ok {
+		return nil
+	}
+	return Error.Wrap(multi.RefreshUsage(ctx))
+}
+
 type storedPieceAccess struct {
 	storage.BlobInfo
 	store   *Store
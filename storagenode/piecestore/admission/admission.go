@@ -0,0 +1,263 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package admission implements admission control for piecestore transfers.
+package admission
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+)
+
+var (
+	// Error defines the admission control error class.
+	Error = errs.Class("admission")
+	// ErrRejected is used when a transfer is rejected because the node is overloaded.
+	ErrRejected = errs.Class("transfer rejected")
+
+	mon = monkit.Package()
+)
+
+const (
+	// minSamples is how many samples need to be seen within the window before
+	// throughput and latency estimates are trusted.
+	minSamples = 10
+	// minSampleSize is the smallest upload which is used to estimate throughput,
+	// since the duration of smaller uploads is dominated by latency.
+	minSampleSize = 64 * memory.KiB
+	// smoothing is the weight of a new sample in the moving averages.
+	smoothing = 0.1
+)
+
+// Config defines parameters for the admission control of uploads and downloads.
+type Config struct {
+	MinUploadThroughput memory.Size   `help:"estimated throughput per upload below which new uploads are rejected, 0 disables the check" default:"0B"`
+	MaxCommitLatency    time.Duration `help:"average latency of committing an uploaded piece to disk, including the fsync, above which new uploads are rejected, 0 disables the check" default:"0s"`
+	Window              time.Duration `help:"how long upload throughput, commit latency and bandwidth are measured for admission" default:"1m0s"`
+	IngressLimit        memory.Size   `help:"average ingress bandwidth per second above which new uploads are rejected, 0 means unlimited" default:"0B"`
+	EgressLimit         memory.Size   `help:"average egress bandwidth per second above which new customer downloads are rejected, 0 means unlimited" default:"0B"`
+	Schedule            string        `help:"time of day bandwidth limits overriding the ingress and egress limits, e.g. '08:00-18:00 ingress=2MB egress=4MB; 18:00-08:00 ingress=0'" default:""`
+}
+
+// Controller decides whether new transfers are admitted, based on recently
+// observed throughput, commit latency and the configured bandwidth limits.
+// Every check is disabled by default.
+//
+// architecture: Service
+type Controller struct {
+	config   Config
+	schedule Schedule
+
+	mu            sync.Mutex
+	activeUploads int
+	ingress       rateMeter
+	egress        rateMeter
+	// capacity is the estimated total upload throughput of the node.
+	capacity      movingAverage
+	commitLatency movingAverage
+}
+
+// NewController creates a new admission controller.
+func NewController(config Config) (*Controller, error) {
+	schedule, err := ParseSchedule(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	window := int(config.Window / time.Second)
+	if window < 1 {
+		window = 1
+	}
+
+	return &Controller{
+		config:   config,
+		schedule: schedule,
+		ingress:  newRateMeter(window),
+		egress:   newRateMeter(window),
+	}, nil
+}
+
+// AdmitUpload checks whether a new upload can be accepted. When the upload is accepted,
+// the returned function must be called with the end time and the size of the upload once
+// it has finished.
+func (controller *Controller) AdmitUpload(now time.Time) (finish func(end time.Time, size int64), err error) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+
+	if limit, _ := controller.limits(now); limit > 0 {
+		if rate := controller.ingress.rate(now); rate >= float64(limit) {
+			mon.Meter("upload_admission_rejected_ingress").Mark(1)
+			return nil, ErrRejected.New("ingress bandwidth limit of %s/s reached", memory.Size(limit).Base10String())
+		}
+	}
+
+	if min := controller.config.MinUploadThroughput; min > 0 && controller.capacity.valid(now, controller.config.Window) {
+		estimate := controller.capacity.value / float64(controller.activeUploads+1)
+		if estimate < float64(min) {
+			mon.Meter("upload_admission_rejected_throughput").Mark(1)
+			return nil, ErrRejected.New("estimated upload throughput of %s/s is below %s/s",
+				memory.Size(estimate).Base10String(), min.Base10String())
+		}
+	}
+
+	if max := controller.config.MaxCommitLatency; max > 0 && controller.commitLatency.valid(now, controller.config.Window) {
+		latency := time.Duration(controller.commitLatency.value)
+		if latency > max {
+			mon.Meter("upload_admission_rejected_latency").Mark(1)
+			return nil, ErrRejected.New("commit latency of %v is above %v", latency, max)
+		}
+	}
+
+	controller.activeUploads++
+	return func(end time.Time, size int64) {
+		controller.mu.Lock()
+		defer controller.mu.Unlock()
+
+		concurrent := controller.activeUploads
+		controller.activeUploads--
+
+		duration := end.Sub(now)
+		if size < minSampleSize.Int64() || duration <= 0 {
+			return
+		}
+		// the upload shared the bandwidth with the other concurrent uploads.
+		rate := float64(size) / duration.Seconds()
+		controller.capacity.observe(end, rate*float64(concurrent), controller.config.Window)
+		mon.FloatVal("upload_admission_capacity").Observe(controller.capacity.value)
+	}, nil
+}
+
+// AdmitDownload checks whether a new customer download can be accepted.
+func (controller *Controller) AdmitDownload(now time.Time) error {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+
+	if _, limit := controller.limits(now); limit > 0 {
+		if rate := controller.egress.rate(now); rate >= float64(limit) {
+			mon.Meter("download_admission_rejected_egress").Mark(1)
+			return ErrRejected.New("egress bandwidth limit of %s/s reached", memory.Size(limit).Base10String())
+		}
+	}
+	return nil
+}
+
+// Ingress records received upload data.
+func (controller *Controller) Ingress(now time.Time, size int64) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+
+	controller.ingress.add(now, size)
+}
+
+// Egress records sent download data.
+func (controller *Controller) Egress(now time.Time, size int64) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+
+	controller.egress.add(now, size)
+}
+
+// ObserveCommit records how long committing an uploaded piece took. Commits
+// are measured rather than writes, since writes are buffered and only the
+// commit waits for the data to reach the disk.
+func (controller *Controller) ObserveCommit(now time.Time, latency time.Duration) {
+	controller.mu.Lock()
+	defer controller.mu.Unlock()
+
+	controller.commitLatency.observe(now, float64(latency), controller.config.Window)
+}
+
+// limits returns the ingress and egress limits in bytes per second which apply at a given time.
+func (controller *Controller) limits(now time.Time) (ingress, egress int64) {
+	ingress, egress = controller.config.IngressLimit.Int64(), controller.config.EgressLimit.Int64()
+	if entry, ok := controller.schedule.Find(now); ok {
+		if entry.Ingress != nil {
+			ingress = entry.Ingress.Int64()
+		}
+		if entry.Egress != nil {
+			egress = entry.Egress.Int64()
+		}
+	}
+	return ingress, egress
+}
+
+// rateMeter counts bytes in one second buckets over a sliding window.
+type rateMeter struct {
+	buckets []int64
+	// newest is the unix second of the newest bucket.
+	newest int64
+}
+
+func newRateMeter(window int) rateMeter {
+	return rateMeter{buckets: make([]int64, window)}
+}
+
+// advance moves the window forward to the given second, clearing the expired buckets.
+func (meter *rateMeter) advance(second int64) {
+	if second <= meter.newest {
+		return
+	}
+
+	steps := second - meter.newest
+	if steps >= int64(len(meter.buckets)) {
+		for i := range meter.buckets {
+			meter.buckets[i] = 0
+		}
+	} else {
+		for i := int64(1); i <= steps; i++ {
+			meter.buckets[(meter.newest+i)%int64(len(meter.buckets))] = 0
+		}
+	}
+	meter.newest = second
+}
+
+func (meter *rateMeter) add(now time.Time, size int64) {
+	second := now.Unix()
+	meter.advance(second)
+	if second <= meter.newest-int64(len(meter.buckets)) {
+		return
+	}
+	meter.buckets[second%int64(len(meter.buckets))] += size
+}
+
+// rate returns the average bytes per second over the window.
+func (meter *rateMeter) rate(now time.Time) float64 {
+	meter.advance(now.Unix())
+
+	var total int64
+	for _, size := range meter.buckets {
+		total += size
+	}
+	return float64(total) / float64(len(meter.buckets))
+}
+
+// movingAverage is an exponentially weighted moving average, which is
+// only trusted while it has recent samples.
+type movingAverage struct {
+	value   float64
+	samples int
+	last    time.Time
+}
+
+func (average *movingAverage) observe(now time.Time, value float64, window time.Duration) {
+	if now.Sub(average.last) > window {
+		average.samples = 0
+	}
+
+	if average.samples == 0 {
+		average.value = value
+	} else {
+		average.value += smoothing * (value - average.value)
+	}
+	average.samples++
+	average.last = now
+}
+
+func (average *movingAverage) valid(now time.Time, window time.Duration) bool {
+	return average.samples >= minSamples && now.Sub(average.last) <= window
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admission_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storagenode/piecestore/admission"
+)
+
+func TestParseSchedule(t *testing.T) {
+	schedule, err := admission.ParseSchedule("")
+	require.NoError(t, err)
+	require.Len(t, schedule, 0)
+
+	schedule, err = admission.ParseSchedule("08:00-18:00 ingress=2MB egress=4MB; 22:30-06:00 ingress=0")
+	require.NoError(t, err)
+	require.Len(t, schedule, 2)
+
+	require.Equal(t, 8*time.Hour, schedule[0].From)
+	require.Equal(t, 18*time.Hour, schedule[0].To)
+	require.Equal(t, 2*memory.MB, *schedule[0].Ingress)
+	require.Equal(t, 4*memory.MB, *schedule[0].Egress)
+
+	require.Equal(t, 22*time.Hour+30*time.Minute, schedule[1].From)
+	require.Equal(t, 6*time.Hour, schedule[1].To)
+	require.Equal(t, memory.Size(0), *schedule[1].Ingress)
+	require.Nil(t, schedule[1].Egress)
+
+	day := time.Date(2021, 4, 20, 0, 0, 0, 0, time.Local)
+	for _, tt := range []struct {
+		at    time.Duration
+		found bool
+		index int
+	}{
+		{at: 7 * time.Hour, found: false},
+		{at: 8 * time.Hour, found: true, index: 0},
+		{at: 17*time.Hour + 59*time.Minute, found: true, index: 0},
+		{at: 18 * time.Hour, found: false},
+		{at: 23 * time.Hour, found: true, index: 1},
+		{at: 3 * time.Hour, found: true, index: 1},
+	} {
+		entry, ok := schedule.Find(day.Add(tt.at))
+		require.Equal(t, tt.found, ok, tt.at)
+		if tt.found {
+			require.Equal(t, schedule[tt.index], entry, tt.at)
+		}
+	}
+
+	for _, invalid := range []string{
+		"08:00",
+		"08:00-18:00",
+		"08:00-08:00 ingress=1MB",
+		"8-18 ingress=1MB",
+		"08:00-25:00 ingress=1MB",
+		"08:00-18:00 ingress",
+		"08:00-18:00 ingress=fast",
+		"08:00-18:00 upload=1MB",
+	} {
+		_, err := admission.ParseSchedule(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestController_BandwidthLimits(t *testing.T) {
+	controller, err := admission.NewController(admission.Config{
+		Window:       10 * time.Second,
+		IngressLimit: memory.MB,
+		Schedule:     "08:00-18:00 egress=1MB; 18:00-20:00 ingress=0",
+	})
+	require.NoError(t, err)
+
+	day := time.Date(2021, 4, 20, 0, 0, 0, 0, time.Local)
+	night := day.Add(2 * time.Hour)
+
+	// no egress limit outside of the schedule
+	controller.Egress(night, 100*memory.MB.Int64())
+	require.NoError(t, controller.AdmitDownload(night))
+
+	// ingress is limited to an average of 1MB/s over the window
+	finish, err := controller.AdmitUpload(night)
+	require.NoError(t, err)
+	controller.Ingress(night, 5*memory.MB.Int64())
+	finish(night, 0)
+
+	_, err = controller.AdmitUpload(night)
+	require.NoError(t, err)
+
+	controller.Ingress(night, 5*memory.MB.Int64())
+	_, err = controller.AdmitUpload(night)
+	require.True(t, admission.ErrRejected.Has(err))
+
+	// the ingress is forgotten once it is outside of the window
+	_, err = controller.AdmitUpload(night.Add(11 * time.Second))
+	require.NoError(t, err)
+
+	// the schedule removes the ingress limit in the evening
+	evening := day.Add(19 * time.Hour)
+	controller.Ingress(evening, 100*memory.MB.Int64())
+	_, err = controller.AdmitUpload(evening)
+	require.NoError(t, err)
+
+	// the schedule limits egress during the day
+	noon := day.Add(12 * time.Hour)
+	require.NoError(t, controller.AdmitDownload(noon))
+	controller.Egress(noon, 10*memory.MB.Int64())
+	require.True(t, admission.ErrRejected.Has(controller.AdmitDownload(noon)))
+}
+
+func TestController_UploadThroughput(t *testing.T) {
+	controller, err := admission.NewController(admission.Config{
+		Window:              time.Minute,
+		MinUploadThroughput: memory.MB,
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2021, 4, 20, 12, 0, 0, 0, time.UTC)
+
+	// uploads are admitted while there are not enough samples
+	for i := 0; i < 10; i++ {
+		finish, err := controller.AdmitUpload(now)
+		require.NoError(t, err)
+		// 2MB in 4 seconds, which is 500KB/s
+		finish(now.Add(4*time.Second), 2*memory.MB.Int64())
+	}
+
+	_, err = controller.AdmitUpload(now.Add(4 * time.Second))
+	require.True(t, admission.ErrRejected.Has(err))
+
+	// the estimate is not trusted once the samples are too old
+	finish, err := controller.AdmitUpload(now.Add(2 * time.Minute))
+	require.NoError(t, err)
+	finish(now.Add(2*time.Minute), 0)
+
+	// fast uploads keep being admitted
+	start := now.Add(3 * time.Minute)
+	for i := 0; i < 20; i++ {
+		finish, err := controller.AdmitUpload(start)
+		require.NoError(t, err)
+		// 2MB in 1 second
+		finish(start.Add(time.Second), 2*memory.MB.Int64())
+	}
+
+	// two concurrent uploads would still get 1MB/s each, but not three
+	first, err := controller.AdmitUpload(start.Add(time.Second))
+	require.NoError(t, err)
+	second, err := controller.AdmitUpload(start.Add(time.Second))
+	require.NoError(t, err)
+	_, err = controller.AdmitUpload(start.Add(time.Second))
+	require.True(t, admission.ErrRejected.Has(err))
+
+	first(start.Add(time.Second), 0)
+	second(start.Add(time.Second), 0)
+}
+
+func TestController_CommitLatency(t *testing.T) {
+	controller, err := admission.NewController(admission.Config{
+		Window:           time.Minute,
+		MaxCommitLatency: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2021, 4, 20, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		controller.ObserveCommit(now, 10*time.Millisecond)
+	}
+	_, err = controller.AdmitUpload(now)
+	require.NoError(t, err)
+
+	for i := 0; i < 50; i++ {
+		controller.ObserveCommit(now, time.Second)
+	}
+	_, err = controller.AdmitUpload(now)
+	require.True(t, admission.ErrRejected.Has(err))
+
+	_, err = controller.AdmitUpload(now.Add(2 * time.Minute))
+	require.NoError(t, err)
+}
+
+func TestController_DisabledByDefault(t *testing.T) {
+	controller, err := admission.NewController(admission.Config{
+		Window: time.Minute,
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2021, 4, 20, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 50; i++ {
+		controller.ObserveCommit(now, 10*time.Second)
+		controller.Ingress(now, 1<<30)
+
+		finish, err := controller.AdmitUpload(now)
+		require.NoError(t, err)
+		finish(now.Add(time.Minute), int64(1<<20))
+	}
+
+	_, err = controller.AdmitUpload(now)
+	require.NoError(t, err)
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admission
+
+import (
+	"strings"
+	"time"
+
+	"storj.io/common/memory"
+)
+
+// ScheduleEntry overrides the bandwidth limits during a time of day range.
+// The range may wrap around midnight. A nil limit keeps the configured limit.
+type ScheduleEntry struct {
+	From time.Duration
+	To   time.Duration
+
+	Ingress *memory.Size
+	Egress  *memory.Size
+}
+
+// Contains returns whether the time of day of t is within the entry.
+func (entry ScheduleEntry) Contains(t time.Time) bool {
+	timeOfDay := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if entry.From <= entry.To {
+		return entry.From <= timeOfDay && timeOfDay < entry.To
+	}
+	return entry.From <= timeOfDay || timeOfDay < entry.To
+}
+
+// Schedule is a list of time of day bandwidth limits.
+type Schedule []ScheduleEntry
+
+// Find returns the first entry which contains the time of day of t.
+func (schedule Schedule) Find(t time.Time) (ScheduleEntry, bool) {
+	for _, entry := range schedule {
+		if entry.Contains(t) {
+			return entry, true
+		}
+	}
+	return ScheduleEntry{}, false
+}
+
+// ParseSchedule parses a schedule of the form
+// "08:00-18:00 ingress=2MB egress=4MB; 18:00-08:00 ingress=0".
+// Times are in the local time zone of the node.
+func ParseSchedule(s string) (Schedule, error) {
+	var schedule Schedule
+	for _, part := range strings.Split(s, ";") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+
+		bounds := strings.Split(fields[0], "-")
+		if len(bounds) != 2 {
+			return nil, Error.New("invalid schedule time range %q", fields[0])
+		}
+
+		var entry ScheduleEntry
+		var err error
+		if entry.From, err = parseTimeOfDay(bounds[0]); err != nil {
+			return nil, err
+		}
+		if entry.To, err = parseTimeOfDay(bounds[1]); err != nil {
+			return nil, err
+		}
+		if entry.From == entry.To {
+			return nil, Error.New("empty schedule time range %q", fields[0])
+		}
+
+		if len(fields) == 1 {
+			return nil, Error.New("schedule time range %q has no limits", fields[0])
+		}
+		for _, field := range fields[1:] {
+			i := strings.IndexByte(field, '=')
+			if i < 0 || i == len(field)-1 {
+				return nil, Error.New("invalid schedule limit %q, use ingress=SIZE or egress=SIZE", field)
+			}
+			key, value := field[:i], field[i+1:]
+			// memory.Size doesn't handle values without any digits.
+			if strings.IndexAny(value, "0123456789") < 0 {
+				return nil, Error.New("invalid schedule limit %q", field)
+			}
+
+			var limit memory.Size
+			if err := limit.Set(value); err != nil {
+				return nil, Error.New("invalid schedule limit %q: %v", field, err)
+			}
+
+			switch key {
+			case "ingress":
+				entry.Ingress = &limit
+			case "egress":
+				entry.Egress = &limit
+			default:
+				return nil, Error.New("unknown schedule limit %q, expected ingress or egress", key)
+			}
+		}
+
+		schedule = append(schedule, entry)
+	}
+	return schedule, nil
+}
+
+// parseTimeOfDay parses a HH:MM time into the duration since midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, Error.New("invalid time of day %q, use HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -27,11 +27,14 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/lostpieces"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/admission"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
@@ -44,12 +47,40 @@ var (
 // OldConfig contains everything necessary for a server.
 type OldConfig struct {
 	Path                   string         `help:"path to store data in" default:"$CONFDIR/storage"`
+	ExtraPaths             []string       `user:"true" help:"additional directories to store data in, each given as path=size with its own allocated disk space (e.g. /mnt/disk2=2TB)"`
 	WhitelistedSatellites  storj.NodeURLs `help:"a comma-separated list of approved satellite node urls (unused)" devDefault:"" releaseDefault:""`
 	AllocatedDiskSpace     memory.Size    `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth     memory.Size    `user:"true" help:"total allocated bandwidth in bytes (deprecated)" default:"0B"`
 	KBucketRefreshInterval time.Duration  `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 }
 
+// ExtraRoots returns the additional storage directories and their allocations.
+func (config *OldConfig) ExtraRoots() ([]filestore.RootConfig, error) {
+	var roots []filestore.RootConfig
+	for _, path := range config.ExtraPaths {
+		root, err := filestore.ParseRootConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
+}
+
+// TotalAllocatedDiskSpace returns the allocated disk space of the storage path
+// together with the additional storage directories.
+func (config *OldConfig) TotalAllocatedDiskSpace() (memory.Size, error) {
+	roots, err := config.ExtraRoots()
+	if err != nil {
+		return 0, err
+	}
+	total := config.AllocatedDiskSpace
+	for _, root := range roots {
+		total += memory.Size(root.Allocated)
+	}
+	return total, nil
+}
+
 // Config defines parameters for piecestore endpoint.
 type Config struct {
 	DatabaseDir             string        `help:"directory to store databases. if empty, uses data path" default:""`
@@ -66,8 +97,9 @@ type Config struct {
 
 	Trust trust.Config
 
-	Monitor monitor.Config
-	Orders  orders.Config
+	Admission admission.Config
+	Monitor   monitor.Config
+	Orders    orders.Config
 }
 
 type pingStatsSource interface {
@@ -94,12 +126,19 @@ type Endpoint struct {
 	usage        bandwidth.DB
 	usedSerials  *usedserials.Table
 	pieceDeleter *pieces.Deleter
+	lostPieces   lostpieces.DB
+	admission    *admission.Controller
 
 	liveRequests int32
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, lostPieces lostpieces.DB, config Config) (*Endpoint, error) {
+	admissionController, err := admission.NewController(config.Admission)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -115,6 +154,8 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		usage:        usage,
 		usedSerials:  usedSerials,
 		pieceDeleter: pieceDeleter,
+		lostPieces:   lostPieces,
+		admission:    admissionController,
 
 		liveRequests: 0,
 	}, nil
@@ -201,6 +242,21 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
 	}
 
+	finishAdmission, err := endpoint.admission.AdmitUpload(time.Now())
+	if err != nil {
+		endpoint.log.Info("upload rejected", zap.Error(err))
+		return rpcstatus.Wrap(rpcstatus.Unavailable, err)
+	}
+
+	var pieceWriter *pieces.Writer
+	defer func() {
+		uploadSize := int64(0)
+		if pieceWriter != nil {
+			uploadSize = pieceWriter.Size()
+		}
+		finishAdmission(time.Now(), uploadSize)
+	}()
+
 	startTime := time.Now().UTC()
 
 	// TODO: set maximum message size
@@ -245,7 +301,6 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return rpcstatus.Errorf(rpcstatus.Aborted, "not enough available disk space, have: %v, need: %v", availableSpace, limit.Limit)
 	}
 
-	var pieceWriter *pieces.Writer
 	// committed is set to true when the piece is committed.
 	// It is used to distinguish successful pieces where the uplink cancels the connections,
 	// and pieces that were actually canceled before being completed.
@@ -363,6 +418,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			if _, err := pieceWriter.Write(message.Chunk.Data); err != nil {
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
+			endpoint.admission.Ingress(time.Now(), chunkSize)
 		}
 
 		if message.Done != nil {
@@ -383,9 +439,12 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 					Signature:    message.Done.GetSignature(),
 					OrderLimit:   *limit,
 				}
+				commitStart := time.Now()
 				if err := pieceWriter.Commit(ctx, info); err != nil {
 					return rpcstatus.Wrap(rpcstatus.Internal, err)
 				}
+				commitEnd := time.Now()
+				endpoint.admission.ObserveCommit(commitEnd, commitEnd.Sub(commitStart))
 				committed = true
 				if !limit.PieceExpiration.IsZero() {
 					err := endpoint.store.SetExpiration(ctx, limit.SatelliteId, limit.PieceId, limit.PieceExpiration)
@@ -454,6 +513,15 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			"expected get or get repair or audit action got %v", limit.Action)
 	}
 
+	// audit and repair traffic is always served, since rejecting it would
+	// hurt the reputation of the node and the durability of the network.
+	if limit.Action == pb.PieceAction_GET {
+		if err := endpoint.admission.AdmitDownload(time.Now()); err != nil {
+			endpoint.log.Info("download rejected", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Error(err))
+			return rpcstatus.Wrap(rpcstatus.Unavailable, err)
+		}
+	}
+
 	if chunk.ChunkSize > limit.Limit {
 		return rpcstatus.Errorf(rpcstatus.InvalidArgument,
 			"requested more that order limit allows, limit=%v requested=%v", limit.Limit, chunk.ChunkSize)
@@ -505,6 +573,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	if err != nil {
 		if os.IsNotExist(err) {
 			endpoint.monitor.VerifyDirReadableLoop.TriggerWait()
+			endpoint.recordLostPiece(ctx, limit)
 			return rpcstatus.Wrap(rpcstatus.NotFound, err)
 		}
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
@@ -594,6 +663,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			if err != nil {
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
+			endpoint.admission.Egress(time.Now(), chunkSize)
 
 			currentOffset += chunkSize
 			unsentAmount -= chunkSize
@@ -690,6 +760,25 @@ func (endpoint *Endpoint) beginSaveOrder(limit *pb.OrderLimit) (_commit func(ctx
 	}, nil
 }
 
+// recordLostPiece records a piece which the satellite expects the node to
+// have, so that it is reported to the satellite. Only audit and repair
+// downloads are considered, since those are created by the satellite for
+// pieces it knows about.
+func (endpoint *Endpoint) recordLostPiece(ctx context.Context, limit *pb.OrderLimit) {
+	if limit.Action != pb.PieceAction_GET_AUDIT && limit.Action != pb.PieceAction_GET_REPAIR {
+		return
+	}
+	mon.Meter("download_lost_piece").Mark(1)
+
+	err := endpoint.lostPieces.Add(ctx, limit.SatelliteId, limit.PieceId, time.Now())
+	if err != nil {
+		endpoint.log.Error("failed to record lost piece",
+			zap.Stringer("Satellite ID", limit.SatelliteId),
+			zap.Stringer("Piece ID", limit.PieceId),
+			zap.Error(err))
+	}
+}
+
 // RestoreTrash restores all trashed items for the satellite issuing the call.
 func (endpoint *Endpoint) RestoreTrash(ctx context.Context, restoreTrashReq *pb.RestoreTrashRequest) (res *pb.RestoreTrashResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -27,6 +27,7 @@ import (
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/apikeys"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/lostpieces"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
@@ -83,6 +84,11 @@ type Config struct {
 	Driver    string // if unset, uses sqlite3
 	Pieces    string
 	Filestore filestore.Config
+
+	// PiecesAllocated is the allocated disk space of Pieces. It's only used
+	// when pieces span ExtraPieces as well.
+	PiecesAllocated int64
+	ExtraPieces     []filestore.RootConfig
 }
 
 // DB contains access to different database tables.
@@ -108,6 +114,7 @@ type DB struct {
 	payoutDB          *payoutDB
 	pricingDB         *pricingDB
 	apiKeysDB         *apiKeysDB
+	scrubDB           *scrubDB
 
 	SQLDBs map[string]DBContainer
 }
@@ -119,7 +126,10 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
+	pieces, err := openPieces(log, config, piecesDir)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
@@ -135,6 +145,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	scrubDB := &scrubDB{}
 
 	db := &DB{
 		log:    log,
@@ -158,6 +169,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		scrubDB:           scrubDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -174,12 +186,36 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			ScrubDBName:           scrubDB,
 		},
 	}
 
 	return db, nil
 }
 
+// openPieces returns the blob storage for pieces, spanning the extra piece
+// directories when they are configured.
+func openPieces(log *zap.Logger, config Config, piecesDir *filestore.Dir) (storage.Blobs, error) {
+	if len(config.ExtraPieces) == 0 {
+		return filestore.New(log, piecesDir, config.Filestore), nil
+	}
+
+	dirs := []*filestore.Dir{piecesDir}
+	allocations := []int64{config.PiecesAllocated}
+	for _, root := range config.ExtraPieces {
+		// extra directories may be added after the node has been set up,
+		// so they are created when missing.
+		dir, err := filestore.NewDir(log, root.Path)
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, dir)
+		allocations = append(allocations, root.Allocated)
+	}
+
+	return filestore.NewMulti(log, dirs, allocations, config.Filestore)
+}
+
 // OpenExisting opens an existing master database for storage node.
 func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	piecesDir, err := filestore.OpenDir(log, config.Pieces)
@@ -187,7 +223,10 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		return nil, err
 	}
 
-	pieces := filestore.New(log, piecesDir, config.Filestore)
+	pieces, err := openPieces(log, config, piecesDir)
+	if err != nil {
+		return nil, err
+	}
 
 	deprecatedInfoDB := &deprecatedInfoDB{}
 	v0PieceInfoDB := &v0PieceInfoDB{}
@@ -203,6 +242,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	scrubDB := &scrubDB{}
 
 	db := &DB{
 		log:    log,
@@ -226,6 +266,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		scrubDB:           scrubDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -242,6 +283,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			ScrubDBName:           scrubDB,
 		},
 	}
 
@@ -275,6 +317,7 @@ func (db *DB) openDatabases(ctx context.Context) error {
 		HeldAmountDBName,
 		PricingDBName,
 		APIKeysDBName,
+		ScrubDBName,
 	}
 
 	for _, dbName := range dbs {
@@ -548,6 +591,16 @@ func (db *DB) APIKeys() apikeys.DB {
 	return db.apiKeysDB
 }
 
+// Scrub returns the instance of the Scrub database.
+func (db *DB) Scrub() pieces.ScrubDB {
+	return db.scrubDB
+}
+
+// LostPieces returns the instance of the LostPieces database.
+func (db *DB) LostPieces() lostpieces.DB {
+	return db.scrubDB
+}
+
 // RawDatabases are required for testing purposes.
 func (db *DB) RawDatabases() map[string]DBContainer {
 	return db.SQLDBs
@@ -1979,6 +2032,44 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					`UPDATE paystubs SET distributed = paid WHERE period < '2020-12'`,
 				},
 			},
+			{
+				DB:          &db.scrubDB.DB,
+				Description: "Create scrub_progress and corrupt_pieces tables",
+				Version:     52,
+				CreateDB: func(ctx context.Context, log *zap.Logger) error {
+					if err := db.openDatabase(ctx, ScrubDBName); err != nil {
+						return ErrDatabase.Wrap(err)
+					}
+
+					return nil
+				},
+				Action: migrate.SQL{
+					`CREATE TABLE scrub_progress (
+						satellite_id BLOB NOT NULL,
+						key_prefix TEXT NOT NULL,
+						pass_started_at TIMESTAMP,
+						last_pass_completed_at TIMESTAMP,
+						pieces_scrubbed INTEGER NOT NULL,
+						pieces_corrupt INTEGER NOT NULL,
+						PRIMARY KEY (satellite_id)
+					);`,
+					`CREATE TABLE corrupt_pieces (
+						satellite_id BLOB NOT NULL,
+						piece_id BLOB NOT NULL,
+						detected_at TIMESTAMP NOT NULL,
+						PRIMARY KEY (satellite_id, piece_id)
+					);`,
+				},
+			},
+			{
+				DB:          &db.scrubDB.DB,
+				Description: "Track missing pieces and their reporting to the satellites",
+				Version:     53,
+				Action: migrate.SQL{
+					`ALTER TABLE corrupt_pieces RENAME TO lost_pieces`,
+					`ALTER TABLE lost_pieces ADD COLUMN reported_at TIMESTAMP`,
+				},
+			},
 		},
 	}
 }
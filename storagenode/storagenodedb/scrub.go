@@ -0,0 +1,176 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/tagsql"
+	"storj.io/storj/storagenode/lostpieces"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// ensures that scrubDB implements pieces.ScrubDB and lostpieces.DB interfaces.
+var (
+	_ pieces.ScrubDB = (*scrubDB)(nil)
+	_ lostpieces.DB  = (*scrubDB)(nil)
+)
+
+// ErrScrubDB represents errors from the scrub database.
+var ErrScrubDB = errs.Class("scrubdb error")
+
+// ScrubDBName represents the database name.
+const ScrubDBName = "scrub"
+
+// scrubDB stores the progress of the piece scrubber and the pieces which
+// are missing or failed verification.
+type scrubDB struct {
+	dbContainerImpl
+}
+
+// GetProgress returns the scrubbing progress for the satellite.
+func (db *scrubDB) GetProgress(ctx context.Context, satelliteID storj.NodeID) (progress pieces.ScrubProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT key_prefix, pass_started_at, last_pass_completed_at, pieces_scrubbed, pieces_corrupt
+		FROM scrub_progress
+		WHERE satellite_id = ?
+	`, satelliteID)
+	if err != nil {
+		return progress, ErrScrubDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	progress.SatelliteID = satelliteID
+	if rows.Next() {
+		var passStartedAt, lastPassCompletedAt *time.Time
+		err := rows.Scan(&progress.KeyPrefix, &passStartedAt, &lastPassCompletedAt, &progress.PiecesScrubbed, &progress.PiecesCorrupt)
+		if err != nil {
+			return progress, ErrScrubDB.Wrap(err)
+		}
+		if passStartedAt != nil {
+			progress.PassStartedAt = *passStartedAt
+		}
+		if lastPassCompletedAt != nil {
+			progress.LastPassCompletedAt = *lastPassCompletedAt
+		}
+	}
+	return progress, ErrScrubDB.Wrap(rows.Err())
+}
+
+// SetProgress stores the scrubbing progress for the satellite.
+func (db *scrubDB) SetProgress(ctx context.Context, progress pieces.ScrubProgress) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		INSERT OR REPLACE INTO scrub_progress (
+			satellite_id, key_prefix, pass_started_at, last_pass_completed_at, pieces_scrubbed, pieces_corrupt
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`, progress.SatelliteID, progress.KeyPrefix,
+		nullTime(progress.PassStartedAt), nullTime(progress.LastPassCompletedAt),
+		progress.PiecesScrubbed, progress.PiecesCorrupt)
+	return ErrScrubDB.Wrap(err)
+}
+
+// AddCorrupt records a piece which failed verification.
+func (db *scrubDB) AddCorrupt(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, detectedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return db.Add(ctx, satelliteID, pieceID, detectedAt)
+}
+
+// Add records a piece which is missing or failed verification. Pieces which
+// were recorded before keep their original detection time.
+func (db *scrubDB) Add(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, detectedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `
+		INSERT OR IGNORE INTO lost_pieces (satellite_id, piece_id, detected_at) VALUES (?, ?, ?)
+	`, satelliteID, pieceID, detectedAt.UTC())
+	return ErrScrubDB.Wrap(err)
+}
+
+// ListUnreported returns up to limit pieces of the satellite which haven't
+// been reported yet, oldest first.
+func (db *scrubDB) ListUnreported(ctx context.Context, satelliteID storj.NodeID, limit int) (lost []lostpieces.LostPiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT piece_id, detected_at
+		FROM lost_pieces
+		WHERE satellite_id = ? AND reported_at IS NULL
+		ORDER BY detected_at, piece_id
+		LIMIT ?
+	`, satelliteID, limit)
+	if err != nil {
+		return nil, ErrScrubDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		piece := lostpieces.LostPiece{SatelliteID: satelliteID}
+		if err := rows.Scan(&piece.PieceID, &piece.DetectedAt); err != nil {
+			return nil, ErrScrubDB.Wrap(err)
+		}
+		lost = append(lost, piece)
+	}
+	return lost, ErrScrubDB.Wrap(rows.Err())
+}
+
+// SetReported marks the pieces as reported to the satellite.
+func (db *scrubDB) SetReported(ctx context.Context, satelliteID storj.NodeID, pieceIDs []storj.PieceID, reportedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrScrubDB.Wrap(withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		for _, pieceID := range pieceIDs {
+			_, err := tx.ExecContext(ctx, `
+				UPDATE lost_pieces SET reported_at = ? WHERE satellite_id = ? AND piece_id = ?
+			`, reportedAt.UTC(), satelliteID, pieceID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// Summary returns the scrubbing totals across all satellites.
+func (db *scrubDB) Summary(ctx context.Context) (summary pieces.ScrubSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT last_pass_completed_at, pieces_scrubbed, pieces_corrupt FROM scrub_progress
+	`)
+	if err != nil {
+		return summary, ErrScrubDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var lastPassCompletedAt *time.Time
+		var scrubbed, corrupt int64
+		if err := rows.Scan(&lastPassCompletedAt, &scrubbed, &corrupt); err != nil {
+			return summary, ErrScrubDB.Wrap(err)
+		}
+		summary.PiecesScrubbed += scrubbed
+		summary.PiecesCorrupt += corrupt
+		if lastPassCompletedAt != nil && lastPassCompletedAt.After(summary.LastPassCompletedAt) {
+			summary.LastPassCompletedAt = *lastPassCompletedAt
+		}
+	}
+	return summary, ErrScrubDB.Wrap(rows.Err())
+}
+
+// nullTime converts the zero time to NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
@@ -66,6 +66,8 @@ var States = MultiDBStates{
 		&v49,
 		&v50,
 		&v51,
+		&v52,
+		&v53,
 	},
 }
 
@@ -0,0 +1,48 @@
+// Copyright (C) 2021 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v52 = MultiDBState{
+	Version: 52,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v47.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v47.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v48.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v47.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v47.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v47.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v47.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v47.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v47.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v47.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v47.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName: &DBState{
+			SQL: v51.DBStates[storagenodedb.HeldAmountDBName].SQL + v51.DBStates[storagenodedb.HeldAmountDBName].NewData,
+		},
+		storagenodedb.PricingDBName: v47.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName: v47.DBStates[storagenodedb.APIKeysDBName],
+		storagenodedb.ScrubDBName: &DBState{
+			SQL: `
+				-- tables to store the progress of the piece scrubber
+				CREATE TABLE scrub_progress (
+					satellite_id BLOB NOT NULL,
+					key_prefix TEXT NOT NULL,
+					pass_started_at TIMESTAMP,
+					last_pass_completed_at TIMESTAMP,
+					pieces_scrubbed INTEGER NOT NULL,
+					pieces_corrupt INTEGER NOT NULL,
+					PRIMARY KEY (satellite_id)
+				);
+				CREATE TABLE corrupt_pieces (
+					satellite_id BLOB NOT NULL,
+					piece_id BLOB NOT NULL,
+					detected_at TIMESTAMP NOT NULL,
+					PRIMARY KEY (satellite_id, piece_id)
+				);
+			`,
+		},
+	},
+}